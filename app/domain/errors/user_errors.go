@@ -2,6 +2,7 @@ package errors
 
 import (
 	"errors"
+	"time"
 )
 
 // ユーザードメインのエラー定義
@@ -26,6 +27,168 @@ var (
 
 	// ErrJWTGenerationFailed はJWT生成に失敗した場合のエラーです
 	ErrJWTGenerationFailed = errors.New("トークン生成に失敗しました")
+
+	// ErrInvalidIDToken はFirebase IDトークンの検証に失敗した場合のエラーです
+	ErrInvalidIDToken = errors.New("IDトークンが不正です")
+
+	// ErrUserDeleted はユーザーが論理削除済みの場合のエラーです
+	ErrUserDeleted = errors.New("このユーザーは削除されています")
+
+	// ErrInvalidRefreshToken はリフレッシュトークンが不正・期限切れ・失効済みの場合のエラーです
+	ErrInvalidRefreshToken = errors.New("リフレッシュトークンが不正です")
+
+	// ErrRefreshTokenReused はローテーション済みのリフレッシュトークンが再利用された場合のエラーです
+	ErrRefreshTokenReused = errors.New("リフレッシュトークンが再利用されました。再度ログインしてください")
+
+	// ErrInvalidAccessToken はアクセストークンが不正・期限切れの場合のエラーです
+	ErrInvalidAccessToken = errors.New("アクセストークンが不正です")
+
+	// ErrTokenRevoked はログアウトなどでトークンが失効済みの場合のエラーです
+	ErrTokenRevoked = errors.New("トークンは失効しています。再度ログインしてください")
+
+	// ErrAuthenticationRequired はログインが必要な操作を未認証で実行した場合のエラーです
+	ErrAuthenticationRequired = errors.New("ログインが必要です")
+
+	// ErrForbidden は操作に必要な権限（ロール）を持たない場合のエラーです
+	ErrForbidden = errors.New("この操作を行う権限がありません")
+
+	// ErrEmailNotVerified はメールアドレスの確認が完了していない場合のエラーです
+	ErrEmailNotVerified = errors.New("メールアドレスの確認が完了していません")
+
+	// ErrTooManyPasswordResetRequests は同じメールアドレスへのパスワードリセット要求が上限を超えた場合のエラーです
+	ErrTooManyPasswordResetRequests = errors.New("パスワードリセットの要求回数が上限に達しました。しばらくしてから再度お試しください")
+
+	// ErrMailSendFailed はメール送信に失敗した場合のエラーです
+	ErrMailSendFailed = errors.New("メールの送信に失敗しました")
+
+	// ErrEmailAlreadyVerified は確認済みのメールアドレスに確認メールの再送信を要求した場合のエラーです
+	ErrEmailAlreadyVerified = errors.New("メールアドレスは既に確認済みです")
+
+	// ErrTooManyVerificationEmailRequests は確認メールの再送信要求が上限を超えた場合のエラーです
+	ErrTooManyVerificationEmailRequests = errors.New("確認メールの再送信回数が上限に達しました。しばらくしてから再度お試しください")
+
+	// ErrUnsupportedProvider は対応していない外部プロバイダが指定された場合のエラーです
+	ErrUnsupportedProvider = errors.New("対応していないログインプロバイダです")
+
+	// ErrInvalidProviderToken は外部プロバイダのIDトークンの検証に失敗した場合のエラーです
+	ErrInvalidProviderToken = errors.New("外部プロバイダのIDトークンが不正です")
+
+	// ErrProviderAlreadyLinked は外部アカウントが既に別のユーザーに連携されている、またはユーザーが同じプロバイダの別アカウントを連携済みの場合のエラーです
+	ErrProviderAlreadyLinked = errors.New("この外部アカウントは既に連携されています")
+
+	// ErrProviderNotLinked は連携されていない外部プロバイダの連携解除を要求した場合のエラーです
+	ErrProviderNotLinked = errors.New("この外部プロバイダは連携されていません")
+
+	// ErrCannotUnlinkLastProvider はログイン手段が無くなる連携解除を要求した場合のエラーです
+	ErrCannotUnlinkLastProvider = errors.New("最後のログイン手段は連携解除できません")
+
+	// ErrProviderEmailRequired は外部プロバイダからメールアドレスが提供されず新規登録できない場合のエラーです
+	ErrProviderEmailRequired = errors.New("外部プロバイダからメールアドレスを取得できませんでした")
+
+	// ErrMfaAlreadyEnabled は二要素認証が既に有効な場合のエラーです
+	ErrMfaAlreadyEnabled = errors.New("二要素認証は既に有効です")
+
+	// ErrMfaNotEnabled は二要素認証が有効になっていない場合のエラーです
+	ErrMfaNotEnabled = errors.New("二要素認証が有効になっていません")
+
+	// ErrMfaEnrollmentNotFound は二要素認証の登録が開始されていない場合のエラーです
+	ErrMfaEnrollmentNotFound = errors.New("二要素認証の登録が開始されていません")
+
+	// ErrInvalidMfaCode は二要素認証の認証コード・リカバリーコードが正しくない場合のエラーです
+	ErrInvalidMfaCode = errors.New("認証コードが正しくありません")
+
+	// ErrInvalidMfaChallenge は二要素認証のチャレンジトークンが不正・期限切れの場合のエラーです
+	ErrInvalidMfaChallenge = errors.New("二要素認証の有効期限が切れました。再度ログインしてください")
+
+	// ErrMfaRequired は二要素認証が必須のユーザー（管理者）が二要素認証を設定していない、または無効にしようとした場合のエラーです
+	ErrMfaRequired = errors.New("管理者は二要素認証の設定が必要です")
+
+	// ErrSessionNotFound は存在しない・失効済み・他のユーザーのセッションの失効を要求した場合のエラーです
+	ErrSessionNotFound = errors.New("セッションが見つかりません")
+
+	// ErrTooManyAttempts は認証の失敗回数が上限に達し、待ち時間中またはロックアウト中の場合のエラーです
+	ErrTooManyAttempts = errors.New("試行回数が上限に達しました。しばらくしてから再度お試しください")
+
+	// ErrInvalidIdempotencyKey は冪等キーが空・255文字を超える・空白や制御文字を含む場合のエラーです
+	ErrInvalidIdempotencyKey = errors.New("冪等キーの形式が不正です")
+
+	// ErrIdempotencyKeyReused は同じ冪等キーで異なる内容のリクエストが送信された場合のエラーです
+	ErrIdempotencyKeyReused = errors.New("この冪等キーは別のリクエストで使用されています")
+
+	// ErrIdempotentRequestInProgress は同じ冪等キーのリクエストが処理中の場合のエラーです
+	ErrIdempotentRequestInProgress = errors.New("同じリクエストを処理中です。しばらくしてから再度お試しください")
+
+	// ErrInvalidProfile はプロフィールの入力値が不正な場合のエラーです
+	ErrInvalidProfile = errors.New("プロフィールの入力内容が不正です")
+
+	// ErrProfileNotFound はプロフィールが見つからない場合のエラーです
+	ErrProfileNotFound = errors.New("プロフィールが見つかりません")
+
+	// ErrProfileAlreadyExists はプロフィールが作成済みの場合のエラーです
+	ErrProfileAlreadyExists = errors.New("プロフィールは既に作成されています")
+
+	// ErrInvalidHandle はハンドルの形式が不正な場合のエラーです
+	ErrInvalidHandle = errors.New("ハンドルの形式が不正です")
+
+	// ErrHandleUnavailable は他のユーザーが使用中・予約済みのハンドルを指定した場合のエラーです
+	ErrHandleUnavailable = errors.New("このハンドルは使用できません")
+
+	// ErrHandleChangeCooldown は前回の変更から一定期間が経過する前にハンドルを変更しようとした場合のエラーです
+	ErrHandleChangeCooldown = errors.New("ハンドルは前回の変更から30日間変更できません")
+
+	// ErrCannotFollowSelf は自分自身をフォローしようとした場合のエラーです
+	ErrCannotFollowSelf = errors.New("自分自身はフォローできません")
+
+	// ErrFollowTargetUnavailable は削除済み・利用停止中のユーザーをフォローしようとした場合のエラーです
+	ErrFollowTargetUnavailable = errors.New("このユーザーはフォローできません")
+
+	// ErrInvalidPagination は一覧の取得件数・カーソルの指定が不正な場合のエラーです
+	ErrInvalidPagination = errors.New("ページネーションの指定が不正です")
+
+	// ErrCannotBlockSelf は自分自身をブロックしようとした場合のエラーです
+	ErrCannotBlockSelf = errors.New("自分自身はブロックできません")
+
+	// ErrCannotMuteSelf は自分自身をミュートしようとした場合のエラーです
+	ErrCannotMuteSelf = errors.New("自分自身はミュートできません")
+
+	// ErrInvalidAccountDeletionToken はアカウント削除の取り消しコードが無効か、取り消し期限を過ぎている場合のエラーです
+	ErrInvalidAccountDeletionToken = errors.New("アカウント削除の取り消しコードが無効か、取り消し期限を過ぎています")
+
+	// ErrDataExportNotFound はデータのエクスポートが見つからない、またはダウンロードの有効期限が切れている場合のエラーです
+	ErrDataExportNotFound = errors.New("データのエクスポートが見つからないか、ダウンロードの有効期限が切れています")
+
+	// ErrStorageError はファイルの保存先（ストレージ）でエラーが発生した場合のエラーです
+	ErrStorageError = errors.New("ストレージでエラーが発生しました")
+
+	// ErrSameEmail は変更後のメールアドレスが現在のメールアドレスと同じ場合のエラーです
+	ErrSameEmail = errors.New("現在と同じメールアドレスには変更できません")
+
+	// ErrTooManyEmailChangeRequests はメールアドレスの変更要求が上限を超えた場合のエラーです
+	ErrTooManyEmailChangeRequests = errors.New("メールアドレスの変更の要求回数が上限に達しました。しばらくしてから再度お試しください")
+
+	// ErrInvalidEmailChangeToken はメールアドレスの変更の確認リンクが無効か、有効期限を過ぎている場合のエラーです
+	ErrInvalidEmailChangeToken = errors.New("メールアドレスの変更の確認リンクが無効か、有効期限を過ぎています")
+
+	// ErrInvalidImage は画像ファイルを読み込めない（破損している）場合のエラーです
+	ErrInvalidImage = errors.New("画像ファイルを読み込めません")
+
+	// ErrUnsupportedImageType はファイルの先頭のバイト列から判定した形式がアップロードできる画像の形式ではない場合のエラーです
+	ErrUnsupportedImageType = errors.New("対応していないファイルの形式です（JPEG・PNGの画像のみアップロードできます）")
+
+	// ErrImageTooLarge は画像のファイルサイズまたは画素数が上限を超えている場合のエラーです
+	ErrImageTooLarge = errors.New("画像のファイルサイズまたは画素数が上限を超えています")
+
+	// ErrInvalidImagePurpose は画像の用途が不正な場合のエラーです
+	ErrInvalidImagePurpose = errors.New("画像の用途が不正です")
+
+	// ErrPrivateAccount は非公開アカウントのコンテンツ・フォロー関係を、承認されたフォロワー以外が閲覧しようとした場合のエラーです
+	ErrPrivateAccount = errors.New("このアカウントは非公開です")
+
+	// ErrFollowRequestNotFound は承認・拒否しようとしたフォローリクエストが存在しない場合のエラーです
+	ErrFollowRequestNotFound = errors.New("フォローリクエストが見つかりません")
+
+	// ErrInvalidPrivacySettings はプライバシー設定の値が不正な場合のエラーです
+	ErrInvalidPrivacySettings = errors.New("プライバシー設定が不正です")
 )
 
 // user_domain_errors はユーザードメインのエラー一覧です
@@ -37,6 +200,60 @@ var user_domain_errors = []error{
 	ErrFirebaseAuthFailed,
 	ErrDatabaseError,
 	ErrJWTGenerationFailed,
+	ErrInvalidIDToken,
+	ErrUserDeleted,
+	ErrInvalidRefreshToken,
+	ErrRefreshTokenReused,
+	ErrInvalidAccessToken,
+	ErrTokenRevoked,
+	ErrAuthenticationRequired,
+	ErrForbidden,
+	ErrEmailNotVerified,
+	ErrTooManyPasswordResetRequests,
+	ErrMailSendFailed,
+	ErrEmailAlreadyVerified,
+	ErrTooManyVerificationEmailRequests,
+	ErrUnsupportedProvider,
+	ErrInvalidProviderToken,
+	ErrProviderAlreadyLinked,
+	ErrProviderNotLinked,
+	ErrCannotUnlinkLastProvider,
+	ErrProviderEmailRequired,
+	ErrMfaAlreadyEnabled,
+	ErrMfaNotEnabled,
+	ErrMfaEnrollmentNotFound,
+	ErrInvalidMfaCode,
+	ErrInvalidMfaChallenge,
+	ErrMfaRequired,
+	ErrSessionNotFound,
+	ErrTooManyAttempts,
+	ErrInvalidIdempotencyKey,
+	ErrIdempotencyKeyReused,
+	ErrIdempotentRequestInProgress,
+	ErrInvalidProfile,
+	ErrProfileNotFound,
+	ErrProfileAlreadyExists,
+	ErrInvalidHandle,
+	ErrHandleUnavailable,
+	ErrHandleChangeCooldown,
+	ErrCannotFollowSelf,
+	ErrFollowTargetUnavailable,
+	ErrInvalidPagination,
+	ErrCannotBlockSelf,
+	ErrCannotMuteSelf,
+	ErrInvalidAccountDeletionToken,
+	ErrDataExportNotFound,
+	ErrStorageError,
+	ErrSameEmail,
+	ErrTooManyEmailChangeRequests,
+	ErrInvalidEmailChangeToken,
+	ErrInvalidImage,
+	ErrUnsupportedImageType,
+	ErrImageTooLarge,
+	ErrInvalidImagePurpose,
+	ErrPrivateAccount,
+	ErrFollowRequestNotFound,
+	ErrInvalidPrivacySettings,
 }
 
 // IsUserDomainError はエラーがユーザードメインのエラーかどうかを判定します
@@ -48,3 +265,26 @@ func IsUserDomainError(err error) bool {
 	}
 	return false
 }
+
+// TooManyAttemptsError は次の試行を受け付けるまでの待ち時間を持つErrTooManyAttemptsです
+// errors.Is(err, ErrTooManyAttempts) で判定し、待ち時間はerrors.Asで取得します
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+// NewTooManyAttemptsError は新しいTooManyAttemptsErrorを作成します
+func NewTooManyAttemptsError(retry_after time.Duration) *TooManyAttemptsError {
+	return &TooManyAttemptsError{
+		RetryAfter: retry_after,
+	}
+}
+
+// Error はErrTooManyAttemptsのメッセージを返します
+func (e *TooManyAttemptsError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+// Unwrap はErrTooManyAttemptsを返します
+func (e *TooManyAttemptsError) Unwrap() error {
+	return ErrTooManyAttempts
+}
@@ -2,7 +2,9 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 )
 
 func TestErrInvalidEmail(t *testing.T) {
@@ -103,6 +105,784 @@ func TestErrJWTGenerationFailed(t *testing.T) {
 	}
 }
 
+func TestErrInvalidIDToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidIDToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidIDToken to be not nil")
+	}
+	if err.Error() != "IDトークンが不正です" {
+		t.Errorf("expected error message to be 'IDトークンが不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrUserDeleted(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrUserDeleted
+	// Assert
+	if err == nil {
+		t.Error("expected ErrUserDeleted to be not nil")
+	}
+	if err.Error() != "このユーザーは削除されています" {
+		t.Errorf("expected error message to be 'このユーザーは削除されています', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidRefreshToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidRefreshToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidRefreshToken to be not nil")
+	}
+	if err.Error() != "リフレッシュトークンが不正です" {
+		t.Errorf("expected error message to be 'リフレッシュトークンが不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrRefreshTokenReused(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrRefreshTokenReused
+	// Assert
+	if err == nil {
+		t.Error("expected ErrRefreshTokenReused to be not nil")
+	}
+	if err.Error() != "リフレッシュトークンが再利用されました。再度ログインしてください" {
+		t.Errorf("expected error message to be 'リフレッシュトークンが再利用されました。再度ログインしてください', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidAccessToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidAccessToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidAccessToken to be not nil")
+	}
+	if err.Error() != "アクセストークンが不正です" {
+		t.Errorf("expected error message to be 'アクセストークンが不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrTokenRevoked(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrTokenRevoked
+	// Assert
+	if err == nil {
+		t.Error("expected ErrTokenRevoked to be not nil")
+	}
+	if err.Error() != "トークンは失効しています。再度ログインしてください" {
+		t.Errorf("expected error message to be 'トークンは失効しています。再度ログインしてください', got '%s'", err.Error())
+	}
+}
+
+func TestErrAuthenticationRequired(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrAuthenticationRequired
+	// Assert
+	if err == nil {
+		t.Error("expected ErrAuthenticationRequired to be not nil")
+	}
+	if err.Error() != "ログインが必要です" {
+		t.Errorf("expected error message to be 'ログインが必要です', got '%s'", err.Error())
+	}
+}
+
+func TestErrForbidden(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrForbidden
+	// Assert
+	if err == nil {
+		t.Error("expected ErrForbidden to be not nil")
+	}
+	if err.Error() != "この操作を行う権限がありません" {
+		t.Errorf("expected error message to be 'この操作を行う権限がありません', got '%s'", err.Error())
+	}
+}
+
+func TestErrEmailNotVerified(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrEmailNotVerified
+	// Assert
+	if err == nil {
+		t.Error("expected ErrEmailNotVerified to be not nil")
+	}
+	if err.Error() != "メールアドレスの確認が完了していません" {
+		t.Errorf("expected error message to be 'メールアドレスの確認が完了していません', got '%s'", err.Error())
+	}
+}
+
+func TestErrTooManyPasswordResetRequests(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrTooManyPasswordResetRequests
+	// Assert
+	if err == nil {
+		t.Error("expected ErrTooManyPasswordResetRequests to be not nil")
+	}
+	if err.Error() != "パスワードリセットの要求回数が上限に達しました。しばらくしてから再度お試しください" {
+		t.Errorf("expected error message to be 'パスワードリセットの要求回数が上限に達しました。しばらくしてから再度お試しください', got '%s'", err.Error())
+	}
+}
+
+func TestErrMailSendFailed(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrMailSendFailed
+	// Assert
+	if err == nil {
+		t.Error("expected ErrMailSendFailed to be not nil")
+	}
+	if err.Error() != "メールの送信に失敗しました" {
+		t.Errorf("expected error message to be 'メールの送信に失敗しました', got '%s'", err.Error())
+	}
+}
+
+func TestErrEmailAlreadyVerified(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrEmailAlreadyVerified
+	// Assert
+	if err == nil {
+		t.Error("expected ErrEmailAlreadyVerified to be not nil")
+	}
+	if err.Error() != "メールアドレスは既に確認済みです" {
+		t.Errorf("expected error message to be 'メールアドレスは既に確認済みです', got '%s'", err.Error())
+	}
+}
+
+func TestErrTooManyVerificationEmailRequests(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrTooManyVerificationEmailRequests
+	// Assert
+	if err == nil {
+		t.Error("expected ErrTooManyVerificationEmailRequests to be not nil")
+	}
+	if err.Error() != "確認メールの再送信回数が上限に達しました。しばらくしてから再度お試しください" {
+		t.Errorf("expected error message to be '確認メールの再送信回数が上限に達しました。しばらくしてから再度お試しください', got '%s'", err.Error())
+	}
+}
+
+func TestErrUnsupportedProvider(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrUnsupportedProvider
+	// Assert
+	if err == nil {
+		t.Error("expected ErrUnsupportedProvider to be not nil")
+	}
+	if err.Error() != "対応していないログインプロバイダです" {
+		t.Errorf("expected error message to be '対応していないログインプロバイダです', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidProviderToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidProviderToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidProviderToken to be not nil")
+	}
+	if err.Error() != "外部プロバイダのIDトークンが不正です" {
+		t.Errorf("expected error message to be '外部プロバイダのIDトークンが不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrProviderAlreadyLinked(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrProviderAlreadyLinked
+	// Assert
+	if err == nil {
+		t.Error("expected ErrProviderAlreadyLinked to be not nil")
+	}
+	if err.Error() != "この外部アカウントは既に連携されています" {
+		t.Errorf("expected error message to be 'この外部アカウントは既に連携されています', got '%s'", err.Error())
+	}
+}
+
+func TestErrProviderNotLinked(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrProviderNotLinked
+	// Assert
+	if err == nil {
+		t.Error("expected ErrProviderNotLinked to be not nil")
+	}
+	if err.Error() != "この外部プロバイダは連携されていません" {
+		t.Errorf("expected error message to be 'この外部プロバイダは連携されていません', got '%s'", err.Error())
+	}
+}
+
+func TestErrCannotUnlinkLastProvider(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrCannotUnlinkLastProvider
+	// Assert
+	if err == nil {
+		t.Error("expected ErrCannotUnlinkLastProvider to be not nil")
+	}
+	if err.Error() != "最後のログイン手段は連携解除できません" {
+		t.Errorf("expected error message to be '最後のログイン手段は連携解除できません', got '%s'", err.Error())
+	}
+}
+
+func TestErrProviderEmailRequired(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrProviderEmailRequired
+	// Assert
+	if err == nil {
+		t.Error("expected ErrProviderEmailRequired to be not nil")
+	}
+	if err.Error() != "外部プロバイダからメールアドレスを取得できませんでした" {
+		t.Errorf("expected error message to be '外部プロバイダからメールアドレスを取得できませんでした', got '%s'", err.Error())
+	}
+}
+
+func TestErrMfaAlreadyEnabled(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrMfaAlreadyEnabled
+	// Assert
+	if err == nil {
+		t.Error("expected ErrMfaAlreadyEnabled to be not nil")
+	}
+	if err.Error() != "二要素認証は既に有効です" {
+		t.Errorf("expected error message to be '二要素認証は既に有効です', got '%s'", err.Error())
+	}
+}
+
+func TestErrMfaNotEnabled(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrMfaNotEnabled
+	// Assert
+	if err == nil {
+		t.Error("expected ErrMfaNotEnabled to be not nil")
+	}
+	if err.Error() != "二要素認証が有効になっていません" {
+		t.Errorf("expected error message to be '二要素認証が有効になっていません', got '%s'", err.Error())
+	}
+}
+
+func TestErrMfaEnrollmentNotFound(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrMfaEnrollmentNotFound
+	// Assert
+	if err == nil {
+		t.Error("expected ErrMfaEnrollmentNotFound to be not nil")
+	}
+	if err.Error() != "二要素認証の登録が開始されていません" {
+		t.Errorf("expected error message to be '二要素認証の登録が開始されていません', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidMfaCode(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidMfaCode
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidMfaCode to be not nil")
+	}
+	if err.Error() != "認証コードが正しくありません" {
+		t.Errorf("expected error message to be '認証コードが正しくありません', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidMfaChallenge(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidMfaChallenge
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidMfaChallenge to be not nil")
+	}
+	if err.Error() != "二要素認証の有効期限が切れました。再度ログインしてください" {
+		t.Errorf("expected error message to be '二要素認証の有効期限が切れました。再度ログインしてください', got '%s'", err.Error())
+	}
+}
+
+func TestErrMfaRequired(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrMfaRequired
+	// Assert
+	if err == nil {
+		t.Error("expected ErrMfaRequired to be not nil")
+	}
+	if err.Error() != "管理者は二要素認証の設定が必要です" {
+		t.Errorf("expected error message to be '管理者は二要素認証の設定が必要です', got '%s'", err.Error())
+	}
+}
+
+func TestErrSessionNotFound(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrSessionNotFound
+	// Assert
+	if err == nil {
+		t.Error("expected ErrSessionNotFound to be not nil")
+	}
+	if err.Error() != "セッションが見つかりません" {
+		t.Errorf("expected error message to be 'セッションが見つかりません', got '%s'", err.Error())
+	}
+}
+
+func TestErrTooManyAttempts(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrTooManyAttempts
+	// Assert
+	if err == nil {
+		t.Error("expected ErrTooManyAttempts to be not nil")
+	}
+	if err.Error() != "試行回数が上限に達しました。しばらくしてから再度お試しください" {
+		t.Errorf("expected error message to be '試行回数が上限に達しました。しばらくしてから再度お試しください', got '%s'", err.Error())
+	}
+}
+
+func TestTooManyAttemptsError(t *testing.T) {
+	// Arrange
+	var err error
+	var too_many_attempts_error *TooManyAttemptsError
+
+	// Act
+	err = fmt.Errorf("wrapped: %w", NewTooManyAttemptsError(30*time.Second))
+	// Assert
+	if !errors.Is(err, ErrTooManyAttempts) {
+		t.Error("expected error to be ErrTooManyAttempts")
+	}
+	if !errors.As(err, &too_many_attempts_error) {
+		t.Fatal("expected error to be TooManyAttemptsError")
+	}
+	if too_many_attempts_error.RetryAfter != 30*time.Second {
+		t.Errorf("expected retry_after 30s, got %v", too_many_attempts_error.RetryAfter)
+	}
+	if !IsUserDomainError(err) {
+		t.Error("expected TooManyAttemptsError to be a user domain error")
+	}
+}
+
+func TestErrInvalidIdempotencyKey(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidIdempotencyKey
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidIdempotencyKey to be not nil")
+	}
+	if err.Error() != "冪等キーの形式が不正です" {
+		t.Errorf("expected error message to be '冪等キーの形式が不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrIdempotencyKeyReused(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrIdempotencyKeyReused
+	// Assert
+	if err == nil {
+		t.Error("expected ErrIdempotencyKeyReused to be not nil")
+	}
+	if err.Error() != "この冪等キーは別のリクエストで使用されています" {
+		t.Errorf("expected error message to be 'この冪等キーは別のリクエストで使用されています', got '%s'", err.Error())
+	}
+}
+
+func TestErrIdempotentRequestInProgress(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrIdempotentRequestInProgress
+	// Assert
+	if err == nil {
+		t.Error("expected ErrIdempotentRequestInProgress to be not nil")
+	}
+	if err.Error() != "同じリクエストを処理中です。しばらくしてから再度お試しください" {
+		t.Errorf("expected error message to be '同じリクエストを処理中です。しばらくしてから再度お試しください', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidProfile(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidProfile
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidProfile to be not nil")
+	}
+	if err.Error() != "プロフィールの入力内容が不正です" {
+		t.Errorf("expected error message to be 'プロフィールの入力内容が不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrProfileNotFound(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrProfileNotFound
+	// Assert
+	if err == nil {
+		t.Error("expected ErrProfileNotFound to be not nil")
+	}
+	if err.Error() != "プロフィールが見つかりません" {
+		t.Errorf("expected error message to be 'プロフィールが見つかりません', got '%s'", err.Error())
+	}
+}
+
+func TestErrProfileAlreadyExists(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrProfileAlreadyExists
+	// Assert
+	if err == nil {
+		t.Error("expected ErrProfileAlreadyExists to be not nil")
+	}
+	if err.Error() != "プロフィールは既に作成されています" {
+		t.Errorf("expected error message to be 'プロフィールは既に作成されています', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidHandle(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidHandle
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidHandle to be not nil")
+	}
+	if err.Error() != "ハンドルの形式が不正です" {
+		t.Errorf("expected error message to be 'ハンドルの形式が不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrHandleUnavailable(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrHandleUnavailable
+	// Assert
+	if err == nil {
+		t.Error("expected ErrHandleUnavailable to be not nil")
+	}
+	if err.Error() != "このハンドルは使用できません" {
+		t.Errorf("expected error message to be 'このハンドルは使用できません', got '%s'", err.Error())
+	}
+}
+
+func TestErrHandleChangeCooldown(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrHandleChangeCooldown
+	// Assert
+	if err == nil {
+		t.Error("expected ErrHandleChangeCooldown to be not nil")
+	}
+	if err.Error() != "ハンドルは前回の変更から30日間変更できません" {
+		t.Errorf("expected error message to be 'ハンドルは前回の変更から30日間変更できません', got '%s'", err.Error())
+	}
+}
+
+func TestErrCannotFollowSelf(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrCannotFollowSelf
+	// Assert
+	if err == nil {
+		t.Error("expected ErrCannotFollowSelf to be not nil")
+	}
+	if err.Error() != "自分自身はフォローできません" {
+		t.Errorf("expected error message to be '自分自身はフォローできません', got '%s'", err.Error())
+	}
+}
+
+func TestErrFollowTargetUnavailable(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrFollowTargetUnavailable
+	// Assert
+	if err == nil {
+		t.Error("expected ErrFollowTargetUnavailable to be not nil")
+	}
+	if err.Error() != "このユーザーはフォローできません" {
+		t.Errorf("expected error message to be 'このユーザーはフォローできません', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidPagination(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidPagination
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidPagination to be not nil")
+	}
+	if err.Error() != "ページネーションの指定が不正です" {
+		t.Errorf("expected error message to be 'ページネーションの指定が不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrCannotBlockSelf(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrCannotBlockSelf
+	// Assert
+	if err == nil {
+		t.Error("expected ErrCannotBlockSelf to be not nil")
+	}
+	if err.Error() != "自分自身はブロックできません" {
+		t.Errorf("expected error message to be '自分自身はブロックできません', got '%s'", err.Error())
+	}
+}
+
+func TestErrCannotMuteSelf(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrCannotMuteSelf
+	// Assert
+	if err == nil {
+		t.Error("expected ErrCannotMuteSelf to be not nil")
+	}
+	if err.Error() != "自分自身はミュートできません" {
+		t.Errorf("expected error message to be '自分自身はミュートできません', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidAccountDeletionToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidAccountDeletionToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidAccountDeletionToken to be not nil")
+	}
+	if err.Error() != "アカウント削除の取り消しコードが無効か、取り消し期限を過ぎています" {
+		t.Errorf("expected error message to be 'アカウント削除の取り消しコードが無効か、取り消し期限を過ぎています', got '%s'", err.Error())
+	}
+}
+
+func TestErrDataExportNotFound(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrDataExportNotFound
+	// Assert
+	if err == nil {
+		t.Error("expected ErrDataExportNotFound to be not nil")
+	}
+	if err.Error() != "データのエクスポートが見つからないか、ダウンロードの有効期限が切れています" {
+		t.Errorf("expected error message to be 'データのエクスポートが見つからないか、ダウンロードの有効期限が切れています', got '%s'", err.Error())
+	}
+}
+
+func TestErrStorageError(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrStorageError
+	// Assert
+	if err == nil {
+		t.Error("expected ErrStorageError to be not nil")
+	}
+	if err.Error() != "ストレージでエラーが発生しました" {
+		t.Errorf("expected error message to be 'ストレージでエラーが発生しました', got '%s'", err.Error())
+	}
+}
+
+func TestErrSameEmail(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrSameEmail
+	// Assert
+	if err == nil {
+		t.Error("expected ErrSameEmail to be not nil")
+	}
+	if err.Error() != "現在と同じメールアドレスには変更できません" {
+		t.Errorf("expected error message to be '現在と同じメールアドレスには変更できません', got '%s'", err.Error())
+	}
+}
+
+func TestErrTooManyEmailChangeRequests(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrTooManyEmailChangeRequests
+	// Assert
+	if err == nil {
+		t.Error("expected ErrTooManyEmailChangeRequests to be not nil")
+	}
+	if err.Error() != "メールアドレスの変更の要求回数が上限に達しました。しばらくしてから再度お試しください" {
+		t.Errorf("expected error message to be 'メールアドレスの変更の要求回数が上限に達しました。しばらくしてから再度お試しください', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidEmailChangeToken(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidEmailChangeToken
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidEmailChangeToken to be not nil")
+	}
+	if err.Error() != "メールアドレスの変更の確認リンクが無効か、有効期限を過ぎています" {
+		t.Errorf("expected error message to be 'メールアドレスの変更の確認リンクが無効か、有効期限を過ぎています', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidImage(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidImage
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidImage to be not nil")
+	}
+	if err.Error() != "画像ファイルを読み込めません" {
+		t.Errorf("expected error message to be '画像ファイルを読み込めません', got '%s'", err.Error())
+	}
+}
+
+func TestErrUnsupportedImageType(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrUnsupportedImageType
+	// Assert
+	if err == nil {
+		t.Error("expected ErrUnsupportedImageType to be not nil")
+	}
+	if err.Error() != "対応していないファイルの形式です（JPEG・PNGの画像のみアップロードできます）" {
+		t.Errorf("expected error message to be '対応していないファイルの形式です（JPEG・PNGの画像のみアップロードできます）', got '%s'", err.Error())
+	}
+}
+
+func TestErrImageTooLarge(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrImageTooLarge
+	// Assert
+	if err == nil {
+		t.Error("expected ErrImageTooLarge to be not nil")
+	}
+	if err.Error() != "画像のファイルサイズまたは画素数が上限を超えています" {
+		t.Errorf("expected error message to be '画像のファイルサイズまたは画素数が上限を超えています', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidImagePurpose(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidImagePurpose
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidImagePurpose to be not nil")
+	}
+	if err.Error() != "画像の用途が不正です" {
+		t.Errorf("expected error message to be '画像の用途が不正です', got '%s'", err.Error())
+	}
+}
+
+func TestErrPrivateAccount(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrPrivateAccount
+	// Assert
+	if err == nil {
+		t.Error("expected ErrPrivateAccount to be not nil")
+	}
+	if err.Error() != "このアカウントは非公開です" {
+		t.Errorf("expected error message to be 'このアカウントは非公開です', got '%s'", err.Error())
+	}
+}
+
+func TestErrFollowRequestNotFound(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrFollowRequestNotFound
+	// Assert
+	if err == nil {
+		t.Error("expected ErrFollowRequestNotFound to be not nil")
+	}
+	if err.Error() != "フォローリクエストが見つかりません" {
+		t.Errorf("expected error message to be 'フォローリクエストが見つかりません', got '%s'", err.Error())
+	}
+}
+
+func TestErrInvalidPrivacySettings(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	err = ErrInvalidPrivacySettings
+	// Assert
+	if err == nil {
+		t.Error("expected ErrInvalidPrivacySettings to be not nil")
+	}
+	if err.Error() != "プライバシー設定が不正です" {
+		t.Errorf("expected error message to be 'プライバシー設定が不正です', got '%s'", err.Error())
+	}
+}
+
 func TestIsUserDomainError(t *testing.T) {
 	// Arrange
 	var user_errors []error
@@ -116,6 +896,60 @@ func TestIsUserDomainError(t *testing.T) {
 		ErrFirebaseAuthFailed,
 		ErrDatabaseError,
 		ErrJWTGenerationFailed,
+		ErrInvalidIDToken,
+		ErrUserDeleted,
+		ErrInvalidRefreshToken,
+		ErrRefreshTokenReused,
+		ErrInvalidAccessToken,
+		ErrTokenRevoked,
+		ErrAuthenticationRequired,
+		ErrForbidden,
+		ErrEmailNotVerified,
+		ErrTooManyPasswordResetRequests,
+		ErrMailSendFailed,
+		ErrEmailAlreadyVerified,
+		ErrTooManyVerificationEmailRequests,
+		ErrUnsupportedProvider,
+		ErrInvalidProviderToken,
+		ErrProviderAlreadyLinked,
+		ErrProviderNotLinked,
+		ErrCannotUnlinkLastProvider,
+		ErrProviderEmailRequired,
+		ErrMfaAlreadyEnabled,
+		ErrMfaNotEnabled,
+		ErrMfaEnrollmentNotFound,
+		ErrInvalidMfaCode,
+		ErrInvalidMfaChallenge,
+		ErrMfaRequired,
+		ErrSessionNotFound,
+		ErrTooManyAttempts,
+		ErrInvalidIdempotencyKey,
+		ErrIdempotencyKeyReused,
+		ErrIdempotentRequestInProgress,
+		ErrInvalidProfile,
+		ErrProfileNotFound,
+		ErrProfileAlreadyExists,
+		ErrInvalidHandle,
+		ErrHandleUnavailable,
+		ErrHandleChangeCooldown,
+		ErrCannotFollowSelf,
+		ErrFollowTargetUnavailable,
+		ErrInvalidPagination,
+		ErrCannotBlockSelf,
+		ErrCannotMuteSelf,
+		ErrInvalidAccountDeletionToken,
+		ErrDataExportNotFound,
+		ErrStorageError,
+		ErrSameEmail,
+		ErrTooManyEmailChangeRequests,
+		ErrInvalidEmailChangeToken,
+		ErrInvalidImage,
+		ErrUnsupportedImageType,
+		ErrImageTooLarge,
+		ErrInvalidImagePurpose,
+		ErrPrivateAccount,
+		ErrFollowRequestNotFound,
+		ErrInvalidPrivacySettings,
 	}
 	other_error = errors.New("some other error")
 	// Act & Assert
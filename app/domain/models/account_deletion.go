@@ -0,0 +1,32 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AccountDeletionGracePeriod は退会してから物理削除するまでの、削除を取り消せる期間です
+const AccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// GenerateAccountDeletionToken はアカウント削除の取り消しコードを発行します
+// 平文はメールでユーザーに送信するためにのみ使用し、DBにはHashAccountDeletionTokenのハッシュを保存します
+func GenerateAccountDeletionToken() (string, error) {
+	var token string
+	var err error
+
+	token, err = generate_secret_token()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate account deletion token: %w", err)
+	}
+	return token, nil
+}
+
+// HashAccountDeletionToken はアカウント削除の取り消しコードを保存用のハッシュ（SHA-256）に変換します
+func HashAccountDeletionToken(token string) string {
+	return hash_secret_token(token)
+}
+
+// AccountPurgeAt は退会した日時から、物理削除される（削除を取り消せなくなる）日時を返します
+func AccountPurgeAt(deleted_at time.Time) time.Time {
+	return deleted_at.Add(AccountDeletionGracePeriod)
+}
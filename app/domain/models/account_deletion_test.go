@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateAccountDeletionToken_Success(t *testing.T) {
+	// Arrange
+	var first string
+	var second string
+	var err error
+
+	// Act
+	first, err = GenerateAccountDeletionToken()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, _ = GenerateAccountDeletionToken()
+	// Assert
+	if len(first) != 43 {
+		t.Errorf("expected 43 characters, got %d", len(first))
+	}
+	if first == second {
+		t.Error("expected different tokens")
+	}
+}
+
+func TestHashAccountDeletionToken_TrimSpace(t *testing.T) {
+	// Arrange & Act
+	var hash string
+
+	hash = HashAccountDeletionToken("token")
+	// Assert
+	if HashAccountDeletionToken(" token\n") != hash {
+		t.Error("expected surrounding spaces to be ignored")
+	}
+	if HashAccountDeletionToken("other") == hash {
+		t.Error("expected different hashes for different tokens")
+	}
+}
+
+func TestAccountPurgeAt(t *testing.T) {
+	// Arrange
+	var deleted_at time.Time
+
+	deleted_at = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	// Act & Assert
+	if !AccountPurgeAt(deleted_at).Equal(time.Date(2026, 11, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected purge 30 days later, got %v", AccountPurgeAt(deleted_at))
+	}
+}
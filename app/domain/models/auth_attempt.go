@@ -0,0 +1,208 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AttemptKeyKind は認証の失敗回数を数える単位を表します
+type AttemptKeyKind string
+
+// AuthAction は試行回数を制限する認証の操作を表します
+type AuthAction string
+
+const (
+	// AttemptKeyKindEmail はメールアドレスごとに失敗回数を数えます（特定のアカウントへの総当たり対策）
+	AttemptKeyKindEmail AttemptKeyKind = "email"
+	// AttemptKeyKindIP はIPアドレスごとに失敗回数を数えます（リスト型攻撃対策）
+	AttemptKeyKindIP AttemptKeyKind = "ip"
+	// AttemptKeyKindDevice は端末ID（X-Device-Idヘッダー）ごとに失敗回数を数えます
+	AttemptKeyKindDevice AttemptKeyKind = "device"
+
+	// AuthActionRegister はユーザー登録です
+	AuthActionRegister AuthAction = "register"
+	// AuthActionLogin はFirebase IDトークンによるログインです
+	AuthActionLogin AuthAction = "login"
+	// AuthActionSignInWithProvider は外部プロバイダのIDトークンによるログインです
+	AuthActionSignInWithProvider AuthAction = "sign_in_with_provider"
+	// AuthActionVerifyMfa はログイン時の二要素認証です
+	AuthActionVerifyMfa AuthAction = "verify_mfa"
+)
+
+// AttemptKey は失敗回数を数えるキー（単位と値）を表す値オブジェクトです
+type AttemptKey struct {
+	kind  AttemptKeyKind
+	value string
+}
+
+// NewAttemptKey は新しいAttemptKeyを作成します
+// メールアドレスは大文字・小文字や前後の空白の違いで別のキーにならないよう正規化します
+func NewAttemptKey(kind AttemptKeyKind, value string) (AttemptKey, error) {
+	value = strings.TrimSpace(value)
+	if kind == AttemptKeyKindEmail {
+		value = strings.ToLower(value)
+	}
+	if kind != AttemptKeyKindEmail && kind != AttemptKeyKindIP && kind != AttemptKeyKindDevice {
+		return AttemptKey{}, fmt.Errorf("invalid attempt key kind: %s", kind)
+	}
+	if value == "" {
+		return AttemptKey{}, fmt.Errorf("value cannot be empty")
+	}
+	return AttemptKey{
+		kind:  kind,
+		value: value,
+	}, nil
+}
+
+// Kind はキーの単位を返します
+func (k AttemptKey) Kind() AttemptKeyKind {
+	return k.kind
+}
+
+// Value はキーの値を返します
+func (k AttemptKey) Value() string {
+	return k.value
+}
+
+// String は保存用のキー（"単位:値"）を返します
+func (k AttemptKey) String() string {
+	return string(k.kind) + ":" + k.value
+}
+
+// MaskedValue はログ出力用に個人情報を伏せた値を返します（メールアドレスはローカル部の先頭1文字のみ残します）
+func (k AttemptKey) MaskedValue() string {
+	var at_index int
+
+	if k.kind != AttemptKeyKindEmail {
+		return k.value
+	}
+	at_index = strings.LastIndex(k.value, "@")
+	if at_index <= 0 {
+		return "***"
+	}
+	return k.value[:1] + "***" + k.value[at_index:]
+}
+
+// AttemptRecord はキーごとの認証の失敗回数の記録です
+// expires_atを過ぎた記録は失敗回数0として扱い、削除可能です
+type AttemptRecord struct {
+	key            AttemptKey
+	failure_count  int
+	last_failed_at time.Time
+	expires_at     time.Time
+}
+
+// NewAttemptRecord は新しいAttemptRecordを作成します
+func NewAttemptRecord(key AttemptKey, failure_count int, last_failed_at time.Time, expires_at time.Time) *AttemptRecord {
+	return &AttemptRecord{
+		key:            key,
+		failure_count:  failure_count,
+		last_failed_at: last_failed_at,
+		expires_at:     expires_at,
+	}
+}
+
+// Key は記録のキーを返します
+func (r *AttemptRecord) Key() AttemptKey {
+	return r.key
+}
+
+// FailureCount は連続した失敗回数を返します
+func (r *AttemptRecord) FailureCount() int {
+	return r.failure_count
+}
+
+// LastFailedAt は最後に失敗した日時を返します
+func (r *AttemptRecord) LastFailedAt() time.Time {
+	return r.last_failed_at
+}
+
+// ExpiresAt は記録の有効期限を返します
+func (r *AttemptRecord) ExpiresAt() time.Time {
+	return r.expires_at
+}
+
+// IsExpired は記録が有効期限切れかどうかを返します
+func (r *AttemptRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.expires_at)
+}
+
+// AttemptPolicy は失敗回数に応じた待ち時間（段階的な遅延とロックアウト）の方針です
+//   - FreeFailures 回までの失敗は待ち時間なし
+//   - それ以降は BaseDelay から失敗するたびに2倍（MaxDelay まで）の待ち時間
+//   - LockoutThreshold 回に達するとロックアウトし、さらに失敗するたびにロックアウト期間を2倍（MaxLockoutDuration まで）
+//   - 最後の失敗から ResetAfter が経過すると失敗回数をリセット
+type AttemptPolicy struct {
+	FreeFailures       int
+	BaseDelay          time.Duration
+	MaxDelay           time.Duration
+	LockoutThreshold   int
+	LockoutDuration    time.Duration
+	MaxLockoutDuration time.Duration
+	ResetAfter         time.Duration
+}
+
+// DefaultAttemptPolicy はキーの単位ごとの既定の方針を返します
+// IPアドレスは複数のユーザーで共有される（NAT・社内ネットワーク）ため、メールアドレス・端末より緩くします
+func DefaultAttemptPolicy(kind AttemptKeyKind) AttemptPolicy {
+	var policy AttemptPolicy
+
+	policy = AttemptPolicy{
+		FreeFailures:       3,
+		BaseDelay:          time.Second,
+		MaxDelay:           time.Minute,
+		LockoutThreshold:   10,
+		LockoutDuration:    15 * time.Minute,
+		MaxLockoutDuration: 24 * time.Hour,
+		ResetAfter:         24 * time.Hour,
+	}
+	switch kind {
+	case AttemptKeyKindDevice:
+		policy.FreeFailures = 5
+		policy.LockoutThreshold = 20
+	case AttemptKeyKindIP:
+		policy.FreeFailures = 20
+		policy.LockoutThreshold = 100
+	}
+	return policy
+}
+
+// IsLockedOut は失敗回数がロックアウトの閾値に達しているかを返します
+func (p AttemptPolicy) IsLockedOut(record *AttemptRecord) bool {
+	return record != nil && record.failure_count >= p.LockoutThreshold
+}
+
+// RetryAfter は次の試行を受け付けるまでの待ち時間を返します（0の場合は試行可能）
+func (p AttemptPolicy) RetryAfter(record *AttemptRecord, now time.Time) time.Duration {
+	var wait time.Duration
+	var retry_after time.Duration
+
+	if record == nil || record.IsExpired(now) || record.failure_count <= p.FreeFailures {
+		return 0
+	}
+	if p.IsLockedOut(record) {
+		wait = double_duration(p.LockoutDuration, record.failure_count-p.LockoutThreshold, p.MaxLockoutDuration)
+	} else {
+		wait = double_duration(p.BaseDelay, record.failure_count-p.FreeFailures-1, p.MaxDelay)
+	}
+	retry_after = record.last_failed_at.Add(wait).Sub(now)
+	if retry_after < 0 {
+		return 0
+	}
+	return retry_after
+}
+
+// double_duration はbaseをtimes回2倍にした期間を返します（maxを上限とします）
+func double_duration(base time.Duration, times int, max time.Duration) time.Duration {
+	var duration time.Duration
+
+	duration = base
+	for i := 0; i < times; i++ {
+		duration *= 2
+		if duration >= max {
+			return max
+		}
+	}
+	return min(duration, max)
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewAttemptKey_NormalizesEmail(t *testing.T) {
+	// Arrange
+	var key AttemptKey
+	var err error
+
+	// Act
+	key, err = NewAttemptKey(AttemptKeyKindEmail, "  Test@Example.com ")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if key.String() != "email:test@example.com" {
+		t.Errorf("expected key email:test@example.com, got %s", key.String())
+	}
+	if key.MaskedValue() != "t***@example.com" {
+		t.Errorf("expected masked value t***@example.com, got %s", key.MaskedValue())
+	}
+}
+
+func TestNewAttemptKey_InvalidArguments(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewAttemptKey(AttemptKeyKind("user"), "value")
+	// Assert
+	if err == nil {
+		t.Error("expected error for invalid kind, got nil")
+	}
+	_, err = NewAttemptKey(AttemptKeyKindIP, " ")
+	if err == nil {
+		t.Error("expected error for empty value, got nil")
+	}
+}
+
+func TestAttemptPolicy_RetryAfter_ExponentialDelay(t *testing.T) {
+	// Arrange
+	var policy AttemptPolicy
+	var key AttemptKey
+	var now time.Time
+	var test_cases []struct {
+		failure_count int
+		expected      time.Duration
+	}
+
+	policy = DefaultAttemptPolicy(AttemptKeyKindEmail)
+	key, _ = NewAttemptKey(AttemptKeyKindEmail, "test@example.com")
+	now = time.Now()
+	test_cases = []struct {
+		failure_count int
+		expected      time.Duration
+	}{
+		{failure_count: 0, expected: 0},
+		{failure_count: policy.FreeFailures, expected: 0},
+		{failure_count: policy.FreeFailures + 1, expected: time.Second},
+		{failure_count: policy.FreeFailures + 2, expected: 2 * time.Second},
+		{failure_count: policy.FreeFailures + 3, expected: 4 * time.Second},
+		{failure_count: policy.LockoutThreshold, expected: policy.LockoutDuration},
+		{failure_count: policy.LockoutThreshold + 1, expected: 2 * policy.LockoutDuration},
+		{failure_count: policy.LockoutThreshold + 20, expected: policy.MaxLockoutDuration},
+	}
+	// Act & Assert
+	for _, test_case := range test_cases {
+		var record *AttemptRecord
+		var retry_after time.Duration
+
+		record = NewAttemptRecord(key, test_case.failure_count, now, now.Add(policy.ResetAfter))
+		retry_after = policy.RetryAfter(record, now)
+		if retry_after != test_case.expected {
+			t.Errorf("expected retry_after %v for %d failures, got %v", test_case.expected, test_case.failure_count, retry_after)
+		}
+	}
+}
+
+func TestAttemptPolicy_RetryAfter_ElapsedOrExpired(t *testing.T) {
+	// Arrange
+	var policy AttemptPolicy
+	var key AttemptKey
+	var now time.Time
+	var record *AttemptRecord
+
+	policy = DefaultAttemptPolicy(AttemptKeyKindEmail)
+	key, _ = NewAttemptKey(AttemptKeyKindEmail, "test@example.com")
+	now = time.Now()
+	// Act & Assert
+	record = NewAttemptRecord(key, policy.LockoutThreshold, now.Add(-policy.LockoutDuration), now.Add(time.Hour))
+	if policy.RetryAfter(record, now) != 0 {
+		t.Error("expected retry_after to be 0 after lockout duration elapsed")
+	}
+	if !policy.IsLockedOut(record) {
+		t.Error("expected record to be locked out")
+	}
+	record = NewAttemptRecord(key, policy.LockoutThreshold+5, now, now)
+	if policy.RetryAfter(record, now) != 0 {
+		t.Error("expected retry_after to be 0 for expired record")
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import "fmt"
+
+// AuthProvider はソーシャルログインの外部プロバイダを表します
+type AuthProvider string
+
+const (
+	// AuthProviderGoogle はGoogleアカウントです
+	AuthProviderGoogle AuthProvider = "google"
+	// AuthProviderApple はApple IDです
+	AuthProviderApple AuthProvider = "apple"
+	// AuthProviderX はX（旧Twitter）アカウントです
+	AuthProviderX AuthProvider = "x"
+	// AuthProviderLINE はLINEアカウントです（Firebaseのネイティブプロバイダではないため、カスタムトークンで認証します）
+	AuthProviderLINE AuthProvider = "line"
+)
+
+// firebase_provider_ids はFirebaseがネイティブにサポートするプロバイダのプロバイダIDです
+var firebase_provider_ids = map[AuthProvider]string{
+	AuthProviderGoogle: "google.com",
+	AuthProviderApple:  "apple.com",
+	AuthProviderX:      "twitter.com",
+}
+
+// NewAuthProvider は文字列からAuthProviderを作成します
+func NewAuthProvider(value string) (AuthProvider, error) {
+	var provider AuthProvider
+
+	provider = AuthProvider(value)
+	if provider != AuthProviderLINE && !provider.IsFirebaseNative() {
+		return "", fmt.Errorf("invalid auth provider: %s", value)
+	}
+	return provider, nil
+}
+
+// IsFirebaseNative はFirebase Authenticationがネイティブにサポートするプロバイダかどうかを返します
+func (p AuthProvider) IsFirebaseNative() bool {
+	var is_native bool
+
+	_, is_native = firebase_provider_ids[p]
+	return is_native
+}
+
+// FirebaseProviderID はFirebase AuthenticationのプロバイダID（google.comなど）を返します
+// ネイティブにサポートされないプロバイダの場合は空文字を返します
+func (p AuthProvider) FirebaseProviderID() string {
+	return firebase_provider_ids[p]
+}
+
+// String はプロバイダの文字列表現を返します
+func (p AuthProvider) String() string {
+	return string(p)
+}
@@ -0,0 +1,60 @@
+package models
+
+import "testing"
+
+func TestNewAuthProvider_Success(t *testing.T) {
+	// Arrange
+	var provider AuthProvider
+	var err error
+
+	// Act
+	provider, err = NewAuthProvider("line")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if provider != AuthProviderLINE {
+		t.Errorf("expected provider %s, got %s", AuthProviderLINE, provider)
+	}
+}
+
+func TestNewAuthProvider_Invalid(t *testing.T) {
+	// Arrange
+	var err error
+
+	// Act
+	_, err = NewAuthProvider("facebook")
+	// Assert
+	if err == nil {
+		t.Error("expected error for invalid provider, got nil")
+	}
+}
+
+func TestAuthProvider_FirebaseProviderID(t *testing.T) {
+	// Arrange
+	var test_cases []struct {
+		provider    AuthProvider
+		provider_id string
+		is_native   bool
+	}
+
+	test_cases = []struct {
+		provider    AuthProvider
+		provider_id string
+		is_native   bool
+	}{
+		{provider: AuthProviderGoogle, provider_id: "google.com", is_native: true},
+		{provider: AuthProviderApple, provider_id: "apple.com", is_native: true},
+		{provider: AuthProviderX, provider_id: "twitter.com", is_native: true},
+		{provider: AuthProviderLINE, provider_id: "", is_native: false},
+	}
+	// Act & Assert
+	for _, test_case := range test_cases {
+		if test_case.provider.FirebaseProviderID() != test_case.provider_id {
+			t.Errorf("expected provider id %s, got %s", test_case.provider_id, test_case.provider.FirebaseProviderID())
+		}
+		if test_case.provider.IsFirebaseNative() != test_case.is_native {
+			t.Errorf("expected is_native %v for %s", test_case.is_native, test_case.provider)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CompensationKind は補償処理の種類を表します
+type CompensationKind string
+
+// CompensationStatus は補償処理の状態を表します
+type CompensationStatus string
+
+const (
+	// CompensationKindDeleteFirebaseUser はDBへの登録に失敗したFirebaseユーザーを削除する補償処理です
+	CompensationKindDeleteFirebaseUser CompensationKind = "delete_firebase_user"
+	// CompensationKindEnableFirebaseUser は退会の失敗時に無効化したままになったFirebaseユーザーを有効化し直す補償処理です
+	CompensationKindEnableFirebaseUser CompensationKind = "enable_firebase_user"
+	// CompensationKindSyncFirebaseEmail はメールアドレスの変更の失敗時に変更後のままになったFirebaseのメールアドレスをusersテーブルに合わせて戻す補償処理です
+	CompensationKindSyncFirebaseEmail CompensationKind = "sync_firebase_email"
+
+	// CompensationStatusPending は再試行待ちの状態です
+	CompensationStatusPending CompensationStatus = "pending"
+	// CompensationStatusExhausted は再試行回数の上限に達し、手動での対応が必要な状態です
+	CompensationStatusExhausted CompensationStatus = "exhausted"
+
+	// MaxCompensationAttempts は補償処理の最大試行回数です
+	MaxCompensationAttempts = 10
+	// compensationBaseBackoff は再試行間隔の初期値です（失敗するたびに2倍にします）
+	compensationBaseBackoff = time.Minute
+	// compensationMaxBackoff は再試行間隔の上限です
+	compensationMaxBackoff = 6 * time.Hour
+)
+
+// CompensationTask は失敗した補償処理を記録し、後から再試行するためのエンティティです
+type CompensationTask struct {
+	task_id         uuid.UUID
+	kind            CompensationKind
+	target          string
+	status          CompensationStatus
+	attempts        int
+	last_error      string
+	next_attempt_at time.Time
+	created_at      time.Time
+}
+
+// NewCompensationTask は新しいCompensationTaskエンティティを作成します
+// 最初の補償処理が失敗した時点で作成するため、試行回数1回として次回の試行時刻を設定します
+func NewCompensationTask(kind CompensationKind, target string, last_error string, now time.Time) (*CompensationTask, error) {
+	return NewCompensationTaskWithState(
+		uuid.New(), kind, target, CompensationStatusPending, 1, last_error, now.Add(compensation_backoff(1)), now,
+	)
+}
+
+// NewCompensationTaskWithState は状態を持つCompensationTaskエンティティを作成します（DBからの復元用）
+func NewCompensationTaskWithState(
+	task_id uuid.UUID,
+	kind CompensationKind,
+	target string,
+	status CompensationStatus,
+	attempts int,
+	last_error string,
+	next_attempt_at time.Time,
+	created_at time.Time,
+) (*CompensationTask, error) {
+	if task_id == uuid.Nil {
+		return nil, fmt.Errorf("task_id cannot be empty")
+	}
+	if kind != CompensationKindDeleteFirebaseUser && kind != CompensationKindEnableFirebaseUser && kind != CompensationKindSyncFirebaseEmail {
+		return nil, fmt.Errorf("invalid compensation kind: %s", kind)
+	}
+	if target == "" {
+		return nil, fmt.Errorf("target cannot be empty")
+	}
+	if status != CompensationStatusPending && status != CompensationStatusExhausted {
+		return nil, fmt.Errorf("invalid compensation status: %s", status)
+	}
+	return &CompensationTask{
+		task_id:         task_id,
+		kind:            kind,
+		target:          target,
+		status:          status,
+		attempts:        attempts,
+		last_error:      last_error,
+		next_attempt_at: next_attempt_at,
+		created_at:      created_at,
+	}, nil
+}
+
+// compensation_backoff は試行回数に応じた次回の試行までの待ち時間を返します
+func compensation_backoff(attempts int) time.Duration {
+	var backoff time.Duration
+
+	backoff = compensationBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= compensationMaxBackoff {
+			return compensationMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// RecordFailure は再試行の失敗を記録します
+// 最大試行回数に達した場合は再試行を打ち切り、exhaustedにします
+func (c *CompensationTask) RecordFailure(last_error string, now time.Time) {
+	c.attempts++
+	c.last_error = last_error
+	if c.attempts >= MaxCompensationAttempts {
+		c.status = CompensationStatusExhausted
+		return
+	}
+	c.next_attempt_at = now.Add(compensation_backoff(c.attempts))
+}
+
+// IsExhausted は再試行回数の上限に達しているかを返します
+func (c *CompensationTask) IsExhausted() bool {
+	return c.status == CompensationStatusExhausted
+}
+
+// TaskID は補償処理のIDを返します
+func (c *CompensationTask) TaskID() uuid.UUID {
+	return c.task_id
+}
+
+// Kind は補償処理の種類を返します
+func (c *CompensationTask) Kind() CompensationKind {
+	return c.kind
+}
+
+// Target は補償処理の対象（Firebase UIDなど）を返します
+func (c *CompensationTask) Target() string {
+	return c.target
+}
+
+// Status は補償処理の状態を返します
+func (c *CompensationTask) Status() CompensationStatus {
+	return c.status
+}
+
+// Attempts はこれまでの試行回数を返します
+func (c *CompensationTask) Attempts() int {
+	return c.attempts
+}
+
+// LastError は最後に失敗した際のエラーメッセージを返します
+func (c *CompensationTask) LastError() string {
+	return c.last_error
+}
+
+// NextAttemptAt は次回の試行時刻を返します
+func (c *CompensationTask) NextAttemptAt() time.Time {
+	return c.next_attempt_at
+}
+
+// CreatedAt は作成日時を返します
+func (c *CompensationTask) CreatedAt() time.Time {
+	return c.created_at
+}
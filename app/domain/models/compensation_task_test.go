@@ -0,0 +1,114 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewCompensationTask_Success(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var task *CompensationTask
+	var err error
+
+	now = time.Now()
+	// Act
+	task, err = NewCompensationTask(CompensationKindDeleteFirebaseUser, "firebase_uid_123", "delete failed", now)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if task.TaskID() == uuid.Nil {
+		t.Error("expected task_id to be generated")
+	}
+	if task.Status() != CompensationStatusPending {
+		t.Errorf("expected status %s, got %s", CompensationStatusPending, task.Status())
+	}
+	if task.Attempts() != 1 {
+		t.Errorf("expected attempts 1, got %d", task.Attempts())
+	}
+	if !task.NextAttemptAt().Equal(now.Add(compensationBaseBackoff)) {
+		t.Errorf("expected next_attempt_at %v, got %v", now.Add(compensationBaseBackoff), task.NextAttemptAt())
+	}
+}
+
+func TestNewCompensationTask_InvalidKind(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewCompensationTask(CompensationKind("unknown"), "firebase_uid_123", "", time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for invalid kind")
+	}
+}
+
+func TestNewCompensationTask_EmptyTarget(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewCompensationTask(CompensationKindDeleteFirebaseUser, "", "", time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty target")
+	}
+}
+
+func TestCompensationTask_RecordFailure_Backoff(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var task *CompensationTask
+	var err error
+
+	now = time.Now()
+	task, err = NewCompensationTask(CompensationKindDeleteFirebaseUser, "firebase_uid_123", "delete failed", now)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	// Act
+	task.RecordFailure("still failing", now)
+	// Assert
+	if task.Attempts() != 2 {
+		t.Errorf("expected attempts 2, got %d", task.Attempts())
+	}
+	if task.LastError() != "still failing" {
+		t.Errorf("expected last_error to be updated, got %s", task.LastError())
+	}
+	if !task.NextAttemptAt().Equal(now.Add(2 * compensationBaseBackoff)) {
+		t.Errorf("expected next_attempt_at %v, got %v", now.Add(2*compensationBaseBackoff), task.NextAttemptAt())
+	}
+}
+
+func TestCompensationTask_RecordFailure_Exhausted(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var task *CompensationTask
+	var err error
+
+	now = time.Now()
+	task, err = NewCompensationTask(CompensationKindDeleteFirebaseUser, "firebase_uid_123", "delete failed", now)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	// Act
+	for i := 1; i < MaxCompensationAttempts; i++ {
+		task.RecordFailure("still failing", now)
+	}
+	// Assert
+	if !task.IsExhausted() {
+		t.Errorf("expected task to be exhausted after %d attempts", task.Attempts())
+	}
+}
+
+func TestCompensationBackoff_Capped(t *testing.T) {
+	// Arrange & Act
+	var backoff time.Duration
+
+	backoff = compensation_backoff(MaxCompensationAttempts * 10)
+	// Assert
+	if backoff != compensationMaxBackoff {
+		t.Errorf("expected backoff to be capped at %v, got %v", compensationMaxBackoff, backoff)
+	}
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus は個人データのエクスポートの状態を表します
+type DataExportStatus string
+
+const (
+	// DataExportStatusPending は定期実行ジョブによるZIPファイルの作成待ちの状態です
+	DataExportStatusPending DataExportStatus = "pending"
+	// DataExportStatusReady はZIPファイルを作成し、ダウンロードできる状態です
+	DataExportStatusReady DataExportStatus = "ready"
+	// DataExportStatusFailed はZIPファイルの作成に失敗した状態です（再度エクスポートを要求できます）
+	DataExportStatusFailed DataExportStatus = "failed"
+
+	// DataExportDownloadPeriod はZIPファイルを作成してからダウンロードできる期間です
+	// 期限を過ぎたZIPファイルは定期実行ジョブで削除します
+	DataExportDownloadPeriod = 7 * 24 * time.Hour
+)
+
+// DataExport はユーザーが要求した個人データのエクスポート（データポータビリティ）を表すエンティティです
+type DataExport struct {
+	export_id    uuid.UUID
+	user_id      uuid.UUID
+	status       DataExportStatus
+	storage_key  string
+	last_error   string
+	completed_at *time.Time
+	expires_at   *time.Time
+	created_at   time.Time
+}
+
+// NewDataExport は作成待ちのDataExportエンティティを作成します
+func NewDataExport(user_id uuid.UUID, now time.Time) (*DataExport, error) {
+	return NewDataExportWithState(uuid.New(), user_id, DataExportStatusPending, "", "", nil, nil, now)
+}
+
+// NewDataExportWithState は状態を持つDataExportエンティティを作成します（DBからの復元用）
+func NewDataExportWithState(
+	export_id uuid.UUID,
+	user_id uuid.UUID,
+	status DataExportStatus,
+	storage_key string,
+	last_error string,
+	completed_at *time.Time,
+	expires_at *time.Time,
+	created_at time.Time,
+) (*DataExport, error) {
+	if export_id == uuid.Nil {
+		return nil, fmt.Errorf("export_id cannot be empty")
+	}
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	if status != DataExportStatusPending && status != DataExportStatusReady && status != DataExportStatusFailed {
+		return nil, fmt.Errorf("invalid data export status: %s", status)
+	}
+	if status == DataExportStatusReady && storage_key == "" {
+		return nil, fmt.Errorf("storage_key cannot be empty for ready data export")
+	}
+	return &DataExport{
+		export_id:    export_id,
+		user_id:      user_id,
+		status:       status,
+		storage_key:  storage_key,
+		last_error:   last_error,
+		completed_at: completed_at,
+		expires_at:   expires_at,
+		created_at:   created_at,
+	}, nil
+}
+
+// ExportID はエクスポートIDを返します
+func (e *DataExport) ExportID() uuid.UUID {
+	return e.export_id
+}
+
+// UserID はエクスポートを要求したユーザーの公開IDを返します
+func (e *DataExport) UserID() uuid.UUID {
+	return e.user_id
+}
+
+// Status はエクスポートの状態を返します
+func (e *DataExport) Status() DataExportStatus {
+	return e.status
+}
+
+// StorageKey はストレージ上のZIPファイルのキーを返します（作成前は空文字）
+func (e *DataExport) StorageKey() string {
+	return e.storage_key
+}
+
+// LastError は作成に失敗した際のエラーメッセージを返します
+func (e *DataExport) LastError() string {
+	return e.last_error
+}
+
+// CompletedAt は作成が完了（または失敗）した日時を返します
+func (e *DataExport) CompletedAt() *time.Time {
+	return e.completed_at
+}
+
+// ExpiresAt はダウンロードの有効期限を返します（作成前はnil）
+func (e *DataExport) ExpiresAt() *time.Time {
+	return e.expires_at
+}
+
+// CreatedAt はエクスポートを要求した日時を返します
+func (e *DataExport) CreatedAt() time.Time {
+	return e.created_at
+}
+
+// IsPending は作成待ちかを返します
+func (e *DataExport) IsPending() bool {
+	return e.status == DataExportStatusPending
+}
+
+// IsDownloadable は指定した日時にダウンロードできるかを返します
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.status == DataExportStatusReady && e.expires_at != nil && now.Before(*e.expires_at)
+}
+
+// MarkReady はZIPファイルの作成完了を記録し、ダウンロードの有効期限を設定します
+func (e *DataExport) MarkReady(storage_key string, now time.Time) error {
+	var expires_at time.Time
+
+	if storage_key == "" {
+		return fmt.Errorf("storage_key cannot be empty")
+	}
+	expires_at = now.Add(DataExportDownloadPeriod)
+	e.status = DataExportStatusReady
+	e.storage_key = storage_key
+	e.completed_at = &now
+	e.expires_at = &expires_at
+	return nil
+}
+
+// MarkFailed はZIPファイルの作成の失敗を記録します
+// 失敗した記録もダウンロードの有効期限と同じ期間を過ぎると削除します
+func (e *DataExport) MarkFailed(last_error string, now time.Time) {
+	var expires_at time.Time
+
+	expires_at = now.Add(DataExportDownloadPeriod)
+	e.status = DataExportStatusFailed
+	e.last_error = last_error
+	e.completed_at = &now
+	e.expires_at = &expires_at
+}
+
+// DataExportStorageKey はエクスポートIDからストレージ上のZIPファイルのキーを返します
+func DataExportStorageKey(export_id uuid.UUID) string {
+	return "data-exports/" + export_id.String() + ".zip"
+}
+
+// GenerateDataExportDownloadToken はダウンロードリンクのトークンを発行します
+// 平文はメールでユーザーに送信するためにのみ使用し、DBにはHashDataExportDownloadTokenのハッシュを保存します
+func GenerateDataExportDownloadToken() (string, error) {
+	var token string
+	var err error
+
+	token, err = generate_secret_token()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate data export download token: %w", err)
+	}
+	return token, nil
+}
+
+// HashDataExportDownloadToken はダウンロードリンクのトークンを保存用のハッシュ（SHA-256）に変換します
+func HashDataExportDownloadToken(token string) string {
+	return hash_secret_token(token)
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewDataExport_Pending(t *testing.T) {
+	// Arrange & Act
+	var data_export *DataExport
+	var err error
+
+	data_export, err = NewDataExport(uuid.New(), time.Now())
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !data_export.IsPending() || data_export.ExpiresAt() != nil {
+		t.Errorf("expected pending export without expiry, got %s", data_export.Status())
+	}
+	if data_export.IsDownloadable(time.Now()) {
+		t.Error("expected pending export not to be downloadable")
+	}
+}
+
+func TestNewDataExportWithState_Invalid(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewDataExportWithState(uuid.New(), uuid.Nil, DataExportStatusPending, "", "", nil, nil, time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	_, err = NewDataExportWithState(uuid.New(), uuid.New(), DataExportStatusReady, "", "", nil, nil, time.Now())
+	if err == nil {
+		t.Error("expected error for ready export without storage_key")
+	}
+}
+
+func TestDataExport_MarkReady(t *testing.T) {
+	// Arrange
+	var data_export *DataExport
+	var now time.Time
+	var err error
+
+	now = time.Now()
+	data_export, _ = NewDataExport(uuid.New(), now)
+	// Act
+	err = data_export.MarkReady(DataExportStorageKey(data_export.ExportID()), now)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !data_export.IsDownloadable(now.Add(DataExportDownloadPeriod - time.Minute)) {
+		t.Error("expected export to be downloadable within download period")
+	}
+	if data_export.IsDownloadable(now.Add(DataExportDownloadPeriod)) {
+		t.Error("expected export not to be downloadable after download period")
+	}
+	if !strings.HasSuffix(data_export.StorageKey(), data_export.ExportID().String()+".zip") {
+		t.Errorf("unexpected storage key: %s", data_export.StorageKey())
+	}
+}
+
+func TestDataExport_MarkFailed(t *testing.T) {
+	// Arrange
+	var data_export *DataExport
+	var now time.Time
+
+	now = time.Now()
+	data_export, _ = NewDataExport(uuid.New(), now)
+	// Act
+	data_export.MarkFailed("storage unavailable", now)
+	// Assert
+	if data_export.Status() != DataExportStatusFailed || data_export.LastError() != "storage unavailable" {
+		t.Errorf("expected failed export, got %s", data_export.Status())
+	}
+	if data_export.IsDownloadable(now) || data_export.ExpiresAt() == nil {
+		t.Error("expected failed export to be not downloadable but expire")
+	}
+}
+
+func TestHashDataExportDownloadToken_DiffersFromToken(t *testing.T) {
+	// Arrange
+	var token string
+	var err error
+
+	token, err = GenerateDataExportDownloadToken()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Act & Assert
+	if HashDataExportDownloadToken(token) == token || len(HashDataExportDownloadToken(token)) != 64 {
+		t.Error("expected SHA-256 hex hash of token")
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DenylistKind はdenylistに登録する値の種類を表します
+type DenylistKind string
+
+const (
+	// DenylistKindToken は単一のトークン（JWTのjti）を失効させます
+	DenylistKindToken DenylistKind = "token"
+	// DenylistKindSession はセッション（JWTのsid）に属する全てのトークンを失効させます
+	DenylistKindSession DenylistKind = "session"
+)
+
+// DenylistedToken はログアウトにより失効したトークンまたはセッションを表すエンティティです
+// expires_atを過ぎた登録は、対象のトークンが全て期限切れになっているため削除可能です
+type DenylistedToken struct {
+	kind       DenylistKind
+	value      string
+	expires_at time.Time
+}
+
+// NewDenylistedToken は新しいDenylistedTokenエンティティを作成します
+func NewDenylistedToken(kind DenylistKind, value string, expires_at time.Time) (*DenylistedToken, error) {
+	if kind != DenylistKindToken && kind != DenylistKindSession {
+		return nil, fmt.Errorf("invalid denylist kind: %s", kind)
+	}
+	if value == "" {
+		return nil, fmt.Errorf("value cannot be empty")
+	}
+	return &DenylistedToken{
+		kind:       kind,
+		value:      value,
+		expires_at: expires_at,
+	}, nil
+}
+
+// Kind は登録の種類を返します
+func (d *DenylistedToken) Kind() DenylistKind {
+	return d.kind
+}
+
+// Value はjtiまたはsidを返します
+func (d *DenylistedToken) Value() string {
+	return d.value
+}
+
+// ExpiresAt は登録の有効期限を返します
+func (d *DenylistedToken) ExpiresAt() time.Time {
+	return d.expires_at
+}
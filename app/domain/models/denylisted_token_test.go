@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDenylistedToken_Success(t *testing.T) {
+	// Arrange
+	var expires_at time.Time
+	var entry *DenylistedToken
+	var err error
+
+	expires_at = time.Now().Add(time.Hour)
+	// Act
+	entry, err = NewDenylistedToken(DenylistKindSession, "session_123", expires_at)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.Kind() != DenylistKindSession {
+		t.Errorf("expected kind %s, got %s", DenylistKindSession, entry.Kind())
+	}
+	if entry.Value() != "session_123" {
+		t.Errorf("expected value session_123, got %s", entry.Value())
+	}
+	if !entry.ExpiresAt().Equal(expires_at) {
+		t.Errorf("expected expires_at %v, got %v", expires_at, entry.ExpiresAt())
+	}
+}
+
+func TestNewDenylistedToken_InvalidKind(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewDenylistedToken(DenylistKind("unknown"), "token_123", time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for invalid kind")
+	}
+}
+
+func TestNewDenylistedToken_EmptyValue(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewDenylistedToken(DenylistKindToken, "", time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty value")
+	}
+}
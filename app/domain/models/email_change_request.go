@@ -0,0 +1,89 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeConfirmationPeriod はメールアドレスの変更を要求してから確認リンクが有効な期間です
+const EmailChangeConfirmationPeriod = 24 * time.Hour
+
+// EmailChangeRequest は確認待ちのメールアドレスの変更を表すエンティティです
+// 変更後のメールアドレスで確認リンクを開くまで、Firebaseとusersテーブルのメールアドレスは変更しません
+type EmailChangeRequest struct {
+	user_id    uuid.UUID
+	new_email  Email
+	expires_at time.Time
+	created_at time.Time
+}
+
+// NewEmailChangeRequest は新しいEmailChangeRequestエンティティを作成します
+func NewEmailChangeRequest(user_id uuid.UUID, new_email Email, now time.Time) (*EmailChangeRequest, error) {
+	return NewEmailChangeRequestWithState(user_id, new_email, now.Add(EmailChangeConfirmationPeriod), now)
+}
+
+// NewEmailChangeRequestWithState は状態を持つEmailChangeRequestエンティティを作成します（DBからの復元用）
+func NewEmailChangeRequestWithState(
+	user_id uuid.UUID,
+	new_email Email,
+	expires_at time.Time,
+	created_at time.Time,
+) (*EmailChangeRequest, error) {
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	if new_email.Value() == "" {
+		return nil, fmt.Errorf("new_email cannot be empty")
+	}
+	return &EmailChangeRequest{
+		user_id:    user_id,
+		new_email:  new_email,
+		expires_at: expires_at,
+		created_at: created_at,
+	}, nil
+}
+
+// UserID は変更を要求したユーザーの公開IDを返します
+func (r *EmailChangeRequest) UserID() uuid.UUID {
+	return r.user_id
+}
+
+// NewEmail は変更後のメールアドレスを返します
+func (r *EmailChangeRequest) NewEmail() Email {
+	return r.new_email
+}
+
+// ExpiresAt は確認リンクの有効期限を返します
+func (r *EmailChangeRequest) ExpiresAt() time.Time {
+	return r.expires_at
+}
+
+// CreatedAt は変更を要求した日時を返します
+func (r *EmailChangeRequest) CreatedAt() time.Time {
+	return r.created_at
+}
+
+// IsExpired は指定した日時に確認リンクの有効期限を過ぎているかを返します
+func (r *EmailChangeRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.expires_at)
+}
+
+// GenerateEmailChangeToken はメールアドレスの変更の確認リンクのトークンを発行します
+// 平文は変更後のメールアドレスに送信するためにのみ使用し、DBにはHashEmailChangeTokenのハッシュを保存します
+func GenerateEmailChangeToken() (string, error) {
+	var token string
+	var err error
+
+	token, err = generate_secret_token()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate email change token: %w", err)
+	}
+	return token, nil
+}
+
+// HashEmailChangeToken はメールアドレスの変更の確認リンクのトークンを保存用のハッシュ（SHA-256）に変換します
+func HashEmailChangeToken(token string) string {
+	return hash_secret_token(token)
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewEmailChangeRequest_ExpiresAfterConfirmationPeriod(t *testing.T) {
+	// Arrange
+	var new_email Email
+	var request *EmailChangeRequest
+	var now time.Time
+	var err error
+
+	new_email, _ = NewEmail("new@example.com")
+	now = time.Now()
+	// Act
+	request, err = NewEmailChangeRequest(uuid.New(), new_email, now)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if request.IsExpired(now.Add(EmailChangeConfirmationPeriod - time.Minute)) {
+		t.Error("expected request not to be expired within confirmation period")
+	}
+	if !request.IsExpired(now.Add(EmailChangeConfirmationPeriod)) {
+		t.Error("expected request to be expired after confirmation period")
+	}
+}
+
+func TestNewEmailChangeRequestWithState_Invalid(t *testing.T) {
+	// Arrange & Act
+	var new_email Email
+	var err error
+
+	new_email, _ = NewEmail("new@example.com")
+	_, err = NewEmailChangeRequestWithState(uuid.Nil, new_email, time.Now(), time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	_, err = NewEmailChangeRequestWithState(uuid.New(), Email{}, time.Now(), time.Now())
+	if err == nil {
+		t.Error("expected error for empty new_email")
+	}
+}
+
+func TestHashEmailChangeToken_IgnoresSurroundingSpaces(t *testing.T) {
+	// Arrange
+	var token string
+
+	token, _ = GenerateEmailChangeToken()
+	// Act & Assert
+	if HashEmailChangeToken(token) != HashEmailChangeToken(" "+token+"\n") {
+		t.Error("expected same hash for token with surrounding spaces")
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// FirebaseAccount はFirebase Authenticationに登録されているアカウントを表す値オブジェクトです
+// usersテーブルとの整合性の確認に使用します
+type FirebaseAccount struct {
+	uid        string
+	email      string
+	created_at time.Time
+}
+
+// NewFirebaseAccount は新しいFirebaseAccountを作成します（emailはプロバイダによっては空です）
+func NewFirebaseAccount(uid string, email string, created_at time.Time) (*FirebaseAccount, error) {
+	if uid == "" {
+		return nil, fmt.Errorf("uid cannot be empty")
+	}
+	return &FirebaseAccount{
+		uid:        uid,
+		email:      email,
+		created_at: created_at,
+	}, nil
+}
+
+// UID はFirebase UIDを返します
+func (a *FirebaseAccount) UID() string {
+	return a.uid
+}
+
+// Email はメールアドレスを返します
+func (a *FirebaseAccount) Email() string {
+	return a.email
+}
+
+// CreatedAt はFirebase上での作成日時を返します
+func (a *FirebaseAccount) CreatedAt() time.Time {
+	return a.created_at
+}
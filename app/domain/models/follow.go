@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Follow はユーザー間のフォロー関係を表すエンティティです
+type Follow struct {
+	follower_id uuid.UUID
+	followee_id uuid.UUID
+	created_at  time.Time
+}
+
+// NewFollow は新しいFollowエンティティを作成します
+// 自分自身をフォローする場合はエラーを返します
+func NewFollow(follower_id uuid.UUID, followee_id uuid.UUID, created_at time.Time) (*Follow, error) {
+	if follower_id == uuid.Nil || followee_id == uuid.Nil {
+		return nil, fmt.Errorf("follower_id and followee_id cannot be empty")
+	}
+	if follower_id == followee_id {
+		return nil, fmt.Errorf("user cannot follow themselves: %s", follower_id)
+	}
+	return &Follow{
+		follower_id: follower_id,
+		followee_id: followee_id,
+		created_at:  created_at,
+	}, nil
+}
+
+// FollowerID はフォローしたユーザーの公開IDを返します
+func (f *Follow) FollowerID() uuid.UUID {
+	return f.follower_id
+}
+
+// FolloweeID はフォローされたユーザーの公開IDを返します
+func (f *Follow) FolloweeID() uuid.UUID {
+	return f.followee_id
+}
+
+// CreatedAt はフォローした日時を返します
+func (f *Follow) CreatedAt() time.Time {
+	return f.created_at
+}
+
+// FollowCounts はユーザーのフォロワー数・フォロー数を表す値オブジェクトです
+type FollowCounts struct {
+	followers int
+	following int
+}
+
+// NewFollowCounts は新しいFollowCountsを作成します
+func NewFollowCounts(followers int, following int) FollowCounts {
+	return FollowCounts{followers: followers, following: following}
+}
+
+// Followers はフォロワー数を返します
+func (c FollowCounts) Followers() int {
+	return c.followers
+}
+
+// Following はフォロー数を返します
+func (c FollowCounts) Following() int {
+	return c.following
+}
+
+// FollowPage はフォロー一覧の1ページを表します
+// end_cursorは次のページを取得するためのカーソルで、内容はリポジトリが決める不透明な文字列です
+type FollowPage struct {
+	follows       []*Follow
+	end_cursor    string
+	has_next_page bool
+}
+
+// NewFollowPage は新しいFollowPageを作成します
+func NewFollowPage(follows []*Follow, end_cursor string, has_next_page bool) *FollowPage {
+	return &FollowPage{
+		follows:       follows,
+		end_cursor:    end_cursor,
+		has_next_page: has_next_page,
+	}
+}
+
+// Follows はページ内のフォロー関係をフォローした日時の新しい順に返します
+func (p *FollowPage) Follows() []*Follow {
+	return p.follows
+}
+
+// EndCursor はページの最後のフォロー関係のカーソルを返します（ページが空の場合は空文字）
+func (p *FollowPage) EndCursor() string {
+	return p.end_cursor
+}
+
+// HasNextPage は次のページがあるかを返します
+func (p *FollowPage) HasNextPage() bool {
+	return p.has_next_page
+}
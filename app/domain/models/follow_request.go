@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FollowRequest は非公開アカウントへの承認待ちのフォローリクエストを表すエンティティです
+// 承認されるとフォロー関係（Follow）になり、拒否・取り消し・承認の時点で削除します
+type FollowRequest struct {
+	requester_id uuid.UUID
+	target_id    uuid.UUID
+	created_at   time.Time
+}
+
+// NewFollowRequest は新しいFollowRequestエンティティを作成します
+// 自分自身へのリクエストの場合はエラーを返します
+func NewFollowRequest(requester_id uuid.UUID, target_id uuid.UUID, created_at time.Time) (*FollowRequest, error) {
+	if requester_id == uuid.Nil || target_id == uuid.Nil {
+		return nil, fmt.Errorf("requester_id and target_id cannot be empty")
+	}
+	if requester_id == target_id {
+		return nil, fmt.Errorf("user cannot request to follow themselves: %s", requester_id)
+	}
+	return &FollowRequest{
+		requester_id: requester_id,
+		target_id:    target_id,
+		created_at:   created_at,
+	}, nil
+}
+
+// RequesterID はフォローをリクエストしたユーザーの公開IDを返します
+func (r *FollowRequest) RequesterID() uuid.UUID {
+	return r.requester_id
+}
+
+// TargetID はリクエストされた非公開アカウントのユーザーの公開IDを返します
+func (r *FollowRequest) TargetID() uuid.UUID {
+	return r.target_id
+}
+
+// CreatedAt はリクエストした日時を返します
+func (r *FollowRequest) CreatedAt() time.Time {
+	return r.created_at
+}
+
+// FollowRequestPage はフォローリクエストの一覧の1ページを表します
+type FollowRequestPage struct {
+	requests      []*FollowRequest
+	end_cursor    string
+	has_next_page bool
+}
+
+// NewFollowRequestPage は新しいFollowRequestPageを作成します
+func NewFollowRequestPage(requests []*FollowRequest, end_cursor string, has_next_page bool) *FollowRequestPage {
+	return &FollowRequestPage{
+		requests:      requests,
+		end_cursor:    end_cursor,
+		has_next_page: has_next_page,
+	}
+}
+
+// Requests はページ内のフォローリクエストをリクエストした日時の新しい順に返します
+func (p *FollowRequestPage) Requests() []*FollowRequest {
+	return p.requests
+}
+
+// EndCursor はページの最後のフォローリクエストのカーソルを返します（ページが空の場合は空文字）
+func (p *FollowRequestPage) EndCursor() string {
+	return p.end_cursor
+}
+
+// HasNextPage は次のページがあるかを返します
+func (p *FollowRequestPage) HasNextPage() bool {
+	return p.has_next_page
+}
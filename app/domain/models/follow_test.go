@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewFollow(t *testing.T) {
+	// Arrange
+	var follower_id uuid.UUID
+	var followee_id uuid.UUID
+	var follow *Follow
+	var err error
+
+	follower_id = uuid.New()
+	followee_id = uuid.New()
+	// Act
+	follow, err = NewFollow(follower_id, followee_id, time.Now())
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if follow.FollowerID() != follower_id || follow.FolloweeID() != followee_id {
+		t.Errorf("expected follow from %s to %s, got %s to %s", follower_id, followee_id, follow.FollowerID(), follow.FolloweeID())
+	}
+}
+
+func TestNewFollow_Self(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var err error
+
+	user_id = uuid.New()
+	// Act
+	_, err = NewFollow(user_id, user_id, time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for following yourself, got nil")
+	}
+}
+
+func TestNewFollowRequest(t *testing.T) {
+	// Arrange
+	var requester_id uuid.UUID
+	var target_id uuid.UUID
+	var request *FollowRequest
+	var err error
+
+	requester_id = uuid.New()
+	target_id = uuid.New()
+	// Act
+	request, err = NewFollowRequest(requester_id, target_id, time.Now())
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if request.RequesterID() != requester_id || request.TargetID() != target_id {
+		t.Errorf("expected request from %s to %s, got %s to %s", requester_id, target_id, request.RequesterID(), request.TargetID())
+	}
+	_, err = NewFollowRequest(requester_id, requester_id, time.Now())
+	if err == nil {
+		t.Error("expected error for requesting yourself, got nil")
+	}
+}
@@ -0,0 +1,182 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ハンドルの文字数の上限・下限です
+const (
+	handleMinLength = 3
+	handleMaxLength = 30
+)
+
+// ハンドルの変更間隔と、変更前のハンドルからリダイレクトする期間です
+const (
+	HandleChangeCooldown = 30 * 24 * time.Hour
+	HandleRedirectPeriod = 90 * 24 * time.Hour
+)
+
+// handle_regex はハンドルに使用できる文字（半角英数字とアンダースコア）の正規表現パターンです
+var handle_regex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// handle_digits_regex は数字のみのハンドル（公開IDや電話番号との混同を防ぐため使用不可）の正規表現パターンです
+var handle_digits_regex = regexp.MustCompile(`^[0-9]+$`)
+
+// reserved_handles はサービスの機能名・運営と紛らわしい、ユーザーが使用できないハンドルです（小文字で比較します）
+var reserved_handles = []string{
+	"about", "account", "admin", "administrator", "api", "app", "auth", "blog", "contact", "explore",
+	"graphql", "help", "home", "info", "login", "logout", "me", "moderator", "news", "notifications",
+	"null", "official", "owner", "privacy", "profile", "query", "register", "root", "search", "security",
+	"settings", "signin", "signup", "sleeve", "sleeve_official", "staff", "support", "system", "terms",
+	"undefined", "user", "users", "www",
+}
+
+// Handle はプロフィールのURLなどに使う@ハンドル（ユーザー名）を表す値オブジェクトです
+// 表示用の値は入力された大文字・小文字を保持し、重複の判定には小文字に揃えたキーを使用します
+type Handle struct {
+	value string
+	key   string
+}
+
+// NewHandle は新しいHandleを作成します
+// NFKC正規化（全角英数字は半角に変換）と先頭の@の除去を行い、3〜30文字の半角英数字・アンダースコア以外・数字のみの場合はエラーを返します
+func NewHandle(value string) (Handle, error) {
+	var normalized_value string
+
+	normalized_value = strings.TrimSpace(norm.NFKC.String(value))
+	normalized_value = strings.TrimPrefix(normalized_value, "@")
+	if normalized_value == "" {
+		return Handle{}, fmt.Errorf("handle cannot be empty")
+	}
+	if utf8.RuneCountInString(normalized_value) < handleMinLength || utf8.RuneCountInString(normalized_value) > handleMaxLength {
+		return Handle{}, fmt.Errorf("handle must be between %d and %d characters", handleMinLength, handleMaxLength)
+	}
+	if !handle_regex.MatchString(normalized_value) {
+		return Handle{}, fmt.Errorf("handle can only contain letters, digits and underscores: %s", normalized_value)
+	}
+	if handle_digits_regex.MatchString(normalized_value) {
+		return Handle{}, fmt.Errorf("handle cannot contain only digits: %s", normalized_value)
+	}
+	return Handle{value: normalized_value, key: strings.ToLower(normalized_value)}, nil
+}
+
+// Value は表示用のハンドルを返します
+func (h Handle) Value() string {
+	return h.value
+}
+
+// Key は大文字・小文字を区別せずに重複を判定するためのキー（小文字）を返します
+func (h Handle) Key() string {
+	return h.key
+}
+
+// IsReserved はサービスで予約されたハンドルかを返します
+func (h Handle) IsReserved() bool {
+	return slices.Contains(reserved_handles, h.key)
+}
+
+// Equals は大文字・小文字を区別せずに同じハンドルかを判定します
+func (h Handle) Equals(other Handle) bool {
+	return h.key == other.key
+}
+
+// UserHandle はユーザーに割り当てられたハンドルを表すエンティティです
+// 変更前のハンドルはretired_atを記録して一定期間残し、旧ハンドルからのリダイレクトと他のユーザーによるなりすましの防止に使用します
+type UserHandle struct {
+	user_id    uuid.UUID
+	handle     Handle
+	created_at time.Time
+	retired_at *time.Time
+}
+
+// NewUserHandle はユーザーに新しく割り当てるUserHandleを作成します
+func NewUserHandle(user_id uuid.UUID, handle Handle, now time.Time) (*UserHandle, error) {
+	return NewUserHandleWithStatus(user_id, handle, now, nil)
+}
+
+// NewUserHandleWithStatus は変更済みの状態を持つUserHandleを作成します（DBからの復元用）
+func NewUserHandleWithStatus(user_id uuid.UUID, handle Handle, created_at time.Time, retired_at *time.Time) (*UserHandle, error) {
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	if handle.Key() == "" {
+		return nil, fmt.Errorf("handle cannot be empty")
+	}
+	return &UserHandle{
+		user_id:    user_id,
+		handle:     handle,
+		created_at: created_at,
+		retired_at: retired_at,
+	}, nil
+}
+
+// UserID はハンドルの所有者の公開ユーザーIDを返します
+func (h *UserHandle) UserID() uuid.UUID {
+	return h.user_id
+}
+
+// Handle はハンドルを返します
+func (h *UserHandle) Handle() Handle {
+	return h.handle
+}
+
+// CreatedAt はハンドルを割り当てた日時を返します
+func (h *UserHandle) CreatedAt() time.Time {
+	return h.created_at
+}
+
+// RetiredAt は別のハンドルに変更した日時を返します（現在のハンドルの場合はnil）
+func (h *UserHandle) RetiredAt() *time.Time {
+	return h.retired_at
+}
+
+// IsCurrent は現在のハンドルかを返します
+func (h *UserHandle) IsCurrent() bool {
+	return h.retired_at == nil
+}
+
+// NextChangeAvailableAt は次にハンドルを変更できる日時を返します
+func (h *UserHandle) NextChangeAvailableAt() time.Time {
+	return h.created_at.Add(HandleChangeCooldown)
+}
+
+// CanChange はハンドルの変更間隔を過ぎているかを返します
+func (h *UserHandle) CanChange(now time.Time) bool {
+	return !now.Before(h.NextChangeAvailableAt())
+}
+
+// IsRedirectActive は変更前のハンドルからのリダイレクト期間中かを返します
+func (h *UserHandle) IsRedirectActive(now time.Time) bool {
+	return h.retired_at != nil && now.Before(h.retired_at.Add(HandleRedirectPeriod))
+}
+
+// Rename は大文字・小文字のみが異なるハンドルに表示を変更します（変更間隔の制限は受けません）
+func (h *UserHandle) Rename(handle Handle) error {
+	if !h.handle.Equals(handle) {
+		return fmt.Errorf("handle can only be renamed to differ in case: %s", handle.Value())
+	}
+	h.handle = handle
+	return nil
+}
+
+// Retire は別のハンドルに変更したことを記録します（変更前のハンドルはリダイレクト期間中も残します）
+func (h *UserHandle) Retire(now time.Time) {
+	h.retired_at = &now
+}
+
+// IsClaimableBy は指定したユーザーがこのハンドルを使用できるかを返します
+// 所有者は常に使用でき、他のユーザーは変更前のハンドルのリダイレクト期間が過ぎた後のみ使用できます
+func (h *UserHandle) IsClaimableBy(user_id uuid.UUID, now time.Time) bool {
+	if h.user_id == user_id {
+		return true
+	}
+	return !h.IsCurrent() && !h.IsRedirectActive(now)
+}
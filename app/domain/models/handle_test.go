@@ -0,0 +1,102 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewHandle_Normalization(t *testing.T) {
+	// Arrange
+	var handle Handle
+	var err error
+
+	// Act
+	handle, err = NewHandle(" @Ｓｌｅｅｖｅ_Ｔａｒｏ１ ")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if handle.Value() != "Sleeve_Taro1" {
+		t.Errorf("expected NFKC normalized handle Sleeve_Taro1, got %s", handle.Value())
+	}
+	if handle.Key() != "sleeve_taro1" {
+		t.Errorf("expected lower case key sleeve_taro1, got %s", handle.Key())
+	}
+}
+
+func TestNewHandle_Invalid(t *testing.T) {
+	// Arrange
+	var invalid_handles []string
+	var err error
+
+	invalid_handles = []string{"", "@", "ab", strings.Repeat("a", 31), "taro.sleeve", "taro-sleeve", "たろう", "taro sleeve", "12345"}
+	// Act & Assert
+	for _, invalid_handle := range invalid_handles {
+		_, err = NewHandle(invalid_handle)
+		if err == nil {
+			t.Errorf("expected error for handle %q, got nil", invalid_handle)
+		}
+	}
+}
+
+func TestHandle_IsReservedAndEquals(t *testing.T) {
+	// Arrange
+	var reserved_handle Handle
+	var upper_handle Handle
+	var lower_handle Handle
+
+	reserved_handle, _ = NewHandle("Admin")
+	upper_handle, _ = NewHandle("TARO")
+	lower_handle, _ = NewHandle("taro")
+	// Act & Assert
+	if !reserved_handle.IsReserved() {
+		t.Error("expected Admin to be reserved regardless of case")
+	}
+	if upper_handle.IsReserved() {
+		t.Error("expected TARO not to be reserved")
+	}
+	if !upper_handle.Equals(lower_handle) {
+		t.Error("expected handles to be equal ignoring case")
+	}
+}
+
+func TestUserHandle_CooldownAndRedirect(t *testing.T) {
+	// Arrange
+	var owner_id uuid.UUID
+	var other_id uuid.UUID
+	var handle Handle
+	var now time.Time
+	var retired_at time.Time
+	var current_handle *UserHandle
+	var retired_handle *UserHandle
+
+	owner_id = uuid.New()
+	other_id = uuid.New()
+	handle, _ = NewHandle("taro")
+	now = time.Now()
+	current_handle, _ = NewUserHandle(owner_id, handle, now)
+	retired_at = now.Add(time.Hour)
+	retired_handle, _ = NewUserHandleWithStatus(owner_id, handle, now, &retired_at)
+	// Act & Assert
+	if current_handle.CanChange(now.Add(HandleChangeCooldown - time.Second)) {
+		t.Error("expected handle not to be changeable during cooldown")
+	}
+	if !current_handle.CanChange(now.Add(HandleChangeCooldown)) {
+		t.Error("expected handle to be changeable after cooldown")
+	}
+	if current_handle.IsClaimableBy(other_id, now) {
+		t.Error("expected current handle not to be claimable by other users")
+	}
+	if !retired_handle.IsRedirectActive(retired_at) || retired_handle.IsClaimableBy(other_id, retired_at) {
+		t.Error("expected retired handle to redirect and stay reserved during redirect period")
+	}
+	if !retired_handle.IsClaimableBy(owner_id, retired_at) {
+		t.Error("expected owner to reclaim retired handle")
+	}
+	if !retired_handle.IsClaimableBy(other_id, retired_at.Add(HandleRedirectPeriod)) {
+		t.Error("expected retired handle to be claimable after redirect period")
+	}
+}
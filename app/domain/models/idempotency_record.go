@@ -0,0 +1,158 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyOperation は冪等キーで重複実行を防ぐ操作を表します
+type IdempotencyOperation string
+
+const (
+	// IdempotencyOperationRegisterUser はユーザー登録です
+	IdempotencyOperationRegisterUser IdempotencyOperation = "register_user"
+)
+
+// idempotencyKeyMaxLength は冪等キーの最大文字数です
+const idempotencyKeyMaxLength = 255
+
+// IdempotencyKey はクライアントがリクエストごとに生成する冪等キー（UUIDなど）を表す値オブジェクトです
+// 同じ冪等キーで再送信されたリクエストは、最初のリクエストの結果を返します
+type IdempotencyKey struct {
+	value string
+}
+
+// NewIdempotencyKey は新しいIdempotencyKeyを作成します
+// 空・255文字を超える・制御文字や空白を含む場合はエラーを返します
+func NewIdempotencyKey(value string) (IdempotencyKey, error) {
+	var trimmed_value string
+
+	trimmed_value = strings.TrimSpace(value)
+	if trimmed_value == "" {
+		return IdempotencyKey{}, fmt.Errorf("idempotency key cannot be empty")
+	}
+	if len(trimmed_value) > idempotencyKeyMaxLength {
+		return IdempotencyKey{}, fmt.Errorf("idempotency key must be at most %d characters", idempotencyKeyMaxLength)
+	}
+	if strings.IndexFunc(trimmed_value, func(r rune) bool { return unicode.IsSpace(r) || !unicode.IsPrint(r) }) >= 0 {
+		return IdempotencyKey{}, fmt.Errorf("idempotency key cannot contain spaces or control characters")
+	}
+	return IdempotencyKey{value: trimmed_value}, nil
+}
+
+// Value は冪等キーの値を返します
+func (k IdempotencyKey) Value() string {
+	return k.value
+}
+
+// Fingerprint は冪等キーとリクエストの内容からリクエストのフィンガープリント（サーバーの秘密鍵によるHMAC-SHA256の16進数）を作成します
+// 同じ冪等キーで異なる内容のリクエストが送信されたことを検出するために使用し、パスワードなどの値そのものは保存しません
+// 鍵のないハッシュは冪等キーと同じ行に保存されるとパスワードを総当たりで復元できるため、DBに含まれない秘密鍵で計算します
+func (k IdempotencyKey) Fingerprint(secret_key []byte, parts ...string) string {
+	var mac hash.Hash
+
+	mac = hmac.New(sha256.New, secret_key)
+	mac.Write([]byte(k.value + "\x00" + strings.Join(parts, "\x00")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IdempotencyRecord は冪等キーごとのリクエストの処理状況を表すエンティティです
+// 処理中の間はuser_idがnilで、処理が完了すると結果のユーザーを記録します
+type IdempotencyRecord struct {
+	operation   IdempotencyOperation
+	key         IdempotencyKey
+	fingerprint string
+	user_id     *uuid.UUID
+	created_at  time.Time
+	expires_at  time.Time
+}
+
+// NewIdempotencyRecord は処理を開始するリクエストのIdempotencyRecordを作成します
+func NewIdempotencyRecord(operation IdempotencyOperation, key IdempotencyKey, fingerprint string, now time.Time, expires_at time.Time) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		operation:   operation,
+		key:         key,
+		fingerprint: fingerprint,
+		user_id:     nil,
+		created_at:  now,
+		expires_at:  expires_at,
+	}
+}
+
+// NewIdempotencyRecordWithResult は処理結果を持つIdempotencyRecordを作成します（DBからの復元用）
+func NewIdempotencyRecordWithResult(
+	operation IdempotencyOperation,
+	key IdempotencyKey,
+	fingerprint string,
+	user_id *uuid.UUID,
+	created_at time.Time,
+	expires_at time.Time,
+) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		operation:   operation,
+		key:         key,
+		fingerprint: fingerprint,
+		user_id:     user_id,
+		created_at:  created_at,
+		expires_at:  expires_at,
+	}
+}
+
+// Operation は操作を返します
+func (r *IdempotencyRecord) Operation() IdempotencyOperation {
+	return r.operation
+}
+
+// Key は冪等キーを返します
+func (r *IdempotencyRecord) Key() IdempotencyKey {
+	return r.key
+}
+
+// Fingerprint はリクエストのフィンガープリントを返します
+func (r *IdempotencyRecord) Fingerprint() string {
+	return r.fingerprint
+}
+
+// UserID は処理結果のユーザーの公開IDを返します（処理中の場合はnil）
+func (r *IdempotencyRecord) UserID() *uuid.UUID {
+	return r.user_id
+}
+
+// CreatedAt は処理を開始した日時を返します
+func (r *IdempotencyRecord) CreatedAt() time.Time {
+	return r.created_at
+}
+
+// ExpiresAt は有効期限を返します
+func (r *IdempotencyRecord) ExpiresAt() time.Time {
+	return r.expires_at
+}
+
+// MatchesFingerprint は同じ内容のリクエストかを返します
+func (r *IdempotencyRecord) MatchesFingerprint(fingerprint string) bool {
+	return subtle.ConstantTimeCompare([]byte(r.fingerprint), []byte(fingerprint)) == 1
+}
+
+// IsCompleted は処理が完了しているかを返します
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.user_id != nil
+}
+
+// IsExpired は有効期限を過ぎているかを返します
+func (r *IdempotencyRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.expires_at)
+}
+
+// IsAbandoned は処理中のまま処理時間の上限を過ぎたか（プロセスの停止などで中断されたか）を返します
+func (r *IdempotencyRecord) IsAbandoned(now time.Time, processing_timeout time.Duration) bool {
+	return !r.IsCompleted() && !now.Before(r.created_at.Add(processing_timeout))
+}
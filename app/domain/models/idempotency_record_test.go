@@ -0,0 +1,104 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testFingerprintKey はテスト用のフィンガープリントの秘密鍵です
+var testFingerprintKey = []byte("test_idempotency_fingerprint_key_32b")
+
+func TestNewIdempotencyKey_Success(t *testing.T) {
+	// Arrange
+	var key IdempotencyKey
+	var err error
+
+	// Act
+	key, err = NewIdempotencyKey("  8f14e45f-ceea-467f-a0e6-61d4b1f7c5a2 ")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if key.Value() != "8f14e45f-ceea-467f-a0e6-61d4b1f7c5a2" {
+		t.Errorf("expected trimmed key, got %s", key.Value())
+	}
+}
+
+func TestNewIdempotencyKey_InvalidArguments(t *testing.T) {
+	// Arrange & Act & Assert
+	var invalid_values []string
+
+	invalid_values = []string{"", "  ", strings.Repeat("a", idempotencyKeyMaxLength+1), "key with space", "key\x00"}
+	for _, value := range invalid_values {
+		var err error
+
+		_, err = NewIdempotencyKey(value)
+		if err == nil {
+			t.Errorf("expected error for %q, got nil", value)
+		}
+	}
+}
+
+func TestIdempotencyKey_Fingerprint(t *testing.T) {
+	// Arrange
+	var key IdempotencyKey
+	var other_key IdempotencyKey
+	var fingerprint string
+
+	key, _ = NewIdempotencyKey("key-1")
+	other_key, _ = NewIdempotencyKey("key-2")
+	// Act
+	fingerprint = key.Fingerprint(testFingerprintKey, "test@example.com", "Password123!")
+	// Assert
+	if len(fingerprint) != 64 || strings.Contains(fingerprint, "Password123!") {
+		t.Errorf("expected HMAC-SHA256 hex fingerprint, got %s", fingerprint)
+	}
+	if fingerprint != key.Fingerprint(testFingerprintKey, "test@example.com", "Password123!") {
+		t.Error("expected fingerprint to be deterministic")
+	}
+	if fingerprint == key.Fingerprint(testFingerprintKey, "test@example.com", "Password1234!") {
+		t.Error("expected different request body to change fingerprint")
+	}
+	if fingerprint == other_key.Fingerprint(testFingerprintKey, "test@example.com", "Password123!") {
+		t.Error("expected different key to change fingerprint")
+	}
+	if fingerprint == key.Fingerprint([]byte("other_idempotency_fingerprint_key"), "test@example.com", "Password123!") {
+		t.Error("expected different secret key to change fingerprint")
+	}
+}
+
+func TestIdempotencyRecord_Status(t *testing.T) {
+	// Arrange
+	var key IdempotencyKey
+	var now time.Time
+	var record *IdempotencyRecord
+	var user_id uuid.UUID
+
+	key, _ = NewIdempotencyKey("key-1")
+	now = time.Now()
+	user_id = uuid.New()
+	// Act & Assert
+	record = NewIdempotencyRecord(IdempotencyOperationRegisterUser, key, key.Fingerprint(testFingerprintKey, "a"), now, now.Add(time.Hour))
+	if record.IsCompleted() {
+		t.Error("expected new record to be in progress")
+	}
+	if !record.MatchesFingerprint(key.Fingerprint(testFingerprintKey, "a")) || record.MatchesFingerprint(key.Fingerprint(testFingerprintKey, "b")) {
+		t.Error("unexpected fingerprint match result")
+	}
+	if record.IsAbandoned(now.Add(time.Second), time.Minute) || !record.IsAbandoned(now.Add(time.Minute), time.Minute) {
+		t.Error("expected record to be abandoned only after processing timeout")
+	}
+	if record.IsExpired(now) || !record.IsExpired(now.Add(time.Hour)) {
+		t.Error("expected record to expire at expires_at")
+	}
+	record = NewIdempotencyRecordWithResult(IdempotencyOperationRegisterUser, key, key.Fingerprint(testFingerprintKey, "a"), &user_id, now, now.Add(time.Hour))
+	if !record.IsCompleted() || *record.UserID() != user_id {
+		t.Errorf("expected completed record for %s, got %v", user_id, record.UserID())
+	}
+	if record.IsAbandoned(now.Add(time.Hour), time.Minute) {
+		t.Error("expected completed record not to be abandoned")
+	}
+}
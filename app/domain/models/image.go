@@ -0,0 +1,248 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImageFormat はアップロードできる画像の形式を表します
+type ImageFormat string
+
+const (
+	// ImageFormatJPEG はJPEG画像です
+	ImageFormatJPEG ImageFormat = "jpeg"
+	// ImageFormatPNG はPNG画像です（透過を保つため、リサイズ画像もPNGで保存します）
+	ImageFormatPNG ImageFormat = "png"
+
+	// MaxImageUploadSize はアップロードできる画像のファイルサイズの上限（10MB）です
+	MaxImageUploadSize = 10 << 20
+	// MaxImagePixels はアップロードできる画像の画素数の上限です
+	// 小さなファイルが巨大な画像に展開される（画像爆弾）場合に、デコード前に拒否するために使用します
+	MaxImagePixels = 6000 * 6000
+)
+
+// image_signatures はファイルの先頭のバイト列（マジックバイト）と画像の形式の対応です
+var image_signatures = []struct {
+	format    ImageFormat
+	signature []byte
+}{
+	{format: ImageFormatJPEG, signature: []byte{0xFF, 0xD8, 0xFF}},
+	{format: ImageFormatPNG, signature: []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}},
+}
+
+// DetectImageFormat はファイルの先頭のバイト列から画像の形式を判定します
+// クライアントが指定したContent-Type・拡張子は信用せず、JPEG・PNG以外の場合はエラーを返します
+func DetectImageFormat(data []byte) (ImageFormat, error) {
+	for _, image_signature := range image_signatures {
+		if bytes.HasPrefix(data, image_signature.signature) {
+			return image_signature.format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported image signature")
+}
+
+// ContentType は画像の形式のMIMEタイプを返します
+func (f ImageFormat) ContentType() string {
+	return "image/" + string(f)
+}
+
+// Extension は保存するファイルの拡張子を返します
+func (f ImageFormat) Extension() string {
+	if f == ImageFormatJPEG {
+		return "jpg"
+	}
+	return string(f)
+}
+
+// ImagePurpose は画像の用途を表します（用途ごとに作成するリサイズ画像のサイズが異なります）
+type ImagePurpose string
+
+const (
+	// ImagePurposeAvatar はプロフィールのアイコンです
+	ImagePurposeAvatar ImagePurpose = "avatar"
+	// ImagePurposeCoordinate はコーデの投稿の画像です
+	ImagePurposeCoordinate ImagePurpose = "coordinate"
+	// ImagePurposeListing はアイテムの出品の画像です
+	ImagePurposeListing ImagePurpose = "listing"
+)
+
+// ImageVariantSpec はリサイズ画像の名前と長辺の最大ピクセル数です
+type ImageVariantSpec struct {
+	name     string
+	max_edge int
+}
+
+// Name はリサイズ画像の名前を返します
+func (s ImageVariantSpec) Name() string {
+	return s.name
+}
+
+// MaxEdge はリサイズ画像の長辺の最大ピクセル数を返します
+func (s ImageVariantSpec) MaxEdge() int {
+	return s.max_edge
+}
+
+// image_variant_specs は用途ごとに作成するリサイズ画像です（小さい順）
+// 元の画像はExif（撮影場所など）を含むため保存せず、最大のリサイズ画像を元の画像の代わりに使用します
+var image_variant_specs = map[ImagePurpose][]ImageVariantSpec{
+	ImagePurposeAvatar: {
+		{name: "small", max_edge: 96},
+		{name: "medium", max_edge: 256},
+		{name: "large", max_edge: 512},
+	},
+	ImagePurposeCoordinate: {
+		{name: "thumbnail", max_edge: 320},
+		{name: "medium", max_edge: 960},
+		{name: "large", max_edge: 1920},
+	},
+	ImagePurposeListing: {
+		{name: "thumbnail", max_edge: 320},
+		{name: "medium", max_edge: 960},
+		{name: "large", max_edge: 1920},
+	},
+}
+
+// NewImagePurpose は文字列からImagePurposeを作成します
+func NewImagePurpose(value string) (ImagePurpose, error) {
+	var purpose ImagePurpose
+	var exists bool
+
+	purpose = ImagePurpose(value)
+	_, exists = image_variant_specs[purpose]
+	if !exists {
+		return "", fmt.Errorf("invalid image purpose: %s", value)
+	}
+	return purpose, nil
+}
+
+// VariantSpecs は用途ごとに作成するリサイズ画像を小さい順に返します
+func (p ImagePurpose) VariantSpecs() []ImageVariantSpec {
+	return image_variant_specs[p]
+}
+
+// String は用途の文字列表現を返します
+func (p ImagePurpose) String() string {
+	return string(p)
+}
+
+// ImageVariant はアップロードした画像から作成したリサイズ画像を表します
+type ImageVariant struct {
+	name        string
+	storage_key string
+	url         string
+	width       int
+	height      int
+}
+
+// NewImageVariant は新しいImageVariantを作成します
+func NewImageVariant(name string, storage_key string, url string, width int, height int) ImageVariant {
+	return ImageVariant{
+		name:        name,
+		storage_key: storage_key,
+		url:         url,
+		width:       width,
+		height:      height,
+	}
+}
+
+// Name はリサイズ画像の名前を返します
+func (v ImageVariant) Name() string {
+	return v.name
+}
+
+// StorageKey はストレージ上のキーを返します
+func (v ImageVariant) StorageKey() string {
+	return v.storage_key
+}
+
+// URL は公開URLを返します
+func (v ImageVariant) URL() string {
+	return v.url
+}
+
+// Width は幅（ピクセル）を返します
+func (v ImageVariant) Width() int {
+	return v.width
+}
+
+// Height は高さ（ピクセル）を返します
+func (v ImageVariant) Height() int {
+	return v.height
+}
+
+// UploadedImage はユーザーがアップロードした画像を表します
+type UploadedImage struct {
+	image_id    uuid.UUID
+	owner_id    uuid.UUID
+	purpose     ImagePurpose
+	format      ImageFormat
+	variants    []ImageVariant
+	uploaded_at time.Time
+}
+
+// NewUploadedImage は新しいUploadedImageを作成します
+func NewUploadedImage(
+	image_id uuid.UUID,
+	owner_id uuid.UUID,
+	purpose ImagePurpose,
+	format ImageFormat,
+	variants []ImageVariant,
+	uploaded_at time.Time,
+) (*UploadedImage, error) {
+	if image_id == uuid.Nil {
+		return nil, fmt.Errorf("image_id cannot be empty")
+	}
+	if owner_id == uuid.Nil {
+		return nil, fmt.Errorf("owner_id cannot be empty")
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("variants cannot be empty")
+	}
+	return &UploadedImage{
+		image_id:    image_id,
+		owner_id:    owner_id,
+		purpose:     purpose,
+		format:      format,
+		variants:    variants,
+		uploaded_at: uploaded_at,
+	}, nil
+}
+
+// ImageID は画像IDを返します
+func (i *UploadedImage) ImageID() uuid.UUID {
+	return i.image_id
+}
+
+// OwnerID はアップロードしたユーザーの公開IDを返します
+func (i *UploadedImage) OwnerID() uuid.UUID {
+	return i.owner_id
+}
+
+// Purpose は画像の用途を返します
+func (i *UploadedImage) Purpose() ImagePurpose {
+	return i.purpose
+}
+
+// Format は画像の形式を返します
+func (i *UploadedImage) Format() ImageFormat {
+	return i.format
+}
+
+// Variants はリサイズ画像を小さい順に返します
+func (i *UploadedImage) Variants() []ImageVariant {
+	return i.variants
+}
+
+// UploadedAt はアップロード日時を返します
+func (i *UploadedImage) UploadedAt() time.Time {
+	return i.uploaded_at
+}
+
+// ImageStorageKey はリサイズ画像のストレージ上のキーを返します
+// アップロードしたユーザーの公開IDを含め、ユーザーごとに画像を削除できるようにします
+func ImageStorageKey(owner_id uuid.UUID, image_id uuid.UUID, variant_name string, format ImageFormat) string {
+	return "images/" + owner_id.String() + "/" + image_id.String() + "/" + variant_name + "." + format.Extension()
+}
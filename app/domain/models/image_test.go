@@ -0,0 +1,116 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDetectImageFormat(t *testing.T) {
+	var test_cases []struct {
+		name     string
+		data     []byte
+		expected ImageFormat
+	}
+	var format ImageFormat
+	var err error
+
+	test_cases = []struct {
+		name     string
+		data     []byte
+		expected ImageFormat
+	}{
+		{name: "jpeg", data: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}, expected: ImageFormatJPEG},
+		{name: "png", data: []byte("\x89PNG\r\n\x1a\n\x00\x00"), expected: ImageFormatPNG},
+	}
+	for _, test_case := range test_cases {
+		format, err = DetectImageFormat(test_case.data)
+		if err != nil {
+			t.Errorf("%s: expected no error, got %v", test_case.name, err)
+		}
+		if format != test_case.expected {
+			t.Errorf("%s: expected %s, got %s", test_case.name, test_case.expected, format)
+		}
+	}
+}
+
+func TestDetectImageFormat_Unsupported(t *testing.T) {
+	var err error
+
+	// GIF・WebP・拡張子だけ画像にしたテキストファイル・空のファイル
+	for _, data := range [][]byte{[]byte("GIF89a"), []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), []byte("<svg></svg>"), {}} {
+		_, err = DetectImageFormat(data)
+		if err == nil {
+			t.Errorf("expected error for %q, got nil", data)
+		}
+	}
+}
+
+func TestImageFormat_ContentTypeAndExtension(t *testing.T) {
+	if ImageFormatJPEG.ContentType() != "image/jpeg" || ImageFormatJPEG.Extension() != "jpg" {
+		t.Errorf("unexpected jpeg content type or extension: %s, %s", ImageFormatJPEG.ContentType(), ImageFormatJPEG.Extension())
+	}
+	if ImageFormatPNG.ContentType() != "image/png" || ImageFormatPNG.Extension() != "png" {
+		t.Errorf("unexpected png content type or extension: %s, %s", ImageFormatPNG.ContentType(), ImageFormatPNG.Extension())
+	}
+}
+
+func TestNewImagePurpose(t *testing.T) {
+	var purpose ImagePurpose
+	var specs []ImageVariantSpec
+	var err error
+
+	for _, value := range []string{"avatar", "coordinate", "listing"} {
+		purpose, err = NewImagePurpose(value)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", value, err)
+		}
+		specs = purpose.VariantSpecs()
+		if len(specs) == 0 {
+			t.Fatalf("%s: expected variant specs, got none", value)
+		}
+		for i := 1; i < len(specs); i++ {
+			if specs[i].MaxEdge() <= specs[i-1].MaxEdge() {
+				t.Errorf("%s: expected variant specs in ascending order, got %+v", value, specs)
+			}
+		}
+	}
+	_, err = NewImagePurpose("banner")
+	if err == nil {
+		t.Error("expected error for invalid purpose, got nil")
+	}
+}
+
+func TestNewUploadedImage(t *testing.T) {
+	var image_id uuid.UUID
+	var owner_id uuid.UUID
+	var variants []ImageVariant
+	var uploaded *UploadedImage
+	var err error
+
+	image_id = uuid.New()
+	owner_id = uuid.New()
+	variants = []ImageVariant{
+		NewImageVariant("small", ImageStorageKey(owner_id, image_id, "small", ImageFormatJPEG), "https://cdn.example.com/small.jpg", 96, 64),
+	}
+	uploaded, err = NewUploadedImage(image_id, owner_id, ImagePurposeAvatar, ImageFormatJPEG, variants, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if uploaded.ImageID() != image_id || uploaded.OwnerID() != owner_id || uploaded.Purpose() != ImagePurposeAvatar {
+		t.Error("expected uploaded image to hold the given ids and purpose")
+	}
+	if uploaded.Variants()[0].StorageKey() != "images/"+owner_id.String()+"/"+image_id.String()+"/small.jpg" {
+		t.Errorf("unexpected storage key: %s", uploaded.Variants()[0].StorageKey())
+	}
+
+	_, err = NewUploadedImage(image_id, owner_id, ImagePurposeAvatar, ImageFormatJPEG, nil, time.Now())
+	if err == nil {
+		t.Error("expected error for empty variants, got nil")
+	}
+	_, err = NewUploadedImage(image_id, uuid.Nil, ImagePurposeAvatar, ImageFormatJPEG, variants, time.Now())
+	if err == nil {
+		t.Error("expected error for empty owner_id, got nil")
+	}
+}
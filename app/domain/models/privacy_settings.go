@@ -0,0 +1,129 @@
+package models
+
+import "fmt"
+
+// CommentPermission はコーデなどの投稿にコメントできるユーザーの範囲を表します
+type CommentPermission string
+
+const (
+	// CommentPermissionEveryone は投稿を閲覧できる全てのユーザーがコメントできます
+	CommentPermissionEveryone CommentPermission = "everyone"
+	// CommentPermissionFollowers はフォロワーのみコメントできます
+	CommentPermissionFollowers CommentPermission = "followers"
+	// CommentPermissionNobody は投稿者以外はコメントできません
+	CommentPermissionNobody CommentPermission = "nobody"
+)
+
+// NewCommentPermission は文字列からCommentPermissionを作成します
+func NewCommentPermission(value string) (CommentPermission, error) {
+	var permission CommentPermission
+
+	permission = CommentPermission(value)
+	switch permission {
+	case CommentPermissionEveryone, CommentPermissionFollowers, CommentPermissionNobody:
+		return permission, nil
+	default:
+		return "", fmt.Errorf("invalid comment permission: %s", value)
+	}
+}
+
+// String はコメントできる範囲の文字列表現を返します
+func (p CommentPermission) String() string {
+	return string(p)
+}
+
+// ViewerRelation は閲覧するユーザーとアカウントの所有者の関係を表します
+type ViewerRelation int
+
+const (
+	// ViewerRelationStranger はフォロワーではないユーザー（未ログインを含む）です
+	ViewerRelationStranger ViewerRelation = iota
+	// ViewerRelationFollower はアカウントをフォローしているユーザーです（非公開アカウントの場合は承認されたフォロワー）
+	ViewerRelationFollower
+	// ViewerRelationOwner はアカウントの所有者本人です
+	ViewerRelationOwner
+)
+
+// PrivacySettings はユーザーのプライバシー設定を表す値オブジェクトです
+// 非公開アカウントの場合、フォローは承認制になり、承認されたフォロワー以外にはコーデ・プロフィールの詳細・フォロー関係の一覧を公開しません
+type PrivacySettings struct {
+	is_private         bool
+	hide_closet        bool
+	hide_likes         bool
+	comment_permission CommentPermission
+}
+
+// NewPrivacySettings は新しいPrivacySettingsを作成します
+func NewPrivacySettings(is_private bool, hide_closet bool, hide_likes bool, comment_permission CommentPermission) (PrivacySettings, error) {
+	var err error
+
+	_, err = NewCommentPermission(comment_permission.String())
+	if err != nil {
+		return PrivacySettings{}, err
+	}
+	return PrivacySettings{
+		is_private:         is_private,
+		hide_closet:        hide_closet,
+		hide_likes:         hide_likes,
+		comment_permission: comment_permission,
+	}, nil
+}
+
+// DefaultPrivacySettings は登録直後のプライバシー設定（公開アカウント・全て公開・誰でもコメント可）を返します
+func DefaultPrivacySettings() PrivacySettings {
+	return PrivacySettings{comment_permission: CommentPermissionEveryone}
+}
+
+// IsPrivate は非公開アカウントかを返します
+func (s PrivacySettings) IsPrivate() bool {
+	return s.is_private
+}
+
+// HideCloset はクローゼット（所有アイテムの一覧）を本人以外に非表示にするかを返します
+func (s PrivacySettings) HideCloset() bool {
+	return s.hide_closet
+}
+
+// HideLikes はいいねした投稿の一覧を本人以外に非表示にするかを返します
+func (s PrivacySettings) HideLikes() bool {
+	return s.hide_likes
+}
+
+// CommentPermission は投稿にコメントできるユーザーの範囲を返します
+func (s PrivacySettings) CommentPermission() CommentPermission {
+	return s.comment_permission
+}
+
+// CanViewContent はコーデ・プロフィールの詳細・フォロー関係の一覧を閲覧できるかを返します
+// 公開アカウントは誰でも、非公開アカウントは本人と承認されたフォロワーのみ閲覧できます
+func (s PrivacySettings) CanViewContent(relation ViewerRelation) bool {
+	return !s.is_private || relation == ViewerRelationFollower || relation == ViewerRelationOwner
+}
+
+// CanViewCloset はクローゼットを閲覧できるかを返します
+func (s PrivacySettings) CanViewCloset(relation ViewerRelation) bool {
+	return relation == ViewerRelationOwner || (s.CanViewContent(relation) && !s.hide_closet)
+}
+
+// CanViewLikes はいいねした投稿の一覧を閲覧できるかを返します
+func (s PrivacySettings) CanViewLikes(relation ViewerRelation) bool {
+	return relation == ViewerRelationOwner || (s.CanViewContent(relation) && !s.hide_likes)
+}
+
+// CanComment は投稿にコメントできるかを返します（投稿者本人は常にコメントできます）
+func (s PrivacySettings) CanComment(relation ViewerRelation) bool {
+	if relation == ViewerRelationOwner {
+		return true
+	}
+	if !s.CanViewContent(relation) {
+		return false
+	}
+	switch s.comment_permission {
+	case CommentPermissionEveryone:
+		return true
+	case CommentPermissionFollowers:
+		return relation == ViewerRelationFollower
+	default:
+		return false
+	}
+}
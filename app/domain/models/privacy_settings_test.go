@@ -0,0 +1,82 @@
+package models
+
+import "testing"
+
+func TestNewCommentPermission(t *testing.T) {
+	// Arrange & Act & Assert
+	for _, value := range []string{"everyone", "followers", "nobody"} {
+		var permission CommentPermission
+		var err error
+
+		permission, err = NewCommentPermission(value)
+		if err != nil || permission.String() != value {
+			t.Errorf("%s: expected valid permission, got %q, %v", value, permission, err)
+		}
+	}
+	for _, value := range []string{"", "EVERYONE", "friends"} {
+		var err error
+
+		_, err = NewCommentPermission(value)
+		if err == nil {
+			t.Errorf("%q: expected error, got nil", value)
+		}
+	}
+}
+
+func TestPrivacySettings_Default(t *testing.T) {
+	// Arrange
+	var settings PrivacySettings
+
+	// Act
+	settings = DefaultPrivacySettings()
+	// Assert
+	if settings.IsPrivate() || settings.HideCloset() || settings.HideLikes() || settings.CommentPermission() != CommentPermissionEveryone {
+		t.Errorf("unexpected default settings: %+v", settings)
+	}
+	if !settings.CanViewContent(ViewerRelationStranger) || !settings.CanComment(ViewerRelationStranger) {
+		t.Error("expected public account to be visible and commentable by anyone")
+	}
+}
+
+func TestPrivacySettings_PrivateAccount(t *testing.T) {
+	// Arrange
+	var settings PrivacySettings
+	var err error
+
+	// Act
+	settings, err = NewPrivacySettings(true, true, false, CommentPermissionEveryone)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if settings.CanViewContent(ViewerRelationStranger) || settings.CanComment(ViewerRelationStranger) || settings.CanViewLikes(ViewerRelationStranger) {
+		t.Error("expected private account to be hidden from non-followers")
+	}
+	if !settings.CanViewContent(ViewerRelationFollower) || !settings.CanViewLikes(ViewerRelationFollower) || !settings.CanComment(ViewerRelationFollower) {
+		t.Error("expected private account to be visible to approved followers")
+	}
+	if settings.CanViewCloset(ViewerRelationFollower) || !settings.CanViewCloset(ViewerRelationOwner) {
+		t.Error("expected hidden closet to be visible only to the owner")
+	}
+}
+
+func TestPrivacySettings_CommentPermission(t *testing.T) {
+	// Arrange
+	var followers_only PrivacySettings
+	var nobody PrivacySettings
+	var err error
+
+	followers_only, _ = NewPrivacySettings(false, false, false, CommentPermissionFollowers)
+	nobody, _ = NewPrivacySettings(false, false, false, CommentPermissionNobody)
+	// Act & Assert
+	if followers_only.CanComment(ViewerRelationStranger) || !followers_only.CanComment(ViewerRelationFollower) {
+		t.Error("expected only followers to be able to comment")
+	}
+	if nobody.CanComment(ViewerRelationFollower) || !nobody.CanComment(ViewerRelationOwner) {
+		t.Error("expected only the owner to be able to comment")
+	}
+	_, err = NewPrivacySettings(false, false, false, CommentPermission("friends"))
+	if err == nil {
+		t.Error("expected error for invalid comment permission, got nil")
+	}
+}
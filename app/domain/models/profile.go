@@ -0,0 +1,355 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// プロフィールの各項目の上限です
+const (
+	displayNameMaxLength   = 50
+	bioMaxLength           = 500
+	profileURLMaxLength    = 2048
+	heightMinCentimeters   = 50
+	heightMaxCentimeters   = 250
+	favoriteStyleMaxLength = 30
+	favoriteStylesMaxCount = 10
+	profileLinksMaxCount   = 5
+)
+
+// DisplayName はプロフィールの表示名を表す値オブジェクトです
+type DisplayName struct {
+	value string
+}
+
+// NewDisplayName は新しいDisplayNameを作成します
+// 空・50文字を超える・制御文字を含む場合はエラーを返します
+func NewDisplayName(value string) (DisplayName, error) {
+	var trimmed_value string
+
+	trimmed_value = strings.TrimSpace(value)
+	if trimmed_value == "" {
+		return DisplayName{}, fmt.Errorf("display name cannot be empty")
+	}
+	if utf8.RuneCountInString(trimmed_value) > displayNameMaxLength {
+		return DisplayName{}, fmt.Errorf("display name must be at most %d characters", displayNameMaxLength)
+	}
+	if strings.IndexFunc(trimmed_value, unicode.IsControl) >= 0 {
+		return DisplayName{}, fmt.Errorf("display name cannot contain control characters")
+	}
+	return DisplayName{value: trimmed_value}, nil
+}
+
+// Value は表示名の値を返します
+func (n DisplayName) Value() string {
+	return n.value
+}
+
+// Bio はプロフィールの自己紹介文を表す値オブジェクトです（空を許可します）
+type Bio struct {
+	value string
+}
+
+// NewBio は新しいBioを作成します
+// 500文字を超える・改行とタブ以外の制御文字を含む場合はエラーを返します
+func NewBio(value string) (Bio, error) {
+	var trimmed_value string
+
+	trimmed_value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed_value) > bioMaxLength {
+		return Bio{}, fmt.Errorf("bio must be at most %d characters", bioMaxLength)
+	}
+	if strings.IndexFunc(trimmed_value, func(r rune) bool { return unicode.IsControl(r) && r != '\n' && r != '\t' }) >= 0 {
+		return Bio{}, fmt.Errorf("bio cannot contain control characters")
+	}
+	return Bio{value: trimmed_value}, nil
+}
+
+// Value は自己紹介文の値を返します（未設定の場合は空文字）
+func (b Bio) Value() string {
+	return b.value
+}
+
+// ProfileURL はアバター画像やリンクのURLを表す値オブジェクトです
+type ProfileURL struct {
+	value string
+}
+
+// NewProfileURL は新しいProfileURLを作成します
+// http・https以外のスキーム・ホストがない・2048文字を超える場合はエラーを返します
+func NewProfileURL(value string) (ProfileURL, error) {
+	var trimmed_value string
+	var parsed_url *url.URL
+	var err error
+
+	trimmed_value = strings.TrimSpace(value)
+	if trimmed_value == "" {
+		return ProfileURL{}, fmt.Errorf("url cannot be empty")
+	}
+	if len(trimmed_value) > profileURLMaxLength {
+		return ProfileURL{}, fmt.Errorf("url must be at most %d characters", profileURLMaxLength)
+	}
+	parsed_url, err = url.Parse(trimmed_value)
+	if err != nil {
+		return ProfileURL{}, fmt.Errorf("invalid url: %w", err)
+	}
+	if parsed_url.Scheme != "http" && parsed_url.Scheme != "https" {
+		return ProfileURL{}, fmt.Errorf("url scheme must be http or https: %s", parsed_url.Scheme)
+	}
+	if parsed_url.Host == "" {
+		return ProfileURL{}, fmt.Errorf("url must have a host")
+	}
+	return ProfileURL{value: trimmed_value}, nil
+}
+
+// Value はURLの値を返します
+func (u ProfileURL) Value() string {
+	return u.value
+}
+
+// Height は身長（cm）を表す値オブジェクトです
+type Height struct {
+	centimeters int
+}
+
+// NewHeight は新しいHeightを作成します
+// 50cm未満・250cmを超える場合はエラーを返します
+func NewHeight(centimeters int) (Height, error) {
+	if centimeters < heightMinCentimeters || centimeters > heightMaxCentimeters {
+		return Height{}, fmt.Errorf("height must be between %d and %d cm", heightMinCentimeters, heightMaxCentimeters)
+	}
+	return Height{centimeters: centimeters}, nil
+}
+
+// Centimeters は身長をcmで返します
+func (h Height) Centimeters() int {
+	return h.centimeters
+}
+
+// FavoriteStyle は好きなファッションスタイル（カジュアル・ストリートなど）を表す値オブジェクトです
+type FavoriteStyle struct {
+	value string
+}
+
+// NewFavoriteStyle は新しいFavoriteStyleを作成します
+// 空・30文字を超える・制御文字を含む場合はエラーを返します
+func NewFavoriteStyle(value string) (FavoriteStyle, error) {
+	var trimmed_value string
+
+	trimmed_value = strings.TrimSpace(value)
+	if trimmed_value == "" {
+		return FavoriteStyle{}, fmt.Errorf("favorite style cannot be empty")
+	}
+	if utf8.RuneCountInString(trimmed_value) > favoriteStyleMaxLength {
+		return FavoriteStyle{}, fmt.Errorf("favorite style must be at most %d characters", favoriteStyleMaxLength)
+	}
+	if strings.IndexFunc(trimmed_value, unicode.IsControl) >= 0 {
+		return FavoriteStyle{}, fmt.Errorf("favorite style cannot contain control characters")
+	}
+	return FavoriteStyle{value: trimmed_value}, nil
+}
+
+// Value は好きなスタイルの値を返します
+func (s FavoriteStyle) Value() string {
+	return s.value
+}
+
+// Profile はユーザーの公開プロフィールを表すエンティティです
+// 他のユーザーに公開する情報のみを持ち、メールアドレスやFirebase UIDは含めません
+type Profile struct {
+	user_id         uuid.UUID
+	display_name    DisplayName
+	bio             Bio
+	avatar_url      *ProfileURL
+	height          *Height
+	favorite_styles []FavoriteStyle
+	links           []ProfileURL
+	created_at      time.Time
+	updated_at      time.Time
+	// restricted は非公開アカウントのプロフィールを承認されたフォロワー以外に返すため、一部の項目を除いた表示かを表します
+	restricted bool
+}
+
+// NewProfile は新しいProfileエンティティを作成します（プロフィールの作成時）
+func NewProfile(user_id uuid.UUID, display_name DisplayName) (*Profile, error) {
+	var now time.Time
+
+	now = time.Now()
+	return NewProfileWithState(user_id, display_name, Bio{}, nil, nil, []FavoriteStyle{}, []ProfileURL{}, now, now)
+}
+
+// NewProfileWithState は全ての項目を持つProfileエンティティを作成します（DBからの復元用）
+func NewProfileWithState(
+	user_id uuid.UUID,
+	display_name DisplayName,
+	bio Bio,
+	avatar_url *ProfileURL,
+	height *Height,
+	favorite_styles []FavoriteStyle,
+	links []ProfileURL,
+	created_at time.Time,
+	updated_at time.Time,
+) (*Profile, error) {
+	var profile *Profile
+	var err error
+
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	profile = &Profile{
+		user_id:      user_id,
+		display_name: display_name,
+		bio:          bio,
+		avatar_url:   avatar_url,
+		height:       height,
+		created_at:   created_at,
+		updated_at:   updated_at,
+	}
+	err = profile.ReplaceFavoriteStyles(favorite_styles)
+	if err != nil {
+		return nil, err
+	}
+	err = profile.ReplaceLinks(links)
+	if err != nil {
+		return nil, err
+	}
+	profile.updated_at = updated_at
+	return profile, nil
+}
+
+// UserID はプロフィールの所有者の公開ユーザーIDを返します
+func (p *Profile) UserID() uuid.UUID {
+	return p.user_id
+}
+
+// DisplayName は表示名を返します
+func (p *Profile) DisplayName() DisplayName {
+	return p.display_name
+}
+
+// Bio は自己紹介文を返します
+func (p *Profile) Bio() Bio {
+	return p.bio
+}
+
+// AvatarURL はアバター画像のURLを返します（未設定の場合はnil）
+func (p *Profile) AvatarURL() *ProfileURL {
+	return p.avatar_url
+}
+
+// Height は身長を返します（未設定の場合はnil）
+func (p *Profile) Height() *Height {
+	return p.height
+}
+
+// FavoriteStyles は好きなスタイルを返します
+func (p *Profile) FavoriteStyles() []FavoriteStyle {
+	return slices.Clone(p.favorite_styles)
+}
+
+// Links はSNSなどのリンクを返します
+func (p *Profile) Links() []ProfileURL {
+	return slices.Clone(p.links)
+}
+
+// CreatedAt は作成日時を返します
+func (p *Profile) CreatedAt() time.Time {
+	return p.created_at
+}
+
+// UpdatedAt は更新日時を返します
+func (p *Profile) UpdatedAt() time.Time {
+	return p.updated_at
+}
+
+// IsRestricted は一部の項目を除いた表示（RestrictedView）かを返します
+func (p *Profile) IsRestricted() bool {
+	return p.restricted
+}
+
+// RestrictedView は非公開アカウントのプロフィールを承認されたフォロワー以外に返す際の表示を返します
+// フォローリクエストの判断に必要な表示名・自己紹介文・アバター画像のみを残し、身長・好きなスタイル・リンクを除きます
+func (p *Profile) RestrictedView() *Profile {
+	return &Profile{
+		user_id:         p.user_id,
+		display_name:    p.display_name,
+		bio:             p.bio,
+		avatar_url:      p.avatar_url,
+		favorite_styles: []FavoriteStyle{},
+		links:           []ProfileURL{},
+		created_at:      p.created_at,
+		updated_at:      p.updated_at,
+		restricted:      true,
+	}
+}
+
+// ChangeDisplayName は表示名を変更します
+func (p *Profile) ChangeDisplayName(display_name DisplayName) {
+	p.display_name = display_name
+	p.updated_at = time.Now()
+}
+
+// ChangeBio は自己紹介文を変更します
+func (p *Profile) ChangeBio(bio Bio) {
+	p.bio = bio
+	p.updated_at = time.Now()
+}
+
+// ChangeAvatarURL はアバター画像のURLを変更します（nilの場合は未設定にします）
+func (p *Profile) ChangeAvatarURL(avatar_url *ProfileURL) {
+	p.avatar_url = avatar_url
+	p.updated_at = time.Now()
+}
+
+// ChangeHeight は身長を変更します（nilの場合は未設定にします）
+func (p *Profile) ChangeHeight(height *Height) {
+	p.height = height
+	p.updated_at = time.Now()
+}
+
+// ReplaceFavoriteStyles は好きなスタイルを置き換えます
+// 大文字・小文字を区別せずに重複したスタイルは1つにまとめ、10件を超える場合はエラーを返します
+func (p *Profile) ReplaceFavoriteStyles(favorite_styles []FavoriteStyle) error {
+	var unique_styles []FavoriteStyle
+
+	unique_styles = make([]FavoriteStyle, 0, len(favorite_styles))
+	for _, style := range favorite_styles {
+		if slices.ContainsFunc(unique_styles, func(s FavoriteStyle) bool { return strings.EqualFold(s.value, style.value) }) {
+			continue
+		}
+		unique_styles = append(unique_styles, style)
+	}
+	if len(unique_styles) > favoriteStylesMaxCount {
+		return fmt.Errorf("favorite styles must be at most %d", favoriteStylesMaxCount)
+	}
+	p.favorite_styles = unique_styles
+	p.updated_at = time.Now()
+	return nil
+}
+
+// ReplaceLinks はSNSなどのリンクを置き換えます
+// 重複したリンクは1つにまとめ、5件を超える場合はエラーを返します
+func (p *Profile) ReplaceLinks(links []ProfileURL) error {
+	var unique_links []ProfileURL
+
+	unique_links = make([]ProfileURL, 0, len(links))
+	for _, link := range links {
+		if slices.Contains(unique_links, link) {
+			continue
+		}
+		unique_links = append(unique_links, link)
+	}
+	if len(unique_links) > profileLinksMaxCount {
+		return fmt.Errorf("links must be at most %d", profileLinksMaxCount)
+	}
+	p.links = unique_links
+	p.updated_at = time.Now()
+	return nil
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewDisplayName(t *testing.T) {
+	// Arrange
+	var display_name DisplayName
+	var invalid_names []string
+	var err error
+
+	// Act & Assert
+	display_name, err = NewDisplayName("  スリーブ太郎  ")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if display_name.Value() != "スリーブ太郎" {
+		t.Errorf("expected trimmed display name, got %q", display_name.Value())
+	}
+	_, err = NewDisplayName(strings.Repeat("あ", 50))
+	if err != nil {
+		t.Errorf("expected 50 characters to be allowed, got %v", err)
+	}
+	invalid_names = []string{"", "   ", strings.Repeat("あ", 51), "name\x00"}
+	for _, invalid_name := range invalid_names {
+		_, err = NewDisplayName(invalid_name)
+		if err == nil {
+			t.Errorf("expected error for display name %q, got nil", invalid_name)
+		}
+	}
+}
+
+func TestNewBio(t *testing.T) {
+	// Arrange
+	var bio Bio
+	var err error
+
+	// Act & Assert
+	bio, err = NewBio("")
+	if err != nil || bio.Value() != "" {
+		t.Errorf("expected empty bio to be allowed, got %q, %v", bio.Value(), err)
+	}
+	_, err = NewBio("1行目\n2行目")
+	if err != nil {
+		t.Errorf("expected newline to be allowed, got %v", err)
+	}
+	_, err = NewBio(strings.Repeat("a", 501))
+	if err == nil {
+		t.Error("expected error for bio over 500 characters, got nil")
+	}
+	_, err = NewBio("bio\x07")
+	if err == nil {
+		t.Error("expected error for bio with control characters, got nil")
+	}
+}
+
+func TestNewProfileURL(t *testing.T) {
+	// Arrange
+	var valid_urls []string
+	var invalid_urls []string
+	var err error
+
+	valid_urls = []string{"https://example.com/avatar.png", "http://instagram.com/sleeve"}
+	invalid_urls = []string{"", "javascript:alert(1)", "ftp://example.com", "https://", "/relative/path", "https://" + strings.Repeat("a", 2048)}
+	// Act & Assert
+	for _, valid_url := range valid_urls {
+		_, err = NewProfileURL(valid_url)
+		if err != nil {
+			t.Errorf("expected no error for url %s, got %v", valid_url, err)
+		}
+	}
+	for _, invalid_url := range invalid_urls {
+		_, err = NewProfileURL(invalid_url)
+		if err == nil {
+			t.Errorf("expected error for url %q, got nil", invalid_url)
+		}
+	}
+}
+
+func TestNewHeight(t *testing.T) {
+	// Arrange
+	var height Height
+	var err error
+
+	// Act & Assert
+	height, err = NewHeight(170)
+	if err != nil || height.Centimeters() != 170 {
+		t.Errorf("expected height 170, got %d, %v", height.Centimeters(), err)
+	}
+	_, err = NewHeight(49)
+	if err == nil {
+		t.Error("expected error for height under 50, got nil")
+	}
+	_, err = NewHeight(251)
+	if err == nil {
+		t.Error("expected error for height over 250, got nil")
+	}
+}
+
+func TestProfile_ReplaceFavoriteStyles(t *testing.T) {
+	// Arrange
+	var profile *Profile
+	var display_name DisplayName
+	var styles []FavoriteStyle
+	var err error
+
+	display_name, _ = NewDisplayName("taro")
+	profile, _ = NewProfile(uuid.New(), display_name)
+	for _, value := range []string{"Casual", "casual", "Street"} {
+		var style FavoriteStyle
+
+		style, _ = NewFavoriteStyle(value)
+		styles = append(styles, style)
+	}
+	// Act
+	err = profile.ReplaceFavoriteStyles(styles)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(profile.FavoriteStyles()) != 2 {
+		t.Errorf("expected duplicated styles to be merged, got %d", len(profile.FavoriteStyles()))
+	}
+	for i := 0; i < 10; i++ {
+		var style FavoriteStyle
+
+		style, _ = NewFavoriteStyle(strings.Repeat("s", i+1))
+		styles = append(styles, style)
+	}
+	err = profile.ReplaceFavoriteStyles(styles)
+	if err == nil {
+		t.Error("expected error for more than 10 styles, got nil")
+	}
+}
+
+func TestProfile_ReplaceLinks(t *testing.T) {
+	// Arrange
+	var profile *Profile
+	var display_name DisplayName
+	var links []ProfileURL
+	var err error
+
+	display_name, _ = NewDisplayName("taro")
+	profile, _ = NewProfile(uuid.New(), display_name)
+	for i := 0; i < 6; i++ {
+		var link ProfileURL
+
+		link, _ = NewProfileURL("https://example.com/" + strings.Repeat("a", i+1))
+		links = append(links, link)
+	}
+	// Act & Assert
+	err = profile.ReplaceLinks(links)
+	if err == nil {
+		t.Error("expected error for more than 5 links, got nil")
+	}
+	err = profile.ReplaceLinks(append(links[:2], links[0]))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(profile.Links()) != 2 {
+		t.Errorf("expected duplicated links to be merged, got %d", len(profile.Links()))
+	}
+}
+
+func TestNewProfileWithState_EmptyUserID(t *testing.T) {
+	// Arrange
+	var display_name DisplayName
+	var err error
+
+	display_name, _ = NewDisplayName("taro")
+	// Act
+	_, err = NewProfile(uuid.Nil, display_name)
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty user_id, got nil")
+	}
+}
+
+func TestProfile_RestrictedView(t *testing.T) {
+	// Arrange
+	var display_name DisplayName
+	var height Height
+	var style FavoriteStyle
+	var link ProfileURL
+	var profile *Profile
+	var restricted *Profile
+
+	display_name, _ = NewDisplayName("taro")
+	height, _ = NewHeight(170)
+	style, _ = NewFavoriteStyle("street")
+	link, _ = NewProfileURL("https://example.com/taro")
+	profile, _ = NewProfileWithState(uuid.New(), display_name, Bio{value: "hello"}, &link, &height, []FavoriteStyle{style}, []ProfileURL{link}, time.Now(), time.Now())
+	// Act
+	restricted = profile.RestrictedView()
+	// Assert
+	if !restricted.IsRestricted() || profile.IsRestricted() {
+		t.Errorf("expected only the view to be restricted, got view=%v original=%v", restricted.IsRestricted(), profile.IsRestricted())
+	}
+	if restricted.DisplayName() != display_name || restricted.Bio().Value() != "hello" || restricted.AvatarURL() == nil {
+		t.Error("expected display name, bio and avatar to be kept")
+	}
+	if restricted.Height() != nil || len(restricted.FavoriteStyles()) != 0 || len(restricted.Links()) != 0 {
+		t.Errorf("expected height, styles and links to be removed, got %v %v %v", restricted.Height(), restricted.FavoriteStyles(), restricted.Links())
+	}
+	if len(profile.Links()) != 1 {
+		t.Errorf("expected original profile to be unchanged, got %d links", len(profile.Links()))
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// リカバリーコードの設定
+const (
+	// RecoveryCodeCount は一度に発行するリカバリーコードの数です
+	RecoveryCodeCount = 10
+	// recoveryCodeLength はリカバリーコードの文字数です（区切りのハイフンを除く）
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet はリカバリーコードに使う文字です（32文字のため乱数のバイトを偏りなく割り当てられる）
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+// GenerateRecoveryCodes は二要素認証のリカバリーコードを発行します
+// コードは「xxxxx-xxxxx」形式の10文字（50ビット）で、平文はユーザーへの表示にのみ使用します
+func GenerateRecoveryCodes() ([]string, error) {
+	var codes []string
+	var random_bytes []byte
+	var builder strings.Builder
+	var err error
+
+	codes = make([]string, 0, RecoveryCodeCount)
+	random_bytes = make([]byte, recoveryCodeLength)
+	for range RecoveryCodeCount {
+		_, err = rand.Read(random_bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		builder.Reset()
+		for i, random_byte := range random_bytes {
+			if i == recoveryCodeLength/2 {
+				builder.WriteByte('-')
+			}
+			builder.WriteByte(recoveryCodeAlphabet[int(random_byte)%len(recoveryCodeAlphabet)])
+		}
+		codes = append(codes, builder.String())
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode はリカバリーコードを保存用のハッシュ（SHA-256）に変換します
+// 大文字・小文字、ハイフン、空白の違いは無視します
+// コードは十分なエントロピーを持つランダム値のため、パスワードのようなストレッチングは行いません
+func HashRecoveryCode(code string) string {
+	var normalized string
+	var sum [sha256.Size]byte
+
+	normalized = strings.ToLower(code)
+	normalized = strings.NewReplacer("-", "", " ", "").Replace(normalized)
+	sum = sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
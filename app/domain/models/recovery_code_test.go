@@ -0,0 +1,52 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestGenerateRecoveryCodes_Success(t *testing.T) {
+	// Arrange
+	var codes []string
+	var code_pattern *regexp.Regexp
+	var seen map[string]bool
+	var err error
+
+	code_pattern = regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`)
+	seen = map[string]bool{}
+	// Act
+	codes, err = GenerateRecoveryCodes()
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(codes) != RecoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d", RecoveryCodeCount, len(codes))
+	}
+	for _, code := range codes {
+		if !code_pattern.MatchString(code) {
+			t.Errorf("unexpected recovery code format: %s", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate recovery code: %s", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestHashRecoveryCode_Normalize(t *testing.T) {
+	// Arrange & Act
+	var hash string
+
+	hash = HashRecoveryCode("abcde-fghij")
+	// Assert
+	if hash != HashRecoveryCode("ABCDE FGHIJ") || hash != HashRecoveryCode("abcdefghij") {
+		t.Error("expected hash to ignore case, hyphens and spaces")
+	}
+	if hash == "abcde-fghij" || len(hash) != 64 {
+		t.Errorf("expected sha-256 hex hash, got %s", hash)
+	}
+	if hash == HashRecoveryCode("abcde-fghik") {
+		t.Error("expected different codes to have different hashes")
+	}
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken はトークンファミリーに属するリフレッシュトークンを表すエンティティです
+// トークン本体は保存せず、JWTのjti（token_id）のみを保持します
+type RefreshToken struct {
+	token_id   string
+	family_id  uuid.UUID
+	user_id    uuid.UUID
+	expires_at time.Time
+	rotated_at *time.Time
+	revoked_at *time.Time
+	created_at time.Time
+}
+
+// NewRefreshToken は新しいRefreshTokenエンティティを作成します
+func NewRefreshToken(token_id string, family_id uuid.UUID, user_id uuid.UUID, expires_at time.Time) (*RefreshToken, error) {
+	if token_id == "" {
+		return nil, fmt.Errorf("token_id cannot be empty")
+	}
+	if family_id == uuid.Nil {
+		return nil, fmt.Errorf("family_id cannot be empty")
+	}
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	return &RefreshToken{
+		token_id:   token_id,
+		family_id:  family_id,
+		user_id:    user_id,
+		expires_at: expires_at,
+		rotated_at: nil,
+		revoked_at: nil,
+		created_at: time.Now(),
+	}, nil
+}
+
+// NewRefreshTokenWithStatus はローテーション・失効状態を持つRefreshTokenエンティティを作成します（DBからの復元用）
+func NewRefreshTokenWithStatus(
+	token_id string,
+	family_id uuid.UUID,
+	user_id uuid.UUID,
+	expires_at time.Time,
+	rotated_at *time.Time,
+	revoked_at *time.Time,
+	created_at time.Time,
+) (*RefreshToken, error) {
+	if token_id == "" {
+		return nil, fmt.Errorf("token_id cannot be empty")
+	}
+	return &RefreshToken{
+		token_id:   token_id,
+		family_id:  family_id,
+		user_id:    user_id,
+		expires_at: expires_at,
+		rotated_at: rotated_at,
+		revoked_at: revoked_at,
+		created_at: created_at,
+	}, nil
+}
+
+// TokenID はJWTのjtiを返します
+func (t *RefreshToken) TokenID() string {
+	return t.token_id
+}
+
+// FamilyID はトークンファミリーIDを返します
+func (t *RefreshToken) FamilyID() uuid.UUID {
+	return t.family_id
+}
+
+// UserID はトークンの所有者の公開ユーザーIDを返します
+func (t *RefreshToken) UserID() uuid.UUID {
+	return t.user_id
+}
+
+// ExpiresAt は有効期限を返します
+func (t *RefreshToken) ExpiresAt() time.Time {
+	return t.expires_at
+}
+
+// RotatedAt はローテーション（使用）日時を返します
+func (t *RefreshToken) RotatedAt() *time.Time {
+	return t.rotated_at
+}
+
+// RevokedAt は失効日時を返します
+func (t *RefreshToken) RevokedAt() *time.Time {
+	return t.revoked_at
+}
+
+// CreatedAt は作成日時を返します
+func (t *RefreshToken) CreatedAt() time.Time {
+	return t.created_at
+}
+
+// IsRotated はトークンが既にローテーション済み（使用済み）かどうかを返します
+func (t *RefreshToken) IsRotated() bool {
+	return t.rotated_at != nil
+}
+
+// IsRevoked はトークンが失効済みかどうかを返します
+func (t *RefreshToken) IsRevoked() bool {
+	return t.revoked_at != nil
+}
+
+// IsExpired は指定時刻においてトークンが有効期限切れかどうかを返します
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.expires_at)
+}
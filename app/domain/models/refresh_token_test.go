@@ -0,0 +1,96 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewRefreshToken_Success(t *testing.T) {
+	// Arrange
+	var token_id string
+	var family_id uuid.UUID
+	var user_id uuid.UUID
+	var expires_at time.Time
+	var token *RefreshToken
+	var err error
+
+	token_id = uuid.NewString()
+	family_id = uuid.New()
+	user_id = uuid.New()
+	expires_at = time.Now().Add(time.Hour)
+	// Act
+	token, err = NewRefreshToken(token_id, family_id, user_id, expires_at)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if token.TokenID() != token_id {
+		t.Errorf("expected token_id %s, got %s", token_id, token.TokenID())
+	}
+	if token.FamilyID() != family_id {
+		t.Errorf("expected family_id %s, got %s", family_id, token.FamilyID())
+	}
+	if token.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, token.UserID())
+	}
+	if token.IsRotated() {
+		t.Error("expected new token to not be rotated")
+	}
+	if token.IsRevoked() {
+		t.Error("expected new token to not be revoked")
+	}
+}
+
+func TestNewRefreshToken_InvalidArguments(t *testing.T) {
+	// Arrange
+	var expires_at time.Time
+	var err error
+
+	expires_at = time.Now().Add(time.Hour)
+	// Act & Assert
+	_, err = NewRefreshToken("", uuid.New(), uuid.New(), expires_at)
+	if err == nil {
+		t.Error("expected error for empty token_id, got nil")
+	}
+	_, err = NewRefreshToken(uuid.NewString(), uuid.Nil, uuid.New(), expires_at)
+	if err == nil {
+		t.Error("expected error for empty family_id, got nil")
+	}
+	_, err = NewRefreshToken(uuid.NewString(), uuid.New(), uuid.Nil, expires_at)
+	if err == nil {
+		t.Error("expected error for empty user_id, got nil")
+	}
+}
+
+func TestRefreshToken_Status(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var rotated *RefreshToken
+	var revoked *RefreshToken
+	var err error
+
+	now = time.Now()
+	rotated, err = NewRefreshTokenWithStatus(uuid.NewString(), uuid.New(), uuid.New(), now.Add(time.Hour), &now, nil, now)
+	if err != nil {
+		t.Fatalf("failed to create rotated token: %v", err)
+	}
+	revoked, err = NewRefreshTokenWithStatus(uuid.NewString(), uuid.New(), uuid.New(), now.Add(-time.Hour), nil, &now, now)
+	if err != nil {
+		t.Fatalf("failed to create revoked token: %v", err)
+	}
+	// Act & Assert
+	if !rotated.IsRotated() {
+		t.Error("expected rotated token to be rotated")
+	}
+	if rotated.IsExpired(now) {
+		t.Error("expected rotated token to not be expired")
+	}
+	if !revoked.IsRevoked() {
+		t.Error("expected revoked token to be revoked")
+	}
+	if !revoked.IsExpired(now) {
+		t.Error("expected revoked token to be expired")
+	}
+}
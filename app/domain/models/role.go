@@ -0,0 +1,29 @@
+package models
+
+import "fmt"
+
+// Role はユーザーの権限ロールを表します
+type Role string
+
+const (
+	// RoleUser は一般ユーザーです
+	RoleUser Role = "user"
+	// RoleAdmin は管理者です
+	RoleAdmin Role = "admin"
+)
+
+// NewRole は文字列からRoleを作成します
+func NewRole(value string) (Role, error) {
+	var role Role
+
+	role = Role(value)
+	if role != RoleUser && role != RoleAdmin {
+		return "", fmt.Errorf("invalid role: %s", value)
+	}
+	return role, nil
+}
+
+// String はロールの文字列表現を返します
+func (r Role) String() string {
+	return string(r)
+}
@@ -0,0 +1,31 @@
+package models
+
+import "testing"
+
+func TestNewRole_Success(t *testing.T) {
+	// Arrange
+	var role Role
+	var err error
+
+	// Act
+	role, err = NewRole("admin")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if role != RoleAdmin {
+		t.Errorf("expected role %s, got %s", RoleAdmin, role)
+	}
+}
+
+func TestNewRole_Invalid(t *testing.T) {
+	// Arrange
+	var err error
+
+	// Act
+	_, err = NewRole("owner")
+	// Assert
+	if err == nil {
+		t.Error("expected error for invalid role, got nil")
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// secretTokenBytes はメールで送信するコード・リンクのトークンの乱数のバイト数です
+const secretTokenBytes = 32
+
+// generate_secret_token はURLに含められるランダムなトークンを発行します
+func generate_secret_token() (string, error) {
+	var random_bytes []byte
+	var err error
+
+	random_bytes = make([]byte, secretTokenBytes)
+	_, err = rand.Read(random_bytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random_bytes), nil
+}
+
+// hash_secret_token はトークンを保存用のハッシュ（SHA-256）に変換します
+// トークンは十分なエントロピーを持つランダム値のため、パスワードのようなストレッチングは行いません
+func hash_secret_token(token string) string {
+	var sum [sha256.Size]byte
+
+	sum = sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"time"
+)
+
+// SecurityEventType はセキュリティイベントの種類を表します
+type SecurityEventType string
+
+const (
+	// SecurityEventAuthFailure は認証の失敗です
+	SecurityEventAuthFailure SecurityEventType = "auth_failure"
+	// SecurityEventAuthLockout は失敗回数が閾値に達し、ロックアウトを開始したことを表します
+	SecurityEventAuthLockout SecurityEventType = "auth_lockout"
+	// SecurityEventAuthThrottled は待ち時間中・ロックアウト中の試行を拒否したことを表します
+	SecurityEventAuthThrottled SecurityEventType = "auth_throttled"
+)
+
+// SecurityEvent は不正検知（fraud）の処理に連携するセキュリティイベントです
+type SecurityEvent struct {
+	event_type    SecurityEventType
+	action        AuthAction
+	keys          []AttemptKey
+	client_ip     string
+	user_agent    string
+	failure_count int
+	retry_after   time.Duration
+	occurred_at   time.Time
+}
+
+// NewSecurityEvent は新しいSecurityEventを作成します
+// failure_countは対象のキーのうち最も多い失敗回数、retry_afterは次の試行を受け付けるまでの待ち時間です
+func NewSecurityEvent(
+	event_type SecurityEventType,
+	action AuthAction,
+	keys []AttemptKey,
+	client_ip string,
+	user_agent string,
+	failure_count int,
+	retry_after time.Duration,
+	occurred_at time.Time,
+) *SecurityEvent {
+	return &SecurityEvent{
+		event_type:    event_type,
+		action:        action,
+		keys:          keys,
+		client_ip:     client_ip,
+		user_agent:    user_agent,
+		failure_count: failure_count,
+		retry_after:   retry_after,
+		occurred_at:   occurred_at,
+	}
+}
+
+// Type はイベントの種類を返します
+func (e *SecurityEvent) Type() SecurityEventType {
+	return e.event_type
+}
+
+// Action は対象の認証の操作を返します
+func (e *SecurityEvent) Action() AuthAction {
+	return e.action
+}
+
+// Keys は試行回数を数えたキーを返します
+func (e *SecurityEvent) Keys() []AttemptKey {
+	return e.keys
+}
+
+// ClientIP はリクエスト元のIPアドレスを返します
+func (e *SecurityEvent) ClientIP() string {
+	return e.client_ip
+}
+
+// UserAgent はリクエスト元のUser-Agentを返します
+func (e *SecurityEvent) UserAgent() string {
+	return e.user_agent
+}
+
+// FailureCount は対象のキーのうち最も多い失敗回数を返します
+func (e *SecurityEvent) FailureCount() int {
+	return e.failure_count
+}
+
+// RetryAfter は次の試行を受け付けるまでの待ち時間を返します
+func (e *SecurityEvent) RetryAfter() time.Duration {
+	return e.retry_after
+}
+
+// OccurredAt はイベントの発生日時を返します
+func (e *SecurityEvent) OccurredAt() time.Time {
+	return e.occurred_at
+}
@@ -0,0 +1,226 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// SessionPlatform はセッションの端末のプラットフォームを表します
+type SessionPlatform string
+
+const (
+	// SessionPlatformIOS はiOSアプリです
+	SessionPlatformIOS SessionPlatform = "ios"
+	// SessionPlatformAndroid はAndroidアプリです
+	SessionPlatformAndroid SessionPlatform = "android"
+	// SessionPlatformWeb はWebブラウザです
+	SessionPlatformWeb SessionPlatform = "web"
+	// SessionPlatformUnknown は判別できない端末です
+	SessionPlatformUnknown SessionPlatform = "unknown"
+)
+
+// sessionDeviceValueMaxLength は端末名・User-Agentとして保存する最大文字数です（長すぎる値は切り詰めます）
+const sessionDeviceValueMaxLength = 255
+
+// NewSessionPlatform は文字列からSessionPlatformを作成します
+func NewSessionPlatform(value string) (SessionPlatform, error) {
+	var platform SessionPlatform
+
+	platform = SessionPlatform(strings.ToLower(value))
+	switch platform {
+	case SessionPlatformIOS, SessionPlatformAndroid, SessionPlatformWeb, SessionPlatformUnknown:
+		return platform, nil
+	default:
+		return "", fmt.Errorf("invalid session platform: %s", value)
+	}
+}
+
+// GuessSessionPlatform はUser-Agentから端末のプラットフォームを推定します
+func GuessSessionPlatform(user_agent string) SessionPlatform {
+	var lower_user_agent string
+
+	lower_user_agent = strings.ToLower(user_agent)
+	switch {
+	case strings.Contains(lower_user_agent, "iphone"), strings.Contains(lower_user_agent, "ipad"), strings.Contains(lower_user_agent, "ios"):
+		return SessionPlatformIOS
+	case strings.Contains(lower_user_agent, "android"):
+		return SessionPlatformAndroid
+	case strings.HasPrefix(lower_user_agent, "mozilla/"):
+		return SessionPlatformWeb
+	default:
+		return SessionPlatformUnknown
+	}
+}
+
+// String はプラットフォームの文字列表現を返します
+func (p SessionPlatform) String() string {
+	return string(p)
+}
+
+// SessionDevice はセッションを開始した端末の情報を表す値オブジェクトです
+type SessionDevice struct {
+	name       string
+	platform   SessionPlatform
+	ip_address string
+	user_agent string
+}
+
+// NewSessionDevice は端末の情報からSessionDeviceを作成します
+// プラットフォームが不正・未指定の場合はUser-Agentから推定し、端末名とUser-Agentは最大文字数で切り詰めます
+func NewSessionDevice(name string, platform string, ip_address string, user_agent string) SessionDevice {
+	var session_platform SessionPlatform
+	var err error
+
+	session_platform, err = NewSessionPlatform(platform)
+	if err != nil || session_platform == SessionPlatformUnknown {
+		session_platform = GuessSessionPlatform(user_agent)
+	}
+	return SessionDevice{
+		name:       truncate_session_device_value(strings.TrimSpace(name)),
+		platform:   session_platform,
+		ip_address: ip_address,
+		user_agent: truncate_session_device_value(user_agent),
+	}
+}
+
+// NewSessionDeviceWithPlatform は保存済みの端末の情報からSessionDeviceを作成します（DBからの復元用）
+func NewSessionDeviceWithPlatform(name string, platform SessionPlatform, ip_address string, user_agent string) SessionDevice {
+	return SessionDevice{
+		name:       name,
+		platform:   platform,
+		ip_address: ip_address,
+		user_agent: user_agent,
+	}
+}
+
+// truncate_session_device_value は文字列を最大文字数で切り詰めます
+func truncate_session_device_value(value string) string {
+	if utf8.RuneCountInString(value) <= sessionDeviceValueMaxLength {
+		return value
+	}
+	return string([]rune(value)[:sessionDeviceValueMaxLength])
+}
+
+// Name は端末名を返します（未指定の場合は空文字）
+func (d SessionDevice) Name() string {
+	return d.name
+}
+
+// Platform は端末のプラットフォームを返します
+func (d SessionDevice) Platform() SessionPlatform {
+	return d.platform
+}
+
+// IPAddress はIPアドレスを返します（不明な場合は空文字）
+func (d SessionDevice) IPAddress() string {
+	return d.ip_address
+}
+
+// UserAgent はUser-Agentを返します（不明な場合は空文字）
+func (d SessionDevice) UserAgent() string {
+	return d.user_agent
+}
+
+// Session はログイン中の端末を表すエンティティです
+// セッションIDはJWTのsidクレーム・リフレッシュトークンのfamily_idと同じ値で、ログイン単位で発行します
+type Session struct {
+	session_id   uuid.UUID
+	user_id      uuid.UUID
+	device       SessionDevice
+	last_seen_at time.Time
+	expires_at   time.Time
+	revoked_at   *time.Time
+	created_at   time.Time
+}
+
+// NewSession はログイン時に新しいSessionエンティティを作成します
+func NewSession(session_id uuid.UUID, user_id uuid.UUID, device SessionDevice, now time.Time, expires_at time.Time) (*Session, error) {
+	if session_id == uuid.Nil {
+		return nil, fmt.Errorf("session_id cannot be empty")
+	}
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	return &Session{
+		session_id:   session_id,
+		user_id:      user_id,
+		device:       device,
+		last_seen_at: now,
+		expires_at:   expires_at,
+		revoked_at:   nil,
+		created_at:   now,
+	}, nil
+}
+
+// NewSessionWithStatus は最終利用日時・失効状態を持つSessionエンティティを作成します（DBからの復元用）
+func NewSessionWithStatus(
+	session_id uuid.UUID,
+	user_id uuid.UUID,
+	device SessionDevice,
+	last_seen_at time.Time,
+	expires_at time.Time,
+	revoked_at *time.Time,
+	created_at time.Time,
+) (*Session, error) {
+	if session_id == uuid.Nil {
+		return nil, fmt.Errorf("session_id cannot be empty")
+	}
+	return &Session{
+		session_id:   session_id,
+		user_id:      user_id,
+		device:       device,
+		last_seen_at: last_seen_at,
+		expires_at:   expires_at,
+		revoked_at:   revoked_at,
+		created_at:   created_at,
+	}, nil
+}
+
+// SessionID はセッションIDを返します
+func (s *Session) SessionID() uuid.UUID {
+	return s.session_id
+}
+
+// UserID はセッションの所有者の公開ユーザーIDを返します
+func (s *Session) UserID() uuid.UUID {
+	return s.user_id
+}
+
+// Device はセッションを開始した端末の情報を返します
+func (s *Session) Device() SessionDevice {
+	return s.device
+}
+
+// LastSeenAt は最終利用日時を返します
+func (s *Session) LastSeenAt() time.Time {
+	return s.last_seen_at
+}
+
+// ExpiresAt は有効期限を返します
+func (s *Session) ExpiresAt() time.Time {
+	return s.expires_at
+}
+
+// RevokedAt は失効日時を返します
+func (s *Session) RevokedAt() *time.Time {
+	return s.revoked_at
+}
+
+// CreatedAt は作成日時（ログイン日時）を返します
+func (s *Session) CreatedAt() time.Time {
+	return s.created_at
+}
+
+// IsActive は指定時刻においてセッションが未失効かつ有効期限内かどうかを返します
+func (s *Session) IsActive(now time.Time) bool {
+	return s.revoked_at == nil && now.Before(s.expires_at)
+}
+
+// IsOwnedBy はセッションが指定したユーザーのものかどうかを返します
+func (s *Session) IsOwnedBy(user_id uuid.UUID) bool {
+	return s.user_id == user_id
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSession_Success(t *testing.T) {
+	// Arrange
+	var session_id uuid.UUID
+	var user_id uuid.UUID
+	var now time.Time
+	var session *Session
+	var err error
+
+	session_id = uuid.New()
+	user_id = uuid.New()
+	now = time.Now()
+	// Act
+	session, err = NewSession(session_id, user_id, NewSessionDevice("My iPhone", "ios", "192.0.2.1", "SleeveApp/1.0"), now, now.Add(time.Hour))
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if session.SessionID() != session_id {
+		t.Errorf("expected session_id %s, got %s", session_id, session.SessionID())
+	}
+	if !session.IsOwnedBy(user_id) {
+		t.Errorf("expected session to be owned by %s", user_id)
+	}
+	if session.Device().Name() != "My iPhone" || session.Device().Platform() != SessionPlatformIOS {
+		t.Errorf("unexpected device: %+v", session.Device())
+	}
+	if !session.LastSeenAt().Equal(now) {
+		t.Errorf("expected last_seen_at %v, got %v", now, session.LastSeenAt())
+	}
+	if !session.IsActive(now) {
+		t.Error("expected new session to be active")
+	}
+	if session.IsActive(now.Add(time.Hour)) {
+		t.Error("expected session to be inactive after expires_at")
+	}
+}
+
+func TestNewSession_InvalidArguments(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var err error
+
+	now = time.Now()
+	// Act & Assert
+	_, err = NewSession(uuid.Nil, uuid.New(), SessionDevice{}, now, now.Add(time.Hour))
+	if err == nil {
+		t.Error("expected error for empty session_id, got nil")
+	}
+	_, err = NewSession(uuid.New(), uuid.Nil, SessionDevice{}, now, now.Add(time.Hour))
+	if err == nil {
+		t.Error("expected error for empty user_id, got nil")
+	}
+}
+
+func TestSession_IsActive_Revoked(t *testing.T) {
+	// Arrange
+	var now time.Time
+	var session *Session
+	var err error
+
+	now = time.Now()
+	session, err = NewSessionWithStatus(uuid.New(), uuid.New(), SessionDevice{}, now, now.Add(time.Hour), &now, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Act & Assert
+	if session.IsActive(now) {
+		t.Error("expected revoked session to be inactive")
+	}
+}
+
+func TestNewSessionDevice_GuessPlatform(t *testing.T) {
+	// Arrange
+	var test_cases []struct {
+		platform   string
+		user_agent string
+		expected   SessionPlatform
+	}
+
+	test_cases = []struct {
+		platform   string
+		user_agent string
+		expected   SessionPlatform
+	}{
+		{platform: "ANDROID", user_agent: "", expected: SessionPlatformAndroid},
+		{platform: "", user_agent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)", expected: SessionPlatformIOS},
+		{platform: "invalid", user_agent: "Mozilla/5.0 (Linux; Android 14)", expected: SessionPlatformAndroid},
+		{platform: "", user_agent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64)", expected: SessionPlatformWeb},
+		{platform: "", user_agent: "curl/8.0", expected: SessionPlatformUnknown},
+	}
+	// Act & Assert
+	for _, test_case := range test_cases {
+		var device SessionDevice
+
+		device = NewSessionDevice("", test_case.platform, "", test_case.user_agent)
+		if device.Platform() != test_case.expected {
+			t.Errorf("expected platform %s for (%s, %s), got %s", test_case.expected, test_case.platform, test_case.user_agent, device.Platform())
+		}
+	}
+}
+
+func TestNewSessionDevice_TruncatesLongValues(t *testing.T) {
+	// Arrange
+	var long_value string
+	var device SessionDevice
+
+	long_value = strings.Repeat("あ", sessionDeviceValueMaxLength+10)
+	// Act
+	device = NewSessionDevice(long_value, "web", "192.0.2.1", long_value)
+	// Assert
+	if len([]rune(device.Name())) != sessionDeviceValueMaxLength {
+		t.Errorf("expected name to be truncated to %d characters, got %d", sessionDeviceValueMaxLength, len([]rune(device.Name())))
+	}
+	if len([]rune(device.UserAgent())) != sessionDeviceValueMaxLength {
+		t.Errorf("expected user_agent to be truncated to %d characters, got %d", sessionDeviceValueMaxLength, len([]rune(device.UserAgent())))
+	}
+}
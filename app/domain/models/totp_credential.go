@@ -0,0 +1,127 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TotpCredential はユーザーの二要素認証（TOTP）の認証情報を表すエンティティです
+// 共有シークレットは暗号化した値、リカバリーコードはハッシュのみを保持します
+type TotpCredential struct {
+	user_id              uuid.UUID
+	encrypted_secret     string
+	recovery_code_hashes []string
+	last_used_step       int64
+	created_at           time.Time
+	// 永続化済みの状態（DBの状態が読み込み後に変更されていないことを更新時に確認するために保持します）
+	persisted_recovery_code_hashes []string
+	persisted_last_used_step       int64
+}
+
+// NewTotpCredential は新しいTotpCredentialエンティティを作成します（登録開始時）
+func NewTotpCredential(user_id uuid.UUID, encrypted_secret string) (*TotpCredential, error) {
+	return NewTotpCredentialWithState(user_id, encrypted_secret, []string{}, 0, time.Now())
+}
+
+// NewTotpCredentialWithState はリカバリーコードと使用状況を持つTotpCredentialエンティティを作成します（DBからの復元用）
+func NewTotpCredentialWithState(
+	user_id uuid.UUID,
+	encrypted_secret string,
+	recovery_code_hashes []string,
+	last_used_step int64,
+	created_at time.Time,
+) (*TotpCredential, error) {
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	if encrypted_secret == "" {
+		return nil, fmt.Errorf("encrypted_secret cannot be empty")
+	}
+	return &TotpCredential{
+		user_id:              user_id,
+		encrypted_secret:     encrypted_secret,
+		recovery_code_hashes: slices.Clone(recovery_code_hashes),
+		last_used_step:       last_used_step,
+		created_at:           created_at,
+
+		persisted_recovery_code_hashes: slices.Clone(recovery_code_hashes),
+		persisted_last_used_step:       last_used_step,
+	}, nil
+}
+
+// UserID はユーザーの公開IDを返します
+func (c *TotpCredential) UserID() uuid.UUID {
+	return c.user_id
+}
+
+// EncryptedSecret は暗号化した共有シークレットを返します
+func (c *TotpCredential) EncryptedSecret() string {
+	return c.encrypted_secret
+}
+
+// RecoveryCodeHashes は未使用のリカバリーコードのハッシュを返します
+func (c *TotpCredential) RecoveryCodeHashes() []string {
+	return slices.Clone(c.recovery_code_hashes)
+}
+
+// LastUsedStep は最後に使用された認証コードのタイムステップを返します
+func (c *TotpCredential) LastUsedStep() int64 {
+	return c.last_used_step
+}
+
+// CreatedAt は作成日時を返します
+func (c *TotpCredential) CreatedAt() time.Time {
+	return c.created_at
+}
+
+// PersistedRecoveryCodeHashes は永続化済みのリカバリーコードのハッシュを返します
+func (c *TotpCredential) PersistedRecoveryCodeHashes() []string {
+	return slices.Clone(c.persisted_recovery_code_hashes)
+}
+
+// PersistedLastUsedStep は永続化済みの最後に使用された認証コードのタイムステップを返します
+func (c *TotpCredential) PersistedLastUsedStep() int64 {
+	return c.persisted_last_used_step
+}
+
+// MarkPersisted は現在の状態を永続化済みとして記録します（保存・更新の成功後に呼び出します）
+func (c *TotpCredential) MarkPersisted() {
+	c.persisted_recovery_code_hashes = slices.Clone(c.recovery_code_hashes)
+	c.persisted_last_used_step = c.last_used_step
+}
+
+// RecordUsedStep は認証に使用したタイムステップを記録します
+// 使用済みのタイムステップ以前のコードは再利用とみなしてfalseを返します
+func (c *TotpCredential) RecordUsedStep(step int64) bool {
+	if step <= c.last_used_step {
+		return false
+	}
+	c.last_used_step = step
+	return true
+}
+
+// ReplaceRecoveryCodes はリカバリーコードを新しいコードに置き換えます（以前のコードは使用できなくなります）
+func (c *TotpCredential) ReplaceRecoveryCodes(codes []string) {
+	c.recovery_code_hashes = make([]string, 0, len(codes))
+	for _, code := range codes {
+		c.recovery_code_hashes = append(c.recovery_code_hashes, HashRecoveryCode(code))
+	}
+}
+
+// ConsumeRecoveryCode はリカバリーコードを使用済みにします
+// 一致する未使用のコードがない場合はfalseを返します
+func (c *TotpCredential) ConsumeRecoveryCode(code string) bool {
+	var code_hash string
+	var index int
+
+	code_hash = HashRecoveryCode(code)
+	index = slices.Index(c.recovery_code_hashes, code_hash)
+	if index < 0 {
+		return false
+	}
+	c.recovery_code_hashes = slices.Delete(c.recovery_code_hashes, index, index+1)
+	return true
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTotpCredential_Success(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var credential *TotpCredential
+	var err error
+
+	user_id = uuid.New()
+	// Act
+	credential, err = NewTotpCredential(user_id, "encrypted_secret")
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if credential.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, credential.UserID())
+	}
+	if credential.EncryptedSecret() != "encrypted_secret" {
+		t.Errorf("expected encrypted_secret, got %s", credential.EncryptedSecret())
+	}
+	if len(credential.RecoveryCodeHashes()) != 0 || credential.LastUsedStep() != 0 {
+		t.Error("expected new credential to have no recovery codes and no used step")
+	}
+}
+
+func TestNewTotpCredential_EmptySecret(t *testing.T) {
+	// Arrange & Act
+	var err error
+
+	_, err = NewTotpCredential(uuid.New(), "")
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty secret")
+	}
+}
+
+func TestTotpCredential_RecordUsedStep(t *testing.T) {
+	// Arrange
+	var credential *TotpCredential
+	var err error
+
+	credential, err = NewTotpCredential(uuid.New(), "encrypted_secret")
+	if err != nil {
+		t.Fatalf("failed to create credential: %v", err)
+	}
+	// Act & Assert
+	if !credential.RecordUsedStep(100) {
+		t.Error("expected first use of step to succeed")
+	}
+	if credential.RecordUsedStep(100) {
+		t.Error("expected reuse of same step to fail")
+	}
+	if credential.RecordUsedStep(99) {
+		t.Error("expected use of older step to fail")
+	}
+	if credential.LastUsedStep() != 100 {
+		t.Errorf("expected last_used_step 100, got %d", credential.LastUsedStep())
+	}
+}
+
+func TestTotpCredential_ConsumeRecoveryCode(t *testing.T) {
+	// Arrange
+	var credential *TotpCredential
+	var err error
+
+	credential, err = NewTotpCredential(uuid.New(), "encrypted_secret")
+	if err != nil {
+		t.Fatalf("failed to create credential: %v", err)
+	}
+	credential.ReplaceRecoveryCodes([]string{"aaaaa-bbbbb", "ccccc-ddddd"})
+	// Act & Assert
+	if !credential.ConsumeRecoveryCode("AAAAA-BBBBB") {
+		t.Error("expected recovery code to be consumed")
+	}
+	if credential.ConsumeRecoveryCode("aaaaa-bbbbb") {
+		t.Error("expected used recovery code to be rejected")
+	}
+	if credential.ConsumeRecoveryCode("eeeee-fffff") {
+		t.Error("expected unknown recovery code to be rejected")
+	}
+	if len(credential.RecoveryCodeHashes()) != 1 {
+		t.Errorf("expected 1 remaining recovery code, got %d", len(credential.RecoveryCodeHashes()))
+	}
+	// 再発行すると以前のコードは使えなくなる
+	credential.ReplaceRecoveryCodes([]string{"ggggg-hhhhh"})
+	if credential.ConsumeRecoveryCode("ccccc-ddddd") {
+		t.Error("expected replaced recovery code to be rejected")
+	}
+}
@@ -9,12 +9,16 @@ import (
 
 // User はユーザーを表すエンティティです
 type User struct {
-	public_id    uuid.UUID
-	firebase_uid string
-	email        Email
-	created_at   time.Time
-	updated_at   time.Time
-	deleted_at   *time.Time
+	public_id         uuid.UUID
+	firebase_uid      string
+	email             Email
+	role              Role
+	email_verified_at *time.Time
+	mfa_enabled_at    *time.Time
+	created_at        time.Time
+	updated_at        time.Time
+	deleted_at        *time.Time
+	banned_at         *time.Time
 }
 
 // NewUser は新しいUserエンティティを作成します
@@ -26,12 +30,15 @@ func NewUser(firebase_uid string, email Email) (*User, error) {
 	}
 	now = time.Now()
 	return &User{
-		public_id:    uuid.New(),
-		firebase_uid: firebase_uid,
-		email:        email,
-		created_at:   now,
-		updated_at:   now,
-		deleted_at:   nil,
+		public_id:         uuid.New(),
+		firebase_uid:      firebase_uid,
+		email:             email,
+		role:              RoleUser,
+		email_verified_at: nil,
+		mfa_enabled_at:    nil,
+		created_at:        now,
+		updated_at:        now,
+		deleted_at:        nil,
 	}, nil
 }
 
@@ -40,20 +47,31 @@ func NewUserWithPublicID(
 	public_id uuid.UUID,
 	firebase_uid string,
 	email Email,
+	role Role,
+	email_verified_at *time.Time,
+	mfa_enabled_at *time.Time,
 	created_at time.Time,
 	updated_at time.Time,
 	deleted_at *time.Time,
+	banned_at *time.Time,
 ) (*User, error) {
 	if firebase_uid == "" {
 		return nil, fmt.Errorf("firebase_uid cannot be empty")
 	}
+	if role != RoleUser && role != RoleAdmin {
+		return nil, fmt.Errorf("invalid role: %s", role)
+	}
 	return &User{
-		public_id:    public_id,
-		firebase_uid: firebase_uid,
-		email:        email,
-		created_at:   created_at,
-		updated_at:   updated_at,
-		deleted_at:   deleted_at,
+		public_id:         public_id,
+		firebase_uid:      firebase_uid,
+		email:             email,
+		role:              role,
+		email_verified_at: email_verified_at,
+		mfa_enabled_at:    mfa_enabled_at,
+		created_at:        created_at,
+		updated_at:        updated_at,
+		deleted_at:        deleted_at,
+		banned_at:         banned_at,
 	}, nil
 }
 
@@ -72,6 +90,60 @@ func (u *User) Email() Email {
 	return u.email
 }
 
+// Role は権限ロールを返します
+func (u *User) Role() Role {
+	return u.role
+}
+
+// HasRole はユーザーが指定されたロールを持つかどうかを返します
+// 管理者は全てのロールの権限を持ちます
+func (u *User) HasRole(role Role) bool {
+	return u.role == role || u.role == RoleAdmin
+}
+
+// EmailVerifiedAt はメールアドレスの確認日時を返します（未確認の場合はnil）
+func (u *User) EmailVerifiedAt() *time.Time {
+	return u.email_verified_at
+}
+
+// IsEmailVerified はメールアドレスの確認が完了しているかどうかを返します
+func (u *User) IsEmailVerified() bool {
+	return u.email_verified_at != nil
+}
+
+// MarkEmailVerified はメールアドレスを確認済みにします（確認済みの場合は確認日時を変更しません）
+func (u *User) MarkEmailVerified(verified_at time.Time) {
+	if u.email_verified_at != nil {
+		return
+	}
+	u.email_verified_at = &verified_at
+}
+
+// MfaEnabledAt は二要素認証（TOTP）を有効にした日時を返します（無効の場合はnil）
+func (u *User) MfaEnabledAt() *time.Time {
+	return u.mfa_enabled_at
+}
+
+// IsMfaEnabled は二要素認証（TOTP）が有効かどうかを返します
+func (u *User) IsMfaEnabled() bool {
+	return u.mfa_enabled_at != nil
+}
+
+// IsMfaRequired は二要素認証の設定が必須のユーザー（管理者）かどうかを返します
+func (u *User) IsMfaRequired() bool {
+	return u.role == RoleAdmin
+}
+
+// EnableMfa は二要素認証を有効にします
+func (u *User) EnableMfa(enabled_at time.Time) {
+	u.mfa_enabled_at = &enabled_at
+}
+
+// DisableMfa は二要素認証を無効にします
+func (u *User) DisableMfa() {
+	u.mfa_enabled_at = nil
+}
+
 // CreatedAt は作成日時を返します
 func (u *User) CreatedAt() time.Time {
 	return u.created_at
@@ -91,3 +163,13 @@ func (u *User) DeletedAt() *time.Time {
 func (u *User) IsDeleted() bool {
 	return u.deleted_at != nil
 }
+
+// BannedAt は利用停止（BAN）にした日時を返します（利用停止されていない場合はnil）
+func (u *User) BannedAt() *time.Time {
+	return u.banned_at
+}
+
+// IsBanned はユーザーが規約違反などで利用停止（BAN）されているかどうかを返します
+func (u *User) IsBanned() bool {
+	return u.banned_at != nil
+}
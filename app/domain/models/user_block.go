@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserBlock はユーザー間のブロックを表すエンティティです
+// ブロックは双方向に作用し、ブロックしたユーザーとブロックされたユーザーはお互いにフォロー・閲覧・やり取りできません
+type UserBlock struct {
+	blocker_id uuid.UUID
+	blocked_id uuid.UUID
+	created_at time.Time
+}
+
+// NewUserBlock は新しいUserBlockエンティティを作成します
+// 自分自身をブロックする場合はエラーを返します
+func NewUserBlock(blocker_id uuid.UUID, blocked_id uuid.UUID, created_at time.Time) (*UserBlock, error) {
+	if blocker_id == uuid.Nil || blocked_id == uuid.Nil {
+		return nil, fmt.Errorf("blocker_id and blocked_id cannot be empty")
+	}
+	if blocker_id == blocked_id {
+		return nil, fmt.Errorf("user cannot block themselves: %s", blocker_id)
+	}
+	return &UserBlock{
+		blocker_id: blocker_id,
+		blocked_id: blocked_id,
+		created_at: created_at,
+	}, nil
+}
+
+// BlockerID はブロックしたユーザーの公開IDを返します
+func (b *UserBlock) BlockerID() uuid.UUID {
+	return b.blocker_id
+}
+
+// BlockedID はブロックされたユーザーの公開IDを返します
+func (b *UserBlock) BlockedID() uuid.UUID {
+	return b.blocked_id
+}
+
+// CreatedAt はブロックした日時を返します
+func (b *UserBlock) CreatedAt() time.Time {
+	return b.created_at
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUserBlock(t *testing.T) {
+	// Arrange
+	var blocker_id uuid.UUID
+	var blocked_id uuid.UUID
+	var user_block *UserBlock
+	var err error
+
+	blocker_id = uuid.New()
+	blocked_id = uuid.New()
+	// Act
+	user_block, err = NewUserBlock(blocker_id, blocked_id, time.Now())
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_block.BlockerID() != blocker_id || user_block.BlockedID() != blocked_id {
+		t.Errorf("expected block from %s to %s, got %s to %s", blocker_id, blocked_id, user_block.BlockerID(), user_block.BlockedID())
+	}
+}
+
+func TestNewUserBlock_Self(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var err error
+
+	user_id = uuid.New()
+	// Act
+	_, err = NewUserBlock(user_id, user_id, time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for blocking yourself, got nil")
+	}
+}
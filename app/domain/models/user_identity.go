@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// lineFirebaseUIDPrefix はLINEでログインしたユーザーのFirebase UIDの接頭辞です
+// LINEはFirebaseのネイティブプロバイダではないため、カスタムトークン用のUIDを「line:<sub>」で発行します
+const lineFirebaseUIDPrefix = "line:"
+
+// IsCustomTokenFirebaseUID はカスタムトークン用に発行したFirebase UIDかを返します
+// クライアントがカスタムトークンでサインインするまで、Firebaseにはこのユーザーが存在しません
+func IsCustomTokenFirebaseUID(firebase_uid string) bool {
+	return strings.HasPrefix(firebase_uid, lineFirebaseUIDPrefix)
+}
+
+// UserIdentity はユーザーに連携された外部プロバイダのアカウントを表すエンティティです
+type UserIdentity struct {
+	user_id   uuid.UUID
+	provider  AuthProvider
+	subject   string
+	linked_at time.Time
+}
+
+// NewUserIdentity は新しいUserIdentityエンティティを作成します
+func NewUserIdentity(user_id uuid.UUID, provider AuthProvider, subject string, linked_at time.Time) (*UserIdentity, error) {
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("subject cannot be empty")
+	}
+	return &UserIdentity{
+		user_id:   user_id,
+		provider:  provider,
+		subject:   subject,
+		linked_at: linked_at,
+	}, nil
+}
+
+// UserID はユーザーの公開IDを返します
+func (i *UserIdentity) UserID() uuid.UUID {
+	return i.user_id
+}
+
+// Provider は外部プロバイダを返します
+func (i *UserIdentity) Provider() AuthProvider {
+	return i.provider
+}
+
+// Subject は外部プロバイダでのユーザー識別子（sub）を返します
+func (i *UserIdentity) Subject() string {
+	return i.subject
+}
+
+// LinkedAt は連携日時を返します
+func (i *UserIdentity) LinkedAt() time.Time {
+	return i.linked_at
+}
+
+// ProviderIdentity は外部プロバイダのIDトークンを検証して得られたアカウント情報です
+type ProviderIdentity struct {
+	provider       AuthProvider
+	subject        string
+	firebase_uid   string
+	email          *Email
+	email_verified bool
+}
+
+// NewProviderIdentity は新しいProviderIdentityを作成します
+// firebase_uidが空の場合（LINEなどFirebaseのネイティブプロバイダではない場合）はsubjectから導出します
+func NewProviderIdentity(
+	provider AuthProvider,
+	subject string,
+	firebase_uid string,
+	email *Email,
+	email_verified bool,
+) (*ProviderIdentity, error) {
+	if subject == "" {
+		return nil, fmt.Errorf("subject cannot be empty")
+	}
+	if firebase_uid == "" {
+		if provider != AuthProviderLINE {
+			return nil, fmt.Errorf("firebase_uid cannot be empty for provider %s", provider)
+		}
+		firebase_uid = lineFirebaseUIDPrefix + subject
+	}
+	return &ProviderIdentity{
+		provider:       provider,
+		subject:        subject,
+		firebase_uid:   firebase_uid,
+		email:          email,
+		email_verified: email != nil && email_verified,
+	}, nil
+}
+
+// Provider は外部プロバイダを返します
+func (i *ProviderIdentity) Provider() AuthProvider {
+	return i.provider
+}
+
+// Subject は外部プロバイダでのユーザー識別子（sub）を返します
+func (i *ProviderIdentity) Subject() string {
+	return i.subject
+}
+
+// FirebaseUID はFirebase UIDを返します
+func (i *ProviderIdentity) FirebaseUID() string {
+	return i.firebase_uid
+}
+
+// Email は外部プロバイダから提供されたメールアドレスを返します（提供されない場合はnil）
+func (i *ProviderIdentity) Email() *Email {
+	return i.email
+}
+
+// IsEmailVerified は外部プロバイダでメールアドレスが確認済みかどうかを返します
+func (i *ProviderIdentity) IsEmailVerified() bool {
+	return i.email_verified
+}
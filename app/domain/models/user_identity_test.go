@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUserIdentity_Success(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var linked_at time.Time
+	var identity *UserIdentity
+	var err error
+
+	user_id = uuid.New()
+	linked_at = time.Now()
+	// Act
+	identity, err = NewUserIdentity(user_id, AuthProviderGoogle, "google_sub_123", linked_at)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if identity.UserID() != user_id || identity.Provider() != AuthProviderGoogle || identity.Subject() != "google_sub_123" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+	if !identity.LinkedAt().Equal(linked_at) {
+		t.Errorf("expected linked_at %v, got %v", linked_at, identity.LinkedAt())
+	}
+}
+
+func TestNewUserIdentity_EmptySubject(t *testing.T) {
+	// Arrange
+	var err error
+
+	// Act
+	_, err = NewUserIdentity(uuid.New(), AuthProviderGoogle, "", time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty subject, got nil")
+	}
+}
+
+func TestNewProviderIdentity_LINE(t *testing.T) {
+	// Arrange
+	var identity *ProviderIdentity
+	var err error
+
+	// Act
+	identity, err = NewProviderIdentity(AuthProviderLINE, "U1234567890", "", nil, true)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if identity.FirebaseUID() != "line:U1234567890" {
+		t.Errorf("expected firebase_uid line:U1234567890, got %s", identity.FirebaseUID())
+	}
+	// メールアドレスがない場合は確認済みとして扱わない
+	if identity.IsEmailVerified() {
+		t.Error("expected email to be not verified without email")
+	}
+}
+
+func TestNewProviderIdentity_FirebaseNativeRequiresUID(t *testing.T) {
+	// Arrange
+	var err error
+
+	// Act
+	_, err = NewProviderIdentity(AuthProviderGoogle, "google_sub_123", "", nil, false)
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty firebase_uid, got nil")
+	}
+}
+
+func TestIsCustomTokenFirebaseUID(t *testing.T) {
+	// Arrange & Act & Assert
+	if !IsCustomTokenFirebaseUID("line:U1234567890") {
+		t.Error("expected line uid to be a custom token uid")
+	}
+	if IsCustomTokenFirebaseUID("firebase_uid_123") {
+		t.Error("expected firebase native uid to not be a custom token uid")
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserMute はユーザー間のミュートを表すエンティティです
+// ミュートはミュートしたユーザーのフィードからミュートされたユーザーを非表示にするだけで、フォローややり取りは制限しません
+type UserMute struct {
+	muter_id   uuid.UUID
+	muted_id   uuid.UUID
+	created_at time.Time
+}
+
+// NewUserMute は新しいUserMuteエンティティを作成します
+// 自分自身をミュートする場合はエラーを返します
+func NewUserMute(muter_id uuid.UUID, muted_id uuid.UUID, created_at time.Time) (*UserMute, error) {
+	if muter_id == uuid.Nil || muted_id == uuid.Nil {
+		return nil, fmt.Errorf("muter_id and muted_id cannot be empty")
+	}
+	if muter_id == muted_id {
+		return nil, fmt.Errorf("user cannot mute themselves: %s", muter_id)
+	}
+	return &UserMute{
+		muter_id:   muter_id,
+		muted_id:   muted_id,
+		created_at: created_at,
+	}, nil
+}
+
+// MuterID はミュートしたユーザーの公開IDを返します
+func (m *UserMute) MuterID() uuid.UUID {
+	return m.muter_id
+}
+
+// MutedID はミュートされたユーザーの公開IDを返します
+func (m *UserMute) MutedID() uuid.UUID {
+	return m.muted_id
+}
+
+// CreatedAt はミュートした日時を返します
+func (m *UserMute) CreatedAt() time.Time {
+	return m.created_at
+}
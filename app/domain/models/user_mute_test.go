@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUserMute(t *testing.T) {
+	// Arrange
+	var muter_id uuid.UUID
+	var muted_id uuid.UUID
+	var user_mute *UserMute
+	var err error
+
+	muter_id = uuid.New()
+	muted_id = uuid.New()
+	// Act
+	user_mute, err = NewUserMute(muter_id, muted_id, time.Now())
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_mute.MuterID() != muter_id || user_mute.MutedID() != muted_id {
+		t.Errorf("expected mute from %s to %s, got %s to %s", muter_id, muted_id, user_mute.MuterID(), user_mute.MutedID())
+	}
+}
+
+func TestNewUserMute_Self(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var err error
+
+	user_id = uuid.New()
+	// Act
+	_, err = NewUserMute(user_id, user_id, time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for muting yourself, got nil")
+	}
+}
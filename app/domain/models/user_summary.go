@@ -0,0 +1,123 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserCounts はユーザーの投稿数・出品数・フォロワー数・評価数を表す値オブジェクトです
+type UserCounts struct {
+	posts     int
+	listings  int
+	followers int
+	ratings   int
+}
+
+// NewUserCounts は新しいUserCountsを作成します
+func NewUserCounts(posts int, listings int, followers int, ratings int) UserCounts {
+	return UserCounts{posts: posts, listings: listings, followers: followers, ratings: ratings}
+}
+
+// Posts はコーデの投稿数を返します
+func (c UserCounts) Posts() int {
+	return c.posts
+}
+
+// Listings はアイテムの出品数を返します
+func (c UserCounts) Listings() int {
+	return c.listings
+}
+
+// Followers はフォロワー数を返します
+func (c UserCounts) Followers() int {
+	return c.followers
+}
+
+// Ratings は取引の評価数を返します
+func (c UserCounts) Ratings() int {
+	return c.ratings
+}
+
+// UserSummary は他のユーザーにも公開するユーザーの概要を表す読み取りモデルです
+// ユーザー一覧・取引相手の表示などで、プロフィールの基本情報と各種の件数をまとめて返すために使用します
+type UserSummary struct {
+	user_id   uuid.UUID
+	handle    *UserHandle
+	profile   *Profile
+	counts    UserCounts
+	joined_at time.Time
+}
+
+// NewUserSummary は新しいUserSummaryを作成します
+// handle・profileは未設定・未作成の場合にnilを指定します
+func NewUserSummary(user_id uuid.UUID, handle *UserHandle, profile *Profile, counts UserCounts, joined_at time.Time) (*UserSummary, error) {
+	if user_id == uuid.Nil {
+		return nil, fmt.Errorf("user_id cannot be empty")
+	}
+	return &UserSummary{
+		user_id:   user_id,
+		handle:    handle,
+		profile:   profile,
+		counts:    counts,
+		joined_at: joined_at,
+	}, nil
+}
+
+// UserID はユーザーの公開IDを返します
+func (s *UserSummary) UserID() uuid.UUID {
+	return s.user_id
+}
+
+// Handle はユーザーの現在のハンドルを返します（未設定の場合はnil）
+func (s *UserSummary) Handle() *UserHandle {
+	return s.handle
+}
+
+// Profile は公開プロフィールを返します（未作成の場合はnil）
+func (s *UserSummary) Profile() *Profile {
+	return s.profile
+}
+
+// Counts は投稿数・出品数・フォロワー数・評価数を返します
+func (s *UserSummary) Counts() UserCounts {
+	return s.counts
+}
+
+// JoinedAt はユーザーの登録日時を返します
+func (s *UserSummary) JoinedAt() time.Time {
+	return s.joined_at
+}
+
+// Me はログイン中のユーザー自身の情報を表す読み取りモデルです
+// 本人にのみ返すアカウントの情報（メールアドレスなど）と、他のユーザーにも公開する概要を含みます
+type Me struct {
+	user    *User
+	summary *UserSummary
+}
+
+// NewMe は新しいMeを作成します
+// summaryはuserの概要である必要があります
+func NewMe(user *User, summary *UserSummary) (*Me, error) {
+	if user == nil || summary == nil {
+		return nil, fmt.Errorf("user and summary cannot be nil")
+	}
+	if user.PublicID() != summary.UserID() {
+		return nil, fmt.Errorf("summary does not belong to user: %s", user.PublicID())
+	}
+	return &Me{
+		user:    user,
+		summary: summary,
+	}, nil
+}
+
+// User はログイン中のユーザーを返します
+func (m *Me) User() *User {
+	return m.user
+}
+
+// Summary は他のユーザーにも公開するユーザーの概要を返します
+func (m *Me) Summary() *UserSummary {
+	return m.summary
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUserSummary(t *testing.T) {
+	// Arrange
+	var user_id uuid.UUID
+	var joined_at time.Time
+	var summary *UserSummary
+	var err error
+
+	user_id = uuid.New()
+	joined_at = time.Now()
+	// Act
+	summary, err = NewUserSummary(user_id, nil, nil, NewUserCounts(1, 2, 3, 4), joined_at)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, summary.UserID())
+	}
+	if summary.Handle() != nil || summary.Profile() != nil {
+		t.Error("expected handle and profile to be nil")
+	}
+	if summary.Counts().Posts() != 1 || summary.Counts().Listings() != 2 || summary.Counts().Followers() != 3 || summary.Counts().Ratings() != 4 {
+		t.Errorf("expected counts 1/2/3/4, got %+v", summary.Counts())
+	}
+	if !summary.JoinedAt().Equal(joined_at) {
+		t.Errorf("expected joined_at %v, got %v", joined_at, summary.JoinedAt())
+	}
+}
+
+func TestNewUserSummary_EmptyUserID(t *testing.T) {
+	var err error
+
+	// Act
+	_, err = NewUserSummary(uuid.Nil, nil, nil, UserCounts{}, time.Now())
+	// Assert
+	if err == nil {
+		t.Error("expected error for empty user_id, got nil")
+	}
+}
+
+func TestNewMe(t *testing.T) {
+	// Arrange
+	var email Email
+	var user *User
+	var summary *UserSummary
+	var me *Me
+	var err error
+
+	email, err = NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = NewUser(test_firebase_uid, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	summary, err = NewUserSummary(user.PublicID(), nil, nil, UserCounts{}, user.CreatedAt())
+	if err != nil {
+		t.Fatalf("failed to create summary: %v", err)
+	}
+	// Act
+	me, err = NewMe(user, summary)
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if me.User() != user || me.Summary() != summary {
+		t.Error("expected me to hold the user and the summary")
+	}
+}
+
+func TestNewMe_SummaryOfAnotherUser(t *testing.T) {
+	// Arrange
+	var email Email
+	var user *User
+	var summary *UserSummary
+	var err error
+
+	email, err = NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = NewUser(test_firebase_uid, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	summary, err = NewUserSummary(uuid.New(), nil, nil, UserCounts{}, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create summary: %v", err)
+	}
+	// Act
+	_, err = NewMe(user, summary)
+	// Assert
+	if err == nil {
+		t.Error("expected error for summary of another user, got nil")
+	}
+}
@@ -88,7 +88,7 @@ func TestNewUserWithPublicID_Success(t *testing.T) {
 	created_at = time.Now().Add(-time.Hour)
 	updated_at = time.Now()
 	// Act
-	user, err = NewUserWithPublicID(public_id, firebase_uid, email, created_at, updated_at, nil)
+	user, err = NewUserWithPublicID(public_id, firebase_uid, email, RoleUser, nil, nil, created_at, updated_at, nil, nil)
 	// Assert
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -137,11 +137,11 @@ func TestUser_IsDeleted(t *testing.T) {
 	created_at = time.Now().Add(-time.Hour)
 	updated_at = time.Now()
 	deleted_at = time.Now()
-	user_not_deleted, err = NewUserWithPublicID(public_id, firebase_uid, email, created_at, updated_at, nil)
+	user_not_deleted, err = NewUserWithPublicID(public_id, firebase_uid, email, RoleUser, nil, nil, created_at, updated_at, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create user_not_deleted: %v", err)
 	}
-	user_deleted, err = NewUserWithPublicID(uuid.New(), firebase_uid, email, created_at, updated_at, &deleted_at)
+	user_deleted, err = NewUserWithPublicID(uuid.New(), firebase_uid, email, RoleUser, nil, nil, created_at, updated_at, &deleted_at, nil)
 	if err != nil {
 		t.Fatalf("failed to create user_deleted: %v", err)
 	}
@@ -153,3 +153,127 @@ func TestUser_IsDeleted(t *testing.T) {
 		t.Error("expected user_deleted to be deleted")
 	}
 }
+
+func TestUser_IsBanned(t *testing.T) {
+	// Arrange
+	var email Email
+	var now time.Time
+	var user_not_banned *User
+	var user_banned *User
+
+	email, _ = NewEmail("test@example.com")
+	now = time.Now()
+	user_not_banned, _ = NewUserWithPublicID(uuid.New(), test_firebase_uid, email, RoleUser, nil, nil, now, now, nil, nil)
+	user_banned, _ = NewUserWithPublicID(uuid.New(), test_firebase_uid, email, RoleUser, nil, nil, now, now, nil, &now)
+	// Act & Assert
+	if user_not_banned.IsBanned() || user_not_banned.BannedAt() != nil {
+		t.Error("expected user_not_banned to not be banned")
+	}
+	if !user_banned.IsBanned() || user_banned.IsDeleted() {
+		t.Error("expected user_banned to be banned but not deleted")
+	}
+}
+
+func TestUser_HasRole(t *testing.T) {
+	// Arrange
+	var email Email
+	var now time.Time
+	var user *User
+	var admin *User
+	var err error
+
+	email, err = NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = NewUserWithPublicID(uuid.New(), test_firebase_uid, email, RoleUser, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	admin, err = NewUserWithPublicID(uuid.New(), test_firebase_uid, email, RoleAdmin, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create admin: %v", err)
+	}
+	// Act & Assert
+	if !user.HasRole(RoleUser) {
+		t.Error("expected user to have user role")
+	}
+	if user.HasRole(RoleAdmin) {
+		t.Error("expected user to not have admin role")
+	}
+	if !admin.HasRole(RoleUser) {
+		t.Error("expected admin to have user role")
+	}
+	if !admin.HasRole(RoleAdmin) {
+		t.Error("expected admin to have admin role")
+	}
+	_, err = NewUserWithPublicID(uuid.New(), test_firebase_uid, email, Role("owner"), nil, nil, now, now, nil, nil)
+	if err == nil {
+		t.Error("expected error for invalid role, got nil")
+	}
+}
+
+func TestUser_MarkEmailVerified(t *testing.T) {
+	// Arrange
+	var email Email
+	var user *User
+	var verified_at time.Time
+	var err error
+
+	email, err = NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = NewUser(test_firebase_uid, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	verified_at = time.Now()
+	// Act & Assert
+	if user.IsEmailVerified() {
+		t.Error("expected new user to not be email verified")
+	}
+	user.MarkEmailVerified(verified_at)
+	if !user.IsEmailVerified() {
+		t.Error("expected user to be email verified")
+	}
+	// 確認済みの場合は確認日時を変更しない
+	user.MarkEmailVerified(verified_at.Add(time.Hour))
+	if !user.EmailVerifiedAt().Equal(verified_at) {
+		t.Errorf("expected email_verified_at to be %v, got %v", verified_at, user.EmailVerifiedAt())
+	}
+}
+
+func TestUser_EnableMfa(t *testing.T) {
+	// Arrange
+	var email Email
+	var user *User
+	var enabled_at time.Time
+	var err error
+
+	email, err = NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = NewUser(test_firebase_uid, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	enabled_at = time.Now()
+	// Act & Assert
+	if user.IsMfaEnabled() {
+		t.Error("expected new user to not have mfa enabled")
+	}
+	if user.IsMfaRequired() {
+		t.Error("expected mfa to be optional for user role")
+	}
+	user.EnableMfa(enabled_at)
+	if !user.IsMfaEnabled() || !user.MfaEnabledAt().Equal(enabled_at) {
+		t.Errorf("expected mfa_enabled_at to be %v, got %v", enabled_at, user.MfaEnabledAt())
+	}
+	user.DisableMfa()
+	if user.IsMfaEnabled() {
+		t.Error("expected mfa to be disabled")
+	}
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/authattempt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuthAttempt is the model entity for the AuthAttempt schema.
+type AuthAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 失敗回数を数えるキー（email:メールアドレス / ip:IPアドレス / device:端末ID）
+	Key string `json:"key,omitempty"`
+	// 連続した失敗回数
+	FailureCount int `json:"failure_count,omitempty"`
+	// 最後に失敗した日時
+	LastFailedAt time.Time `json:"last_failed_at,omitempty"`
+	// 記録の有効期限（最後の失敗からリセットまでの期間、以降は失敗回数0として扱い削除可能）
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuthAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case authattempt.FieldID, authattempt.FieldFailureCount:
+			values[i] = new(sql.NullInt64)
+		case authattempt.FieldKey:
+			values[i] = new(sql.NullString)
+		case authattempt.FieldDeletedAt, authattempt.FieldLastFailedAt, authattempt.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuthAttempt fields.
+func (_m *AuthAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case authattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case authattempt.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case authattempt.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case authattempt.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				_m.FailureCount = int(value.Int64)
+			}
+		case authattempt.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				_m.LastFailedAt = value.Time
+			}
+		case authattempt.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuthAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuthAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuthAttempt.
+// Note that you need to call AuthAttempt.Unwrap() before calling this method if this AuthAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuthAttempt) Update() *AuthAttemptUpdateOne {
+	return NewAuthAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuthAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuthAttempt) Unwrap() *AuthAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuthAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuthAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuthAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailureCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_failed_at=")
+	builder.WriteString(_m.LastFailedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuthAttempts is a parsable slice of AuthAttempt.
+type AuthAttempts []*AuthAttempt
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/authattempt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthAttemptCreate is the builder for creating a AuthAttempt entity.
+type AuthAttemptCreate struct {
+	config
+	mutation *AuthAttemptMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AuthAttemptCreate) SetDeletedAt(v time.Time) *AuthAttemptCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AuthAttemptCreate) SetNillableDeletedAt(v *time.Time) *AuthAttemptCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *AuthAttemptCreate) SetKey(v string) *AuthAttemptCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_c *AuthAttemptCreate) SetFailureCount(v int) *AuthAttemptCreate {
+	_c.mutation.SetFailureCount(v)
+	return _c
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_c *AuthAttemptCreate) SetNillableFailureCount(v *int) *AuthAttemptCreate {
+	if v != nil {
+		_c.SetFailureCount(*v)
+	}
+	return _c
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (_c *AuthAttemptCreate) SetLastFailedAt(v time.Time) *AuthAttemptCreate {
+	_c.mutation.SetLastFailedAt(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AuthAttemptCreate) SetExpiresAt(v time.Time) *AuthAttemptCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the AuthAttemptMutation object of the builder.
+func (_c *AuthAttemptCreate) Mutation() *AuthAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the AuthAttempt in the database.
+func (_c *AuthAttemptCreate) Save(ctx context.Context) (*AuthAttempt, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuthAttemptCreate) SaveX(ctx context.Context) *AuthAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuthAttemptCreate) defaults() error {
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		v := authattempt.DefaultFailureCount
+		_c.mutation.SetFailureCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuthAttemptCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "AuthAttempt.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := authattempt.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AuthAttempt.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "AuthAttempt.failure_count"`)}
+	}
+	if _, ok := _c.mutation.LastFailedAt(); !ok {
+		return &ValidationError{Name: "last_failed_at", err: errors.New(`ent: missing required field "AuthAttempt.last_failed_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AuthAttempt.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *AuthAttemptCreate) sqlSave(ctx context.Context) (*AuthAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuthAttemptCreate) createSpec() (*AuthAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(authattempt.Table, sqlgraph.NewFieldSpec(authattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(authattempt.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(authattempt.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.FailureCount(); ok {
+		_spec.SetField(authattempt.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
+	if value, ok := _c.mutation.LastFailedAt(); ok {
+		_spec.SetField(authattempt.FieldLastFailedAt, field.TypeTime, value)
+		_node.LastFailedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(authattempt.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// AuthAttemptCreateBulk is the builder for creating many AuthAttempt entities in bulk.
+type AuthAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*AuthAttemptCreate
+}
+
+// Save creates the AuthAttempt entities in the database.
+func (_c *AuthAttemptCreateBulk) Save(ctx context.Context) ([]*AuthAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuthAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuthAttemptCreateBulk) SaveX(ctx context.Context) []*AuthAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
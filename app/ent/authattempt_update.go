@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/authattempt"
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthAttemptUpdate is the builder for updating AuthAttempt entities.
+type AuthAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthAttemptMutation
+}
+
+// Where appends a list predicates to the AuthAttemptUpdate builder.
+func (_u *AuthAttemptUpdate) Where(ps ...predicate.AuthAttempt) *AuthAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuthAttemptUpdate) SetDeletedAt(v time.Time) *AuthAttemptUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdate) SetNillableDeletedAt(v *time.Time) *AuthAttemptUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuthAttemptUpdate) ClearDeletedAt() *AuthAttemptUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *AuthAttemptUpdate) SetFailureCount(v int) *AuthAttemptUpdate {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *AuthAttemptUpdate) SetNillableFailureCount(v *int) *AuthAttemptUpdate {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *AuthAttemptUpdate) AddFailureCount(v int) *AuthAttemptUpdate {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (_u *AuthAttemptUpdate) SetLastFailedAt(v time.Time) *AuthAttemptUpdate {
+	_u.mutation.SetLastFailedAt(v)
+	return _u
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdate) SetNillableLastFailedAt(v *time.Time) *AuthAttemptUpdate {
+	if v != nil {
+		_u.SetLastFailedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthAttemptUpdate) SetExpiresAt(v time.Time) *AuthAttemptUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdate) SetNillableExpiresAt(v *time.Time) *AuthAttemptUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthAttemptMutation object of the builder.
+func (_u *AuthAttemptUpdate) Mutation() *AuthAttemptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuthAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuthAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuthAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authattempt.Table, authattempt.Columns, sqlgraph.NewFieldSpec(authattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(authattempt.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(authattempt.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(authattempt.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(authattempt.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailedAt(); ok {
+		_spec.SetField(authattempt.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authattempt.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuthAttemptUpdateOne is the builder for updating a single AuthAttempt entity.
+type AuthAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthAttemptMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuthAttemptUpdateOne) SetDeletedAt(v time.Time) *AuthAttemptUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdateOne) SetNillableDeletedAt(v *time.Time) *AuthAttemptUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuthAttemptUpdateOne) ClearDeletedAt() *AuthAttemptUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *AuthAttemptUpdateOne) SetFailureCount(v int) *AuthAttemptUpdateOne {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *AuthAttemptUpdateOne) SetNillableFailureCount(v *int) *AuthAttemptUpdateOne {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *AuthAttemptUpdateOne) AddFailureCount(v int) *AuthAttemptUpdateOne {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (_u *AuthAttemptUpdateOne) SetLastFailedAt(v time.Time) *AuthAttemptUpdateOne {
+	_u.mutation.SetLastFailedAt(v)
+	return _u
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdateOne) SetNillableLastFailedAt(v *time.Time) *AuthAttemptUpdateOne {
+	if v != nil {
+		_u.SetLastFailedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthAttemptUpdateOne) SetExpiresAt(v time.Time) *AuthAttemptUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthAttemptUpdateOne) SetNillableExpiresAt(v *time.Time) *AuthAttemptUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthAttemptMutation object of the builder.
+func (_u *AuthAttemptUpdateOne) Mutation() *AuthAttemptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuthAttemptUpdate builder.
+func (_u *AuthAttemptUpdateOne) Where(ps ...predicate.AuthAttempt) *AuthAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuthAttemptUpdateOne) Select(field string, fields ...string) *AuthAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuthAttempt entity.
+func (_u *AuthAttemptUpdateOne) Save(ctx context.Context) (*AuthAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthAttemptUpdateOne) SaveX(ctx context.Context) *AuthAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuthAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuthAttemptUpdateOne) sqlSave(ctx context.Context) (_node *AuthAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authattempt.Table, authattempt.Columns, sqlgraph.NewFieldSpec(authattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authattempt.FieldID)
+		for _, f := range fields {
+			if !authattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(authattempt.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(authattempt.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(authattempt.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(authattempt.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailedAt(); ok {
+		_spec.SetField(authattempt.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authattempt.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &AuthAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/compensationtask"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CompensationTask is the model entity for the CompensationTask schema.
+type CompensationTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 補償処理ID（UUID）
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// 補償処理の種類（delete_firebase_user: Firebaseユーザーの削除, enable_firebase_user: Firebaseユーザーの再有効化, sync_firebase_email: Firebaseのメールアドレスをusersテーブルに合わせる）
+	Kind compensationtask.Kind `json:"kind,omitempty"`
+	// 補償処理の対象（Firebase UIDなど）
+	Target string `json:"target,omitempty"`
+	// 状態（pending: 再試行待ち, exhausted: 再試行回数の上限に到達）
+	Status compensationtask.Status `json:"status,omitempty"`
+	// 試行回数
+	Attempts int `json:"attempts,omitempty"`
+	// 最後に失敗した際のエラーメッセージ
+	LastError string `json:"last_error,omitempty"`
+	// 次回の試行日時
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// 作成日時
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CompensationTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case compensationtask.FieldID, compensationtask.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case compensationtask.FieldKind, compensationtask.FieldTarget, compensationtask.FieldStatus, compensationtask.FieldLastError:
+			values[i] = new(sql.NullString)
+		case compensationtask.FieldDeletedAt, compensationtask.FieldNextAttemptAt, compensationtask.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case compensationtask.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CompensationTask fields.
+func (_m *CompensationTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case compensationtask.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case compensationtask.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case compensationtask.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				_m.TaskID = *value
+			}
+		case compensationtask.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = compensationtask.Kind(value.String)
+			}
+		case compensationtask.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = value.String
+			}
+		case compensationtask.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = compensationtask.Status(value.String)
+			}
+		case compensationtask.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case compensationtask.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case compensationtask.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case compensationtask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CompensationTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *CompensationTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CompensationTask.
+// Note that you need to call CompensationTask.Unwrap() before calling this method if this CompensationTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CompensationTask) Update() *CompensationTaskUpdateOne {
+	return NewCompensationTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CompensationTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CompensationTask) Unwrap() *CompensationTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CompensationTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CompensationTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("CompensationTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(_m.Target)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CompensationTasks is a parsable slice of CompensationTask.
+type CompensationTasks []*CompensationTask
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/compensationtask"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CompensationTaskCreate is the builder for creating a CompensationTask entity.
+type CompensationTaskCreate struct {
+	config
+	mutation *CompensationTaskMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CompensationTaskCreate) SetDeletedAt(v time.Time) *CompensationTaskCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableDeletedAt(v *time.Time) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *CompensationTaskCreate) SetTaskID(v uuid.UUID) *CompensationTaskCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableTaskID(v *uuid.UUID) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *CompensationTaskCreate) SetKind(v compensationtask.Kind) *CompensationTaskCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *CompensationTaskCreate) SetTarget(v string) *CompensationTaskCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CompensationTaskCreate) SetStatus(v compensationtask.Status) *CompensationTaskCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableStatus(v *compensationtask.Status) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *CompensationTaskCreate) SetAttempts(v int) *CompensationTaskCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableAttempts(v *int) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CompensationTaskCreate) SetLastError(v string) *CompensationTaskCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableLastError(v *string) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *CompensationTaskCreate) SetNextAttemptAt(v time.Time) *CompensationTaskCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CompensationTaskCreate) SetCreatedAt(v time.Time) *CompensationTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CompensationTaskCreate) SetNillableCreatedAt(v *time.Time) *CompensationTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CompensationTaskMutation object of the builder.
+func (_c *CompensationTaskCreate) Mutation() *CompensationTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the CompensationTask in the database.
+func (_c *CompensationTaskCreate) Save(ctx context.Context) (*CompensationTask, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CompensationTaskCreate) SaveX(ctx context.Context) *CompensationTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CompensationTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CompensationTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CompensationTaskCreate) defaults() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		if compensationtask.DefaultTaskID == nil {
+			return fmt.Errorf("ent: uninitialized compensationtask.DefaultTaskID (forgotten import ent/runtime?)")
+		}
+		v := compensationtask.DefaultTaskID()
+		_c.mutation.SetTaskID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := compensationtask.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := compensationtask.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		v := compensationtask.DefaultLastError
+		_c.mutation.SetLastError(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if compensationtask.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized compensationtask.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := compensationtask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CompensationTaskCreate) check() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "CompensationTask.task_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CompensationTask.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := compensationtask.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CompensationTask.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "CompensationTask.target"`)}
+	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := compensationtask.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "CompensationTask.target": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CompensationTask.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := compensationtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CompensationTask.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "CompensationTask.attempts"`)}
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "CompensationTask.last_error"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "CompensationTask.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CompensationTask.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CompensationTaskCreate) sqlSave(ctx context.Context) (*CompensationTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CompensationTaskCreate) createSpec() (*CompensationTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CompensationTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(compensationtask.Table, sqlgraph.NewFieldSpec(compensationtask.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(compensationtask.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(compensationtask.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(compensationtask.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(compensationtask.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(compensationtask.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(compensationtask.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(compensationtask.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compensationtask.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(compensationtask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CompensationTaskCreateBulk is the builder for creating many CompensationTask entities in bulk.
+type CompensationTaskCreateBulk struct {
+	config
+	err      error
+	builders []*CompensationTaskCreate
+}
+
+// Save creates the CompensationTask entities in the database.
+func (_c *CompensationTaskCreateBulk) Save(ctx context.Context) ([]*CompensationTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CompensationTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CompensationTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CompensationTaskCreateBulk) SaveX(ctx context.Context) []*CompensationTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CompensationTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CompensationTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/compensationtask"
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CompensationTaskUpdate is the builder for updating CompensationTask entities.
+type CompensationTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CompensationTaskMutation
+}
+
+// Where appends a list predicates to the CompensationTaskUpdate builder.
+func (_u *CompensationTaskUpdate) Where(ps ...predicate.CompensationTask) *CompensationTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CompensationTaskUpdate) SetDeletedAt(v time.Time) *CompensationTaskUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CompensationTaskUpdate) SetNillableDeletedAt(v *time.Time) *CompensationTaskUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CompensationTaskUpdate) ClearDeletedAt() *CompensationTaskUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CompensationTaskUpdate) SetStatus(v compensationtask.Status) *CompensationTaskUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CompensationTaskUpdate) SetNillableStatus(v *compensationtask.Status) *CompensationTaskUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CompensationTaskUpdate) SetAttempts(v int) *CompensationTaskUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CompensationTaskUpdate) SetNillableAttempts(v *int) *CompensationTaskUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CompensationTaskUpdate) AddAttempts(v int) *CompensationTaskUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CompensationTaskUpdate) SetLastError(v string) *CompensationTaskUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CompensationTaskUpdate) SetNillableLastError(v *string) *CompensationTaskUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CompensationTaskUpdate) SetNextAttemptAt(v time.Time) *CompensationTaskUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CompensationTaskUpdate) SetNillableNextAttemptAt(v *time.Time) *CompensationTaskUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CompensationTaskMutation object of the builder.
+func (_u *CompensationTaskUpdate) Mutation() *CompensationTaskMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CompensationTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CompensationTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CompensationTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CompensationTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CompensationTaskUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := compensationtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CompensationTask.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CompensationTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(compensationtask.Table, compensationtask.Columns, sqlgraph.NewFieldSpec(compensationtask.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(compensationtask.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(compensationtask.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(compensationtask.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(compensationtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(compensationtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(compensationtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compensationtask.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{compensationtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CompensationTaskUpdateOne is the builder for updating a single CompensationTask entity.
+type CompensationTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CompensationTaskMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CompensationTaskUpdateOne) SetDeletedAt(v time.Time) *CompensationTaskUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CompensationTaskUpdateOne) SetNillableDeletedAt(v *time.Time) *CompensationTaskUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CompensationTaskUpdateOne) ClearDeletedAt() *CompensationTaskUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CompensationTaskUpdateOne) SetStatus(v compensationtask.Status) *CompensationTaskUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CompensationTaskUpdateOne) SetNillableStatus(v *compensationtask.Status) *CompensationTaskUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CompensationTaskUpdateOne) SetAttempts(v int) *CompensationTaskUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CompensationTaskUpdateOne) SetNillableAttempts(v *int) *CompensationTaskUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CompensationTaskUpdateOne) AddAttempts(v int) *CompensationTaskUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CompensationTaskUpdateOne) SetLastError(v string) *CompensationTaskUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CompensationTaskUpdateOne) SetNillableLastError(v *string) *CompensationTaskUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CompensationTaskUpdateOne) SetNextAttemptAt(v time.Time) *CompensationTaskUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CompensationTaskUpdateOne) SetNillableNextAttemptAt(v *time.Time) *CompensationTaskUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CompensationTaskMutation object of the builder.
+func (_u *CompensationTaskUpdateOne) Mutation() *CompensationTaskMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CompensationTaskUpdate builder.
+func (_u *CompensationTaskUpdateOne) Where(ps ...predicate.CompensationTask) *CompensationTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CompensationTaskUpdateOne) Select(field string, fields ...string) *CompensationTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CompensationTask entity.
+func (_u *CompensationTaskUpdateOne) Save(ctx context.Context) (*CompensationTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CompensationTaskUpdateOne) SaveX(ctx context.Context) *CompensationTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CompensationTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CompensationTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CompensationTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := compensationtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CompensationTask.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CompensationTaskUpdateOne) sqlSave(ctx context.Context) (_node *CompensationTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(compensationtask.Table, compensationtask.Columns, sqlgraph.NewFieldSpec(compensationtask.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CompensationTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, compensationtask.FieldID)
+		for _, f := range fields {
+			if !compensationtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != compensationtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(compensationtask.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(compensationtask.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(compensationtask.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(compensationtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(compensationtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(compensationtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compensationtask.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	_node = &CompensationTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{compensationtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
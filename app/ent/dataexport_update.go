@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/dataexport"
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DataExportUpdate) SetDeletedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableDeletedAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DataExportUpdate) ClearDeletedAt() *DataExportUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataExportUpdate) SetStatus(v dataexport.Status) *DataExportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStatus(v *dataexport.Status) *DataExportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdate) SetStorageKey(v string) *DataExportUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStorageKey(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdate) ClearStorageKey() *DataExportUpdate {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (_u *DataExportUpdate) SetDownloadTokenHash(v string) *DataExportUpdate {
+	_u.mutation.SetDownloadTokenHash(v)
+	return _u
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableDownloadTokenHash(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetDownloadTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearDownloadTokenHash clears the value of the "download_token_hash" field.
+func (_u *DataExportUpdate) ClearDownloadTokenHash() *DataExportUpdate {
+	_u.mutation.ClearDownloadTokenHash()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataExportUpdate) SetLastError(v string) *DataExportUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableLastError(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DataExportUpdate) SetCompletedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableCompletedAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DataExportUpdate) ClearCompletedAt() *DataExportUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdate) SetExpiresAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableExpiresAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdate) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+func (_u *DataExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(dataexport.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(dataexport.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.DownloadTokenHashCleared() {
+		_spec.ClearField(dataexport.FieldDownloadTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DataExportUpdateOne) SetDeletedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableDeletedAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DataExportUpdateOne) ClearDeletedAt() *DataExportUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataExportUpdateOne) SetStatus(v dataexport.Status) *DataExportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStatus(v *dataexport.Status) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdateOne) SetStorageKey(v string) *DataExportUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStorageKey(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdateOne) ClearStorageKey() *DataExportUpdateOne {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (_u *DataExportUpdateOne) SetDownloadTokenHash(v string) *DataExportUpdateOne {
+	_u.mutation.SetDownloadTokenHash(v)
+	return _u
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableDownloadTokenHash(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetDownloadTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearDownloadTokenHash clears the value of the "download_token_hash" field.
+func (_u *DataExportUpdateOne) ClearDownloadTokenHash() *DataExportUpdateOne {
+	_u.mutation.ClearDownloadTokenHash()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataExportUpdateOne) SetLastError(v string) *DataExportUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableLastError(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DataExportUpdateOne) SetCompletedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableCompletedAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DataExportUpdateOne) ClearCompletedAt() *DataExportUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdateOne) SetExpiresAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableExpiresAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (_u *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+func (_u *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(dataexport.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(dataexport.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.DownloadTokenHashCleared() {
+		_spec.ClearField(dataexport.FieldDownloadTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &DataExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/denylistedtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DenylistedTokenCreate is the builder for creating a DenylistedToken entity.
+type DenylistedTokenCreate struct {
+	config
+	mutation *DenylistedTokenMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DenylistedTokenCreate) SetDeletedAt(v time.Time) *DenylistedTokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DenylistedTokenCreate) SetNillableDeletedAt(v *time.Time) *DenylistedTokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *DenylistedTokenCreate) SetKind(v denylistedtoken.Kind) *DenylistedTokenCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DenylistedTokenCreate) SetValue(v string) *DenylistedTokenCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *DenylistedTokenCreate) SetExpiresAt(v time.Time) *DenylistedTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DenylistedTokenCreate) SetCreatedAt(v time.Time) *DenylistedTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DenylistedTokenCreate) SetNillableCreatedAt(v *time.Time) *DenylistedTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DenylistedTokenMutation object of the builder.
+func (_c *DenylistedTokenCreate) Mutation() *DenylistedTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the DenylistedToken in the database.
+func (_c *DenylistedTokenCreate) Save(ctx context.Context) (*DenylistedToken, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DenylistedTokenCreate) SaveX(ctx context.Context) *DenylistedToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DenylistedTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DenylistedTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DenylistedTokenCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if denylistedtoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized denylistedtoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := denylistedtoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DenylistedTokenCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DenylistedToken.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := denylistedtoken.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DenylistedToken.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "DenylistedToken.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := denylistedtoken.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "DenylistedToken.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "DenylistedToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DenylistedToken.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DenylistedTokenCreate) sqlSave(ctx context.Context) (*DenylistedToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DenylistedTokenCreate) createSpec() (*DenylistedToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DenylistedToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(denylistedtoken.Table, sqlgraph.NewFieldSpec(denylistedtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(denylistedtoken.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(denylistedtoken.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(denylistedtoken.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(denylistedtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(denylistedtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// DenylistedTokenCreateBulk is the builder for creating many DenylistedToken entities in bulk.
+type DenylistedTokenCreateBulk struct {
+	config
+	err      error
+	builders []*DenylistedTokenCreate
+}
+
+// Save creates the DenylistedToken entities in the database.
+func (_c *DenylistedTokenCreateBulk) Save(ctx context.Context) ([]*DenylistedToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DenylistedToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DenylistedTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DenylistedTokenCreateBulk) SaveX(ctx context.Context) []*DenylistedToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DenylistedTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DenylistedTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
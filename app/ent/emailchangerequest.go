@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmailChangeRequest is the model entity for the EmailChangeRequest schema.
+type EmailChangeRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ユーザーID
+	UserID int `json:"user_id,omitempty"`
+	// 変更後のメールアドレス（確認が完了するまでusers.emailは変更しない）
+	NewEmail string `json:"new_email,omitempty"`
+	// 確認リンクのトークンのSHA-256ハッシュ
+	TokenHash string `json:"-"`
+	// 確認リンクの有効期限
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 作成日時（変更を要求した日時）
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailChangeRequestQuery when eager-loading is set.
+	Edges        EmailChangeRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailChangeRequestEdges holds the relations/edges for other nodes in the graph.
+type EmailChangeRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailChangeRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailChangeRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailchangerequest.FieldID, emailchangerequest.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case emailchangerequest.FieldNewEmail, emailchangerequest.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case emailchangerequest.FieldDeletedAt, emailchangerequest.FieldExpiresAt, emailchangerequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailChangeRequest fields.
+func (_m *EmailChangeRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailchangerequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case emailchangerequest.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case emailchangerequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case emailchangerequest.FieldNewEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_email", values[i])
+			} else if value.Valid {
+				_m.NewEmail = value.String
+			}
+		case emailchangerequest.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case emailchangerequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case emailchangerequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailChangeRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailChangeRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailChangeRequest entity.
+func (_m *EmailChangeRequest) QueryUser() *UserQuery {
+	return NewEmailChangeRequestClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this EmailChangeRequest.
+// Note that you need to call EmailChangeRequest.Unwrap() before calling this method if this EmailChangeRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailChangeRequest) Update() *EmailChangeRequestUpdateOne {
+	return NewEmailChangeRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailChangeRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailChangeRequest) Unwrap() *EmailChangeRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailChangeRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailChangeRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailChangeRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("new_email=")
+	builder.WriteString(_m.NewEmail)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailChangeRequests is a parsable slice of EmailChangeRequest.
+type EmailChangeRequests []*EmailChangeRequest
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailChangeRequestCreate is the builder for creating a EmailChangeRequest entity.
+type EmailChangeRequestCreate struct {
+	config
+	mutation *EmailChangeRequestMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EmailChangeRequestCreate) SetDeletedAt(v time.Time) *EmailChangeRequestCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EmailChangeRequestCreate) SetNillableDeletedAt(v *time.Time) *EmailChangeRequestCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmailChangeRequestCreate) SetUserID(v int) *EmailChangeRequestCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_c *EmailChangeRequestCreate) SetNewEmail(v string) *EmailChangeRequestCreate {
+	_c.mutation.SetNewEmail(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *EmailChangeRequestCreate) SetTokenHash(v string) *EmailChangeRequestCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EmailChangeRequestCreate) SetExpiresAt(v time.Time) *EmailChangeRequestCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailChangeRequestCreate) SetCreatedAt(v time.Time) *EmailChangeRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailChangeRequestCreate) SetNillableCreatedAt(v *time.Time) *EmailChangeRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EmailChangeRequestCreate) SetUser(v *User) *EmailChangeRequestCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailChangeRequestMutation object of the builder.
+func (_c *EmailChangeRequestCreate) Mutation() *EmailChangeRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailChangeRequest in the database.
+func (_c *EmailChangeRequestCreate) Save(ctx context.Context) (*EmailChangeRequest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailChangeRequestCreate) SaveX(ctx context.Context) *EmailChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailChangeRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailChangeRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailChangeRequestCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if emailchangerequest.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized emailchangerequest.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := emailchangerequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailChangeRequestCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailChangeRequest.user_id"`)}
+	}
+	if _, ok := _c.mutation.NewEmail(); !ok {
+		return &ValidationError{Name: "new_email", err: errors.New(`ent: missing required field "EmailChangeRequest.new_email"`)}
+	}
+	if v, ok := _c.mutation.NewEmail(); ok {
+		if err := emailchangerequest.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.new_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EmailChangeRequest.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := emailchangerequest.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailChangeRequest.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailChangeRequest.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailChangeRequest.user"`)}
+	}
+	return nil
+}
+
+func (_c *EmailChangeRequestCreate) sqlSave(ctx context.Context) (*EmailChangeRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailChangeRequestCreate) createSpec() (*EmailChangeRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailChangeRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailchangerequest.Table, sqlgraph.NewFieldSpec(emailchangerequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(emailchangerequest.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangerequest.FieldNewEmail, field.TypeString, value)
+		_node.NewEmail = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangerequest.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangerequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailchangerequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangerequest.UserTable,
+			Columns: []string{emailchangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailChangeRequestCreateBulk is the builder for creating many EmailChangeRequest entities in bulk.
+type EmailChangeRequestCreateBulk struct {
+	config
+	err      error
+	builders []*EmailChangeRequestCreate
+}
+
+// Save creates the EmailChangeRequest entities in the database.
+func (_c *EmailChangeRequestCreateBulk) Save(ctx context.Context) ([]*EmailChangeRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailChangeRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailChangeRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailChangeRequestCreateBulk) SaveX(ctx context.Context) []*EmailChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailChangeRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailChangeRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailChangeRequestUpdate is the builder for updating EmailChangeRequest entities.
+type EmailChangeRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailChangeRequestMutation
+}
+
+// Where appends a list predicates to the EmailChangeRequestUpdate builder.
+func (_u *EmailChangeRequestUpdate) Where(ps ...predicate.EmailChangeRequest) *EmailChangeRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmailChangeRequestUpdate) SetDeletedAt(v time.Time) *EmailChangeRequestUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdate) SetNillableDeletedAt(v *time.Time) *EmailChangeRequestUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmailChangeRequestUpdate) ClearDeletedAt() *EmailChangeRequestUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_u *EmailChangeRequestUpdate) SetNewEmail(v string) *EmailChangeRequestUpdate {
+	_u.mutation.SetNewEmail(v)
+	return _u
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdate) SetNillableNewEmail(v *string) *EmailChangeRequestUpdate {
+	if v != nil {
+		_u.SetNewEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EmailChangeRequestUpdate) SetTokenHash(v string) *EmailChangeRequestUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdate) SetNillableTokenHash(v *string) *EmailChangeRequestUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailChangeRequestUpdate) SetExpiresAt(v time.Time) *EmailChangeRequestUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdate) SetNillableExpiresAt(v *time.Time) *EmailChangeRequestUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EmailChangeRequestMutation object of the builder.
+func (_u *EmailChangeRequestUpdate) Mutation() *EmailChangeRequestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailChangeRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailChangeRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailChangeRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailChangeRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailChangeRequestUpdate) check() error {
+	if v, ok := _u.mutation.NewEmail(); ok {
+		if err := emailchangerequest.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.new_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := emailchangerequest.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChangeRequest.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailChangeRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchangerequest.Table, emailchangerequest.Columns, sqlgraph.NewFieldSpec(emailchangerequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emailchangerequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emailchangerequest.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangerequest.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangerequest.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangerequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailChangeRequestUpdateOne is the builder for updating a single EmailChangeRequest entity.
+type EmailChangeRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailChangeRequestMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EmailChangeRequestUpdateOne) SetDeletedAt(v time.Time) *EmailChangeRequestUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdateOne) SetNillableDeletedAt(v *time.Time) *EmailChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EmailChangeRequestUpdateOne) ClearDeletedAt() *EmailChangeRequestUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_u *EmailChangeRequestUpdateOne) SetNewEmail(v string) *EmailChangeRequestUpdateOne {
+	_u.mutation.SetNewEmail(v)
+	return _u
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdateOne) SetNillableNewEmail(v *string) *EmailChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetNewEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EmailChangeRequestUpdateOne) SetTokenHash(v string) *EmailChangeRequestUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdateOne) SetNillableTokenHash(v *string) *EmailChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailChangeRequestUpdateOne) SetExpiresAt(v time.Time) *EmailChangeRequestUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailChangeRequestUpdateOne) SetNillableExpiresAt(v *time.Time) *EmailChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EmailChangeRequestMutation object of the builder.
+func (_u *EmailChangeRequestUpdateOne) Mutation() *EmailChangeRequestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailChangeRequestUpdate builder.
+func (_u *EmailChangeRequestUpdateOne) Where(ps ...predicate.EmailChangeRequest) *EmailChangeRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailChangeRequestUpdateOne) Select(field string, fields ...string) *EmailChangeRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailChangeRequest entity.
+func (_u *EmailChangeRequestUpdateOne) Save(ctx context.Context) (*EmailChangeRequest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailChangeRequestUpdateOne) SaveX(ctx context.Context) *EmailChangeRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailChangeRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailChangeRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailChangeRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.NewEmail(); ok {
+		if err := emailchangerequest.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.new_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := emailchangerequest.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeRequest.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChangeRequest.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailChangeRequestUpdateOne) sqlSave(ctx context.Context) (_node *EmailChangeRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchangerequest.Table, emailchangerequest.Columns, sqlgraph.NewFieldSpec(emailchangerequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailChangeRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailchangerequest.FieldID)
+		for _, f := range fields {
+			if !emailchangerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailchangerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(emailchangerequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(emailchangerequest.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangerequest.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangerequest.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangerequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &EmailChangeRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,8 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sleeve/ent/authattempt"
+	"sleeve/ent/compensationtask"
+	"sleeve/ent/dataexport"
+	"sleeve/ent/denylistedtoken"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/follow"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/idempotencyrecord"
+	"sleeve/ent/profile"
+	"sleeve/ent/refreshtoken"
+	"sleeve/ent/session"
 	"sleeve/ent/test"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/uploadedimage"
 	"sleeve/ent/user"
+	"sleeve/ent/userblock"
+	"sleeve/ent/userhandle"
+	"sleeve/ent/useridentity"
+	"sleeve/ent/usermute"
 	"sync"
 
 	"entgo.io/ent"
@@ -74,8 +91,25 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			test.Table: test.ValidColumn,
-			user.Table: user.ValidColumn,
+			authattempt.Table:        authattempt.ValidColumn,
+			compensationtask.Table:   compensationtask.ValidColumn,
+			dataexport.Table:         dataexport.ValidColumn,
+			denylistedtoken.Table:    denylistedtoken.ValidColumn,
+			emailchangerequest.Table: emailchangerequest.ValidColumn,
+			follow.Table:             follow.ValidColumn,
+			followrequest.Table:      followrequest.ValidColumn,
+			idempotencyrecord.Table:  idempotencyrecord.ValidColumn,
+			profile.Table:            profile.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			session.Table:            session.ValidColumn,
+			test.Table:               test.ValidColumn,
+			totpcredential.Table:     totpcredential.ValidColumn,
+			uploadedimage.Table:      uploadedimage.ValidColumn,
+			user.Table:               user.ValidColumn,
+			userblock.Table:          userblock.ValidColumn,
+			userhandle.Table:         userhandle.ValidColumn,
+			useridentity.Table:       useridentity.ValidColumn,
+			usermute.Table:           usermute.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FollowerID applies equality check predicate on the "follower_id" field. It's identical to FollowerIDEQ.
+func FollowerID(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FolloweeID applies equality check predicate on the "followee_id" field. It's identical to FolloweeIDEQ.
+func FolloweeID(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Follow {
+	return predicate.Follow(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Follow {
+	return predicate.Follow(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FollowerIDEQ applies the EQ predicate on the "follower_id" field.
+func FollowerIDEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FollowerIDNEQ applies the NEQ predicate on the "follower_id" field.
+func FollowerIDNEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFollowerID, v))
+}
+
+// FollowerIDIn applies the In predicate on the "follower_id" field.
+func FollowerIDIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFollowerID, vs...))
+}
+
+// FollowerIDNotIn applies the NotIn predicate on the "follower_id" field.
+func FollowerIDNotIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFollowerID, vs...))
+}
+
+// FolloweeIDEQ applies the EQ predicate on the "followee_id" field.
+func FolloweeIDEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDNEQ applies the NEQ predicate on the "followee_id" field.
+func FolloweeIDNEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDIn applies the In predicate on the "followee_id" field.
+func FolloweeIDIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFolloweeID, vs...))
+}
+
+// FolloweeIDNotIn applies the NotIn predicate on the "followee_id" field.
+func FolloweeIDNotIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFolloweeID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFollower applies the HasEdge predicate on the "follower" edge.
+func HasFollower() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FollowerTable, FollowerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowerWith applies the HasEdge predicate on the "follower" edge with a given conditions (other predicates).
+func HasFollowerWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFollowerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowee applies the HasEdge predicate on the "followee" edge.
+func HasFollowee() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FolloweeTable, FolloweeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolloweeWith applies the HasEdge predicate on the "followee" edge with a given conditions (other predicates).
+func HasFolloweeWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFolloweeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.NotPredicates(p))
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/follow"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowCreate is the builder for creating a Follow entity.
+type FollowCreate struct {
+	config
+	mutation *FollowMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FollowCreate) SetDeletedAt(v time.Time) *FollowCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FollowCreate) SetNillableDeletedAt(v *time.Time) *FollowCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (_c *FollowCreate) SetFollowerID(v int) *FollowCreate {
+	_c.mutation.SetFollowerID(v)
+	return _c
+}
+
+// SetFolloweeID sets the "followee_id" field.
+func (_c *FollowCreate) SetFolloweeID(v int) *FollowCreate {
+	_c.mutation.SetFolloweeID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FollowCreate) SetCreatedAt(v time.Time) *FollowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FollowCreate) SetNillableCreatedAt(v *time.Time) *FollowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetFollower sets the "follower" edge to the User entity.
+func (_c *FollowCreate) SetFollower(v *User) *FollowCreate {
+	return _c.SetFollowerID(v.ID)
+}
+
+// SetFollowee sets the "followee" edge to the User entity.
+func (_c *FollowCreate) SetFollowee(v *User) *FollowCreate {
+	return _c.SetFolloweeID(v.ID)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (_c *FollowCreate) Mutation() *FollowMutation {
+	return _c.mutation
+}
+
+// Save creates the Follow in the database.
+func (_c *FollowCreate) Save(ctx context.Context) (*Follow, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FollowCreate) SaveX(ctx context.Context) *Follow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FollowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FollowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FollowCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if follow.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized follow.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := follow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FollowCreate) check() error {
+	if _, ok := _c.mutation.FollowerID(); !ok {
+		return &ValidationError{Name: "follower_id", err: errors.New(`ent: missing required field "Follow.follower_id"`)}
+	}
+	if _, ok := _c.mutation.FolloweeID(); !ok {
+		return &ValidationError{Name: "followee_id", err: errors.New(`ent: missing required field "Follow.followee_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Follow.created_at"`)}
+	}
+	if len(_c.mutation.FollowerIDs()) == 0 {
+		return &ValidationError{Name: "follower", err: errors.New(`ent: missing required edge "Follow.follower"`)}
+	}
+	if len(_c.mutation.FolloweeIDs()) == 0 {
+		return &ValidationError{Name: "followee", err: errors.New(`ent: missing required edge "Follow.followee"`)}
+	}
+	return nil
+}
+
+func (_c *FollowCreate) sqlSave(ctx context.Context) (*Follow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Follow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(follow.Table, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(follow.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(follow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FollowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FollowerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FolloweeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FolloweeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FollowCreateBulk is the builder for creating many Follow entities in bulk.
+type FollowCreateBulk struct {
+	config
+	err      error
+	builders []*FollowCreate
+}
+
+// Save creates the Follow entities in the database.
+func (_c *FollowCreateBulk) Save(ctx context.Context) ([]*Follow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Follow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FollowCreateBulk) SaveX(ctx context.Context) []*Follow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FollowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FollowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
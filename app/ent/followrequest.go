@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FollowRequest is the model entity for the FollowRequest schema.
+type FollowRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// フォローをリクエストしたユーザーのID
+	RequesterID int `json:"requester_id,omitempty"`
+	// リクエストされた非公開アカウントのユーザーのID
+	TargetID int `json:"target_id,omitempty"`
+	// リクエストした日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowRequestQuery when eager-loading is set.
+	Edges        FollowRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FollowRequestEdges holds the relations/edges for other nodes in the graph.
+type FollowRequestEdges struct {
+	// Requester holds the value of the requester edge.
+	Requester *User `json:"requester,omitempty"`
+	// Target holds the value of the target edge.
+	Target *User `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RequesterOrErr returns the Requester value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowRequestEdges) RequesterOrErr() (*User, error) {
+	if e.Requester != nil {
+		return e.Requester, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "requester"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowRequestEdges) TargetOrErr() (*User, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FollowRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case followrequest.FieldID, followrequest.FieldRequesterID, followrequest.FieldTargetID:
+			values[i] = new(sql.NullInt64)
+		case followrequest.FieldDeletedAt, followrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FollowRequest fields.
+func (_m *FollowRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case followrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case followrequest.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case followrequest.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				_m.RequesterID = int(value.Int64)
+			}
+		case followrequest.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				_m.TargetID = int(value.Int64)
+			}
+		case followrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FollowRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *FollowRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRequester queries the "requester" edge of the FollowRequest entity.
+func (_m *FollowRequest) QueryRequester() *UserQuery {
+	return NewFollowRequestClient(_m.config).QueryRequester(_m)
+}
+
+// QueryTarget queries the "target" edge of the FollowRequest entity.
+func (_m *FollowRequest) QueryTarget() *UserQuery {
+	return NewFollowRequestClient(_m.config).QueryTarget(_m)
+}
+
+// Update returns a builder for updating this FollowRequest.
+// Note that you need to call FollowRequest.Unwrap() before calling this method if this FollowRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FollowRequest) Update() *FollowRequestUpdateOne {
+	return NewFollowRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FollowRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FollowRequest) Unwrap() *FollowRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FollowRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FollowRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("FollowRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequesterID))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FollowRequests is a parsable slice of FollowRequest.
+type FollowRequests []*FollowRequest
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowRequestCreate is the builder for creating a FollowRequest entity.
+type FollowRequestCreate struct {
+	config
+	mutation *FollowRequestMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FollowRequestCreate) SetDeletedAt(v time.Time) *FollowRequestCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FollowRequestCreate) SetNillableDeletedAt(v *time.Time) *FollowRequestCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_c *FollowRequestCreate) SetRequesterID(v int) *FollowRequestCreate {
+	_c.mutation.SetRequesterID(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *FollowRequestCreate) SetTargetID(v int) *FollowRequestCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FollowRequestCreate) SetCreatedAt(v time.Time) *FollowRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FollowRequestCreate) SetNillableCreatedAt(v *time.Time) *FollowRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRequester sets the "requester" edge to the User entity.
+func (_c *FollowRequestCreate) SetRequester(v *User) *FollowRequestCreate {
+	return _c.SetRequesterID(v.ID)
+}
+
+// SetTarget sets the "target" edge to the User entity.
+func (_c *FollowRequestCreate) SetTarget(v *User) *FollowRequestCreate {
+	return _c.SetTargetID(v.ID)
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (_c *FollowRequestCreate) Mutation() *FollowRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the FollowRequest in the database.
+func (_c *FollowRequestCreate) Save(ctx context.Context) (*FollowRequest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FollowRequestCreate) SaveX(ctx context.Context) *FollowRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FollowRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FollowRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FollowRequestCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if followrequest.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized followrequest.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := followrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FollowRequestCreate) check() error {
+	if _, ok := _c.mutation.RequesterID(); !ok {
+		return &ValidationError{Name: "requester_id", err: errors.New(`ent: missing required field "FollowRequest.requester_id"`)}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "FollowRequest.target_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FollowRequest.created_at"`)}
+	}
+	if len(_c.mutation.RequesterIDs()) == 0 {
+		return &ValidationError{Name: "requester", err: errors.New(`ent: missing required edge "FollowRequest.requester"`)}
+	}
+	if len(_c.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "FollowRequest.target"`)}
+	}
+	return nil
+}
+
+func (_c *FollowRequestCreate) sqlSave(ctx context.Context) (*FollowRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FollowRequestCreate) createSpec() (*FollowRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FollowRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(followrequest.Table, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(followrequest.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(followrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RequesterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.RequesterTable,
+			Columns: []string{followrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RequesterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.TargetTable,
+			Columns: []string{followrequest.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FollowRequestCreateBulk is the builder for creating many FollowRequest entities in bulk.
+type FollowRequestCreateBulk struct {
+	config
+	err      error
+	builders []*FollowRequestCreate
+}
+
+// Save creates the FollowRequest entities in the database.
+func (_c *FollowRequestCreateBulk) Save(ctx context.Context) ([]*FollowRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FollowRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FollowRequestCreateBulk) SaveX(ctx context.Context) []*FollowRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FollowRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FollowRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowRequestUpdate is the builder for updating FollowRequest entities.
+type FollowRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FollowRequestMutation
+}
+
+// Where appends a list predicates to the FollowRequestUpdate builder.
+func (_u *FollowRequestUpdate) Where(ps ...predicate.FollowRequest) *FollowRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FollowRequestUpdate) SetDeletedAt(v time.Time) *FollowRequestUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FollowRequestUpdate) SetNillableDeletedAt(v *time.Time) *FollowRequestUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FollowRequestUpdate) ClearDeletedAt() *FollowRequestUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (_u *FollowRequestUpdate) Mutation() *FollowRequestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FollowRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FollowRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FollowRequestUpdate) check() error {
+	if _u.mutation.RequesterCleared() && len(_u.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.requester"`)
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.target"`)
+	}
+	return nil
+}
+
+func (_u *FollowRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followrequest.Table, followrequest.Columns, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(followrequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(followrequest.FieldDeletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FollowRequestUpdateOne is the builder for updating a single FollowRequest entity.
+type FollowRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FollowRequestMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FollowRequestUpdateOne) SetDeletedAt(v time.Time) *FollowRequestUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FollowRequestUpdateOne) SetNillableDeletedAt(v *time.Time) *FollowRequestUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FollowRequestUpdateOne) ClearDeletedAt() *FollowRequestUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (_u *FollowRequestUpdateOne) Mutation() *FollowRequestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FollowRequestUpdate builder.
+func (_u *FollowRequestUpdateOne) Where(ps ...predicate.FollowRequest) *FollowRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FollowRequestUpdateOne) Select(field string, fields ...string) *FollowRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FollowRequest entity.
+func (_u *FollowRequestUpdateOne) Save(ctx context.Context) (*FollowRequest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowRequestUpdateOne) SaveX(ctx context.Context) *FollowRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FollowRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FollowRequestUpdateOne) check() error {
+	if _u.mutation.RequesterCleared() && len(_u.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.requester"`)
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.target"`)
+	}
+	return nil
+}
+
+func (_u *FollowRequestUpdateOne) sqlSave(ctx context.Context) (_node *FollowRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followrequest.Table, followrequest.Columns, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FollowRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, followrequest.FieldID)
+		for _, f := range fields {
+			if !followrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != followrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(followrequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(followrequest.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &FollowRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
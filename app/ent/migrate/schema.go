@@ -3,14 +3,474 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AuthAttemptsColumns holds the columns for the "auth_attempts" table.
+	AuthAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_failed_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// AuthAttemptsTable holds the schema information for the "auth_attempts" table.
+	AuthAttemptsTable = &schema.Table{
+		Name:       "auth_attempts",
+		Columns:    AuthAttemptsColumns,
+		PrimaryKey: []*schema.Column{AuthAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authattempt_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthAttemptsColumns[1]},
+			},
+			{
+				Name:    "authattempt_key",
+				Unique:  true,
+				Columns: []*schema.Column{AuthAttemptsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "authattempt_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthAttemptsColumns[5]},
+			},
+		},
+	}
+	// CompensationTasksColumns holds the columns for the "compensation_tasks" table.
+	CompensationTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"delete_firebase_user", "enable_firebase_user", "sync_firebase_email"}},
+		{Name: "target", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "exhausted"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CompensationTasksTable holds the schema information for the "compensation_tasks" table.
+	CompensationTasksTable = &schema.Table{
+		Name:       "compensation_tasks",
+		Columns:    CompensationTasksColumns,
+		PrimaryKey: []*schema.Column{CompensationTasksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "compensationtask_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{CompensationTasksColumns[1]},
+			},
+			{
+				Name:    "compensationtask_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{CompensationTasksColumns[5], CompensationTasksColumns[8]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "export_id", Type: field.TypeUUID, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "ready", "failed"}, Default: "pending"},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "download_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "data_exports_users_data_exports",
+				Columns:    []*schema.Column{DataExportsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataexport_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[1]},
+			},
+			{
+				Name:    "dataexport_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[3], DataExportsColumns[9]},
+			},
+			{
+				Name:    "dataexport_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[10], DataExportsColumns[3]},
+			},
+			{
+				Name:    "dataexport_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[8]},
+			},
+		},
+	}
+	// DenylistedTokensColumns holds the columns for the "denylisted_tokens" table.
+	DenylistedTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"token", "session"}},
+		{Name: "value", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DenylistedTokensTable holds the schema information for the "denylisted_tokens" table.
+	DenylistedTokensTable = &schema.Table{
+		Name:       "denylisted_tokens",
+		Columns:    DenylistedTokensColumns,
+		PrimaryKey: []*schema.Column{DenylistedTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "denylistedtoken_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{DenylistedTokensColumns[1]},
+			},
+			{
+				Name:    "denylistedtoken_kind_value",
+				Unique:  true,
+				Columns: []*schema.Column{DenylistedTokensColumns[2], DenylistedTokensColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "denylistedtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DenylistedTokensColumns[4]},
+			},
+		},
+	}
+	// EmailChangeRequestsColumns holds the columns for the "email_change_requests" table.
+	EmailChangeRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "new_email", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailChangeRequestsTable holds the schema information for the "email_change_requests" table.
+	EmailChangeRequestsTable = &schema.Table{
+		Name:       "email_change_requests",
+		Columns:    EmailChangeRequestsColumns,
+		PrimaryKey: []*schema.Column{EmailChangeRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_change_requests_users_email_change_request",
+				Columns:    []*schema.Column{EmailChangeRequestsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailchangerequest_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailChangeRequestsColumns[1]},
+			},
+			{
+				Name:    "emailchangerequest_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{EmailChangeRequestsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "follower_id", Type: field.TypeInt},
+		{Name: "followee_id", Type: field.TypeInt},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follows_users_following",
+				Columns:    []*schema.Column{FollowsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "follows_users_followers",
+				Columns:    []*schema.Column{FollowsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[1]},
+			},
+			{
+				Name:    "follow_follower_id_followee_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[3], FollowsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "follow_followee_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[4]},
+			},
+		},
+	}
+	// FollowRequestsColumns holds the columns for the "follow_requests" table.
+	FollowRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "requester_id", Type: field.TypeInt},
+		{Name: "target_id", Type: field.TypeInt},
+	}
+	// FollowRequestsTable holds the schema information for the "follow_requests" table.
+	FollowRequestsTable = &schema.Table{
+		Name:       "follow_requests",
+		Columns:    FollowRequestsColumns,
+		PrimaryKey: []*schema.Column{FollowRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follow_requests_users_sent_follow_requests",
+				Columns:    []*schema.Column{FollowRequestsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "follow_requests_users_received_follow_requests",
+				Columns:    []*schema.Column{FollowRequestsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "followrequest_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FollowRequestsColumns[1]},
+			},
+			{
+				Name:    "followrequest_requester_id_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowRequestsColumns[3], FollowRequestsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "followrequest_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowRequestsColumns[4]},
+			},
+		},
+	}
+	// IdempotencyRecordsColumns holds the columns for the "idempotency_records" table.
+	IdempotencyRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"register_user"}},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// IdempotencyRecordsTable holds the schema information for the "idempotency_records" table.
+	IdempotencyRecordsTable = &schema.Table{
+		Name:       "idempotency_records",
+		Columns:    IdempotencyRecordsColumns,
+		PrimaryKey: []*schema.Column{IdempotencyRecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_records_users_idempotency_records",
+				Columns:    []*schema.Column{IdempotencyRecordsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencyrecord_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyRecordsColumns[1]},
+			},
+			{
+				Name:    "idempotencyrecord_operation_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyRecordsColumns[2], IdempotencyRecordsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "idempotencyrecord_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyRecordsColumns[6]},
+			},
+		},
+	}
+	// ProfilesColumns holds the columns for the "profiles" table.
+	ProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Size: 50},
+		{Name: "bio", Type: field.TypeString, Size: 500, Default: ""},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "height_cm", Type: field.TypeInt, Nullable: true},
+		{Name: "favorite_styles", Type: field.TypeJSON},
+		{Name: "links", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ProfilesTable holds the schema information for the "profiles" table.
+	ProfilesTable = &schema.Table{
+		Name:       "profiles",
+		Columns:    ProfilesColumns,
+		PrimaryKey: []*schema.Column{ProfilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profiles_users_profile",
+				Columns:    []*schema.Column{ProfilesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profile_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilesColumns[1]},
+			},
+			{
+				Name:    "profile_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProfilesColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token_id", Type: field.TypeString, Unique: true},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[1]},
+			},
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[3]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[8]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "session_id", Type: field.TypeUUID, Unique: true},
+		{Name: "device_name", Type: field.TypeString, Default: ""},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"ios", "android", "web", "unknown"}, Default: "unknown"},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[1]},
+			},
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[11]},
+			},
+		},
+	}
 	// TestsColumns holds the columns for the "tests" table.
 	TestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "done", Type: field.TypeBool, Default: false},
 	}
@@ -19,16 +479,110 @@ var (
 		Name:       "tests",
 		Columns:    TestsColumns,
 		PrimaryKey: []*schema.Column{TestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "test_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TestsColumns[1]},
+			},
+		},
+	}
+	// TotpCredentialsColumns holds the columns for the "totp_credentials" table.
+	TotpCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "encrypted_secret", Type: field.TypeString},
+		{Name: "recovery_code_hashes", Type: field.TypeJSON},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// TotpCredentialsTable holds the schema information for the "totp_credentials" table.
+	TotpCredentialsTable = &schema.Table{
+		Name:       "totp_credentials",
+		Columns:    TotpCredentialsColumns,
+		PrimaryKey: []*schema.Column{TotpCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "totp_credentials_users_totp_credential",
+				Columns:    []*schema.Column{TotpCredentialsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "totpcredential_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TotpCredentialsColumns[1]},
+			},
+			{
+				Name:    "totpcredential_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TotpCredentialsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// UploadedImagesColumns holds the columns for the "uploaded_images" table.
+	UploadedImagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "image_id", Type: field.TypeUUID, Unique: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"avatar", "coordinate", "listing"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"jpeg", "png"}},
+		{Name: "variants", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UploadedImagesTable holds the schema information for the "uploaded_images" table.
+	UploadedImagesTable = &schema.Table{
+		Name:       "uploaded_images",
+		Columns:    UploadedImagesColumns,
+		PrimaryKey: []*schema.Column{UploadedImagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "uploaded_images_users_uploaded_images",
+				Columns:    []*schema.Column{UploadedImagesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uploadedimage_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadedImagesColumns[1]},
+			},
+			{
+				Name:    "uploadedimage_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadedImagesColumns[7], UploadedImagesColumns[6]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "public_id", Type: field.TypeUUID, Unique: true},
 		{Name: "firebase_uid", Type: field.TypeString, Unique: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_enabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "banned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "follower_count", Type: field.TypeInt, Default: 0},
+		{Name: "following_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_private", Type: field.TypeBool, Default: false},
+		{Name: "hide_closet", Type: field.TypeBool, Default: false},
+		{Name: "hide_likes", Type: field.TypeBool, Default: false},
+		{Name: "comment_permission", Type: field.TypeEnum, Enums: []string{"everyone", "followers", "nobody"}, Default: "everyone"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -36,34 +590,250 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "user_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[1]},
+			},
 			{
 				Name:    "user_public_id",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[2]},
 			},
 			{
 				Name:    "user_firebase_uid",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[2]},
+				Columns: []*schema.Column{UsersColumns[3]},
 			},
 			{
 				Name:    "user_email",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[3]},
+				Columns: []*schema.Column{UsersColumns[4]},
+			},
+		},
+	}
+	// UserBlocksColumns holds the columns for the "user_blocks" table.
+	UserBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blocker_id", Type: field.TypeInt},
+		{Name: "blocked_id", Type: field.TypeInt},
+	}
+	// UserBlocksTable holds the schema information for the "user_blocks" table.
+	UserBlocksTable = &schema.Table{
+		Name:       "user_blocks",
+		Columns:    UserBlocksColumns,
+		PrimaryKey: []*schema.Column{UserBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocks_users_blocking",
+				Columns:    []*schema.Column{UserBlocksColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 			{
-				Name:    "user_deleted_at",
+				Symbol:     "user_blocks_users_blocked_by",
+				Columns:    []*schema.Column{UserBlocksColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userblock_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserBlocksColumns[1]},
+			},
+			{
+				Name:    "userblock_blocker_id_blocked_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserBlocksColumns[3], UserBlocksColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "userblock_blocked_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserBlocksColumns[4]},
+			},
+		},
+	}
+	// UserHandlesColumns holds the columns for the "user_handles" table.
+	UserHandlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "handle", Type: field.TypeString, Size: 30},
+		{Name: "handle_key", Type: field.TypeString, Size: 30},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserHandlesTable holds the schema information for the "user_handles" table.
+	UserHandlesTable = &schema.Table{
+		Name:       "user_handles",
+		Columns:    UserHandlesColumns,
+		PrimaryKey: []*schema.Column{UserHandlesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_handles_users_handles",
+				Columns:    []*schema.Column{UserHandlesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userhandle_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[6]},
+				Columns: []*schema.Column{UserHandlesColumns[1]},
+			},
+			{
+				Name:    "userhandle_handle_key",
+				Unique:  true,
+				Columns: []*schema.Column{UserHandlesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "userhandle_user_id_retired_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserHandlesColumns[6], UserHandlesColumns[5]},
+			},
+		},
+	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"google", "apple", "x", "line"}},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "linked_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserIdentitiesColumns[1]},
+			},
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[2], UserIdentitiesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "useridentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[5], UserIdentitiesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// UserMutesColumns holds the columns for the "user_mutes" table.
+	UserMutesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "muter_id", Type: field.TypeInt},
+		{Name: "muted_id", Type: field.TypeInt},
+	}
+	// UserMutesTable holds the schema information for the "user_mutes" table.
+	UserMutesTable = &schema.Table{
+		Name:       "user_mutes",
+		Columns:    UserMutesColumns,
+		PrimaryKey: []*schema.Column{UserMutesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_mutes_users_muting",
+				Columns:    []*schema.Column{UserMutesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_mutes_users_muted_by",
+				Columns:    []*schema.Column{UserMutesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usermute_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserMutesColumns[1]},
+			},
+			{
+				Name:    "usermute_muter_id_muted_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserMutesColumns[3], UserMutesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuthAttemptsTable,
+		CompensationTasksTable,
+		DataExportsTable,
+		DenylistedTokensTable,
+		EmailChangeRequestsTable,
+		FollowsTable,
+		FollowRequestsTable,
+		IdempotencyRecordsTable,
+		ProfilesTable,
+		RefreshTokensTable,
+		SessionsTable,
 		TestsTable,
+		TotpCredentialsTable,
+		UploadedImagesTable,
 		UsersTable,
+		UserBlocksTable,
+		UserHandlesTable,
+		UserIdentitiesTable,
+		UserMutesTable,
 	}
 )
 
 func init() {
+	DataExportsTable.ForeignKeys[0].RefTable = UsersTable
+	EmailChangeRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowsTable.ForeignKeys[1].RefTable = UsersTable
+	FollowRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	IdempotencyRecordsTable.ForeignKeys[0].RefTable = UsersTable
+	ProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	TotpCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	UploadedImagesTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlocksTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlocksTable.ForeignKeys[1].RefTable = UsersTable
+	UserHandlesTable.ForeignKeys[0].RefTable = UsersTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	UserMutesTable.ForeignKeys[0].RefTable = UsersTable
+	UserMutesTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -6,8 +6,59 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuthAttempt is the predicate function for authattempt builders.
+type AuthAttempt func(*sql.Selector)
+
+// CompensationTask is the predicate function for compensationtask builders.
+type CompensationTask func(*sql.Selector)
+
+// DataExport is the predicate function for dataexport builders.
+type DataExport func(*sql.Selector)
+
+// DenylistedToken is the predicate function for denylistedtoken builders.
+type DenylistedToken func(*sql.Selector)
+
+// EmailChangeRequest is the predicate function for emailchangerequest builders.
+type EmailChangeRequest func(*sql.Selector)
+
+// Follow is the predicate function for follow builders.
+type Follow func(*sql.Selector)
+
+// FollowRequest is the predicate function for followrequest builders.
+type FollowRequest func(*sql.Selector)
+
+// IdempotencyRecord is the predicate function for idempotencyrecord builders.
+type IdempotencyRecord func(*sql.Selector)
+
+// Profile is the predicate function for profile builders.
+type Profile func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Test is the predicate function for test builders.
 type Test func(*sql.Selector)
 
+// TotpCredential is the predicate function for totpcredential builders.
+type TotpCredential func(*sql.Selector)
+
+// UploadedImage is the predicate function for uploadedimage builders.
+type UploadedImage func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserBlock is the predicate function for userblock builders.
+type UserBlock func(*sql.Selector)
+
+// UserHandle is the predicate function for userhandle builders.
+type UserHandle func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
+// UserMute is the predicate function for usermute builders.
+type UserMute func(*sql.Selector)
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/profile"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileCreate is the builder for creating a Profile entity.
+type ProfileCreate struct {
+	config
+	mutation *ProfileMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ProfileCreate) SetDeletedAt(v time.Time) *ProfileCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableDeletedAt(v *time.Time) *ProfileCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ProfileCreate) SetUserID(v int) *ProfileCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *ProfileCreate) SetDisplayName(v string) *ProfileCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetBio sets the "bio" field.
+func (_c *ProfileCreate) SetBio(v string) *ProfileCreate {
+	_c.mutation.SetBio(v)
+	return _c
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableBio(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetBio(*v)
+	}
+	return _c
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_c *ProfileCreate) SetAvatarURL(v string) *ProfileCreate {
+	_c.mutation.SetAvatarURL(v)
+	return _c
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableAvatarURL(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetAvatarURL(*v)
+	}
+	return _c
+}
+
+// SetHeightCm sets the "height_cm" field.
+func (_c *ProfileCreate) SetHeightCm(v int) *ProfileCreate {
+	_c.mutation.SetHeightCm(v)
+	return _c
+}
+
+// SetNillableHeightCm sets the "height_cm" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableHeightCm(v *int) *ProfileCreate {
+	if v != nil {
+		_c.SetHeightCm(*v)
+	}
+	return _c
+}
+
+// SetFavoriteStyles sets the "favorite_styles" field.
+func (_c *ProfileCreate) SetFavoriteStyles(v []string) *ProfileCreate {
+	_c.mutation.SetFavoriteStyles(v)
+	return _c
+}
+
+// SetLinks sets the "links" field.
+func (_c *ProfileCreate) SetLinks(v []string) *ProfileCreate {
+	_c.mutation.SetLinks(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProfileCreate) SetCreatedAt(v time.Time) *ProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableCreatedAt(v *time.Time) *ProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProfileCreate) SetUpdatedAt(v time.Time) *ProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableUpdatedAt(v *time.Time) *ProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ProfileCreate) SetUser(v *User) *ProfileCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_c *ProfileCreate) Mutation() *ProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the Profile in the database.
+func (_c *ProfileCreate) Save(ctx context.Context) (*Profile, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProfileCreate) SaveX(ctx context.Context) *Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProfileCreate) defaults() error {
+	if _, ok := _c.mutation.Bio(); !ok {
+		v := profile.DefaultBio
+		_c.mutation.SetBio(v)
+	}
+	if _, ok := _c.mutation.FavoriteStyles(); !ok {
+		v := profile.DefaultFavoriteStyles
+		_c.mutation.SetFavoriteStyles(v)
+	}
+	if _, ok := _c.mutation.Links(); !ok {
+		v := profile.DefaultLinks
+		_c.mutation.SetLinks(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if profile.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if profile.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProfileCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Profile.user_id"`)}
+	}
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "Profile.display_name"`)}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := profile.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "Profile.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Bio(); !ok {
+		return &ValidationError{Name: "bio", err: errors.New(`ent: missing required field "Profile.bio"`)}
+	}
+	if v, ok := _c.mutation.Bio(); ok {
+		if err := profile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "Profile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FavoriteStyles(); !ok {
+		return &ValidationError{Name: "favorite_styles", err: errors.New(`ent: missing required field "Profile.favorite_styles"`)}
+	}
+	if _, ok := _c.mutation.Links(); !ok {
+		return &ValidationError{Name: "links", err: errors.New(`ent: missing required field "Profile.links"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Profile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Profile.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Profile.user"`)}
+	}
+	return nil
+}
+
+func (_c *ProfileCreate) sqlSave(ctx context.Context) (*Profile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Profile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(profile.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(profile.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.Bio(); ok {
+		_spec.SetField(profile.FieldBio, field.TypeString, value)
+		_node.Bio = value
+	}
+	if value, ok := _c.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = &value
+	}
+	if value, ok := _c.mutation.HeightCm(); ok {
+		_spec.SetField(profile.FieldHeightCm, field.TypeInt, value)
+		_node.HeightCm = &value
+	}
+	if value, ok := _c.mutation.FavoriteStyles(); ok {
+		_spec.SetField(profile.FieldFavoriteStyles, field.TypeJSON, value)
+		_node.FavoriteStyles = value
+	}
+	if value, ok := _c.mutation.Links(); ok {
+		_spec.SetField(profile.FieldLinks, field.TypeJSON, value)
+		_node.Links = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(profile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profile.UserTable,
+			Columns: []string{profile.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProfileCreateBulk is the builder for creating many Profile entities in bulk.
+type ProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileCreate
+}
+
+// Save creates the Profile entities in the database.
+func (_c *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Profile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProfileCreateBulk) SaveX(ctx context.Context) []*Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/predicate"
+	"sleeve/ent/profile"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileUpdate is the builder for updating Profile entities.
+type ProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdate) Where(ps ...predicate.Profile) *ProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProfileUpdate) SetDeletedAt(v time.Time) *ProfileUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableDeletedAt(v *time.Time) *ProfileUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProfileUpdate) ClearDeletedAt() *ProfileUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *ProfileUpdate) SetDisplayName(v string) *ProfileUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableDisplayName(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *ProfileUpdate) SetBio(v string) *ProfileUpdate {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableBio(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ProfileUpdate) SetAvatarURL(v string) *ProfileUpdate {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableAvatarURL(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ProfileUpdate) ClearAvatarURL() *ProfileUpdate {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetHeightCm sets the "height_cm" field.
+func (_u *ProfileUpdate) SetHeightCm(v int) *ProfileUpdate {
+	_u.mutation.ResetHeightCm()
+	_u.mutation.SetHeightCm(v)
+	return _u
+}
+
+// SetNillableHeightCm sets the "height_cm" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableHeightCm(v *int) *ProfileUpdate {
+	if v != nil {
+		_u.SetHeightCm(*v)
+	}
+	return _u
+}
+
+// AddHeightCm adds value to the "height_cm" field.
+func (_u *ProfileUpdate) AddHeightCm(v int) *ProfileUpdate {
+	_u.mutation.AddHeightCm(v)
+	return _u
+}
+
+// ClearHeightCm clears the value of the "height_cm" field.
+func (_u *ProfileUpdate) ClearHeightCm() *ProfileUpdate {
+	_u.mutation.ClearHeightCm()
+	return _u
+}
+
+// SetFavoriteStyles sets the "favorite_styles" field.
+func (_u *ProfileUpdate) SetFavoriteStyles(v []string) *ProfileUpdate {
+	_u.mutation.SetFavoriteStyles(v)
+	return _u
+}
+
+// AppendFavoriteStyles appends value to the "favorite_styles" field.
+func (_u *ProfileUpdate) AppendFavoriteStyles(v []string) *ProfileUpdate {
+	_u.mutation.AppendFavoriteStyles(v)
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *ProfileUpdate) SetLinks(v []string) *ProfileUpdate {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *ProfileUpdate) AppendLinks(v []string) *ProfileUpdate {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProfileUpdate) SetUpdatedAt(v time.Time) *ProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdate) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProfileUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProfileUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if profile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdate) check() error {
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := profile.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "Profile.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := profile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "Profile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
+	}
+	return nil
+}
+
+func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(profile.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(profile.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(profile.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(profile.FieldBio, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(profile.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.HeightCm(); ok {
+		_spec.SetField(profile.FieldHeightCm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeightCm(); ok {
+		_spec.AddField(profile.FieldHeightCm, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCmCleared() {
+		_spec.ClearField(profile.FieldHeightCm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FavoriteStyles(); ok {
+		_spec.SetField(profile.FieldFavoriteStyles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFavoriteStyles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldFavoriteStyles, value)
+		})
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(profile.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldLinks, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProfileUpdateOne is the builder for updating a single Profile entity.
+type ProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProfileUpdateOne) SetDeletedAt(v time.Time) *ProfileUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableDeletedAt(v *time.Time) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProfileUpdateOne) ClearDeletedAt() *ProfileUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *ProfileUpdateOne) SetDisplayName(v string) *ProfileUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableDisplayName(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *ProfileUpdateOne) SetBio(v string) *ProfileUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableBio(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ProfileUpdateOne) SetAvatarURL(v string) *ProfileUpdateOne {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableAvatarURL(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ProfileUpdateOne) ClearAvatarURL() *ProfileUpdateOne {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetHeightCm sets the "height_cm" field.
+func (_u *ProfileUpdateOne) SetHeightCm(v int) *ProfileUpdateOne {
+	_u.mutation.ResetHeightCm()
+	_u.mutation.SetHeightCm(v)
+	return _u
+}
+
+// SetNillableHeightCm sets the "height_cm" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableHeightCm(v *int) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetHeightCm(*v)
+	}
+	return _u
+}
+
+// AddHeightCm adds value to the "height_cm" field.
+func (_u *ProfileUpdateOne) AddHeightCm(v int) *ProfileUpdateOne {
+	_u.mutation.AddHeightCm(v)
+	return _u
+}
+
+// ClearHeightCm clears the value of the "height_cm" field.
+func (_u *ProfileUpdateOne) ClearHeightCm() *ProfileUpdateOne {
+	_u.mutation.ClearHeightCm()
+	return _u
+}
+
+// SetFavoriteStyles sets the "favorite_styles" field.
+func (_u *ProfileUpdateOne) SetFavoriteStyles(v []string) *ProfileUpdateOne {
+	_u.mutation.SetFavoriteStyles(v)
+	return _u
+}
+
+// AppendFavoriteStyles appends value to the "favorite_styles" field.
+func (_u *ProfileUpdateOne) AppendFavoriteStyles(v []string) *ProfileUpdateOne {
+	_u.mutation.AppendFavoriteStyles(v)
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *ProfileUpdateOne) SetLinks(v []string) *ProfileUpdateOne {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *ProfileUpdateOne) AppendLinks(v []string) *ProfileUpdateOne {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProfileUpdateOne) SetUpdatedAt(v time.Time) *ProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdateOne) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdateOne) Where(ps ...predicate.Profile) *ProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProfileUpdateOne) Select(field string, fields ...string) *ProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Profile entity.
+func (_u *ProfileUpdateOne) Save(ctx context.Context) (*Profile, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdateOne) SaveX(ctx context.Context) *Profile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProfileUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if profile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized profile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := profile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := profile.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "Profile.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := profile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "Profile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
+	}
+	return nil
+}
+
+func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Profile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profile.FieldID)
+		for _, f := range fields {
+			if !profile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(profile.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(profile.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(profile.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(profile.FieldBio, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(profile.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.HeightCm(); ok {
+		_spec.SetField(profile.FieldHeightCm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeightCm(); ok {
+		_spec.AddField(profile.FieldHeightCm, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCmCleared() {
+		_spec.ClearField(profile.FieldHeightCm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FavoriteStyles(); ok {
+		_spec.SetField(profile.FieldFavoriteStyles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFavoriteStyles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldFavoriteStyles, value)
+		})
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(profile.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldLinks, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(profile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Profile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
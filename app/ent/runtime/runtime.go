@@ -2,7 +2,479 @@
 
 package runtime
 
-// The schema-stitching logic is generated in sleeve/ent/runtime.go
+import (
+	"sleeve/ent/authattempt"
+	"sleeve/ent/compensationtask"
+	"sleeve/ent/dataexport"
+	"sleeve/ent/denylistedtoken"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/follow"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/idempotencyrecord"
+	"sleeve/ent/profile"
+	"sleeve/ent/refreshtoken"
+	"sleeve/ent/schema"
+	"sleeve/ent/session"
+	"sleeve/ent/test"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/uploadedimage"
+	"sleeve/ent/user"
+	"sleeve/ent/userblock"
+	"sleeve/ent/userhandle"
+	"sleeve/ent/useridentity"
+	"sleeve/ent/usermute"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	authattemptMixin := schema.AuthAttempt{}.Mixin()
+	authattemptMixinHooks0 := authattemptMixin[0].Hooks()
+	authattempt.Hooks[0] = authattemptMixinHooks0[0]
+	authattempt.Hooks[1] = authattemptMixinHooks0[1]
+	authattemptMixinInters0 := authattemptMixin[0].Interceptors()
+	authattempt.Interceptors[0] = authattemptMixinInters0[0]
+	authattemptFields := schema.AuthAttempt{}.Fields()
+	_ = authattemptFields
+	// authattemptDescKey is the schema descriptor for key field.
+	authattemptDescKey := authattemptFields[0].Descriptor()
+	// authattempt.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	authattempt.KeyValidator = authattemptDescKey.Validators[0].(func(string) error)
+	// authattemptDescFailureCount is the schema descriptor for failure_count field.
+	authattemptDescFailureCount := authattemptFields[1].Descriptor()
+	// authattempt.DefaultFailureCount holds the default value on creation for the failure_count field.
+	authattempt.DefaultFailureCount = authattemptDescFailureCount.Default.(int)
+	compensationtaskMixin := schema.CompensationTask{}.Mixin()
+	compensationtaskMixinHooks0 := compensationtaskMixin[0].Hooks()
+	compensationtask.Hooks[0] = compensationtaskMixinHooks0[0]
+	compensationtask.Hooks[1] = compensationtaskMixinHooks0[1]
+	compensationtaskMixinInters0 := compensationtaskMixin[0].Interceptors()
+	compensationtask.Interceptors[0] = compensationtaskMixinInters0[0]
+	compensationtaskFields := schema.CompensationTask{}.Fields()
+	_ = compensationtaskFields
+	// compensationtaskDescTaskID is the schema descriptor for task_id field.
+	compensationtaskDescTaskID := compensationtaskFields[0].Descriptor()
+	// compensationtask.DefaultTaskID holds the default value on creation for the task_id field.
+	compensationtask.DefaultTaskID = compensationtaskDescTaskID.Default.(func() uuid.UUID)
+	// compensationtaskDescTarget is the schema descriptor for target field.
+	compensationtaskDescTarget := compensationtaskFields[2].Descriptor()
+	// compensationtask.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	compensationtask.TargetValidator = compensationtaskDescTarget.Validators[0].(func(string) error)
+	// compensationtaskDescAttempts is the schema descriptor for attempts field.
+	compensationtaskDescAttempts := compensationtaskFields[4].Descriptor()
+	// compensationtask.DefaultAttempts holds the default value on creation for the attempts field.
+	compensationtask.DefaultAttempts = compensationtaskDescAttempts.Default.(int)
+	// compensationtaskDescLastError is the schema descriptor for last_error field.
+	compensationtaskDescLastError := compensationtaskFields[5].Descriptor()
+	// compensationtask.DefaultLastError holds the default value on creation for the last_error field.
+	compensationtask.DefaultLastError = compensationtaskDescLastError.Default.(string)
+	// compensationtaskDescCreatedAt is the schema descriptor for created_at field.
+	compensationtaskDescCreatedAt := compensationtaskFields[7].Descriptor()
+	// compensationtask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	compensationtask.DefaultCreatedAt = compensationtaskDescCreatedAt.Default.(func() time.Time)
+	dataexportMixin := schema.DataExport{}.Mixin()
+	dataexportMixinHooks0 := dataexportMixin[0].Hooks()
+	dataexport.Hooks[0] = dataexportMixinHooks0[0]
+	dataexport.Hooks[1] = dataexportMixinHooks0[1]
+	dataexportMixinInters0 := dataexportMixin[0].Interceptors()
+	dataexport.Interceptors[0] = dataexportMixinInters0[0]
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescLastError is the schema descriptor for last_error field.
+	dataexportDescLastError := dataexportFields[5].Descriptor()
+	// dataexport.DefaultLastError holds the default value on creation for the last_error field.
+	dataexport.DefaultLastError = dataexportDescLastError.Default.(string)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[8].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	denylistedtokenMixin := schema.DenylistedToken{}.Mixin()
+	denylistedtokenMixinHooks0 := denylistedtokenMixin[0].Hooks()
+	denylistedtoken.Hooks[0] = denylistedtokenMixinHooks0[0]
+	denylistedtoken.Hooks[1] = denylistedtokenMixinHooks0[1]
+	denylistedtokenMixinInters0 := denylistedtokenMixin[0].Interceptors()
+	denylistedtoken.Interceptors[0] = denylistedtokenMixinInters0[0]
+	denylistedtokenFields := schema.DenylistedToken{}.Fields()
+	_ = denylistedtokenFields
+	// denylistedtokenDescValue is the schema descriptor for value field.
+	denylistedtokenDescValue := denylistedtokenFields[1].Descriptor()
+	// denylistedtoken.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	denylistedtoken.ValueValidator = denylistedtokenDescValue.Validators[0].(func(string) error)
+	// denylistedtokenDescCreatedAt is the schema descriptor for created_at field.
+	denylistedtokenDescCreatedAt := denylistedtokenFields[3].Descriptor()
+	// denylistedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	denylistedtoken.DefaultCreatedAt = denylistedtokenDescCreatedAt.Default.(func() time.Time)
+	emailchangerequestMixin := schema.EmailChangeRequest{}.Mixin()
+	emailchangerequestMixinHooks0 := emailchangerequestMixin[0].Hooks()
+	emailchangerequest.Hooks[0] = emailchangerequestMixinHooks0[0]
+	emailchangerequest.Hooks[1] = emailchangerequestMixinHooks0[1]
+	emailchangerequestMixinInters0 := emailchangerequestMixin[0].Interceptors()
+	emailchangerequest.Interceptors[0] = emailchangerequestMixinInters0[0]
+	emailchangerequestFields := schema.EmailChangeRequest{}.Fields()
+	_ = emailchangerequestFields
+	// emailchangerequestDescNewEmail is the schema descriptor for new_email field.
+	emailchangerequestDescNewEmail := emailchangerequestFields[1].Descriptor()
+	// emailchangerequest.NewEmailValidator is a validator for the "new_email" field. It is called by the builders before save.
+	emailchangerequest.NewEmailValidator = emailchangerequestDescNewEmail.Validators[0].(func(string) error)
+	// emailchangerequestDescTokenHash is the schema descriptor for token_hash field.
+	emailchangerequestDescTokenHash := emailchangerequestFields[2].Descriptor()
+	// emailchangerequest.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailchangerequest.TokenHashValidator = emailchangerequestDescTokenHash.Validators[0].(func(string) error)
+	// emailchangerequestDescCreatedAt is the schema descriptor for created_at field.
+	emailchangerequestDescCreatedAt := emailchangerequestFields[4].Descriptor()
+	// emailchangerequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailchangerequest.DefaultCreatedAt = emailchangerequestDescCreatedAt.Default.(func() time.Time)
+	followMixin := schema.Follow{}.Mixin()
+	followMixinHooks0 := followMixin[0].Hooks()
+	follow.Hooks[0] = followMixinHooks0[0]
+	follow.Hooks[1] = followMixinHooks0[1]
+	followMixinInters0 := followMixin[0].Interceptors()
+	follow.Interceptors[0] = followMixinInters0[0]
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreatedAt is the schema descriptor for created_at field.
+	followDescCreatedAt := followFields[2].Descriptor()
+	// follow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	follow.DefaultCreatedAt = followDescCreatedAt.Default.(func() time.Time)
+	followrequestMixin := schema.FollowRequest{}.Mixin()
+	followrequestMixinHooks0 := followrequestMixin[0].Hooks()
+	followrequest.Hooks[0] = followrequestMixinHooks0[0]
+	followrequest.Hooks[1] = followrequestMixinHooks0[1]
+	followrequestMixinInters0 := followrequestMixin[0].Interceptors()
+	followrequest.Interceptors[0] = followrequestMixinInters0[0]
+	followrequestFields := schema.FollowRequest{}.Fields()
+	_ = followrequestFields
+	// followrequestDescCreatedAt is the schema descriptor for created_at field.
+	followrequestDescCreatedAt := followrequestFields[2].Descriptor()
+	// followrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	followrequest.DefaultCreatedAt = followrequestDescCreatedAt.Default.(func() time.Time)
+	idempotencyrecordMixin := schema.IdempotencyRecord{}.Mixin()
+	idempotencyrecordMixinHooks0 := idempotencyrecordMixin[0].Hooks()
+	idempotencyrecord.Hooks[0] = idempotencyrecordMixinHooks0[0]
+	idempotencyrecord.Hooks[1] = idempotencyrecordMixinHooks0[1]
+	idempotencyrecordMixinInters0 := idempotencyrecordMixin[0].Interceptors()
+	idempotencyrecord.Interceptors[0] = idempotencyrecordMixinInters0[0]
+	idempotencyrecordFields := schema.IdempotencyRecord{}.Fields()
+	_ = idempotencyrecordFields
+	// idempotencyrecordDescKey is the schema descriptor for key field.
+	idempotencyrecordDescKey := idempotencyrecordFields[1].Descriptor()
+	// idempotencyrecord.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencyrecord.KeyValidator = func() func(string) error {
+		validators := idempotencyrecordDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencyrecordDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencyrecordDescFingerprint := idempotencyrecordFields[2].Descriptor()
+	// idempotencyrecord.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencyrecord.FingerprintValidator = idempotencyrecordDescFingerprint.Validators[0].(func(string) error)
+	// idempotencyrecordDescCreatedAt is the schema descriptor for created_at field.
+	idempotencyrecordDescCreatedAt := idempotencyrecordFields[4].Descriptor()
+	// idempotencyrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencyrecord.DefaultCreatedAt = idempotencyrecordDescCreatedAt.Default.(func() time.Time)
+	profileMixin := schema.Profile{}.Mixin()
+	profileMixinHooks0 := profileMixin[0].Hooks()
+	profile.Hooks[0] = profileMixinHooks0[0]
+	profile.Hooks[1] = profileMixinHooks0[1]
+	profileMixinInters0 := profileMixin[0].Interceptors()
+	profile.Interceptors[0] = profileMixinInters0[0]
+	profileFields := schema.Profile{}.Fields()
+	_ = profileFields
+	// profileDescDisplayName is the schema descriptor for display_name field.
+	profileDescDisplayName := profileFields[1].Descriptor()
+	// profile.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	profile.DisplayNameValidator = func() func(string) error {
+		validators := profileDescDisplayName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(display_name string) error {
+			for _, fn := range fns {
+				if err := fn(display_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// profileDescBio is the schema descriptor for bio field.
+	profileDescBio := profileFields[2].Descriptor()
+	// profile.DefaultBio holds the default value on creation for the bio field.
+	profile.DefaultBio = profileDescBio.Default.(string)
+	// profile.BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	profile.BioValidator = profileDescBio.Validators[0].(func(string) error)
+	// profileDescAvatarURL is the schema descriptor for avatar_url field.
+	profileDescAvatarURL := profileFields[3].Descriptor()
+	// profile.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	profile.AvatarURLValidator = profileDescAvatarURL.Validators[0].(func(string) error)
+	// profileDescFavoriteStyles is the schema descriptor for favorite_styles field.
+	profileDescFavoriteStyles := profileFields[5].Descriptor()
+	// profile.DefaultFavoriteStyles holds the default value on creation for the favorite_styles field.
+	profile.DefaultFavoriteStyles = profileDescFavoriteStyles.Default.([]string)
+	// profileDescLinks is the schema descriptor for links field.
+	profileDescLinks := profileFields[6].Descriptor()
+	// profile.DefaultLinks holds the default value on creation for the links field.
+	profile.DefaultLinks = profileDescLinks.Default.([]string)
+	// profileDescCreatedAt is the schema descriptor for created_at field.
+	profileDescCreatedAt := profileFields[7].Descriptor()
+	// profile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profile.DefaultCreatedAt = profileDescCreatedAt.Default.(func() time.Time)
+	// profileDescUpdatedAt is the schema descriptor for updated_at field.
+	profileDescUpdatedAt := profileFields[8].Descriptor()
+	// profile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profile.DefaultUpdatedAt = profileDescUpdatedAt.Default.(func() time.Time)
+	// profile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profile.UpdateDefaultUpdatedAt = profileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinHooks0 := refreshtokenMixin[0].Hooks()
+	refreshtoken.Hooks[0] = refreshtokenMixinHooks0[0]
+	refreshtoken.Hooks[1] = refreshtokenMixinHooks0[1]
+	refreshtokenMixinInters0 := refreshtokenMixin[0].Interceptors()
+	refreshtoken.Interceptors[0] = refreshtokenMixinInters0[0]
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenID is the schema descriptor for token_id field.
+	refreshtokenDescTokenID := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	refreshtoken.TokenIDValidator = refreshtokenDescTokenID.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[6].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	sessionMixin := schema.Session{}.Mixin()
+	sessionMixinHooks0 := sessionMixin[0].Hooks()
+	session.Hooks[0] = sessionMixinHooks0[0]
+	session.Hooks[1] = sessionMixinHooks0[1]
+	sessionMixinInters0 := sessionMixin[0].Interceptors()
+	session.Interceptors[0] = sessionMixinInters0[0]
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescDeviceName is the schema descriptor for device_name field.
+	sessionDescDeviceName := sessionFields[2].Descriptor()
+	// session.DefaultDeviceName holds the default value on creation for the device_name field.
+	session.DefaultDeviceName = sessionDescDeviceName.Default.(string)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[4].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[5].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[9].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	testMixin := schema.Test{}.Mixin()
+	testMixinHooks0 := testMixin[0].Hooks()
+	test.Hooks[0] = testMixinHooks0[0]
+	test.Hooks[1] = testMixinHooks0[1]
+	testMixinInters0 := testMixin[0].Interceptors()
+	test.Interceptors[0] = testMixinInters0[0]
+	testFields := schema.Test{}.Fields()
+	_ = testFields
+	// testDescTitle is the schema descriptor for title field.
+	testDescTitle := testFields[0].Descriptor()
+	// test.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	test.TitleValidator = testDescTitle.Validators[0].(func(string) error)
+	// testDescDone is the schema descriptor for done field.
+	testDescDone := testFields[1].Descriptor()
+	// test.DefaultDone holds the default value on creation for the done field.
+	test.DefaultDone = testDescDone.Default.(bool)
+	totpcredentialMixin := schema.TotpCredential{}.Mixin()
+	totpcredentialMixinHooks0 := totpcredentialMixin[0].Hooks()
+	totpcredential.Hooks[0] = totpcredentialMixinHooks0[0]
+	totpcredential.Hooks[1] = totpcredentialMixinHooks0[1]
+	totpcredentialMixinInters0 := totpcredentialMixin[0].Interceptors()
+	totpcredential.Interceptors[0] = totpcredentialMixinInters0[0]
+	totpcredentialFields := schema.TotpCredential{}.Fields()
+	_ = totpcredentialFields
+	// totpcredentialDescEncryptedSecret is the schema descriptor for encrypted_secret field.
+	totpcredentialDescEncryptedSecret := totpcredentialFields[1].Descriptor()
+	// totpcredential.EncryptedSecretValidator is a validator for the "encrypted_secret" field. It is called by the builders before save.
+	totpcredential.EncryptedSecretValidator = totpcredentialDescEncryptedSecret.Validators[0].(func(string) error)
+	// totpcredentialDescRecoveryCodeHashes is the schema descriptor for recovery_code_hashes field.
+	totpcredentialDescRecoveryCodeHashes := totpcredentialFields[2].Descriptor()
+	// totpcredential.DefaultRecoveryCodeHashes holds the default value on creation for the recovery_code_hashes field.
+	totpcredential.DefaultRecoveryCodeHashes = totpcredentialDescRecoveryCodeHashes.Default.([]string)
+	// totpcredentialDescLastUsedStep is the schema descriptor for last_used_step field.
+	totpcredentialDescLastUsedStep := totpcredentialFields[3].Descriptor()
+	// totpcredential.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	totpcredential.DefaultLastUsedStep = totpcredentialDescLastUsedStep.Default.(int64)
+	// totpcredentialDescCreatedAt is the schema descriptor for created_at field.
+	totpcredentialDescCreatedAt := totpcredentialFields[4].Descriptor()
+	// totpcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	totpcredential.DefaultCreatedAt = totpcredentialDescCreatedAt.Default.(func() time.Time)
+	uploadedimageMixin := schema.UploadedImage{}.Mixin()
+	uploadedimageMixinHooks0 := uploadedimageMixin[0].Hooks()
+	uploadedimage.Hooks[0] = uploadedimageMixinHooks0[0]
+	uploadedimage.Hooks[1] = uploadedimageMixinHooks0[1]
+	uploadedimageMixinInters0 := uploadedimageMixin[0].Interceptors()
+	uploadedimage.Interceptors[0] = uploadedimageMixinInters0[0]
+	uploadedimageFields := schema.UploadedImage{}.Fields()
+	_ = uploadedimageFields
+	// uploadedimageDescCreatedAt is the schema descriptor for created_at field.
+	uploadedimageDescCreatedAt := uploadedimageFields[5].Descriptor()
+	// uploadedimage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	uploadedimage.DefaultCreatedAt = uploadedimageDescCreatedAt.Default.(func() time.Time)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks0 := userMixin[0].Hooks()
+	user.Hooks[0] = userMixinHooks0[0]
+	user.Hooks[1] = userMixinHooks0[1]
+	userMixinInters0 := userMixin[0].Interceptors()
+	user.Interceptors[0] = userMixinInters0[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescPublicID is the schema descriptor for public_id field.
+	userDescPublicID := userFields[0].Descriptor()
+	// user.DefaultPublicID holds the default value on creation for the public_id field.
+	user.DefaultPublicID = userDescPublicID.Default.(func() uuid.UUID)
+	// userDescFirebaseUID is the schema descriptor for firebase_uid field.
+	userDescFirebaseUID := userFields[1].Descriptor()
+	// user.FirebaseUIDValidator is a validator for the "firebase_uid" field. It is called by the builders before save.
+	user.FirebaseUIDValidator = userDescFirebaseUID.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[6].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[7].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescFollowerCount is the schema descriptor for follower_count field.
+	userDescFollowerCount := userFields[10].Descriptor()
+	// user.DefaultFollowerCount holds the default value on creation for the follower_count field.
+	user.DefaultFollowerCount = userDescFollowerCount.Default.(int)
+	// user.FollowerCountValidator is a validator for the "follower_count" field. It is called by the builders before save.
+	user.FollowerCountValidator = userDescFollowerCount.Validators[0].(func(int) error)
+	// userDescFollowingCount is the schema descriptor for following_count field.
+	userDescFollowingCount := userFields[11].Descriptor()
+	// user.DefaultFollowingCount holds the default value on creation for the following_count field.
+	user.DefaultFollowingCount = userDescFollowingCount.Default.(int)
+	// user.FollowingCountValidator is a validator for the "following_count" field. It is called by the builders before save.
+	user.FollowingCountValidator = userDescFollowingCount.Validators[0].(func(int) error)
+	// userDescIsPrivate is the schema descriptor for is_private field.
+	userDescIsPrivate := userFields[12].Descriptor()
+	// user.DefaultIsPrivate holds the default value on creation for the is_private field.
+	user.DefaultIsPrivate = userDescIsPrivate.Default.(bool)
+	// userDescHideCloset is the schema descriptor for hide_closet field.
+	userDescHideCloset := userFields[13].Descriptor()
+	// user.DefaultHideCloset holds the default value on creation for the hide_closet field.
+	user.DefaultHideCloset = userDescHideCloset.Default.(bool)
+	// userDescHideLikes is the schema descriptor for hide_likes field.
+	userDescHideLikes := userFields[14].Descriptor()
+	// user.DefaultHideLikes holds the default value on creation for the hide_likes field.
+	user.DefaultHideLikes = userDescHideLikes.Default.(bool)
+	userblockMixin := schema.UserBlock{}.Mixin()
+	userblockMixinHooks0 := userblockMixin[0].Hooks()
+	userblock.Hooks[0] = userblockMixinHooks0[0]
+	userblock.Hooks[1] = userblockMixinHooks0[1]
+	userblockMixinInters0 := userblockMixin[0].Interceptors()
+	userblock.Interceptors[0] = userblockMixinInters0[0]
+	userblockFields := schema.UserBlock{}.Fields()
+	_ = userblockFields
+	// userblockDescCreatedAt is the schema descriptor for created_at field.
+	userblockDescCreatedAt := userblockFields[2].Descriptor()
+	// userblock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userblock.DefaultCreatedAt = userblockDescCreatedAt.Default.(func() time.Time)
+	userhandleMixin := schema.UserHandle{}.Mixin()
+	userhandleMixinHooks0 := userhandleMixin[0].Hooks()
+	userhandle.Hooks[0] = userhandleMixinHooks0[0]
+	userhandle.Hooks[1] = userhandleMixinHooks0[1]
+	userhandleMixinInters0 := userhandleMixin[0].Interceptors()
+	userhandle.Interceptors[0] = userhandleMixinInters0[0]
+	userhandleFields := schema.UserHandle{}.Fields()
+	_ = userhandleFields
+	// userhandleDescHandle is the schema descriptor for handle field.
+	userhandleDescHandle := userhandleFields[1].Descriptor()
+	// userhandle.HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	userhandle.HandleValidator = func() func(string) error {
+		validators := userhandleDescHandle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(handle string) error {
+			for _, fn := range fns {
+				if err := fn(handle); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userhandleDescHandleKey is the schema descriptor for handle_key field.
+	userhandleDescHandleKey := userhandleFields[2].Descriptor()
+	// userhandle.HandleKeyValidator is a validator for the "handle_key" field. It is called by the builders before save.
+	userhandle.HandleKeyValidator = func() func(string) error {
+		validators := userhandleDescHandleKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(handle_key string) error {
+			for _, fn := range fns {
+				if err := fn(handle_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userhandleDescCreatedAt is the schema descriptor for created_at field.
+	userhandleDescCreatedAt := userhandleFields[3].Descriptor()
+	// userhandle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userhandle.DefaultCreatedAt = userhandleDescCreatedAt.Default.(func() time.Time)
+	useridentityMixin := schema.UserIdentity{}.Mixin()
+	useridentityMixinHooks0 := useridentityMixin[0].Hooks()
+	useridentity.Hooks[0] = useridentityMixinHooks0[0]
+	useridentity.Hooks[1] = useridentityMixinHooks0[1]
+	useridentityMixinInters0 := useridentityMixin[0].Interceptors()
+	useridentity.Interceptors[0] = useridentityMixinInters0[0]
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[2].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = useridentityDescSubject.Validators[0].(func(string) error)
+	// useridentityDescLinkedAt is the schema descriptor for linked_at field.
+	useridentityDescLinkedAt := useridentityFields[3].Descriptor()
+	// useridentity.DefaultLinkedAt holds the default value on creation for the linked_at field.
+	useridentity.DefaultLinkedAt = useridentityDescLinkedAt.Default.(func() time.Time)
+	usermuteMixin := schema.UserMute{}.Mixin()
+	usermuteMixinHooks0 := usermuteMixin[0].Hooks()
+	usermute.Hooks[0] = usermuteMixinHooks0[0]
+	usermute.Hooks[1] = usermuteMixinHooks0[1]
+	usermuteMixinInters0 := usermuteMixin[0].Interceptors()
+	usermute.Interceptors[0] = usermuteMixinInters0[0]
+	usermuteFields := schema.UserMute{}.Fields()
+	_ = usermuteFields
+	// usermuteDescCreatedAt is the schema descriptor for created_at field.
+	usermuteDescCreatedAt := usermuteFields[2].Descriptor()
+	// usermute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usermute.DefaultCreatedAt = usermuteDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
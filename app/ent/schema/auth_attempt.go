@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuthAttempt holds the schema definition for the AuthAttempt entity.
+type AuthAttempt struct {
+	ent.Schema
+}
+
+// Mixin of the AuthAttempt.
+func (AuthAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the AuthAttempt.
+func (AuthAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("失敗回数を数えるキー（email:メールアドレス / ip:IPアドレス / device:端末ID）"),
+		field.Int("failure_count").
+			Default(0).
+			Comment("連続した失敗回数"),
+		field.Time("last_failed_at").
+			Comment("最後に失敗した日時"),
+		field.Time("expires_at").
+			Comment("記録の有効期限（最後の失敗からリセットまでの期間、以降は失敗回数0として扱い削除可能）"),
+	}
+}
+
+// Edges of the AuthAttempt.
+func (AuthAttempt) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuthAttempt.
+func (AuthAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		// キーごとに1件（成功によるリセットで論理削除した記録は除く）
+		index.Fields("key").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 期限切れの記録の削除用
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CompensationTask holds the schema definition for the CompensationTask entity.
+type CompensationTask struct {
+	ent.Schema
+}
+
+// Mixin of the CompensationTask.
+func (CompensationTask) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the CompensationTask.
+func (CompensationTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("task_id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable().
+			Comment("補償処理ID（UUID）"),
+		field.Enum("kind").
+			Values("delete_firebase_user", "enable_firebase_user", "sync_firebase_email").
+			Immutable().
+			Comment("補償処理の種類（delete_firebase_user: Firebaseユーザーの削除, enable_firebase_user: Firebaseユーザーの再有効化, sync_firebase_email: Firebaseのメールアドレスをusersテーブルに合わせる）"),
+		field.String("target").
+			NotEmpty().
+			Immutable().
+			Comment("補償処理の対象（Firebase UIDなど）"),
+		field.Enum("status").
+			Values("pending", "exhausted").
+			Default("pending").
+			Comment("状態（pending: 再試行待ち, exhausted: 再試行回数の上限に到達）"),
+		field.Int("attempts").
+			Default(0).
+			Comment("試行回数"),
+		field.String("last_error").
+			Default("").
+			Comment("最後に失敗した際のエラーメッセージ"),
+		field.Time("next_attempt_at").
+			Comment("次回の試行日時"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the CompensationTask.
+func (CompensationTask) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CompensationTask.
+func (CompensationTask) Indexes() []ent.Index {
+	return []ent.Index{
+		// 再試行対象の取得用
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+type DataExport struct {
+	ent.Schema
+}
+
+// Mixin of the DataExport.
+func (DataExport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("export_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("エクスポートID（UUID、ダウンロードリンクで使用）"),
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.Enum("status").
+			Values("pending", "ready", "failed").
+			Default("pending").
+			Comment("状態（pending: 作成待ち, ready: ダウンロード可能, failed: 作成に失敗）"),
+		field.String("storage_key").
+			Optional().
+			Nillable().
+			Comment("ストレージ上のZIPファイルのキー（作成後に設定）"),
+		field.String("download_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("ダウンロードリンクのトークンのSHA-256ハッシュ（作成後に設定）"),
+		field.String("last_error").
+			Default("").
+			Comment("作成に失敗した際のエラーメッセージ"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("作成が完了した日時"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("ダウンロードの有効期限（期限を過ぎるとZIPファイルと行を削除する）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（エクスポートを要求した日時）"),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("data_exports").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		// 作成待ちのエクスポートの取得用
+		index.Fields("status", "created_at"),
+		// ユーザーの作成待ちのエクスポートの確認用
+		index.Fields("user_id", "status"),
+		// 有効期限切れのエクスポートの削除用
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DenylistedToken holds the schema definition for the DenylistedToken entity.
+type DenylistedToken struct {
+	ent.Schema
+}
+
+// Mixin of the DenylistedToken.
+func (DenylistedToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the DenylistedToken.
+func (DenylistedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("token", "session").
+			Immutable().
+			Comment("失効対象の種類（token: jti, session: sid）"),
+		field.String("value").
+			NotEmpty().
+			Immutable().
+			Comment("失効させたjtiまたはsid"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("登録の有効期限（対象トークンが全て期限切れになる日時）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the DenylistedToken.
+func (DenylistedToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DenylistedToken.
+func (DenylistedToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// トークン検証時の参照用（論理削除した登録は除く）
+		index.Fields("kind", "value").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 期限切れ登録の削除用
+		index.Fields("expires_at"),
+	}
+}
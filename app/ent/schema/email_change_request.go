@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailChangeRequest holds the schema definition for the EmailChangeRequest entity.
+type EmailChangeRequest struct {
+	ent.Schema
+}
+
+// Mixin of the EmailChangeRequest.
+func (EmailChangeRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the EmailChangeRequest.
+func (EmailChangeRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.String("new_email").
+			NotEmpty().
+			Comment("変更後のメールアドレス（確認が完了するまでusers.emailは変更しない）"),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("確認リンクのトークンのSHA-256ハッシュ"),
+		field.Time("expires_at").
+			Comment("確認リンクの有効期限"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（変更を要求した日時）"),
+	}
+}
+
+// Edges of the EmailChangeRequest.
+func (EmailChangeRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 1ユーザーにつき確認待ちの変更は1件（再度要求した場合は置き換える）
+		edge.From("user", User.Type).
+			Ref("email_change_request").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the EmailChangeRequest.
+func (EmailChangeRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// 論理削除されていない要求は1ユーザーにつき1件まで
+		index.Fields("user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
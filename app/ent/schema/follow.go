@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Follow holds the schema definition for the Follow entity.
+type Follow struct {
+	ent.Schema
+}
+
+// Mixin of the Follow.
+func (Follow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("follower_id").
+			Immutable().
+			Comment("フォローしたユーザーのID"),
+		field.Int("followee_id").
+			Immutable().
+			Comment("フォローされたユーザーのID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("フォローした日時"),
+	}
+}
+
+// Edges of the Follow.
+func (Follow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("follower", User.Type).
+			Ref("following").
+			Field("follower_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("followee", User.Type).
+			Ref("followers").
+			Field("followee_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Follow.
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーを重複してフォローしない（フォロー一覧の検索にも使用、フォロー解除で論理削除した行は対象外）
+		index.Fields("follower_id", "followee_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// フォロワー一覧の検索用
+		index.Fields("followee_id"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FollowRequest holds the schema definition for the FollowRequest entity.
+type FollowRequest struct {
+	ent.Schema
+}
+
+// Mixin of the FollowRequest.
+func (FollowRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the FollowRequest.
+func (FollowRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("requester_id").
+			Immutable().
+			Comment("フォローをリクエストしたユーザーのID"),
+		field.Int("target_id").
+			Immutable().
+			Comment("リクエストされた非公開アカウントのユーザーのID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("リクエストした日時"),
+	}
+}
+
+// Edges of the FollowRequest.
+func (FollowRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("requester", User.Type).
+			Ref("sent_follow_requests").
+			Field("requester_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("target", User.Type).
+			Ref("received_follow_requests").
+			Field("target_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the FollowRequest.
+func (FollowRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーに重複してリクエストしない（取り消し・承認・拒否で論理削除したリクエストは除く）
+		index.Fields("requester_id", "target_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 受信したリクエストの一覧の検索用
+		index.Fields("target_id"),
+	}
+}
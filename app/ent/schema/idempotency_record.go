@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyRecord holds the schema definition for the IdempotencyRecord entity.
+type IdempotencyRecord struct {
+	ent.Schema
+}
+
+// Mixin of the IdempotencyRecord.
+func (IdempotencyRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the IdempotencyRecord.
+func (IdempotencyRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("operation").
+			Values("register_user").
+			Immutable().
+			Comment("冪等キーで重複実行を防ぐ操作（register_user: ユーザー登録）"),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("クライアントが生成した冪等キー（Idempotency-Keyヘッダーまたは入力フィールド）"),
+		field.String("fingerprint").
+			NotEmpty().
+			Immutable().
+			Comment("リクエストのフィンガープリント（冪等キーとリクエストの内容のSHA-256）"),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("処理結果のユーザーID（処理中の場合はNULL）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（処理を開始した日時）"),
+		field.Time("expires_at").
+			Comment("有効期限（以降は同じ冪等キーでも新しいリクエストとして処理し、削除可能）"),
+	}
+}
+
+// Edges of the IdempotencyRecord.
+func (IdempotencyRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("idempotency_records").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the IdempotencyRecord.
+func (IdempotencyRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		// 冪等キーは操作ごとに一意（処理の失敗で論理削除した記録は除き、同じキーで再試行できる）
+		index.Fields("operation", "key").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 期限切れの記録の削除用
+		index.Fields("expires_at"),
+	}
+}
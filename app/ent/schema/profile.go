@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Profile holds the schema definition for the Profile entity.
+type Profile struct {
+	ent.Schema
+}
+
+// Mixin of the Profile.
+func (Profile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Profile.
+func (Profile) Fields() []ent.Field {
+	return []ent.Field{
+		// 内部ID（auto increment）のみ。外部にはユーザーの公開IDで公開するためpublic_idは不要
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.String("display_name").
+			NotEmpty().
+			MaxLen(50).
+			Comment("表示名"),
+		field.String("bio").
+			MaxLen(500).
+			Default("").
+			Comment("自己紹介文"),
+		field.String("avatar_url").
+			MaxLen(2048).
+			Optional().
+			Nillable().
+			Comment("アバター画像のURL（未設定の場合はNULL）"),
+		field.Int("height_cm").
+			Optional().
+			Nillable().
+			Comment("身長（cm、未設定の場合はNULL）"),
+		field.Strings("favorite_styles").
+			Default([]string{}).
+			Comment("好きなファッションスタイル（最大10件）"),
+		field.Strings("links").
+			Default([]string{}).
+			Comment("SNSなどのリンクのURL（最大5件）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the Profile.
+func (Profile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("profile").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Profile.
+func (Profile) Indexes() []ent.Index {
+	return []ent.Index{
+		// 論理削除されていないプロフィールは1ユーザーにつき1つまで
+		index.Fields("user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
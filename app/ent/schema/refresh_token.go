@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Mixin of the RefreshToken.
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		// 内部ID（auto increment）のみ。外部には公開しないためpublic_idは不要
+		field.String("token_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("リフレッシュトークンのjti（一意制約のインデックスをjtiでの検索にも使用）"),
+		field.UUID("family_id", uuid.UUID{}).
+			Immutable().
+			Comment("トークンファミリーID（ログイン単位で発行）"),
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("有効期限"),
+		field.Time("rotated_at").
+			Optional().
+			Nillable().
+			Comment("ローテーション日時（使用済みになった日時）"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("失効日時"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 再利用検出時のファミリー一括失効用
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+	}
+}
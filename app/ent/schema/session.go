@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+type Session struct {
+	ent.Schema
+}
+
+// Mixin of the Session.
+func (Session) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		// 内部ID（auto increment）のみ。セッションIDはJWTのsidクレームとして公開する
+		field.UUID("session_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("セッションID（JWTのsidクレーム、リフレッシュトークンのfamily_idと同じ値）"),
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.String("device_name").
+			Default("").
+			Immutable().
+			Comment("端末名（クライアントがX-Device-Nameヘッダーで送信）"),
+		field.Enum("platform").
+			Values("ios", "android", "web", "unknown").
+			Default("unknown").
+			Immutable().
+			Comment("端末のプラットフォーム"),
+		field.String("ip_address").
+			Default("").
+			Immutable().
+			Comment("ログイン時のIPアドレス"),
+		field.String("user_agent").
+			Default("").
+			Immutable().
+			Comment("ログイン時のUser-Agent"),
+		field.Time("last_seen_at").
+			Comment("最終利用日時（トークンのリフレッシュ時に更新）"),
+		field.Time("expires_at").
+			Comment("有効期限（最新のリフレッシュトークンの有効期限）"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("失効日時"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（ログイン日時）"),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーのセッション一覧の取得用
+		index.Fields("user_id"),
+	}
+}
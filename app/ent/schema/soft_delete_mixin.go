@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	gen "sleeve/ent"
+	"sleeve/ent/hook"
+	"sleeve/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// softDeleteKey は論理削除の自動処理を無効にするコンテキストのキーです
+type softDeleteKey struct{}
+
+// soft_delete_skip は論理削除の自動処理を無効にするエンティティの種類です
+type soft_delete_skip struct {
+	all        bool
+	type_names []string
+}
+
+// SkipSoftDelete は論理削除の自動処理を無効にしたコンテキストを返します
+// type_names（gen.TypeUserなど）を指定した場合はその種類のエンティティのみ、省略した場合は全てのエンティティで無効にします
+// 検索・更新で論理削除された行も対象にし、削除は論理削除ではなく物理削除になります
+// 削除の取り消し・退会ユーザーや期限切れの行の物理削除・整合性チェックなど、論理削除された行を扱う処理でのみ使用します
+func SkipSoftDelete(parent context.Context, type_names ...string) context.Context {
+	var skip soft_delete_skip
+
+	skip, _ = parent.Value(softDeleteKey{}).(soft_delete_skip)
+	skip.all = skip.all || len(type_names) == 0
+	skip.type_names = append(slices.Clone(skip.type_names), type_names...)
+	return context.WithValue(parent, softDeleteKey{}, skip)
+}
+
+// is_soft_delete_skipped は指定した種類のエンティティの論理削除の自動処理が無効にされているかを返します
+func is_soft_delete_skipped(ctx context.Context, type_name string) bool {
+	var skip soft_delete_skip
+
+	skip, _ = ctx.Value(softDeleteKey{}).(soft_delete_skip)
+	return skip.all || slices.Contains(skip.type_names, type_name)
+}
+
+// SoftDeleteMixin は論理削除の日時（deleted_at）を追加し、論理削除を自動で処理するMixinです
+// 論理削除するテーブルのスキーマは全てこのMixinを使用します
+//   - 検索（関連の読み込みを含む）では deleted_at IS NULL の行のみを返します
+//   - 更新（Update・UpdateOne）は deleted_at IS NULL の行のみを対象にします
+//   - 削除（Delete・DeleteOne）は deleted_at を設定する更新に変換します
+//
+// 関連の条件（HasXxxWith）は別のクエリではないため自動では絞り込まれません。関連先のDeletedAtIsNilを条件に含めてください
+// 論理削除された行を扱う場合はSkipSoftDeleteで無効にしたコンテキストを使用します
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("削除日時（論理削除）"),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		// deleted_atにインデックス（論理削除の検索用）
+		index.Fields("deleted_at"),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if is_soft_delete_skipped(ctx, q.Type()) {
+				return nil
+			}
+			d.where_not_deleted(q)
+			return nil
+		}),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					var mutation soft_deletable_mutation
+					var ok bool
+
+					if is_soft_delete_skipped(ctx, m.Type()) {
+						return next.Mutate(ctx, m)
+					}
+					mutation, ok = m.(soft_deletable_mutation)
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type for soft delete: %T", m)
+					}
+					// 更新として実行し直すため、論理削除済みの行の除外（削除日時を上書きしない）は更新のフックで行う
+					mutation.SetOp(ent.OpUpdate)
+					mutation.SetDeletedAt(time.Now())
+					return mutation.Client().Mutate(ctx, m)
+				})
+			},
+			ent.OpDeleteOne|ent.OpDelete,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					var mutation soft_deletable_mutation
+					var ok bool
+
+					if is_soft_delete_skipped(ctx, m.Type()) {
+						return next.Mutate(ctx, m)
+					}
+					mutation, ok = m.(soft_deletable_mutation)
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type for soft delete: %T", m)
+					}
+					d.where_not_deleted(mutation)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
+
+// soft_deletable_mutation は論理削除に変換する削除のMutationのインターフェースです
+type soft_deletable_mutation interface {
+	SetOp(ent.Op)
+	Client() *gen.Client
+	SetDeletedAt(time.Time)
+	WhereP(...func(*sql.Selector))
+}
+
+// where_not_deleted は論理削除されていない行のみを対象にする条件を追加します
+func (d SoftDeleteMixin) where_not_deleted(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull(d.Fields()[0].Descriptor().Name))
+}
@@ -0,0 +1,201 @@
+package schema_test
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	gen "sleeve/ent"
+	_ "sleeve/ent/runtime"
+	"sleeve/ent/schema"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// recording_driver は発行されたSQLを記録し、空の結果を返すテスト用のドライバーです
+type recording_driver struct {
+	queries []string
+}
+
+// Exec は更新・削除のSQLを記録します
+func (d *recording_driver) Exec(_ context.Context, query string, _, v any) error {
+	var result *stdsql.Result
+	var ok bool
+
+	d.queries = append(d.queries, query)
+	result, ok = v.(*stdsql.Result)
+	if ok {
+		*result = driver.RowsAffected(1)
+	}
+	return nil
+}
+
+// Query は検索のSQLを記録し、0件の結果を返します
+func (d *recording_driver) Query(_ context.Context, query string, _, v any) error {
+	var rows *sql.Rows
+	var ok bool
+
+	d.queries = append(d.queries, query)
+	rows, ok = v.(*sql.Rows)
+	if ok {
+		rows.ColumnScanner = empty_rows{}
+	}
+	return nil
+}
+
+// Tx はトランザクションを使用しないテストのため未対応です
+func (d *recording_driver) Tx(context.Context) (dialect.Tx, error) {
+	return nil, stdsql.ErrConnDone
+}
+
+// Close は何もしません
+func (d *recording_driver) Close() error {
+	return nil
+}
+
+// Dialect はPostgreSQLとしてSQLを組み立てさせます
+func (d *recording_driver) Dialect() string {
+	return dialect.Postgres
+}
+
+// empty_rows は0件の検索結果です
+type empty_rows struct{}
+
+func (empty_rows) Close() error                               { return nil }
+func (empty_rows) ColumnTypes() ([]*stdsql.ColumnType, error) { return nil, nil }
+func (empty_rows) Columns() ([]string, error)                 { return nil, nil }
+func (empty_rows) Err() error                                 { return nil }
+func (empty_rows) Next() bool                                 { return false }
+func (empty_rows) NextResultSet() bool                        { return false }
+func (empty_rows) Scan(...any) error                          { return nil }
+
+// setup_soft_delete_test はSQLを記録するドライバーを使用したクライアントを作成します
+func setup_soft_delete_test() (*gen.Client, *recording_driver) {
+	var drv *recording_driver
+
+	drv = &recording_driver{}
+	return gen.NewClient(gen.Driver(drv)), drv
+}
+
+// TestSoftDeleteMixin_Query は検索に論理削除されていない行の条件が追加されることをテストします
+func TestSoftDeleteMixin_Query(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var err error
+
+	client, drv = setup_soft_delete_test()
+
+	_, err = client.User.Query().All(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 1 || !strings.Contains(drv.queries[0], `"deleted_at" IS NULL`) {
+		t.Errorf("expected query to filter deleted_at IS NULL, got %v", drv.queries)
+	}
+}
+
+// TestSoftDeleteMixin_Query_SkipSoftDelete はSkipSoftDeleteのコンテキストで論理削除された行も検索されることをテストします
+func TestSoftDeleteMixin_Query_SkipSoftDelete(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var err error
+
+	client, drv = setup_soft_delete_test()
+
+	_, err = client.User.Query().All(schema.SkipSoftDelete(context.Background()))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 1 || strings.Contains(drv.queries[0], "WHERE") {
+		t.Errorf("expected query without deleted_at condition, got %v", drv.queries)
+	}
+}
+
+// TestSoftDeleteMixin_Delete は削除が論理削除されていない行のdeleted_atを設定する更新に変換されることをテストします
+func TestSoftDeleteMixin_Delete(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var err error
+
+	client, drv = setup_soft_delete_test()
+
+	_, err = client.User.Delete().Exec(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 1 {
+		t.Fatalf("expected 1 statement, got %v", drv.queries)
+	}
+	if !strings.HasPrefix(drv.queries[0], `UPDATE "users" SET`) || !strings.Contains(drv.queries[0], `"deleted_at" = $`) {
+		t.Errorf("expected delete to be converted to update of deleted_at, got %s", drv.queries[0])
+	}
+	if !strings.Contains(drv.queries[0], `"deleted_at" IS NULL`) {
+		t.Errorf("expected already deleted rows to be excluded, got %s", drv.queries[0])
+	}
+}
+
+// TestSoftDeleteMixin_Delete_SkipSoftDelete はSkipSoftDeleteのコンテキストで物理削除されることをテストします
+func TestSoftDeleteMixin_Delete_SkipSoftDelete(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var err error
+
+	client, drv = setup_soft_delete_test()
+
+	_, err = client.User.Delete().Exec(schema.SkipSoftDelete(context.Background()))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 1 || !strings.HasPrefix(drv.queries[0], `DELETE FROM "users"`) {
+		t.Errorf("expected hard delete, got %v", drv.queries)
+	}
+}
+
+// TestSoftDeleteMixin_Update は更新に論理削除されていない行の条件が追加されることをテストします
+func TestSoftDeleteMixin_Update(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var err error
+
+	client, drv = setup_soft_delete_test()
+
+	_, err = client.User.Update().SetIsPrivate(true).Save(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 1 || !strings.Contains(drv.queries[0], `"deleted_at" IS NULL`) {
+		t.Errorf("expected update to filter deleted_at IS NULL, got %v", drv.queries)
+	}
+}
+
+// TestSoftDeleteMixin_SkipSoftDelete_TypeNames は種類を指定したSkipSoftDeleteが他の種類のエンティティに影響しないことをテストします
+func TestSoftDeleteMixin_SkipSoftDelete_TypeNames(t *testing.T) {
+	var client *gen.Client
+	var drv *recording_driver
+	var ctx context.Context
+	var err error
+
+	client, drv = setup_soft_delete_test()
+	ctx = schema.SkipSoftDelete(context.Background(), gen.TypeFollow)
+
+	_, err = client.Follow.Delete().Exec(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = client.User.Delete().Exec(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(drv.queries) != 2 {
+		t.Fatalf("expected 2 statements, got %v", drv.queries)
+	}
+	if !strings.HasPrefix(drv.queries[0], `DELETE FROM "follows"`) {
+		t.Errorf("expected hard delete of follows, got %s", drv.queries[0])
+	}
+	if !strings.HasPrefix(drv.queries[1], `UPDATE "users" SET`) {
+		t.Errorf("expected soft delete of users, got %s", drv.queries[1])
+	}
+}
@@ -11,6 +11,13 @@ type Test struct {
 	ent.Schema
 }
 
+// Mixin of the Test.
+func (Test) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Test.
 func (Test) Fields() []ent.Field {
 	return []ent.Field{
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TotpCredential holds the schema definition for the TotpCredential entity.
+type TotpCredential struct {
+	ent.Schema
+}
+
+// Mixin of the TotpCredential.
+func (TotpCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the TotpCredential.
+func (TotpCredential) Fields() []ent.Field {
+	return []ent.Field{
+		// 内部ID（auto increment）のみ。外部には公開しないためpublic_idは不要
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.String("encrypted_secret").
+			NotEmpty().
+			Immutable().
+			Sensitive().
+			Comment("暗号化したTOTPの共有シークレット（AES-256-GCM）"),
+		field.Strings("recovery_code_hashes").
+			Default([]string{}).
+			Sensitive().
+			Comment("リカバリーコードのハッシュ（SHA-256、使用済みのコードは削除）"),
+		field.Int64("last_used_step").
+			Default(0).
+			Comment("最後に使用された認証コードのタイムステップ（同じコードの再利用防止）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the TotpCredential.
+func (TotpCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("totp_credential").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the TotpCredential.
+func (TotpCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		// 論理削除されていない認証情報は1ユーザーにつき1つまで（無効化した後に再登録できる）
+		index.Fields("user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
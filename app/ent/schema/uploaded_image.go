@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"sleeve/ent/schematype"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UploadedImage holds the schema definition for the UploadedImage entity.
+type UploadedImage struct {
+	ent.Schema
+}
+
+// Mixin of the UploadedImage.
+func (UploadedImage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the UploadedImage.
+func (UploadedImage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("image_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("画像ID（UUID、ストレージ上のキーに含める）"),
+		field.Int("user_id").
+			Immutable().
+			Comment("アップロードしたユーザーのID"),
+		field.Enum("purpose").
+			Values("avatar", "coordinate", "listing").
+			Immutable().
+			Comment("画像の用途（avatar: プロフィールのアイコン, coordinate: コーデの投稿, listing: アイテムの出品）"),
+		field.Enum("format").
+			Values("jpeg", "png").
+			Immutable().
+			Comment("画像の形式"),
+		field.JSON("variants", []schematype.UploadedImageVariant{}).
+			Comment("保存したリサイズ画像のストレージ上のキー・公開URL・大きさ（小さい順）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（アップロード日時）"),
+	}
+}
+
+// Edges of the UploadedImage.
+func (UploadedImage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("uploaded_images").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UploadedImage.
+func (UploadedImage) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーの画像の一覧（データのエクスポート・退会ユーザーの物理削除）用
+		index.Fields("user_id", "created_at"),
+	}
+}
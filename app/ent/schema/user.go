@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -14,6 +16,14 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+// 退会したユーザーは論理削除し、削除を取り消せる期間を過ぎた後に物理削除する
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
@@ -33,6 +43,18 @@ func (User) Fields() []ent.Field {
 			NotEmpty().
 			Unique().
 			Comment("メールアドレス"),
+		field.Enum("role").
+			Values("user", "admin").
+			Default("user").
+			Comment("権限ロール"),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable().
+			Comment("メールアドレスの確認日時（未確認の場合はNULL）"),
+		field.Time("mfa_enabled_at").
+			Optional().
+			Nillable().
+			Comment("二要素認証（TOTP）を有効にした日時（無効の場合はNULL）"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -41,16 +63,91 @@ func (User) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新日時"),
-		field.Time("deleted_at").
+		field.String("deletion_token_hash").
 			Optional().
 			Nillable().
-			Comment("削除日時（論理削除）"),
+			Unique().
+			Sensitive().
+			Comment("アカウント削除の取り消しコードのSHA-256ハッシュ（削除の取り消し・物理削除でNULLに戻す）"),
+		field.Time("banned_at").
+			Optional().
+			Nillable().
+			Comment("利用停止（BAN）にした日時（運営が規約違反のユーザーに設定、利用停止されていない場合はNULL）"),
+		field.Int("follower_count").
+			NonNegative().
+			Default(0).
+			Comment("フォロワー数（followsテーブルの非正規化カウント、フォロー・フォロー解除時に加算・減算）"),
+		field.Int("following_count").
+			NonNegative().
+			Default(0).
+			Comment("フォロー数（followsテーブルの非正規化カウント、フォロー・フォロー解除時に加算・減算）"),
+		// プライバシー設定（フォローの承認・一覧の絞り込みで相手のユーザーと同じ行から参照するため、usersテーブルに持つ）
+		field.Bool("is_private").
+			Default(false).
+			Comment("非公開アカウントか（trueの場合はフォローを承認制にし、承認されたフォロワー以外にコンテンツを公開しない）"),
+		field.Bool("hide_closet").
+			Default(false).
+			Comment("クローゼットを本人以外に非表示にするか"),
+		field.Bool("hide_likes").
+			Default(false).
+			Comment("いいねした投稿の一覧を本人以外に非表示にするか"),
+		field.Enum("comment_permission").
+			Values("everyone", "followers", "nobody").
+			Default("everyone").
+			Comment("投稿にコメントできるユーザーの範囲"),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("identities", UserIdentity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("totp_credential", TotpCredential.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("idempotency_records", IdempotencyRecord.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("profile", Profile.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("handles", UserHandle.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// フォローしているユーザーとのフォロー関係（自分がfollower）
+		edge.To("following", Follow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// フォロワーとのフォロー関係（自分がfollowee）
+		edge.To("followers", Follow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 自分が送信した承認待ちのフォローリクエスト（自分がrequester）
+		edge.To("sent_follow_requests", FollowRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 自分が受信した承認待ちのフォローリクエスト（自分がtarget）
+		edge.To("received_follow_requests", FollowRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ブロックしているユーザーとのブロック（自分がblocker）
+		edge.To("blocking", UserBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ブロックされているユーザーとのブロック（自分がblocked）
+		edge.To("blocked_by", UserBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ミュートしているユーザーとのミュート（自分がmuter）
+		edge.To("muting", UserMute.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ミュートされているユーザーとのミュート（自分がmuted）
+		edge.To("muted_by", UserMute.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("data_exports", DataExport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 確認待ちのメールアドレスの変更（論理削除された過去の要求も含む）
+		edge.To("email_change_request", EmailChangeRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// アップロードした画像（ストレージ上のファイルは退会ユーザーの物理削除の前に削除する）
+		edge.To("uploaded_images", UploadedImage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 // Indexes of the User.
@@ -65,7 +162,5 @@ func (User) Indexes() []ent.Index {
 		// emailにインデックス
 		index.Fields("email").
 			Unique(),
-		// deleted_atにインデックス（論理削除の検索用）
-		index.Fields("deleted_at"),
 	}
 }
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserBlock holds the schema definition for the UserBlock entity.
+type UserBlock struct {
+	ent.Schema
+}
+
+// Mixin of the UserBlock.
+func (UserBlock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the UserBlock.
+func (UserBlock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("blocker_id").
+			Immutable().
+			Comment("ブロックしたユーザーのID"),
+		field.Int("blocked_id").
+			Immutable().
+			Comment("ブロックされたユーザーのID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("ブロックした日時"),
+	}
+}
+
+// Edges of the UserBlock.
+func (UserBlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blocker", User.Type).
+			Ref("blocking").
+			Field("blocker_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("blocked", User.Type).
+			Ref("blocked_by").
+			Field("blocked_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserBlock.
+func (UserBlock) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーを重複してブロックしない（ブロックしているかの判定にも使用、解除後は再度ブロックできるよう論理削除した行を除く）
+		index.Fields("blocker_id", "blocked_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// ブロックされているかの判定用
+		index.Fields("blocked_id"),
+	}
+}
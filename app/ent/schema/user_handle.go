@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserHandle holds the schema definition for the UserHandle entity.
+type UserHandle struct {
+	ent.Schema
+}
+
+// Mixin of the UserHandle.
+func (UserHandle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the UserHandle.
+func (UserHandle) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.String("handle").
+			NotEmpty().
+			MaxLen(30).
+			Comment("表示用のハンドル（入力された大文字・小文字を保持し、大文字・小文字のみの変更は上書き）"),
+		field.String("handle_key").
+			NotEmpty().
+			MaxLen(30).
+			Immutable().
+			Comment("重複判定用のハンドル（NFKC正規化後に小文字に変換した値）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時（ハンドルを割り当てた日時）"),
+		field.Time("retired_at").
+			Optional().
+			Nillable().
+			Comment("別のハンドルに変更した日時（現在のハンドルの場合はNULL）"),
+	}
+}
+
+// Edges of the UserHandle.
+func (UserHandle) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("handles").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserHandle.
+func (UserHandle) Indexes() []ent.Index {
+	return []ent.Index{
+		// 大文字・小文字を区別せずにハンドルは一意（変更前のハンドルも削除されるまで他のユーザーは使用不可）
+		// 論理削除したハンドルは除き、他のユーザーが使用できるようにする
+		index.Fields("handle_key").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// ユーザーの現在のハンドルの検索用
+		index.Fields("user_id", "retired_at"),
+	}
+}
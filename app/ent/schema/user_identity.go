@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Mixin of the UserIdentity.
+func (UserIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		// 内部ID（auto increment）のみ。外部には公開しないためpublic_idは不要
+		field.Int("user_id").
+			Immutable().
+			Comment("ユーザーID"),
+		field.Enum("provider").
+			Values("google", "apple", "x", "line").
+			Immutable().
+			Comment("外部プロバイダ"),
+		field.String("subject").
+			NotEmpty().
+			Immutable().
+			Comment("外部プロバイダでのユーザー識別子（sub）"),
+		field.Time("linked_at").
+			Default(time.Now).
+			Immutable().
+			Comment("連携日時"),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("identities").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じ外部アカウントを複数のユーザーに連携させない（連携の解除で論理削除した行は除く）
+		index.Fields("provider", "subject").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 1ユーザーにつき1プロバイダ1アカウントまで
+		index.Fields("user_id", "provider").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserMute holds the schema definition for the UserMute entity.
+type UserMute struct {
+	ent.Schema
+}
+
+// Mixin of the UserMute.
+func (UserMute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the UserMute.
+func (UserMute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("muter_id").
+			Immutable().
+			Comment("ミュートしたユーザーのID"),
+		field.Int("muted_id").
+			Immutable().
+			Comment("ミュートされたユーザーのID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("ミュートした日時"),
+	}
+}
+
+// Edges of the UserMute.
+func (UserMute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("muter", User.Type).
+			Ref("muting").
+			Field("muter_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("muted", User.Type).
+			Ref("muted_by").
+			Field("muted_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserMute.
+func (UserMute) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーを重複してミュートしない（フィードでの絞り込みにも使用、解除で論理削除した行を除く）
+		index.Fields("muter_id", "muted_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
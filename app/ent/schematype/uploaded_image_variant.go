@@ -0,0 +1,12 @@
+// Package schematype はスキーマのJSONカラムで使用する型を定義します
+// 生成されたentのコードから参照されるため、entのコードをimportするschemaパッケージとは分けて定義します
+package schematype
+
+// UploadedImageVariant はアップロードした画像から作成したリサイズ画像です（uploaded_images.variantsの要素）
+type UploadedImageVariant struct {
+	Name       string `json:"name"`
+	StorageKey string `json:"storage_key"`
+	URL        string `json:"url"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sleeve/ent/test"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,6 +20,20 @@ type TestCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TestCreate) SetDeletedAt(v time.Time) *TestCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TestCreate) SetNillableDeletedAt(v *time.Time) *TestCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *TestCreate) SetTitle(v string) *TestCreate {
 	_c.mutation.SetTitle(v)
@@ -46,7 +61,9 @@ func (_c *TestCreate) Mutation() *TestMutation {
 
 // Save creates the Test in the database.
 func (_c *TestCreate) Save(ctx context.Context) (*Test, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -73,11 +90,12 @@ func (_c *TestCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TestCreate) defaults() {
+func (_c *TestCreate) defaults() error {
 	if _, ok := _c.mutation.Done(); !ok {
 		v := test.DefaultDone
 		_c.mutation.SetDone(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -119,6 +137,10 @@ func (_c *TestCreate) createSpec() (*Test, *sqlgraph.CreateSpec) {
 		_node = &Test{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(test.Table, sqlgraph.NewFieldSpec(test.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(test.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(test.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -262,12 +262,12 @@ func (_q *TestQuery) Clone() *TestQuery {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Test.Query().
-//		GroupBy(test.FieldTitle).
+//		GroupBy(test.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TestQuery) GroupBy(field string, fields ...string) *TestGroupBy {
@@ -285,11 +285,11 @@ func (_q *TestQuery) GroupBy(field string, fields ...string) *TestGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Test.Query().
-//		Select(test.FieldTitle).
+//		Select(test.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *TestQuery) Select(fields ...string) *TestSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
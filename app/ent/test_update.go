@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sleeve/ent/predicate"
 	"sleeve/ent/test"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,6 +28,26 @@ func (_u *TestUpdate) Where(ps ...predicate.Test) *TestUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TestUpdate) SetDeletedAt(v time.Time) *TestUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TestUpdate) SetNillableDeletedAt(v *time.Time) *TestUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TestUpdate) ClearDeletedAt() *TestUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TestUpdate) SetTitle(v string) *TestUpdate {
 	_u.mutation.SetTitle(v)
@@ -109,6 +130,12 @@ func (_u *TestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(test.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(test.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(test.FieldTitle, field.TypeString, value)
 	}
@@ -135,6 +162,26 @@ type TestUpdateOne struct {
 	mutation *TestMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TestUpdateOne) SetDeletedAt(v time.Time) *TestUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TestUpdateOne) SetNillableDeletedAt(v *time.Time) *TestUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TestUpdateOne) ClearDeletedAt() *TestUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TestUpdateOne) SetTitle(v string) *TestUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -247,6 +294,12 @@ func (_u *TestUpdateOne) sqlSave(ctx context.Context) (_node *Test, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(test.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(test.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(test.FieldTitle, field.TypeString, value)
 	}
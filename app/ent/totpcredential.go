@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TotpCredential is the model entity for the TotpCredential schema.
+type TotpCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ユーザーID
+	UserID int `json:"user_id,omitempty"`
+	// 暗号化したTOTPの共有シークレット（AES-256-GCM）
+	EncryptedSecret string `json:"-"`
+	// リカバリーコードのハッシュ（SHA-256、使用済みのコードは削除）
+	RecoveryCodeHashes []string `json:"-"`
+	// 最後に使用された認証コードのタイムステップ（同じコードの再利用防止）
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TotpCredentialQuery when eager-loading is set.
+	Edges        TotpCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TotpCredentialEdges holds the relations/edges for other nodes in the graph.
+type TotpCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TotpCredentialEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TotpCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case totpcredential.FieldRecoveryCodeHashes:
+			values[i] = new([]byte)
+		case totpcredential.FieldID, totpcredential.FieldUserID, totpcredential.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case totpcredential.FieldEncryptedSecret:
+			values[i] = new(sql.NullString)
+		case totpcredential.FieldDeletedAt, totpcredential.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TotpCredential fields.
+func (_m *TotpCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case totpcredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case totpcredential.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case totpcredential.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case totpcredential.FieldEncryptedSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_secret", values[i])
+			} else if value.Valid {
+				_m.EncryptedSecret = value.String
+			}
+		case totpcredential.FieldRecoveryCodeHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_code_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RecoveryCodeHashes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_code_hashes: %w", err)
+				}
+			}
+		case totpcredential.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				_m.LastUsedStep = value.Int64
+			}
+		case totpcredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TotpCredential.
+// This includes values selected through modifiers, order, etc.
+func (_m *TotpCredential) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TotpCredential entity.
+func (_m *TotpCredential) QueryUser() *UserQuery {
+	return NewTotpCredentialClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TotpCredential.
+// Note that you need to call TotpCredential.Unwrap() before calling this method if this TotpCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TotpCredential) Update() *TotpCredentialUpdateOne {
+	return NewTotpCredentialClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TotpCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TotpCredential) Unwrap() *TotpCredential {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TotpCredential is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TotpCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("TotpCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("recovery_code_hashes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastUsedStep))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TotpCredentials is a parsable slice of TotpCredential.
+type TotpCredentials []*TotpCredential
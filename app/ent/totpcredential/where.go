@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package totpcredential
+
+import (
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// EncryptedSecret applies equality check predicate on the "encrypted_secret" field. It's identical to EncryptedSecretEQ.
+func EncryptedSecret(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldEncryptedSecret, v))
+}
+
+// LastUsedStep applies equality check predicate on the "last_used_step" field. It's identical to LastUsedStepEQ.
+func LastUsedStep(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// EncryptedSecretEQ applies the EQ predicate on the "encrypted_secret" field.
+func EncryptedSecretEQ(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretNEQ applies the NEQ predicate on the "encrypted_secret" field.
+func EncryptedSecretNEQ(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretIn applies the In predicate on the "encrypted_secret" field.
+func EncryptedSecretIn(vs ...string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldEncryptedSecret, vs...))
+}
+
+// EncryptedSecretNotIn applies the NotIn predicate on the "encrypted_secret" field.
+func EncryptedSecretNotIn(vs ...string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldEncryptedSecret, vs...))
+}
+
+// EncryptedSecretGT applies the GT predicate on the "encrypted_secret" field.
+func EncryptedSecretGT(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGT(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretGTE applies the GTE predicate on the "encrypted_secret" field.
+func EncryptedSecretGTE(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGTE(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretLT applies the LT predicate on the "encrypted_secret" field.
+func EncryptedSecretLT(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLT(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretLTE applies the LTE predicate on the "encrypted_secret" field.
+func EncryptedSecretLTE(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLTE(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretContains applies the Contains predicate on the "encrypted_secret" field.
+func EncryptedSecretContains(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldContains(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretHasPrefix applies the HasPrefix predicate on the "encrypted_secret" field.
+func EncryptedSecretHasPrefix(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldHasPrefix(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretHasSuffix applies the HasSuffix predicate on the "encrypted_secret" field.
+func EncryptedSecretHasSuffix(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldHasSuffix(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretEqualFold applies the EqualFold predicate on the "encrypted_secret" field.
+func EncryptedSecretEqualFold(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEqualFold(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretContainsFold applies the ContainsFold predicate on the "encrypted_secret" field.
+func EncryptedSecretContainsFold(v string) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldContainsFold(FieldEncryptedSecret, v))
+}
+
+// LastUsedStepEQ applies the EQ predicate on the "last_used_step" field.
+func LastUsedStepEQ(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepNEQ applies the NEQ predicate on the "last_used_step" field.
+func LastUsedStepNEQ(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepIn applies the In predicate on the "last_used_step" field.
+func LastUsedStepIn(vs ...int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepNotIn applies the NotIn predicate on the "last_used_step" field.
+func LastUsedStepNotIn(vs ...int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepGT applies the GT predicate on the "last_used_step" field.
+func LastUsedStepGT(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepGTE applies the GTE predicate on the "last_used_step" field.
+func LastUsedStepGTE(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGTE(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLT applies the LT predicate on the "last_used_step" field.
+func LastUsedStepLT(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLTE applies the LTE predicate on the "last_used_step" field.
+func LastUsedStepLTE(v int64) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLTE(FieldLastUsedStep, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TotpCredential {
+	return predicate.TotpCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TotpCredential {
+	return predicate.TotpCredential(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TotpCredential) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TotpCredential) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TotpCredential) predicate.TotpCredential {
+	return predicate.TotpCredential(sql.NotPredicates(p))
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TotpCredentialCreate is the builder for creating a TotpCredential entity.
+type TotpCredentialCreate struct {
+	config
+	mutation *TotpCredentialMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TotpCredentialCreate) SetDeletedAt(v time.Time) *TotpCredentialCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TotpCredentialCreate) SetNillableDeletedAt(v *time.Time) *TotpCredentialCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TotpCredentialCreate) SetUserID(v int) *TotpCredentialCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEncryptedSecret sets the "encrypted_secret" field.
+func (_c *TotpCredentialCreate) SetEncryptedSecret(v string) *TotpCredentialCreate {
+	_c.mutation.SetEncryptedSecret(v)
+	return _c
+}
+
+// SetRecoveryCodeHashes sets the "recovery_code_hashes" field.
+func (_c *TotpCredentialCreate) SetRecoveryCodeHashes(v []string) *TotpCredentialCreate {
+	_c.mutation.SetRecoveryCodeHashes(v)
+	return _c
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_c *TotpCredentialCreate) SetLastUsedStep(v int64) *TotpCredentialCreate {
+	_c.mutation.SetLastUsedStep(v)
+	return _c
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_c *TotpCredentialCreate) SetNillableLastUsedStep(v *int64) *TotpCredentialCreate {
+	if v != nil {
+		_c.SetLastUsedStep(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TotpCredentialCreate) SetCreatedAt(v time.Time) *TotpCredentialCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TotpCredentialCreate) SetNillableCreatedAt(v *time.Time) *TotpCredentialCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TotpCredentialCreate) SetUser(v *User) *TotpCredentialCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the TotpCredentialMutation object of the builder.
+func (_c *TotpCredentialCreate) Mutation() *TotpCredentialMutation {
+	return _c.mutation
+}
+
+// Save creates the TotpCredential in the database.
+func (_c *TotpCredentialCreate) Save(ctx context.Context) (*TotpCredential, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TotpCredentialCreate) SaveX(ctx context.Context) *TotpCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TotpCredentialCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TotpCredentialCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TotpCredentialCreate) defaults() error {
+	if _, ok := _c.mutation.RecoveryCodeHashes(); !ok {
+		v := totpcredential.DefaultRecoveryCodeHashes
+		_c.mutation.SetRecoveryCodeHashes(v)
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		v := totpcredential.DefaultLastUsedStep
+		_c.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if totpcredential.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized totpcredential.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := totpcredential.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TotpCredentialCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TotpCredential.user_id"`)}
+	}
+	if _, ok := _c.mutation.EncryptedSecret(); !ok {
+		return &ValidationError{Name: "encrypted_secret", err: errors.New(`ent: missing required field "TotpCredential.encrypted_secret"`)}
+	}
+	if v, ok := _c.mutation.EncryptedSecret(); ok {
+		if err := totpcredential.EncryptedSecretValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_secret", err: fmt.Errorf(`ent: validator failed for field "TotpCredential.encrypted_secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RecoveryCodeHashes(); !ok {
+		return &ValidationError{Name: "recovery_code_hashes", err: errors.New(`ent: missing required field "TotpCredential.recovery_code_hashes"`)}
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "TotpCredential.last_used_step"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TotpCredential.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TotpCredential.user"`)}
+	}
+	return nil
+}
+
+func (_c *TotpCredentialCreate) sqlSave(ctx context.Context) (*TotpCredential, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TotpCredentialCreate) createSpec() (*TotpCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TotpCredential{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(totpcredential.Table, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(totpcredential.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.EncryptedSecret(); ok {
+		_spec.SetField(totpcredential.FieldEncryptedSecret, field.TypeString, value)
+		_node.EncryptedSecret = value
+	}
+	if value, ok := _c.mutation.RecoveryCodeHashes(); ok {
+		_spec.SetField(totpcredential.FieldRecoveryCodeHashes, field.TypeJSON, value)
+		_node.RecoveryCodeHashes = value
+	}
+	if value, ok := _c.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(totpcredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TotpCredentialCreateBulk is the builder for creating many TotpCredential entities in bulk.
+type TotpCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*TotpCredentialCreate
+}
+
+// Save creates the TotpCredential entities in the database.
+func (_c *TotpCredentialCreateBulk) Save(ctx context.Context) ([]*TotpCredential, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TotpCredential, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TotpCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TotpCredentialCreateBulk) SaveX(ctx context.Context) []*TotpCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TotpCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TotpCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/predicate"
+	"sleeve/ent/totpcredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TotpCredentialUpdate is the builder for updating TotpCredential entities.
+type TotpCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TotpCredentialMutation
+}
+
+// Where appends a list predicates to the TotpCredentialUpdate builder.
+func (_u *TotpCredentialUpdate) Where(ps ...predicate.TotpCredential) *TotpCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TotpCredentialUpdate) SetDeletedAt(v time.Time) *TotpCredentialUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TotpCredentialUpdate) SetNillableDeletedAt(v *time.Time) *TotpCredentialUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TotpCredentialUpdate) ClearDeletedAt() *TotpCredentialUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRecoveryCodeHashes sets the "recovery_code_hashes" field.
+func (_u *TotpCredentialUpdate) SetRecoveryCodeHashes(v []string) *TotpCredentialUpdate {
+	_u.mutation.SetRecoveryCodeHashes(v)
+	return _u
+}
+
+// AppendRecoveryCodeHashes appends value to the "recovery_code_hashes" field.
+func (_u *TotpCredentialUpdate) AppendRecoveryCodeHashes(v []string) *TotpCredentialUpdate {
+	_u.mutation.AppendRecoveryCodeHashes(v)
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *TotpCredentialUpdate) SetLastUsedStep(v int64) *TotpCredentialUpdate {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *TotpCredentialUpdate) SetNillableLastUsedStep(v *int64) *TotpCredentialUpdate {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *TotpCredentialUpdate) AddLastUsedStep(v int64) *TotpCredentialUpdate {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// Mutation returns the TotpCredentialMutation object of the builder.
+func (_u *TotpCredentialUpdate) Mutation() *TotpCredentialMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TotpCredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TotpCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TotpCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TotpCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TotpCredentialUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TotpCredential.user"`)
+	}
+	return nil
+}
+
+func (_u *TotpCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpcredential.Table, totpcredential.Columns, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(totpcredential.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(totpcredential.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RecoveryCodeHashes(); ok {
+		_spec.SetField(totpcredential.FieldRecoveryCodeHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecoveryCodeHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, totpcredential.FieldRecoveryCodeHashes, value)
+		})
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TotpCredentialUpdateOne is the builder for updating a single TotpCredential entity.
+type TotpCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TotpCredentialMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TotpCredentialUpdateOne) SetDeletedAt(v time.Time) *TotpCredentialUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TotpCredentialUpdateOne) SetNillableDeletedAt(v *time.Time) *TotpCredentialUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TotpCredentialUpdateOne) ClearDeletedAt() *TotpCredentialUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRecoveryCodeHashes sets the "recovery_code_hashes" field.
+func (_u *TotpCredentialUpdateOne) SetRecoveryCodeHashes(v []string) *TotpCredentialUpdateOne {
+	_u.mutation.SetRecoveryCodeHashes(v)
+	return _u
+}
+
+// AppendRecoveryCodeHashes appends value to the "recovery_code_hashes" field.
+func (_u *TotpCredentialUpdateOne) AppendRecoveryCodeHashes(v []string) *TotpCredentialUpdateOne {
+	_u.mutation.AppendRecoveryCodeHashes(v)
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *TotpCredentialUpdateOne) SetLastUsedStep(v int64) *TotpCredentialUpdateOne {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *TotpCredentialUpdateOne) SetNillableLastUsedStep(v *int64) *TotpCredentialUpdateOne {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *TotpCredentialUpdateOne) AddLastUsedStep(v int64) *TotpCredentialUpdateOne {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// Mutation returns the TotpCredentialMutation object of the builder.
+func (_u *TotpCredentialUpdateOne) Mutation() *TotpCredentialMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TotpCredentialUpdate builder.
+func (_u *TotpCredentialUpdateOne) Where(ps ...predicate.TotpCredential) *TotpCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TotpCredentialUpdateOne) Select(field string, fields ...string) *TotpCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TotpCredential entity.
+func (_u *TotpCredentialUpdateOne) Save(ctx context.Context) (*TotpCredential, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TotpCredentialUpdateOne) SaveX(ctx context.Context) *TotpCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TotpCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TotpCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TotpCredentialUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TotpCredential.user"`)
+	}
+	return nil
+}
+
+func (_u *TotpCredentialUpdateOne) sqlSave(ctx context.Context) (_node *TotpCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpcredential.Table, totpcredential.Columns, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TotpCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpcredential.FieldID)
+		for _, f := range fields {
+			if !totpcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != totpcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(totpcredential.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(totpcredential.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RecoveryCodeHashes(); ok {
+		_spec.SetField(totpcredential.FieldRecoveryCodeHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecoveryCodeHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, totpcredential.FieldRecoveryCodeHashes, value)
+		})
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	_node = &TotpCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
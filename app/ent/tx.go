@@ -12,10 +12,44 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuthAttempt is the client for interacting with the AuthAttempt builders.
+	AuthAttempt *AuthAttemptClient
+	// CompensationTask is the client for interacting with the CompensationTask builders.
+	CompensationTask *CompensationTaskClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// DenylistedToken is the client for interacting with the DenylistedToken builders.
+	DenylistedToken *DenylistedTokenClient
+	// EmailChangeRequest is the client for interacting with the EmailChangeRequest builders.
+	EmailChangeRequest *EmailChangeRequestClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
+	// FollowRequest is the client for interacting with the FollowRequest builders.
+	FollowRequest *FollowRequestClient
+	// IdempotencyRecord is the client for interacting with the IdempotencyRecord builders.
+	IdempotencyRecord *IdempotencyRecordClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Test is the client for interacting with the Test builders.
 	Test *TestClient
+	// TotpCredential is the client for interacting with the TotpCredential builders.
+	TotpCredential *TotpCredentialClient
+	// UploadedImage is the client for interacting with the UploadedImage builders.
+	UploadedImage *UploadedImageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBlock is the client for interacting with the UserBlock builders.
+	UserBlock *UserBlockClient
+	// UserHandle is the client for interacting with the UserHandle builders.
+	UserHandle *UserHandleClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
+	// UserMute is the client for interacting with the UserMute builders.
+	UserMute *UserMuteClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +181,25 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuthAttempt = NewAuthAttemptClient(tx.config)
+	tx.CompensationTask = NewCompensationTaskClient(tx.config)
+	tx.DataExport = NewDataExportClient(tx.config)
+	tx.DenylistedToken = NewDenylistedTokenClient(tx.config)
+	tx.EmailChangeRequest = NewEmailChangeRequestClient(tx.config)
+	tx.Follow = NewFollowClient(tx.config)
+	tx.FollowRequest = NewFollowRequestClient(tx.config)
+	tx.IdempotencyRecord = NewIdempotencyRecordClient(tx.config)
+	tx.Profile = NewProfileClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.Test = NewTestClient(tx.config)
+	tx.TotpCredential = NewTotpCredentialClient(tx.config)
+	tx.UploadedImage = NewUploadedImageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserBlock = NewUserBlockClient(tx.config)
+	tx.UserHandle = NewUserHandleClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
+	tx.UserMute = NewUserMuteClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +209,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Test.QueryXXX(), the query will be executed
+// applies a query, for example: AuthAttempt.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sleeve/ent/schematype"
+	"sleeve/ent/uploadedimage"
+	"sleeve/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// UploadedImage is the model entity for the UploadedImage schema.
+type UploadedImage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 画像ID（UUID、ストレージ上のキーに含める）
+	ImageID uuid.UUID `json:"image_id,omitempty"`
+	// アップロードしたユーザーのID
+	UserID int `json:"user_id,omitempty"`
+	// 画像の用途（avatar: プロフィールのアイコン, coordinate: コーデの投稿, listing: アイテムの出品）
+	Purpose uploadedimage.Purpose `json:"purpose,omitempty"`
+	// 画像の形式
+	Format uploadedimage.Format `json:"format,omitempty"`
+	// 保存したリサイズ画像のストレージ上のキー・公開URL・大きさ（小さい順）
+	Variants []schematype.UploadedImageVariant `json:"variants,omitempty"`
+	// 作成日時（アップロード日時）
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UploadedImageQuery when eager-loading is set.
+	Edges        UploadedImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UploadedImageEdges holds the relations/edges for other nodes in the graph.
+type UploadedImageEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UploadedImageEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UploadedImage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case uploadedimage.FieldVariants:
+			values[i] = new([]byte)
+		case uploadedimage.FieldID, uploadedimage.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case uploadedimage.FieldPurpose, uploadedimage.FieldFormat:
+			values[i] = new(sql.NullString)
+		case uploadedimage.FieldDeletedAt, uploadedimage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case uploadedimage.FieldImageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UploadedImage fields.
+func (_m *UploadedImage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case uploadedimage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case uploadedimage.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case uploadedimage.FieldImageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field image_id", values[i])
+			} else if value != nil {
+				_m.ImageID = *value
+			}
+		case uploadedimage.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case uploadedimage.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				_m.Purpose = uploadedimage.Purpose(value.String)
+			}
+		case uploadedimage.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = uploadedimage.Format(value.String)
+			}
+		case uploadedimage.FieldVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Variants); err != nil {
+					return fmt.Errorf("unmarshal field variants: %w", err)
+				}
+			}
+		case uploadedimage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UploadedImage.
+// This includes values selected through modifiers, order, etc.
+func (_m *UploadedImage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UploadedImage entity.
+func (_m *UploadedImage) QueryUser() *UserQuery {
+	return NewUploadedImageClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UploadedImage.
+// Note that you need to call UploadedImage.Unwrap() before calling this method if this UploadedImage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UploadedImage) Update() *UploadedImageUpdateOne {
+	return NewUploadedImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UploadedImage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UploadedImage) Unwrap() *UploadedImage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UploadedImage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UploadedImage) String() string {
+	var builder strings.Builder
+	builder.WriteString("UploadedImage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("image_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("variants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Variants))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UploadedImages is a parsable slice of UploadedImage.
+type UploadedImages []*UploadedImage
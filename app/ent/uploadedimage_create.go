@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/schematype"
+	"sleeve/ent/uploadedimage"
+	"sleeve/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadedImageCreate is the builder for creating a UploadedImage entity.
+type UploadedImageCreate struct {
+	config
+	mutation *UploadedImageMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UploadedImageCreate) SetDeletedAt(v time.Time) *UploadedImageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UploadedImageCreate) SetNillableDeletedAt(v *time.Time) *UploadedImageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetImageID sets the "image_id" field.
+func (_c *UploadedImageCreate) SetImageID(v uuid.UUID) *UploadedImageCreate {
+	_c.mutation.SetImageID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UploadedImageCreate) SetUserID(v int) *UploadedImageCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPurpose sets the "purpose" field.
+func (_c *UploadedImageCreate) SetPurpose(v uploadedimage.Purpose) *UploadedImageCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *UploadedImageCreate) SetFormat(v uploadedimage.Format) *UploadedImageCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetVariants sets the "variants" field.
+func (_c *UploadedImageCreate) SetVariants(v []schematype.UploadedImageVariant) *UploadedImageCreate {
+	_c.mutation.SetVariants(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadedImageCreate) SetCreatedAt(v time.Time) *UploadedImageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadedImageCreate) SetNillableCreatedAt(v *time.Time) *UploadedImageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UploadedImageCreate) SetUser(v *User) *UploadedImageCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UploadedImageMutation object of the builder.
+func (_c *UploadedImageCreate) Mutation() *UploadedImageMutation {
+	return _c.mutation
+}
+
+// Save creates the UploadedImage in the database.
+func (_c *UploadedImageCreate) Save(ctx context.Context) (*UploadedImage, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadedImageCreate) SaveX(ctx context.Context) *UploadedImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadedImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadedImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadedImageCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if uploadedimage.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized uploadedimage.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := uploadedimage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadedImageCreate) check() error {
+	if _, ok := _c.mutation.ImageID(); !ok {
+		return &ValidationError{Name: "image_id", err: errors.New(`ent: missing required field "UploadedImage.image_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UploadedImage.user_id"`)}
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "UploadedImage.purpose"`)}
+	}
+	if v, ok := _c.mutation.Purpose(); ok {
+		if err := uploadedimage.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UploadedImage.purpose": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "UploadedImage.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := uploadedimage.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "UploadedImage.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Variants(); !ok {
+		return &ValidationError{Name: "variants", err: errors.New(`ent: missing required field "UploadedImage.variants"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UploadedImage.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UploadedImage.user"`)}
+	}
+	return nil
+}
+
+func (_c *UploadedImageCreate) sqlSave(ctx context.Context) (*UploadedImage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadedImageCreate) createSpec() (*UploadedImage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UploadedImage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(uploadedimage.Table, sqlgraph.NewFieldSpec(uploadedimage.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(uploadedimage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ImageID(); ok {
+		_spec.SetField(uploadedimage.FieldImageID, field.TypeUUID, value)
+		_node.ImageID = value
+	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(uploadedimage.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(uploadedimage.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Variants(); ok {
+		_spec.SetField(uploadedimage.FieldVariants, field.TypeJSON, value)
+		_node.Variants = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(uploadedimage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadedimage.UserTable,
+			Columns: []string{uploadedimage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UploadedImageCreateBulk is the builder for creating many UploadedImage entities in bulk.
+type UploadedImageCreateBulk struct {
+	config
+	err      error
+	builders []*UploadedImageCreate
+}
+
+// Save creates the UploadedImage entities in the database.
+func (_c *UploadedImageCreateBulk) Save(ctx context.Context) ([]*UploadedImage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UploadedImage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadedImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadedImageCreateBulk) SaveX(ctx context.Context) []*UploadedImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadedImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadedImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
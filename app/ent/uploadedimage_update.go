@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/predicate"
+	"sleeve/ent/schematype"
+	"sleeve/ent/uploadedimage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// UploadedImageUpdate is the builder for updating UploadedImage entities.
+type UploadedImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadedImageMutation
+}
+
+// Where appends a list predicates to the UploadedImageUpdate builder.
+func (_u *UploadedImageUpdate) Where(ps ...predicate.UploadedImage) *UploadedImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UploadedImageUpdate) SetDeletedAt(v time.Time) *UploadedImageUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UploadedImageUpdate) SetNillableDeletedAt(v *time.Time) *UploadedImageUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UploadedImageUpdate) ClearDeletedAt() *UploadedImageUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVariants sets the "variants" field.
+func (_u *UploadedImageUpdate) SetVariants(v []schematype.UploadedImageVariant) *UploadedImageUpdate {
+	_u.mutation.SetVariants(v)
+	return _u
+}
+
+// AppendVariants appends value to the "variants" field.
+func (_u *UploadedImageUpdate) AppendVariants(v []schematype.UploadedImageVariant) *UploadedImageUpdate {
+	_u.mutation.AppendVariants(v)
+	return _u
+}
+
+// Mutation returns the UploadedImageMutation object of the builder.
+func (_u *UploadedImageUpdate) Mutation() *UploadedImageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadedImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadedImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadedImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadedImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadedImageUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadedImage.user"`)
+	}
+	return nil
+}
+
+func (_u *UploadedImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadedimage.Table, uploadedimage.Columns, sqlgraph.NewFieldSpec(uploadedimage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(uploadedimage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(uploadedimage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Variants(); ok {
+		_spec.SetField(uploadedimage.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, uploadedimage.FieldVariants, value)
+		})
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadedimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadedImageUpdateOne is the builder for updating a single UploadedImage entity.
+type UploadedImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadedImageMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UploadedImageUpdateOne) SetDeletedAt(v time.Time) *UploadedImageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UploadedImageUpdateOne) SetNillableDeletedAt(v *time.Time) *UploadedImageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UploadedImageUpdateOne) ClearDeletedAt() *UploadedImageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVariants sets the "variants" field.
+func (_u *UploadedImageUpdateOne) SetVariants(v []schematype.UploadedImageVariant) *UploadedImageUpdateOne {
+	_u.mutation.SetVariants(v)
+	return _u
+}
+
+// AppendVariants appends value to the "variants" field.
+func (_u *UploadedImageUpdateOne) AppendVariants(v []schematype.UploadedImageVariant) *UploadedImageUpdateOne {
+	_u.mutation.AppendVariants(v)
+	return _u
+}
+
+// Mutation returns the UploadedImageMutation object of the builder.
+func (_u *UploadedImageUpdateOne) Mutation() *UploadedImageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UploadedImageUpdate builder.
+func (_u *UploadedImageUpdateOne) Where(ps ...predicate.UploadedImage) *UploadedImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadedImageUpdateOne) Select(field string, fields ...string) *UploadedImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UploadedImage entity.
+func (_u *UploadedImageUpdateOne) Save(ctx context.Context) (*UploadedImage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadedImageUpdateOne) SaveX(ctx context.Context) *UploadedImage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadedImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadedImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadedImageUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadedImage.user"`)
+	}
+	return nil
+}
+
+func (_u *UploadedImageUpdateOne) sqlSave(ctx context.Context) (_node *UploadedImage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadedimage.Table, uploadedimage.Columns, sqlgraph.NewFieldSpec(uploadedimage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UploadedImage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadedimage.FieldID)
+		for _, f := range fields {
+			if !uploadedimage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != uploadedimage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(uploadedimage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(uploadedimage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Variants(); ok {
+		_spec.SetField(uploadedimage.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, uploadedimage.FieldVariants, value)
+		})
+	}
+	_node = &UploadedImage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadedimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -18,31 +18,263 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 公開用ユーザーID（UUID）
 	PublicID uuid.UUID `json:"public_id,omitempty"`
 	// Firebase Authentication UID
 	FirebaseUID string `json:"firebase_uid,omitempty"`
 	// メールアドレス
 	Email string `json:"email,omitempty"`
+	// 権限ロール
+	Role user.Role `json:"role,omitempty"`
+	// メールアドレスの確認日時（未確認の場合はNULL）
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// 二要素認証（TOTP）を有効にした日時（無効の場合はNULL）
+	MfaEnabledAt *time.Time `json:"mfa_enabled_at,omitempty"`
 	// 作成日時
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新日時
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 削除日時（論理削除）
-	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	// アカウント削除の取り消しコードのSHA-256ハッシュ（削除の取り消し・物理削除でNULLに戻す）
+	DeletionTokenHash *string `json:"-"`
+	// 利用停止（BAN）にした日時（運営が規約違反のユーザーに設定、利用停止されていない場合はNULL）
+	BannedAt *time.Time `json:"banned_at,omitempty"`
+	// フォロワー数（followsテーブルの非正規化カウント、フォロー・フォロー解除時に加算・減算）
+	FollowerCount int `json:"follower_count,omitempty"`
+	// フォロー数（followsテーブルの非正規化カウント、フォロー・フォロー解除時に加算・減算）
+	FollowingCount int `json:"following_count,omitempty"`
+	// 非公開アカウントか（trueの場合はフォローを承認制にし、承認されたフォロワー以外にコンテンツを公開しない）
+	IsPrivate bool `json:"is_private,omitempty"`
+	// クローゼットを本人以外に非表示にするか
+	HideCloset bool `json:"hide_closet,omitempty"`
+	// いいねした投稿の一覧を本人以外に非表示にするか
+	HideLikes bool `json:"hide_likes,omitempty"`
+	// 投稿にコメントできるユーザーの範囲
+	CommentPermission user.CommentPermission `json:"comment_permission,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// TotpCredential holds the value of the totp_credential edge.
+	TotpCredential []*TotpCredential `json:"totp_credential,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// IdempotencyRecords holds the value of the idempotency_records edge.
+	IdempotencyRecords []*IdempotencyRecord `json:"idempotency_records,omitempty"`
+	// Profile holds the value of the profile edge.
+	Profile []*Profile `json:"profile,omitempty"`
+	// Handles holds the value of the handles edge.
+	Handles []*UserHandle `json:"handles,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*Follow `json:"following,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*Follow `json:"followers,omitempty"`
+	// SentFollowRequests holds the value of the sent_follow_requests edge.
+	SentFollowRequests []*FollowRequest `json:"sent_follow_requests,omitempty"`
+	// ReceivedFollowRequests holds the value of the received_follow_requests edge.
+	ReceivedFollowRequests []*FollowRequest `json:"received_follow_requests,omitempty"`
+	// Blocking holds the value of the blocking edge.
+	Blocking []*UserBlock `json:"blocking,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*UserBlock `json:"blocked_by,omitempty"`
+	// Muting holds the value of the muting edge.
+	Muting []*UserMute `json:"muting,omitempty"`
+	// MutedBy holds the value of the muted_by edge.
+	MutedBy []*UserMute `json:"muted_by,omitempty"`
+	// DataExports holds the value of the data_exports edge.
+	DataExports []*DataExport `json:"data_exports,omitempty"`
+	// EmailChangeRequest holds the value of the email_change_request edge.
+	EmailChangeRequest []*EmailChangeRequest `json:"email_change_request,omitempty"`
+	// UploadedImages holds the value of the uploaded_images edge.
+	UploadedImages []*UploadedImage `json:"uploaded_images,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [18]bool
+}
+
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[0] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[1] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// TotpCredentialOrErr returns the TotpCredential value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TotpCredentialOrErr() ([]*TotpCredential, error) {
+	if e.loadedTypes[2] {
+		return e.TotpCredential, nil
+	}
+	return nil, &NotLoadedError{edge: "totp_credential"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[3] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// IdempotencyRecordsOrErr returns the IdempotencyRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdempotencyRecordsOrErr() ([]*IdempotencyRecord, error) {
+	if e.loadedTypes[4] {
+		return e.IdempotencyRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "idempotency_records"}
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ProfileOrErr() ([]*Profile, error) {
+	if e.loadedTypes[5] {
+		return e.Profile, nil
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// HandlesOrErr returns the Handles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HandlesOrErr() ([]*UserHandle, error) {
+	if e.loadedTypes[6] {
+		return e.Handles, nil
+	}
+	return nil, &NotLoadedError{edge: "handles"}
+}
+
+// FollowingOrErr returns the Following value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowingOrErr() ([]*Follow, error) {
+	if e.loadedTypes[7] {
+		return e.Following, nil
+	}
+	return nil, &NotLoadedError{edge: "following"}
+}
+
+// FollowersOrErr returns the Followers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowersOrErr() ([]*Follow, error) {
+	if e.loadedTypes[8] {
+		return e.Followers, nil
+	}
+	return nil, &NotLoadedError{edge: "followers"}
+}
+
+// SentFollowRequestsOrErr returns the SentFollowRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentFollowRequestsOrErr() ([]*FollowRequest, error) {
+	if e.loadedTypes[9] {
+		return e.SentFollowRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_follow_requests"}
+}
+
+// ReceivedFollowRequestsOrErr returns the ReceivedFollowRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReceivedFollowRequestsOrErr() ([]*FollowRequest, error) {
+	if e.loadedTypes[10] {
+		return e.ReceivedFollowRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "received_follow_requests"}
+}
+
+// BlockingOrErr returns the Blocking value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockingOrErr() ([]*UserBlock, error) {
+	if e.loadedTypes[11] {
+		return e.Blocking, nil
+	}
+	return nil, &NotLoadedError{edge: "blocking"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*UserBlock, error) {
+	if e.loadedTypes[12] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// MutingOrErr returns the Muting value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutingOrErr() ([]*UserMute, error) {
+	if e.loadedTypes[13] {
+		return e.Muting, nil
+	}
+	return nil, &NotLoadedError{edge: "muting"}
+}
+
+// MutedByOrErr returns the MutedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedByOrErr() ([]*UserMute, error) {
+	if e.loadedTypes[14] {
+		return e.MutedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_by"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[15] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
+// EmailChangeRequestOrErr returns the EmailChangeRequest value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailChangeRequestOrErr() ([]*EmailChangeRequest, error) {
+	if e.loadedTypes[16] {
+		return e.EmailChangeRequest, nil
+	}
+	return nil, &NotLoadedError{edge: "email_change_request"}
+}
+
+// UploadedImagesOrErr returns the UploadedImages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UploadedImagesOrErr() ([]*UploadedImage, error) {
+	if e.loadedTypes[17] {
+		return e.UploadedImages, nil
+	}
+	return nil, &NotLoadedError{edge: "uploaded_images"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldIsPrivate, user.FieldHideCloset, user.FieldHideLikes:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldFollowerCount, user.FieldFollowingCount:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFirebaseUID, user.FieldEmail:
+		case user.FieldFirebaseUID, user.FieldEmail, user.FieldRole, user.FieldDeletionTokenHash, user.FieldCommentPermission:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
+		case user.FieldDeletedAt, user.FieldEmailVerifiedAt, user.FieldMfaEnabledAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldBannedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldPublicID:
 			values[i] = new(uuid.UUID)
@@ -67,6 +299,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case user.FieldPublicID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field public_id", values[i])
@@ -85,6 +324,26 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Email = value.String
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldMfaEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_enabled_at", values[i])
+			} else if value.Valid {
+				_m.MfaEnabledAt = new(time.Time)
+				*_m.MfaEnabledAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -97,12 +356,55 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case user.FieldDeletedAt:
+		case user.FieldDeletionTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_token_hash", values[i])
+			} else if value.Valid {
+				_m.DeletionTokenHash = new(string)
+				*_m.DeletionTokenHash = value.String
+			}
+		case user.FieldBannedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
 			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
+				_m.BannedAt = new(time.Time)
+				*_m.BannedAt = value.Time
+			}
+		case user.FieldFollowerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_count", values[i])
+			} else if value.Valid {
+				_m.FollowerCount = int(value.Int64)
+			}
+		case user.FieldFollowingCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field following_count", values[i])
+			} else if value.Valid {
+				_m.FollowingCount = int(value.Int64)
+			}
+		case user.FieldIsPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_private", values[i])
+			} else if value.Valid {
+				_m.IsPrivate = value.Bool
+			}
+		case user.FieldHideCloset:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_closet", values[i])
+			} else if value.Valid {
+				_m.HideCloset = value.Bool
+			}
+		case user.FieldHideLikes:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_likes", values[i])
+			} else if value.Valid {
+				_m.HideLikes = value.Bool
+			}
+		case user.FieldCommentPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_permission", values[i])
+			} else if value.Valid {
+				_m.CommentPermission = user.CommentPermission(value.String)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -117,6 +419,96 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(_m.config).QueryRefreshTokens(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryTotpCredential queries the "totp_credential" edge of the User entity.
+func (_m *User) QueryTotpCredential() *TotpCredentialQuery {
+	return NewUserClient(_m.config).QueryTotpCredential(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryIdempotencyRecords queries the "idempotency_records" edge of the User entity.
+func (_m *User) QueryIdempotencyRecords() *IdempotencyRecordQuery {
+	return NewUserClient(_m.config).QueryIdempotencyRecords(_m)
+}
+
+// QueryProfile queries the "profile" edge of the User entity.
+func (_m *User) QueryProfile() *ProfileQuery {
+	return NewUserClient(_m.config).QueryProfile(_m)
+}
+
+// QueryHandles queries the "handles" edge of the User entity.
+func (_m *User) QueryHandles() *UserHandleQuery {
+	return NewUserClient(_m.config).QueryHandles(_m)
+}
+
+// QueryFollowing queries the "following" edge of the User entity.
+func (_m *User) QueryFollowing() *FollowQuery {
+	return NewUserClient(_m.config).QueryFollowing(_m)
+}
+
+// QueryFollowers queries the "followers" edge of the User entity.
+func (_m *User) QueryFollowers() *FollowQuery {
+	return NewUserClient(_m.config).QueryFollowers(_m)
+}
+
+// QuerySentFollowRequests queries the "sent_follow_requests" edge of the User entity.
+func (_m *User) QuerySentFollowRequests() *FollowRequestQuery {
+	return NewUserClient(_m.config).QuerySentFollowRequests(_m)
+}
+
+// QueryReceivedFollowRequests queries the "received_follow_requests" edge of the User entity.
+func (_m *User) QueryReceivedFollowRequests() *FollowRequestQuery {
+	return NewUserClient(_m.config).QueryReceivedFollowRequests(_m)
+}
+
+// QueryBlocking queries the "blocking" edge of the User entity.
+func (_m *User) QueryBlocking() *UserBlockQuery {
+	return NewUserClient(_m.config).QueryBlocking(_m)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (_m *User) QueryBlockedBy() *UserBlockQuery {
+	return NewUserClient(_m.config).QueryBlockedBy(_m)
+}
+
+// QueryMuting queries the "muting" edge of the User entity.
+func (_m *User) QueryMuting() *UserMuteQuery {
+	return NewUserClient(_m.config).QueryMuting(_m)
+}
+
+// QueryMutedBy queries the "muted_by" edge of the User entity.
+func (_m *User) QueryMutedBy() *UserMuteQuery {
+	return NewUserClient(_m.config).QueryMutedBy(_m)
+}
+
+// QueryDataExports queries the "data_exports" edge of the User entity.
+func (_m *User) QueryDataExports() *DataExportQuery {
+	return NewUserClient(_m.config).QueryDataExports(_m)
+}
+
+// QueryEmailChangeRequest queries the "email_change_request" edge of the User entity.
+func (_m *User) QueryEmailChangeRequest() *EmailChangeRequestQuery {
+	return NewUserClient(_m.config).QueryEmailChangeRequest(_m)
+}
+
+// QueryUploadedImages queries the "uploaded_images" edge of the User entity.
+func (_m *User) QueryUploadedImages() *UploadedImageQuery {
+	return NewUserClient(_m.config).QueryUploadedImages(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -140,6 +532,11 @@ func (_m *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("public_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PublicID))
 	builder.WriteString(", ")
@@ -149,16 +546,49 @@ func (_m *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MfaEnabledAt; v != nil {
+		builder.WriteString("mfa_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
+	builder.WriteString("deletion_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.BannedAt; v != nil {
+		builder.WriteString("banned_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("follower_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FollowerCount))
+	builder.WriteString(", ")
+	builder.WriteString("following_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FollowingCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_private=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsPrivate))
+	builder.WriteString(", ")
+	builder.WriteString("hide_closet=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideCloset))
+	builder.WriteString(", ")
+	builder.WriteString("hide_likes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideLikes))
+	builder.WriteString(", ")
+	builder.WriteString("comment_permission=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommentPermission))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,9 +3,12 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -14,31 +17,226 @@ const (
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldPublicID holds the string denoting the public_id field in the database.
 	FieldPublicID = "public_id"
 	// FieldFirebaseUID holds the string denoting the firebase_uid field in the database.
 	FieldFirebaseUID = "firebase_uid"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldMfaEnabledAt holds the string denoting the mfa_enabled_at field in the database.
+	FieldMfaEnabledAt = "mfa_enabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
+	// FieldDeletionTokenHash holds the string denoting the deletion_token_hash field in the database.
+	FieldDeletionTokenHash = "deletion_token_hash"
+	// FieldBannedAt holds the string denoting the banned_at field in the database.
+	FieldBannedAt = "banned_at"
+	// FieldFollowerCount holds the string denoting the follower_count field in the database.
+	FieldFollowerCount = "follower_count"
+	// FieldFollowingCount holds the string denoting the following_count field in the database.
+	FieldFollowingCount = "following_count"
+	// FieldIsPrivate holds the string denoting the is_private field in the database.
+	FieldIsPrivate = "is_private"
+	// FieldHideCloset holds the string denoting the hide_closet field in the database.
+	FieldHideCloset = "hide_closet"
+	// FieldHideLikes holds the string denoting the hide_likes field in the database.
+	FieldHideLikes = "hide_likes"
+	// FieldCommentPermission holds the string denoting the comment_permission field in the database.
+	FieldCommentPermission = "comment_permission"
+	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
+	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeTotpCredential holds the string denoting the totp_credential edge name in mutations.
+	EdgeTotpCredential = "totp_credential"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeIdempotencyRecords holds the string denoting the idempotency_records edge name in mutations.
+	EdgeIdempotencyRecords = "idempotency_records"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// EdgeHandles holds the string denoting the handles edge name in mutations.
+	EdgeHandles = "handles"
+	// EdgeFollowing holds the string denoting the following edge name in mutations.
+	EdgeFollowing = "following"
+	// EdgeFollowers holds the string denoting the followers edge name in mutations.
+	EdgeFollowers = "followers"
+	// EdgeSentFollowRequests holds the string denoting the sent_follow_requests edge name in mutations.
+	EdgeSentFollowRequests = "sent_follow_requests"
+	// EdgeReceivedFollowRequests holds the string denoting the received_follow_requests edge name in mutations.
+	EdgeReceivedFollowRequests = "received_follow_requests"
+	// EdgeBlocking holds the string denoting the blocking edge name in mutations.
+	EdgeBlocking = "blocking"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeMuting holds the string denoting the muting edge name in mutations.
+	EdgeMuting = "muting"
+	// EdgeMutedBy holds the string denoting the muted_by edge name in mutations.
+	EdgeMutedBy = "muted_by"
+	// EdgeDataExports holds the string denoting the data_exports edge name in mutations.
+	EdgeDataExports = "data_exports"
+	// EdgeEmailChangeRequest holds the string denoting the email_change_request edge name in mutations.
+	EdgeEmailChangeRequest = "email_change_request"
+	// EdgeUploadedImages holds the string denoting the uploaded_images edge name in mutations.
+	EdgeUploadedImages = "uploaded_images"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
+	RefreshTokensColumn = "user_id"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "user_identities"
+	// IdentitiesInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentitiesInverseTable = "user_identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_id"
+	// TotpCredentialTable is the table that holds the totp_credential relation/edge.
+	TotpCredentialTable = "totp_credentials"
+	// TotpCredentialInverseTable is the table name for the TotpCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "totpcredential" package.
+	TotpCredentialInverseTable = "totp_credentials"
+	// TotpCredentialColumn is the table column denoting the totp_credential relation/edge.
+	TotpCredentialColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
+	// IdempotencyRecordsTable is the table that holds the idempotency_records relation/edge.
+	IdempotencyRecordsTable = "idempotency_records"
+	// IdempotencyRecordsInverseTable is the table name for the IdempotencyRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "idempotencyrecord" package.
+	IdempotencyRecordsInverseTable = "idempotency_records"
+	// IdempotencyRecordsColumn is the table column denoting the idempotency_records relation/edge.
+	IdempotencyRecordsColumn = "user_id"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "profiles"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "user_id"
+	// HandlesTable is the table that holds the handles relation/edge.
+	HandlesTable = "user_handles"
+	// HandlesInverseTable is the table name for the UserHandle entity.
+	// It exists in this package in order to avoid circular dependency with the "userhandle" package.
+	HandlesInverseTable = "user_handles"
+	// HandlesColumn is the table column denoting the handles relation/edge.
+	HandlesColumn = "user_id"
+	// FollowingTable is the table that holds the following relation/edge.
+	FollowingTable = "follows"
+	// FollowingInverseTable is the table name for the Follow entity.
+	// It exists in this package in order to avoid circular dependency with the "follow" package.
+	FollowingInverseTable = "follows"
+	// FollowingColumn is the table column denoting the following relation/edge.
+	FollowingColumn = "follower_id"
+	// FollowersTable is the table that holds the followers relation/edge.
+	FollowersTable = "follows"
+	// FollowersInverseTable is the table name for the Follow entity.
+	// It exists in this package in order to avoid circular dependency with the "follow" package.
+	FollowersInverseTable = "follows"
+	// FollowersColumn is the table column denoting the followers relation/edge.
+	FollowersColumn = "followee_id"
+	// SentFollowRequestsTable is the table that holds the sent_follow_requests relation/edge.
+	SentFollowRequestsTable = "follow_requests"
+	// SentFollowRequestsInverseTable is the table name for the FollowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "followrequest" package.
+	SentFollowRequestsInverseTable = "follow_requests"
+	// SentFollowRequestsColumn is the table column denoting the sent_follow_requests relation/edge.
+	SentFollowRequestsColumn = "requester_id"
+	// ReceivedFollowRequestsTable is the table that holds the received_follow_requests relation/edge.
+	ReceivedFollowRequestsTable = "follow_requests"
+	// ReceivedFollowRequestsInverseTable is the table name for the FollowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "followrequest" package.
+	ReceivedFollowRequestsInverseTable = "follow_requests"
+	// ReceivedFollowRequestsColumn is the table column denoting the received_follow_requests relation/edge.
+	ReceivedFollowRequestsColumn = "target_id"
+	// BlockingTable is the table that holds the blocking relation/edge.
+	BlockingTable = "user_blocks"
+	// BlockingInverseTable is the table name for the UserBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "userblock" package.
+	BlockingInverseTable = "user_blocks"
+	// BlockingColumn is the table column denoting the blocking relation/edge.
+	BlockingColumn = "blocker_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge.
+	BlockedByTable = "user_blocks"
+	// BlockedByInverseTable is the table name for the UserBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "userblock" package.
+	BlockedByInverseTable = "user_blocks"
+	// BlockedByColumn is the table column denoting the blocked_by relation/edge.
+	BlockedByColumn = "blocked_id"
+	// MutingTable is the table that holds the muting relation/edge.
+	MutingTable = "user_mutes"
+	// MutingInverseTable is the table name for the UserMute entity.
+	// It exists in this package in order to avoid circular dependency with the "usermute" package.
+	MutingInverseTable = "user_mutes"
+	// MutingColumn is the table column denoting the muting relation/edge.
+	MutingColumn = "muter_id"
+	// MutedByTable is the table that holds the muted_by relation/edge.
+	MutedByTable = "user_mutes"
+	// MutedByInverseTable is the table name for the UserMute entity.
+	// It exists in this package in order to avoid circular dependency with the "usermute" package.
+	MutedByInverseTable = "user_mutes"
+	// MutedByColumn is the table column denoting the muted_by relation/edge.
+	MutedByColumn = "muted_id"
+	// DataExportsTable is the table that holds the data_exports relation/edge.
+	DataExportsTable = "data_exports"
+	// DataExportsInverseTable is the table name for the DataExport entity.
+	// It exists in this package in order to avoid circular dependency with the "dataexport" package.
+	DataExportsInverseTable = "data_exports"
+	// DataExportsColumn is the table column denoting the data_exports relation/edge.
+	DataExportsColumn = "user_id"
+	// EmailChangeRequestTable is the table that holds the email_change_request relation/edge.
+	EmailChangeRequestTable = "email_change_requests"
+	// EmailChangeRequestInverseTable is the table name for the EmailChangeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "emailchangerequest" package.
+	EmailChangeRequestInverseTable = "email_change_requests"
+	// EmailChangeRequestColumn is the table column denoting the email_change_request relation/edge.
+	EmailChangeRequestColumn = "user_id"
+	// UploadedImagesTable is the table that holds the uploaded_images relation/edge.
+	UploadedImagesTable = "uploaded_images"
+	// UploadedImagesInverseTable is the table name for the UploadedImage entity.
+	// It exists in this package in order to avoid circular dependency with the "uploadedimage" package.
+	UploadedImagesInverseTable = "uploaded_images"
+	// UploadedImagesColumn is the table column denoting the uploaded_images relation/edge.
+	UploadedImagesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldPublicID,
 	FieldFirebaseUID,
 	FieldEmail,
+	FieldRole,
+	FieldEmailVerifiedAt,
+	FieldMfaEnabledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldDeletedAt,
+	FieldDeletionTokenHash,
+	FieldBannedAt,
+	FieldFollowerCount,
+	FieldFollowingCount,
+	FieldIsPrivate,
+	FieldHideCloset,
+	FieldHideLikes,
+	FieldCommentPermission,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -51,7 +249,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "sleeve/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultPublicID holds the default value on creation for the "public_id" field.
 	DefaultPublicID func() uuid.UUID
 	// FirebaseUIDValidator is a validator for the "firebase_uid" field. It is called by the builders before save.
@@ -64,8 +269,75 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultFollowerCount holds the default value on creation for the "follower_count" field.
+	DefaultFollowerCount int
+	// FollowerCountValidator is a validator for the "follower_count" field. It is called by the builders before save.
+	FollowerCountValidator func(int) error
+	// DefaultFollowingCount holds the default value on creation for the "following_count" field.
+	DefaultFollowingCount int
+	// FollowingCountValidator is a validator for the "following_count" field. It is called by the builders before save.
+	FollowingCountValidator func(int) error
+	// DefaultIsPrivate holds the default value on creation for the "is_private" field.
+	DefaultIsPrivate bool
+	// DefaultHideCloset holds the default value on creation for the "hide_closet" field.
+	DefaultHideCloset bool
+	// DefaultHideLikes holds the default value on creation for the "hide_likes" field.
+	DefaultHideLikes bool
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
+// CommentPermission defines the type for the "comment_permission" enum field.
+type CommentPermission string
+
+// CommentPermissionEveryone is the default value of the CommentPermission enum.
+const DefaultCommentPermission = CommentPermissionEveryone
+
+// CommentPermission values.
+const (
+	CommentPermissionEveryone  CommentPermission = "everyone"
+	CommentPermissionFollowers CommentPermission = "followers"
+	CommentPermissionNobody    CommentPermission = "nobody"
+)
+
+func (cp CommentPermission) String() string {
+	return string(cp)
+}
+
+// CommentPermissionValidator is a validator for the "comment_permission" field enum values. It is called by the builders before save.
+func CommentPermissionValidator(cp CommentPermission) error {
+	switch cp {
+	case CommentPermissionEveryone, CommentPermissionFollowers, CommentPermissionNobody:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for comment_permission field: %q", cp)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -74,6 +346,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByPublicID orders the results by the public_id field.
 func ByPublicID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublicID, opts...).ToFunc()
@@ -89,6 +366,21 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByMfaEnabledAt orders the results by the mfa_enabled_at field.
+func ByMfaEnabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaEnabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -99,7 +391,420 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+// ByDeletionTokenHash orders the results by the deletion_token_hash field.
+func ByDeletionTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionTokenHash, opts...).ToFunc()
+}
+
+// ByBannedAt orders the results by the banned_at field.
+func ByBannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedAt, opts...).ToFunc()
+}
+
+// ByFollowerCount orders the results by the follower_count field.
+func ByFollowerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerCount, opts...).ToFunc()
+}
+
+// ByFollowingCountField orders the results by the following_count field.
+func ByFollowingCountField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowingCount, opts...).ToFunc()
+}
+
+// ByIsPrivate orders the results by the is_private field.
+func ByIsPrivate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPrivate, opts...).ToFunc()
+}
+
+// ByHideCloset orders the results by the hide_closet field.
+func ByHideCloset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideCloset, opts...).ToFunc()
+}
+
+// ByHideLikes orders the results by the hide_likes field.
+func ByHideLikes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideLikes, opts...).ToFunc()
+}
+
+// ByCommentPermission orders the results by the comment_permission field.
+func ByCommentPermission(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommentPermission, opts...).ToFunc()
+}
+
+// ByRefreshTokensCount orders the results by refresh_tokens count.
+func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefreshTokensStep(), opts...)
+	}
+}
+
+// ByRefreshTokens orders the results by refresh_tokens terms.
+func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTotpCredentialCount orders the results by totp_credential count.
+func ByTotpCredentialCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTotpCredentialStep(), opts...)
+	}
+}
+
+// ByTotpCredential orders the results by totp_credential terms.
+func ByTotpCredential(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTotpCredentialStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdempotencyRecordsCount orders the results by idempotency_records count.
+func ByIdempotencyRecordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdempotencyRecordsStep(), opts...)
+	}
+}
+
+// ByIdempotencyRecords orders the results by idempotency_records terms.
+func ByIdempotencyRecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdempotencyRecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfileCount orders the results by profile count.
+func ByProfileCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProfileStep(), opts...)
+	}
+}
+
+// ByProfile orders the results by profile terms.
+func ByProfile(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHandlesCount orders the results by handles count.
+func ByHandlesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHandlesStep(), opts...)
+	}
+}
+
+// ByHandles orders the results by handles terms.
+func ByHandles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHandlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowingCount orders the results by following count.
+func ByFollowingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowingStep(), opts...)
+	}
+}
+
+// ByFollowing orders the results by following terms.
+func ByFollowing(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowersCount orders the results by followers count.
+func ByFollowersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowersStep(), opts...)
+	}
+}
+
+// ByFollowers orders the results by followers terms.
+func ByFollowers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentFollowRequestsCount orders the results by sent_follow_requests count.
+func BySentFollowRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentFollowRequestsStep(), opts...)
+	}
+}
+
+// BySentFollowRequests orders the results by sent_follow_requests terms.
+func BySentFollowRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentFollowRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedFollowRequestsCount orders the results by received_follow_requests count.
+func ByReceivedFollowRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedFollowRequestsStep(), opts...)
+	}
+}
+
+// ByReceivedFollowRequests orders the results by received_follow_requests terms.
+func ByReceivedFollowRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedFollowRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockingCount orders the results by blocking count.
+func ByBlockingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockingStep(), opts...)
+	}
+}
+
+// ByBlocking orders the results by blocking terms.
+func ByBlocking(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutingCount orders the results by muting count.
+func ByMutingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutingStep(), opts...)
+	}
+}
+
+// ByMuting orders the results by muting terms.
+func ByMuting(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedByCount orders the results by muted_by count.
+func ByMutedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedByStep(), opts...)
+	}
+}
+
+// ByMutedBy orders the results by muted_by terms.
+func ByMutedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDataExportsCount orders the results by data_exports count.
+func ByDataExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDataExportsStep(), opts...)
+	}
+}
+
+// ByDataExports orders the results by data_exports terms.
+func ByDataExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDataExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailChangeRequestCount orders the results by email_change_request count.
+func ByEmailChangeRequestCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailChangeRequestStep(), opts...)
+	}
+}
+
+// ByEmailChangeRequest orders the results by email_change_request terms.
+func ByEmailChangeRequest(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailChangeRequestStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUploadedImagesCount orders the results by uploaded_images count.
+func ByUploadedImagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadedImagesStep(), opts...)
+	}
+}
+
+// ByUploadedImages orders the results by uploaded_images terms.
+func ByUploadedImages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadedImagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRefreshTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefreshTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newTotpCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TotpCredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TotpCredentialTable, TotpCredentialColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newIdempotencyRecordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdempotencyRecordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdempotencyRecordsTable, IdempotencyRecordsColumn),
+	)
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProfileTable, ProfileColumn),
+	)
+}
+func newHandlesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HandlesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HandlesTable, HandlesColumn),
+	)
+}
+func newFollowingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowingTable, FollowingColumn),
+	)
+}
+func newFollowersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+	)
+}
+func newSentFollowRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentFollowRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentFollowRequestsTable, SentFollowRequestsColumn),
+	)
+}
+func newReceivedFollowRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedFollowRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedFollowRequestsTable, ReceivedFollowRequestsColumn),
+	)
+}
+func newBlockingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockingTable, BlockingColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+	)
+}
+func newMutingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutingTable, MutingColumn),
+	)
+}
+func newMutedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutedByTable, MutedByColumn),
+	)
+}
+func newDataExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DataExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DataExportsTable, DataExportsColumn),
+	)
+}
+func newEmailChangeRequestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailChangeRequestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailChangeRequestTable, EmailChangeRequestColumn),
+	)
+}
+func newUploadedImagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadedImagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadedImagesTable, UploadedImagesColumn),
+	)
 }
@@ -6,7 +6,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sleeve/ent/dataexport"
+	"sleeve/ent/emailchangerequest"
+	"sleeve/ent/follow"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/idempotencyrecord"
+	"sleeve/ent/profile"
+	"sleeve/ent/refreshtoken"
+	"sleeve/ent/session"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/uploadedimage"
 	"sleeve/ent/user"
+	"sleeve/ent/userblock"
+	"sleeve/ent/userhandle"
+	"sleeve/ent/useridentity"
+	"sleeve/ent/usermute"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -21,6 +35,20 @@ type UserCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UserCreate) SetDeletedAt(v time.Time) *UserCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeletedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetPublicID sets the "public_id" field.
 func (_c *UserCreate) SetPublicID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetPublicID(v)
@@ -47,6 +75,48 @@ func (_c *UserCreate) SetEmail(v string) *UserCreate {
 	return _c
 }
 
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v user.Role) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *user.Role) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_c *UserCreate) SetEmailVerifiedAt(v time.Time) *UserCreate {
+	_c.mutation.SetEmailVerifiedAt(v)
+	return _c
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailVerifiedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetEmailVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetMfaEnabledAt sets the "mfa_enabled_at" field.
+func (_c *UserCreate) SetMfaEnabledAt(v time.Time) *UserCreate {
+	_c.mutation.SetMfaEnabledAt(v)
+	return _c
+}
+
+// SetNillableMfaEnabledAt sets the "mfa_enabled_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMfaEnabledAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetMfaEnabledAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -75,20 +145,388 @@ func (_c *UserCreate) SetNillableUpdatedAt(v *time.Time) *UserCreate {
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *UserCreate) SetDeletedAt(v time.Time) *UserCreate {
-	_c.mutation.SetDeletedAt(v)
+// SetDeletionTokenHash sets the "deletion_token_hash" field.
+func (_c *UserCreate) SetDeletionTokenHash(v string) *UserCreate {
+	_c.mutation.SetDeletionTokenHash(v)
 	return _c
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *UserCreate) SetNillableDeletedAt(v *time.Time) *UserCreate {
+// SetNillableDeletionTokenHash sets the "deletion_token_hash" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeletionTokenHash(v *string) *UserCreate {
 	if v != nil {
-		_c.SetDeletedAt(*v)
+		_c.SetDeletionTokenHash(*v)
+	}
+	return _c
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (_c *UserCreate) SetBannedAt(v time.Time) *UserCreate {
+	_c.mutation.SetBannedAt(v)
+	return _c
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableBannedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetBannedAt(*v)
+	}
+	return _c
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (_c *UserCreate) SetFollowerCount(v int) *UserCreate {
+	_c.mutation.SetFollowerCount(v)
+	return _c
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (_c *UserCreate) SetNillableFollowerCount(v *int) *UserCreate {
+	if v != nil {
+		_c.SetFollowerCount(*v)
+	}
+	return _c
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (_c *UserCreate) SetFollowingCount(v int) *UserCreate {
+	_c.mutation.SetFollowingCount(v)
+	return _c
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (_c *UserCreate) SetNillableFollowingCount(v *int) *UserCreate {
+	if v != nil {
+		_c.SetFollowingCount(*v)
+	}
+	return _c
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (_c *UserCreate) SetIsPrivate(v bool) *UserCreate {
+	_c.mutation.SetIsPrivate(v)
+	return _c
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (_c *UserCreate) SetNillableIsPrivate(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetIsPrivate(*v)
+	}
+	return _c
+}
+
+// SetHideCloset sets the "hide_closet" field.
+func (_c *UserCreate) SetHideCloset(v bool) *UserCreate {
+	_c.mutation.SetHideCloset(v)
+	return _c
+}
+
+// SetNillableHideCloset sets the "hide_closet" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHideCloset(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetHideCloset(*v)
+	}
+	return _c
+}
+
+// SetHideLikes sets the "hide_likes" field.
+func (_c *UserCreate) SetHideLikes(v bool) *UserCreate {
+	_c.mutation.SetHideLikes(v)
+	return _c
+}
+
+// SetNillableHideLikes sets the "hide_likes" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHideLikes(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetHideLikes(*v)
 	}
 	return _c
 }
 
+// SetCommentPermission sets the "comment_permission" field.
+func (_c *UserCreate) SetCommentPermission(v user.CommentPermission) *UserCreate {
+	_c.mutation.SetCommentPermission(v)
+	return _c
+}
+
+// SetNillableCommentPermission sets the "comment_permission" field if the given value is not nil.
+func (_c *UserCreate) SetNillableCommentPermission(v *user.CommentPermission) *UserCreate {
+	if v != nil {
+		_c.SetCommentPermission(*v)
+	}
+	return _c
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (_c *UserCreate) AddRefreshTokenIDs(ids ...int) *UserCreate {
+	_c.mutation.AddRefreshTokenIDs(ids...)
+	return _c
+}
+
+// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
+func (_c *UserCreate) AddRefreshTokens(v ...*RefreshToken) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefreshTokenIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (_c *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIdentityIDs(ids...)
+	return _c
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (_c *UserCreate) AddIdentities(v ...*UserIdentity) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdentityIDs(ids...)
+}
+
+// AddTotpCredentialIDs adds the "totp_credential" edge to the TotpCredential entity by IDs.
+func (_c *UserCreate) AddTotpCredentialIDs(ids ...int) *UserCreate {
+	_c.mutation.AddTotpCredentialIDs(ids...)
+	return _c
+}
+
+// AddTotpCredential adds the "totp_credential" edges to the TotpCredential entity.
+func (_c *UserCreate) AddTotpCredential(v ...*TotpCredential) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTotpCredentialIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *UserCreate) AddSessions(v ...*Session) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddIdempotencyRecordIDs adds the "idempotency_records" edge to the IdempotencyRecord entity by IDs.
+func (_c *UserCreate) AddIdempotencyRecordIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIdempotencyRecordIDs(ids...)
+	return _c
+}
+
+// AddIdempotencyRecords adds the "idempotency_records" edges to the IdempotencyRecord entity.
+func (_c *UserCreate) AddIdempotencyRecords(v ...*IdempotencyRecord) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdempotencyRecordIDs(ids...)
+}
+
+// AddProfileIDs adds the "profile" edge to the Profile entity by IDs.
+func (_c *UserCreate) AddProfileIDs(ids ...int) *UserCreate {
+	_c.mutation.AddProfileIDs(ids...)
+	return _c
+}
+
+// AddProfile adds the "profile" edges to the Profile entity.
+func (_c *UserCreate) AddProfile(v ...*Profile) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProfileIDs(ids...)
+}
+
+// AddHandleIDs adds the "handles" edge to the UserHandle entity by IDs.
+func (_c *UserCreate) AddHandleIDs(ids ...int) *UserCreate {
+	_c.mutation.AddHandleIDs(ids...)
+	return _c
+}
+
+// AddHandles adds the "handles" edges to the UserHandle entity.
+func (_c *UserCreate) AddHandles(v ...*UserHandle) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHandleIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (_c *UserCreate) AddFollowingIDs(ids ...int) *UserCreate {
+	_c.mutation.AddFollowingIDs(ids...)
+	return _c
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (_c *UserCreate) AddFollowing(v ...*Follow) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFollowingIDs(ids...)
+}
+
+// AddFollowerIDs adds the "followers" edge to the Follow entity by IDs.
+func (_c *UserCreate) AddFollowerIDs(ids ...int) *UserCreate {
+	_c.mutation.AddFollowerIDs(ids...)
+	return _c
+}
+
+// AddFollowers adds the "followers" edges to the Follow entity.
+func (_c *UserCreate) AddFollowers(v ...*Follow) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFollowerIDs(ids...)
+}
+
+// AddSentFollowRequestIDs adds the "sent_follow_requests" edge to the FollowRequest entity by IDs.
+func (_c *UserCreate) AddSentFollowRequestIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSentFollowRequestIDs(ids...)
+	return _c
+}
+
+// AddSentFollowRequests adds the "sent_follow_requests" edges to the FollowRequest entity.
+func (_c *UserCreate) AddSentFollowRequests(v ...*FollowRequest) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSentFollowRequestIDs(ids...)
+}
+
+// AddReceivedFollowRequestIDs adds the "received_follow_requests" edge to the FollowRequest entity by IDs.
+func (_c *UserCreate) AddReceivedFollowRequestIDs(ids ...int) *UserCreate {
+	_c.mutation.AddReceivedFollowRequestIDs(ids...)
+	return _c
+}
+
+// AddReceivedFollowRequests adds the "received_follow_requests" edges to the FollowRequest entity.
+func (_c *UserCreate) AddReceivedFollowRequests(v ...*FollowRequest) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceivedFollowRequestIDs(ids...)
+}
+
+// AddBlockingIDs adds the "blocking" edge to the UserBlock entity by IDs.
+func (_c *UserCreate) AddBlockingIDs(ids ...int) *UserCreate {
+	_c.mutation.AddBlockingIDs(ids...)
+	return _c
+}
+
+// AddBlocking adds the "blocking" edges to the UserBlock entity.
+func (_c *UserCreate) AddBlocking(v ...*UserBlock) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockingIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the UserBlock entity by IDs.
+func (_c *UserCreate) AddBlockedByIDs(ids ...int) *UserCreate {
+	_c.mutation.AddBlockedByIDs(ids...)
+	return _c
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the UserBlock entity.
+func (_c *UserCreate) AddBlockedBy(v ...*UserBlock) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedByIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the UserMute entity by IDs.
+func (_c *UserCreate) AddMutingIDs(ids ...int) *UserCreate {
+	_c.mutation.AddMutingIDs(ids...)
+	return _c
+}
+
+// AddMuting adds the "muting" edges to the UserMute entity.
+func (_c *UserCreate) AddMuting(v ...*UserMute) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the UserMute entity by IDs.
+func (_c *UserCreate) AddMutedByIDs(ids ...int) *UserCreate {
+	_c.mutation.AddMutedByIDs(ids...)
+	return _c
+}
+
+// AddMutedBy adds the "muted_by" edges to the UserMute entity.
+func (_c *UserCreate) AddMutedBy(v ...*UserMute) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMutedByIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_c *UserCreate) AddDataExportIDs(ids ...int) *UserCreate {
+	_c.mutation.AddDataExportIDs(ids...)
+	return _c
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_c *UserCreate) AddDataExports(v ...*DataExport) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDataExportIDs(ids...)
+}
+
+// AddEmailChangeRequestIDs adds the "email_change_request" edge to the EmailChangeRequest entity by IDs.
+func (_c *UserCreate) AddEmailChangeRequestIDs(ids ...int) *UserCreate {
+	_c.mutation.AddEmailChangeRequestIDs(ids...)
+	return _c
+}
+
+// AddEmailChangeRequest adds the "email_change_request" edges to the EmailChangeRequest entity.
+func (_c *UserCreate) AddEmailChangeRequest(v ...*EmailChangeRequest) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEmailChangeRequestIDs(ids...)
+}
+
+// AddUploadedImageIDs adds the "uploaded_images" edge to the UploadedImage entity by IDs.
+func (_c *UserCreate) AddUploadedImageIDs(ids ...int) *UserCreate {
+	_c.mutation.AddUploadedImageIDs(ids...)
+	return _c
+}
+
+// AddUploadedImages adds the "uploaded_images" edges to the UploadedImage entity.
+func (_c *UserCreate) AddUploadedImages(v ...*UploadedImage) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUploadedImageIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -96,7 +534,9 @@ func (_c *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (_c *UserCreate) Save(ctx context.Context) (*User, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -123,19 +563,57 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *UserCreate) defaults() {
+func (_c *UserCreate) defaults() error {
 	if _, ok := _c.mutation.PublicID(); !ok {
+		if user.DefaultPublicID == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultPublicID (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultPublicID()
 		_c.mutation.SetPublicID(v)
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if user.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if user.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.FollowerCount(); !ok {
+		v := user.DefaultFollowerCount
+		_c.mutation.SetFollowerCount(v)
+	}
+	if _, ok := _c.mutation.FollowingCount(); !ok {
+		v := user.DefaultFollowingCount
+		_c.mutation.SetFollowingCount(v)
+	}
+	if _, ok := _c.mutation.IsPrivate(); !ok {
+		v := user.DefaultIsPrivate
+		_c.mutation.SetIsPrivate(v)
+	}
+	if _, ok := _c.mutation.HideCloset(); !ok {
+		v := user.DefaultHideCloset
+		_c.mutation.SetHideCloset(v)
+	}
+	if _, ok := _c.mutation.HideLikes(); !ok {
+		v := user.DefaultHideLikes
+		_c.mutation.SetHideLikes(v)
+	}
+	if _, ok := _c.mutation.CommentPermission(); !ok {
+		v := user.DefaultCommentPermission
+		_c.mutation.SetCommentPermission(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -159,12 +637,53 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := _c.mutation.FollowerCount(); !ok {
+		return &ValidationError{Name: "follower_count", err: errors.New(`ent: missing required field "User.follower_count"`)}
+	}
+	if v, ok := _c.mutation.FollowerCount(); ok {
+		if err := user.FollowerCountValidator(v); err != nil {
+			return &ValidationError{Name: "follower_count", err: fmt.Errorf(`ent: validator failed for field "User.follower_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FollowingCount(); !ok {
+		return &ValidationError{Name: "following_count", err: errors.New(`ent: missing required field "User.following_count"`)}
+	}
+	if v, ok := _c.mutation.FollowingCount(); ok {
+		if err := user.FollowingCountValidator(v); err != nil {
+			return &ValidationError{Name: "following_count", err: fmt.Errorf(`ent: validator failed for field "User.following_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsPrivate(); !ok {
+		return &ValidationError{Name: "is_private", err: errors.New(`ent: missing required field "User.is_private"`)}
+	}
+	if _, ok := _c.mutation.HideCloset(); !ok {
+		return &ValidationError{Name: "hide_closet", err: errors.New(`ent: missing required field "User.hide_closet"`)}
+	}
+	if _, ok := _c.mutation.HideLikes(); !ok {
+		return &ValidationError{Name: "hide_likes", err: errors.New(`ent: missing required field "User.hide_likes"`)}
+	}
+	if _, ok := _c.mutation.CommentPermission(); !ok {
+		return &ValidationError{Name: "comment_permission", err: errors.New(`ent: missing required field "User.comment_permission"`)}
+	}
+	if v, ok := _c.mutation.CommentPermission(); ok {
+		if err := user.CommentPermissionValidator(v); err != nil {
+			return &ValidationError{Name: "comment_permission", err: fmt.Errorf(`ent: validator failed for field "User.comment_permission": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -191,6 +710,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.PublicID(); ok {
 		_spec.SetField(user.FieldPublicID, field.TypeUUID, value)
 		_node.PublicID = value
@@ -203,6 +726,18 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
+	if value, ok := _c.mutation.MfaEnabledAt(); ok {
+		_spec.SetField(user.FieldMfaEnabledAt, field.TypeTime, value)
+		_node.MfaEnabledAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -211,9 +746,325 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
+	if value, ok := _c.mutation.DeletionTokenHash(); ok {
+		_spec.SetField(user.FieldDeletionTokenHash, field.TypeString, value)
+		_node.DeletionTokenHash = &value
+	}
+	if value, ok := _c.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+		_node.BannedAt = &value
+	}
+	if value, ok := _c.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+		_node.FollowerCount = value
+	}
+	if value, ok := _c.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+		_node.FollowingCount = value
+	}
+	if value, ok := _c.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+		_node.IsPrivate = value
+	}
+	if value, ok := _c.mutation.HideCloset(); ok {
+		_spec.SetField(user.FieldHideCloset, field.TypeBool, value)
+		_node.HideCloset = value
+	}
+	if value, ok := _c.mutation.HideLikes(); ok {
+		_spec.SetField(user.FieldHideLikes, field.TypeBool, value)
+		_node.HideLikes = value
+	}
+	if value, ok := _c.mutation.CommentPermission(); ok {
+		_spec.SetField(user.FieldCommentPermission, field.TypeEnum, value)
+		_node.CommentPermission = value
+	}
+	if nodes := _c.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TotpCredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TotpCredentialTable,
+			Columns: []string{user.TotpCredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IdempotencyRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdempotencyRecordsTable,
+			Columns: []string{user.IdempotencyRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProfileTable,
+			Columns: []string{user.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HandlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandlesTable,
+			Columns: []string{user.HandlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userhandle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SentFollowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentFollowRequestsTable,
+			Columns: []string{user.SentFollowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceivedFollowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedFollowRequestsTable,
+			Columns: []string{user.ReceivedFollowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MutingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usermute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usermute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmailChangeRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangeRequestTable,
+			Columns: []string{user.EmailChangeRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UploadedImagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedImagesTable,
+			Columns: []string{user.UploadedImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uploadedimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userblock
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the userblock type in the database.
+	Label = "user_block"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldBlockerID holds the string denoting the blocker_id field in the database.
+	FieldBlockerID = "blocker_id"
+	// FieldBlockedID holds the string denoting the blocked_id field in the database.
+	FieldBlockedID = "blocked_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBlocker holds the string denoting the blocker edge name in mutations.
+	EdgeBlocker = "blocker"
+	// EdgeBlocked holds the string denoting the blocked edge name in mutations.
+	EdgeBlocked = "blocked"
+	// Table holds the table name of the userblock in the database.
+	Table = "user_blocks"
+	// BlockerTable is the table that holds the blocker relation/edge.
+	BlockerTable = "user_blocks"
+	// BlockerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BlockerInverseTable = "users"
+	// BlockerColumn is the table column denoting the blocker relation/edge.
+	BlockerColumn = "blocker_id"
+	// BlockedTable is the table that holds the blocked relation/edge.
+	BlockedTable = "user_blocks"
+	// BlockedInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BlockedInverseTable = "users"
+	// BlockedColumn is the table column denoting the blocked relation/edge.
+	BlockedColumn = "blocked_id"
+)
+
+// Columns holds all SQL columns for userblock fields.
+var Columns = []string{
+	FieldID,
+	FieldDeletedAt,
+	FieldBlockerID,
+	FieldBlockedID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "sleeve/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UserBlock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByBlockerID orders the results by the blocker_id field.
+func ByBlockerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockerID, opts...).ToFunc()
+}
+
+// ByBlockedID orders the results by the blocked_id field.
+func ByBlockedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBlockerField orders the results by blocker field.
+func ByBlockerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBlockedField orders the results by blocked field.
+func ByBlockedField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBlockerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BlockerTable, BlockerColumn),
+	)
+}
+func newBlockedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BlockedTable, BlockedColumn),
+	)
+}
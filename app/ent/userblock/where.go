@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userblock
+
+import (
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// BlockerID applies equality check predicate on the "blocker_id" field. It's identical to BlockerIDEQ.
+func BlockerID(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockedID applies equality check predicate on the "blocked_id" field. It's identical to BlockedIDEQ.
+func BlockedID(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// BlockerIDEQ applies the EQ predicate on the "blocker_id" field.
+func BlockerIDEQ(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockerIDNEQ applies the NEQ predicate on the "blocker_id" field.
+func BlockerIDNEQ(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldBlockerID, v))
+}
+
+// BlockerIDIn applies the In predicate on the "blocker_id" field.
+func BlockerIDIn(vs ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldBlockerID, vs...))
+}
+
+// BlockerIDNotIn applies the NotIn predicate on the "blocker_id" field.
+func BlockerIDNotIn(vs ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldBlockerID, vs...))
+}
+
+// BlockedIDEQ applies the EQ predicate on the "blocked_id" field.
+func BlockedIDEQ(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// BlockedIDNEQ applies the NEQ predicate on the "blocked_id" field.
+func BlockedIDNEQ(v int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldBlockedID, v))
+}
+
+// BlockedIDIn applies the In predicate on the "blocked_id" field.
+func BlockedIDIn(vs ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldBlockedID, vs...))
+}
+
+// BlockedIDNotIn applies the NotIn predicate on the "blocked_id" field.
+func BlockedIDNotIn(vs ...int) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldBlockedID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBlocker applies the HasEdge predicate on the "blocker" edge.
+func HasBlocker() predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlockerTable, BlockerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockerWith applies the HasEdge predicate on the "blocker" edge with a given conditions (other predicates).
+func HasBlockerWith(preds ...predicate.User) predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := newBlockerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocked applies the HasEdge predicate on the "blocked" edge.
+func HasBlocked() predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlockedTable, BlockedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
+func HasBlockedWith(preds ...predicate.User) predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := newBlockedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.NotPredicates(p))
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/user"
+	"sleeve/ent/userhandle"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserHandle is the model entity for the UserHandle schema.
+type UserHandle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ユーザーID
+	UserID int `json:"user_id,omitempty"`
+	// 表示用のハンドル（入力された大文字・小文字を保持し、大文字・小文字のみの変更は上書き）
+	Handle string `json:"handle,omitempty"`
+	// 重複判定用のハンドル（NFKC正規化後に小文字に変換した値）
+	HandleKey string `json:"handle_key,omitempty"`
+	// 作成日時（ハンドルを割り当てた日時）
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 別のハンドルに変更した日時（現在のハンドルの場合はNULL）
+	RetiredAt *time.Time `json:"retired_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserHandleQuery when eager-loading is set.
+	Edges        UserHandleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserHandleEdges holds the relations/edges for other nodes in the graph.
+type UserHandleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserHandleEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserHandle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userhandle.FieldID, userhandle.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case userhandle.FieldHandle, userhandle.FieldHandleKey:
+			values[i] = new(sql.NullString)
+		case userhandle.FieldDeletedAt, userhandle.FieldCreatedAt, userhandle.FieldRetiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserHandle fields.
+func (_m *UserHandle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userhandle.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userhandle.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case userhandle.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case userhandle.FieldHandle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle", values[i])
+			} else if value.Valid {
+				_m.Handle = value.String
+			}
+		case userhandle.FieldHandleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle_key", values[i])
+			} else if value.Valid {
+				_m.HandleKey = value.String
+			}
+		case userhandle.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userhandle.FieldRetiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_at", values[i])
+			} else if value.Valid {
+				_m.RetiredAt = new(time.Time)
+				*_m.RetiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserHandle.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserHandle) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserHandle entity.
+func (_m *UserHandle) QueryUser() *UserQuery {
+	return NewUserHandleClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UserHandle.
+// Note that you need to call UserHandle.Unwrap() before calling this method if this UserHandle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserHandle) Update() *UserHandleUpdateOne {
+	return NewUserHandleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserHandle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserHandle) Unwrap() *UserHandle {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserHandle is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserHandle) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserHandle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("handle=")
+	builder.WriteString(_m.Handle)
+	builder.WriteString(", ")
+	builder.WriteString("handle_key=")
+	builder.WriteString(_m.HandleKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RetiredAt; v != nil {
+		builder.WriteString("retired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserHandles is a parsable slice of UserHandle.
+type UserHandles []*UserHandle
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userhandle
+
+import (
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldUserID, v))
+}
+
+// Handle applies equality check predicate on the "handle" field. It's identical to HandleEQ.
+func Handle(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleKey applies equality check predicate on the "handle_key" field. It's identical to HandleKeyEQ.
+func HandleKey(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldHandleKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RetiredAt applies equality check predicate on the "retired_at" field. It's identical to RetiredAtEQ.
+func RetiredAt(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// HandleEQ applies the EQ predicate on the "handle" field.
+func HandleEQ(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleNEQ applies the NEQ predicate on the "handle" field.
+func HandleNEQ(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldHandle, v))
+}
+
+// HandleIn applies the In predicate on the "handle" field.
+func HandleIn(vs ...string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldHandle, vs...))
+}
+
+// HandleNotIn applies the NotIn predicate on the "handle" field.
+func HandleNotIn(vs ...string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldHandle, vs...))
+}
+
+// HandleGT applies the GT predicate on the "handle" field.
+func HandleGT(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldHandle, v))
+}
+
+// HandleGTE applies the GTE predicate on the "handle" field.
+func HandleGTE(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldHandle, v))
+}
+
+// HandleLT applies the LT predicate on the "handle" field.
+func HandleLT(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldHandle, v))
+}
+
+// HandleLTE applies the LTE predicate on the "handle" field.
+func HandleLTE(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldHandle, v))
+}
+
+// HandleContains applies the Contains predicate on the "handle" field.
+func HandleContains(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldContains(FieldHandle, v))
+}
+
+// HandleHasPrefix applies the HasPrefix predicate on the "handle" field.
+func HandleHasPrefix(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldHasPrefix(FieldHandle, v))
+}
+
+// HandleHasSuffix applies the HasSuffix predicate on the "handle" field.
+func HandleHasSuffix(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldHasSuffix(FieldHandle, v))
+}
+
+// HandleEqualFold applies the EqualFold predicate on the "handle" field.
+func HandleEqualFold(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEqualFold(FieldHandle, v))
+}
+
+// HandleContainsFold applies the ContainsFold predicate on the "handle" field.
+func HandleContainsFold(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldContainsFold(FieldHandle, v))
+}
+
+// HandleKeyEQ applies the EQ predicate on the "handle_key" field.
+func HandleKeyEQ(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldHandleKey, v))
+}
+
+// HandleKeyNEQ applies the NEQ predicate on the "handle_key" field.
+func HandleKeyNEQ(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldHandleKey, v))
+}
+
+// HandleKeyIn applies the In predicate on the "handle_key" field.
+func HandleKeyIn(vs ...string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldHandleKey, vs...))
+}
+
+// HandleKeyNotIn applies the NotIn predicate on the "handle_key" field.
+func HandleKeyNotIn(vs ...string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldHandleKey, vs...))
+}
+
+// HandleKeyGT applies the GT predicate on the "handle_key" field.
+func HandleKeyGT(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldHandleKey, v))
+}
+
+// HandleKeyGTE applies the GTE predicate on the "handle_key" field.
+func HandleKeyGTE(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldHandleKey, v))
+}
+
+// HandleKeyLT applies the LT predicate on the "handle_key" field.
+func HandleKeyLT(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldHandleKey, v))
+}
+
+// HandleKeyLTE applies the LTE predicate on the "handle_key" field.
+func HandleKeyLTE(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldHandleKey, v))
+}
+
+// HandleKeyContains applies the Contains predicate on the "handle_key" field.
+func HandleKeyContains(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldContains(FieldHandleKey, v))
+}
+
+// HandleKeyHasPrefix applies the HasPrefix predicate on the "handle_key" field.
+func HandleKeyHasPrefix(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldHasPrefix(FieldHandleKey, v))
+}
+
+// HandleKeyHasSuffix applies the HasSuffix predicate on the "handle_key" field.
+func HandleKeyHasSuffix(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldHasSuffix(FieldHandleKey, v))
+}
+
+// HandleKeyEqualFold applies the EqualFold predicate on the "handle_key" field.
+func HandleKeyEqualFold(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEqualFold(FieldHandleKey, v))
+}
+
+// HandleKeyContainsFold applies the ContainsFold predicate on the "handle_key" field.
+func HandleKeyContainsFold(v string) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldContainsFold(FieldHandleKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RetiredAtEQ applies the EQ predicate on the "retired_at" field.
+func RetiredAtEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtNEQ applies the NEQ predicate on the "retired_at" field.
+func RetiredAtNEQ(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtIn applies the In predicate on the "retired_at" field.
+func RetiredAtIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtNotIn applies the NotIn predicate on the "retired_at" field.
+func RetiredAtNotIn(vs ...time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtGT applies the GT predicate on the "retired_at" field.
+func RetiredAtGT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGT(FieldRetiredAt, v))
+}
+
+// RetiredAtGTE applies the GTE predicate on the "retired_at" field.
+func RetiredAtGTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldGTE(FieldRetiredAt, v))
+}
+
+// RetiredAtLT applies the LT predicate on the "retired_at" field.
+func RetiredAtLT(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLT(FieldRetiredAt, v))
+}
+
+// RetiredAtLTE applies the LTE predicate on the "retired_at" field.
+func RetiredAtLTE(v time.Time) predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldLTE(FieldRetiredAt, v))
+}
+
+// RetiredAtIsNil applies the IsNil predicate on the "retired_at" field.
+func RetiredAtIsNil() predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldIsNull(FieldRetiredAt))
+}
+
+// RetiredAtNotNil applies the NotNil predicate on the "retired_at" field.
+func RetiredAtNotNil() predicate.UserHandle {
+	return predicate.UserHandle(sql.FieldNotNull(FieldRetiredAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserHandle {
+	return predicate.UserHandle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserHandle {
+	return predicate.UserHandle(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserHandle) predicate.UserHandle {
+	return predicate.UserHandle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserHandle) predicate.UserHandle {
+	return predicate.UserHandle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserHandle) predicate.UserHandle {
+	return predicate.UserHandle(sql.NotPredicates(p))
+}
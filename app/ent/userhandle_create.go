@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/user"
+	"sleeve/ent/userhandle"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserHandleCreate is the builder for creating a UserHandle entity.
+type UserHandleCreate struct {
+	config
+	mutation *UserHandleMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UserHandleCreate) SetDeletedAt(v time.Time) *UserHandleCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UserHandleCreate) SetNillableDeletedAt(v *time.Time) *UserHandleCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserHandleCreate) SetUserID(v int) *UserHandleCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetHandle sets the "handle" field.
+func (_c *UserHandleCreate) SetHandle(v string) *UserHandleCreate {
+	_c.mutation.SetHandle(v)
+	return _c
+}
+
+// SetHandleKey sets the "handle_key" field.
+func (_c *UserHandleCreate) SetHandleKey(v string) *UserHandleCreate {
+	_c.mutation.SetHandleKey(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserHandleCreate) SetCreatedAt(v time.Time) *UserHandleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserHandleCreate) SetNillableCreatedAt(v *time.Time) *UserHandleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_c *UserHandleCreate) SetRetiredAt(v time.Time) *UserHandleCreate {
+	_c.mutation.SetRetiredAt(v)
+	return _c
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_c *UserHandleCreate) SetNillableRetiredAt(v *time.Time) *UserHandleCreate {
+	if v != nil {
+		_c.SetRetiredAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserHandleCreate) SetUser(v *User) *UserHandleCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UserHandleMutation object of the builder.
+func (_c *UserHandleCreate) Mutation() *UserHandleMutation {
+	return _c.mutation
+}
+
+// Save creates the UserHandle in the database.
+func (_c *UserHandleCreate) Save(ctx context.Context) (*UserHandle, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserHandleCreate) SaveX(ctx context.Context) *UserHandle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserHandleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserHandleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserHandleCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if userhandle.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userhandle.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := userhandle.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserHandleCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserHandle.user_id"`)}
+	}
+	if _, ok := _c.mutation.Handle(); !ok {
+		return &ValidationError{Name: "handle", err: errors.New(`ent: missing required field "UserHandle.handle"`)}
+	}
+	if v, ok := _c.mutation.Handle(); ok {
+		if err := userhandle.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "UserHandle.handle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HandleKey(); !ok {
+		return &ValidationError{Name: "handle_key", err: errors.New(`ent: missing required field "UserHandle.handle_key"`)}
+	}
+	if v, ok := _c.mutation.HandleKey(); ok {
+		if err := userhandle.HandleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "handle_key", err: fmt.Errorf(`ent: validator failed for field "UserHandle.handle_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserHandle.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserHandle.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserHandleCreate) sqlSave(ctx context.Context) (*UserHandle, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserHandleCreate) createSpec() (*UserHandle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserHandle{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userhandle.Table, sqlgraph.NewFieldSpec(userhandle.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(userhandle.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Handle(); ok {
+		_spec.SetField(userhandle.FieldHandle, field.TypeString, value)
+		_node.Handle = value
+	}
+	if value, ok := _c.mutation.HandleKey(); ok {
+		_spec.SetField(userhandle.FieldHandleKey, field.TypeString, value)
+		_node.HandleKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userhandle.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RetiredAt(); ok {
+		_spec.SetField(userhandle.FieldRetiredAt, field.TypeTime, value)
+		_node.RetiredAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userhandle.UserTable,
+			Columns: []string{userhandle.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserHandleCreateBulk is the builder for creating many UserHandle entities in bulk.
+type UserHandleCreateBulk struct {
+	config
+	err      error
+	builders []*UserHandleCreate
+}
+
+// Save creates the UserHandle entities in the database.
+func (_c *UserHandleCreateBulk) Save(ctx context.Context) ([]*UserHandle, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserHandle, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserHandleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserHandleCreateBulk) SaveX(ctx context.Context) []*UserHandle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserHandleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserHandleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
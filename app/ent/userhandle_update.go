@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/predicate"
+	"sleeve/ent/userhandle"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserHandleUpdate is the builder for updating UserHandle entities.
+type UserHandleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserHandleMutation
+}
+
+// Where appends a list predicates to the UserHandleUpdate builder.
+func (_u *UserHandleUpdate) Where(ps ...predicate.UserHandle) *UserHandleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserHandleUpdate) SetDeletedAt(v time.Time) *UserHandleUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserHandleUpdate) SetNillableDeletedAt(v *time.Time) *UserHandleUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserHandleUpdate) ClearDeletedAt() *UserHandleUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *UserHandleUpdate) SetHandle(v string) *UserHandleUpdate {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *UserHandleUpdate) SetNillableHandle(v *string) *UserHandleUpdate {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *UserHandleUpdate) SetRetiredAt(v time.Time) *UserHandleUpdate {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *UserHandleUpdate) SetNillableRetiredAt(v *time.Time) *UserHandleUpdate {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *UserHandleUpdate) ClearRetiredAt() *UserHandleUpdate {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// Mutation returns the UserHandleMutation object of the builder.
+func (_u *UserHandleUpdate) Mutation() *UserHandleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserHandleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserHandleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserHandleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserHandleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserHandleUpdate) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := userhandle.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "UserHandle.handle": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserHandle.user"`)
+	}
+	return nil
+}
+
+func (_u *UserHandleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userhandle.Table, userhandle.Columns, sqlgraph.NewFieldSpec(userhandle.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(userhandle.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(userhandle.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(userhandle.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(userhandle.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(userhandle.FieldRetiredAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userhandle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserHandleUpdateOne is the builder for updating a single UserHandle entity.
+type UserHandleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserHandleMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserHandleUpdateOne) SetDeletedAt(v time.Time) *UserHandleUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserHandleUpdateOne) SetNillableDeletedAt(v *time.Time) *UserHandleUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserHandleUpdateOne) ClearDeletedAt() *UserHandleUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *UserHandleUpdateOne) SetHandle(v string) *UserHandleUpdateOne {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *UserHandleUpdateOne) SetNillableHandle(v *string) *UserHandleUpdateOne {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *UserHandleUpdateOne) SetRetiredAt(v time.Time) *UserHandleUpdateOne {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *UserHandleUpdateOne) SetNillableRetiredAt(v *time.Time) *UserHandleUpdateOne {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *UserHandleUpdateOne) ClearRetiredAt() *UserHandleUpdateOne {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// Mutation returns the UserHandleMutation object of the builder.
+func (_u *UserHandleUpdateOne) Mutation() *UserHandleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserHandleUpdate builder.
+func (_u *UserHandleUpdateOne) Where(ps ...predicate.UserHandle) *UserHandleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserHandleUpdateOne) Select(field string, fields ...string) *UserHandleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserHandle entity.
+func (_u *UserHandleUpdateOne) Save(ctx context.Context) (*UserHandle, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserHandleUpdateOne) SaveX(ctx context.Context) *UserHandle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserHandleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserHandleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserHandleUpdateOne) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := userhandle.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "UserHandle.handle": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserHandle.user"`)
+	}
+	return nil
+}
+
+func (_u *UserHandleUpdateOne) sqlSave(ctx context.Context) (_node *UserHandle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userhandle.Table, userhandle.Columns, sqlgraph.NewFieldSpec(userhandle.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserHandle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userhandle.FieldID)
+		for _, f := range fields {
+			if !userhandle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userhandle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(userhandle.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(userhandle.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(userhandle.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(userhandle.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(userhandle.FieldRetiredAt, field.TypeTime)
+	}
+	_node = &UserHandle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userhandle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
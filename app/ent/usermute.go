@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sleeve/ent/user"
+	"sleeve/ent/usermute"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserMute is the model entity for the UserMute schema.
+type UserMute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 削除日時（論理削除）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ミュートしたユーザーのID
+	MuterID int `json:"muter_id,omitempty"`
+	// ミュートされたユーザーのID
+	MutedID int `json:"muted_id,omitempty"`
+	// ミュートした日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserMuteQuery when eager-loading is set.
+	Edges        UserMuteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserMuteEdges holds the relations/edges for other nodes in the graph.
+type UserMuteEdges struct {
+	// Muter holds the value of the muter edge.
+	Muter *User `json:"muter,omitempty"`
+	// Muted holds the value of the muted edge.
+	Muted *User `json:"muted,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MuterOrErr returns the Muter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserMuteEdges) MuterOrErr() (*User, error) {
+	if e.Muter != nil {
+		return e.Muter, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "muter"}
+}
+
+// MutedOrErr returns the Muted value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserMuteEdges) MutedOrErr() (*User, error) {
+	if e.Muted != nil {
+		return e.Muted, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "muted"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserMute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usermute.FieldID, usermute.FieldMuterID, usermute.FieldMutedID:
+			values[i] = new(sql.NullInt64)
+		case usermute.FieldDeletedAt, usermute.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserMute fields.
+func (_m *UserMute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usermute.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case usermute.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case usermute.FieldMuterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field muter_id", values[i])
+			} else if value.Valid {
+				_m.MuterID = int(value.Int64)
+			}
+		case usermute.FieldMutedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_id", values[i])
+			} else if value.Valid {
+				_m.MutedID = int(value.Int64)
+			}
+		case usermute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserMute.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserMute) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMuter queries the "muter" edge of the UserMute entity.
+func (_m *UserMute) QueryMuter() *UserQuery {
+	return NewUserMuteClient(_m.config).QueryMuter(_m)
+}
+
+// QueryMuted queries the "muted" edge of the UserMute entity.
+func (_m *UserMute) QueryMuted() *UserQuery {
+	return NewUserMuteClient(_m.config).QueryMuted(_m)
+}
+
+// Update returns a builder for updating this UserMute.
+// Note that you need to call UserMute.Unwrap() before calling this method if this UserMute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserMute) Update() *UserMuteUpdateOne {
+	return NewUserMuteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserMute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserMute) Unwrap() *UserMute {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserMute is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserMute) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserMute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("muter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MuterID))
+	builder.WriteString(", ")
+	builder.WriteString("muted_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MutedID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserMutes is a parsable slice of UserMute.
+type UserMutes []*UserMute
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usermute
+
+import (
+	"sleeve/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// MuterID applies equality check predicate on the "muter_id" field. It's identical to MuterIDEQ.
+func MuterID(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldMuterID, v))
+}
+
+// MutedID applies equality check predicate on the "muted_id" field. It's identical to MutedIDEQ.
+func MutedID(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldMutedID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserMute {
+	return predicate.UserMute(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// MuterIDEQ applies the EQ predicate on the "muter_id" field.
+func MuterIDEQ(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldMuterID, v))
+}
+
+// MuterIDNEQ applies the NEQ predicate on the "muter_id" field.
+func MuterIDNEQ(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNEQ(FieldMuterID, v))
+}
+
+// MuterIDIn applies the In predicate on the "muter_id" field.
+func MuterIDIn(vs ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldIn(FieldMuterID, vs...))
+}
+
+// MuterIDNotIn applies the NotIn predicate on the "muter_id" field.
+func MuterIDNotIn(vs ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotIn(FieldMuterID, vs...))
+}
+
+// MutedIDEQ applies the EQ predicate on the "muted_id" field.
+func MutedIDEQ(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldMutedID, v))
+}
+
+// MutedIDNEQ applies the NEQ predicate on the "muted_id" field.
+func MutedIDNEQ(v int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNEQ(FieldMutedID, v))
+}
+
+// MutedIDIn applies the In predicate on the "muted_id" field.
+func MutedIDIn(vs ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldIn(FieldMutedID, vs...))
+}
+
+// MutedIDNotIn applies the NotIn predicate on the "muted_id" field.
+func MutedIDNotIn(vs ...int) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotIn(FieldMutedID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserMute {
+	return predicate.UserMute(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMuter applies the HasEdge predicate on the "muter" edge.
+func HasMuter() predicate.UserMute {
+	return predicate.UserMute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MuterTable, MuterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMuterWith applies the HasEdge predicate on the "muter" edge with a given conditions (other predicates).
+func HasMuterWith(preds ...predicate.User) predicate.UserMute {
+	return predicate.UserMute(func(s *sql.Selector) {
+		step := newMuterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMuted applies the HasEdge predicate on the "muted" edge.
+func HasMuted() predicate.UserMute {
+	return predicate.UserMute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MutedTable, MutedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedWith applies the HasEdge predicate on the "muted" edge with a given conditions (other predicates).
+func HasMutedWith(preds ...predicate.User) predicate.UserMute {
+	return predicate.UserMute(func(s *sql.Selector) {
+		step := newMutedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserMute) predicate.UserMute {
+	return predicate.UserMute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserMute) predicate.UserMute {
+	return predicate.UserMute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserMute) predicate.UserMute {
+	return predicate.UserMute(sql.NotPredicates(p))
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/user"
+	"sleeve/ent/usermute"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserMuteCreate is the builder for creating a UserMute entity.
+type UserMuteCreate struct {
+	config
+	mutation *UserMuteMutation
+	hooks    []Hook
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UserMuteCreate) SetDeletedAt(v time.Time) *UserMuteCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UserMuteCreate) SetNillableDeletedAt(v *time.Time) *UserMuteCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetMuterID sets the "muter_id" field.
+func (_c *UserMuteCreate) SetMuterID(v int) *UserMuteCreate {
+	_c.mutation.SetMuterID(v)
+	return _c
+}
+
+// SetMutedID sets the "muted_id" field.
+func (_c *UserMuteCreate) SetMutedID(v int) *UserMuteCreate {
+	_c.mutation.SetMutedID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserMuteCreate) SetCreatedAt(v time.Time) *UserMuteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserMuteCreate) SetNillableCreatedAt(v *time.Time) *UserMuteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetMuter sets the "muter" edge to the User entity.
+func (_c *UserMuteCreate) SetMuter(v *User) *UserMuteCreate {
+	return _c.SetMuterID(v.ID)
+}
+
+// SetMuted sets the "muted" edge to the User entity.
+func (_c *UserMuteCreate) SetMuted(v *User) *UserMuteCreate {
+	return _c.SetMutedID(v.ID)
+}
+
+// Mutation returns the UserMuteMutation object of the builder.
+func (_c *UserMuteCreate) Mutation() *UserMuteMutation {
+	return _c.mutation
+}
+
+// Save creates the UserMute in the database.
+func (_c *UserMuteCreate) Save(ctx context.Context) (*UserMute, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserMuteCreate) SaveX(ctx context.Context) *UserMute {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserMuteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserMuteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserMuteCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if usermute.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized usermute.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := usermute.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserMuteCreate) check() error {
+	if _, ok := _c.mutation.MuterID(); !ok {
+		return &ValidationError{Name: "muter_id", err: errors.New(`ent: missing required field "UserMute.muter_id"`)}
+	}
+	if _, ok := _c.mutation.MutedID(); !ok {
+		return &ValidationError{Name: "muted_id", err: errors.New(`ent: missing required field "UserMute.muted_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserMute.created_at"`)}
+	}
+	if len(_c.mutation.MuterIDs()) == 0 {
+		return &ValidationError{Name: "muter", err: errors.New(`ent: missing required edge "UserMute.muter"`)}
+	}
+	if len(_c.mutation.MutedIDs()) == 0 {
+		return &ValidationError{Name: "muted", err: errors.New(`ent: missing required edge "UserMute.muted"`)}
+	}
+	return nil
+}
+
+func (_c *UserMuteCreate) sqlSave(ctx context.Context) (*UserMute, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserMuteCreate) createSpec() (*UserMute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserMute{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usermute.Table, sqlgraph.NewFieldSpec(usermute.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(usermute.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usermute.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MuterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usermute.MuterTable,
+			Columns: []string{usermute.MuterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MuterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MutedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usermute.MutedTable,
+			Columns: []string{usermute.MutedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MutedID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserMuteCreateBulk is the builder for creating many UserMute entities in bulk.
+type UserMuteCreateBulk struct {
+	config
+	err      error
+	builders []*UserMuteCreate
+}
+
+// Save creates the UserMute entities in the database.
+func (_c *UserMuteCreateBulk) Save(ctx context.Context) ([]*UserMute, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserMute, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserMuteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserMuteCreateBulk) SaveX(ctx context.Context) []*UserMute {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserMuteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserMuteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sleeve/ent/predicate"
+	"sleeve/ent/usermute"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserMuteUpdate is the builder for updating UserMute entities.
+type UserMuteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserMuteMutation
+}
+
+// Where appends a list predicates to the UserMuteUpdate builder.
+func (_u *UserMuteUpdate) Where(ps ...predicate.UserMute) *UserMuteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserMuteUpdate) SetDeletedAt(v time.Time) *UserMuteUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserMuteUpdate) SetNillableDeletedAt(v *time.Time) *UserMuteUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserMuteUpdate) ClearDeletedAt() *UserMuteUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the UserMuteMutation object of the builder.
+func (_u *UserMuteUpdate) Mutation() *UserMuteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserMuteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserMuteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserMuteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserMuteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserMuteUpdate) check() error {
+	if _u.mutation.MuterCleared() && len(_u.mutation.MuterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserMute.muter"`)
+	}
+	if _u.mutation.MutedCleared() && len(_u.mutation.MutedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserMute.muted"`)
+	}
+	return nil
+}
+
+func (_u *UserMuteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermute.Table, usermute.Columns, sqlgraph.NewFieldSpec(usermute.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(usermute.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(usermute.FieldDeletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserMuteUpdateOne is the builder for updating a single UserMute entity.
+type UserMuteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMuteMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserMuteUpdateOne) SetDeletedAt(v time.Time) *UserMuteUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserMuteUpdateOne) SetNillableDeletedAt(v *time.Time) *UserMuteUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserMuteUpdateOne) ClearDeletedAt() *UserMuteUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the UserMuteMutation object of the builder.
+func (_u *UserMuteUpdateOne) Mutation() *UserMuteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserMuteUpdate builder.
+func (_u *UserMuteUpdateOne) Where(ps ...predicate.UserMute) *UserMuteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserMuteUpdateOne) Select(field string, fields ...string) *UserMuteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserMute entity.
+func (_u *UserMuteUpdateOne) Save(ctx context.Context) (*UserMute, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserMuteUpdateOne) SaveX(ctx context.Context) *UserMute {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserMuteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserMuteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserMuteUpdateOne) check() error {
+	if _u.mutation.MuterCleared() && len(_u.mutation.MuterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserMute.muter"`)
+	}
+	if _u.mutation.MutedCleared() && len(_u.mutation.MutedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserMute.muted"`)
+	}
+	return nil
+}
+
+func (_u *UserMuteUpdateOne) sqlSave(ctx context.Context) (_node *UserMute, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermute.Table, usermute.Columns, sqlgraph.NewFieldSpec(usermute.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserMute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usermute.FieldID)
+		for _, f := range fields {
+			if !usermute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usermute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(usermute.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(usermute.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &UserMute{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	domain_errors "sleeve/domain/errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// 認可エラー・試行回数の制限のエラーコード（extensions.code）
+const (
+	errorCodeUnauthenticated = "UNAUTHENTICATED"
+	errorCodeForbidden       = "FORBIDDEN"
+	errorCodeTooManyAttempts = "TOO_MANY_ATTEMPTS"
+)
+
+// authorization_error_codes は認可・認証の試行回数の制限に関するドメインエラーとエラーコードの対応です
+var authorization_error_codes = []struct {
+	err  error
+	code string
+}{
+	{err: domain_errors.ErrAuthenticationRequired, code: errorCodeUnauthenticated},
+	{err: domain_errors.ErrForbidden, code: errorCodeForbidden},
+	{err: domain_errors.ErrEmailNotVerified, code: errorCodeForbidden},
+	{err: domain_errors.ErrMfaRequired, code: errorCodeForbidden},
+	{err: domain_errors.ErrTooManyAttempts, code: errorCodeTooManyAttempts},
+}
+
+// error_presenter は認可エラーに一貫したエラーコード（extensions.code）を付与するエラープレゼンターです
+// 認可エラーのメッセージは内部の詳細を含めず、ドメインエラーのメッセージのみを返します
+// 試行回数の制限では、次の試行を受け付けるまでの秒数（切り上げ）をextensions.retryAfterに付与します
+func error_presenter(ctx context.Context, err error) *gqlerror.Error {
+	var gql_err *gqlerror.Error
+	var too_many_attempts_err *domain_errors.TooManyAttemptsError
+
+	gql_err = graphql.DefaultErrorPresenter(ctx, err)
+	for _, authorization_error := range authorization_error_codes {
+		if errors.Is(err, authorization_error.err) {
+			gql_err.Message = authorization_error.err.Error()
+			if gql_err.Extensions == nil {
+				gql_err.Extensions = map[string]any{}
+			}
+			gql_err.Extensions["code"] = authorization_error.code
+			if errors.As(err, &too_many_attempts_err) {
+				gql_err.Extensions["retryAfter"] = int(math.Ceil(too_many_attempts_err.RetryAfter.Seconds()))
+			}
+			return gql_err
+		}
+	}
+	return gql_err
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// TestErrorPresenter_AuthorizationErrors は認可エラーに一貫したエラーコードとメッセージが付与されることをテストします
+func TestErrorPresenter_AuthorizationErrors(t *testing.T) {
+	var test_cases []struct {
+		err           error
+		expected_code string
+	}
+	var gql_err *gqlerror.Error
+
+	test_cases = []struct {
+		err           error
+		expected_code string
+	}{
+		{err: domain_errors.ErrAuthenticationRequired, expected_code: errorCodeUnauthenticated},
+		{err: domain_errors.ErrForbidden, expected_code: errorCodeForbidden},
+		{err: domain_errors.ErrEmailNotVerified, expected_code: errorCodeForbidden},
+		{err: domain_errors.ErrMfaRequired, expected_code: errorCodeForbidden},
+		{err: domain_errors.ErrTooManyAttempts, expected_code: errorCodeTooManyAttempts},
+	}
+	for _, test_case := range test_cases {
+		gql_err = error_presenter(context.Background(), fmt.Errorf("%w: detail", test_case.err))
+		if gql_err.Extensions["code"] != test_case.expected_code {
+			t.Errorf("expected error code %s, got %v", test_case.expected_code, gql_err.Extensions["code"])
+		}
+		if gql_err.Message != test_case.err.Error() {
+			t.Errorf("expected message %s, got %s", test_case.err.Error(), gql_err.Message)
+		}
+	}
+}
+
+// TestErrorPresenter_TooManyAttempts は試行回数の制限に待ち時間の秒数（切り上げ）が付与されることをテストします
+func TestErrorPresenter_TooManyAttempts(t *testing.T) {
+	var gql_err *gqlerror.Error
+
+	gql_err = error_presenter(context.Background(), domain_errors.NewTooManyAttemptsError(1500*time.Millisecond))
+	if gql_err.Extensions["code"] != errorCodeTooManyAttempts {
+		t.Errorf("expected error code %s, got %v", errorCodeTooManyAttempts, gql_err.Extensions["code"])
+	}
+	if gql_err.Extensions["retryAfter"] != 2 {
+		t.Errorf("expected retryAfter 2, got %v", gql_err.Extensions["retryAfter"])
+	}
+	if gql_err.Message != domain_errors.ErrTooManyAttempts.Error() {
+		t.Errorf("expected message %s, got %s", domain_errors.ErrTooManyAttempts.Error(), gql_err.Message)
+	}
+}
+
+// TestErrorPresenter_OtherError は認可以外のエラーにはエラーコードを付与しないことをテストします
+func TestErrorPresenter_OtherError(t *testing.T) {
+	var gql_err *gqlerror.Error
+	var has_code bool
+
+	gql_err = error_presenter(context.Background(), domain_errors.ErrInvalidEmail)
+	if gql_err.Message != domain_errors.ErrInvalidEmail.Error() {
+		t.Errorf("expected message %s, got %s", domain_errors.ErrInvalidEmail.Error(), gql_err.Message)
+	}
+	_, has_code = gql_err.Extensions["code"]
+	if has_code {
+		t.Errorf("expected no error code, got %v", gql_err.Extensions["code"])
+	}
+}
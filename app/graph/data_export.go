@@ -0,0 +1,27 @@
+package graph
+
+import (
+	"strings"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+)
+
+// build_data_export_model はドメインのエクスポートをGraphQLのDataExportに変換します
+func build_data_export_model(data_export *models.DataExport) *model.DataExport {
+	var result *model.DataExport
+
+	result = &model.DataExport{
+		ID:          data_export.ExportID().String(),
+		Status:      model.DataExportStatus(strings.ToUpper(string(data_export.Status()))),
+		RequestedAt: data_export.CreatedAt().Format(time.RFC3339),
+	}
+	if data_export.ExpiresAt() != nil {
+		var expires_at string
+
+		expires_at = data_export.ExpiresAt().Format(time.RFC3339)
+		result.ExpiresAt = &expires_at
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+	"sleeve/usecase/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// NewDirectiveRoot はスキーマで宣言したディレクティブ（@auth / @hasRole / @verifiedEmail）の実装を返します
+// 認証情報は認証ミドルウェアがcontextに格納したものを参照します
+func NewDirectiveRoot() DirectiveRoot {
+	return DirectiveRoot{
+		Auth:          auth_directive,
+		HasRole:       has_role_directive,
+		VerifiedEmail: verified_email_directive,
+	}
+}
+
+// auth_directive はログインしていない場合にErrAuthenticationRequiredを返します
+func auth_directive(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	var err error
+
+	_, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// has_role_directive は指定したロールを持たない場合にErrForbiddenを返します
+// 管理者権限が必要なフィールドは、二要素認証を有効にしていない場合にErrMfaRequiredを返します
+func has_role_directive(ctx context.Context, obj any, next graphql.Resolver, role model.Role) (any, error) {
+	var current_user *models.User
+	var required_role models.Role
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required_role, err = convert_graphql_role_to_domain(role)
+	if err != nil {
+		return nil, err
+	}
+	if !current_user.HasRole(required_role) {
+		return nil, fmt.Errorf("%w: role %s is required", domain_errors.ErrForbidden, required_role)
+	}
+	if required_role == models.RoleAdmin && !current_user.IsMfaEnabled() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrMfaRequired, current_user.PublicID())
+	}
+	return next(ctx)
+}
+
+// verified_email_directive はメールアドレスの確認が完了していない場合にErrEmailNotVerifiedを返します
+// 確認状態はログイン時にFirebaseから同期したusers.email_verified_atを参照します
+func verified_email_directive(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	var err error
+
+	_, err = utils.RequireVerifiedEmail(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// convert_graphql_role_to_domain はGraphQLのRoleをドメインのRoleに変換します
+func convert_graphql_role_to_domain(role model.Role) (models.Role, error) {
+	switch role {
+	case model.RoleUser:
+		return models.RoleUser, nil
+	case model.RoleAdmin:
+		return models.RoleAdmin, nil
+	default:
+		return "", fmt.Errorf("unknown role: %s", role)
+	}
+}
@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// TestAuthDirective_Unauthenticated は未認証の場合にUNAUTHENTICATEDとなり、リゾルバーが実行されないことをテストします
+func TestAuthDirective_Unauthenticated(t *testing.T) {
+	var directives DirectiveRoot
+	var next *MockNextResolver
+	var err error
+
+	directives = NewDirectiveRoot()
+	next = NewMockNextResolver()
+	_, err = directives.Auth(context.Background(), nil, next.Resolve)
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+	if next.Called {
+		t.Error("expected next resolver to not be called")
+	}
+}
+
+// TestAuthDirective_Authenticated は認証済みの場合にリゾルバーが実行されることをテストします
+func TestAuthDirective_Authenticated(t *testing.T) {
+	var directives DirectiveRoot
+	var next *MockNextResolver
+	var result any
+	var err error
+
+	directives = NewDirectiveRoot()
+	next = NewMockNextResolver()
+	result, err = directives.Auth(create_authenticated_context(t, models.RoleUser), nil, next.Resolve)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !next.Called || result != mockNextResolverResult {
+		t.Errorf("expected next resolver result, got %v", result)
+	}
+}
+
+// TestHasRoleDirective はロールによる認可をテストします
+func TestHasRoleDirective(t *testing.T) {
+	var directives DirectiveRoot
+	var next *MockNextResolver
+	var err error
+
+	directives = NewDirectiveRoot()
+
+	// 未認証
+	next = NewMockNextResolver()
+	_, err = directives.HasRole(context.Background(), nil, next.Resolve, model.RoleAdmin)
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+
+	// 権限不足
+	next = NewMockNextResolver()
+	_, err = directives.HasRole(create_authenticated_context(t, models.RoleUser), nil, next.Resolve, model.RoleAdmin)
+	if !errors.Is(err, domain_errors.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if next.Called {
+		t.Error("expected next resolver to not be called")
+	}
+
+	// 管理者は全てのロールの権限を持つ
+	next = NewMockNextResolver()
+	_, err = directives.HasRole(create_authenticated_context(t, models.RoleAdmin), nil, next.Resolve, model.RoleUser)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !next.Called {
+		t.Error("expected next resolver to be called")
+	}
+}
+
+// TestHasRoleDirective_AdminRequiresMfa は管理者権限が必要なフィールドで二要素認証が必須であることをテストします
+func TestHasRoleDirective_AdminRequiresMfa(t *testing.T) {
+	var directives DirectiveRoot
+	var next *MockNextResolver
+	var current_user *models.User
+	var ctx context.Context
+	var err error
+
+	directives = NewDirectiveRoot()
+	ctx = create_authenticated_context(t, models.RoleAdmin)
+
+	// 二要素認証が無効
+	next = NewMockNextResolver()
+	_, err = directives.HasRole(ctx, nil, next.Resolve, model.RoleAdmin)
+	if !errors.Is(err, domain_errors.ErrMfaRequired) {
+		t.Errorf("expected ErrMfaRequired, got %v", err)
+	}
+	if next.Called {
+		t.Error("expected next resolver to not be called")
+	}
+
+	// 二要素認証が有効
+	current_user, _ = utils.GetCurrentUser(ctx)
+	current_user.EnableMfa(time.Now())
+	next = NewMockNextResolver()
+	_, err = directives.HasRole(ctx, nil, next.Resolve, model.RoleAdmin)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !next.Called {
+		t.Error("expected next resolver to be called")
+	}
+}
+
+// TestVerifiedEmailDirective はメールアドレス確認状態による認可をテストします
+func TestVerifiedEmailDirective(t *testing.T) {
+	var directives DirectiveRoot
+	var next *MockNextResolver
+	var current_user *models.User
+	var ctx context.Context
+	var err error
+
+	directives = NewDirectiveRoot()
+	ctx = create_authenticated_context(t, models.RoleUser)
+
+	// 未確認
+	next = NewMockNextResolver()
+	_, err = directives.VerifiedEmail(ctx, nil, next.Resolve)
+	if !errors.Is(err, domain_errors.ErrEmailNotVerified) {
+		t.Errorf("expected ErrEmailNotVerified, got %v", err)
+	}
+	if next.Called {
+		t.Error("expected next resolver to not be called")
+	}
+
+	// 確認済み
+	current_user, _ = utils.GetCurrentUser(ctx)
+	current_user.MarkEmailVerified(time.Now())
+	next = NewMockNextResolver()
+	_, err = directives.VerifiedEmail(ctx, nil, next.Resolve)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !next.Called {
+		t.Error("expected next resolver to be called")
+	}
+}
+
+// create_authenticated_context は指定したロールのユーザーで認証済みのcontextを作成します
+func create_authenticated_context(t *testing.T, role models.Role) context.Context {
+	var email models.Email
+	var now time.Time
+	var current_user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	current_user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, role, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return utils.WithAuthInfo(context.Background(), &utils.JWTClaims{UserID: current_user.PublicID().String()}, current_user)
+}
+
+// mockNextResolverResult はMockNextResolverが返す値です
+const mockNextResolverResult = "resolved"
+
+// MockNextResolver はディレクティブの後続リゾルバーのモックです
+type MockNextResolver struct {
+	Called bool
+}
+
+// NewMockNextResolver は新しいMockNextResolverを作成します
+func NewMockNextResolver() *MockNextResolver {
+	return &MockNextResolver{
+		Called: false,
+	}
+}
+
+// Resolve はリゾルバーの呼び出しを記録します
+func (m *MockNextResolver) Resolve(ctx context.Context) (any, error) {
+	m.Called = true
+	return mockNextResolverResult, nil
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+)
+
+// build_follow_status_model はフォロー状態をGraphQLのFollowStatusに変換します
+func build_follow_status_model(status *user.FollowStatus) *model.FollowStatus {
+	return &model.FollowStatus{
+		UserID:         status.UserID.String(),
+		Following:      status.Following,
+		Requested:      status.Requested,
+		FollowerCount:  int32(status.Counts.Followers()),
+		FollowingCount: int32(status.Counts.Following()),
+	}
+}
+
+// build_follow_connection_model はフォロー関係の一覧をGraphQLのFollowConnectionに変換します
+// followersでは各フォロー関係のフォローしたユーザー、followingではフォローされたユーザーをnodesに含めます
+func build_follow_connection_model(direction user.FollowDirection, connection *user.FollowConnection) *model.FollowConnection {
+	var nodes []*model.FollowUser
+
+	nodes = make([]*model.FollowUser, 0, len(connection.Page.Follows()))
+	for _, follow := range connection.Page.Follows() {
+		var node *model.FollowUser
+
+		node = &model.FollowUser{
+			UserID:     follow.FolloweeID().String(),
+			FollowedAt: follow.CreatedAt().Format(time.RFC3339),
+		}
+		if direction == user.FollowDirectionFollowers {
+			node.UserID = follow.FollowerID().String()
+		}
+		nodes = append(nodes, node)
+	}
+	return &model.FollowConnection{
+		Nodes:      nodes,
+		PageInfo:   build_page_info_model(connection.Page.EndCursor(), connection.Page.HasNextPage()),
+		TotalCount: int32(connection.TotalCount),
+	}
+}
+
+// build_follow_request_connection_model は受信したフォローリクエストの一覧をGraphQLのFollowRequestConnectionに変換します
+func build_follow_request_connection_model(page *models.FollowRequestPage) *model.FollowRequestConnection {
+	var nodes []*model.FollowRequest
+
+	nodes = make([]*model.FollowRequest, 0, len(page.Requests()))
+	for _, request := range page.Requests() {
+		nodes = append(nodes, &model.FollowRequest{
+			UserID:      request.RequesterID().String(),
+			RequestedAt: request.CreatedAt().Format(time.RFC3339),
+		})
+	}
+	return &model.FollowRequestConnection{
+		Nodes:    nodes,
+		PageInfo: build_page_info_model(page.EndCursor(), page.HasNextPage()),
+	}
+}
+
+// build_page_info_model はページネーションの情報をGraphQLのPageInfoに変換します（カーソルが空の場合はendCursorをnullにします）
+func build_page_info_model(end_cursor string, has_next_page bool) *model.PageInfo {
+	var page_info *model.PageInfo
+
+	page_info = &model.PageInfo{HasNextPage: has_next_page}
+	if end_cursor != "" {
+		page_info.EndCursor = &end_cursor
+	}
+	return page_info
+}
+
+// build_page_size_input はGraphQLのページサイズの入力値をユースケースの入力値に変換します
+func build_page_size_input(first *int32) *int {
+	var value int
+
+	if first == nil {
+		return nil
+	}
+	value = int(*first)
+	return &value
+}
+
+// list_follows はフォロー関係の一覧を取得してGraphQLのFollowConnectionに変換します
+func (r *queryResolver) list_follows(ctx context.Context, direction user.FollowDirection, user_id string, first *int32, after *string) (*model.FollowConnection, error) {
+	var viewer *models.User
+	var connection *user.FollowConnection
+	var err error
+
+	// ログイン中の場合はブロックしている・されているユーザーを除外する
+	viewer, _ = utils.GetCurrentUser(ctx)
+	connection, err = r.ListFollowsUseCase.Execute(ctx, viewer, direction, user_id, build_page_size_input(first), after)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_connection_model(direction, connection), nil
+}
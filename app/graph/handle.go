@@ -0,0 +1,36 @@
+package graph
+
+import (
+	"strings"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+)
+
+// build_user_handle_model はドメインのハンドルをGraphQLのUserHandleに変換します
+func build_user_handle_model(user_handle *models.UserHandle) *model.UserHandle {
+	return &model.UserHandle{
+		Handle:                user_handle.Handle().Value(),
+		ChangedAt:             user_handle.CreatedAt().Format(time.RFC3339),
+		NextChangeAvailableAt: user_handle.NextChangeAvailableAt().Format(time.RFC3339),
+	}
+}
+
+// build_handle_availability_model はハンドルの使用可否をGraphQLのHandleAvailabilityに変換します
+func build_handle_availability_model(availability *user.HandleAvailability) *model.HandleAvailability {
+	var result *model.HandleAvailability
+
+	result = &model.HandleAvailability{
+		Handle:    availability.Handle,
+		Available: availability.Available,
+	}
+	if availability.Reason != "" {
+		var reason model.HandleUnavailableReason
+
+		reason = model.HandleUnavailableReason(strings.ToUpper(string(availability.Reason)))
+		result.Reason = &reason
+	}
+	return result
+}
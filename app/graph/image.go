@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"strings"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+)
+
+// build_uploaded_image_model はアップロードした画像をGraphQLのUploadedImageに変換します
+func build_uploaded_image_model(uploaded *models.UploadedImage) *model.UploadedImage {
+	var variants []*model.ImageVariant
+
+	variants = []*model.ImageVariant{}
+	for _, variant := range uploaded.Variants() {
+		variants = append(variants, &model.ImageVariant{
+			Name:   variant.Name(),
+			URL:    variant.URL(),
+			Width:  int32(variant.Width()),
+			Height: int32(variant.Height()),
+		})
+	}
+	return &model.UploadedImage{
+		ID:          uploaded.ImageID().String(),
+		Purpose:     model.ImagePurpose(strings.ToUpper(uploaded.Purpose().String())),
+		ContentType: uploaded.Format().ContentType(),
+		Variants:    variants,
+	}
+}
@@ -2,25 +2,139 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type AccountDeletion struct {
+	PurgeAfter string `json:"purgeAfter"`
+}
+
 type AuthTokens struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
 }
 
+type CreateProfileInput struct {
+	DisplayName    string   `json:"displayName"`
+	Bio            *string  `json:"bio,omitempty"`
+	AvatarURL      *string  `json:"avatarUrl,omitempty"`
+	HeightCm       *int32   `json:"heightCm,omitempty"`
+	FavoriteStyles []string `json:"favoriteStyles,omitempty"`
+	Links          []string `json:"links,omitempty"`
+}
+
+type DataExport struct {
+	ID          string           `json:"id"`
+	Status      DataExportStatus `json:"status"`
+	RequestedAt string           `json:"requestedAt"`
+	ExpiresAt   *string          `json:"expiresAt,omitempty"`
+}
+
+type FollowConnection struct {
+	Nodes      []*FollowUser `json:"nodes"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int32         `json:"totalCount"`
+}
+
+type FollowRequest struct {
+	UserID      string `json:"userId"`
+	RequestedAt string `json:"requestedAt"`
+}
+
+type FollowRequestConnection struct {
+	Nodes    []*FollowRequest `json:"nodes"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type FollowStatus struct {
+	UserID         string `json:"userId"`
+	Following      bool   `json:"following"`
+	Requested      bool   `json:"requested"`
+	FollowerCount  int32  `json:"followerCount"`
+	FollowingCount int32  `json:"followingCount"`
+}
+
+type FollowUser struct {
+	UserID     string `json:"userId"`
+	FollowedAt string `json:"followedAt"`
+}
+
+type HandleAvailability struct {
+	Handle    string                   `json:"handle"`
+	Available bool                     `json:"available"`
+	Reason    *HandleUnavailableReason `json:"reason,omitempty"`
+}
+
+type ImageVariant struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Width  int32  `json:"width"`
+	Height int32  `json:"height"`
+}
+
+type LinkProviderInput struct {
+	Provider AuthProvider `json:"provider"`
+	IDToken  string       `json:"idToken"`
+}
+
+type LoginPayload struct {
+	User         *RegisteredUser `json:"user"`
+	Tokens       *AuthTokens     `json:"tokens,omitempty"`
+	MfaChallenge *string         `json:"mfaChallenge,omitempty"`
+}
+
+type LoginWithIDTokenInput struct {
+	IDToken string `json:"idToken"`
+}
+
+type Me struct {
+	ID            string       `json:"id"`
+	Email         string       `json:"email"`
+	EmailVerified bool         `json:"emailVerified"`
+	Role          Role         `json:"role"`
+	MfaEnabled    bool         `json:"mfaEnabled"`
+	Summary       *UserSummary `json:"summary"`
+}
+
 type Mutation struct {
 }
 
-type NewTodo struct {
-	Text   string `json:"text"`
-	UserID string `json:"userId"`
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
+type PrivacySettings struct {
+	IsPrivate         bool              `json:"isPrivate"`
+	HideCloset        bool              `json:"hideCloset"`
+	HideLikes         bool              `json:"hideLikes"`
+	CommentPermission CommentPermission `json:"commentPermission"`
+}
+
+type Profile struct {
+	UserID         string   `json:"userId"`
+	DisplayName    string   `json:"displayName"`
+	Bio            string   `json:"bio"`
+	AvatarURL      *string  `json:"avatarUrl,omitempty"`
+	HeightCm       *int32   `json:"heightCm,omitempty"`
+	FavoriteStyles []string `json:"favoriteStyles"`
+	Links          []string `json:"links"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+	Restricted     bool     `json:"restricted"`
 }
 
 type Query struct {
 }
 
 type RegisterUserInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email          string  `json:"email"`
+	Password       string  `json:"password"`
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 type RegisterUserPayload struct {
@@ -29,18 +143,495 @@ type RegisterUserPayload struct {
 }
 
 type RegisteredUser struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
+}
+
+type Session struct {
+	ID         string          `json:"id"`
+	DeviceName string          `json:"deviceName"`
+	Platform   SessionPlatform `json:"platform"`
+	IPAddress  string          `json:"ipAddress"`
+	UserAgent  string          `json:"userAgent"`
+	LastSeenAt string          `json:"lastSeenAt"`
+	CreatedAt  string          `json:"createdAt"`
+	Current    bool            `json:"current"`
+}
+
+type SignInWithProviderInput struct {
+	Provider AuthProvider `json:"provider"`
+	IDToken  string       `json:"idToken"`
+}
+
+type SignInWithProviderPayload struct {
+	User                *RegisteredUser `json:"user"`
+	Tokens              *AuthTokens     `json:"tokens,omitempty"`
+	MfaChallenge        *string         `json:"mfaChallenge,omitempty"`
+	IsNewUser           bool            `json:"isNewUser"`
+	FirebaseCustomToken *string         `json:"firebaseCustomToken,omitempty"`
+}
+
+type TotpEnrollment struct {
+	Secret     string `json:"secret"`
+	OtpauthURL string `json:"otpauthUrl"`
+}
+
+type UpdatePrivacySettingsInput struct {
+	IsPrivate         *bool              `json:"isPrivate,omitempty"`
+	HideCloset        *bool              `json:"hideCloset,omitempty"`
+	HideLikes         *bool              `json:"hideLikes,omitempty"`
+	CommentPermission *CommentPermission `json:"commentPermission,omitempty"`
+}
+
+type UpdateProfileInput struct {
+	DisplayName    *string  `json:"displayName,omitempty"`
+	Bio            *string  `json:"bio,omitempty"`
+	AvatarURL      *string  `json:"avatarUrl,omitempty"`
+	HeightCm       *int32   `json:"heightCm,omitempty"`
+	FavoriteStyles []string `json:"favoriteStyles,omitempty"`
+	Links          []string `json:"links,omitempty"`
+}
+
+type UploadedImage struct {
+	ID          string          `json:"id"`
+	Purpose     ImagePurpose    `json:"purpose"`
+	ContentType string          `json:"contentType"`
+	Variants    []*ImageVariant `json:"variants"`
+}
+
+type UserByHandlePayload struct {
+	UserID     string   `json:"userId"`
+	Handle     string   `json:"handle"`
+	Redirected bool     `json:"redirected"`
+	Profile    *Profile `json:"profile,omitempty"`
+}
+
+type UserCounts struct {
+	Posts     int32 `json:"posts"`
+	Listings  int32 `json:"listings"`
+	Followers int32 `json:"followers"`
+	Ratings   int32 `json:"ratings"`
+}
+
+type UserHandle struct {
+	Handle                string `json:"handle"`
+	ChangedAt             string `json:"changedAt"`
+	NextChangeAvailableAt string `json:"nextChangeAvailableAt"`
+}
+
+type UserIdentity struct {
+	Provider AuthProvider `json:"provider"`
+	LinkedAt string       `json:"linkedAt"`
+}
+
+type UserSummary struct {
+	UserID      string      `json:"userId"`
+	Handle      *string     `json:"handle,omitempty"`
+	DisplayName *string     `json:"displayName,omitempty"`
+	AvatarURL   *string     `json:"avatarUrl,omitempty"`
+	Bio         *string     `json:"bio,omitempty"`
+	Counts      *UserCounts `json:"counts"`
+	JoinedAt    string      `json:"joinedAt"`
+}
+
+type AuthProvider string
+
+const (
+	AuthProviderGoogle AuthProvider = "GOOGLE"
+	AuthProviderApple  AuthProvider = "APPLE"
+	AuthProviderX      AuthProvider = "X"
+	AuthProviderLine   AuthProvider = "LINE"
+)
+
+var AllAuthProvider = []AuthProvider{
+	AuthProviderGoogle,
+	AuthProviderApple,
+	AuthProviderX,
+	AuthProviderLine,
+}
+
+func (e AuthProvider) IsValid() bool {
+	switch e {
+	case AuthProviderGoogle, AuthProviderApple, AuthProviderX, AuthProviderLine:
+		return true
+	}
+	return false
+}
+
+func (e AuthProvider) String() string {
+	return string(e)
+}
+
+func (e *AuthProvider) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuthProvider(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuthProvider", str)
+	}
+	return nil
+}
+
+func (e AuthProvider) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuthProvider) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuthProvider) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CommentPermission string
+
+const (
+	CommentPermissionEveryone  CommentPermission = "EVERYONE"
+	CommentPermissionFollowers CommentPermission = "FOLLOWERS"
+	CommentPermissionNobody    CommentPermission = "NOBODY"
+)
+
+var AllCommentPermission = []CommentPermission{
+	CommentPermissionEveryone,
+	CommentPermissionFollowers,
+	CommentPermissionNobody,
+}
+
+func (e CommentPermission) IsValid() bool {
+	switch e {
+	case CommentPermissionEveryone, CommentPermissionFollowers, CommentPermissionNobody:
+		return true
+	}
+	return false
+}
+
+func (e CommentPermission) String() string {
+	return string(e)
+}
+
+func (e *CommentPermission) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentPermission(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentPermission", str)
+	}
+	return nil
+}
+
+func (e CommentPermission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentPermission) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentPermission) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPending DataExportStatus = "PENDING"
+	DataExportStatusReady   DataExportStatus = "READY"
+	DataExportStatusFailed  DataExportStatus = "FAILED"
+)
+
+var AllDataExportStatus = []DataExportStatus{
+	DataExportStatusPending,
+	DataExportStatusReady,
+	DataExportStatusFailed,
+}
+
+func (e DataExportStatus) IsValid() bool {
+	switch e {
+	case DataExportStatusPending, DataExportStatusReady, DataExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e DataExportStatus) String() string {
+	return string(e)
+}
+
+func (e *DataExportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DataExportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DataExportStatus", str)
+	}
+	return nil
+}
+
+func (e DataExportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DataExportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DataExportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type HandleUnavailableReason string
+
+const (
+	HandleUnavailableReasonInvalid  HandleUnavailableReason = "INVALID"
+	HandleUnavailableReasonReserved HandleUnavailableReason = "RESERVED"
+	HandleUnavailableReasonTaken    HandleUnavailableReason = "TAKEN"
+)
+
+var AllHandleUnavailableReason = []HandleUnavailableReason{
+	HandleUnavailableReasonInvalid,
+	HandleUnavailableReasonReserved,
+	HandleUnavailableReasonTaken,
+}
+
+func (e HandleUnavailableReason) IsValid() bool {
+	switch e {
+	case HandleUnavailableReasonInvalid, HandleUnavailableReasonReserved, HandleUnavailableReasonTaken:
+		return true
+	}
+	return false
+}
+
+func (e HandleUnavailableReason) String() string {
+	return string(e)
+}
+
+func (e *HandleUnavailableReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HandleUnavailableReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HandleUnavailableReason", str)
+	}
+	return nil
+}
+
+func (e HandleUnavailableReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HandleUnavailableReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HandleUnavailableReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ImagePurpose string
+
+const (
+	ImagePurposeAvatar     ImagePurpose = "AVATAR"
+	ImagePurposeCoordinate ImagePurpose = "COORDINATE"
+	ImagePurposeListing    ImagePurpose = "LISTING"
+)
+
+var AllImagePurpose = []ImagePurpose{
+	ImagePurposeAvatar,
+	ImagePurposeCoordinate,
+	ImagePurposeListing,
+}
+
+func (e ImagePurpose) IsValid() bool {
+	switch e {
+	case ImagePurposeAvatar, ImagePurposeCoordinate, ImagePurposeListing:
+		return true
+	}
+	return false
+}
+
+func (e ImagePurpose) String() string {
+	return string(e)
+}
+
+func (e *ImagePurpose) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImagePurpose(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImagePurpose", str)
+	}
+	return nil
+}
+
+func (e ImagePurpose) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ImagePurpose) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ImagePurpose) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Role string
+
+const (
+	RoleUser  Role = "USER"
+	RoleAdmin Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SessionPlatform string
+
+const (
+	SessionPlatformIos     SessionPlatform = "IOS"
+	SessionPlatformAndroid SessionPlatform = "ANDROID"
+	SessionPlatformWeb     SessionPlatform = "WEB"
+	SessionPlatformUnknown SessionPlatform = "UNKNOWN"
+)
+
+var AllSessionPlatform = []SessionPlatform{
+	SessionPlatformIos,
+	SessionPlatformAndroid,
+	SessionPlatformWeb,
+	SessionPlatformUnknown,
+}
+
+func (e SessionPlatform) IsValid() bool {
+	switch e {
+	case SessionPlatformIos, SessionPlatformAndroid, SessionPlatformWeb, SessionPlatformUnknown:
+		return true
+	}
+	return false
+}
+
+func (e SessionPlatform) String() string {
+	return string(e)
+}
+
+func (e *SessionPlatform) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SessionPlatform(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SessionPlatform", str)
+	}
+	return nil
+}
+
+func (e SessionPlatform) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type Todo struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
-	Done bool   `json:"done"`
-	User *User  `json:"user"`
+func (e *SessionPlatform) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
 }
 
-type User struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+func (e SessionPlatform) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"strings"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+)
+
+// build_privacy_settings_model はドメインのプライバシー設定をGraphQLのPrivacySettingsに変換します
+func build_privacy_settings_model(settings models.PrivacySettings) *model.PrivacySettings {
+	return &model.PrivacySettings{
+		IsPrivate:         settings.IsPrivate(),
+		HideCloset:        settings.HideCloset(),
+		HideLikes:         settings.HideLikes(),
+		CommentPermission: model.CommentPermission(strings.ToUpper(settings.CommentPermission().String())),
+	}
+}
+
+// build_privacy_settings_input はGraphQLのプライバシー設定の更新の入力値をユースケースの入力値に変換します
+func build_privacy_settings_input(input model.UpdatePrivacySettingsInput) user.PrivacySettingsInput {
+	var result user.PrivacySettingsInput
+
+	result = user.PrivacySettingsInput{
+		IsPrivate:  input.IsPrivate,
+		HideCloset: input.HideCloset,
+		HideLikes:  input.HideLikes,
+	}
+	if input.CommentPermission != nil {
+		var comment_permission string
+
+		comment_permission = strings.ToLower(input.CommentPermission.String())
+		result.CommentPermission = &comment_permission
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+)
+
+// build_profile_model はドメインのプロフィールをGraphQLのProfileに変換します
+// 他のユーザーにも返すため、プロフィールの公開情報のみを含めます
+func build_profile_model(profile *models.Profile) *model.Profile {
+	var result *model.Profile
+
+	result = &model.Profile{
+		UserID:         profile.UserID().String(),
+		DisplayName:    profile.DisplayName().Value(),
+		Bio:            profile.Bio().Value(),
+		FavoriteStyles: make([]string, 0, len(profile.FavoriteStyles())),
+		Links:          make([]string, 0, len(profile.Links())),
+		CreatedAt:      profile.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:      profile.UpdatedAt().Format(time.RFC3339),
+		Restricted:     profile.IsRestricted(),
+	}
+	if profile.AvatarURL() != nil {
+		var avatar_url string
+
+		avatar_url = profile.AvatarURL().Value()
+		result.AvatarURL = &avatar_url
+	}
+	if profile.Height() != nil {
+		var height_cm int32
+
+		height_cm = int32(profile.Height().Centimeters())
+		result.HeightCm = &height_cm
+	}
+	for _, style := range profile.FavoriteStyles() {
+		result.FavoriteStyles = append(result.FavoriteStyles, style.Value())
+	}
+	for _, link := range profile.Links() {
+		result.Links = append(result.Links, link.Value())
+	}
+	return result
+}
+
+// build_height_cm_input はGraphQLの身長の入力値をユースケースの入力値に変換します
+func build_height_cm_input(height_cm *int32) *int {
+	var value int
+
+	if height_cm == nil {
+		return nil
+	}
+	value = int(*height_cm)
+	return &value
+}
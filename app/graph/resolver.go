@@ -10,6 +10,46 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	Client              *ent.Client
-	RegisterUserUseCase *user.RegisterUserUseCase
+	Client                         *ent.Client
+	RegisterUserUseCase            *user.RegisterUserUseCase
+	LoginUserUseCase               *user.LoginUserUseCase
+	RefreshTokensUseCase           *user.RefreshTokensUseCase
+	LogoutUseCase                  *user.LogoutUseCase
+	RequestPasswordResetUseCase    *user.RequestPasswordResetUseCase
+	ResendVerificationEmailUseCase *user.ResendVerificationEmailUseCase
+	SignInWithProviderUseCase      *user.SignInWithProviderUseCase
+	LinkProviderUseCase            *user.LinkProviderUseCase
+	UnlinkProviderUseCase          *user.UnlinkProviderUseCase
+	EnrollTotpUseCase              *user.EnrollTotpUseCase
+	ConfirmTotpUseCase             *user.ConfirmTotpUseCase
+	DisableTotpUseCase             *user.DisableTotpUseCase
+	RegenerateRecoveryCodesUseCase *user.RegenerateRecoveryCodesUseCase
+	VerifyMfaUseCase               *user.VerifyMfaUseCase
+	ListSessionsUseCase            *user.ListSessionsUseCase
+	RevokeSessionUseCase           *user.RevokeSessionUseCase
+	CreateProfileUseCase           *user.CreateProfileUseCase
+	UpdateProfileUseCase           *user.UpdateProfileUseCase
+	GetProfileUseCase              *user.GetProfileUseCase
+	CheckHandleAvailabilityUseCase *user.CheckHandleAvailabilityUseCase
+	ChangeHandleUseCase            *user.ChangeHandleUseCase
+	FindUserByHandleUseCase        *user.FindUserByHandleUseCase
+	FollowUserUseCase              *user.FollowUserUseCase
+	UnfollowUserUseCase            *user.UnfollowUserUseCase
+	ListFollowsUseCase             *user.ListFollowsUseCase
+	ApproveFollowRequestUseCase    *user.ApproveFollowRequestUseCase
+	RejectFollowRequestUseCase     *user.RejectFollowRequestUseCase
+	ListFollowRequestsUseCase      *user.ListFollowRequestsUseCase
+	GetPrivacySettingsUseCase      *user.GetPrivacySettingsUseCase
+	UpdatePrivacySettingsUseCase   *user.UpdatePrivacySettingsUseCase
+	BlockUserUseCase               *user.BlockUserUseCase
+	UnblockUserUseCase             *user.UnblockUserUseCase
+	MuteUserUseCase                *user.MuteUserUseCase
+	UnmuteUserUseCase              *user.UnmuteUserUseCase
+	DeleteMyAccountUseCase         *user.DeleteMyAccountUseCase
+	CancelAccountDeletionUseCase   *user.CancelAccountDeletionUseCase
+	RequestDataExportUseCase       *user.RequestDataExportUseCase
+	RequestEmailChangeUseCase      *user.RequestEmailChangeUseCase
+	GetMeUseCase                   *user.GetMeUseCase
+	GetUserSummaryUseCase          *user.GetUserSummaryUseCase
+	UploadImageUseCase             *user.UploadImageUseCase
 }
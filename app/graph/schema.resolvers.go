@@ -7,30 +7,37 @@ package graph
 
 import (
 	"context"
-	"fmt"
+	"sleeve/domain/models"
 	"sleeve/graph/model"
 	"sleeve/usecase/user"
-)
+	"sleeve/usecase/utils"
+	"strings"
+	"time"
 
-// CreateTodo is the resolver for the createTodo field.
-func (r *mutationResolver) CreateTodo(ctx context.Context, input model.NewTodo) (*model.Todo, error) {
-	panic(fmt.Errorf("not implemented: CreateTodo - createTodo"))
-}
+	"github.com/99designs/gqlgen/graphql"
+)
 
 // RegisterUser is the resolver for the registerUser field.
 func (r *mutationResolver) RegisterUser(ctx context.Context, input model.RegisterUserInput) (*model.RegisterUserPayload, error) {
 	var result *model.RegisterUserPayload
+	var idempotency_key string
 	var usecase_result *user.RegisterUserResult
 	var err error
 
-	usecase_result, err = r.RegisterUserUseCase.Execute(ctx, input.Email, input.Password)
+	// 冪等キーは入力フィールドを優先し、未指定の場合はIdempotency-Keyヘッダーの値を使用する
+	idempotency_key = utils.GetIdempotencyKey(ctx)
+	if input.IdempotencyKey != nil {
+		idempotency_key = *input.IdempotencyKey
+	}
+	usecase_result, err = r.RegisterUserUseCase.ExecuteWithIdempotencyKey(ctx, idempotency_key, input.Email, input.Password)
 	if err != nil {
 		return nil, err
 	}
 	result = &model.RegisterUserPayload{
 		User: &model.RegisteredUser{
-			ID:    usecase_result.User.PublicID().String(),
-			Email: usecase_result.User.Email().Value(),
+			ID:            usecase_result.User.PublicID().String(),
+			Email:         usecase_result.User.Email().Value(),
+			EmailVerified: usecase_result.User.IsEmailVerified(),
 		},
 		Tokens: &model.AuthTokens{
 			AccessToken:  usecase_result.AccessToken,
@@ -40,9 +47,723 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input model.Registe
 	return result, nil
 }
 
-// Todos is the resolver for the todos field.
-func (r *queryResolver) Todos(ctx context.Context) ([]*model.Todo, error) {
-	panic(fmt.Errorf("not implemented: Todos - todos"))
+// LoginWithIDToken is the resolver for the loginWithIdToken field.
+func (r *mutationResolver) LoginWithIDToken(ctx context.Context, input model.LoginWithIDTokenInput) (*model.LoginPayload, error) {
+	var result *model.LoginPayload
+	var usecase_result *user.LoginUserResult
+	var err error
+
+	usecase_result, err = r.LoginUserUseCase.Execute(ctx, input.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	result = &model.LoginPayload{
+		User: &model.RegisteredUser{
+			ID:            usecase_result.User.PublicID().String(),
+			Email:         usecase_result.User.Email().Value(),
+			EmailVerified: usecase_result.User.IsEmailVerified(),
+		},
+	}
+	if usecase_result.MfaChallenge != "" {
+		// 二要素認証が有効な場合は、verifyMfaでチャレンジを完了するまでトークンを返さない
+		result.MfaChallenge = &usecase_result.MfaChallenge
+		return result, nil
+	}
+	result.Tokens = &model.AuthTokens{
+		AccessToken:  usecase_result.AccessToken,
+		RefreshToken: usecase_result.RefreshToken,
+	}
+	return result, nil
+}
+
+// RefreshTokens is the resolver for the refreshTokens field.
+func (r *mutationResolver) RefreshTokens(ctx context.Context, refreshToken string) (*model.AuthTokens, error) {
+	var usecase_result *user.RefreshTokensResult
+	var err error
+
+	usecase_result, err = r.RefreshTokensUseCase.Execute(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AuthTokens{
+		AccessToken:  usecase_result.AccessToken,
+		RefreshToken: usecase_result.RefreshToken,
+	}, nil
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, accessToken string) (bool, error) {
+	var err error
+
+	err = r.LogoutUseCase.Execute(ctx, accessToken, false)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LogoutAllSessions is the resolver for the logoutAllSessions field.
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context, accessToken string) (bool, error) {
+	var err error
+
+	err = r.LogoutUseCase.Execute(ctx, accessToken, true)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, email string) (bool, error) {
+	var err error
+
+	err = r.RequestPasswordResetUseCase.Execute(ctx, email)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResendVerificationEmail is the resolver for the resendVerificationEmail field.
+func (r *mutationResolver) ResendVerificationEmail(ctx context.Context) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.ResendVerificationEmailUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SignInWithProvider is the resolver for the signInWithProvider field.
+func (r *mutationResolver) SignInWithProvider(ctx context.Context, input model.SignInWithProviderInput) (*model.SignInWithProviderPayload, error) {
+	var result *model.SignInWithProviderPayload
+	var usecase_result *user.SignInWithProviderResult
+	var err error
+
+	// GraphQLの列挙値（GOOGLEなど）をドメインのプロバイダ名（googleなど）に変換
+	usecase_result, err = r.SignInWithProviderUseCase.Execute(ctx, strings.ToLower(input.Provider.String()), input.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	result = &model.SignInWithProviderPayload{
+		User: &model.RegisteredUser{
+			ID:            usecase_result.User.PublicID().String(),
+			Email:         usecase_result.User.Email().Value(),
+			EmailVerified: usecase_result.User.IsEmailVerified(),
+		},
+		IsNewUser: usecase_result.IsNewUser,
+	}
+	if usecase_result.FirebaseCustomToken != "" {
+		result.FirebaseCustomToken = &usecase_result.FirebaseCustomToken
+	}
+	if usecase_result.MfaChallenge != "" {
+		// 二要素認証が有効な場合は、verifyMfaでチャレンジを完了するまでトークンを返さない
+		result.MfaChallenge = &usecase_result.MfaChallenge
+		return result, nil
+	}
+	result.Tokens = &model.AuthTokens{
+		AccessToken:  usecase_result.AccessToken,
+		RefreshToken: usecase_result.RefreshToken,
+	}
+	return result, nil
+}
+
+// LinkProvider is the resolver for the linkProvider field.
+func (r *mutationResolver) LinkProvider(ctx context.Context, input model.LinkProviderInput) (*model.UserIdentity, error) {
+	var current_user *models.User
+	var identity *models.UserIdentity
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err = r.LinkProviderUseCase.Execute(ctx, current_user, strings.ToLower(input.Provider.String()), input.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UserIdentity{
+		Provider: model.AuthProvider(strings.ToUpper(identity.Provider().String())),
+		LinkedAt: identity.LinkedAt().Format(time.RFC3339),
+	}, nil
+}
+
+// UnlinkProvider is the resolver for the unlinkProvider field.
+func (r *mutationResolver) UnlinkProvider(ctx context.Context, provider model.AuthProvider) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.UnlinkProviderUseCase.Execute(ctx, current_user, strings.ToLower(provider.String()))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// EnrollTotp is the resolver for the enrollTotp field.
+func (r *mutationResolver) EnrollTotp(ctx context.Context) (*model.TotpEnrollment, error) {
+	var current_user *models.User
+	var enrollment *user.TotpEnrollment
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err = r.EnrollTotpUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TotpEnrollment{
+		Secret:     enrollment.Secret,
+		OtpauthURL: enrollment.ProvisioningURI,
+	}, nil
+}
+
+// ConfirmTotp is the resolver for the confirmTotp field.
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.ConfirmTotpUseCase.Execute(ctx, current_user, code)
+}
+
+// DisableTotp is the resolver for the disableTotp field.
+func (r *mutationResolver) DisableTotp(ctx context.Context, code string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.DisableTotpUseCase.Execute(ctx, current_user, code)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RegenerateRecoveryCodes is the resolver for the regenerateRecoveryCodes field.
+func (r *mutationResolver) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.RegenerateRecoveryCodesUseCase.Execute(ctx, current_user, code)
+}
+
+// VerifyMfa is the resolver for the verifyMfa field.
+func (r *mutationResolver) VerifyMfa(ctx context.Context, challenge string, code string) (*model.LoginPayload, error) {
+	var usecase_result *user.LoginUserResult
+	var err error
+
+	usecase_result, err = r.VerifyMfaUseCase.Execute(ctx, challenge, code)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LoginPayload{
+		User: &model.RegisteredUser{
+			ID:            usecase_result.User.PublicID().String(),
+			Email:         usecase_result.User.Email().Value(),
+			EmailVerified: usecase_result.User.IsEmailVerified(),
+		},
+		Tokens: &model.AuthTokens{
+			AccessToken:  usecase_result.AccessToken,
+			RefreshToken: usecase_result.RefreshToken,
+		},
+	}, nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.RevokeSessionUseCase.Execute(ctx, current_user, id)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateProfile is the resolver for the createProfile field.
+func (r *mutationResolver) CreateProfile(ctx context.Context, input model.CreateProfileInput) (*model.Profile, error) {
+	var current_user *models.User
+	var profile *models.Profile
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err = r.CreateProfileUseCase.Execute(ctx, current_user, user.ProfileInput{
+		DisplayName:    &input.DisplayName,
+		Bio:            input.Bio,
+		AvatarURL:      input.AvatarURL,
+		HeightCm:       build_height_cm_input(input.HeightCm),
+		FavoriteStyles: input.FavoriteStyles,
+		Links:          input.Links,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return build_profile_model(profile), nil
+}
+
+// UpdateProfile is the resolver for the updateProfile field.
+func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.UpdateProfileInput) (*model.Profile, error) {
+	var current_user *models.User
+	var profile *models.Profile
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err = r.UpdateProfileUseCase.Execute(ctx, current_user, user.ProfileInput{
+		DisplayName:    input.DisplayName,
+		Bio:            input.Bio,
+		AvatarURL:      input.AvatarURL,
+		HeightCm:       build_height_cm_input(input.HeightCm),
+		FavoriteStyles: input.FavoriteStyles,
+		Links:          input.Links,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return build_profile_model(profile), nil
+}
+
+// ChangeHandle is the resolver for the changeHandle field.
+func (r *mutationResolver) ChangeHandle(ctx context.Context, handle string) (*model.UserHandle, error) {
+	var current_user *models.User
+	var user_handle *models.UserHandle
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user_handle, err = r.ChangeHandleUseCase.Execute(ctx, current_user, handle)
+	if err != nil {
+		return nil, err
+	}
+	return build_user_handle_model(user_handle), nil
+}
+
+// Follow is the resolver for the follow field.
+func (r *mutationResolver) Follow(ctx context.Context, userID string) (*model.FollowStatus, error) {
+	var current_user *models.User
+	var status *user.FollowStatus
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err = r.FollowUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_status_model(status), nil
+}
+
+// Unfollow is the resolver for the unfollow field.
+func (r *mutationResolver) Unfollow(ctx context.Context, userID string) (*model.FollowStatus, error) {
+	var current_user *models.User
+	var status *user.FollowStatus
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err = r.UnfollowUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_status_model(status), nil
+}
+
+// ApproveFollowRequest is the resolver for the approveFollowRequest field.
+func (r *mutationResolver) ApproveFollowRequest(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.ApproveFollowRequestUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RejectFollowRequest is the resolver for the rejectFollowRequest field.
+func (r *mutationResolver) RejectFollowRequest(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.RejectFollowRequestUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdatePrivacySettings is the resolver for the updatePrivacySettings field.
+func (r *mutationResolver) UpdatePrivacySettings(ctx context.Context, input model.UpdatePrivacySettingsInput) (*model.PrivacySettings, error) {
+	var current_user *models.User
+	var settings models.PrivacySettings
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, err = r.UpdatePrivacySettingsUseCase.Execute(ctx, current_user, build_privacy_settings_input(input))
+	if err != nil {
+		return nil, err
+	}
+	return build_privacy_settings_model(settings), nil
+}
+
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.BlockUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.UnblockUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MuteUser is the resolver for the muteUser field.
+func (r *mutationResolver) MuteUser(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.MuteUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnmuteUser is the resolver for the unmuteUser field.
+func (r *mutationResolver) UnmuteUser(ctx context.Context, userID string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.UnmuteUserUseCase.Execute(ctx, current_user, userID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteMyAccount is the resolver for the deleteMyAccount field.
+func (r *mutationResolver) DeleteMyAccount(ctx context.Context) (*model.AccountDeletion, error) {
+	var current_user *models.User
+	var purge_at time.Time
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	purge_at, err = r.DeleteMyAccountUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AccountDeletion{
+		PurgeAfter: purge_at.Format(time.RFC3339),
+	}, nil
+}
+
+// CancelAccountDeletion is the resolver for the cancelAccountDeletion field.
+func (r *mutationResolver) CancelAccountDeletion(ctx context.Context, token string) (bool, error) {
+	var err error
+
+	err = r.CancelAccountDeletionUseCase.Execute(ctx, token)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RequestDataExport is the resolver for the requestDataExport field.
+func (r *mutationResolver) RequestDataExport(ctx context.Context) (*model.DataExport, error) {
+	var current_user *models.User
+	var data_export *models.DataExport
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data_export, err = r.RequestDataExportUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	return build_data_export_model(data_export), nil
+}
+
+// RequestEmailChange is the resolver for the requestEmailChange field.
+func (r *mutationResolver) RequestEmailChange(ctx context.Context, newEmail string) (bool, error) {
+	var current_user *models.User
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = r.RequestEmailChangeUseCase.Execute(ctx, current_user, newEmail)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UploadImage is the resolver for the uploadImage field.
+func (r *mutationResolver) UploadImage(ctx context.Context, file graphql.Upload, purpose model.ImagePurpose) (*model.UploadedImage, error) {
+	var current_user *models.User
+	var uploaded *models.UploadedImage
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uploaded, err = r.UploadImageUseCase.Execute(ctx, current_user, strings.ToLower(purpose.String()), file.File)
+	if err != nil {
+		return nil, err
+	}
+	return build_uploaded_image_model(uploaded), nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.Me, error) {
+	var current_user *models.User
+	var me *models.Me
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	me, err = r.GetMeUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	return build_me_model(me), nil
+}
+
+// UserSummary is the resolver for the userSummary field.
+func (r *queryResolver) UserSummary(ctx context.Context, publicID string) (*model.UserSummary, error) {
+	var viewer *models.User
+	var summary *models.UserSummary
+	var err error
+
+	viewer, _ = utils.GetCurrentUser(ctx)
+	summary, err = r.GetUserSummaryUseCase.Execute(ctx, viewer, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return build_user_summary_model(summary), nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*model.Session, error) {
+	var current_user *models.User
+	var claims *utils.JWTClaims
+	var current_session_id string
+	var sessions []*models.Session
+	var result []*model.Session
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims, _ = utils.GetAuthClaims(ctx)
+	if claims != nil {
+		current_session_id = claims.SessionID
+	}
+	sessions, err = r.ListSessionsUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &model.Session{
+			ID:         session.SessionID().String(),
+			DeviceName: session.Device().Name(),
+			Platform:   model.SessionPlatform(strings.ToUpper(session.Device().Platform().String())),
+			IPAddress:  session.Device().IPAddress(),
+			UserAgent:  session.Device().UserAgent(),
+			LastSeenAt: session.LastSeenAt().Format(time.RFC3339),
+			CreatedAt:  session.CreatedAt().Format(time.RFC3339),
+			Current:    session.SessionID().String() == current_session_id,
+		})
+	}
+	return result, nil
+}
+
+// Profile is the resolver for the profile field.
+func (r *queryResolver) Profile(ctx context.Context, publicID string) (*model.Profile, error) {
+	var viewer *models.User
+	var profile *models.Profile
+	var err error
+
+	viewer, _ = utils.GetCurrentUser(ctx)
+	profile, err = r.GetProfileUseCase.Execute(ctx, viewer, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return build_profile_model(profile), nil
+}
+
+// CheckHandleAvailability is the resolver for the checkHandleAvailability field.
+func (r *queryResolver) CheckHandleAvailability(ctx context.Context, handle string) (*model.HandleAvailability, error) {
+	var current_user *models.User
+	var availability *user.HandleAvailability
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	availability, err = r.CheckHandleAvailabilityUseCase.Execute(ctx, current_user, handle)
+	if err != nil {
+		return nil, err
+	}
+	return build_handle_availability_model(availability), nil
+}
+
+// UserByHandle is the resolver for the userByHandle field.
+func (r *queryResolver) UserByHandle(ctx context.Context, handle string) (*model.UserByHandlePayload, error) {
+	var viewer *models.User
+	var result *user.UserByHandleResult
+	var payload *model.UserByHandlePayload
+	var err error
+
+	viewer, _ = utils.GetCurrentUser(ctx)
+	result, err = r.FindUserByHandleUseCase.Execute(ctx, viewer, handle)
+	if err != nil {
+		return nil, err
+	}
+	payload = &model.UserByHandlePayload{
+		UserID:     result.User.PublicID().String(),
+		Handle:     result.Handle.Handle().Value(),
+		Redirected: result.Redirected,
+	}
+	if result.Profile != nil {
+		payload.Profile = build_profile_model(result.Profile)
+	}
+	return payload, nil
+}
+
+// Followers is the resolver for the followers field.
+func (r *queryResolver) Followers(ctx context.Context, userID string, first *int32, after *string) (*model.FollowConnection, error) {
+	return r.list_follows(ctx, user.FollowDirectionFollowers, userID, first, after)
+}
+
+// Following is the resolver for the following field.
+func (r *queryResolver) Following(ctx context.Context, userID string, first *int32, after *string) (*model.FollowConnection, error) {
+	return r.list_follows(ctx, user.FollowDirectionFollowing, userID, first, after)
+}
+
+// MyPrivacySettings is the resolver for the myPrivacySettings field.
+func (r *queryResolver) MyPrivacySettings(ctx context.Context) (*model.PrivacySettings, error) {
+	var current_user *models.User
+	var settings models.PrivacySettings
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, err = r.GetPrivacySettingsUseCase.Execute(ctx, current_user)
+	if err != nil {
+		return nil, err
+	}
+	return build_privacy_settings_model(settings), nil
+}
+
+// FollowRequests is the resolver for the followRequests field.
+func (r *queryResolver) FollowRequests(ctx context.Context, first *int32, after *string) (*model.FollowRequestConnection, error) {
+	var current_user *models.User
+	var page *models.FollowRequestPage
+	var err error
+
+	current_user, err = utils.RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err = r.ListFollowRequestsUseCase.Execute(ctx, current_user, build_page_size_input(first), after)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_request_connection_model(page), nil
 }
 
 // Mutation returns MutationResolver implementation.
@@ -2,13 +2,18 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
 	"sleeve/graph/model"
 	"sleeve/usecase/user"
 	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
 )
 
 // テスト用定数
@@ -17,6 +22,8 @@ const (
 	testPassword    = "Password123!"
 	testFirebaseUID = "firebase_uid_123"
 	testSecretKey   = "test_secret_key_for_testing_1234567890"
+	// testFingerprintKey は冪等キーの記録のフィンガープリントの秘密鍵です
+	testFingerprintKey = "test_idempotency_fingerprint_key_1234567890"
 )
 
 // TestRegisterUser_Success は正常なユーザー登録をテストします
@@ -126,79 +133,2476 @@ func TestRegisterUser_DuplicateEmail(t *testing.T) {
 	}
 }
 
-// createTestMutationResolver はテスト用のmutationResolverを作成します
-func createTestMutationResolver(
-	firebase_repo user.FirebaseUserRepositoryInterface,
-	user_dao user.UserDAOInterface,
-) *mutationResolver {
-	var resolver *Resolver
-	var use_case *user.RegisterUserUseCase
+// TestLoginWithIDToken_Success は正常なログインをテストします
+func TestLoginWithIDToken_Success(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var input model.LoginWithIDTokenInput
+	var result *model.LoginPayload
+	var err error
 
-	use_case = user.NewRegisterUserUseCase(
-		firebase_repo,
-		user_dao,
-		utils.NewJWTService(testSecretKey),
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
 	)
-	resolver = &Resolver{
-		RegisterUserUseCase: use_case,
+	input = model.LoginWithIDTokenInput{
+		IDToken: "valid_id_token",
+	}
+	result, err = resolver.LoginWithIDToken(ctx, input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.User == nil {
+		t.Fatal("expected user to be non-nil")
+	}
+	if result.User.Email != testEmail {
+		t.Errorf("expected email %s, got %s", testEmail, result.User.Email)
+	}
+	if result.Tokens == nil {
+		t.Fatal("expected tokens to be non-nil")
+	}
+	if result.Tokens.AccessToken == "" {
+		t.Error("expected access_token to be non-empty")
+	}
+	if result.Tokens.RefreshToken == "" {
+		t.Error("expected refresh_token to be non-empty")
 	}
-	return &mutationResolver{resolver}
 }
 
-// MockFirebaseUserRepository はテスト用のFirebaseリポジトリモックです
-type MockFirebaseUserRepository struct {
-	should_return_duplicate_error bool
-	should_return_error           bool
+// TestLoginWithIDToken_InvalidIDToken は不正なIDトークンでエラーを返すケースをテストします
+func TestLoginWithIDToken_InvalidIDToken(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var input model.LoginWithIDTokenInput
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifierWithError(),
+		NewMockUserFinder(),
+	)
+	input = model.LoginWithIDTokenInput{
+		IDToken: "invalid_id_token",
+	}
+	_, err = resolver.LoginWithIDToken(ctx, input)
+	if !errors.Is(err, domain_errors.ErrInvalidIDToken) {
+		t.Errorf("expected ErrInvalidIDToken, got %v", err)
+	}
 }
 
-// NewMockFirebaseUserRepository は新しいMockFirebaseUserRepositoryを作成します
-func NewMockFirebaseUserRepository() *MockFirebaseUserRepository {
-	return &MockFirebaseUserRepository{
-		should_return_duplicate_error: false,
-		should_return_error:           false,
+// TestLoginWithIDToken_DeletedUser は論理削除済みユーザーでエラーを返すケースをテストします
+func TestLoginWithIDToken_DeletedUser(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var input model.LoginWithIDTokenInput
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinderWithDeletedUser(),
+	)
+	input = model.LoginWithIDTokenInput{
+		IDToken: "valid_id_token",
+	}
+	_, err = resolver.LoginWithIDToken(ctx, input)
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Errorf("expected ErrUserDeleted, got %v", err)
 	}
 }
 
-// NewMockFirebaseUserRepositoryWithDuplicateEmail はメール重複エラーを返すモックを作成します
-func NewMockFirebaseUserRepositoryWithDuplicateEmail() *MockFirebaseUserRepository {
-	return &MockFirebaseUserRepository{
-		should_return_duplicate_error: true,
-		should_return_error:           false,
+// TestRefreshTokens_Success は正常なトークンリフレッシュをテストします
+func TestRefreshTokens_Success(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var login_result *model.LoginPayload
+	var result *model.AuthTokens
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+	)
+	login_result, err = resolver.LoginWithIDToken(ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	result, err = resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.AccessToken == "" {
+		t.Error("expected access_token to be non-empty")
+	}
+	if result.RefreshToken == login_result.Tokens.RefreshToken {
+		t.Error("expected refresh_token to be rotated")
 	}
 }
 
-// CreateUser はモックのユーザー作成を行います
-func (m *MockFirebaseUserRepository) CreateUser(_ context.Context, _ models.Email, _ models.Password) (string, error) {
-	if m.should_return_duplicate_error {
-		return "", domain_errors.ErrDuplicateEmail
+// TestRefreshTokens_Reused は使用済みリフレッシュトークンの再利用でエラーを返すケースをテストします
+func TestRefreshTokens_Reused(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var login_result *model.LoginPayload
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+	)
+	login_result, err = resolver.LoginWithIDToken(ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
 	}
-	if m.should_return_error {
-		return "", domain_errors.ErrFirebaseAuthFailed
+	_, err = resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected first refresh to succeed, got %v", err)
+	}
+	_, err = resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrRefreshTokenReused) {
+		t.Errorf("expected ErrRefreshTokenReused, got %v", err)
 	}
-	return testFirebaseUID, nil
 }
 
-// DeleteUser はモックのユーザー削除を行います
-func (m *MockFirebaseUserRepository) DeleteUser(_ context.Context, _ string) error {
-	return nil
+// TestLogout_Success はログアウト後にセッションのリフレッシュトークンが使えなくなることをテストします
+func TestLogout_Success(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var login_result *model.LoginPayload
+	var result bool
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+	)
+	login_result, err = resolver.LoginWithIDToken(ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	result, err = resolver.Logout(ctx, login_result.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+	_, err = resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
 }
 
-// MockUserDAO はテスト用のUserDAOモックです
-type MockUserDAO struct {
-	should_return_error bool
+// TestLogoutAllSessions_Success は全端末からのログアウトで他セッションも失効することをテストします
+func TestLogoutAllSessions_Success(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var current_session *model.LoginPayload
+	var other_session *model.LoginPayload
+	var result bool
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestLoginMutationResolver(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+	)
+	current_session, err = resolver.LoginWithIDToken(ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	other_session, err = resolver.LoginWithIDToken(ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	result, err = resolver.LogoutAllSessions(ctx, current_session.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+	_, err = resolver.Logout(ctx, other_session.Tokens.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked for other session, got %v", err)
+	}
 }
 
-// NewMockUserDAO は新しいMockUserDAOを作成します
-func NewMockUserDAO() *MockUserDAO {
-	return &MockUserDAO{
-		should_return_error: false,
+// TestRequestPasswordReset_UnregisteredEmail は未登録のメールアドレスでも登録済みと同じ結果を返すことをテストします
+func TestRequestPasswordReset_UnregisteredEmail(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var mail_sender *MockPasswordResetMailSender
+	var result bool
+	var err error
+
+	ctx = context.Background()
+	mail_sender = NewMockPasswordResetMailSender()
+	resolver = createTestPasswordResetMutationResolver(mail_sender)
+	for _, email := range []string{testEmail, "unregistered@example.com"} {
+		result, err = resolver.RequestPasswordReset(ctx, email)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %v", email, err)
+		}
+		if !result {
+			t.Errorf("expected result to be true for %s", email)
+		}
+	}
+	if mail_sender.sent_count != 1 {
+		t.Errorf("expected 1 mail to be sent, got %d", mail_sender.sent_count)
 	}
 }
 
-// Save はモックのユーザー保存を行います
-func (m *MockUserDAO) Save(_ context.Context, _ *models.User) error {
-	if m.should_return_error {
-		return domain_errors.ErrDatabaseError
+// TestRequestPasswordReset_TooManyRequests はレート制限を超えた場合にエラーを返すことをテストします
+func TestRequestPasswordReset_TooManyRequests(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var err error
+
+	ctx = context.Background()
+	resolver = createTestPasswordResetMutationResolver(NewMockPasswordResetMailSender())
+	for i := 0; i < testPasswordResetLimit; i++ {
+		_, err = resolver.RequestPasswordReset(ctx, testEmail)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	_, err = resolver.RequestPasswordReset(ctx, testEmail)
+	if !errors.Is(err, domain_errors.ErrTooManyPasswordResetRequests) {
+		t.Errorf("expected ErrTooManyPasswordResetRequests, got %v", err)
+	}
+}
+
+// TestResendVerificationEmail_Success はログインユーザーに確認メールが再送信されることをテストします
+func TestResendVerificationEmail_Success(t *testing.T) {
+	var resolver *mutationResolver
+	var result bool
+	var err error
+
+	resolver = createTestResendVerificationEmailMutationResolver()
+	result, err = resolver.ResendVerificationEmail(create_authenticated_context(t, models.RoleUser))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+}
+
+// TestResendVerificationEmail_Unauthenticated は未認証の場合にErrAuthenticationRequiredを返すことをテストします
+func TestResendVerificationEmail_Unauthenticated(t *testing.T) {
+	var resolver *mutationResolver
+	var err error
+
+	resolver = createTestResendVerificationEmailMutationResolver()
+	_, err = resolver.ResendVerificationEmail(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestSignInWithProvider_LINE はLINEのIDトークンで新規登録され、Firebaseのカスタムトークンが返ることをテストします
+func TestSignInWithProvider_LINE(t *testing.T) {
+	var resolver *mutationResolver
+	var result *model.SignInWithProviderPayload
+	var err error
+
+	resolver = createTestProviderMutationResolver(NewMockUserIdentityRepository())
+	result, err = resolver.SignInWithProvider(context.Background(), model.SignInWithProviderInput{
+		Provider: model.AuthProviderLine,
+		IDToken:  "line_id_token",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.IsNewUser {
+		t.Error("expected isNewUser to be true")
+	}
+	if result.User.Email != testEmail || !result.User.EmailVerified {
+		t.Errorf("expected verified email %s, got %s (verified=%v)", testEmail, result.User.Email, result.User.EmailVerified)
+	}
+	if result.FirebaseCustomToken == nil || *result.FirebaseCustomToken == "" {
+		t.Error("expected firebaseCustomToken to be returned for LINE")
+	}
+	if result.Tokens.AccessToken == "" || result.Tokens.RefreshToken == "" {
+		t.Error("expected tokens to be non-empty")
+	}
+}
+
+// TestLinkProvider_Success はログインユーザーに外部アカウントを連携できることをテストします
+func TestLinkProvider_Success(t *testing.T) {
+	var resolver *mutationResolver
+	var identity_repo *MockUserIdentityRepository
+	var result *model.UserIdentity
+	var err error
+
+	identity_repo = NewMockUserIdentityRepository()
+	resolver = createTestProviderMutationResolver(identity_repo)
+	result, err = resolver.LinkProvider(create_authenticated_context(t, models.RoleUser), model.LinkProviderInput{
+		Provider: model.AuthProviderGoogle,
+		IDToken:  "google_id_token",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Provider != model.AuthProviderGoogle {
+		t.Errorf("expected provider GOOGLE, got %s", result.Provider)
+	}
+	if len(identity_repo.identities) != 1 {
+		t.Errorf("expected 1 identity, got %d", len(identity_repo.identities))
+	}
+}
+
+// TestUnlinkProvider_NotLinked は連携していないプロバイダの解除でErrProviderNotLinkedを返すことをテストします
+func TestUnlinkProvider_NotLinked(t *testing.T) {
+	var resolver *mutationResolver
+	var err error
+
+	resolver = createTestProviderMutationResolver(NewMockUserIdentityRepository())
+	_, err = resolver.UnlinkProvider(create_authenticated_context(t, models.RoleUser), model.AuthProviderApple)
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		t.Errorf("expected ErrProviderNotLinked, got %v", err)
+	}
+}
+
+// TestUnlinkProvider_Unauthenticated は未認証の場合にErrAuthenticationRequiredを返すことをテストします
+func TestUnlinkProvider_Unauthenticated(t *testing.T) {
+	var resolver *mutationResolver
+	var err error
+
+	resolver = createTestProviderMutationResolver(NewMockUserIdentityRepository())
+	_, err = resolver.UnlinkProvider(context.Background(), model.AuthProviderGoogle)
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestMfa_EnrollAndVerify は二要素認証の登録後、ログインでチャレンジが返されverifyMfaでトークンが発行されることをテストします
+func TestMfa_EnrollAndVerify(t *testing.T) {
+	var ctx context.Context
+	var resolver *mutationResolver
+	var user_finder *MockUserFinder
+	var current_user *models.User
+	var enrollment *model.TotpEnrollment
+	var code string
+	var recovery_codes []string
+	var login_result *model.LoginPayload
+	var verify_result *model.LoginPayload
+	var err error
+
+	user_finder = NewMockUserFinder()
+	resolver = createTestMfaMutationResolver(user_finder)
+	current_user, err = user_finder.FindByFirebaseUID(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	ctx = utils.WithAuthInfo(context.Background(), &utils.JWTClaims{UserID: current_user.PublicID().String()}, current_user)
+
+	// 登録と確認
+	enrollment, err = resolver.EnrollTotp(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	code, err = utils.GenerateTotpCode(enrollment.Secret, time.Now())
+	if err != nil {
+		t.Fatalf("failed to generate totp code: %v", err)
+	}
+	recovery_codes, err = resolver.ConfirmTotp(ctx, code)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(recovery_codes) != models.RecoveryCodeCount {
+		t.Errorf("expected %d recovery codes, got %d", models.RecoveryCodeCount, len(recovery_codes))
+	}
+	user_finder.mfa_enabled_at = current_user.MfaEnabledAt()
+
+	// ログインではトークンの代わりにチャレンジが返される
+	login_result, err = resolver.LoginWithIDToken(context.Background(), model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if login_result.Tokens != nil {
+		t.Error("expected tokens to be nil before mfa verification")
+	}
+	if login_result.MfaChallenge == nil {
+		t.Fatal("expected mfa challenge to be non-nil")
+	}
+
+	// チャレンジを完了するとトークンが発行される
+	verify_result, err = resolver.VerifyMfa(context.Background(), *login_result.MfaChallenge, recovery_codes[0])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if verify_result.Tokens == nil || verify_result.Tokens.AccessToken == "" {
+		t.Error("expected access_token to be issued")
+	}
+}
+
+// TestVerifyMfa_InvalidCode は誤った認証コードでErrInvalidMfaCodeを返すことをテストします
+func TestVerifyMfa_InvalidCode(t *testing.T) {
+	var resolver *mutationResolver
+	var user_finder *MockUserFinder
+	var current_user *models.User
+	var challenge string
+	var err error
+
+	user_finder = NewMockUserFinder()
+	resolver = createTestMfaMutationResolver(user_finder)
+	current_user, err = user_finder.FindByFirebaseUID(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	_, err = resolver.EnrollTotp(utils.WithAuthInfo(context.Background(), &utils.JWTClaims{UserID: current_user.PublicID().String()}, current_user))
+	if err != nil {
+		t.Fatalf("failed to enroll totp: %v", err)
+	}
+	current_user.EnableMfa(time.Now())
+	user_finder.mfa_enabled_at = current_user.MfaEnabledAt()
+	challenge, err = createTestJWTService(NewMockTokenDenylist()).GenerateMfaChallengeToken(current_user.PublicID().String(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate challenge: %v", err)
+	}
+	_, err = resolver.VerifyMfa(context.Background(), challenge, "aaaaa-aaaaa")
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode, got %v", err)
+	}
+}
+
+// TestMySessionsAndRevokeSession はログイン中の端末の一覧と、失効させた端末のトークンが使えなくなることをテストします
+func TestMySessionsAndRevokeSession(t *testing.T) {
+	var resolver *Resolver
+	var jwt_service *utils.JWTService
+	var user_finder *MockUserFinder
+	var current_user *models.User
+	var device_ctx context.Context
+	var lost_login *model.LoginPayload
+	var current_login *model.LoginPayload
+	var claims *utils.JWTClaims
+	var auth_ctx context.Context
+	var sessions []*model.Session
+	var result bool
+	var err error
+
+	user_finder = NewMockUserFinder()
+	resolver, jwt_service = createTestSessionResolver(user_finder)
+	current_user, err = user_finder.FindByFirebaseUID(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	device_ctx = utils.WithClientInfo(context.Background(), &utils.ClientInfo{DeviceName: "Lost iPhone", Platform: "ios"})
+	lost_login, err = resolver.Mutation().LoginWithIDToken(device_ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	device_ctx = utils.WithClientInfo(context.Background(), &utils.ClientInfo{DeviceName: "My Pixel", Platform: "android"})
+	current_login, err = resolver.Mutation().LoginWithIDToken(device_ctx, model.LoginWithIDTokenInput{IDToken: "valid_id_token"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	claims, err = jwt_service.ValidateToken(context.Background(), current_login.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate access token: %v", err)
+	}
+	auth_ctx = utils.WithAuthInfo(context.Background(), claims, current_user)
+
+	sessions, err = resolver.Query().MySessions(auth_ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0].DeviceName != "Lost iPhone" || sessions[0].Platform != model.SessionPlatformIos || sessions[0].Current {
+		t.Errorf("unexpected session: %+v", sessions[0])
+	}
+	if sessions[1].Platform != model.SessionPlatformAndroid || !sessions[1].Current {
+		t.Errorf("unexpected session: %+v", sessions[1])
+	}
+
+	result, err = resolver.Mutation().RevokeSession(auth_ctx, sessions[0].ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+	_, err = jwt_service.ValidateToken(context.Background(), lost_login.Tokens.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked, got %v", err)
+	}
+	_, err = jwt_service.ValidateToken(context.Background(), current_login.Tokens.AccessToken)
+	if err != nil {
+		t.Errorf("expected current session to remain valid, got %v", err)
+	}
+	_, err = resolver.Mutation().RevokeSession(auth_ctx, sessions[0].ID)
+	if !errors.Is(err, domain_errors.ErrSessionNotFound) {
+		t.Errorf("expected ErrSessionNotFound, got %v", err)
+	}
+}
+
+// TestMySessions_Unauthenticated は未認証の場合にErrAuthenticationRequiredを返すことをテストします
+func TestMySessions_Unauthenticated(t *testing.T) {
+	var resolver *Resolver
+	var err error
+
+	resolver, _ = createTestSessionResolver(NewMockUserFinder())
+	_, err = resolver.Query().MySessions(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestProfile_CreateUpdateAndView はプロフィールの作成・更新と、他のユーザーからの閲覧をテストします
+func TestProfile_CreateUpdateAndView(t *testing.T) {
+	var ctx context.Context
+	var resolver *Resolver
+	var current_user *models.User
+	var bio string
+	var height_cm int32
+	var created_profile *model.Profile
+	var viewed_profile *model.Profile
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	resolver = createTestProfileResolver(current_user)
+	created_profile, err = (&mutationResolver{resolver}).CreateProfile(ctx, model.CreateProfileInput{
+		DisplayName:    "スリーブ太郎",
+		FavoriteStyles: []string{"casual"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created_profile.UserID != current_user.PublicID().String() {
+		t.Errorf("expected userId %s, got %s", current_user.PublicID(), created_profile.UserID)
+	}
+	bio = "古着が好きです"
+	height_cm = 168
+	_, err = (&mutationResolver{resolver}).UpdateProfile(ctx, model.UpdateProfileInput{Bio: &bio, HeightCm: &height_cm})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// 未ログインのユーザーからも公開プロフィールを閲覧できる
+	viewed_profile, err = (&queryResolver{resolver}).Profile(context.Background(), current_user.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if viewed_profile.DisplayName != "スリーブ太郎" || viewed_profile.Bio != bio {
+		t.Errorf("expected updated profile, got %+v", viewed_profile)
+	}
+	if viewed_profile.HeightCm == nil || *viewed_profile.HeightCm != height_cm {
+		t.Errorf("expected heightCm %d, got %v", height_cm, viewed_profile.HeightCm)
+	}
+	_, err = (&queryResolver{resolver}).Profile(context.Background(), uuid.New().String())
+	if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+}
+
+// TestCreateProfile_Unauthenticated は未認証の場合にErrAuthenticationRequiredを返すことをテストします
+func TestCreateProfile_Unauthenticated(t *testing.T) {
+	var err error
+
+	_, err = (&mutationResolver{createTestProfileResolver(nil)}).CreateProfile(context.Background(), model.CreateProfileInput{DisplayName: "taro"})
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestHandle_ChangeCheckAndFind はハンドルの変更・使用可否の確認と、変更前のハンドルでの検索をテストします
+func TestHandle_ChangeCheckAndFind(t *testing.T) {
+	var ctx context.Context
+	var resolver *Resolver
+	var current_user *models.User
+	var user_handle *model.UserHandle
+	var availability *model.HandleAvailability
+	var payload *model.UserByHandlePayload
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	resolver = createTestHandleResolver(current_user)
+	user_handle, err = (&mutationResolver{resolver}).ChangeHandle(ctx, "@Taro")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_handle.Handle != "Taro" {
+		t.Errorf("expected handle Taro, got %s", user_handle.Handle)
+	}
+	_, err = (&mutationResolver{resolver}).ChangeHandle(ctx, "jiro")
+	if !errors.Is(err, domain_errors.ErrHandleChangeCooldown) {
+		t.Errorf("expected ErrHandleChangeCooldown, got %v", err)
+	}
+
+	availability, err = (&queryResolver{resolver}).CheckHandleAvailability(ctx, "admin")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if availability.Available || availability.Reason == nil || *availability.Reason != model.HandleUnavailableReasonReserved {
+		t.Errorf("expected admin to be reserved, got %+v", availability)
+	}
+
+	// 未ログインのユーザーからも大文字・小文字を区別せずに検索できる
+	payload, err = (&queryResolver{resolver}).UserByHandle(context.Background(), "TARO")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if payload.UserID != current_user.PublicID().String() || payload.Handle != "Taro" || payload.Redirected || payload.Profile != nil {
+		t.Errorf("expected user with handle Taro and no profile, got %+v", payload)
+	}
+	_, err = (&queryResolver{resolver}).UserByHandle(context.Background(), "nobody")
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestFollow_FollowListAndUnfollow はフォロー・フォロワーの一覧・フォロー解除をテストします
+func TestFollow_FollowListAndUnfollow(t *testing.T) {
+	var ctx context.Context
+	var current_user *models.User
+	var followee *models.User
+	var resolver *Resolver
+	var status *model.FollowStatus
+	var connection *model.FollowConnection
+	var first int32
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	followee, _ = utils.GetCurrentUser(create_authenticated_context(t, models.RoleUser))
+	resolver = createTestFollowResolver(followee)
+
+	for range 2 {
+		status, err = (&mutationResolver{resolver}).Follow(ctx, followee.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !status.Following || status.FollowerCount != 1 {
+			t.Errorf("expected following with 1 follower, got %+v", status)
+		}
+	}
+	_, err = (&mutationResolver{resolver}).Follow(ctx, current_user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotFollowSelf) {
+		t.Errorf("expected ErrCannotFollowSelf, got %v", err)
+	}
+
+	// 未ログインのユーザーからもフォロワーの一覧を取得できる
+	connection, err = (&queryResolver{resolver}).Followers(context.Background(), followee.PublicID().String(), nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(connection.Nodes) != 1 || connection.Nodes[0].UserID != current_user.PublicID().String() || connection.TotalCount != 1 {
+		t.Errorf("expected current user as the only follower, got %+v", connection)
+	}
+	if connection.PageInfo.HasNextPage {
+		t.Error("expected no next page")
+	}
+	first = 0
+	_, err = (&queryResolver{resolver}).Following(context.Background(), followee.PublicID().String(), &first, nil)
+	if !errors.Is(err, domain_errors.ErrInvalidPagination) {
+		t.Errorf("expected ErrInvalidPagination, got %v", err)
+	}
+
+	status, err = (&mutationResolver{resolver}).Unfollow(ctx, followee.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if status.Following || status.FollowerCount != 0 {
+		t.Errorf("expected not following with 0 followers, got %+v", status)
+	}
+}
+
+// TestFollow_PrivateAccount は非公開アカウントへのフォローリクエストの承認と、フォロワーの一覧の制限をテストします
+func TestFollow_PrivateAccount(t *testing.T) {
+	var ctx context.Context
+	var followee_ctx context.Context
+	var current_user *models.User
+	var followee *models.User
+	var resolver *Resolver
+	var is_private bool
+	var comment_permission model.CommentPermission
+	var settings *model.PrivacySettings
+	var status *model.FollowStatus
+	var requests *model.FollowRequestConnection
+	var approved bool
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	followee_ctx = create_authenticated_context(t, models.RoleUser)
+	followee, _ = utils.GetCurrentUser(followee_ctx)
+	resolver = createTestFollowResolver(followee)
+
+	is_private = true
+	comment_permission = model.CommentPermissionFollowers
+	settings, err = (&mutationResolver{resolver}).UpdatePrivacySettings(followee_ctx, model.UpdatePrivacySettingsInput{
+		IsPrivate:         &is_private,
+		CommentPermission: &comment_permission,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !settings.IsPrivate || settings.CommentPermission != model.CommentPermissionFollowers {
+		t.Errorf("expected private account with followers-only comments, got %+v", settings)
+	}
+
+	status, err = (&mutationResolver{resolver}).Follow(ctx, followee.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if status.Following || !status.Requested || status.FollowerCount != 0 {
+		t.Errorf("expected pending follow request, got %+v", status)
+	}
+	_, err = (&queryResolver{resolver}).Followers(ctx, followee.PublicID().String(), nil, nil)
+	if !errors.Is(err, domain_errors.ErrPrivateAccount) {
+		t.Errorf("expected ErrPrivateAccount before approval, got %v", err)
+	}
+
+	requests, err = (&queryResolver{resolver}).FollowRequests(followee_ctx, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(requests.Nodes) != 1 || requests.Nodes[0].UserID != current_user.PublicID().String() {
+		t.Fatalf("expected request from current user, got %+v", requests.Nodes)
+	}
+	approved, err = (&mutationResolver{resolver}).ApproveFollowRequest(followee_ctx, current_user.PublicID().String())
+	if err != nil || !approved {
+		t.Fatalf("expected approval, got %v (err=%v)", approved, err)
+	}
+	_, err = (&mutationResolver{resolver}).RejectFollowRequest(followee_ctx, current_user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrFollowRequestNotFound) {
+		t.Errorf("expected ErrFollowRequestNotFound after approval, got %v", err)
+	}
+	_, err = (&queryResolver{resolver}).Followers(ctx, followee.PublicID().String(), nil, nil)
+	if err != nil {
+		t.Errorf("expected approved follower to see followers, got %v", err)
+	}
+}
+
+// TestMeAndUserSummary はログインユーザー自身の情報と、他のユーザーからの概要の閲覧をテストします
+func TestMeAndUserSummary(t *testing.T) {
+	var ctx context.Context
+	var current_user *models.User
+	var resolver *Resolver
+	var me *model.Me
+	var summary *model.UserSummary
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	resolver = createTestUserSummaryResolver(current_user)
+	_, err = (&mutationResolver{resolver}).ChangeHandle(ctx, "taro")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// プロフィールが未作成の場合は表示名などをnullで返す
+	me, err = (&queryResolver{resolver}).Me(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if me.ID != current_user.PublicID().String() || me.Email != current_user.Email().Value() || me.Role != model.RoleUser {
+		t.Errorf("expected current user, got %+v", me)
+	}
+	if me.Summary.Handle == nil || *me.Summary.Handle != "taro" || me.Summary.DisplayName != nil {
+		t.Errorf("expected handle taro and no display name, got %+v", me.Summary)
+	}
+
+	// 未ログインのユーザーからも概要を閲覧できる
+	_, err = (&mutationResolver{resolver}).CreateProfile(ctx, model.CreateProfileInput{DisplayName: "スリーブ太郎"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	summary, err = (&queryResolver{resolver}).UserSummary(context.Background(), current_user.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.DisplayName == nil || *summary.DisplayName != "スリーブ太郎" {
+		t.Errorf("expected display name スリーブ太郎, got %v", summary.DisplayName)
+	}
+	if summary.Counts.Followers != 0 || summary.Counts.Posts != 0 {
+		t.Errorf("expected zero counts, got %+v", summary.Counts)
+	}
+	_, err = (&queryResolver{resolver}).UserSummary(context.Background(), uuid.New().String())
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+
+	_, err = (&queryResolver{resolver}).Me(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestBlockAndMute はブロックでフォローが解除され、ブロック中はフォローできないことと、ミュートの冪等性をテストします
+func TestBlockAndMute(t *testing.T) {
+	var ctx context.Context
+	var current_user *models.User
+	var target *models.User
+	var resolver *Resolver
+	var ok bool
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	target, _ = utils.GetCurrentUser(create_authenticated_context(t, models.RoleUser))
+	resolver = createTestFollowResolver(target)
+
+	_, err = (&mutationResolver{resolver}).Follow(ctx, target.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ok, err = (&mutationResolver{resolver}).BlockUser(ctx, target.PublicID().String())
+	if err != nil || !ok {
+		t.Fatalf("expected block to succeed, got %v (err=%v)", ok, err)
+	}
+	// ブロックするとフォローが解除され、ブロック中は存在しないユーザーとしてフォローできない
+	_, err = (&mutationResolver{resolver}).Follow(ctx, target.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+	_, err = (&queryResolver{resolver}).Followers(ctx, target.PublicID().String(), nil, nil)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for blocked user's followers, got %v", err)
+	}
+	_, err = (&mutationResolver{resolver}).BlockUser(ctx, current_user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotBlockSelf) {
+		t.Errorf("expected ErrCannotBlockSelf, got %v", err)
+	}
+	ok, err = (&mutationResolver{resolver}).UnblockUser(ctx, target.PublicID().String())
+	if err != nil || !ok {
+		t.Fatalf("expected unblock to succeed, got %v (err=%v)", ok, err)
+	}
+	_, err = (&mutationResolver{resolver}).Follow(ctx, target.PublicID().String())
+	if err != nil {
+		t.Errorf("expected follow after unblock to succeed, got %v", err)
+	}
+
+	for range 2 {
+		ok, err = (&mutationResolver{resolver}).MuteUser(ctx, target.PublicID().String())
+		if err != nil || !ok {
+			t.Errorf("expected mute to succeed, got %v (err=%v)", ok, err)
+		}
+	}
+	ok, err = (&mutationResolver{resolver}).UnmuteUser(ctx, target.PublicID().String())
+	if err != nil || !ok {
+		t.Errorf("expected unmute to succeed, got %v (err=%v)", ok, err)
+	}
+	_, err = (&mutationResolver{resolver}).MuteUser(context.Background(), target.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestDeleteAndCancelAccount は退会と、メールで送信した取り消しコードによる削除の取り消しをテストします
+func TestDeleteAndCancelAccount(t *testing.T) {
+	var ctx context.Context
+	var current_user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var mail_sender *MockAccountDeletionMailSender
+	var resolver *Resolver
+	var deletion *model.AccountDeletion
+	var purge_after time.Time
+	var ok bool
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	current_user, _ = utils.GetCurrentUser(ctx)
+	user_repo = &MockAccountDeletionRepository{}
+	mail_sender = &MockAccountDeletionMailSender{}
+	resolver = &Resolver{
+		DeleteMyAccountUseCase: user.NewDeleteMyAccountUseCase(
+			&MockFirebaseUserDisabler{}, user_repo,
+			user.NewUserSessionRevoker(NewMockTokenDenylist(), NewMockRefreshTokenRepository(), NewMockSessionRepository()), mail_sender,
+			user.NewFirebaseUserCompensator(NewMockFirebaseUserRepository(), &MockCompensationTaskRepository{}),
+		),
+		CancelAccountDeletionUseCase: user.NewCancelAccountDeletionUseCase(user_repo, &MockFirebaseUserDisabler{}),
+	}
+
+	deletion, err = (&mutationResolver{resolver}).DeleteMyAccount(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	purge_after, err = time.Parse(time.RFC3339, deletion.PurgeAfter)
+	if err != nil || purge_after.Before(time.Now().Add(models.AccountDeletionGracePeriod-time.Minute)) {
+		t.Errorf("expected purgeAfter after grace period, got %s (err=%v)", deletion.PurgeAfter, err)
+	}
+	if user_repo.deleted_user_id != current_user.PublicID() {
+		t.Errorf("expected current user to be deleted, got %s", user_repo.deleted_user_id)
+	}
+	_, err = (&mutationResolver{resolver}).CancelAccountDeletion(context.Background(), "wrong-token")
+	if !errors.Is(err, domain_errors.ErrInvalidAccountDeletionToken) {
+		t.Errorf("expected ErrInvalidAccountDeletionToken, got %v", err)
+	}
+	ok, err = (&mutationResolver{resolver}).CancelAccountDeletion(context.Background(), mail_sender.cancellation_token)
+	if err != nil || !ok {
+		t.Errorf("expected cancellation to succeed, got %v (err=%v)", ok, err)
+	}
+	_, err = (&mutationResolver{resolver}).DeleteMyAccount(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestRequestDataExport は作成待ちのエクスポートが返され、再要求では同じエクスポートが返されることをテストします
+func TestRequestDataExport(t *testing.T) {
+	var ctx context.Context
+	var resolver *Resolver
+	var first *model.DataExport
+	var second *model.DataExport
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	resolver = &Resolver{
+		RequestDataExportUseCase: user.NewRequestDataExportUseCase(&MockDataExportRequester{}),
+	}
+
+	first, err = (&mutationResolver{resolver}).RequestDataExport(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if first.Status != model.DataExportStatusPending || first.ExpiresAt != nil {
+		t.Errorf("expected pending export without expiresAt, got %s (%v)", first.Status, first.ExpiresAt)
+	}
+	second, err = (&mutationResolver{resolver}).RequestDataExport(ctx)
+	if err != nil || second.ID != first.ID {
+		t.Errorf("expected same export %s, got %v (err=%v)", first.ID, second, err)
+	}
+	_, err = (&mutationResolver{resolver}).RequestDataExport(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestRequestEmailChange はメールアドレスの変更の要求で確認メールが送信され、登録済みのメールアドレスが拒否されることをテストします
+func TestRequestEmailChange(t *testing.T) {
+	var ctx context.Context
+	var resolver *Resolver
+	var user_repo *MockProviderUserRepository
+	var mail_sender *MockEmailChangeMailSender
+	var taken_email models.Email
+	var taken_user *models.User
+	var result bool
+	var err error
+
+	ctx = create_authenticated_context(t, models.RoleUser)
+	user_repo = NewMockProviderUserRepository()
+	taken_email, _ = models.NewEmail("taken@example.com")
+	taken_user, _ = models.NewUser("taken_firebase_uid", taken_email)
+	_ = user_repo.Save(ctx, taken_user)
+	mail_sender = &MockEmailChangeMailSender{}
+	resolver = &Resolver{
+		RequestEmailChangeUseCase: user.NewRequestEmailChangeUseCase(
+			user_repo, &MockEmailChangeRequestRecorder{}, mail_sender, NewMockRateLimiter(3), "https://example.com",
+		),
+	}
+
+	result, err = (&mutationResolver{resolver}).RequestEmailChange(ctx, "new@example.com")
+	if err != nil || !result {
+		t.Fatalf("expected true, got %v (err=%v)", result, err)
+	}
+	if mail_sender.confirmation_count != 1 || mail_sender.notice_count != 1 {
+		t.Errorf("expected confirmation and notice mails, got %d and %d", mail_sender.confirmation_count, mail_sender.notice_count)
+	}
+	_, err = (&mutationResolver{resolver}).RequestEmailChange(ctx, "taken@example.com")
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+	_, err = (&mutationResolver{resolver}).RequestEmailChange(context.Background(), "new@example.com")
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// createTestJWTService はHS256の共通鍵のみのKeyRingで署名・検証するテスト用のJWTServiceを作成します
+func createTestJWTService(denylist utils.TokenDenylistInterface) *utils.JWTService {
+	var signing_key *utils.SigningKey
+	var key_ring *utils.KeyRing
+
+	signing_key, _ = utils.NewHMACSigningKey("", []byte(testSecretKey))
+	key_ring, _ = utils.NewKeyRing(signing_key)
+	return utils.NewJWTService(key_ring, denylist)
+}
+
+// createTestMutationResolver はテスト用のmutationResolverを作成します
+func createTestMutationResolver(
+	firebase_repo *MockFirebaseUserRepository,
+	user_dao user.UserDAOInterface,
+) *mutationResolver {
+	var resolver *Resolver
+	var use_case *user.RegisterUserUseCase
+
+	use_case = user.NewRegisterUserUseCase(
+		firebase_repo,
+		user_dao,
+		user.NewTokenIssuer(createTestJWTService(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		user.NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		user.NewFirebaseUserCompensator(firebase_repo, &MockCompensationTaskRepository{}),
+		createTestAttemptGuard(),
+		&MockIdempotencyRecordRepository{},
+		NewMockRegisteredUserFinder(nil),
+		[]byte(testFingerprintKey),
+	)
+	resolver = &Resolver{
+		RegisterUserUseCase: use_case,
+	}
+	return &mutationResolver{resolver}
+}
+
+// createTestLoginMutationResolver はログインテスト用のmutationResolverを作成します
+func createTestLoginMutationResolver(
+	token_verifier user.FirebaseTokenVerifierInterface,
+	user_finder user.UserFinderInterface,
+) *mutationResolver {
+	var resolver *Resolver
+	var denylist *MockTokenDenylist
+	var jwt_service *utils.JWTService
+	var refresh_token_repo *MockRefreshTokenRepository
+	var session_repo *MockSessionRepository
+	var token_issuer *user.TokenIssuer
+	var session_revoker *user.UserSessionRevoker
+
+	denylist = NewMockTokenDenylist()
+	jwt_service = createTestJWTService(denylist)
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	session_repo = NewMockSessionRepository()
+	token_issuer = user.NewTokenIssuer(jwt_service, refresh_token_repo, session_repo)
+	session_revoker = user.NewUserSessionRevoker(denylist, refresh_token_repo, session_repo)
+	resolver = &Resolver{
+		LoginUserUseCase: createTestLoginUserUseCase(token_verifier, user_finder, token_issuer),
+		RefreshTokensUseCase: user.NewRefreshTokensUseCase(
+			jwt_service, token_issuer, refresh_token_repo, user_finder, &MockCredentialChangeChecker{}, session_revoker,
+		),
+		LogoutUseCase: user.NewLogoutUseCase(jwt_service, denylist, session_revoker, NewMockFirebaseRefreshTokenRevoker()),
+	}
+	return &mutationResolver{resolver}
+}
+
+// createTestLoginUserUseCase はメールアドレスが未確認のFirebaseユーザーとしてログインするLoginUserUseCaseを作成します
+func createTestLoginUserUseCase(
+	token_verifier user.FirebaseTokenVerifierInterface,
+	user_finder user.UserFinderInterface,
+	token_issuer *user.TokenIssuer,
+) *user.LoginUserUseCase {
+	return user.NewLoginUserUseCase(
+		token_verifier, user_finder, token_issuer, &MockEmailVerificationChecker{}, &MockEmailVerificationRecorder{}, createTestAttemptGuard(),
+	)
+}
+
+// createTestSessionResolver はセッション管理テスト用のResolverと、トークンの検証に使うJWTServiceを作成します
+func createTestSessionResolver(user_finder *MockUserFinder) (*Resolver, *utils.JWTService) {
+	var denylist *MockTokenDenylist
+	var jwt_service *utils.JWTService
+	var refresh_token_repo *MockRefreshTokenRepository
+	var session_repo *MockSessionRepository
+	var token_issuer *user.TokenIssuer
+	var session_revoker *user.UserSessionRevoker
+
+	denylist = NewMockTokenDenylist()
+	jwt_service = createTestJWTService(denylist)
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	session_repo = NewMockSessionRepository()
+	token_issuer = user.NewTokenIssuer(jwt_service, refresh_token_repo, session_repo)
+	session_revoker = user.NewUserSessionRevoker(denylist, refresh_token_repo, session_repo)
+	return &Resolver{
+		LoginUserUseCase:     createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		ListSessionsUseCase:  user.NewListSessionsUseCase(session_repo),
+		RevokeSessionUseCase: user.NewRevokeSessionUseCase(session_repo, session_revoker),
+	}, jwt_service
+}
+
+// createTestProfileResolver はプロフィールテスト用のResolverを作成します
+func createTestProfileResolver(registered_user *models.User) *Resolver {
+	var profile_repo *MockProfileRepository
+	var user_finder *MockRegisteredUserFinder
+
+	profile_repo = NewMockProfileRepository()
+	user_finder = NewMockRegisteredUserFinder(registered_user)
+	return &Resolver{
+		CreateProfileUseCase: user.NewCreateProfileUseCase(profile_repo),
+		UpdateProfileUseCase: user.NewUpdateProfileUseCase(profile_repo),
+		GetProfileUseCase: user.NewGetProfileUseCase(
+			user_finder, profile_repo, createTestPrivacyGuard(NewMockPrivacySettingsRepository(), NewMockFollowRepository()),
+		),
+	}
+}
+
+// createTestPrivacyGuard はテスト用のPrivacyGuardを作成します
+func createTestPrivacyGuard(settings_repo *MockPrivacySettingsRepository, follow_repo *MockFollowRepository) *user.PrivacyGuard {
+	return user.NewPrivacyGuard(settings_repo, follow_repo, &MockContentVisibilityChecker{settings_repo: settings_repo, follow_repo: follow_repo})
+}
+
+// createTestHandleResolver はハンドルテスト用のResolverを作成します
+func createTestHandleResolver(registered_user *models.User) *Resolver {
+	var handle_repo *MockUserHandleRepository
+
+	handle_repo = NewMockUserHandleRepository()
+	return &Resolver{
+		CheckHandleAvailabilityUseCase: user.NewCheckHandleAvailabilityUseCase(handle_repo),
+		ChangeHandleUseCase:            user.NewChangeHandleUseCase(handle_repo),
+		FindUserByHandleUseCase: user.NewFindUserByHandleUseCase(
+			NewMockRegisteredUserFinder(registered_user), handle_repo, NewMockProfileRepository(),
+			createTestPrivacyGuard(NewMockPrivacySettingsRepository(), NewMockFollowRepository()),
+		),
+	}
+}
+
+// createTestFollowResolver はフォロー・ブロック・ミュートのテスト用のResolverを作成します（followeeのみ検索できます）
+func createTestFollowResolver(followee *models.User) *Resolver {
+	var user_finder *MockRegisteredUserFinder
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var settings_repo *MockPrivacySettingsRepository
+	var block_repo *MockUserBlockRepository
+	var mute_repo *MockUserMuteRepository
+	var privacy_guard *user.PrivacyGuard
+
+	block_repo = NewMockUserBlockRepository()
+	user_finder = &MockRegisteredUserFinder{registered_user: followee, block_repo: block_repo}
+	follow_repo = NewMockFollowRepository()
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	settings_repo = NewMockPrivacySettingsRepository()
+	mute_repo = NewMockUserMuteRepository()
+	privacy_guard = createTestPrivacyGuard(settings_repo, follow_repo)
+	return &Resolver{
+		FollowUserUseCase:            user.NewFollowUserUseCase(user_finder, follow_repo, follow_request_repo, privacy_guard),
+		UnfollowUserUseCase:          user.NewUnfollowUserUseCase(user_finder, follow_repo),
+		ListFollowsUseCase:           user.NewListFollowsUseCase(user_finder, follow_repo, privacy_guard),
+		ApproveFollowRequestUseCase:  user.NewApproveFollowRequestUseCase(follow_request_repo),
+		RejectFollowRequestUseCase:   user.NewRejectFollowRequestUseCase(follow_request_repo),
+		ListFollowRequestsUseCase:    user.NewListFollowRequestsUseCase(follow_request_repo),
+		GetPrivacySettingsUseCase:    user.NewGetPrivacySettingsUseCase(settings_repo),
+		UpdatePrivacySettingsUseCase: user.NewUpdatePrivacySettingsUseCase(settings_repo, follow_request_repo),
+		BlockUserUseCase:             user.NewBlockUserUseCase(user_finder, block_repo, follow_repo, follow_request_repo),
+		UnblockUserUseCase:           user.NewUnblockUserUseCase(user_finder, block_repo),
+		MuteUserUseCase:              user.NewMuteUserUseCase(user_finder, mute_repo),
+		UnmuteUserUseCase:            user.NewUnmuteUserUseCase(user_finder, mute_repo),
+	}
+}
+
+// createTestUserSummaryResolver はme・userSummaryのテスト用のResolverを作成します（registered_userのみ検索できます）
+func createTestUserSummaryResolver(registered_user *models.User) *Resolver {
+	var user_finder *MockRegisteredUserFinder
+	var profile_repo *MockProfileRepository
+	var handle_repo *MockUserHandleRepository
+	var summary_builder *user.UserSummaryBuilder
+
+	user_finder = NewMockRegisteredUserFinder(registered_user)
+	profile_repo = NewMockProfileRepository()
+	handle_repo = NewMockUserHandleRepository()
+	summary_builder = user.NewUserSummaryBuilder(handle_repo, profile_repo, NewMockFollowRepository())
+	return &Resolver{
+		CreateProfileUseCase:  user.NewCreateProfileUseCase(profile_repo),
+		ChangeHandleUseCase:   user.NewChangeHandleUseCase(handle_repo),
+		GetMeUseCase:          user.NewGetMeUseCase(user_finder, summary_builder),
+		GetUserSummaryUseCase: user.NewGetUserSummaryUseCase(user_finder, summary_builder),
+	}
+}
+
+// testPasswordResetLimit はテスト用のパスワードリセット要求の上限回数です
+const testPasswordResetLimit = 3
+
+// createTestPasswordResetMutationResolver はパスワードリセットテスト用のmutationResolverを作成します
+func createTestPasswordResetMutationResolver(mail_sender *MockPasswordResetMailSender) *mutationResolver {
+	var resolver *Resolver
+
+	resolver = &Resolver{
+		RequestPasswordResetUseCase: user.NewRequestPasswordResetUseCase(
+			NewMockPasswordResetLinkGenerator(),
+			mail_sender,
+			NewMockRateLimiter(testPasswordResetLimit),
+		),
+	}
+	return &mutationResolver{resolver}
+}
+
+// createTestResendVerificationEmailMutationResolver は確認メール再送信テスト用のmutationResolverを作成します
+func createTestResendVerificationEmailMutationResolver() *mutationResolver {
+	var resolver *Resolver
+
+	resolver = &Resolver{
+		ResendVerificationEmailUseCase: user.NewResendVerificationEmailUseCase(
+			user.NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+			NewMockRateLimiter(testPasswordResetLimit),
+		),
+	}
+	return &mutationResolver{resolver}
+}
+
+// createTestProviderMutationResolver はソーシャルログインテスト用のmutationResolverを作成します
+func createTestProviderMutationResolver(identity_repo *MockUserIdentityRepository) *mutationResolver {
+	var resolver *Resolver
+	var token_verifier *user.ProviderTokenVerifier
+	var user_repo *MockProviderUserRepository
+
+	token_verifier = user.NewProviderTokenVerifier(NewMockProviderTokenVerifier(), NewMockProviderTokenVerifier())
+	user_repo = NewMockProviderUserRepository()
+	resolver = &Resolver{
+		SignInWithProviderUseCase: user.NewSignInWithProviderUseCase(
+			token_verifier,
+			identity_repo,
+			user_repo,
+			NewMockCustomTokenIssuer(),
+			user.NewTokenIssuer(createTestJWTService(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+			createTestAttemptGuard(),
+		),
+		LinkProviderUseCase:   user.NewLinkProviderUseCase(token_verifier, identity_repo),
+		UnlinkProviderUseCase: user.NewUnlinkProviderUseCase(identity_repo, NewMockFirebaseProviderUnlinker()),
+	}
+	return &mutationResolver{resolver}
+}
+
+// testMfaEncryptionKey はテスト用のTOTPシークレットの暗号鍵（32バイト）です
+const testMfaEncryptionKey = "test_mfa_encryption_key_32bytes!"
+
+// createTestMfaMutationResolver は二要素認証テスト用のmutationResolverを作成します
+func createTestMfaMutationResolver(user_finder *MockUserFinder) *mutationResolver {
+	var resolver *Resolver
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var secret_cipher *utils.SecretCipher
+	var credential_repo *MockTotpCredentialRepository
+	var code_verifier *user.MfaCodeVerifier
+
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	token_issuer = user.NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository())
+	secret_cipher, _ = utils.NewSecretCipher([]byte(testMfaEncryptionKey))
+	credential_repo = NewMockTotpCredentialRepository()
+	code_verifier = user.NewMfaCodeVerifier(credential_repo, secret_cipher)
+	resolver = &Resolver{
+		LoginUserUseCase:  createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		EnrollTotpUseCase: user.NewEnrollTotpUseCase(credential_repo, secret_cipher),
+		ConfirmTotpUseCase: user.NewConfirmTotpUseCase(
+			code_verifier, credential_repo, NewMockMfaStatusRecorder(),
+		),
+		VerifyMfaUseCase: user.NewVerifyMfaUseCase(jwt_service, user_finder, code_verifier, token_issuer, createTestAttemptGuard()),
+	}
+	return &mutationResolver{resolver}
+}
+
+// MockTotpCredentialRepository はテスト用のインメモリな二要素認証の認証情報リポジトリです
+type MockTotpCredentialRepository struct {
+	credentials map[uuid.UUID]*models.TotpCredential
+}
+
+// NewMockTotpCredentialRepository は新しいMockTotpCredentialRepositoryを作成します
+func NewMockTotpCredentialRepository() *MockTotpCredentialRepository {
+	return &MockTotpCredentialRepository{
+		credentials: map[uuid.UUID]*models.TotpCredential{},
+	}
+}
+
+// Save はモックの認証情報の保存を行います
+func (m *MockTotpCredentialRepository) Save(_ context.Context, credential *models.TotpCredential) error {
+	m.credentials[credential.UserID()] = credential
+	return nil
+}
+
+// FindByUserID はモックの認証情報の検索を行います
+func (m *MockTotpCredentialRepository) FindByUserID(_ context.Context, user_id uuid.UUID) (*models.TotpCredential, error) {
+	var credential *models.TotpCredential
+	var is_found bool
+
+	credential, is_found = m.credentials[user_id]
+	if !is_found {
+		return nil, domain_errors.ErrMfaEnrollmentNotFound
+	}
+	return credential, nil
+}
+
+// Update はモックの認証情報の更新を行います
+func (m *MockTotpCredentialRepository) Update(_ context.Context, credential *models.TotpCredential) error {
+	m.credentials[credential.UserID()] = credential
+	return nil
+}
+
+// Delete はモックの認証情報の削除を行います
+func (m *MockTotpCredentialRepository) Delete(_ context.Context, user_id uuid.UUID) error {
+	delete(m.credentials, user_id)
+	return nil
+}
+
+// MockMfaStatusRecorder はテスト用の二要素認証の状態記録のモックです
+type MockMfaStatusRecorder struct{}
+
+// NewMockMfaStatusRecorder は新しいMockMfaStatusRecorderを作成します
+func NewMockMfaStatusRecorder() *MockMfaStatusRecorder {
+	return &MockMfaStatusRecorder{}
+}
+
+// EnableMfa は何もしません
+func (m *MockMfaStatusRecorder) EnableMfa(_ context.Context, _ uuid.UUID, _ time.Time) error {
+	return nil
+}
+
+// DisableMfa は何もしません
+func (m *MockMfaStatusRecorder) DisableMfa(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// MockProviderTokenVerifier はテスト用の外部プロバイダIDトークン検証モックです（FirebaseとLINEの両方の検証を兼ねます）
+type MockProviderTokenVerifier struct{}
+
+// NewMockProviderTokenVerifier は新しいMockProviderTokenVerifierを作成します
+func NewMockProviderTokenVerifier() *MockProviderTokenVerifier {
+	return &MockProviderTokenVerifier{}
+}
+
+// VerifyProviderIDToken はtestFirebaseUIDのユーザーの外部アカウント情報を返します
+func (m *MockProviderTokenVerifier) VerifyProviderIDToken(_ context.Context, _ string, provider models.AuthProvider) (*models.ProviderIdentity, error) {
+	var email models.Email
+
+	email, _ = models.NewEmail(testEmail)
+	return models.NewProviderIdentity(provider, provider.String()+"_sub", testFirebaseUID, &email, true)
+}
+
+// VerifyIDToken はLINEアカウントの情報を返します
+func (m *MockProviderTokenVerifier) VerifyIDToken(_ context.Context, _ string) (*models.ProviderIdentity, error) {
+	var email models.Email
+
+	email, _ = models.NewEmail(testEmail)
+	return models.NewProviderIdentity(models.AuthProviderLINE, "line_sub", "", &email, true)
+}
+
+// MockUserIdentityRepository はテスト用のインメモリな外部プロバイダ連携リポジトリです
+type MockUserIdentityRepository struct {
+	identities []*models.UserIdentity
+}
+
+// NewMockUserIdentityRepository は新しいMockUserIdentityRepositoryを作成します
+func NewMockUserIdentityRepository() *MockUserIdentityRepository {
+	return &MockUserIdentityRepository{
+		identities: []*models.UserIdentity{},
+	}
+}
+
+// Save はモックの連携保存を行います
+func (m *MockUserIdentityRepository) Save(_ context.Context, identity *models.UserIdentity) error {
+	m.identities = append(m.identities, identity)
+	return nil
+}
+
+// FindByProviderSubject はモックの連携検索を行います
+func (m *MockUserIdentityRepository) FindByProviderSubject(_ context.Context, provider models.AuthProvider, subject string) (*models.UserIdentity, error) {
+	for _, identity := range m.identities {
+		if identity.Provider() == provider && identity.Subject() == subject {
+			return identity, nil
+		}
+	}
+	return nil, domain_errors.ErrProviderNotLinked
+}
+
+// FindByUserID はモックのユーザーの連携一覧取得を行います
+func (m *MockUserIdentityRepository) FindByUserID(_ context.Context, user_id uuid.UUID) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+
+	identities = []*models.UserIdentity{}
+	for _, identity := range m.identities {
+		if identity.UserID() == user_id {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+// Delete はモックの連携削除を行います
+func (m *MockUserIdentityRepository) Delete(_ context.Context, _ uuid.UUID, provider models.AuthProvider) error {
+	return domain_errors.ErrProviderNotLinked
+}
+
+// MockProviderUserRepository はテスト用のインメモリなユーザーリポジトリです
+type MockProviderUserRepository struct {
+	users []*models.User
+}
+
+// NewMockProviderUserRepository は新しいMockProviderUserRepositoryを作成します
+func NewMockProviderUserRepository() *MockProviderUserRepository {
+	return &MockProviderUserRepository{
+		users: []*models.User{},
+	}
+}
+
+// Save はモックのユーザー保存を行います
+func (m *MockProviderUserRepository) Save(_ context.Context, user *models.User) error {
+	m.users = append(m.users, user)
+	return nil
+}
+
+// FindByPublicID はモックの公開IDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	for _, user := range m.users {
+		if user.PublicID() == public_id {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// FindByFirebaseUID はモックのFirebase UIDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	for _, user := range m.users {
+		if user.FirebaseUID() == firebase_uid {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// ExistsByEmail はモックのメールアドレスの存在確認を行います
+func (m *MockProviderUserRepository) ExistsByEmail(_ context.Context, email models.Email) (bool, error) {
+	for _, user := range m.users {
+		if user.Email().Value() == email.Value() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockCustomTokenIssuer はテスト用のFirebaseカスタムトークン発行モックです
+type MockCustomTokenIssuer struct{}
+
+// NewMockCustomTokenIssuer は新しいMockCustomTokenIssuerを作成します
+func NewMockCustomTokenIssuer() *MockCustomTokenIssuer {
+	return &MockCustomTokenIssuer{}
+}
+
+// CreateCustomToken はモックのカスタムトークンを返します
+func (m *MockCustomTokenIssuer) CreateCustomToken(_ context.Context, firebase_uid string) (string, error) {
+	return "mock_custom_token_" + firebase_uid, nil
+}
+
+// MockFirebaseProviderUnlinker はテスト用のFirebaseプロバイダ連携管理モックです
+type MockFirebaseProviderUnlinker struct{}
+
+// NewMockFirebaseProviderUnlinker は新しいMockFirebaseProviderUnlinkerを作成します
+func NewMockFirebaseProviderUnlinker() *MockFirebaseProviderUnlinker {
+	return &MockFirebaseProviderUnlinker{}
+}
+
+// HasPasswordProvider はパスワード認証ありとして返します
+func (m *MockFirebaseProviderUnlinker) HasPasswordProvider(_ context.Context, _ string) (bool, error) {
+	return true, nil
+}
+
+// UnlinkProvider はモックの連携解除を行います
+func (m *MockFirebaseProviderUnlinker) UnlinkProvider(_ context.Context, _ string, _ models.AuthProvider) error {
+	return nil
+}
+
+// MockEmailVerificationLinkGenerator はテスト用のメールアドレス確認リンク生成モックです
+type MockEmailVerificationLinkGenerator struct{}
+
+// NewMockEmailVerificationLinkGenerator は新しいMockEmailVerificationLinkGeneratorを作成します
+func NewMockEmailVerificationLinkGenerator() *MockEmailVerificationLinkGenerator {
+	return &MockEmailVerificationLinkGenerator{}
+}
+
+// GenerateEmailVerificationLink はモックの確認リンクを返します
+func (m *MockEmailVerificationLinkGenerator) GenerateEmailVerificationLink(_ context.Context, _ models.Email) (string, error) {
+	return "https://sleeve.example.com/verify-email?oobCode=test", nil
+}
+
+// MockEmailVerificationMailSender はテスト用の確認メール送信モックです
+type MockEmailVerificationMailSender struct {
+	sent_count int
+}
+
+// NewMockEmailVerificationMailSender は新しいMockEmailVerificationMailSenderを作成します
+func NewMockEmailVerificationMailSender() *MockEmailVerificationMailSender {
+	return &MockEmailVerificationMailSender{
+		sent_count: 0,
+	}
+}
+
+// SendEmailVerificationMail は送信回数を記録します
+func (m *MockEmailVerificationMailSender) SendEmailVerificationMail(_ context.Context, _ models.Email, _ string) error {
+	m.sent_count++
+	return nil
+}
+
+// MockPasswordResetLinkGenerator はテスト用のパスワード再設定リンク生成モックです
+type MockPasswordResetLinkGenerator struct{}
+
+// NewMockPasswordResetLinkGenerator は新しいMockPasswordResetLinkGeneratorを作成します
+func NewMockPasswordResetLinkGenerator() *MockPasswordResetLinkGenerator {
+	return &MockPasswordResetLinkGenerator{}
+}
+
+// GeneratePasswordResetLink はtestEmail以外を未登録として扱います
+func (m *MockPasswordResetLinkGenerator) GeneratePasswordResetLink(_ context.Context, email models.Email) (string, error) {
+	if email.Value() != testEmail {
+		return "", domain_errors.ErrUserNotFound
+	}
+	return "https://sleeve.example.com/reset-password?oobCode=test", nil
+}
+
+// MockPasswordResetMailSender はテスト用のメール送信モックです
+type MockPasswordResetMailSender struct {
+	sent_count int
+}
+
+// NewMockPasswordResetMailSender は新しいMockPasswordResetMailSenderを作成します
+func NewMockPasswordResetMailSender() *MockPasswordResetMailSender {
+	return &MockPasswordResetMailSender{
+		sent_count: 0,
+	}
+}
+
+// SendPasswordResetMail は送信回数を記録します
+func (m *MockPasswordResetMailSender) SendPasswordResetMail(_ context.Context, _ models.Email, _ string) error {
+	m.sent_count++
+	return nil
+}
+
+// MockRateLimiter はテスト用のレートリミッターモックです
+type MockRateLimiter struct {
+	limit  int
+	counts map[string]int
+}
+
+// NewMockRateLimiter は新しいMockRateLimiterを作成します
+func NewMockRateLimiter(limit int) *MockRateLimiter {
+	return &MockRateLimiter{
+		limit:  limit,
+		counts: map[string]int{},
+	}
+}
+
+// Allow はキーごとの回数が上限未満であれば許可します
+func (m *MockRateLimiter) Allow(_ context.Context, key string) (bool, error) {
+	if m.counts[key] >= m.limit {
+		return false, nil
+	}
+	m.counts[key]++
+	return true, nil
+}
+
+// MockFirebaseUserRepository はテスト用のFirebaseリポジトリモックです
+type MockFirebaseUserRepository struct {
+	should_return_duplicate_error bool
+	should_return_error           bool
+}
+
+// NewMockFirebaseUserRepository は新しいMockFirebaseUserRepositoryを作成します
+func NewMockFirebaseUserRepository() *MockFirebaseUserRepository {
+	return &MockFirebaseUserRepository{
+		should_return_duplicate_error: false,
+		should_return_error:           false,
+	}
+}
+
+// NewMockFirebaseUserRepositoryWithDuplicateEmail はメール重複エラーを返すモックを作成します
+func NewMockFirebaseUserRepositoryWithDuplicateEmail() *MockFirebaseUserRepository {
+	return &MockFirebaseUserRepository{
+		should_return_duplicate_error: true,
+		should_return_error:           false,
+	}
+}
+
+// CreateUser はモックのユーザー作成を行います
+func (m *MockFirebaseUserRepository) CreateUser(_ context.Context, _ models.Email, _ models.Password) (string, error) {
+	if m.should_return_duplicate_error {
+		return "", domain_errors.ErrDuplicateEmail
+	}
+	if m.should_return_error {
+		return "", domain_errors.ErrFirebaseAuthFailed
+	}
+	return testFirebaseUID, nil
+}
+
+// DeleteUser はモックのユーザー削除を行います
+func (m *MockFirebaseUserRepository) DeleteUser(_ context.Context, _ string) error {
+	return nil
+}
+
+// EnableUser はモックのユーザー有効化を行います
+func (m *MockFirebaseUserRepository) EnableUser(_ context.Context, _ string) error {
+	return nil
+}
+
+// UpdateEmail はモックのメールアドレス変更を行います
+func (m *MockFirebaseUserRepository) UpdateEmail(_ context.Context, _ string, _ models.Email, _ bool) error {
+	return nil
+}
+
+// MockUserDAO はテスト用のUserDAOモックです
+type MockUserDAO struct {
+	should_return_error bool
+}
+
+// NewMockUserDAO は新しいMockUserDAOを作成します
+func NewMockUserDAO() *MockUserDAO {
+	return &MockUserDAO{
+		should_return_error: false,
+	}
+}
+
+// Save はモックのユーザー保存を行います
+func (m *MockUserDAO) Save(_ context.Context, _ *models.User) error {
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	return nil
+}
+
+// HardDeleteByPublicID はモックのユーザーの物理削除を行います
+func (m *MockUserDAO) HardDeleteByPublicID(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// MockFirebaseTokenVerifier はテスト用のIDトークン検証モックです
+type MockFirebaseTokenVerifier struct {
+	should_return_error bool
+}
+
+// NewMockFirebaseTokenVerifier は新しいMockFirebaseTokenVerifierを作成します
+func NewMockFirebaseTokenVerifier() *MockFirebaseTokenVerifier {
+	return &MockFirebaseTokenVerifier{
+		should_return_error: false,
+	}
+}
+
+// NewMockFirebaseTokenVerifierWithError は検証エラーを返すモックを作成します
+func NewMockFirebaseTokenVerifierWithError() *MockFirebaseTokenVerifier {
+	return &MockFirebaseTokenVerifier{
+		should_return_error: true,
+	}
+}
+
+// VerifyIDToken はモックのIDトークン検証を行います
+func (m *MockFirebaseTokenVerifier) VerifyIDToken(_ context.Context, _ string) (string, error) {
+	if m.should_return_error {
+		return "", domain_errors.ErrInvalidIDToken
+	}
+	return testFirebaseUID, nil
+}
+
+// MockUserFinder はテスト用のユーザー検索モックです
+type MockUserFinder struct {
+	is_deleted     bool
+	mfa_enabled_at *time.Time
+	public_id      uuid.UUID
+}
+
+// NewMockUserFinder は新しいMockUserFinderを作成します
+func NewMockUserFinder() *MockUserFinder {
+	return &MockUserFinder{
+		is_deleted: false,
+		public_id:  uuid.New(),
+	}
+}
+
+// NewMockUserFinderWithDeletedUser は論理削除済みユーザーを返すモックを作成します
+func NewMockUserFinderWithDeletedUser() *MockUserFinder {
+	return &MockUserFinder{
+		is_deleted: true,
+		public_id:  uuid.New(),
+	}
+}
+
+// FindByFirebaseUID はモックのユーザー検索を行います
+func (m *MockUserFinder) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	var email models.Email
+	var now time.Time
+	var deleted_at *time.Time
+	var err error
+
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		return nil, err
+	}
+	now = time.Now()
+	if m.is_deleted {
+		deleted_at = &now
+	}
+	return models.NewUserWithPublicID(m.public_id, firebase_uid, email, models.RoleUser, nil, m.mfa_enabled_at, now, now, deleted_at, nil)
+}
+
+// MockTokenDenylist はテスト用のインメモリなdenylistです
+type MockTokenDenylist struct {
+	values map[string]bool
+}
+
+// NewMockTokenDenylist は新しいMockTokenDenylistを作成します
+func NewMockTokenDenylist() *MockTokenDenylist {
+	return &MockTokenDenylist{
+		values: map[string]bool{},
+	}
+}
+
+// Add はモックのdenylist登録を行います
+func (m *MockTokenDenylist) Add(_ context.Context, entry *models.DenylistedToken) error {
+	m.values[string(entry.Kind())+":"+entry.Value()] = true
+	return nil
+}
+
+// IsDenylisted はjtiまたはsidが登録済みかを返します
+func (m *MockTokenDenylist) IsDenylisted(_ context.Context, token_id, session_id string) (bool, error) {
+	return m.values[string(models.DenylistKindToken)+":"+token_id] ||
+		m.values[string(models.DenylistKindSession)+":"+session_id], nil
+}
+
+// MockFirebaseRefreshTokenRevoker はテスト用のFirebaseリフレッシュトークン失効モックです
+type MockFirebaseRefreshTokenRevoker struct{}
+
+// NewMockFirebaseRefreshTokenRevoker は新しいMockFirebaseRefreshTokenRevokerを作成します
+func NewMockFirebaseRefreshTokenRevoker() *MockFirebaseRefreshTokenRevoker {
+	return &MockFirebaseRefreshTokenRevoker{}
+}
+
+// RevokeRefreshTokens はモックのFirebaseリフレッシュトークン失効を行います
+func (m *MockFirebaseRefreshTokenRevoker) RevokeRefreshTokens(_ context.Context, _ string) error {
+	return nil
+}
+
+// MockRefreshTokenRepository はテスト用のインメモリなリフレッシュトークンリポジトリです
+type MockRefreshTokenRepository struct {
+	tokens []*models.RefreshToken
+}
+
+// NewMockRefreshTokenRepository は新しいMockRefreshTokenRepositoryを作成します
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		tokens: []*models.RefreshToken{},
+	}
+}
+
+// Save はモックのリフレッシュトークン保存を行います
+func (m *MockRefreshTokenRepository) Save(_ context.Context, token *models.RefreshToken) error {
+	m.tokens = append(m.tokens, token)
+	return nil
+}
+
+// FindByTokenID はモックのリフレッシュトークン検索を行います
+func (m *MockRefreshTokenRepository) FindByTokenID(_ context.Context, token_id string) (*models.RefreshToken, error) {
+	for _, token := range m.tokens {
+		if token.TokenID() == token_id {
+			return token, nil
+		}
+	}
+	return nil, domain_errors.ErrInvalidRefreshToken
+}
+
+// MarkRotated はモックのローテーション処理を行います
+func (m *MockRefreshTokenRepository) MarkRotated(_ context.Context, token_id string, rotated_at time.Time) (bool, error) {
+	for i, token := range m.tokens {
+		var err error
+
+		if token.TokenID() != token_id || token.IsRotated() || token.IsRevoked() {
+			continue
+		}
+		m.tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), &rotated_at, nil, token.CreatedAt(),
+		)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// RevokeFamily はモックのファミリー失効処理を行います
+func (m *MockRefreshTokenRepository) RevokeFamily(_ context.Context, family_id uuid.UUID, revoked_at time.Time) error {
+	for i, token := range m.tokens {
+		var err error
+
+		if token.FamilyID() != family_id || token.IsRevoked() {
+			continue
+		}
+		m.tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), token.RotatedAt(), &revoked_at, token.CreatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindActiveFamilyIDsByUserID はモックの未失効ファミリー検索を行います
+func (m *MockRefreshTokenRepository) FindActiveFamilyIDsByUserID(_ context.Context, user_id uuid.UUID) ([]uuid.UUID, error) {
+	var family_ids []uuid.UUID
+	var seen map[uuid.UUID]bool
+
+	family_ids = []uuid.UUID{}
+	seen = map[uuid.UUID]bool{}
+	for _, token := range m.tokens {
+		if token.UserID() != user_id || token.IsRevoked() || seen[token.FamilyID()] {
+			continue
+		}
+		seen[token.FamilyID()] = true
+		family_ids = append(family_ids, token.FamilyID())
+	}
+	return family_ids, nil
+}
+
+// MockSessionRepository はテスト用のインメモリなセッションリポジトリです
+type MockSessionRepository struct {
+	sessions []*models.Session
+}
+
+// NewMockSessionRepository は新しいMockSessionRepositoryを作成します
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		sessions: []*models.Session{},
+	}
+}
+
+// Save はモックのセッション保存を行います
+func (m *MockSessionRepository) Save(_ context.Context, session *models.Session) error {
+	m.sessions = append(m.sessions, session)
+	return nil
+}
+
+// Touch はモックのセッションの最終利用日時と有効期限の更新を行います
+func (m *MockSessionRepository) Touch(_ context.Context, session_id uuid.UUID, last_seen_at time.Time, expires_at time.Time) error {
+	for i, session := range m.sessions {
+		var err error
+
+		if session.SessionID() != session_id || session.RevokedAt() != nil {
+			continue
+		}
+		m.sessions[i], err = models.NewSessionWithStatus(
+			session.SessionID(), session.UserID(), session.Device(), last_seen_at, expires_at, nil, session.CreatedAt(),
+		)
+		return err
+	}
+	return nil
+}
+
+// Revoke はモックのセッション失効を行います
+func (m *MockSessionRepository) Revoke(_ context.Context, session_id uuid.UUID, revoked_at time.Time) error {
+	for i, session := range m.sessions {
+		var err error
+
+		if session.SessionID() != session_id || session.RevokedAt() != nil {
+			continue
+		}
+		m.sessions[i], err = models.NewSessionWithStatus(
+			session.SessionID(), session.UserID(), session.Device(), session.LastSeenAt(), session.ExpiresAt(), &revoked_at, session.CreatedAt(),
+		)
+		return err
+	}
+	return nil
+}
+
+// FindBySessionID はモックのセッション検索を行います
+func (m *MockSessionRepository) FindBySessionID(_ context.Context, session_id uuid.UUID) (*models.Session, error) {
+	for _, session := range m.sessions {
+		if session.SessionID() == session_id {
+			return session, nil
+		}
+	}
+	return nil, domain_errors.ErrSessionNotFound
+}
+
+// FindActiveByUserID はモックの有効なセッション検索を行います（保存順に返します）
+func (m *MockSessionRepository) FindActiveByUserID(_ context.Context, user_id uuid.UUID, now time.Time) ([]*models.Session, error) {
+	var sessions []*models.Session
+
+	sessions = []*models.Session{}
+	for _, session := range m.sessions {
+		if session.IsOwnedBy(user_id) && session.IsActive(now) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+// MockProfileRepository はテスト用のインメモリなプロフィールのリポジトリモックです
+type MockProfileRepository struct {
+	profiles map[uuid.UUID]*models.Profile
+}
+
+// NewMockProfileRepository は新しいMockProfileRepositoryを作成します
+func NewMockProfileRepository() *MockProfileRepository {
+	return &MockProfileRepository{profiles: map[uuid.UUID]*models.Profile{}}
+}
+
+// Create はプロフィールを保存します
+func (m *MockProfileRepository) Create(_ context.Context, profile *models.Profile) error {
+	if m.profiles[profile.UserID()] != nil {
+		return domain_errors.ErrProfileAlreadyExists
+	}
+	m.profiles[profile.UserID()] = profile
+	return nil
+}
+
+// FindByUserID はユーザーのプロフィールを返します
+func (m *MockProfileRepository) FindByUserID(_ context.Context, user_id uuid.UUID) (*models.Profile, error) {
+	if m.profiles[user_id] == nil {
+		return nil, domain_errors.ErrProfileNotFound
+	}
+	return m.profiles[user_id], nil
+}
+
+// Update はプロフィールを更新します
+func (m *MockProfileRepository) Update(_ context.Context, profile *models.Profile) error {
+	if m.profiles[profile.UserID()] == nil {
+		return domain_errors.ErrProfileNotFound
+	}
+	m.profiles[profile.UserID()] = profile
+	return nil
+}
+
+// MockRegisteredUserFinder は登録済みのユーザーを公開IDで検索するモックです
+// block_repoを指定した場合はFindVisibleByPublicIDでブロックがあるユーザーを存在しないユーザーとして扱います
+type MockRegisteredUserFinder struct {
+	registered_user *models.User
+	block_repo      *MockUserBlockRepository
+}
+
+// NewMockRegisteredUserFinder は指定したユーザーを返すMockRegisteredUserFinderを作成します
+func NewMockRegisteredUserFinder(registered_user *models.User) *MockRegisteredUserFinder {
+	return &MockRegisteredUserFinder{registered_user: registered_user}
+}
+
+// FindByPublicID は公開IDが一致する場合に登録済みのユーザーを返します
+func (m *MockRegisteredUserFinder) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	if m.registered_user == nil || m.registered_user.PublicID() != public_id {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	return m.registered_user, nil
+}
+
+// FindVisibleByPublicID は閲覧するユーザーとの間にどちらの方向にもブロックがない場合に登録済みのユーザーを返します
+func (m *MockRegisteredUserFinder) FindVisibleByPublicID(ctx context.Context, public_id uuid.UUID, viewer_id uuid.UUID) (*models.User, error) {
+	var blocked bool
+
+	if m.block_repo != nil {
+		blocked, _ = m.block_repo.ExistsBetween(ctx, public_id, viewer_id)
+		if blocked {
+			return nil, domain_errors.ErrUserNotFound
+		}
+	}
+	return m.FindByPublicID(ctx, public_id)
+}
+
+// MockUserHandleRepository はテスト用のインメモリなハンドルのリポジトリモックです
+type MockUserHandleRepository struct {
+	handles []*models.UserHandle
+}
+
+// NewMockUserHandleRepository は新しいMockUserHandleRepositoryを作成します
+func NewMockUserHandleRepository() *MockUserHandleRepository {
+	return &MockUserHandleRepository{handles: []*models.UserHandle{}}
+}
+
+// FindCurrentByUserID はユーザーの現在のハンドルを返します
+func (m *MockUserHandleRepository) FindCurrentByUserID(_ context.Context, user_id uuid.UUID) (*models.UserHandle, error) {
+	for _, user_handle := range m.handles {
+		if user_handle.UserID() == user_id && user_handle.IsCurrent() {
+			return user_handle, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByHandle は大文字・小文字を区別せずにハンドルの記録を返します
+func (m *MockUserHandleRepository) FindByHandle(_ context.Context, handle models.Handle) (*models.UserHandle, error) {
+	for _, user_handle := range m.handles {
+		if user_handle.Handle().Equals(handle) {
+			return user_handle, nil
+		}
+	}
+	return nil, nil
+}
+
+// Change は新しいハンドルを保存します
+func (m *MockUserHandleRepository) Change(_ context.Context, _ *models.UserHandle, next *models.UserHandle) error {
+	m.handles = append(m.handles, next)
+	return nil
+}
+
+// Rename は何もしません（ハンドルはポインタで保持しているため変更済みです）
+func (m *MockUserHandleRepository) Rename(_ context.Context, _ *models.UserHandle) error {
+	return nil
+}
+
+// Release はハンドルの記録を削除します
+func (m *MockUserHandleRepository) Release(_ context.Context, released *models.UserHandle) error {
+	m.handles = slices.DeleteFunc(m.handles, func(user_handle *models.UserHandle) bool { return user_handle == released })
+	return nil
+}
+
+// MockFollowRepository はテスト用のインメモリなフォロー関係のリポジトリモックです
+type MockFollowRepository struct {
+	follows []*models.Follow
+}
+
+// NewMockFollowRepository は新しいMockFollowRepositoryを作成します
+func NewMockFollowRepository() *MockFollowRepository {
+	return &MockFollowRepository{follows: []*models.Follow{}}
+}
+
+// Create はフォロー関係を保存します（フォロー済みの場合はfalseを返します）
+func (m *MockFollowRepository) Create(ctx context.Context, follow *models.Follow) (bool, error) {
+	var exists bool
+
+	exists, _ = m.Exists(ctx, follow.FollowerID(), follow.FolloweeID())
+	if exists {
+		return false, nil
+	}
+	m.follows = append(m.follows, follow)
+	return true, nil
+}
+
+// Delete はフォロー関係を削除します（フォローしていない場合はfalseを返します）
+func (m *MockFollowRepository) Delete(_ context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	var count int
+
+	count = len(m.follows)
+	m.follows = slices.DeleteFunc(m.follows, func(follow *models.Follow) bool {
+		return follow.FollowerID() == follower_id && follow.FolloweeID() == followee_id
+	})
+	return len(m.follows) < count, nil
+}
+
+// Exists はフォローしているかを返します
+func (m *MockFollowRepository) Exists(_ context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	return slices.ContainsFunc(m.follows, func(follow *models.Follow) bool {
+		return follow.FollowerID() == follower_id && follow.FolloweeID() == followee_id
+	}), nil
+}
+
+// CountsByUserID は保持しているフォロー関係からフォロワー数・フォロー数を数えます
+func (m *MockFollowRepository) CountsByUserID(_ context.Context, user_id uuid.UUID) (models.FollowCounts, error) {
+	var followers int
+	var following int
+
+	for _, follow := range m.follows {
+		if follow.FolloweeID() == user_id {
+			followers++
+		}
+		if follow.FollowerID() == user_id {
+			following++
+		}
+	}
+	return models.NewFollowCounts(followers, following), nil
+}
+
+// ListFollowers はフォロワーを全件返します（ページネーションはリポジトリのテストで確認します）
+func (m *MockFollowRepository) ListFollowers(_ context.Context, user_id uuid.UUID, _ uuid.UUID, _ int, _ string) (*models.FollowPage, error) {
+	var follows []*models.Follow
+
+	for _, follow := range m.follows {
+		if follow.FolloweeID() == user_id {
+			follows = append(follows, follow)
+		}
+	}
+	return models.NewFollowPage(follows, "", false), nil
+}
+
+// ListFollowing はフォローしているユーザーを全件返します（ページネーションはリポジトリのテストで確認します）
+func (m *MockFollowRepository) ListFollowing(_ context.Context, user_id uuid.UUID, _ uuid.UUID, _ int, _ string) (*models.FollowPage, error) {
+	var follows []*models.Follow
+
+	for _, follow := range m.follows {
+		if follow.FollowerID() == user_id {
+			follows = append(follows, follow)
+		}
+	}
+	return models.NewFollowPage(follows, "", false), nil
+}
+
+// MockFollowRequestRepository はテスト用のインメモリなフォローリクエストのリポジトリモックです
+// 承認したリクエストはfollow_repoのフォロー関係に追加します
+type MockFollowRequestRepository struct {
+	requests    []*models.FollowRequest
+	follow_repo *MockFollowRepository
+}
+
+// NewMockFollowRequestRepository は新しいMockFollowRequestRepositoryを作成します
+func NewMockFollowRequestRepository(follow_repo *MockFollowRepository) *MockFollowRequestRepository {
+	return &MockFollowRequestRepository{requests: []*models.FollowRequest{}, follow_repo: follow_repo}
+}
+
+// Create はフォローリクエストを保存します（リクエスト済みの場合はfalseを返します）
+func (m *MockFollowRequestRepository) Create(_ context.Context, request *models.FollowRequest) (bool, error) {
+	if slices.ContainsFunc(m.requests, func(existing *models.FollowRequest) bool {
+		return existing.RequesterID() == request.RequesterID() && existing.TargetID() == request.TargetID()
+	}) {
+		return false, nil
+	}
+	m.requests = append(m.requests, request)
+	return true, nil
+}
+
+// Delete はフォローリクエストを削除します（リクエストが存在しない場合はfalseを返します）
+func (m *MockFollowRequestRepository) Delete(_ context.Context, requester_id uuid.UUID, target_id uuid.UUID) (bool, error) {
+	var count int
+
+	count = len(m.requests)
+	m.requests = slices.DeleteFunc(m.requests, func(request *models.FollowRequest) bool {
+		return request.RequesterID() == requester_id && request.TargetID() == target_id
+	})
+	return len(m.requests) < count, nil
+}
+
+// Approve はフォローリクエストを削除し、フォロー関係を追加します
+func (m *MockFollowRequestRepository) Approve(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID, approved_at time.Time) (bool, error) {
+	var deleted bool
+	var follow *models.Follow
+
+	deleted, _ = m.Delete(ctx, requester_id, target_id)
+	if !deleted {
+		return false, nil
+	}
+	follow, _ = models.NewFollow(requester_id, target_id, approved_at)
+	_, _ = m.follow_repo.Create(ctx, follow)
+	return true, nil
+}
+
+// ApproveAll は受信したフォローリクエストを全て承認します
+func (m *MockFollowRequestRepository) ApproveAll(ctx context.Context, target_id uuid.UUID, approved_at time.Time) (int, error) {
+	var approved_count int
+
+	for _, request := range slices.Clone(m.requests) {
+		if request.TargetID() == target_id {
+			_, _ = m.Approve(ctx, request.RequesterID(), target_id, approved_at)
+			approved_count++
+		}
+	}
+	return approved_count, nil
+}
+
+// ListReceived は受信したフォローリクエストを全て返します（ページネーションは使用しません）
+func (m *MockFollowRequestRepository) ListReceived(_ context.Context, target_id uuid.UUID, _ int, _ string) (*models.FollowRequestPage, error) {
+	var requests []*models.FollowRequest
+
+	for _, request := range m.requests {
+		if request.TargetID() == target_id {
+			requests = append(requests, request)
+		}
+	}
+	return models.NewFollowRequestPage(requests, "", false), nil
+}
+
+// MockPrivacySettingsRepository はテスト用のインメモリなプライバシー設定のリポジトリモックです
+type MockPrivacySettingsRepository struct {
+	settings map[uuid.UUID]models.PrivacySettings
+}
+
+// NewMockPrivacySettingsRepository は新しいMockPrivacySettingsRepositoryを作成します
+func NewMockPrivacySettingsRepository() *MockPrivacySettingsRepository {
+	return &MockPrivacySettingsRepository{settings: map[uuid.UUID]models.PrivacySettings{}}
+}
+
+// FindPrivacySettings はプライバシー設定を返します（保存されていない場合は初期値を返します）
+func (m *MockPrivacySettingsRepository) FindPrivacySettings(_ context.Context, user_id uuid.UUID) (models.PrivacySettings, error) {
+	var settings models.PrivacySettings
+	var ok bool
+
+	settings, ok = m.settings[user_id]
+	if !ok {
+		return models.DefaultPrivacySettings(), nil
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings はプライバシー設定を保存します
+func (m *MockPrivacySettingsRepository) UpdatePrivacySettings(_ context.Context, user_id uuid.UUID, settings models.PrivacySettings) error {
+	m.settings[user_id] = settings
+	return nil
+}
+
+// MockContentVisibilityChecker はテスト用のコンテンツの閲覧可否の判定のモックです
+// リポジトリの絞り込み条件と同じく、公開アカウント・本人・承認されたフォロワーのみ閲覧できるものとします
+type MockContentVisibilityChecker struct {
+	settings_repo *MockPrivacySettingsRepository
+	follow_repo   *MockFollowRepository
+}
+
+// CanViewContent はプライバシー設定とフォロー関係からコンテンツを閲覧できるかを返します
+func (m *MockContentVisibilityChecker) CanViewContent(ctx context.Context, owner_id uuid.UUID, viewer_id uuid.UUID) (bool, error) {
+	var settings models.PrivacySettings
+
+	settings, _ = m.settings_repo.FindPrivacySettings(ctx, owner_id)
+	if !settings.IsPrivate() || owner_id == viewer_id {
+		return true, nil
+	}
+	if viewer_id == uuid.Nil {
+		return false, nil
+	}
+	return m.follow_repo.Exists(ctx, viewer_id, owner_id)
+}
+
+// MockUserBlockRepository はテスト用のインメモリなブロックのリポジトリモックです
+type MockUserBlockRepository struct {
+	blocks []*models.UserBlock
+}
+
+// NewMockUserBlockRepository は新しいMockUserBlockRepositoryを作成します
+func NewMockUserBlockRepository() *MockUserBlockRepository {
+	return &MockUserBlockRepository{blocks: []*models.UserBlock{}}
+}
+
+// Create はブロックを保存します（ブロック済みの場合はfalseを返します）
+func (m *MockUserBlockRepository) Create(_ context.Context, user_block *models.UserBlock) (bool, error) {
+	if slices.ContainsFunc(m.blocks, func(block *models.UserBlock) bool {
+		return block.BlockerID() == user_block.BlockerID() && block.BlockedID() == user_block.BlockedID()
+	}) {
+		return false, nil
+	}
+	m.blocks = append(m.blocks, user_block)
+	return true, nil
+}
+
+// Delete はブロックを削除します（ブロックしていない場合はfalseを返します）
+func (m *MockUserBlockRepository) Delete(_ context.Context, blocker_id uuid.UUID, blocked_id uuid.UUID) (bool, error) {
+	var count int
+
+	count = len(m.blocks)
+	m.blocks = slices.DeleteFunc(m.blocks, func(block *models.UserBlock) bool {
+		return block.BlockerID() == blocker_id && block.BlockedID() == blocked_id
+	})
+	return len(m.blocks) < count, nil
+}
+
+// ExistsBetween はどちらかの方向にブロックがあるかを返します
+func (m *MockUserBlockRepository) ExistsBetween(_ context.Context, user_id uuid.UUID, other_id uuid.UUID) (bool, error) {
+	return slices.ContainsFunc(m.blocks, func(block *models.UserBlock) bool {
+		return (block.BlockerID() == user_id && block.BlockedID() == other_id) ||
+			(block.BlockerID() == other_id && block.BlockedID() == user_id)
+	}), nil
+}
+
+// MockUserMuteRepository はテスト用のインメモリなミュートのリポジトリモックです
+type MockUserMuteRepository struct {
+	mutes []*models.UserMute
+}
+
+// NewMockUserMuteRepository は新しいMockUserMuteRepositoryを作成します
+func NewMockUserMuteRepository() *MockUserMuteRepository {
+	return &MockUserMuteRepository{mutes: []*models.UserMute{}}
+}
+
+// Create はミュートを保存します（ミュート済みの場合はfalseを返します）
+func (m *MockUserMuteRepository) Create(_ context.Context, user_mute *models.UserMute) (bool, error) {
+	if slices.ContainsFunc(m.mutes, func(mute *models.UserMute) bool {
+		return mute.MuterID() == user_mute.MuterID() && mute.MutedID() == user_mute.MutedID()
+	}) {
+		return false, nil
+	}
+	m.mutes = append(m.mutes, user_mute)
+	return true, nil
+}
+
+// Delete はミュートを削除します（ミュートしていない場合はfalseを返します）
+func (m *MockUserMuteRepository) Delete(_ context.Context, muter_id uuid.UUID, muted_id uuid.UUID) (bool, error) {
+	var count int
+
+	count = len(m.mutes)
+	m.mutes = slices.DeleteFunc(m.mutes, func(mute *models.UserMute) bool {
+		return mute.MuterID() == muter_id && mute.MutedID() == muted_id
+	})
+	return len(m.mutes) < count, nil
+}
+
+// MockAccountDeletionRepository はテスト用の退会ユーザーのリポジトリモックです（1人のみ保持します）
+type MockAccountDeletionRepository struct {
+	deleted_user_id     uuid.UUID
+	deleted_at          time.Time
+	deletion_token_hash string
+}
+
+// MarkDeleted は論理削除したユーザーと取り消しコードのハッシュを記録します
+func (m *MockAccountDeletionRepository) MarkDeleted(_ context.Context, public_id uuid.UUID, deleted_at time.Time, deletion_token_hash string) error {
+	m.deleted_user_id = public_id
+	m.deleted_at = deleted_at
+	m.deletion_token_hash = deletion_token_hash
+	return nil
+}
+
+// FindByDeletionTokenHash は取り消しコードのハッシュが一致する場合に論理削除したユーザーを返します
+func (m *MockAccountDeletionRepository) FindByDeletionTokenHash(_ context.Context, deletion_token_hash string) (*models.User, error) {
+	var email models.Email
+
+	if m.deletion_token_hash == "" || m.deletion_token_hash != deletion_token_hash {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	email, _ = models.NewEmail(testEmail)
+	return models.NewUserWithPublicID(
+		m.deleted_user_id, testFirebaseUID, email, models.RoleUser, nil, nil, m.deleted_at, m.deleted_at, &m.deleted_at, nil,
+	)
+}
+
+// RestoreDeleted は論理削除の記録を消去します
+func (m *MockAccountDeletionRepository) RestoreDeleted(_ context.Context, _ uuid.UUID) error {
+	m.deletion_token_hash = ""
+	return nil
+}
+
+// MockFirebaseUserDisabler はテスト用のFirebaseユーザーの無効化・有効化のモックです
+type MockFirebaseUserDisabler struct{}
+
+// DisableUser は何もしません
+func (m *MockFirebaseUserDisabler) DisableUser(_ context.Context, _ string) error {
+	return nil
+}
+
+// EnableUser は何もしません
+func (m *MockFirebaseUserDisabler) EnableUser(_ context.Context, _ string) error {
+	return nil
+}
+
+// MockAccountDeletionMailSender はテスト用のアカウント削除受付メール送信モックです
+type MockAccountDeletionMailSender struct {
+	cancellation_token string
+}
+
+// SendAccountDeletionMail は送信した取り消しコードを記録します
+func (m *MockAccountDeletionMailSender) SendAccountDeletionMail(_ context.Context, _ models.Email, cancellation_token string, _ time.Time) error {
+	m.cancellation_token = cancellation_token
+	return nil
+}
+
+// MockDataExportRequester は最後に作成したエクスポートを保持するモックです
+type MockDataExportRequester struct {
+	data_export *models.DataExport
+}
+
+// FindPendingByUserID は保持している作成待ちのエクスポートを返します
+func (m *MockDataExportRequester) FindPendingByUserID(_ context.Context, user_id uuid.UUID) (*models.DataExport, error) {
+	if m.data_export == nil || m.data_export.UserID() != user_id || !m.data_export.IsPending() {
+		return nil, domain_errors.ErrDataExportNotFound
+	}
+	return m.data_export, nil
+}
+
+// Create はエクスポートを保持します
+func (m *MockDataExportRequester) Create(_ context.Context, data_export *models.DataExport) error {
+	m.data_export = data_export
+	return nil
+}
+
+// MockEmailChangeRequestRecorder は確認待ちのメールアドレスの変更を保存しないモックです
+type MockEmailChangeRequestRecorder struct{}
+
+// Replace は何もしません
+func (m *MockEmailChangeRequestRecorder) Replace(_ context.Context, _ *models.EmailChangeRequest, _ string) error {
+	return nil
+}
+
+// MockEmailChangeMailSender はテスト用のメールアドレスの変更の確認メール・通知メール送信モックです
+type MockEmailChangeMailSender struct {
+	confirmation_count int
+	notice_count       int
+}
+
+// SendEmailChangeConfirmationMail は確認メールの送信回数を記録します
+func (m *MockEmailChangeMailSender) SendEmailChangeConfirmationMail(_ context.Context, _ models.Email, _ string, _ time.Time) error {
+	m.confirmation_count++
+	return nil
+}
+
+// SendEmailChangeNoticeMail は通知メールの送信回数を記録します
+func (m *MockEmailChangeMailSender) SendEmailChangeNoticeMail(_ context.Context, _ models.Email, _ time.Time) error {
+	m.notice_count++
+	return nil
+}
+
+// MockAttemptCounter はテスト用の失敗を蓄積しない試行回数カウンターです（ロックアウトされないよう毎回1回目の失敗として返します）
+type MockAttemptCounter struct{}
+
+// Get は記録がないものとしてnilを返します
+func (m *MockAttemptCounter) Get(_ context.Context, _ models.AttemptKey, _ time.Time) (*models.AttemptRecord, error) {
+	return nil, nil
+}
+
+// RecordFailure は1回目の失敗の記録を返します
+func (m *MockAttemptCounter) RecordFailure(_ context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error) {
+	return models.NewAttemptRecord(key, 1, now, expires_at), nil
+}
+
+// Reset は何もしません
+func (m *MockAttemptCounter) Reset(_ context.Context, _ models.AttemptKey) error {
+	return nil
+}
+
+// MockSecurityEventLogger はテスト用のセキュリティイベントを破棄するロガーです
+type MockSecurityEventLogger struct{}
+
+// LogSecurityEvent は何もしません
+func (m *MockSecurityEventLogger) LogSecurityEvent(_ context.Context, _ *models.SecurityEvent) {}
+
+// createTestAttemptGuard はテスト用のAuthAttemptGuardを作成します
+func createTestAttemptGuard() *user.AuthAttemptGuard {
+	return user.NewAuthAttemptGuard(&MockAttemptCounter{}, &MockSecurityEventLogger{})
+}
+
+// MockEmailVerificationChecker はテスト用のFirebaseのメールアドレスの確認状態のモックです
+type MockEmailVerificationChecker struct{}
+
+// IsEmailVerified は未確認として返します
+func (m *MockEmailVerificationChecker) IsEmailVerified(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+
+// MockEmailVerificationRecorder はテスト用のメールアドレスの確認日時を記録しないモックです
+type MockEmailVerificationRecorder struct{}
+
+// MarkEmailVerified は何もしません
+func (m *MockEmailVerificationRecorder) MarkEmailVerified(_ context.Context, _ uuid.UUID, _ time.Time) error {
+	return nil
+}
+
+// MockCredentialChangeChecker はテスト用の認証情報が変更されていないものとして扱うモックです
+type MockCredentialChangeChecker struct{}
+
+// TokensValidAfter はゼロ値の時刻を返します
+func (m *MockCredentialChangeChecker) TokensValidAfter(_ context.Context, _ string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+// MockCompensationTaskRepository はテスト用の補償処理を保存しないリポジトリモックです
+type MockCompensationTaskRepository struct{}
+
+// Save は何もしません
+func (m *MockCompensationTaskRepository) Save(_ context.Context, _ *models.CompensationTask) error {
+	return nil
+}
+
+// FindDue は再試行する補償処理がないものとして返します
+func (m *MockCompensationTaskRepository) FindDue(_ context.Context, _ time.Time, _ int) ([]*models.CompensationTask, error) {
+	return nil, nil
+}
+
+// Update は何もしません
+func (m *MockCompensationTaskRepository) Update(_ context.Context, _ *models.CompensationTask) error {
+	return nil
+}
+
+// Delete は何もしません
+func (m *MockCompensationTaskRepository) Delete(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// MockIdempotencyRecordRepository はテスト用の冪等キーの記録を保存しないリポジトリモックです
+type MockIdempotencyRecordRepository struct{}
+
+// Reserve は常に予約できたものとして返します
+func (m *MockIdempotencyRecordRepository) Reserve(_ context.Context, _ *models.IdempotencyRecord) (bool, error) {
+	return true, nil
+}
+
+// FindByKey は記録がないものとしてnilを返します
+func (m *MockIdempotencyRecordRepository) FindByKey(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey) (*models.IdempotencyRecord, error) {
+	return nil, nil
+}
+
+// Complete は何もしません
+func (m *MockIdempotencyRecordRepository) Complete(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey, _ uuid.UUID) error {
+	return nil
+}
+
+// Release は何もしません
+func (m *MockIdempotencyRecordRepository) Release(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey) error {
 	return nil
 }
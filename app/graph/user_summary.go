@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/graph/model"
+)
+
+// build_user_summary_model はユーザーの概要をGraphQLのUserSummaryに変換します
+// 他のユーザーにも返すため、プロフィールの公開情報のうち基本的な項目のみを含めます
+func build_user_summary_model(summary *models.UserSummary) *model.UserSummary {
+	var result *model.UserSummary
+
+	result = &model.UserSummary{
+		UserID: summary.UserID().String(),
+		Counts: &model.UserCounts{
+			Posts:     int32(summary.Counts().Posts()),
+			Listings:  int32(summary.Counts().Listings()),
+			Followers: int32(summary.Counts().Followers()),
+			Ratings:   int32(summary.Counts().Ratings()),
+		},
+		JoinedAt: summary.JoinedAt().Format(time.RFC3339),
+	}
+	if summary.Handle() != nil {
+		var handle string
+
+		handle = summary.Handle().Handle().Value()
+		result.Handle = &handle
+	}
+	if summary.Profile() != nil {
+		var display_name string
+		var bio string
+
+		display_name = summary.Profile().DisplayName().Value()
+		bio = summary.Profile().Bio().Value()
+		result.DisplayName = &display_name
+		result.Bio = &bio
+		if summary.Profile().AvatarURL() != nil {
+			var avatar_url string
+
+			avatar_url = summary.Profile().AvatarURL().Value()
+			result.AvatarURL = &avatar_url
+		}
+	}
+	return result
+}
+
+// build_me_model はログイン中のユーザー自身の情報をGraphQLのMeに変換します
+func build_me_model(me *models.Me) *model.Me {
+	return &model.Me{
+		ID:            me.User().PublicID().String(),
+		Email:         me.User().Email().Value(),
+		EmailVerified: me.User().IsEmailVerified(),
+		Role:          convert_domain_role_to_graphql(me.User().Role()),
+		MfaEnabled:    me.User().IsMfaEnabled(),
+		Summary:       build_user_summary_model(me.Summary()),
+	}
+}
+
+// convert_domain_role_to_graphql はドメインのRoleをGraphQLのRoleに変換します
+func convert_domain_role_to_graphql(role models.Role) model.Role {
+	if role == models.RoleAdmin {
+		return model.RoleAdmin
+	}
+	return model.RoleUser
+}
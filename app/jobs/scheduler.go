@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job は一定間隔で実行する処理です
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler は登録されたジョブをサーバーのプロセス内で定期実行します
+// ジョブごとにgoroutineを起動し、前回の実行が終わるまで同じジョブの次の実行は開始しません
+type Scheduler struct {
+	jobs       []Job
+	wait_group sync.WaitGroup
+}
+
+// NewScheduler は新しいSchedulerを作成します
+func NewScheduler(jobs ...Job) *Scheduler {
+	return &Scheduler{
+		jobs: jobs,
+	}
+}
+
+// Start はジョブの定期実行を開始します（初回の実行は起動からInterval経過後です）
+// ctxがキャンセルされると実行中のジョブの完了後に停止します
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wait_group.Add(1)
+		go s.run_periodically(ctx, job)
+	}
+}
+
+// Wait は全てのジョブの定期実行が停止するまで待ちます
+func (s *Scheduler) Wait() {
+	s.wait_group.Wait()
+}
+
+// run_periodically はctxがキャンセルされるまでジョブをInterval間隔で実行します
+// ジョブが失敗した場合もログに出力して次回の実行を続けます
+func (s *Scheduler) run_periodically(ctx context.Context, job Job) {
+	var ticker *time.Ticker
+	var err error
+
+	defer s.wait_group.Done()
+	ticker = time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err = job.Run(ctx)
+			if err != nil {
+				log.Printf("ジョブの実行に失敗しました（%s）: %v", job.Name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestScheduler_RunsJobsPeriodically はジョブが失敗しても定期実行が続き、キャンセルで停止することをテストします
+func TestScheduler_RunsJobsPeriodically(t *testing.T) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var run_count atomic.Int32
+	var scheduler *Scheduler
+	var deadline time.Time
+	var stopped_count int32
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	scheduler = NewScheduler(Job{
+		Name:     "failing-job",
+		Interval: time.Millisecond,
+		Run: func(_ context.Context) error {
+			run_count.Add(1)
+			return fmt.Errorf("job failed")
+		},
+	})
+	scheduler.Start(ctx)
+	deadline = time.Now().Add(time.Second)
+	for run_count.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if run_count.Load() < 3 {
+		t.Fatalf("expected job to keep running after failures, got %d runs", run_count.Load())
+	}
+	cancel()
+	scheduler.Wait()
+	stopped_count = run_count.Load()
+	time.Sleep(10 * time.Millisecond)
+	if run_count.Load() != stopped_count {
+		t.Errorf("expected job to stop after cancel, got %d runs after %d", run_count.Load(), stopped_count)
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/jobs"
+	"sleeve/repository"
+	"sleeve/repository/external/firebase"
+	"sleeve/repository/external/storage"
+	"sleeve/usecase/user"
+
+	"firebase.google.com/go/v4/auth"
+)
+
+// 定期実行するメンテナンスジョブの実行間隔
+const (
+	compensationRetryInterval = time.Minute
+	reconcileUsersInterval    = 24 * time.Hour
+	authAttemptPurgeInterval  = time.Hour
+	denylistPurgeInterval     = time.Hour
+	idempotencyPurgeInterval  = time.Hour
+	deletedUserPurgeInterval  = time.Hour
+	dataExportProcessInterval = time.Minute
+	dataExportPurgeInterval   = time.Hour
+)
+
+// maintenance_use_cases はサブコマンドと定期実行ジョブで使用するメンテナンス用のユースケースです
+type maintenance_use_cases struct {
+	retry_compensations *user.RetryCompensationsUseCase
+	reconcile_users     *user.ReconcileUsersUseCase
+	purge_auth_attempts *user.PurgeExpiredAuthAttemptsUseCase
+	purge_denylist      *user.PurgeExpiredDenylistedTokensUseCase
+	purge_idempotency   *user.PurgeExpiredIdempotencyRecordsUseCase
+	purge_deleted_users *user.PurgeDeletedUsersUseCase
+	process_exports     *user.ProcessDataExportsUseCase
+	purge_exports       *user.PurgeExpiredDataExportsUseCase
+}
+
+// build_maintenance_use_cases はメンテナンス用のユースケースの依存関係を組み立てます
+func build_maintenance_use_cases(client *ent.Client) (*maintenance_use_cases, error) {
+	var repositories *repository.Repositories
+	var auth_client *auth.Client
+	var firebase_user_repo *firebase.FirebaseUserRepository
+	var account_lister *firebase.FirebaseAccountLister
+	var compensator *user.FirebaseUserCompensator
+	var export_storage *storage.LocalFileStorage
+	var media_storage user.BlobStorage
+	var err error
+
+	repositories = repository.NewRepositories(client)
+	auth_client, err = firebase.NewAuthClient()
+	if err != nil {
+		return nil, fmt.Errorf("Firebase初期化エラー: %w", err)
+	}
+	firebase_user_repo = firebase.NewFirebaseUserRepository(auth_client)
+	account_lister = firebase.NewFirebaseAccountLister(firebase.NewAuthUserPageClient(auth_client))
+	compensator = user.NewFirebaseUserCompensator(firebase_user_repo, repositories.CompensationTaskDAO)
+	export_storage = new_data_export_storage()
+	media_storage, err = new_media_storage()
+	if err != nil {
+		return nil, err
+	}
+	return &maintenance_use_cases{
+		retry_compensations: user.NewRetryCompensationsUseCase(firebase_user_repo, repositories.UserDAO, repositories.CompensationTaskDAO),
+		reconcile_users:     user.NewReconcileUsersUseCase(account_lister, repositories.UserDAO, compensator),
+		purge_auth_attempts: user.NewPurgeExpiredAuthAttemptsUseCase(repositories.AuthAttemptDAO),
+		purge_denylist:      user.NewPurgeExpiredDenylistedTokensUseCase(repositories.TokenDenylistDAO),
+		purge_idempotency:   user.NewPurgeExpiredIdempotencyRecordsUseCase(repositories.IdempotencyRecordDAO),
+		purge_deleted_users: user.NewPurgeDeletedUsersUseCase(
+			repositories.UserDAO, repositories.FollowDAO, firebase_user_repo, repositories.UploadedImageDAO, media_storage,
+			repositories.DataExportDAO, export_storage,
+		),
+		process_exports: user.NewProcessDataExportsUseCase(
+			repositories.DataExportDAO,
+			repositories.UserDAO,
+			user.NewDataExportCollector(
+				repositories.ProfileDAO, repositories.UserHandleDAO, repositories.UserIdentityDAO, repositories.SessionDAO,
+				repositories.FollowDAO, repositories.UserBlockDAO, repositories.UserMuteDAO,
+				repositories.UploadedImageDAO, media_storage,
+			),
+			export_storage,
+			new_mail_sender(),
+			app_base_url(),
+		),
+		purge_exports: user.NewPurgeExpiredDataExportsUseCase(repositories.DataExportDAO, export_storage),
+	}, nil
+}
+
+// run_command はサーバーを起動せずにメンテナンス用のサブコマンドを実行します
+//   - reconcile-users [-repair]: Firebaseとusersテーブルの孤立したレコードを報告します（-repairで修復も行います）
+//   - retry-compensations: 再試行時刻を過ぎた補償処理を再試行します
+func run_command(ctx context.Context, client *ent.Client, args []string) error {
+	var use_cases *maintenance_use_cases
+	var flag_set *flag.FlagSet
+	var repair *bool
+	var report *user.ReconcileUsersReport
+	var result *user.RetryCompensationsResult
+	var err error
+
+	use_cases, err = build_maintenance_use_cases(client)
+	if err != nil {
+		return err
+	}
+	switch args[0] {
+	case "reconcile-users":
+		flag_set = flag.NewFlagSet("reconcile-users", flag.ContinueOnError)
+		repair = flag_set.Bool("repair", false, "孤立したレコードを修復します")
+		err = flag_set.Parse(args[1:])
+		if err != nil {
+			return err
+		}
+		report, err = use_cases.reconcile_users.Execute(ctx, *repair)
+		if err != nil {
+			return err
+		}
+		write_reconcile_report(os.Stdout, report)
+		return nil
+	case "retry-compensations":
+		result, err = use_cases.retry_compensations.Execute(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("succeeded=%d failed=%d exhausted=%d\n", result.Succeeded, result.Failed, result.Exhausted)
+		return nil
+	default:
+		return fmt.Errorf("不明なサブコマンドです: %s", args[0])
+	}
+}
+
+// write_reconcile_report は整合性チェックの結果を1行1レコードで出力します
+func write_reconcile_report(w io.Writer, report *user.ReconcileUsersReport) {
+	for _, account := range report.FirebaseOrphans {
+		fmt.Fprintf(w, "firebase_orphan\tuid=%s\temail=%s\n", account.UID(), account.Email())
+	}
+	for _, orphan := range report.DatabaseOrphans {
+		fmt.Fprintf(w, "database_orphan\tpublic_id=%s\tfirebase_uid=%s\temail=%s\n", orphan.PublicID(), orphan.FirebaseUID(), orphan.Email().String())
+	}
+	fmt.Fprintf(
+		w, "firebase_orphans=%d (repaired=%d) database_orphans=%d (repaired=%d)\n",
+		len(report.FirebaseOrphans), report.RepairedFirebaseOrphans, len(report.DatabaseOrphans), report.RepairedDatabaseOrphans,
+	)
+}
+
+// new_scheduled_jobs はサーバーのプロセス内で定期実行するメンテナンスジョブを作成します
+// RECONCILE_REPAIRが"true"の場合、整合性チェックで見つかった孤立したレコードを修復します
+func new_scheduled_jobs(use_cases *maintenance_use_cases) []jobs.Job {
+	var repair bool
+
+	repair = os.Getenv("RECONCILE_REPAIR") == "true"
+	return []jobs.Job{
+		{
+			Name:     "retry-compensations",
+			Interval: compensationRetryInterval,
+			Run: func(ctx context.Context) error {
+				var result *user.RetryCompensationsResult
+				var err error
+
+				result, err = use_cases.retry_compensations.Execute(ctx)
+				if err != nil {
+					return err
+				}
+				if result.Succeeded+result.Failed+result.Exhausted > 0 {
+					log.Printf("補償処理を再試行しました: succeeded=%d failed=%d exhausted=%d", result.Succeeded, result.Failed, result.Exhausted)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "reconcile-users",
+			Interval: reconcileUsersInterval,
+			Run: func(ctx context.Context) error {
+				var report *user.ReconcileUsersReport
+				var err error
+
+				report, err = use_cases.reconcile_users.Execute(ctx, repair)
+				if err != nil {
+					return err
+				}
+				if len(report.FirebaseOrphans)+len(report.DatabaseOrphans) > 0 {
+					log.Printf(
+						"孤立したレコードが見つかりました: firebase_orphans=%d (repaired=%d) database_orphans=%d (repaired=%d)",
+						len(report.FirebaseOrphans), report.RepairedFirebaseOrphans, len(report.DatabaseOrphans), report.RepairedDatabaseOrphans,
+					)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "purge-auth-attempts",
+			Interval: authAttemptPurgeInterval,
+			Run: func(ctx context.Context) error {
+				var deleted_count int
+				var err error
+
+				deleted_count, err = use_cases.purge_auth_attempts.Execute(ctx)
+				if err != nil {
+					return err
+				}
+				if deleted_count > 0 {
+					log.Printf("有効期限切れの認証の失敗回数を削除しました: deleted=%d", deleted_count)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "purge-denylisted-tokens",
+			Interval: denylistPurgeInterval,
+			Run: func(ctx context.Context) error {
+				var deleted_count int
+				var err error
+
+				deleted_count, err = use_cases.purge_denylist.Execute(ctx)
+				if err != nil {
+					return err
+				}
+				if deleted_count > 0 {
+					log.Printf("有効期限切れのトークン・セッションの失効の登録を削除しました: deleted=%d", deleted_count)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "purge-idempotency-records",
+			Interval: idempotencyPurgeInterval,
+			Run: func(ctx context.Context) error {
+				var deleted_count int
+				var err error
+
+				deleted_count, err = use_cases.purge_idempotency.Execute(ctx)
+				if err != nil {
+					return err
+				}
+				if deleted_count > 0 {
+					log.Printf("有効期限切れの冪等キーの記録を削除しました: deleted=%d", deleted_count)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "purge-deleted-users",
+			Interval: deletedUserPurgeInterval,
+			Run: func(ctx context.Context) error {
+				var purged_count int
+				var err error
+
+				purged_count, err = use_cases.purge_deleted_users.Execute(ctx)
+				if purged_count > 0 {
+					log.Printf("取り消し期間を過ぎた退会ユーザーを削除しました: purged=%d", purged_count)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "process-data-exports",
+			Interval: dataExportProcessInterval,
+			Run: func(ctx context.Context) error {
+				var processed_count int
+				var err error
+
+				processed_count, err = use_cases.process_exports.Execute(ctx)
+				if processed_count > 0 {
+					log.Printf("個人データのエクスポートを処理しました: processed=%d", processed_count)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "purge-expired-data-exports",
+			Interval: dataExportPurgeInterval,
+			Run: func(ctx context.Context) error {
+				var deleted_count int
+				var err error
+
+				deleted_count, err = use_cases.purge_exports.Execute(ctx)
+				if deleted_count > 0 {
+					log.Printf("有効期限切れの個人データのエクスポートを削除しました: deleted=%d", deleted_count)
+				}
+				return err
+			},
+		},
+	}
+}
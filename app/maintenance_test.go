@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/usecase/user"
+)
+
+// TestWriteReconcileReport は孤立したレコードと件数が1行ずつ出力されることをテストします
+func TestWriteReconcileReport(t *testing.T) {
+	var account *models.FirebaseAccount
+	var email models.Email
+	var orphan *models.User
+	var output bytes.Buffer
+	var lines []string
+	var err error
+
+	account, err = models.NewFirebaseAccount("firebase_orphan_uid", "orphan@example.com", time.Now())
+	if err != nil {
+		t.Fatalf("failed to create firebase account: %v", err)
+	}
+	email, err = models.NewEmail("db_orphan@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	orphan, err = models.NewUser("db_orphan_uid", email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	write_reconcile_report(&output, &user.ReconcileUsersReport{
+		FirebaseOrphans:         []*models.FirebaseAccount{account},
+		DatabaseOrphans:         []*models.User{orphan},
+		RepairedFirebaseOrphans: 1,
+	})
+	lines = strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d: %q", len(lines), output.String())
+	}
+	if !strings.Contains(lines[0], "uid=firebase_orphan_uid") {
+		t.Errorf("expected firebase orphan line, got %s", lines[0])
+	}
+	if !strings.Contains(lines[1], "firebase_uid=db_orphan_uid") {
+		t.Errorf("expected database orphan line, got %s", lines[1])
+	}
+	if lines[2] != "firebase_orphans=1 (repaired=1) database_orphans=1 (repaired=0)" {
+		t.Errorf("unexpected summary line: %s", lines[2])
+	}
+}
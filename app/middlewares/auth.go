@@ -0,0 +1,163 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// bearerPrefix はAuthorizationヘッダーのBearerスキームの接頭辞です
+const bearerPrefix = "Bearer "
+
+// AccessTokenValidatorInterface はアクセストークンを検証するインターフェースです
+type AccessTokenValidatorInterface interface {
+	ValidateToken(ctx context.Context, token_string string) (*utils.JWTClaims, error)
+}
+
+// CurrentUserFinderInterface は公開IDでユーザーを検索するインターフェースです
+type CurrentUserFinderInterface interface {
+	FindByPublicID(ctx context.Context, public_id uuid.UUID) (*models.User, error)
+}
+
+// AuthMiddleware はAuthorizationヘッダーのアクセストークンを検証し、認証情報をcontextに格納するミドルウェアです
+// Authorizationヘッダーがないリクエストは未認証のまま通過させます（ログイン不要の機能のため）
+type AuthMiddleware struct {
+	token_validator AccessTokenValidatorInterface
+	user_finder     CurrentUserFinderInterface
+}
+
+// NewAuthMiddleware は新しいAuthMiddlewareを作成します
+func NewAuthMiddleware(token_validator AccessTokenValidatorInterface, user_finder CurrentUserFinderInterface) *AuthMiddleware {
+	return &AuthMiddleware{
+		token_validator: token_validator,
+		user_finder:     user_finder,
+	}
+}
+
+// Handler は認証処理を行うhttp.Handlerを返します
+func (m *AuthMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var authorization string
+		var ctx context.Context
+		var err error
+
+		authorization = r.Header.Get("Authorization")
+		if authorization == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, err = m.authenticate(r.Context(), authorization)
+		if err != nil {
+			write_auth_error(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authenticate はAuthorizationヘッダーを検証し、認証情報を格納したcontextを返します
+func (m *AuthMiddleware) authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	var token_string string
+	var claims *utils.JWTClaims
+	var public_id uuid.UUID
+	var user *models.User
+	var err error
+
+	token_string, err = parse_bearer_token(authorization)
+	if err != nil {
+		return nil, err
+	}
+	claims, err = m.token_validator.ValidateToken(ctx, token_string)
+	if err != nil {
+		if errors.Is(err, domain_errors.ErrTokenRevoked) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	// リフレッシュトークンや二要素認証のチャレンジトークンをアクセストークンとして使うことは許可しない
+	if claims.TokenType != utils.TokenTypeAccess {
+		return nil, fmt.Errorf("%w: unexpected token type %s", domain_errors.ErrInvalidAccessToken, claims.TokenType)
+	}
+	public_id, err = uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	// 論理削除されたユーザーは検索の対象外のため、退会済みのユーザーのトークンも不正なアクセストークンとして拒否する
+	user, err = m.user_finder.FindByPublicID(ctx, public_id)
+	if err != nil {
+		if errors.Is(err, domain_errors.ErrUserNotFound) {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+		}
+		return nil, err
+	}
+	return utils.WithAuthInfo(ctx, claims, user), nil
+}
+
+// parse_bearer_token はAuthorizationヘッダーからBearerトークンを取り出します
+func parse_bearer_token(authorization string) (string, error) {
+	var token_string string
+
+	if len(authorization) < len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return "", fmt.Errorf("%w: authorization scheme must be Bearer", domain_errors.ErrInvalidAccessToken)
+	}
+	token_string = strings.TrimSpace(authorization[len(bearerPrefix):])
+	if token_string == "" {
+		return "", fmt.Errorf("%w: bearer token is empty", domain_errors.ErrInvalidAccessToken)
+	}
+	return token_string, nil
+}
+
+// auth_error_response はGraphQLのエラー形式に合わせた認証エラーのレスポンスです
+type auth_error_response struct {
+	Errors []auth_error `json:"errors"`
+}
+
+// auth_error は認証エラーの内容です
+type auth_error struct {
+	Message    string            `json:"message"`
+	Extensions map[string]string `json:"extensions"`
+}
+
+// write_auth_error は認証エラーをHTTPレスポンスとして書き込みます
+func write_auth_error(w http.ResponseWriter, err error) {
+	var status_code int
+	var domain_err error
+	var code string
+
+	status_code, domain_err, code = build_auth_error(err)
+	if status_code == http.StatusInternalServerError {
+		log.Printf("認証処理でエラーが発生しました: %v", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status_code)
+	_ = json.NewEncoder(w).Encode(auth_error_response{
+		Errors: []auth_error{
+			{
+				Message:    domain_err.Error(),
+				Extensions: map[string]string{"code": code},
+			},
+		},
+	})
+}
+
+// build_auth_error はエラーからHTTPステータス・ドメインエラー・エラーコードを決定します
+func build_auth_error(err error) (int, error, string) {
+	switch {
+	case errors.Is(err, domain_errors.ErrTokenRevoked):
+		return http.StatusUnauthorized, domain_errors.ErrTokenRevoked, "TOKEN_REVOKED"
+	case errors.Is(err, domain_errors.ErrInvalidAccessToken):
+		return http.StatusUnauthorized, domain_errors.ErrInvalidAccessToken, "INVALID_ACCESS_TOKEN"
+	default:
+		return http.StatusInternalServerError, domain_errors.ErrDatabaseError, "DATABASE_ERROR"
+	}
+}
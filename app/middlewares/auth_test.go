@@ -0,0 +1,318 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// テスト用定数
+const (
+	testSecretKey   = "test_secret_key_for_middleware_1234567890"
+	testFirebaseUID = "firebase_uid_123"
+	testEmail       = "test@example.com"
+)
+
+// setupAuthMiddlewareTest はテスト用のミドルウェアと、認証情報を記録するハンドラーを作成します
+func setupAuthMiddlewareTest(user_finder *MockCurrentUserFinder) (http.Handler, *utils.JWTService, *recordedAuthInfo) {
+	var jwt_service *utils.JWTService
+	var recorded *recordedAuthInfo
+	var next http.Handler
+
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	recorded = &recordedAuthInfo{}
+	next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.is_called = true
+		recorded.user, recorded.is_authenticated = utils.GetCurrentUser(r.Context())
+		recorded.claims, _ = utils.GetAuthClaims(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	return NewAuthMiddleware(jwt_service, user_finder).Handler(next), jwt_service, recorded
+}
+
+// createTestJWTService はHS256の共通鍵のみのKeyRingで署名・検証するテスト用のJWTServiceを作成します
+func createTestJWTService(denylist utils.TokenDenylistInterface) *utils.JWTService {
+	var signing_key *utils.SigningKey
+	var key_ring *utils.KeyRing
+
+	signing_key, _ = utils.NewHMACSigningKey("", []byte(testSecretKey))
+	key_ring, _ = utils.NewKeyRing(signing_key)
+	return utils.NewJWTService(key_ring, denylist)
+}
+
+// recordedAuthInfo は後続ハンドラーが受け取った認証情報です
+type recordedAuthInfo struct {
+	is_called        bool
+	is_authenticated bool
+	user             *models.User
+	claims           *utils.JWTClaims
+}
+
+// serve_with_authorization はAuthorizationヘッダー付きのリクエストを処理します
+func serve_with_authorization(handler http.Handler, authorization string) *httptest.ResponseRecorder {
+	var request *http.Request
+	var recorder *httptest.ResponseRecorder
+
+	request = httptest.NewRequest(http.MethodPost, "/query", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// decode_error_code はレスポンスのエラーコードを取り出します
+func decode_error_code(t *testing.T, recorder *httptest.ResponseRecorder) string {
+	var response auth_error_response
+	var err error
+
+	t.Helper()
+	err = json.NewDecoder(recorder.Body).Decode(&response)
+	if err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(response.Errors))
+	}
+	return response.Errors[0].Extensions["code"]
+}
+
+// TestAuthMiddleware_Anonymous はAuthorizationヘッダーがないリクエストが未認証で通過することをテストします
+func TestAuthMiddleware_Anonymous(t *testing.T) {
+	var handler http.Handler
+	var recorded *recordedAuthInfo
+	var recorder *httptest.ResponseRecorder
+
+	handler, _, recorded = setupAuthMiddlewareTest(NewMockCurrentUserFinder())
+	recorder = serve_with_authorization(handler, "")
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", recorder.Code)
+	}
+	if !recorded.is_called {
+		t.Fatal("expected next handler to be called")
+	}
+	if recorded.is_authenticated {
+		t.Error("expected request to be anonymous")
+	}
+}
+
+// TestAuthMiddleware_ValidAccessToken は有効なアクセストークンで認証情報がcontextに格納されることをテストします
+func TestAuthMiddleware_ValidAccessToken(t *testing.T) {
+	var user_finder *MockCurrentUserFinder
+	var handler http.Handler
+	var jwt_service *utils.JWTService
+	var recorded *recordedAuthInfo
+	var token_pair *utils.TokenPair
+	var recorder *httptest.ResponseRecorder
+	var err error
+
+	user_finder = NewMockCurrentUserFinder()
+	handler, jwt_service, recorded = setupAuthMiddlewareTest(user_finder)
+	token_pair, err = jwt_service.GenerateTokenPair(user_finder.public_id.String(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	recorder = serve_with_authorization(handler, "Bearer "+token_pair.AccessToken)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+	if !recorded.is_authenticated {
+		t.Fatal("expected request to be authenticated")
+	}
+	if recorded.user.PublicID() != user_finder.public_id {
+		t.Errorf("expected user %s, got %s", user_finder.public_id, recorded.user.PublicID())
+	}
+	if recorded.claims.TokenType != utils.TokenTypeAccess {
+		t.Errorf("expected access token claims, got %s", recorded.claims.TokenType)
+	}
+}
+
+// TestAuthMiddleware_RefreshTokenRejected はリフレッシュトークンがアクセストークンとして拒否されることをテストします
+func TestAuthMiddleware_RefreshTokenRejected(t *testing.T) {
+	var user_finder *MockCurrentUserFinder
+	var handler http.Handler
+	var jwt_service *utils.JWTService
+	var recorded *recordedAuthInfo
+	var token_pair *utils.TokenPair
+	var recorder *httptest.ResponseRecorder
+	var err error
+
+	user_finder = NewMockCurrentUserFinder()
+	handler, jwt_service, recorded = setupAuthMiddlewareTest(user_finder)
+	token_pair, err = jwt_service.GenerateTokenPair(user_finder.public_id.String(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	recorder = serve_with_authorization(handler, "Bearer "+token_pair.RefreshToken)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", recorder.Code)
+	}
+	if decode_error_code(t, recorder) != "INVALID_ACCESS_TOKEN" {
+		t.Error("expected INVALID_ACCESS_TOKEN code")
+	}
+	if recorded.is_called {
+		t.Error("expected next handler NOT to be called")
+	}
+}
+
+// TestAuthMiddleware_InvalidAuthorization は不正なAuthorizationヘッダーが拒否されることをテストします
+func TestAuthMiddleware_InvalidAuthorization(t *testing.T) {
+	var handler http.Handler
+	var recorded *recordedAuthInfo
+	var recorder *httptest.ResponseRecorder
+
+	handler, _, recorded = setupAuthMiddlewareTest(NewMockCurrentUserFinder())
+	for _, authorization := range []string{"Bearer invalid.token.string", "Basic dXNlcjpwYXNz", "Bearer "} {
+		recorder = serve_with_authorization(handler, authorization)
+		if recorder.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401 for %q, got %d", authorization, recorder.Code)
+		}
+		if decode_error_code(t, recorder) != "INVALID_ACCESS_TOKEN" {
+			t.Errorf("expected INVALID_ACCESS_TOKEN code for %q", authorization)
+		}
+	}
+	if recorded.is_called {
+		t.Error("expected next handler NOT to be called")
+	}
+}
+
+// TestAuthMiddleware_RevokedToken は失効済みトークンがTOKEN_REVOKEDで拒否されることをテストします
+func TestAuthMiddleware_RevokedToken(t *testing.T) {
+	var user_finder *MockCurrentUserFinder
+	var jwt_service *utils.JWTService
+	var handler http.Handler
+	var token_pair *utils.TokenPair
+	var recorder *httptest.ResponseRecorder
+	var err error
+
+	user_finder = NewMockCurrentUserFinder()
+	jwt_service = createTestJWTService(&MockRevokeAllDenylist{})
+	handler = NewAuthMiddleware(jwt_service, user_finder).Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	token_pair, err = jwt_service.GenerateTokenPair(user_finder.public_id.String(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	recorder = serve_with_authorization(handler, "Bearer "+token_pair.AccessToken)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", recorder.Code)
+	}
+	if decode_error_code(t, recorder) != "TOKEN_REVOKED" {
+		t.Error("expected TOKEN_REVOKED code")
+	}
+}
+
+// TestAuthMiddleware_DeletedUser は論理削除済みユーザーのトークンが不正なアクセストークンとして拒否されることをテストします
+func TestAuthMiddleware_DeletedUser(t *testing.T) {
+	var user_finder *MockCurrentUserFinder
+	var handler http.Handler
+	var jwt_service *utils.JWTService
+	var token_pair *utils.TokenPair
+	var recorder *httptest.ResponseRecorder
+	var err error
+
+	user_finder = NewMockCurrentUserFinder()
+	user_finder.is_deleted = true
+	handler, jwt_service, _ = setupAuthMiddlewareTest(user_finder)
+	token_pair, err = jwt_service.GenerateTokenPair(user_finder.public_id.String(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	recorder = serve_with_authorization(handler, "Bearer "+token_pair.AccessToken)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", recorder.Code)
+	}
+	if decode_error_code(t, recorder) != "INVALID_ACCESS_TOKEN" {
+		t.Error("expected INVALID_ACCESS_TOKEN code")
+	}
+}
+
+// TestAuthMiddleware_DatabaseError はユーザー検索のDBエラーが500になることをテストします
+func TestAuthMiddleware_DatabaseError(t *testing.T) {
+	var user_finder *MockCurrentUserFinder
+	var handler http.Handler
+	var jwt_service *utils.JWTService
+	var token_pair *utils.TokenPair
+	var recorder *httptest.ResponseRecorder
+	var err error
+
+	user_finder = NewMockCurrentUserFinder()
+	user_finder.should_return_error = true
+	handler, jwt_service, _ = setupAuthMiddlewareTest(user_finder)
+	token_pair, err = jwt_service.GenerateTokenPair(user_finder.public_id.String(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	recorder = serve_with_authorization(handler, "Bearer "+token_pair.AccessToken)
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", recorder.Code)
+	}
+}
+
+// MockCurrentUserFinder はテスト用のユーザー検索モックです
+type MockCurrentUserFinder struct {
+	public_id           uuid.UUID
+	is_deleted          bool
+	should_return_error bool
+}
+
+// NewMockCurrentUserFinder は新しいMockCurrentUserFinderを作成します
+func NewMockCurrentUserFinder() *MockCurrentUserFinder {
+	return &MockCurrentUserFinder{
+		public_id:           uuid.New(),
+		is_deleted:          false,
+		should_return_error: false,
+	}
+}
+
+// FindByPublicID はモックのユーザー検索を行います（UserDAOと同じく論理削除されたユーザーは対象外です）
+func (m *MockCurrentUserFinder) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	var email models.Email
+	var now time.Time
+	var err error
+
+	if m.should_return_error {
+		return nil, domain_errors.ErrDatabaseError
+	}
+	if public_id != m.public_id || m.is_deleted {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		return nil, err
+	}
+	now = time.Now()
+	return models.NewUserWithPublicID(public_id, testFirebaseUID, email, models.RoleUser, nil, nil, now, now, nil, nil)
+}
+
+// MockTokenDenylist は失効済みのトークンがないものとして扱うdenylistモックです
+type MockTokenDenylist struct{}
+
+// NewMockTokenDenylist は新しいMockTokenDenylistを作成します
+func NewMockTokenDenylist() *MockTokenDenylist {
+	return &MockTokenDenylist{}
+}
+
+// IsDenylisted は常に失効していないものとして返します
+func (m *MockTokenDenylist) IsDenylisted(_ context.Context, _, _ string) (bool, error) {
+	return false, nil
+}
+
+// MockRevokeAllDenylist は全てのトークンを失効済みとして扱うdenylistモックです
+type MockRevokeAllDenylist struct{}
+
+// IsDenylisted は常に失効済みを返します
+func (m *MockRevokeAllDenylist) IsDenylisted(_ context.Context, _, _ string) (bool, error) {
+	return true, nil
+}
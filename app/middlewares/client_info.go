@@ -0,0 +1,66 @@
+package middlewares
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"sleeve/usecase/utils"
+)
+
+// 端末情報を送信するリクエストヘッダー
+const (
+	deviceIDHeader       = "X-Device-Id"
+	deviceNameHeader     = "X-Device-Name"
+	devicePlatformHeader = "X-Device-Platform"
+	forwardedForHeader   = "X-Forwarded-For"
+)
+
+// ClientInfoMiddleware はリクエスト元の端末情報（端末ID・端末名・プラットフォーム・IPアドレス・User-Agent）をcontextに格納するミドルウェアです
+// 端末ID・端末名・プラットフォームはクライアントがX-Device-Id・X-Device-Name・X-Device-Platformヘッダーで送信します
+type ClientInfoMiddleware struct {
+	trust_forwarded_for bool
+}
+
+// NewClientInfoMiddleware は新しいClientInfoMiddlewareを作成します
+// trust_forwarded_forがtrueの場合は、ロードバランサーが付与したX-Forwarded-Forの先頭のアドレスをIPアドレスとして使用します
+func NewClientInfoMiddleware(trust_forwarded_for bool) *ClientInfoMiddleware {
+	return &ClientInfoMiddleware{
+		trust_forwarded_for: trust_forwarded_for,
+	}
+}
+
+// Handler は端末情報をcontextに格納するhttp.Handlerを返します
+func (m *ClientInfoMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var client_info *utils.ClientInfo
+
+		client_info = &utils.ClientInfo{
+			DeviceID:   r.Header.Get(deviceIDHeader),
+			DeviceName: r.Header.Get(deviceNameHeader),
+			Platform:   r.Header.Get(devicePlatformHeader),
+			IPAddress:  m.client_ip_address(r),
+			UserAgent:  r.UserAgent(),
+		}
+		next.ServeHTTP(w, r.WithContext(utils.WithClientInfo(r.Context(), client_info)))
+	})
+}
+
+// client_ip_address はリクエスト元のIPアドレスを返します
+func (m *ClientInfoMiddleware) client_ip_address(r *http.Request) string {
+	var forwarded_for string
+	var host string
+	var err error
+
+	if m.trust_forwarded_for {
+		forwarded_for = r.Header.Get(forwardedForHeader)
+		if forwarded_for != "" {
+			return strings.TrimSpace(strings.Split(forwarded_for, ",")[0])
+		}
+	}
+	host, _, err = net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,57 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sleeve/usecase/utils"
+)
+
+// serve_with_client_info はミドルウェアを通したリクエストを処理し、後続ハンドラーが受け取った端末情報を返します
+func serve_with_client_info(middleware *ClientInfoMiddleware, request *http.Request) *utils.ClientInfo {
+	var recorded *utils.ClientInfo
+	var next http.Handler
+
+	next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded = utils.GetClientInfo(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	middleware.Handler(next).ServeHTTP(httptest.NewRecorder(), request)
+	return recorded
+}
+
+// TestClientInfoMiddleware_Headers は端末情報のヘッダーとリモートアドレスがcontextに格納されることをテストします
+func TestClientInfoMiddleware_Headers(t *testing.T) {
+	var request *http.Request
+	var client_info *utils.ClientInfo
+
+	request = httptest.NewRequest(http.MethodPost, "/query", nil)
+	request.RemoteAddr = "192.0.2.1:54321"
+	request.Header.Set("X-Device-Id", "device_id_123")
+	request.Header.Set("X-Device-Name", "My iPhone")
+	request.Header.Set("X-Device-Platform", "ios")
+	request.Header.Set("User-Agent", "SleeveApp/1.0")
+	request.Header.Set("X-Forwarded-For", "203.0.113.5")
+	client_info = serve_with_client_info(NewClientInfoMiddleware(false), request)
+	if client_info.DeviceID != "device_id_123" || client_info.DeviceName != "My iPhone" || client_info.Platform != "ios" || client_info.UserAgent != "SleeveApp/1.0" {
+		t.Errorf("unexpected client info: %+v", client_info)
+	}
+	if client_info.IPAddress != "192.0.2.1" {
+		t.Errorf("expected remote address to be used when X-Forwarded-For is not trusted, got %s", client_info.IPAddress)
+	}
+}
+
+// TestClientInfoMiddleware_TrustForwardedFor はX-Forwarded-Forを信頼する場合に先頭のアドレスを使用することをテストします
+func TestClientInfoMiddleware_TrustForwardedFor(t *testing.T) {
+	var request *http.Request
+	var client_info *utils.ClientInfo
+
+	request = httptest.NewRequest(http.MethodPost, "/query", nil)
+	request.RemoteAddr = "10.0.0.1:54321"
+	request.Header.Set("X-Forwarded-For", "203.0.113.5, 10.0.0.2")
+	client_info = serve_with_client_info(NewClientInfoMiddleware(true), request)
+	if client_info.IPAddress != "203.0.113.5" {
+		t.Errorf("expected forwarded address 203.0.113.5, got %s", client_info.IPAddress)
+	}
+}
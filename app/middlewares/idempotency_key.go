@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"sleeve/usecase/utils"
+)
+
+// idempotencyKeyHeader はクライアントが再送信を識別するために送信する冪等キーのリクエストヘッダーです
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKeyMiddleware はIdempotency-Keyヘッダーの冪等キーをcontextに格納するミドルウェアです
+// 冪等キーに対応する操作（registerUser）は、入力フィールドで指定されていない場合にこの値を使用します
+type IdempotencyKeyMiddleware struct{}
+
+// NewIdempotencyKeyMiddleware は新しいIdempotencyKeyMiddlewareを作成します
+func NewIdempotencyKeyMiddleware() *IdempotencyKeyMiddleware {
+	return &IdempotencyKeyMiddleware{}
+}
+
+// Handler は冪等キーをcontextに格納するhttp.Handlerを返します（ヘッダーがない場合はそのまま通過します）
+func (m *IdempotencyKeyMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var idempotency_key string
+
+		idempotency_key = r.Header.Get(idempotencyKeyHeader)
+		if idempotency_key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(utils.WithIdempotencyKey(r.Context(), idempotency_key)))
+	})
+}
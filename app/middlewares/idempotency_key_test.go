@@ -0,0 +1,42 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sleeve/usecase/utils"
+)
+
+// TestIdempotencyKeyMiddleware_Header はIdempotency-Keyヘッダーの値がcontextに格納されることをテストします
+func TestIdempotencyKeyMiddleware_Header(t *testing.T) {
+	var test_cases []struct {
+		header   string
+		expected string
+	}
+
+	test_cases = []struct {
+		header   string
+		expected string
+	}{
+		{header: "8f14e45f-ceea-467f-a0e6-61d4b1f7c5a2", expected: "8f14e45f-ceea-467f-a0e6-61d4b1f7c5a2"},
+		{header: "", expected: ""},
+	}
+	for _, test_case := range test_cases {
+		var request *http.Request
+		var recorded string
+		var next http.Handler
+
+		request = httptest.NewRequest(http.MethodPost, "/query", nil)
+		if test_case.header != "" {
+			request.Header.Set("Idempotency-Key", test_case.header)
+		}
+		next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorded = utils.GetIdempotencyKey(r.Context())
+		})
+		NewIdempotencyKeyMiddleware().Handler(next).ServeHTTP(httptest.NewRecorder(), request)
+		if recorded != test_case.expected {
+			t.Errorf("expected idempotency key %q, got %q", test_case.expected, recorded)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+-- Create "refresh_tokens" table
+CREATE TABLE "public"."refresh_tokens" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "token_id" character varying NOT NULL,
+  "family_id" uuid NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "rotated_at" timestamptz NULL,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "refresh_tokens_users_refresh_tokens" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "refresh_tokens_token_id_key" to table: "refresh_tokens"
+CREATE UNIQUE INDEX "refresh_tokens_token_id_key" ON "public"."refresh_tokens" ("token_id");
+-- Create index "refreshtoken_family_id" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_family_id" ON "public"."refresh_tokens" ("family_id");
+-- Create index "refreshtoken_token_id" to table: "refresh_tokens"
+CREATE UNIQUE INDEX "refreshtoken_token_id" ON "public"."refresh_tokens" ("token_id");
+-- Create index "refreshtoken_user_id" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_user_id" ON "public"."refresh_tokens" ("user_id");
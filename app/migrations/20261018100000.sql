@@ -0,0 +1,13 @@
+-- Create "denylisted_tokens" table
+CREATE TABLE "public"."denylisted_tokens" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "kind" character varying NOT NULL,
+  "value" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "denylistedtoken_expires_at" to table: "denylisted_tokens"
+CREATE INDEX "denylistedtoken_expires_at" ON "public"."denylisted_tokens" ("expires_at");
+-- Create index "denylistedtoken_kind_value" to table: "denylisted_tokens"
+CREATE UNIQUE INDEX "denylistedtoken_kind_value" ON "public"."denylisted_tokens" ("kind", "value");
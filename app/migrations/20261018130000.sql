@@ -0,0 +1,14 @@
+-- Create "user_identities" table
+CREATE TABLE "public"."user_identities" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "provider" character varying NOT NULL,
+  "subject" character varying NOT NULL,
+  "linked_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_identities_users_identities" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "useridentity_provider_subject" to table: "user_identities"
+CREATE UNIQUE INDEX "useridentity_provider_subject" ON "public"."user_identities" ("provider", "subject");
+-- Create index "useridentity_user_id_provider" to table: "user_identities"
+CREATE UNIQUE INDEX "useridentity_user_id_provider" ON "public"."user_identities" ("user_id", "provider");
@@ -0,0 +1,17 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "mfa_enabled_at" timestamptz NULL;
+-- Create "totp_credentials" table
+CREATE TABLE "public"."totp_credentials" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "encrypted_secret" character varying NOT NULL,
+  "recovery_code_hashes" jsonb NOT NULL,
+  "last_used_step" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "totp_credentials_users_totp_credential" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "totp_credentials_user_id_key" to table: "totp_credentials"
+CREATE UNIQUE INDEX "totp_credentials_user_id_key" ON "public"."totp_credentials" ("user_id");
+-- Create index "totpcredential_user_id" to table: "totp_credentials"
+CREATE UNIQUE INDEX "totpcredential_user_id" ON "public"."totp_credentials" ("user_id");
@@ -0,0 +1,17 @@
+-- Create "compensation_tasks" table
+CREATE TABLE "public"."compensation_tasks" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "task_id" uuid NOT NULL,
+  "kind" character varying NOT NULL,
+  "target" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" character varying NOT NULL DEFAULT '',
+  "next_attempt_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "compensation_tasks_task_id_key" to table: "compensation_tasks"
+CREATE UNIQUE INDEX "compensation_tasks_task_id_key" ON "public"."compensation_tasks" ("task_id");
+-- Create index "compensationtask_status_next_attempt_at" to table: "compensation_tasks"
+CREATE INDEX "compensationtask_status_next_attempt_at" ON "public"."compensation_tasks" ("status", "next_attempt_at");
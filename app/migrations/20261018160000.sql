@@ -0,0 +1,20 @@
+-- Create "sessions" table
+CREATE TABLE "public"."sessions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "session_id" uuid NOT NULL,
+  "device_name" character varying NOT NULL DEFAULT '',
+  "platform" character varying NOT NULL DEFAULT 'unknown',
+  "ip_address" character varying NOT NULL DEFAULT '',
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "last_seen_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sessions_users_sessions" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "sessions_session_id_key" to table: "sessions"
+CREATE UNIQUE INDEX "sessions_session_id_key" ON "public"."sessions" ("session_id");
+-- Create index "session_user_id" to table: "sessions"
+CREATE INDEX "session_user_id" ON "public"."sessions" ("user_id");
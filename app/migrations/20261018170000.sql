@@ -0,0 +1,13 @@
+-- Create "auth_attempts" table
+CREATE TABLE "public"."auth_attempts" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying NOT NULL,
+  "failure_count" bigint NOT NULL DEFAULT 0,
+  "last_failed_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "auth_attempts_key_key" to table: "auth_attempts"
+CREATE UNIQUE INDEX "auth_attempts_key_key" ON "public"."auth_attempts" ("key");
+-- Create index "authattempt_expires_at" to table: "auth_attempts"
+CREATE INDEX "authattempt_expires_at" ON "public"."auth_attempts" ("expires_at");
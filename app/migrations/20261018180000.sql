@@ -0,0 +1,16 @@
+-- Create "idempotency_records" table
+CREATE TABLE "public"."idempotency_records" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "operation" character varying NOT NULL,
+  "key" character varying(255) NOT NULL,
+  "fingerprint" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "user_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "idempotency_records_users_idempotency_records" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idempotencyrecord_operation_key" to table: "idempotency_records"
+CREATE UNIQUE INDEX "idempotencyrecord_operation_key" ON "public"."idempotency_records" ("operation", "key");
+-- Create index "idempotencyrecord_expires_at" to table: "idempotency_records"
+CREATE INDEX "idempotencyrecord_expires_at" ON "public"."idempotency_records" ("expires_at");
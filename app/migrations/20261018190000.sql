@@ -0,0 +1,19 @@
+-- Create "profiles" table
+CREATE TABLE "public"."profiles" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "display_name" character varying(50) NOT NULL,
+  "bio" character varying(500) NOT NULL DEFAULT '',
+  "avatar_url" character varying(2048) NULL,
+  "height_cm" bigint NULL,
+  "favorite_styles" jsonb NOT NULL,
+  "links" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "profiles_users_profile" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "profiles_user_id_key" to table: "profiles"
+CREATE UNIQUE INDEX "profiles_user_id_key" ON "public"."profiles" ("user_id");
+-- Create index "profile_user_id" to table: "profiles"
+CREATE UNIQUE INDEX "profile_user_id" ON "public"."profiles" ("user_id");
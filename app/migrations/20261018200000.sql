@@ -0,0 +1,15 @@
+-- Create "user_handles" table
+CREATE TABLE "public"."user_handles" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "handle" character varying(30) NOT NULL,
+  "handle_key" character varying(30) NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "retired_at" timestamptz NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_handles_users_handles" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "userhandle_handle_key" to table: "user_handles"
+CREATE UNIQUE INDEX "userhandle_handle_key" ON "public"."user_handles" ("handle_key");
+-- Create index "userhandle_user_id_retired_at" to table: "user_handles"
+CREATE INDEX "userhandle_user_id_retired_at" ON "public"."user_handles" ("user_id", "retired_at");
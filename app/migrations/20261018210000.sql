@@ -0,0 +1,16 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "banned_at" timestamptz NULL, ADD COLUMN "follower_count" bigint NOT NULL DEFAULT 0, ADD COLUMN "following_count" bigint NOT NULL DEFAULT 0;
+-- Create "follows" table
+CREATE TABLE "public"."follows" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "follower_id" bigint NOT NULL,
+  "followee_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "follows_users_followers" FOREIGN KEY ("followee_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "follows_users_following" FOREIGN KEY ("follower_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "follow_followee_id" to table: "follows"
+CREATE INDEX "follow_followee_id" ON "public"."follows" ("followee_id");
+-- Create index "follow_follower_id_followee_id" to table: "follows"
+CREATE UNIQUE INDEX "follow_follower_id_followee_id" ON "public"."follows" ("follower_id", "followee_id");
@@ -0,0 +1,26 @@
+-- Create "user_blocks" table
+CREATE TABLE "public"."user_blocks" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "blocker_id" bigint NOT NULL,
+  "blocked_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_blocks_users_blocked_by" FOREIGN KEY ("blocked_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_blocks_users_blocking" FOREIGN KEY ("blocker_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "userblock_blocked_id" to table: "user_blocks"
+CREATE INDEX "userblock_blocked_id" ON "public"."user_blocks" ("blocked_id");
+-- Create index "userblock_blocker_id_blocked_id" to table: "user_blocks"
+CREATE UNIQUE INDEX "userblock_blocker_id_blocked_id" ON "public"."user_blocks" ("blocker_id", "blocked_id");
+-- Create "user_mutes" table
+CREATE TABLE "public"."user_mutes" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "muter_id" bigint NOT NULL,
+  "muted_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_mutes_users_muted_by" FOREIGN KEY ("muted_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_mutes_users_muting" FOREIGN KEY ("muter_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "usermute_muter_id_muted_id" to table: "user_mutes"
+CREATE UNIQUE INDEX "usermute_muter_id_muted_id" ON "public"."user_mutes" ("muter_id", "muted_id");
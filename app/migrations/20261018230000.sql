@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "deletion_token_hash" character varying NULL;
+-- Create index "users_deletion_token_hash_key" to table: "users"
+CREATE UNIQUE INDEX "users_deletion_token_hash_key" ON "public"."users" ("deletion_token_hash");
@@ -0,0 +1,25 @@
+-- Create "data_exports" table
+CREATE TABLE "public"."data_exports" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "export_id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "storage_key" character varying NULL,
+  "download_token_hash" character varying NULL,
+  "last_error" character varying NOT NULL DEFAULT '',
+  "completed_at" timestamptz NULL,
+  "expires_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "data_exports_users_data_exports" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "data_exports_download_token_hash_key" to table: "data_exports"
+CREATE UNIQUE INDEX "data_exports_download_token_hash_key" ON "public"."data_exports" ("download_token_hash");
+-- Create index "data_exports_export_id_key" to table: "data_exports"
+CREATE UNIQUE INDEX "data_exports_export_id_key" ON "public"."data_exports" ("export_id");
+-- Create index "dataexport_expires_at" to table: "data_exports"
+CREATE INDEX "dataexport_expires_at" ON "public"."data_exports" ("expires_at");
+-- Create index "dataexport_status_created_at" to table: "data_exports"
+CREATE INDEX "dataexport_status_created_at" ON "public"."data_exports" ("status", "created_at");
+-- Create index "dataexport_user_id_status" to table: "data_exports"
+CREATE INDEX "dataexport_user_id_status" ON "public"."data_exports" ("user_id", "status");
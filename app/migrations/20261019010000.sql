@@ -0,0 +1,15 @@
+-- Create "email_change_requests" table
+CREATE TABLE "public"."email_change_requests" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "new_email" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "email_change_requests_users_email_change_request" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "email_change_requests_token_hash_key" to table: "email_change_requests"
+CREATE UNIQUE INDEX "email_change_requests_token_hash_key" ON "public"."email_change_requests" ("token_hash");
+-- Create index "email_change_requests_user_id_key" to table: "email_change_requests"
+CREATE UNIQUE INDEX "email_change_requests_user_id_key" ON "public"."email_change_requests" ("user_id");
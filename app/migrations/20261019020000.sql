@@ -0,0 +1,16 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "is_private" boolean NOT NULL DEFAULT false, ADD COLUMN "hide_closet" boolean NOT NULL DEFAULT false, ADD COLUMN "hide_likes" boolean NOT NULL DEFAULT false, ADD COLUMN "comment_permission" character varying NOT NULL DEFAULT 'everyone';
+-- Create "follow_requests" table
+CREATE TABLE "public"."follow_requests" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "requester_id" bigint NOT NULL,
+  "target_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "follow_requests_users_received_follow_requests" FOREIGN KEY ("target_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "follow_requests_users_sent_follow_requests" FOREIGN KEY ("requester_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "followrequest_requester_id_target_id" to table: "follow_requests"
+CREATE UNIQUE INDEX "followrequest_requester_id_target_id" ON "public"."follow_requests" ("requester_id", "target_id");
+-- Create index "followrequest_target_id" to table: "follow_requests"
+CREATE INDEX "followrequest_target_id" ON "public"."follow_requests" ("target_id");
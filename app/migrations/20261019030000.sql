@@ -0,0 +1,124 @@
+-- Drop index "auth_attempts_key_key" from table: "auth_attempts"
+DROP INDEX "public"."auth_attempts_key_key";
+-- Modify "auth_attempts" table
+ALTER TABLE "public"."auth_attempts" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "authattempt_deleted_at" to table: "auth_attempts"
+CREATE INDEX "authattempt_deleted_at" ON "public"."auth_attempts" ("deleted_at");
+-- Create index "authattempt_key" to table: "auth_attempts"
+CREATE UNIQUE INDEX "authattempt_key" ON "public"."auth_attempts" ("key") WHERE (deleted_at IS NULL);
+-- Modify "compensation_tasks" table
+ALTER TABLE "public"."compensation_tasks" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "compensationtask_deleted_at" to table: "compensation_tasks"
+CREATE INDEX "compensationtask_deleted_at" ON "public"."compensation_tasks" ("deleted_at");
+-- Modify "data_exports" table
+ALTER TABLE "public"."data_exports" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "dataexport_deleted_at" to table: "data_exports"
+CREATE INDEX "dataexport_deleted_at" ON "public"."data_exports" ("deleted_at");
+-- Drop index "denylistedtoken_kind_value" from table: "denylisted_tokens"
+DROP INDEX "public"."denylistedtoken_kind_value";
+-- Modify "denylisted_tokens" table
+ALTER TABLE "public"."denylisted_tokens" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "denylistedtoken_deleted_at" to table: "denylisted_tokens"
+CREATE INDEX "denylistedtoken_deleted_at" ON "public"."denylisted_tokens" ("deleted_at");
+-- Create index "denylistedtoken_kind_value" to table: "denylisted_tokens"
+CREATE UNIQUE INDEX "denylistedtoken_kind_value" ON "public"."denylisted_tokens" ("kind", "value") WHERE (deleted_at IS NULL);
+-- Drop index "email_change_requests_user_id_key" from table: "email_change_requests"
+DROP INDEX "public"."email_change_requests_user_id_key";
+-- Modify "email_change_requests" table
+ALTER TABLE "public"."email_change_requests" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "emailchangerequest_deleted_at" to table: "email_change_requests"
+CREATE INDEX "emailchangerequest_deleted_at" ON "public"."email_change_requests" ("deleted_at");
+-- Create index "emailchangerequest_user_id" to table: "email_change_requests"
+CREATE UNIQUE INDEX "emailchangerequest_user_id" ON "public"."email_change_requests" ("user_id") WHERE (deleted_at IS NULL);
+-- Drop index "followrequest_requester_id_target_id" from table: "follow_requests"
+DROP INDEX "public"."followrequest_requester_id_target_id";
+-- Modify "follow_requests" table
+ALTER TABLE "public"."follow_requests" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "followrequest_deleted_at" to table: "follow_requests"
+CREATE INDEX "followrequest_deleted_at" ON "public"."follow_requests" ("deleted_at");
+-- Create index "followrequest_requester_id_target_id" to table: "follow_requests"
+CREATE UNIQUE INDEX "followrequest_requester_id_target_id" ON "public"."follow_requests" ("requester_id", "target_id") WHERE (deleted_at IS NULL);
+-- Drop index "follow_follower_id_followee_id" from table: "follows"
+DROP INDEX "public"."follow_follower_id_followee_id";
+-- Modify "follows" table
+ALTER TABLE "public"."follows" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "follow_deleted_at" to table: "follows"
+CREATE INDEX "follow_deleted_at" ON "public"."follows" ("deleted_at");
+-- Create index "follow_follower_id_followee_id" to table: "follows"
+CREATE UNIQUE INDEX "follow_follower_id_followee_id" ON "public"."follows" ("follower_id", "followee_id") WHERE (deleted_at IS NULL);
+-- Drop index "idempotencyrecord_operation_key" from table: "idempotency_records"
+DROP INDEX "public"."idempotencyrecord_operation_key";
+-- Modify "idempotency_records" table
+ALTER TABLE "public"."idempotency_records" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "idempotencyrecord_deleted_at" to table: "idempotency_records"
+CREATE INDEX "idempotencyrecord_deleted_at" ON "public"."idempotency_records" ("deleted_at");
+-- Create index "idempotencyrecord_operation_key" to table: "idempotency_records"
+CREATE UNIQUE INDEX "idempotencyrecord_operation_key" ON "public"."idempotency_records" ("operation", "key") WHERE (deleted_at IS NULL);
+-- Drop index "profile_user_id" from table: "profiles"
+DROP INDEX "public"."profile_user_id";
+-- Drop index "profiles_user_id_key" from table: "profiles"
+DROP INDEX "public"."profiles_user_id_key";
+-- Modify "profiles" table
+ALTER TABLE "public"."profiles" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "profile_deleted_at" to table: "profiles"
+CREATE INDEX "profile_deleted_at" ON "public"."profiles" ("deleted_at");
+-- Create index "profile_user_id" to table: "profiles"
+CREATE UNIQUE INDEX "profile_user_id" ON "public"."profiles" ("user_id") WHERE (deleted_at IS NULL);
+-- Modify "refresh_tokens" table
+ALTER TABLE "public"."refresh_tokens" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "refreshtoken_deleted_at" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_deleted_at" ON "public"."refresh_tokens" ("deleted_at");
+-- Modify "sessions" table
+ALTER TABLE "public"."sessions" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "session_deleted_at" to table: "sessions"
+CREATE INDEX "session_deleted_at" ON "public"."sessions" ("deleted_at");
+-- Modify "tests" table
+ALTER TABLE "public"."tests" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "test_deleted_at" to table: "tests"
+CREATE INDEX "test_deleted_at" ON "public"."tests" ("deleted_at");
+-- Drop index "totp_credentials_user_id_key" from table: "totp_credentials"
+DROP INDEX "public"."totp_credentials_user_id_key";
+-- Drop index "totpcredential_user_id" from table: "totp_credentials"
+DROP INDEX "public"."totpcredential_user_id";
+-- Modify "totp_credentials" table
+ALTER TABLE "public"."totp_credentials" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "totpcredential_deleted_at" to table: "totp_credentials"
+CREATE INDEX "totpcredential_deleted_at" ON "public"."totp_credentials" ("deleted_at");
+-- Create index "totpcredential_user_id" to table: "totp_credentials"
+CREATE UNIQUE INDEX "totpcredential_user_id" ON "public"."totp_credentials" ("user_id") WHERE (deleted_at IS NULL);
+-- Drop index "userblock_blocker_id_blocked_id" from table: "user_blocks"
+DROP INDEX "public"."userblock_blocker_id_blocked_id";
+-- Modify "user_blocks" table
+ALTER TABLE "public"."user_blocks" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "userblock_deleted_at" to table: "user_blocks"
+CREATE INDEX "userblock_deleted_at" ON "public"."user_blocks" ("deleted_at");
+-- Create index "userblock_blocker_id_blocked_id" to table: "user_blocks"
+CREATE UNIQUE INDEX "userblock_blocker_id_blocked_id" ON "public"."user_blocks" ("blocker_id", "blocked_id") WHERE (deleted_at IS NULL);
+-- Drop index "userhandle_handle_key" from table: "user_handles"
+DROP INDEX "public"."userhandle_handle_key";
+-- Modify "user_handles" table
+ALTER TABLE "public"."user_handles" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "userhandle_deleted_at" to table: "user_handles"
+CREATE INDEX "userhandle_deleted_at" ON "public"."user_handles" ("deleted_at");
+-- Create index "userhandle_handle_key" to table: "user_handles"
+CREATE UNIQUE INDEX "userhandle_handle_key" ON "public"."user_handles" ("handle_key") WHERE (deleted_at IS NULL);
+-- Drop index "useridentity_provider_subject" from table: "user_identities"
+DROP INDEX "public"."useridentity_provider_subject";
+-- Drop index "useridentity_user_id_provider" from table: "user_identities"
+DROP INDEX "public"."useridentity_user_id_provider";
+-- Modify "user_identities" table
+ALTER TABLE "public"."user_identities" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "useridentity_deleted_at" to table: "user_identities"
+CREATE INDEX "useridentity_deleted_at" ON "public"."user_identities" ("deleted_at");
+-- Create index "useridentity_provider_subject" to table: "user_identities"
+CREATE UNIQUE INDEX "useridentity_provider_subject" ON "public"."user_identities" ("provider", "subject") WHERE (deleted_at IS NULL);
+-- Create index "useridentity_user_id_provider" to table: "user_identities"
+CREATE UNIQUE INDEX "useridentity_user_id_provider" ON "public"."user_identities" ("user_id", "provider") WHERE (deleted_at IS NULL);
+-- Drop index "usermute_muter_id_muted_id" from table: "user_mutes"
+DROP INDEX "public"."usermute_muter_id_muted_id";
+-- Modify "user_mutes" table
+ALTER TABLE "public"."user_mutes" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "usermute_deleted_at" to table: "user_mutes"
+CREATE INDEX "usermute_deleted_at" ON "public"."user_mutes" ("deleted_at");
+-- Create index "usermute_muter_id_muted_id" to table: "user_mutes"
+CREATE UNIQUE INDEX "usermute_muter_id_muted_id" ON "public"."user_mutes" ("muter_id", "muted_id") WHERE (deleted_at IS NULL);
@@ -0,0 +1,19 @@
+-- Create "uploaded_images" table
+CREATE TABLE "public"."uploaded_images" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "deleted_at" timestamptz NULL,
+  "image_id" uuid NOT NULL,
+  "purpose" character varying NOT NULL,
+  "format" character varying NOT NULL,
+  "variants" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uploaded_images_users_uploaded_images" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "uploaded_images_image_id_key" to table: "uploaded_images"
+CREATE UNIQUE INDEX "uploaded_images_image_id_key" ON "public"."uploaded_images" ("image_id");
+-- Create index "uploadedimage_deleted_at" to table: "uploaded_images"
+CREATE INDEX "uploadedimage_deleted_at" ON "public"."uploaded_images" ("deleted_at");
+-- Create index "uploadedimage_user_id_created_at" to table: "uploaded_images"
+CREATE INDEX "uploadedimage_user_id_created_at" ON "public"."uploaded_images" ("user_id", "created_at");
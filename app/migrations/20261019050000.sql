@@ -0,0 +1,2 @@
+-- Drop index "refreshtoken_token_id" from table: "refresh_tokens"
+DROP INDEX "public"."refreshtoken_token_id";
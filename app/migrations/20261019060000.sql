@@ -0,0 +1,3 @@
+-- Delete rows from table: "idempotency_records"
+-- 鍵のないSHA-256のフィンガープリント（パスワードを含む）を残さないよう、HMACへの変更前の記録を削除する
+DELETE FROM "public"."idempotency_records";
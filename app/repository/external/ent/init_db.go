@@ -6,6 +6,8 @@ import (
 	"log"
 
 	"sleeve/ent"
+	// スキーマのデフォルト値・フック・インターセプター（論理削除など）をEnt Clientに登録する
+	_ "sleeve/ent/runtime"
 	"sleeve/repository/external/utils"
 
 	_ "github.com/lib/pq"
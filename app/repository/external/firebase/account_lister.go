@@ -0,0 +1,135 @@
+package firebase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"firebase.google.com/go/v4/auth"
+	"google.golang.org/api/iterator"
+)
+
+// Firebase Admin SDKの1回の呼び出しで扱える件数の上限
+const (
+	listUsersPageSize    = 1000
+	getUsersMaxBatchSize = 100
+)
+
+// FirebaseUserPageClientInterface はFirebaseユーザーの一覧取得に使用するクライアントのインターフェースです
+type FirebaseUserPageClientInterface interface {
+	ListUsersPage(ctx context.Context, page_token string, page_size int) ([]*auth.ExportedUserRecord, string, error)
+	GetUsers(ctx context.Context, identifiers []auth.UserIdentifier) (*auth.GetUsersResult, error)
+}
+
+// AuthUserPageClient は*auth.ClientをFirebaseUserPageClientInterfaceに適合させるアダプターです
+type AuthUserPageClient struct {
+	client *auth.Client
+}
+
+// NewAuthUserPageClient は新しいAuthUserPageClientを作成します
+func NewAuthUserPageClient(client *auth.Client) *AuthUserPageClient {
+	return &AuthUserPageClient{
+		client: client,
+	}
+}
+
+// ListUsersPage はページトークンの位置から最大page_size件のFirebaseユーザーと次のページトークンを返します
+// 最後のページでは次のページトークンが空文字になります
+func (c *AuthUserPageClient) ListUsersPage(ctx context.Context, page_token string, page_size int) ([]*auth.ExportedUserRecord, string, error) {
+	var pager *iterator.Pager
+	var users []*auth.ExportedUserRecord
+	var next_page_token string
+	var err error
+
+	pager = iterator.NewPager(c.client.Users(ctx, ""), page_size, page_token)
+	next_page_token, err = pager.NextPage(&users)
+	if err != nil {
+		return nil, "", err
+	}
+	return users, next_page_token, nil
+}
+
+// GetUsers は指定した識別子のFirebaseユーザーをまとめて取得します
+func (c *AuthUserPageClient) GetUsers(ctx context.Context, identifiers []auth.UserIdentifier) (*auth.GetUsersResult, error) {
+	return c.client.GetUsers(ctx, identifiers)
+}
+
+// FirebaseAccountLister はusersテーブルとの整合性の確認のためにFirebaseのアカウントを列挙します
+type FirebaseAccountLister struct {
+	page_client FirebaseUserPageClientInterface
+}
+
+// NewFirebaseAccountLister は新しいFirebaseAccountListerを作成します
+func NewFirebaseAccountLister(page_client FirebaseUserPageClientInterface) *FirebaseAccountLister {
+	return &FirebaseAccountLister{
+		page_client: page_client,
+	}
+}
+
+// ListAccounts はページトークンの位置からFirebaseのアカウントを1ページ分返します
+// 最初のページは空文字を指定し、次のページトークンが空文字になるまで繰り返します
+func (l *FirebaseAccountLister) ListAccounts(ctx context.Context, page_token string) ([]*models.FirebaseAccount, string, error) {
+	var user_records []*auth.ExportedUserRecord
+	var next_page_token string
+	var accounts []*models.FirebaseAccount
+	var err error
+
+	user_records, next_page_token, err = l.page_client.ListUsersPage(ctx, page_token, listUsersPageSize)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	accounts = make([]*models.FirebaseAccount, 0, len(user_records))
+	for _, user_record := range user_records {
+		var account *models.FirebaseAccount
+
+		account, err = convert_user_record_to_account(user_record.UserRecord)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, next_page_token, nil
+}
+
+// FindMissingUIDs は指定したFirebase UIDのうち、Firebaseにアカウントが存在しないものを返します
+func (l *FirebaseAccountLister) FindMissingUIDs(ctx context.Context, firebase_uids []string) ([]string, error) {
+	var missing_uids []string
+
+	missing_uids = []string{}
+	for start := 0; start < len(firebase_uids); start += getUsersMaxBatchSize {
+		var identifiers []auth.UserIdentifier
+		var result *auth.GetUsersResult
+		var err error
+
+		for _, firebase_uid := range firebase_uids[start:min(start+getUsersMaxBatchSize, len(firebase_uids))] {
+			identifiers = append(identifiers, auth.UIDIdentifier{UID: firebase_uid})
+		}
+		result, err = l.page_client.GetUsers(ctx, identifiers)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+		}
+		for _, identifier := range result.NotFound {
+			var uid_identifier auth.UIDIdentifier
+			var is_uid bool
+
+			uid_identifier, is_uid = identifier.(auth.UIDIdentifier)
+			if is_uid {
+				missing_uids = append(missing_uids, uid_identifier.UID)
+			}
+		}
+	}
+	return missing_uids, nil
+}
+
+// convert_user_record_to_account はFirebaseのユーザー情報をFirebaseAccountに変換します
+func convert_user_record_to_account(user_record *auth.UserRecord) (*models.FirebaseAccount, error) {
+	var created_at time.Time
+
+	if user_record.UserMetadata != nil {
+		created_at = time.UnixMilli(user_record.UserMetadata.CreationTimestamp)
+	}
+	return models.NewFirebaseAccount(user_record.UID, user_record.Email, created_at)
+}
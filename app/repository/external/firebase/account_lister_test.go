@@ -0,0 +1,73 @@
+package firebase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestFirebaseAccountLister_ListAccounts はFirebaseのアカウントがFirebaseAccountに変換されることをテストします
+func TestFirebaseAccountLister_ListAccounts(t *testing.T) {
+	var created_at time.Time
+	var lister *FirebaseAccountLister
+	var accounts []*models.FirebaseAccount
+	var next_page_token string
+	var err error
+
+	created_at = time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	lister = NewFirebaseAccountLister(NewMockFirebaseUserPageClient(created_at, "uid_a", "uid_b"))
+	accounts, next_page_token, err = lister.ListAccounts(context.Background(), "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if next_page_token != "" {
+		t.Errorf("expected empty next page token, got %s", next_page_token)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(accounts))
+	}
+	if accounts[0].UID() != "uid_a" || accounts[0].Email() != "uid_a@example.com" {
+		t.Errorf("expected uid_a, got %s (%s)", accounts[0].UID(), accounts[0].Email())
+	}
+	if !accounts[0].CreatedAt().Equal(created_at) {
+		t.Errorf("expected created_at %v, got %v", created_at, accounts[0].CreatedAt())
+	}
+}
+
+// TestFirebaseAccountLister_FindMissingUIDs は存在しないUIDが100件ずつの一括取得で検出されることをテストします
+func TestFirebaseAccountLister_FindMissingUIDs(t *testing.T) {
+	var page_client *MockFirebaseUserPageClient
+	var firebase_uids []string
+	var missing_uids []string
+	var err error
+
+	page_client = NewMockFirebaseUserPageClient(time.Now(), "uid_0", "uid_150")
+	for i := 0; i < 151; i++ {
+		firebase_uids = append(firebase_uids, fmt.Sprintf("uid_%d", i))
+	}
+	missing_uids, err = NewFirebaseAccountLister(page_client).FindMissingUIDs(context.Background(), firebase_uids)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(missing_uids) != 149 {
+		t.Errorf("expected 149 missing uids, got %d", len(missing_uids))
+	}
+	if len(page_client.GetUsersRequestCounts) != 2 || page_client.GetUsersRequestCounts[0] != getUsersMaxBatchSize {
+		t.Errorf("expected requests to be batched by %d, got %v", getUsersMaxBatchSize, page_client.GetUsersRequestCounts)
+	}
+}
+
+// TestFirebaseAccountLister_ListAccounts_Error はFirebaseのエラーをErrFirebaseAuthFailedとして返すことをテストします
+func TestFirebaseAccountLister_ListAccounts_Error(t *testing.T) {
+	var err error
+
+	_, _, err = NewFirebaseAccountLister(NewMockFirebaseUserPageClientWithError()).ListAccounts(context.Background(), "")
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
@@ -48,3 +48,16 @@ func get_auth_client() (*auth.Client, error) {
 	}
 	return auth_client, nil
 }
+
+// NewAuthClient は Firebase Admin SDK を初期化し、Auth クライアントを返します
+func NewAuthClient() (*auth.Client, error) {
+	var err error
+
+	if firebase_app == nil || auth_client == nil {
+		err = initialize_firebase()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return get_auth_client()
+}
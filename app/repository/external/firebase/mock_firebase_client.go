@@ -3,6 +3,7 @@ package firebase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"firebase.google.com/go/v4/auth"
 )
@@ -11,6 +12,17 @@ import (
 type MockFirebaseAuthClient struct {
 	should_return_duplicate_error bool
 	should_return_existing_email  bool
+	should_return_invalid_token   bool
+	should_return_revoke_error    bool
+	email_verified                bool
+	tokens_valid_after            time.Time
+	provider_token                *auth.Token
+	provider_ids                  []string
+	delete_error_message          string
+	update_error_message          string
+	RevokedUIDs                   []string
+	DeletedUIDs                   []string
+	UpdatedUIDs                   []string
 }
 
 // NewMockFirebaseAuthClient は新しいMockFirebaseAuthClientを作成します
@@ -18,6 +30,9 @@ func NewMockFirebaseAuthClient() *MockFirebaseAuthClient {
 	return &MockFirebaseAuthClient{
 		should_return_duplicate_error: false,
 		should_return_existing_email:  false,
+		should_return_invalid_token:   false,
+		should_return_revoke_error:    false,
+		RevokedUIDs:                   []string{},
 	}
 }
 
@@ -26,6 +41,9 @@ func NewMockFirebaseAuthClientWithDuplicateEmail() *MockFirebaseAuthClient {
 	return &MockFirebaseAuthClient{
 		should_return_duplicate_error: true,
 		should_return_existing_email:  false,
+		should_return_invalid_token:   false,
+		should_return_revoke_error:    false,
+		RevokedUIDs:                   []string{},
 	}
 }
 
@@ -34,9 +52,98 @@ func NewMockFirebaseAuthClientWithExistingEmail() *MockFirebaseAuthClient {
 	return &MockFirebaseAuthClient{
 		should_return_duplicate_error: false,
 		should_return_existing_email:  true,
+		should_return_invalid_token:   false,
+		should_return_revoke_error:    false,
+		RevokedUIDs:                   []string{},
 	}
 }
 
+// NewMockFirebaseAuthClientWithInvalidIDToken はIDトークン検証エラーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithInvalidIDToken() *MockFirebaseAuthClient {
+	return &MockFirebaseAuthClient{
+		should_return_duplicate_error: false,
+		should_return_existing_email:  false,
+		should_return_invalid_token:   true,
+		should_return_revoke_error:    false,
+		RevokedUIDs:                   []string{},
+	}
+}
+
+// NewMockFirebaseAuthClientWithRevokeError はリフレッシュトークン失効エラーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithRevokeError() *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.should_return_revoke_error = true
+	return client
+}
+
+// NewMockFirebaseAuthClientWithVerifiedEmail はメールアドレス確認済みのユーザーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithVerifiedEmail() *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.email_verified = true
+	return client
+}
+
+// NewMockFirebaseAuthClientWithTokensValidAfter はパスワード変更日時（トークンの有効起点）を持つモッククライアントを作成します
+func NewMockFirebaseAuthClientWithTokensValidAfter(tokens_valid_after time.Time) *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.tokens_valid_after = tokens_valid_after
+	return client
+}
+
+// NewMockFirebaseAuthClientWithProviderToken は外部プロバイダでサインインしたIDトークンを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithProviderToken(firebase_uid string, provider_id string, subject string, email string) *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.provider_token = &auth.Token{
+		UID: firebase_uid,
+		Firebase: auth.FirebaseInfo{
+			SignInProvider: provider_id,
+			Identities: map[string]any{
+				provider_id: []any{subject},
+			},
+		},
+		Claims: map[string]any{
+			"email":          email,
+			"email_verified": email != "",
+		},
+	}
+	return client
+}
+
+// NewMockFirebaseAuthClientWithProviders は指定されたプロバイダが連携済みのユーザーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithProviders(provider_ids ...string) *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.provider_ids = provider_ids
+	return client
+}
+
+// NewMockFirebaseAuthClientWithDeleteError はユーザー削除で指定したメッセージのエラーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithDeleteError(error_message string) *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.delete_error_message = error_message
+	return client
+}
+
+// NewMockFirebaseAuthClientWithUpdateError はユーザー更新で指定したメッセージのエラーを返すモッククライアントを作成します
+func NewMockFirebaseAuthClientWithUpdateError(error_message string) *MockFirebaseAuthClient {
+	var client *MockFirebaseAuthClient
+
+	client = NewMockFirebaseAuthClient()
+	client.update_error_message = error_message
+	return client
+}
+
 // CreateUser はモックのユーザー作成処理です
 func (m *MockFirebaseAuthClient) CreateUser(ctx context.Context, params *auth.UserToCreate) (*auth.UserRecord, error) {
 	if m.should_return_duplicate_error {
@@ -49,6 +156,23 @@ func (m *MockFirebaseAuthClient) CreateUser(ctx context.Context, params *auth.Us
 	}, nil
 }
 
+// GetUser はモックのUIDでユーザー取得処理です
+func (m *MockFirebaseAuthClient) GetUser(ctx context.Context, uid string) (*auth.UserRecord, error) {
+	var provider_user_info []*auth.UserInfo
+
+	for _, provider_id := range m.provider_ids {
+		provider_user_info = append(provider_user_info, &auth.UserInfo{ProviderID: provider_id})
+	}
+	return &auth.UserRecord{
+		UserInfo: &auth.UserInfo{
+			UID: uid,
+		},
+		EmailVerified:          m.email_verified,
+		ProviderUserInfo:       provider_user_info,
+		TokensValidAfterMillis: m.tokens_valid_after.UnixMilli(),
+	}, nil
+}
+
 // GetUserByEmail はモックのメールでユーザー取得処理です
 func (m *MockFirebaseAuthClient) GetUserByEmail(ctx context.Context, email string) (*auth.UserRecord, error) {
 	if m.should_return_existing_email {
@@ -62,7 +186,71 @@ func (m *MockFirebaseAuthClient) GetUserByEmail(ctx context.Context, email strin
 	return nil, fmt.Errorf("USER_NOT_FOUND")
 }
 
-// DeleteUser はモックのユーザー削除処理です
+// DeleteUser はモックのユーザー削除処理です（削除したUIDを記録します）
 func (m *MockFirebaseAuthClient) DeleteUser(ctx context.Context, uid string) error {
+	if m.delete_error_message != "" {
+		return fmt.Errorf("%s", m.delete_error_message)
+	}
+	m.DeletedUIDs = append(m.DeletedUIDs, uid)
 	return nil
 }
+
+// VerifyIDToken はモックのIDトークン検証処理です
+func (m *MockFirebaseAuthClient) VerifyIDToken(ctx context.Context, id_token string) (*auth.Token, error) {
+	if m.should_return_invalid_token {
+		return nil, fmt.Errorf("ID token has invalid signature")
+	}
+	if m.provider_token != nil {
+		return m.provider_token, nil
+	}
+	return &auth.Token{
+		UID: "mock_firebase_uid_123",
+	}, nil
+}
+
+// RevokeRefreshTokens はモックのリフレッシュトークン失効処理です
+func (m *MockFirebaseAuthClient) RevokeRefreshTokens(ctx context.Context, uid string) error {
+	if m.should_return_revoke_error {
+		return fmt.Errorf("failed to revoke refresh tokens")
+	}
+	m.RevokedUIDs = append(m.RevokedUIDs, uid)
+	return nil
+}
+
+// PasswordResetLink はモックのパスワードリセットリンク生成処理です（登録済みのメールアドレスのみ生成）
+func (m *MockFirebaseAuthClient) PasswordResetLink(ctx context.Context, email string) (string, error) {
+	if !m.should_return_existing_email {
+		return "", fmt.Errorf("EMAIL_NOT_FOUND")
+	}
+	return "https://sleeve.example.com/reset-password?oobCode=mock_oob_code", nil
+}
+
+// EmailVerificationLink はモックのメールアドレス確認リンク生成処理です（登録済みのメールアドレスのみ生成）
+func (m *MockFirebaseAuthClient) EmailVerificationLink(ctx context.Context, email string) (string, error) {
+	if !m.should_return_existing_email {
+		return "", fmt.Errorf("EMAIL_NOT_FOUND")
+	}
+	return "https://sleeve.example.com/verify-email?oobCode=mock_oob_code", nil
+}
+
+// CustomToken はモックのカスタムトークン発行処理です
+func (m *MockFirebaseAuthClient) CustomToken(ctx context.Context, uid string) (string, error) {
+	return "mock_custom_token_" + uid, nil
+}
+
+// UpdateUser はモックのユーザー更新処理です（更新したUIDを記録します）
+// 重複エラーを返すモッククライアントでは、メールアドレスの重複エラーを返します
+func (m *MockFirebaseAuthClient) UpdateUser(ctx context.Context, uid string, params *auth.UserToUpdate) (*auth.UserRecord, error) {
+	if m.should_return_duplicate_error {
+		return nil, fmt.Errorf("EMAIL_EXISTS")
+	}
+	if m.update_error_message != "" {
+		return nil, fmt.Errorf("%s", m.update_error_message)
+	}
+	m.UpdatedUIDs = append(m.UpdatedUIDs, uid)
+	return &auth.UserRecord{
+		UserInfo: &auth.UserInfo{
+			UID: uid,
+		},
+	}, nil
+}
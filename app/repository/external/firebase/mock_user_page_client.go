@@ -0,0 +1,103 @@
+package firebase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+)
+
+// MockFirebaseUserPageClient はテスト用のFirebaseユーザー一覧取得のモッククライアントです
+// ページトークンは次のページの先頭の位置を表す数値の文字列です
+type MockFirebaseUserPageClient struct {
+	user_records          []*auth.ExportedUserRecord
+	should_return_error   bool
+	GetUsersRequestCounts []int
+}
+
+// NewMockFirebaseUserPageClient は指定したUIDのFirebaseユーザーを持つモッククライアントを作成します
+func NewMockFirebaseUserPageClient(created_at time.Time, firebase_uids ...string) *MockFirebaseUserPageClient {
+	var client *MockFirebaseUserPageClient
+
+	client = &MockFirebaseUserPageClient{
+		user_records:          []*auth.ExportedUserRecord{},
+		should_return_error:   false,
+		GetUsersRequestCounts: []int{},
+	}
+	for _, firebase_uid := range firebase_uids {
+		client.user_records = append(client.user_records, &auth.ExportedUserRecord{
+			UserRecord: &auth.UserRecord{
+				UserInfo: &auth.UserInfo{
+					UID:   firebase_uid,
+					Email: firebase_uid + "@example.com",
+				},
+				UserMetadata: &auth.UserMetadata{
+					CreationTimestamp: created_at.UnixMilli(),
+				},
+			},
+		})
+	}
+	return client
+}
+
+// NewMockFirebaseUserPageClientWithError はエラーを返すモッククライアントを作成します
+func NewMockFirebaseUserPageClientWithError() *MockFirebaseUserPageClient {
+	var client *MockFirebaseUserPageClient
+
+	client = NewMockFirebaseUserPageClient(time.Now())
+	client.should_return_error = true
+	return client
+}
+
+// ListUsersPage はモックのFirebaseユーザーの一覧取得処理です
+func (m *MockFirebaseUserPageClient) ListUsersPage(_ context.Context, page_token string, page_size int) ([]*auth.ExportedUserRecord, string, error) {
+	var start int
+	var end int
+	var next_page_token string
+	var err error
+
+	if m.should_return_error {
+		return nil, "", fmt.Errorf("failed to list users")
+	}
+	if page_token != "" {
+		start, err = strconv.Atoi(page_token)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %s", page_token)
+		}
+	}
+	end = min(start+page_size, len(m.user_records))
+	if end < len(m.user_records) {
+		next_page_token = strconv.Itoa(end)
+	}
+	return m.user_records[start:end], next_page_token, nil
+}
+
+// GetUsers はモックのFirebaseユーザーの一括取得処理です（リクエストした件数を記録します）
+func (m *MockFirebaseUserPageClient) GetUsers(_ context.Context, identifiers []auth.UserIdentifier) (*auth.GetUsersResult, error) {
+	var result *auth.GetUsersResult
+
+	if m.should_return_error {
+		return nil, fmt.Errorf("failed to get users")
+	}
+	m.GetUsersRequestCounts = append(m.GetUsersRequestCounts, len(identifiers))
+	result = &auth.GetUsersResult{
+		Users:    []*auth.UserRecord{},
+		NotFound: []auth.UserIdentifier{},
+	}
+	for _, identifier := range identifiers {
+		var index int
+
+		index = slices.IndexFunc(m.user_records, func(user_record *auth.ExportedUserRecord) bool {
+			return auth.UIDIdentifier{UID: user_record.UID} == identifier
+		})
+		if index < 0 {
+			result.NotFound = append(result.NotFound, identifier)
+			continue
+		}
+		result.Users = append(result.Users, m.user_records[index].UserRecord)
+	}
+	return result, nil
+}
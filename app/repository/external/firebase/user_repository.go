@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
@@ -14,10 +15,20 @@ import (
 // FirebaseAuthClientInterface はFirebase Auth Clientのインターフェースです
 type FirebaseAuthClientInterface interface {
 	CreateUser(ctx context.Context, params *auth.UserToCreate) (*auth.UserRecord, error)
+	GetUser(ctx context.Context, uid string) (*auth.UserRecord, error)
 	GetUserByEmail(ctx context.Context, email string) (*auth.UserRecord, error)
 	DeleteUser(ctx context.Context, uid string) error
+	VerifyIDToken(ctx context.Context, id_token string) (*auth.Token, error)
+	RevokeRefreshTokens(ctx context.Context, uid string) error
+	PasswordResetLink(ctx context.Context, email string) (string, error)
+	EmailVerificationLink(ctx context.Context, email string) (string, error)
+	CustomToken(ctx context.Context, uid string) (string, error)
+	UpdateUser(ctx context.Context, uid string, params *auth.UserToUpdate) (*auth.UserRecord, error)
 }
 
+// passwordProviderID はメールアドレス・パスワード認証のFirebaseプロバイダIDです
+const passwordProviderID = "password"
+
 // FirebaseUserRepository はFirebase Authenticationを使用したユーザーリポジトリです
 type FirebaseUserRepository struct {
 	auth_client FirebaseAuthClientInterface
@@ -65,16 +76,268 @@ func (r *FirebaseUserRepository) CheckEmailExists(ctx context.Context, email mod
 }
 
 // DeleteUser はFirebase Authenticationからユーザーを削除します
+// 補償処理の再試行で同じユーザーを繰り返し削除するため、既に存在しない場合も成功とします
 func (r *FirebaseUserRepository) DeleteUser(ctx context.Context, firebase_uid string) error {
 	var err error
 
 	err = r.auth_client.DeleteUser(ctx, firebase_uid)
+	if err != nil {
+		if is_user_not_found_error(err) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return nil
+}
+
+// VerifyIDToken はFirebase IDトークンを検証し、Firebase UIDを返します
+func (r *FirebaseUserRepository) VerifyIDToken(ctx context.Context, id_token string) (string, error) {
+	var token *auth.Token
+	var err error
+
+	if id_token == "" {
+		return "", fmt.Errorf("%w: id token is empty", domain_errors.ErrInvalidIDToken)
+	}
+	token, err = r.auth_client.VerifyIDToken(ctx, id_token)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domain_errors.ErrInvalidIDToken, err)
+	}
+	return token.UID, nil
+}
+
+// RevokeRefreshTokens はFirebaseのリフレッシュトークンを全て失効させます（全端末からのログアウト用）
+func (r *FirebaseUserRepository) RevokeRefreshTokens(ctx context.Context, firebase_uid string) error {
+	var err error
+
+	err = r.auth_client.RevokeRefreshTokens(ctx, firebase_uid)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
 	}
 	return nil
 }
 
+// IsEmailVerified はFirebase上でメールアドレスの確認が完了しているかを返します
+func (r *FirebaseUserRepository) IsEmailVerified(ctx context.Context, firebase_uid string) (bool, error) {
+	var user_record *auth.UserRecord
+	var err error
+
+	user_record, err = r.auth_client.GetUser(ctx, firebase_uid)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return user_record.EmailVerified, nil
+}
+
+// TokensValidAfter はFirebaseで発行済みのトークンが有効となる起点の日時を返します
+// パスワードの変更（リセット）やリフレッシュトークンの失効が行われると、この日時が更新されます
+func (r *FirebaseUserRepository) TokensValidAfter(ctx context.Context, firebase_uid string) (time.Time, error) {
+	var user_record *auth.UserRecord
+	var err error
+
+	user_record, err = r.auth_client.GetUser(ctx, firebase_uid)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return time.UnixMilli(user_record.TokensValidAfterMillis), nil
+}
+
+// GeneratePasswordResetLink はパスワードリセット用のリンクを生成します
+// メールアドレスが登録されていない場合はErrUserNotFoundを返します
+func (r *FirebaseUserRepository) GeneratePasswordResetLink(ctx context.Context, email models.Email) (string, error) {
+	var reset_link string
+	var err error
+
+	reset_link, err = r.auth_client.PasswordResetLink(ctx, email.Value())
+	if err != nil {
+		if is_user_not_found_error(err) {
+			return "", fmt.Errorf("%w: %w", domain_errors.ErrUserNotFound, err)
+		}
+		return "", fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return reset_link, nil
+}
+
+// GenerateEmailVerificationLink はメールアドレス確認用のリンクを生成します
+func (r *FirebaseUserRepository) GenerateEmailVerificationLink(ctx context.Context, email models.Email) (string, error) {
+	var verification_link string
+	var err error
+
+	verification_link, err = r.auth_client.EmailVerificationLink(ctx, email.Value())
+	if err != nil {
+		if is_user_not_found_error(err) {
+			return "", fmt.Errorf("%w: %w", domain_errors.ErrUserNotFound, err)
+		}
+		return "", fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return verification_link, nil
+}
+
+// VerifyProviderIDToken はGoogle・Apple・Xでサインインした Firebase IDトークンを検証し、外部プロバイダのアカウント情報を返します
+// トークンのサインイン方法が指定されたプロバイダと一致しない場合はErrInvalidProviderTokenを返します
+func (r *FirebaseUserRepository) VerifyProviderIDToken(ctx context.Context, id_token string, provider models.AuthProvider) (*models.ProviderIdentity, error) {
+	var token *auth.Token
+	var provider_id string
+	var subject string
+	var email *models.Email
+	var email_verified bool
+	var identity *models.ProviderIdentity
+	var err error
+
+	if !provider.IsFirebaseNative() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUnsupportedProvider, provider)
+	}
+	if id_token == "" {
+		return nil, fmt.Errorf("%w: id token is empty", domain_errors.ErrInvalidProviderToken)
+	}
+	token, err = r.auth_client.VerifyIDToken(ctx, id_token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	provider_id = provider.FirebaseProviderID()
+	subject = extract_provider_subject(token.Firebase.Identities, provider_id)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: token is not linked to %s", domain_errors.ErrInvalidProviderToken, provider_id)
+	}
+	email, email_verified = extract_token_email(token.Claims)
+	identity, err = models.NewProviderIdentity(provider, subject, token.UID, email, email_verified)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	return identity, nil
+}
+
+// CreateCustomToken はFirebaseのカスタムトークンを発行します（LINEなどFirebaseのネイティブプロバイダではないログイン用）
+// クライアントがこのトークンでFirebaseにサインインすると、UIDに対応するFirebaseユーザーが作成されます
+func (r *FirebaseUserRepository) CreateCustomToken(ctx context.Context, firebase_uid string) (string, error) {
+	var custom_token string
+	var err error
+
+	custom_token, err = r.auth_client.CustomToken(ctx, firebase_uid)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return custom_token, nil
+}
+
+// HasPasswordProvider はFirebaseユーザーがメールアドレス・パスワードでログインできるかを返します
+// カスタムトークンでまだサインインしていない（Firebaseユーザーが存在しない）場合はfalseを返します
+func (r *FirebaseUserRepository) HasPasswordProvider(ctx context.Context, firebase_uid string) (bool, error) {
+	var user_record *auth.UserRecord
+	var err error
+
+	user_record, err = r.auth_client.GetUser(ctx, firebase_uid)
+	if err != nil {
+		if is_user_not_found_error(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	for _, provider_info := range user_record.ProviderUserInfo {
+		if provider_info.ProviderID == passwordProviderID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UnlinkProvider はFirebaseユーザーから外部プロバイダの連携を解除します
+// Firebaseのネイティブプロバイダではない場合は何もしません
+func (r *FirebaseUserRepository) UnlinkProvider(ctx context.Context, firebase_uid string, provider models.AuthProvider) error {
+	var params *auth.UserToUpdate
+	var err error
+
+	if !provider.IsFirebaseNative() {
+		return nil
+	}
+	params = (&auth.UserToUpdate{}).ProvidersToDelete([]string{provider.FirebaseProviderID()})
+	_, err = r.auth_client.UpdateUser(ctx, firebase_uid, params)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return nil
+}
+
+// DisableUser はFirebaseユーザーを無効化し、ログインやトークンの更新をできなくします
+// カスタムトークンでサインインする前のLINEのユーザーなど、Firebaseに存在しないユーザーの場合は何もせず成功として扱います
+func (r *FirebaseUserRepository) DisableUser(ctx context.Context, firebase_uid string) error {
+	return r.set_user_disabled(ctx, firebase_uid, true)
+}
+
+// EnableUser は無効化したFirebaseユーザーを再度有効化します
+// Firebaseに存在しないユーザーの場合は何もせず成功として扱います（補償処理の再試行を完了させるため）
+func (r *FirebaseUserRepository) EnableUser(ctx context.Context, firebase_uid string) error {
+	return r.set_user_disabled(ctx, firebase_uid, false)
+}
+
+// UpdateEmail はFirebaseユーザーのメールアドレスと確認状態を更新します
+// 他のFirebaseユーザーが同じメールアドレスを使用している場合はErrDuplicateEmailを返します
+func (r *FirebaseUserRepository) UpdateEmail(ctx context.Context, firebase_uid string, email models.Email, email_verified bool) error {
+	var params *auth.UserToUpdate
+	var err error
+
+	params = (&auth.UserToUpdate{}).
+		Email(email.Value()).
+		EmailVerified(email_verified)
+	_, err = r.auth_client.UpdateUser(ctx, firebase_uid, params)
+	if err != nil {
+		if is_duplicate_email_error(err) {
+			return fmt.Errorf("%w: %s", domain_errors.ErrDuplicateEmail, email.Value())
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return nil
+}
+
+// set_user_disabled はFirebaseユーザーの無効化状態を更新します
+func (r *FirebaseUserRepository) set_user_disabled(ctx context.Context, firebase_uid string, disabled bool) error {
+	var params *auth.UserToUpdate
+	var err error
+
+	params = (&auth.UserToUpdate{}).Disabled(disabled)
+	_, err = r.auth_client.UpdateUser(ctx, firebase_uid, params)
+	if err != nil {
+		if is_user_not_found_error(err) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrFirebaseAuthFailed, err)
+	}
+	return nil
+}
+
+// extract_provider_subject はIDトークンのfirebase.identitiesから外部プロバイダでのユーザー識別子を取り出します
+func extract_provider_subject(identities map[string]any, provider_id string) string {
+	var subjects []any
+	var subject string
+	var is_valid bool
+
+	subjects, is_valid = identities[provider_id].([]any)
+	if !is_valid || len(subjects) == 0 {
+		return ""
+	}
+	subject, _ = subjects[0].(string)
+	return subject
+}
+
+// extract_token_email はIDトークンのクレームからメールアドレスと確認状態を取り出します
+// メールアドレスが含まれない（Xでメールアドレスの提供を許可していないなど）場合はnilを返します
+func extract_token_email(claims map[string]any) (*models.Email, bool) {
+	var raw_email string
+	var email models.Email
+	var email_verified bool
+	var err error
+
+	raw_email, _ = claims["email"].(string)
+	if raw_email == "" {
+		return nil, false
+	}
+	email, err = models.NewEmail(raw_email)
+	if err != nil {
+		return nil, false
+	}
+	email_verified, _ = claims["email_verified"].(bool)
+	return &email, email_verified
+}
+
 // is_duplicate_email_error はFirebaseのメール重複エラーかどうかを判定します
 func is_duplicate_email_error(err error) bool {
 	var error_message string
@@ -90,5 +353,7 @@ func is_user_not_found_error(err error) bool {
 
 	error_message = err.Error()
 	return strings.Contains(error_message, "USER_NOT_FOUND") ||
-		strings.Contains(error_message, "user-not-found")
+		strings.Contains(error_message, "EMAIL_NOT_FOUND") ||
+		strings.Contains(error_message, "user-not-found") ||
+		auth.IsUserNotFound(err)
 }
@@ -2,7 +2,9 @@ package firebase
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
@@ -122,3 +124,387 @@ func TestFirebaseUserRepository_DeleteUser_Success(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 }
+
+// TestFirebaseUserRepository_DeleteUser_NotFound は既に存在しないユーザーの削除を成功として扱うことをテストします
+func TestFirebaseUserRepository_DeleteUser_NotFound(t *testing.T) {
+	var err error
+
+	err = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithDeleteError("USER_NOT_FOUND")).
+		DeleteUser(context.Background(), "firebase_uid_123")
+	if err != nil {
+		t.Errorf("expected no error for missing user, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_DeleteUser_Error はFirebaseの削除エラーをErrFirebaseAuthFailedとして返すことをテストします
+func TestFirebaseUserRepository_DeleteUser_Error(t *testing.T) {
+	var err error
+
+	err = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithDeleteError("internal error")).
+		DeleteUser(context.Background(), "firebase_uid_123")
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_VerifyIDToken_Success はIDトークン検証が成功するケースをテストします
+func TestFirebaseUserRepository_VerifyIDToken_Success(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var firebase_uid string
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClient())
+	firebase_uid, err = repo.VerifyIDToken(ctx, "valid_id_token")
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if firebase_uid != "mock_firebase_uid_123" {
+		t.Errorf("expected firebase_uid mock_firebase_uid_123, got %s", firebase_uid)
+	}
+}
+
+// TestFirebaseUserRepository_VerifyIDToken_Invalid は不正なIDトークンでエラーを返すケースをテストします
+func TestFirebaseUserRepository_VerifyIDToken_Invalid(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithInvalidIDToken())
+	_, err = repo.VerifyIDToken(ctx, "invalid_id_token")
+	if err == nil {
+		t.Error("expected error for invalid id token, got nil")
+	}
+	if !errors.Is(err, domain_errors.ErrInvalidIDToken) {
+		t.Errorf("expected ErrInvalidIDToken, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_VerifyIDToken_Empty は空のIDトークンでエラーを返すケースをテストします
+func TestFirebaseUserRepository_VerifyIDToken_Empty(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClient())
+	_, err = repo.VerifyIDToken(ctx, "")
+	if !errors.Is(err, domain_errors.ErrInvalidIDToken) {
+		t.Errorf("expected ErrInvalidIDToken, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_RevokeRefreshTokens_Success はリフレッシュトークンの失効が成功するケースをテストします
+func TestFirebaseUserRepository_RevokeRefreshTokens_Success(t *testing.T) {
+	var ctx context.Context
+	var client *MockFirebaseAuthClient
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFirebaseAuthClient()
+	repo = NewFirebaseUserRepository(client)
+	err = repo.RevokeRefreshTokens(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if len(client.RevokedUIDs) != 1 || client.RevokedUIDs[0] != "firebase_uid_123" {
+		t.Errorf("expected firebase_uid_123 to be revoked, got %v", client.RevokedUIDs)
+	}
+}
+
+// TestFirebaseUserRepository_RevokeRefreshTokens_Error は失効に失敗した場合にエラーを返すケースをテストします
+func TestFirebaseUserRepository_RevokeRefreshTokens_Error(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithRevokeError())
+	err = repo.RevokeRefreshTokens(ctx, "firebase_uid_123")
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_IsEmailVerified はFirebaseのメールアドレス確認状態を返すケースをテストします
+func TestFirebaseUserRepository_IsEmailVerified(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var verified_repo *FirebaseUserRepository
+	var is_verified bool
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClient())
+	verified_repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithVerifiedEmail())
+	is_verified, err = repo.IsEmailVerified(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if is_verified {
+		t.Error("expected email to be not verified")
+	}
+	is_verified, err = verified_repo.IsEmailVerified(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !is_verified {
+		t.Error("expected email to be verified")
+	}
+}
+
+// TestFirebaseUserRepository_TokensValidAfter はパスワード変更日時を返すケースをテストします
+func TestFirebaseUserRepository_TokensValidAfter(t *testing.T) {
+	var ctx context.Context
+	var changed_at time.Time
+	var repo *FirebaseUserRepository
+	var valid_after time.Time
+	var err error
+
+	ctx = context.Background()
+	changed_at = time.Now().Truncate(time.Millisecond)
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithTokensValidAfter(changed_at))
+	valid_after, err = repo.TokensValidAfter(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !valid_after.Equal(changed_at) {
+		t.Errorf("expected %v, got %v", changed_at, valid_after)
+	}
+}
+
+// TestFirebaseUserRepository_GeneratePasswordResetLink_Success は登録済みのメールアドレスでリンクを生成するケースをテストします
+func TestFirebaseUserRepository_GeneratePasswordResetLink_Success(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var email models.Email
+	var reset_link string
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithExistingEmail())
+	email, _ = models.NewEmail("test@example.com")
+	reset_link, err = repo.GeneratePasswordResetLink(ctx, email)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reset_link == "" {
+		t.Error("expected reset link to be non-empty")
+	}
+}
+
+// TestFirebaseUserRepository_GeneratePasswordResetLink_NotFound は未登録のメールアドレスでErrUserNotFoundを返すケースをテストします
+func TestFirebaseUserRepository_GeneratePasswordResetLink_NotFound(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var email models.Email
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClient())
+	email, _ = models.NewEmail("notfound@example.com")
+	_, err = repo.GeneratePasswordResetLink(ctx, email)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_GenerateEmailVerificationLink_Success はメールアドレス確認リンクを生成するケースをテストします
+func TestFirebaseUserRepository_GenerateEmailVerificationLink_Success(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var email models.Email
+	var verification_link string
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithExistingEmail())
+	email, _ = models.NewEmail("test@example.com")
+	verification_link, err = repo.GenerateEmailVerificationLink(ctx, email)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if verification_link == "" {
+		t.Error("expected verification link to be non-empty")
+	}
+}
+
+// TestFirebaseUserRepository_VerifyProviderIDToken_Success は外部プロバイダのアカウント情報を取り出すケースをテストします
+func TestFirebaseUserRepository_VerifyProviderIDToken_Success(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var identity *models.ProviderIdentity
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithProviderToken("firebase_uid_google", "google.com", "google_sub_123", "google@example.com"))
+	identity, err = repo.VerifyProviderIDToken(ctx, "valid_id_token", models.AuthProviderGoogle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if identity.Subject() != "google_sub_123" {
+		t.Errorf("expected subject google_sub_123, got %s", identity.Subject())
+	}
+	if identity.FirebaseUID() != "firebase_uid_google" {
+		t.Errorf("expected firebase_uid firebase_uid_google, got %s", identity.FirebaseUID())
+	}
+	if identity.Email() == nil || identity.Email().Value() != "google@example.com" || !identity.IsEmailVerified() {
+		t.Errorf("expected verified email google@example.com, got %v", identity.Email())
+	}
+}
+
+// TestFirebaseUserRepository_VerifyProviderIDToken_ProviderMismatch は別のプロバイダでサインインしたトークンを拒否するケースをテストします
+func TestFirebaseUserRepository_VerifyProviderIDToken_ProviderMismatch(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithProviderToken("firebase_uid_google", "google.com", "google_sub_123", ""))
+	_, err = repo.VerifyProviderIDToken(ctx, "valid_id_token", models.AuthProviderApple)
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_VerifyProviderIDToken_LINE はFirebaseのネイティブプロバイダではないLINEを拒否するケースをテストします
+func TestFirebaseUserRepository_VerifyProviderIDToken_LINE(t *testing.T) {
+	var ctx context.Context
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClient())
+	_, err = repo.VerifyProviderIDToken(ctx, "line_id_token", models.AuthProviderLINE)
+	if !errors.Is(err, domain_errors.ErrUnsupportedProvider) {
+		t.Errorf("expected ErrUnsupportedProvider, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_HasPasswordProvider はパスワード認証の有無を判定するケースをテストします
+func TestFirebaseUserRepository_HasPasswordProvider(t *testing.T) {
+	var ctx context.Context
+	var has_password bool
+	var err error
+
+	ctx = context.Background()
+	has_password, err = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithProviders("google.com", "password")).
+		HasPasswordProvider(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !has_password {
+		t.Error("expected password provider to be found")
+	}
+	has_password, err = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithProviders("google.com")).
+		HasPasswordProvider(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if has_password {
+		t.Error("expected password provider to be not found")
+	}
+}
+
+// TestFirebaseUserRepository_UnlinkProvider はネイティブプロバイダのみFirebaseで連携解除するケースをテストします
+func TestFirebaseUserRepository_UnlinkProvider(t *testing.T) {
+	var ctx context.Context
+	var client *MockFirebaseAuthClient
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFirebaseAuthClient()
+	repo = NewFirebaseUserRepository(client)
+	err = repo.UnlinkProvider(ctx, "firebase_uid_123", models.AuthProviderGoogle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = repo.UnlinkProvider(ctx, "line:U123", models.AuthProviderLINE)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.UpdatedUIDs) != 1 || client.UpdatedUIDs[0] != "firebase_uid_123" {
+		t.Errorf("expected only firebase_uid_123 to be updated, got %v", client.UpdatedUIDs)
+	}
+}
+
+// TestFirebaseUserRepository_DisableAndEnableUser はFirebaseユーザーの無効化と再有効化をテストします
+func TestFirebaseUserRepository_DisableAndEnableUser(t *testing.T) {
+	var ctx context.Context
+	var client *MockFirebaseAuthClient
+	var repo *FirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFirebaseAuthClient()
+	repo = NewFirebaseUserRepository(client)
+	err = repo.DisableUser(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = repo.EnableUser(ctx, "firebase_uid_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.UpdatedUIDs) != 2 {
+		t.Errorf("expected 2 updates, got %v", client.UpdatedUIDs)
+	}
+}
+
+// TestFirebaseUserRepository_DisableUser_NotFound はFirebaseに存在しないユーザー（サインイン前のLINEのユーザーなど）の無効化を成功として扱うことをテストします
+func TestFirebaseUserRepository_DisableUser_NotFound(t *testing.T) {
+	var repo *FirebaseUserRepository
+	var err error
+
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithUpdateError("USER_NOT_FOUND"))
+	err = repo.DisableUser(context.Background(), "line:U1234567890")
+	if err != nil {
+		t.Errorf("expected no error for missing user, got %v", err)
+	}
+	err = repo.EnableUser(context.Background(), "line:U1234567890")
+	if err != nil {
+		t.Errorf("expected no error for missing user, got %v", err)
+	}
+	err = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithUpdateError("internal error")).
+		DisableUser(context.Background(), "firebase_uid_123")
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
+
+// TestFirebaseUserRepository_UpdateEmail はFirebaseユーザーのメールアドレスの更新をテストします
+func TestFirebaseUserRepository_UpdateEmail(t *testing.T) {
+	var client *MockFirebaseAuthClient
+	var repo *FirebaseUserRepository
+	var email models.Email
+	var err error
+
+	client = NewMockFirebaseAuthClient()
+	repo = NewFirebaseUserRepository(client)
+	email, _ = models.NewEmail("new@example.com")
+	err = repo.UpdateEmail(context.Background(), "firebase_uid_123", email, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.UpdatedUIDs) != 1 || client.UpdatedUIDs[0] != "firebase_uid_123" {
+		t.Errorf("expected firebase_uid_123 to be updated, got %v", client.UpdatedUIDs)
+	}
+}
+
+// TestFirebaseUserRepository_UpdateEmail_Duplicate はメールアドレスの重複がErrDuplicateEmailになることをテストします
+func TestFirebaseUserRepository_UpdateEmail_Duplicate(t *testing.T) {
+	var repo *FirebaseUserRepository
+	var email models.Email
+	var err error
+
+	repo = NewFirebaseUserRepository(NewMockFirebaseAuthClientWithDuplicateEmail())
+	email, _ = models.NewEmail("taken@example.com")
+	err = repo.UpdateEmail(context.Background(), "firebase_uid_123", email, true)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+}
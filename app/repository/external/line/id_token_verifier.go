@@ -0,0 +1,243 @@
+package line
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LINE Loginの公開鍵（JWKS）のURLと、IDトークンの発行者
+const (
+	DefaultJWKSURL = "https://api.line.me/oauth2/v2.1/certs"
+	idTokenIssuer  = "https://access.line.me"
+)
+
+// jwksCacheTTL は取得したJWKSをキャッシュする期間です（未知のkidを受け取った場合は期間内でも再取得します）
+const jwksCacheTTL = time.Hour
+
+// jwksMinRefetchInterval はJWKSを再取得する最短の間隔です
+// 任意のkidを指定したIDトークンを大量に送信されても、LINEへのリクエストがこの間隔より頻繁にならないようにします
+const jwksMinRefetchInterval = time.Minute
+
+// jwksFetchTimeout はJWKSの取得のタイムアウトです
+const jwksFetchTimeout = 10 * time.Second
+
+// json_web_key はLINEのJWKSに含まれるES256の公開鍵です
+type json_web_key struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// json_web_key_set はLINEのJWKSです
+type json_web_key_set struct {
+	Keys []json_web_key `json:"keys"`
+}
+
+// id_token_claims はLINEのIDトークンのクレームです
+type id_token_claims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// LINEIDTokenVerifier はLINE LoginのIDトークンをJWKSの公開鍵で検証します
+// JWKSのURLを差し替えられるため、テストではローカルのJWKSサーバーで検証できます
+type LINEIDTokenVerifier struct {
+	jwks_url     string
+	channel_id   string
+	http_client  *http.Client
+	mutex        sync.Mutex
+	keys         map[string]*ecdsa.PublicKey
+	fetched_at   time.Time
+	attempted_at time.Time
+	fetching     chan struct{}
+}
+
+// NewLINEIDTokenVerifier は新しいLINEIDTokenVerifierを作成します
+// channel_idはIDトークンのaud（LINEログインチャネルのチャネルID）として検証します
+func NewLINEIDTokenVerifier(jwks_url string, channel_id string) *LINEIDTokenVerifier {
+	return &LINEIDTokenVerifier{
+		jwks_url:    jwks_url,
+		channel_id:  channel_id,
+		http_client: &http.Client{Timeout: jwksFetchTimeout},
+		keys:        map[string]*ecdsa.PublicKey{},
+	}
+}
+
+// VerifyIDToken はLINEのIDトークンを検証し、LINEアカウントの情報を返します
+// LINEはメールアドレスの確認を済ませたアカウントのメールアドレスのみ提供するため、メールアドレスは確認済みとして扱います
+func (v *LINEIDTokenVerifier) VerifyIDToken(ctx context.Context, id_token string) (*models.ProviderIdentity, error) {
+	var claims *id_token_claims
+	var email *models.Email
+	var parsed_email models.Email
+	var identity *models.ProviderIdentity
+	var err error
+
+	if id_token == "" {
+		return nil, fmt.Errorf("%w: id token is empty", domain_errors.ErrInvalidProviderToken)
+	}
+	claims = &id_token_claims{}
+	_, err = jwt.ParseWithClaims(
+		id_token,
+		claims,
+		func(token *jwt.Token) (any, error) {
+			var kid string
+
+			kid, _ = token.Header["kid"].(string)
+			return v.public_key(ctx, kid)
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(idTokenIssuer),
+		jwt.WithAudience(v.channel_id),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	if claims.Email != "" {
+		parsed_email, err = models.NewEmail(claims.Email)
+		if err == nil {
+			email = &parsed_email
+		}
+	}
+	identity, err = models.NewProviderIdentity(models.AuthProviderLINE, claims.Subject, "", email, email != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	return identity, nil
+}
+
+// public_key はkidに対応する公開鍵を返します
+// キャッシュが期限切れ、またはkidが未知の場合はJWKSを再取得します（鍵のローテーション対応）
+// 再取得は前回の取得からjwksMinRefetchInterval以上経過した場合のみ行い、ロックの外で1件ずつ実行します
+// 他のリクエストが取得中の場合は、その完了を待ってからキャッシュを参照し直します
+func (v *LINEIDTokenVerifier) public_key(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+	var key *ecdsa.PublicKey
+	var is_found bool
+	var fetching chan struct{}
+	var keys map[string]*ecdsa.PublicKey
+	var err error
+
+	for {
+		v.mutex.Lock()
+		key, is_found = v.keys[kid]
+		if is_found && time.Since(v.fetched_at) < jwksCacheTTL {
+			v.mutex.Unlock()
+			return key, nil
+		}
+		if v.fetching == nil {
+			break
+		}
+		fetching = v.fetching
+		v.mutex.Unlock()
+		select {
+		case <-fetching:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w", ctx.Err())
+		}
+	}
+	if time.Since(v.attempted_at) < jwksMinRefetchInterval {
+		v.mutex.Unlock()
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	fetching = make(chan struct{})
+	v.fetching = fetching
+	v.attempted_at = time.Now()
+	v.mutex.Unlock()
+
+	// 待機中の他のリクエストにも結果を使うため、リクエストのキャンセルでは取得を中断しない（http_clientのタイムアウトで打ち切る）
+	keys, err = v.fetch_keys(context.WithoutCancel(ctx))
+
+	v.mutex.Lock()
+	if err == nil {
+		v.keys = keys
+		v.fetched_at = time.Now()
+	}
+	v.fetching = nil
+	close(fetching)
+	key, is_found = v.keys[kid]
+	v.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if !is_found {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	return key, nil
+}
+
+// fetch_keys はJWKSを取得し、kidごとの公開鍵を返します
+func (v *LINEIDTokenVerifier) fetch_keys(ctx context.Context) (map[string]*ecdsa.PublicKey, error) {
+	var request *http.Request
+	var response *http.Response
+	var key_set json_web_key_set
+	var keys map[string]*ecdsa.PublicKey
+	var key *ecdsa.PublicKey
+	var err error
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodGet, v.jwks_url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwks request: %w", err)
+	}
+	response, err = v.http_client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: status %d", response.StatusCode)
+	}
+	err = json.NewDecoder(response.Body).Decode(&key_set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+	keys = map[string]*ecdsa.PublicKey{}
+	for _, json_key := range key_set.Keys {
+		key, err = parse_ec_public_key(json_key)
+		if err != nil {
+			// ES256以外の鍵は検証に使わないため読み飛ばす
+			continue
+		}
+		keys[json_key.KeyID] = key
+	}
+	return keys, nil
+}
+
+// parse_ec_public_key はJWKのP-256公開鍵をecdsa.PublicKeyに変換します
+func parse_ec_public_key(json_key json_web_key) (*ecdsa.PublicKey, error) {
+	var x_bytes []byte
+	var y_bytes []byte
+	var key *ecdsa.PublicKey
+	var err error
+
+	if json_key.KeyType != "EC" || json_key.Curve != "P-256" {
+		return nil, fmt.Errorf("unsupported key type %s/%s: kid=%s", json_key.KeyType, json_key.Curve, json_key.KeyID)
+	}
+	x_bytes, err = base64.RawURLEncoding.DecodeString(json_key.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: kid=%s: %w", json_key.KeyID, err)
+	}
+	y_bytes, err = base64.RawURLEncoding.DecodeString(json_key.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: kid=%s: %w", json_key.KeyID, err)
+	}
+	// 非圧縮形式（0x04 || X || Y）に変換して曲線上の点であることを検証する
+	key, err = ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x_bytes...), y_bytes...))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: kid=%s: %w", json_key.KeyID, err)
+	}
+	return key, nil
+}
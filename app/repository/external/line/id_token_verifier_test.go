@@ -0,0 +1,221 @@
+package line
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testChannelID はテスト用のLINEログインチャネルIDです
+const testChannelID = "1234567890"
+
+// setup_mock_jwks_server はテスト用のJWKSサーバーを起動します
+func setup_mock_jwks_server(t *testing.T) *MockJWKSServer {
+	var server *MockJWKSServer
+	var err error
+
+	t.Helper()
+	server, err = NewMockJWKSServer()
+	if err != nil {
+		t.Fatalf("failed to start jwks server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_Success はローカルのJWKSサーバーの鍵でIDトークンを検証するケースをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_Success(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var id_token string
+	var identity *models.ProviderIdentity
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	id_token, err = server.SignIDToken(testChannelID, "U1234567890", "line@example.com", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	identity, err = NewLINEIDTokenVerifier(server.URL(), testChannelID).VerifyIDToken(ctx, id_token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if identity.Provider() != models.AuthProviderLINE {
+		t.Errorf("expected provider line, got %s", identity.Provider())
+	}
+	if identity.Subject() != "U1234567890" {
+		t.Errorf("expected subject U1234567890, got %s", identity.Subject())
+	}
+	if identity.FirebaseUID() != "line:U1234567890" {
+		t.Errorf("expected firebase_uid line:U1234567890, got %s", identity.FirebaseUID())
+	}
+	if identity.Email() == nil || identity.Email().Value() != "line@example.com" {
+		t.Errorf("expected email line@example.com, got %v", identity.Email())
+	}
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_WrongAudience は別のチャネル向けのIDトークンを拒否するケースをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_WrongAudience(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var id_token string
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	id_token, err = server.SignIDToken("other_channel", "U1234567890", "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	_, err = NewLINEIDTokenVerifier(server.URL(), testChannelID).VerifyIDToken(ctx, id_token)
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_Expired は期限切れのIDトークンを拒否するケースをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_Expired(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var id_token string
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	id_token, err = server.SignIDToken(testChannelID, "U1234567890", "", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	_, err = NewLINEIDTokenVerifier(server.URL(), testChannelID).VerifyIDToken(ctx, id_token)
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_UnknownSigner はJWKSに含まれない鍵で署名されたIDトークンを拒否するケースをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_UnknownSigner(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var other_server *MockJWKSServer
+	var id_token string
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	other_server = setup_mock_jwks_server(t)
+	id_token, err = other_server.SignIDToken(testChannelID, "U1234567890", "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	_, err = NewLINEIDTokenVerifier(server.URL(), testChannelID).VerifyIDToken(ctx, id_token)
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+}
+
+// sign_id_token_with_key_id はモックJWKSサーバーの鍵で、指定したkidのIDトークンを署名します
+func sign_id_token_with_key_id(t *testing.T, server *MockJWKSServer, kid string) string {
+	var token *jwt.Token
+	var id_token string
+	var err error
+
+	t.Helper()
+	token = jwt.NewWithClaims(jwt.SigningMethodES256, id_token_claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    idTokenIssuer,
+			Subject:   "U1234567890",
+			Audience:  jwt.ClaimStrings{testChannelID},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	token.Header["kid"] = kid
+	id_token, err = token.SignedString(server.private_key)
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	return id_token
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_UnknownKeyIDThrottled は未知のkidのIDトークンを連続して受け取っても、最短の間隔内はJWKSを再取得しないことをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_UnknownKeyIDThrottled(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var verifier *LINEIDTokenVerifier
+	var id_token string
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	verifier = NewLINEIDTokenVerifier(server.URL(), testChannelID)
+	id_token, err = server.SignIDToken(testChannelID, "U1234567890", "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	_, err = verifier.VerifyIDToken(ctx, id_token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, kid := range []string{"unknown_kid_1", "unknown_kid_2", "unknown_kid_3"} {
+		_, err = verifier.VerifyIDToken(ctx, sign_id_token_with_key_id(t, server, kid))
+		if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+			t.Errorf("expected ErrInvalidProviderToken for %s, got %v", kid, err)
+		}
+	}
+	if server.RequestCount() != 1 {
+		t.Errorf("expected jwks to be fetched once, got %d", server.RequestCount())
+	}
+
+	// 最短の間隔を過ぎた場合は未知のkidでJWKSを再取得する
+	verifier.attempted_at = time.Now().Add(-jwksMinRefetchInterval)
+	_, err = verifier.VerifyIDToken(ctx, sign_id_token_with_key_id(t, server, "unknown_kid_4"))
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+	if server.RequestCount() != 2 {
+		t.Errorf("expected jwks to be fetched twice, got %d", server.RequestCount())
+	}
+}
+
+// TestLINEIDTokenVerifier_VerifyIDToken_ConcurrentFetch は同時に検証したIDトークンがJWKSの1回の取得を共有することをテストします
+func TestLINEIDTokenVerifier_VerifyIDToken_ConcurrentFetch(t *testing.T) {
+	var ctx context.Context
+	var server *MockJWKSServer
+	var verifier *LINEIDTokenVerifier
+	var id_token string
+	var wait_group sync.WaitGroup
+	var err error
+
+	ctx = context.Background()
+	server = setup_mock_jwks_server(t)
+	verifier = NewLINEIDTokenVerifier(server.URL(), testChannelID)
+	id_token, err = server.SignIDToken(testChannelID, "U1234567890", "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+
+	for range 10 {
+		wait_group.Add(1)
+		go func() {
+			var verify_err error
+
+			defer wait_group.Done()
+			_, verify_err = verifier.VerifyIDToken(ctx, id_token)
+			if verify_err != nil {
+				t.Errorf("expected no error, got %v", verify_err)
+			}
+		}()
+	}
+	wait_group.Wait()
+	if server.RequestCount() != 1 {
+		t.Errorf("expected jwks to be fetched once, got %d", server.RequestCount())
+	}
+}
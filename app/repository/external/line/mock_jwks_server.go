@@ -0,0 +1,98 @@
+package line
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockJWKSKeyID はモックJWKSサーバーの署名鍵のkidです
+const mockJWKSKeyID = "mock_line_kid"
+
+// MockJWKSServer はテスト用にLINEのJWKSを配信するローカルサーバーです
+// 配信している公開鍵に対応する秘密鍵で、LINEのIDトークンと同じ形式のトークンを署名できます
+type MockJWKSServer struct {
+	server        *httptest.Server
+	private_key   *ecdsa.PrivateKey
+	request_count atomic.Int32
+}
+
+// NewMockJWKSServer は新しいMockJWKSServerを起動します（使い終わったらCloseを呼び出してください）
+func NewMockJWKSServer() (*MockJWKSServer, error) {
+	var mock_server *MockJWKSServer
+	var private_key *ecdsa.PrivateKey
+	var err error
+
+	private_key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+	mock_server = &MockJWKSServer{private_key: private_key}
+	mock_server.server = httptest.NewServer(http.HandlerFunc(mock_server.serve_jwks))
+	return mock_server, nil
+}
+
+// URL はJWKSのURLを返します
+func (m *MockJWKSServer) URL() string {
+	return m.server.URL
+}
+
+// Close はサーバーを停止します
+func (m *MockJWKSServer) Close() {
+	m.server.Close()
+}
+
+// RequestCount はJWKSが取得された回数を返します
+func (m *MockJWKSServer) RequestCount() int {
+	return int(m.request_count.Load())
+}
+
+// SignIDToken はLINEのIDトークンと同じ形式のES256トークンを署名します
+func (m *MockJWKSServer) SignIDToken(channel_id string, subject string, email string, expires_at time.Time) (string, error) {
+	var token *jwt.Token
+
+	token = jwt.NewWithClaims(jwt.SigningMethodES256, id_token_claims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    idTokenIssuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{channel_id},
+			ExpiresAt: jwt.NewNumericDate(expires_at),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+	token.Header["kid"] = mockJWKSKeyID
+	return token.SignedString(m.private_key)
+}
+
+// serve_jwks は公開鍵をJWKS形式で返します
+func (m *MockJWKSServer) serve_jwks(writer http.ResponseWriter, _ *http.Request) {
+	var public_key_bytes []byte
+	var key_set json_web_key_set
+
+	m.request_count.Add(1)
+	// 非圧縮形式（0x04 || X || Y）からX・Y座標を取り出す
+	public_key_bytes, _ = m.private_key.PublicKey.Bytes()
+	key_set = json_web_key_set{
+		Keys: []json_web_key{
+			{
+				KeyType: "EC",
+				KeyID:   mockJWKSKeyID,
+				Curve:   "P-256",
+				X:       base64.RawURLEncoding.EncodeToString(public_key_bytes[1:33]),
+				Y:       base64.RawURLEncoding.EncodeToString(public_key_bytes[33:]),
+			},
+		},
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(key_set)
+}
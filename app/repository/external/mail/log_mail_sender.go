@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// LogMailSender はメールを送信せずにログへ記録するメール送信サービスです（SMTP未設定のローカル開発用）
+// リンクは認証情報と同等のため、ログには出力しません
+type LogMailSender struct{}
+
+// NewLogMailSender は新しいLogMailSenderを作成します
+func NewLogMailSender() *LogMailSender {
+	return &LogMailSender{}
+}
+
+// SendPasswordResetMail はパスワード再設定メールの送信をログに記録します
+func (s *LogMailSender) SendPasswordResetMail(ctx context.Context, email models.Email, reset_link string) error {
+	log.Printf("SMTPが未設定のため、パスワード再設定メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
+
+// SendEmailVerificationMail はメールアドレス確認メールの送信をログに記録します
+func (s *LogMailSender) SendEmailVerificationMail(ctx context.Context, email models.Email, verification_link string) error {
+	log.Printf("SMTPが未設定のため、メールアドレス確認メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
+
+// SendAccountDeletionMail はアカウント削除受付メールの送信をログに記録します（取り消しコードは出力しません）
+func (s *LogMailSender) SendAccountDeletionMail(ctx context.Context, email models.Email, cancellation_token string, purge_at time.Time) error {
+	log.Printf("SMTPが未設定のため、アカウント削除受付メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
+
+// SendDataExportReadyMail はデータのエクスポート完了メールの送信をログに記録します（ダウンロードリンクは出力しません）
+func (s *LogMailSender) SendDataExportReadyMail(ctx context.Context, email models.Email, download_link string, expires_at time.Time) error {
+	log.Printf("SMTPが未設定のため、データのエクスポート完了メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
+
+// SendEmailChangeConfirmationMail はメールアドレスの変更の確認メールの送信をログに記録します（確認リンクは出力しません）
+func (s *LogMailSender) SendEmailChangeConfirmationMail(ctx context.Context, email models.Email, confirmation_link string, expires_at time.Time) error {
+	log.Printf("SMTPが未設定のため、メールアドレスの変更の確認メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
+
+// SendEmailChangeNoticeMail はメールアドレスの変更の通知メールの送信をログに記録します
+func (s *LogMailSender) SendEmailChangeNoticeMail(ctx context.Context, email models.Email, requested_at time.Time) error {
+	log.Printf("SMTPが未設定のため、メールアドレスの変更の通知メールの送信をスキップしました: to=%s", email.Value())
+	return nil
+}
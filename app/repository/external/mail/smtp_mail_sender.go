@@ -0,0 +1,126 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// パスワードリセットメール・メールアドレス確認メール・アカウント削除受付メールなどの件名と本文
+const (
+	passwordResetSubject  = "【SLEEVE】パスワード再設定のご案内"
+	passwordResetBodyText = "SLEEVEをご利用いただきありがとうございます。\r\n\r\n" +
+		"以下のリンクからパスワードを再設定してください。\r\n%s\r\n\r\n" +
+		"このメールに心当たりがない場合は、破棄していただいて問題ありません。\r\n"
+	emailVerificationSubject  = "【SLEEVE】メールアドレス確認のお願い"
+	emailVerificationBodyText = "SLEEVEにご登録いただきありがとうございます。\r\n\r\n" +
+		"以下のリンクからメールアドレスの確認を完了してください。\r\n%s\r\n\r\n" +
+		"このメールに心当たりがない場合は、破棄していただいて問題ありません。\r\n"
+	accountDeletionSubject  = "【SLEEVE】アカウント削除を受け付けました"
+	accountDeletionBodyText = "SLEEVEをご利用いただきありがとうございました。\r\n\r\n" +
+		"アカウントの削除を受け付けました。%s 以降にアカウントとデータは完全に削除されます。\r\n\r\n" +
+		"それまでの間は、以下の取り消しコードで削除を取り消すことができます。\r\n%s\r\n\r\n" +
+		"この操作に心当たりがない場合は、速やかに取り消しを行ってください。\r\n"
+	dataExportReadySubject  = "【SLEEVE】データのエクスポートが完了しました"
+	dataExportReadyBodyText = "SLEEVEをご利用いただきありがとうございます。\r\n\r\n" +
+		"ご依頼いただいたデータのエクスポートが完了しました。以下のリンクからZIPファイルをダウンロードしてください。\r\n%s\r\n\r\n" +
+		"このリンクは %s まで有効です。リンクを知っている人は誰でもダウンロードできるため、他の人と共有しないでください。\r\n"
+	emailChangeConfirmationSubject  = "【SLEEVE】メールアドレス変更の確認のお願い"
+	emailChangeConfirmationBodyText = "SLEEVEをご利用いただきありがとうございます。\r\n\r\n" +
+		"このメールアドレスへの変更を受け付けました。以下のリンクから変更を確定してください。\r\n%s\r\n\r\n" +
+		"このリンクは %s まで有効です。このメールに心当たりがない場合は、破棄していただいて問題ありません。\r\n"
+	emailChangeNoticeSubject  = "【SLEEVE】メールアドレスの変更が要求されました"
+	emailChangeNoticeBodyText = "SLEEVEをご利用いただきありがとうございます。\r\n\r\n" +
+		"%s に、お客様のアカウントのメールアドレスを別のアドレスへ変更する操作が行われました。\r\n" +
+		"変更後のメールアドレスで確認が完了すると、このメールアドレスではログインできなくなります。\r\n\r\n" +
+		"この操作に心当たりがない場合は、速やかにパスワードを変更してください。\r\n"
+)
+
+// SMTPConfig はSMTPサーバーの接続設定です
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// send_mail_func はメールを送信する関数の型です（smtp.SendMailと同じシグネチャ）
+type send_mail_func func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+
+// SMTPMailSender はSMTPでメールを送信するメール送信サービスです
+type SMTPMailSender struct {
+	config    SMTPConfig
+	send_mail send_mail_func
+}
+
+// NewSMTPMailSender は新しいSMTPMailSenderを作成します
+func NewSMTPMailSender(config SMTPConfig) *SMTPMailSender {
+	return &SMTPMailSender{
+		config:    config,
+		send_mail: smtp.SendMail,
+	}
+}
+
+// SendPasswordResetMail はパスワード再設定リンクをメールで送信します
+func (s *SMTPMailSender) SendPasswordResetMail(ctx context.Context, email models.Email, reset_link string) error {
+	return s.send(email, passwordResetSubject, fmt.Sprintf(passwordResetBodyText, reset_link))
+}
+
+// SendEmailVerificationMail はメールアドレス確認リンクをメールで送信します
+func (s *SMTPMailSender) SendEmailVerificationMail(ctx context.Context, email models.Email, verification_link string) error {
+	return s.send(email, emailVerificationSubject, fmt.Sprintf(emailVerificationBodyText, verification_link))
+}
+
+// SendAccountDeletionMail はアカウント削除の受付と削除を取り消すためのコードをメールで送信します
+func (s *SMTPMailSender) SendAccountDeletionMail(ctx context.Context, email models.Email, cancellation_token string, purge_at time.Time) error {
+	return s.send(email, accountDeletionSubject, fmt.Sprintf(accountDeletionBodyText, purge_at.UTC().Format(time.RFC3339), cancellation_token))
+}
+
+// SendDataExportReadyMail はデータのエクスポートの完了とダウンロードリンクをメールで送信します
+func (s *SMTPMailSender) SendDataExportReadyMail(ctx context.Context, email models.Email, download_link string, expires_at time.Time) error {
+	return s.send(email, dataExportReadySubject, fmt.Sprintf(dataExportReadyBodyText, download_link, expires_at.UTC().Format(time.RFC3339)))
+}
+
+// SendEmailChangeConfirmationMail はメールアドレスの変更の確認リンクを変更後のメールアドレスに送信します
+func (s *SMTPMailSender) SendEmailChangeConfirmationMail(ctx context.Context, email models.Email, confirmation_link string, expires_at time.Time) error {
+	return s.send(email, emailChangeConfirmationSubject, fmt.Sprintf(emailChangeConfirmationBodyText, confirmation_link, expires_at.UTC().Format(time.RFC3339)))
+}
+
+// SendEmailChangeNoticeMail はメールアドレスの変更が要求されたことを変更前のメールアドレスに通知します
+func (s *SMTPMailSender) SendEmailChangeNoticeMail(ctx context.Context, email models.Email, requested_at time.Time) error {
+	return s.send(email, emailChangeNoticeSubject, fmt.Sprintf(emailChangeNoticeBodyText, requested_at.UTC().Format(time.RFC3339)))
+}
+
+// send はテキスト形式のメールを送信します
+func (s *SMTPMailSender) send(email models.Email, subject string, body string) error {
+	var auth smtp.Auth
+	var message string
+	var err error
+
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+	message = strings.Join([]string{
+		"From: " + s.config.From,
+		"To: " + email.Value(),
+		"Subject: " + mime.BEncoding.Encode("UTF-8", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"Content-Transfer-Encoding: 8bit",
+		"",
+		body,
+	}, "\r\n")
+	err = s.send_mail(net.JoinHostPort(s.config.Host, s.config.Port), auth, s.config.From, []string{email.Value()}, []byte(message))
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrMailSendFailed, err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// テスト用定数
+const (
+	testResetLink = "https://sleeve.example.com/reset-password?oobCode=test"
+	testFrom      = "no-reply@sleeve.example.com"
+)
+
+// TestSMTPMailSender_SendPasswordResetMail_Success はパスワード再設定メールが宛先とリンクを含めて送信されることをテストします
+func TestSMTPMailSender_SendPasswordResetMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", Username: "user", Password: "pass", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("test@example.com")
+	err = sender.SendPasswordResetMail(context.Background(), email, testResetLink)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.Addr != "smtp.example.com:587" {
+		t.Errorf("expected addr smtp.example.com:587, got %s", client.Addr)
+	}
+	if len(client.To) != 1 || client.To[0] != "test@example.com" {
+		t.Errorf("expected recipient test@example.com, got %v", client.To)
+	}
+	if !strings.Contains(client.Message, testResetLink) {
+		t.Error("expected message to contain reset link")
+	}
+	if !strings.Contains(client.Message, "From: "+testFrom) {
+		t.Error("expected message to contain from header")
+	}
+}
+
+// TestSMTPMailSender_SendPasswordResetMail_Error は送信失敗時にErrMailSendFailedを返すことをテストします
+func TestSMTPMailSender_SendPasswordResetMail_Error(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var err error
+
+	client = NewMockSMTPClientWithError()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("test@example.com")
+	err = sender.SendPasswordResetMail(context.Background(), email, testResetLink)
+	if !errors.Is(err, domain_errors.ErrMailSendFailed) {
+		t.Errorf("expected ErrMailSendFailed, got %v", err)
+	}
+}
+
+// TestSMTPMailSender_SendEmailVerificationMail_Success はメールアドレス確認メールが確認リンクを含めて送信されることをテストします
+func TestSMTPMailSender_SendEmailVerificationMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var verification_link string
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("test@example.com")
+	verification_link = "https://sleeve.example.com/verify-email?oobCode=test"
+	err = sender.SendEmailVerificationMail(context.Background(), email, verification_link)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(client.Message, verification_link) {
+		t.Error("expected message to contain verification link")
+	}
+}
+
+// TestSMTPMailSender_SendAccountDeletionMail_Success はアカウント削除受付メールが取り消しコードを含めて送信されることをテストします
+func TestSMTPMailSender_SendAccountDeletionMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var cancellation_token string
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("test@example.com")
+	cancellation_token = "cancellation-token"
+	err = sender.SendAccountDeletionMail(context.Background(), email, cancellation_token, time.Date(2026, 11, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(client.Message, cancellation_token) || !strings.Contains(client.Message, "2026-11-17T00:00:00Z") {
+		t.Error("expected message to contain cancellation token and purge time")
+	}
+}
+
+// TestSMTPMailSender_SendDataExportReadyMail_Success はエクスポート完了メールがダウンロードリンクと有効期限を含めて送信されることをテストします
+func TestSMTPMailSender_SendDataExportReadyMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var download_link string
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("test@example.com")
+	download_link = "https://example.com/data-exports/export-id?token=download-token"
+	err = sender.SendDataExportReadyMail(context.Background(), email, download_link, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(client.Message, download_link) || !strings.Contains(client.Message, "2026-10-25T00:00:00Z") {
+		t.Error("expected message to contain download link and expiration time")
+	}
+}
+
+// TestSMTPMailSender_SendEmailChangeConfirmationMail_Success はメールアドレスの変更の確認メールが確認リンクを含めて変更後のアドレスに送信されることをテストします
+func TestSMTPMailSender_SendEmailChangeConfirmationMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var confirmation_link string
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("new@example.com")
+	confirmation_link = "https://example.com/email-change/confirm?token=confirmation-token"
+	err = sender.SendEmailChangeConfirmationMail(context.Background(), email, confirmation_link, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.To) != 1 || client.To[0] != "new@example.com" {
+		t.Errorf("expected recipient new@example.com, got %v", client.To)
+	}
+	if !strings.Contains(client.Message, confirmation_link) || !strings.Contains(client.Message, "2026-10-20T00:00:00Z") {
+		t.Error("expected message to contain confirmation link and expiration time")
+	}
+}
+
+// TestSMTPMailSender_SendEmailChangeNoticeMail_Success はメールアドレスの変更の通知メールが変更前のアドレスに送信されることをテストします
+func TestSMTPMailSender_SendEmailChangeNoticeMail_Success(t *testing.T) {
+	var sender *SMTPMailSender
+	var email models.Email
+	var client *MockSMTPClient
+	var err error
+
+	client = NewMockSMTPClient()
+	sender = NewSMTPMailSender(SMTPConfig{Host: "smtp.example.com", Port: "587", From: testFrom})
+	sender.send_mail = client.SendMail
+	email, _ = models.NewEmail("old@example.com")
+	err = sender.SendEmailChangeNoticeMail(context.Background(), email, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.To) != 1 || client.To[0] != "old@example.com" {
+		t.Errorf("expected recipient old@example.com, got %v", client.To)
+	}
+	if !strings.Contains(client.Message, "2026-10-19T00:00:00Z") {
+		t.Error("expected message to contain requested time")
+	}
+}
+
+// MockSMTPClient は送信内容を記録するSMTPのモックです
+type MockSMTPClient struct {
+	should_return_error bool
+	Addr                string
+	To                  []string
+	Message             string
+}
+
+// NewMockSMTPClient は新しいMockSMTPClientを作成します
+func NewMockSMTPClient() *MockSMTPClient {
+	return &MockSMTPClient{
+		should_return_error: false,
+	}
+}
+
+// NewMockSMTPClientWithError は送信エラーを返すMockSMTPClientを作成します
+func NewMockSMTPClientWithError() *MockSMTPClient {
+	return &MockSMTPClient{
+		should_return_error: true,
+	}
+}
+
+// SendMail はモックのメール送信処理です
+func (m *MockSMTPClient) SendMail(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	if m.should_return_error {
+		return fmt.Errorf("connection refused")
+	}
+	m.Addr = addr
+	m.To = to
+	m.Message = string(msg)
+	return nil
+}
@@ -0,0 +1,67 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// LogSecurityEventLogger はセキュリティイベントを1行のJSONとしてログに出力します
+// ログ基盤から不正検知（fraud）の処理へ連携するため、メールアドレスはマスクして出力します
+type LogSecurityEventLogger struct {
+	logger *log.Logger
+}
+
+// security_event_log はログに出力するセキュリティイベントの形式です
+type security_event_log struct {
+	Kind              string            `json:"kind"`
+	Type              string            `json:"type"`
+	Action            string            `json:"action"`
+	Keys              map[string]string `json:"keys"`
+	ClientIP          string            `json:"client_ip"`
+	UserAgent         string            `json:"user_agent"`
+	FailureCount      int               `json:"failure_count"`
+	RetryAfterSeconds int64             `json:"retry_after_seconds"`
+	OccurredAt        string            `json:"occurred_at"`
+}
+
+// NewLogSecurityEventLogger は新しいLogSecurityEventLoggerを作成します（loggerがnilの場合は標準のロガーに出力します）
+func NewLogSecurityEventLogger(logger *log.Logger) *LogSecurityEventLogger {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogSecurityEventLogger{
+		logger: logger,
+	}
+}
+
+// LogSecurityEvent はセキュリティイベントをログに出力します
+func (l *LogSecurityEventLogger) LogSecurityEvent(ctx context.Context, event *models.SecurityEvent) {
+	var entry security_event_log
+	var encoded []byte
+	var err error
+
+	entry = security_event_log{
+		Kind:              "security_event",
+		Type:              string(event.Type()),
+		Action:            string(event.Action()),
+		Keys:              map[string]string{},
+		ClientIP:          event.ClientIP(),
+		UserAgent:         event.UserAgent(),
+		FailureCount:      event.FailureCount(),
+		RetryAfterSeconds: int64(event.RetryAfter().Seconds()),
+		OccurredAt:        event.OccurredAt().UTC().Format(time.RFC3339),
+	}
+	for _, key := range event.Keys() {
+		entry.Keys[string(key.Kind())] = key.MaskedValue()
+	}
+	encoded, err = json.Marshal(entry)
+	if err != nil {
+		l.logger.Printf("セキュリティイベントの出力に失敗しました: %v", err)
+		return
+	}
+	l.logger.Println(string(encoded))
+}
@@ -0,0 +1,53 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// TestLogSecurityEventLogger_LogSecurityEvent はイベントがメールアドレスをマスクしたJSONで出力されることをテストします
+func TestLogSecurityEventLogger_LogSecurityEvent(t *testing.T) {
+	var buffer bytes.Buffer
+	var logger *LogSecurityEventLogger
+	var email_key models.AttemptKey
+	var ip_key models.AttemptKey
+	var entry map[string]any
+	var err error
+
+	logger = NewLogSecurityEventLogger(log.New(&buffer, "", 0))
+	email_key, _ = models.NewAttemptKey(models.AttemptKeyKindEmail, "test@example.com")
+	ip_key, _ = models.NewAttemptKey(models.AttemptKeyKindIP, "192.0.2.1")
+	logger.LogSecurityEvent(context.Background(), models.NewSecurityEvent(
+		models.SecurityEventAuthLockout,
+		models.AuthActionLogin,
+		[]models.AttemptKey{ip_key, email_key},
+		"192.0.2.1",
+		"SleeveApp/1.0",
+		10,
+		15*time.Minute,
+		time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	))
+	if strings.Contains(buffer.String(), "test@example.com") {
+		t.Fatalf("expected email to be masked, got %s", buffer.String())
+	}
+	err = json.Unmarshal(buffer.Bytes(), &entry)
+	if err != nil {
+		t.Fatalf("expected JSON line, got %s: %v", buffer.String(), err)
+	}
+	if entry["type"] != "auth_lockout" || entry["action"] != "login" {
+		t.Errorf("unexpected type/action: %v", entry)
+	}
+	if entry["keys"].(map[string]any)["email"] != "t***@example.com" {
+		t.Errorf("expected masked email key, got %v", entry["keys"])
+	}
+	if entry["retry_after_seconds"] != float64(900) || entry["occurred_at"] != "2026-10-18T12:00:00Z" {
+		t.Errorf("unexpected retry_after_seconds/occurred_at: %v", entry)
+	}
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// LocalBlobStorage は画像などの公開するファイルをローカルのディレクトリに保存するストレージです（ローカル開発・単一インスタンス用）
+// 保存したファイルはbase_url配下のURLで配信する前提です（配信はサーバーのハンドラーがOpenで読み出して行います）
+type LocalBlobStorage struct {
+	files    *LocalFileStorage
+	base_url string
+}
+
+// NewLocalBlobStorage は新しいLocalBlobStorageを作成します
+// base_urlは保存したファイルを配信するURLの先頭です（例: http://localhost:8080/media）
+func NewLocalBlobStorage(root_dir string, base_url string) *LocalBlobStorage {
+	return &LocalBlobStorage{
+		files:    NewLocalFileStorage(root_dir),
+		base_url: strings.TrimRight(base_url, "/"),
+	}
+}
+
+// Put はデータをキーのファイルに保存します
+// Content-Typeは配信時にキーの拡張子から判定するため保存しません
+func (s *LocalBlobStorage) Put(ctx context.Context, key string, data []byte, content_type string) error {
+	return s.files.Save(ctx, key, data)
+}
+
+// Open はキーのファイルを読み出し用に開きます（呼び出し側でCloseしてください）
+func (s *LocalBlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.files.Open(ctx, key)
+}
+
+// Delete はキーのファイルを削除します（存在しない場合も成功とします）
+func (s *LocalBlobStorage) Delete(ctx context.Context, key string) error {
+	return s.files.Delete(ctx, key)
+}
+
+// URL はキーのファイルを配信するURLを返します
+func (s *LocalBlobStorage) URL(key string) string {
+	return s.base_url + "/" + key
+}
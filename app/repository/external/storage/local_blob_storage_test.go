@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+)
+
+// TestLocalBlobStorage_PutOpenDelete は保存・公開URL・読み出し・削除の流れをテストします
+func TestLocalBlobStorage_PutOpenDelete(t *testing.T) {
+	var ctx context.Context
+	var storage *LocalBlobStorage
+	var reader io.ReadCloser
+	var data []byte
+	var err error
+
+	ctx = context.Background()
+	storage = NewLocalBlobStorage(t.TempDir(), "http://localhost:8080/media/")
+
+	err = storage.Put(ctx, "images/user/image/small.jpg", []byte("jpeg"), "image/jpeg")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if storage.URL("images/user/image/small.jpg") != "http://localhost:8080/media/images/user/image/small.jpg" {
+		t.Errorf("unexpected url: %s", storage.URL("images/user/image/small.jpg"))
+	}
+	reader, err = storage.Open(ctx, "images/user/image/small.jpg")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	data, _ = io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "jpeg" {
+		t.Errorf("expected jpeg, got %q", data)
+	}
+
+	err = storage.Delete(ctx, "images/user/image/small.jpg")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = storage.Open(ctx, "images/user/image/small.jpg")
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError after delete, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	domain_errors "sleeve/domain/errors"
+)
+
+// LocalFileStorage はファイルをローカルのディレクトリに保存するストレージです（ローカル開発・単一インスタンス用）
+// キーはroot_dirからの相対パスとして扱い、root_dirの外を指すキーは拒否します
+type LocalFileStorage struct {
+	root_dir string
+}
+
+// NewLocalFileStorage は新しいLocalFileStorageを作成します
+func NewLocalFileStorage(root_dir string) *LocalFileStorage {
+	return &LocalFileStorage{
+		root_dir: root_dir,
+	}
+}
+
+// Save はデータをキーのファイルに保存します
+// 一時ファイルに書き込んでから名前を変更するため、書き込み途中のファイルが読み出されることはありません
+func (s *LocalFileStorage) Save(ctx context.Context, key string, data []byte) error {
+	var path string
+	var temp_file *os.File
+	var err error
+
+	path, err = s.resolve_path(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	temp_file, err = os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	defer os.Remove(temp_file.Name())
+	_, err = temp_file.Write(data)
+	if err != nil {
+		temp_file.Close()
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	err = temp_file.Close()
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	err = os.Rename(temp_file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	return nil
+}
+
+// Open はキーのファイルを読み出し用に開きます（呼び出し側でCloseしてください）
+func (s *LocalFileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	var path string
+	var file *os.File
+	var err error
+
+	path, err = s.resolve_path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err = os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	return file, nil
+}
+
+// Delete はキーのファイルを削除します（存在しない場合も成功とします）
+func (s *LocalFileStorage) Delete(ctx context.Context, key string) error {
+	var path string
+	var err error
+
+	path, err = s.resolve_path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	return nil
+}
+
+// resolve_path はキーをroot_dir配下のファイルパスに変換します
+func (s *LocalFileStorage) resolve_path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("%w: invalid storage key: %q", domain_errors.ErrStorageError, key)
+	}
+	return filepath.Join(s.root_dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+)
+
+// TestLocalFileStorage_SaveOpenDelete は保存・読み出し・削除の流れをテストします
+func TestLocalFileStorage_SaveOpenDelete(t *testing.T) {
+	var ctx context.Context
+	var storage *LocalFileStorage
+	var reader io.ReadCloser
+	var data []byte
+	var err error
+
+	ctx = context.Background()
+	storage = NewLocalFileStorage(t.TempDir())
+
+	err = storage.Save(ctx, "data-exports/export.zip", []byte("content"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	reader, err = storage.Open(ctx, "data-exports/export.zip")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	data, _ = io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "content" {
+		t.Errorf("expected content, got %q", data)
+	}
+
+	for i := 0; i < 2; i++ {
+		err = storage.Delete(ctx, "data-exports/export.zip")
+		if err != nil {
+			t.Fatalf("attempt %d: expected no error, got %v", i+1, err)
+		}
+	}
+	_, err = storage.Open(ctx, "data-exports/export.zip")
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError after delete, got %v", err)
+	}
+}
+
+// TestLocalFileStorage_RejectsPathTraversal はルートディレクトリの外を指すキーが拒否されることをテストします
+func TestLocalFileStorage_RejectsPathTraversal(t *testing.T) {
+	var storage *LocalFileStorage
+	var err error
+
+	storage = NewLocalFileStorage(t.TempDir())
+	for _, key := range []string{"../outside.zip", "/etc/passwd", "data-exports/../../outside.zip", ""} {
+		err = storage.Save(context.Background(), key, []byte("content"))
+		if !errors.Is(err, domain_errors.ErrStorageError) {
+			t.Errorf("key %q: expected ErrStorageError, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// MockS3Server はテスト用にS3のPutObject・GetObject・DeleteObjectを再現するローカルサーバーです
+// S3と同じ方法でリクエストの署名（AWS Signature Version 4）と本文のハッシュを検証し、オブジェクトをメモリに保持します
+type MockS3Server struct {
+	server      *httptest.Server
+	bucket      string
+	credentials s3_credentials
+	mutex       sync.Mutex
+	objects     map[string]mock_s3_object
+	// fail_status が0以外の場合は全てのリクエストにこのステータスコードを返します（障害の再現用）
+	fail_status int
+}
+
+// mock_s3_object はMockS3Serverが保持するオブジェクトです
+type mock_s3_object struct {
+	data          []byte
+	content_type  string
+	cache_control string
+}
+
+// NewMockS3Server は新しいMockS3Serverを起動します（使い終わったらCloseを呼び出してください）
+func NewMockS3Server(bucket string, access_key_id string, secret_access_key string, region string) *MockS3Server {
+	var mock_server *MockS3Server
+
+	mock_server = &MockS3Server{
+		bucket: bucket,
+		credentials: s3_credentials{
+			access_key_id:     access_key_id,
+			secret_access_key: secret_access_key,
+			region:            region,
+		},
+		objects: map[string]mock_s3_object{},
+	}
+	mock_server.server = httptest.NewServer(http.HandlerFunc(mock_server.serve_object))
+	return mock_server
+}
+
+// URL はS3互換のAPIのURL（S3ConfigのEndpoint）を返します
+func (m *MockS3Server) URL() string {
+	return m.server.URL
+}
+
+// Close はサーバーを停止します
+func (m *MockS3Server) Close() {
+	m.server.Close()
+}
+
+// Object は保存されたオブジェクトの内容とContent-Type・Cache-Controlを返します
+func (m *MockS3Server) Object(key string) ([]byte, string, string, bool) {
+	var object mock_s3_object
+	var exists bool
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	object, exists = m.objects[key]
+	return object.data, object.content_type, object.cache_control, exists
+}
+
+// SetFailStatus は全てのリクエストに返すステータスコードを設定します（0で障害の再現を解除します）
+func (m *MockS3Server) SetFailStatus(status int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.fail_status = status
+}
+
+// serve_object はバケット内のオブジェクトへのリクエストを処理します
+func (m *MockS3Server) serve_object(w http.ResponseWriter, r *http.Request) {
+	var key string
+	var body []byte
+	var object mock_s3_object
+	var exists bool
+	var is_bucket bool
+	var err error
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.fail_status != 0 {
+		http.Error(w, "InternalError", m.fail_status)
+		return
+	}
+	key, is_bucket = strings.CutPrefix(r.URL.Path, "/"+m.bucket+"/")
+	if !is_bucket || key == "" {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	body, err = io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "IncompleteBody", http.StatusBadRequest)
+		return
+	}
+	if !m.verify_signature(r, body) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		m.objects[key] = mock_s3_object{data: body, content_type: r.Header.Get("Content-Type"), cache_control: r.Header.Get("Cache-Control")}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		object, exists = m.objects[key]
+		if !exists {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", object.content_type)
+		_, _ = w.Write(object.data)
+	case http.MethodDelete:
+		delete(m.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// verify_signature はAuthorizationヘッダーの署名とx-amz-content-sha256を検証します
+func (m *MockS3Server) verify_signature(r *http.Request, body []byte) bool {
+	var fields map[string]string
+	var part string
+	var name string
+	var value string
+	var found bool
+	var expected string
+
+	if len(r.Header.Get("X-Amz-Date")) != len(amzDateFormat) || r.Header.Get("X-Amz-Content-Sha256") != hash_s3_payload(body) {
+		return false
+	}
+	part, found = strings.CutPrefix(r.Header.Get("Authorization"), s3SigningAlgorithm+" ")
+	if !found {
+		return false
+	}
+	fields = map[string]string{}
+	for _, part = range strings.Split(part, ",") {
+		name, value, found = strings.Cut(strings.TrimSpace(part), "=")
+		if found {
+			fields[name] = value
+		}
+	}
+	if fields["Credential"] != m.credentials.access_key_id+"/"+s3_credential_scope(r.Header.Get("X-Amz-Date"), m.credentials.region) {
+		return false
+	}
+	expected = calculate_s3_signature(r, m.credentials, strings.Split(fields["SignedHeaders"], ";"), hash_s3_payload(body), r.Header.Get("X-Amz-Date"))
+	return hmac.Equal([]byte(expected), []byte(fields["Signature"]))
+}
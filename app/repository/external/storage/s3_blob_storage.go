@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+)
+
+const (
+	// s3RequestTimeout はS3互換ストレージへのリクエストのタイムアウトです
+	s3RequestTimeout = 30 * time.Second
+	// s3ErrorBodyLimit はエラーの応答本文をエラーメッセージに含める上限のバイト数です
+	s3ErrorBodyLimit = 512
+	// blobCacheControl は保存したファイルのCache-Controlです
+	// キーはアップロードごとに異なり、同じキーの内容を書き換えないため、長期間キャッシュさせます
+	blobCacheControl = "public, max-age=31536000, immutable"
+)
+
+// S3Config はS3互換のオブジェクトストレージの接続設定です
+type S3Config struct {
+	// Endpoint はAPIのURLです（例: https://s3.ap-northeast-1.amazonaws.com、ローカルのMinIOはhttp://localhost:9000）
+	Endpoint string
+	// Region は署名に使用するリージョンです（MinIOなどリージョンがない場合はus-east-1）
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicBaseURL は保存したファイルを配信するURLの先頭です（CDNなど、未設定の場合はEndpoint/Bucket）
+	PublicBaseURL string
+}
+
+// S3BlobStorage は画像などの公開するファイルをS3互換のオブジェクトストレージに保存するストレージです
+// AWS S3・Cloudflare R2・MinIOなどで使用できるよう、バケット名をパスに含める形式（パス形式）でアクセスします
+type S3BlobStorage struct {
+	endpoint        string
+	bucket          string
+	public_base_url string
+	credentials     s3_credentials
+	http_client     *http.Client
+}
+
+// NewS3BlobStorage は新しいS3BlobStorageを作成します
+func NewS3BlobStorage(config S3Config) (*S3BlobStorage, error) {
+	var public_base_url string
+
+	if config.Endpoint == "" || config.Region == "" || config.Bucket == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("endpoint, region, bucket, access key id and secret access key are required")
+	}
+	public_base_url = strings.TrimRight(config.PublicBaseURL, "/")
+	if public_base_url == "" {
+		public_base_url = strings.TrimRight(config.Endpoint, "/") + "/" + config.Bucket
+	}
+	return &S3BlobStorage{
+		endpoint:        strings.TrimRight(config.Endpoint, "/"),
+		bucket:          config.Bucket,
+		public_base_url: public_base_url,
+		credentials: s3_credentials{
+			access_key_id:     config.AccessKeyID,
+			secret_access_key: config.SecretAccessKey,
+			region:            config.Region,
+		},
+		http_client: &http.Client{Timeout: s3RequestTimeout},
+	}, nil
+}
+
+// Put はデータをキーのオブジェクトとして保存します
+func (s *S3BlobStorage) Put(ctx context.Context, key string, data []byte, content_type string) error {
+	var req *http.Request
+	var err error
+
+	req, err = s.new_request(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", content_type)
+	req.Header.Set("Cache-Control", blobCacheControl)
+	return s.do(req, data, http.StatusOK)
+}
+
+// Open はキーのオブジェクトを読み出し用に開きます（呼び出し側でCloseしてください）
+func (s *S3BlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	var req *http.Request
+	var resp *http.Response
+	var error_body []byte
+	var err error
+
+	req, err = s.new_request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	sign_s3_request(req, s.credentials, hash_s3_payload(nil), time.Now())
+	resp, err = s.http_client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		error_body, _ = io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s %s returned %d: %s", domain_errors.ErrStorageError, req.Method, req.URL.Path, resp.StatusCode, error_body)
+	}
+	return resp.Body, nil
+}
+
+// Delete はキーのオブジェクトを削除します（存在しない場合も成功とします）
+func (s *S3BlobStorage) Delete(ctx context.Context, key string) error {
+	var req *http.Request
+	var err error
+
+	req, err = s.new_request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	return s.do(req, nil, http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+}
+
+// URL はキーのオブジェクトを配信するURLを返します
+func (s *S3BlobStorage) URL(key string) string {
+	return s.public_base_url + "/" + s3_uri_encode(key, false)
+}
+
+// new_request はキーのオブジェクトへのリクエストを作成します
+// バケットの外を指すキー・空のキーは拒否します
+func (s *S3BlobStorage) new_request(ctx context.Context, method string, key string, body []byte) (*http.Request, error) {
+	var req *http.Request
+	var err error
+
+	if !filepath.IsLocal(key) || path.Clean(key) != key {
+		return nil, fmt.Errorf("%w: invalid storage key: %q", domain_errors.ErrStorageError, key)
+	}
+	req, err = http.NewRequestWithContext(ctx, method, s.endpoint+"/"+s.bucket+"/"+s3_uri_encode(key, false), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	return req, nil
+}
+
+// do はリクエストに署名して送信し、想定したステータスコード以外の場合はErrStorageErrorを返します
+func (s *S3BlobStorage) do(req *http.Request, body []byte, expected_statuses ...int) error {
+	var resp *http.Response
+	var error_body []byte
+	var err error
+
+	sign_s3_request(req, s.credentials, hash_s3_payload(body), time.Now())
+	resp, err = s.http_client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+	}
+	defer resp.Body.Close()
+	for _, status := range expected_statuses {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+	error_body, _ = io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+	return fmt.Errorf("%w: %s %s returned %d: %s", domain_errors.ErrStorageError, req.Method, req.URL.Path, resp.StatusCode, error_body)
+}
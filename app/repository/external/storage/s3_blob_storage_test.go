@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+)
+
+// テスト用のS3互換ストレージの設定
+const (
+	testS3Bucket          = "sleeve-media"
+	testS3AccessKeyID     = "test-access-key"
+	testS3SecretAccessKey = "test-secret-key"
+	testS3Region          = "ap-northeast-1"
+)
+
+// setup_s3_blob_storage_test はMockS3Serverとそのサーバーに接続するS3BlobStorageを作成します
+func setup_s3_blob_storage_test(t *testing.T, secret_access_key string) (*MockS3Server, *S3BlobStorage) {
+	var mock_server *MockS3Server
+	var storage *S3BlobStorage
+	var err error
+
+	t.Helper()
+	mock_server = NewMockS3Server(testS3Bucket, testS3AccessKeyID, testS3SecretAccessKey, testS3Region)
+	t.Cleanup(mock_server.Close)
+	storage, err = NewS3BlobStorage(S3Config{
+		Endpoint:        mock_server.URL(),
+		Region:          testS3Region,
+		Bucket:          testS3Bucket,
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: secret_access_key,
+	})
+	if err != nil {
+		t.Fatalf("failed to create s3 blob storage: %v", err)
+	}
+	return mock_server, storage
+}
+
+// TestS3BlobStorage_PutDelete は署名付きのリクエストで保存・削除できることをテストします
+func TestS3BlobStorage_PutDelete(t *testing.T) {
+	var ctx context.Context
+	var mock_server *MockS3Server
+	var storage *S3BlobStorage
+	var data []byte
+	var content_type string
+	var cache_control string
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	mock_server, storage = setup_s3_blob_storage_test(t, testS3SecretAccessKey)
+
+	err = storage.Put(ctx, "images/user/image/small.jpg", []byte("jpeg"), "image/jpeg")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	data, content_type, cache_control, exists = mock_server.Object("images/user/image/small.jpg")
+	if !exists || string(data) != "jpeg" || content_type != "image/jpeg" || cache_control != blobCacheControl {
+		t.Errorf("unexpected object: exists=%v data=%q content_type=%s cache_control=%s", exists, data, content_type, cache_control)
+	}
+	if storage.URL("images/user/image/small.jpg") != mock_server.URL()+"/"+testS3Bucket+"/images/user/image/small.jpg" {
+		t.Errorf("unexpected url: %s", storage.URL("images/user/image/small.jpg"))
+	}
+
+	// 存在しないオブジェクトの削除も成功とする
+	for i := 0; i < 2; i++ {
+		err = storage.Delete(ctx, "images/user/image/small.jpg")
+		if err != nil {
+			t.Fatalf("attempt %d: expected no error, got %v", i+1, err)
+		}
+	}
+	_, _, _, exists = mock_server.Object("images/user/image/small.jpg")
+	if exists {
+		t.Error("expected object to be deleted")
+	}
+}
+
+// TestS3BlobStorage_PublicBaseURL はCDNなどの配信URLを指定した場合の公開URLをテストします
+func TestS3BlobStorage_PublicBaseURL(t *testing.T) {
+	var storage *S3BlobStorage
+	var err error
+
+	storage, err = NewS3BlobStorage(S3Config{
+		Endpoint:        "https://s3.ap-northeast-1.amazonaws.com",
+		Region:          testS3Region,
+		Bucket:          testS3Bucket,
+		AccessKeyID:     testS3AccessKeyID,
+		SecretAccessKey: testS3SecretAccessKey,
+		PublicBaseURL:   "https://media.example.com/",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if storage.URL("images/a/b/large.png") != "https://media.example.com/images/a/b/large.png" {
+		t.Errorf("unexpected url: %s", storage.URL("images/a/b/large.png"))
+	}
+	_, err = NewS3BlobStorage(S3Config{Endpoint: "https://s3.ap-northeast-1.amazonaws.com", Bucket: testS3Bucket})
+	if err == nil {
+		t.Error("expected error for missing credentials, got nil")
+	}
+}
+
+// TestS3BlobStorage_Errors は署名の不一致・ストレージの障害・不正なキーでErrStorageErrorを返すことをテストします
+func TestS3BlobStorage_Errors(t *testing.T) {
+	var ctx context.Context
+	var mock_server *MockS3Server
+	var storage *S3BlobStorage
+	var err error
+
+	ctx = context.Background()
+	_, storage = setup_s3_blob_storage_test(t, "wrong-secret-key")
+	err = storage.Put(ctx, "images/user/image/small.jpg", []byte("jpeg"), "image/jpeg")
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError for wrong secret key, got %v", err)
+	}
+
+	mock_server, storage = setup_s3_blob_storage_test(t, testS3SecretAccessKey)
+	mock_server.SetFailStatus(http.StatusServiceUnavailable)
+	err = storage.Put(ctx, "images/user/image/small.jpg", []byte("jpeg"), "image/jpeg")
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError for unavailable storage, got %v", err)
+	}
+	mock_server.SetFailStatus(0)
+
+	for _, key := range []string{"../outside.jpg", "/images/a.jpg", "images/../../a.jpg", "images//a.jpg", ""} {
+		err = storage.Put(ctx, key, []byte("jpeg"), "image/jpeg")
+		if !errors.Is(err, domain_errors.ErrStorageError) {
+			t.Errorf("key %q: expected ErrStorageError, got %v", key, err)
+		}
+	}
+}
+
+// TestS3BlobStorage_Open は保存したオブジェクトを読み出せること、存在しないオブジェクトはErrStorageErrorになることをテストします
+func TestS3BlobStorage_Open(t *testing.T) {
+	var ctx context.Context
+	var storage *S3BlobStorage
+	var reader io.ReadCloser
+	var data []byte
+	var err error
+
+	ctx = context.Background()
+	_, storage = setup_s3_blob_storage_test(t, testS3SecretAccessKey)
+	err = storage.Put(ctx, "images/user/image/small.png", []byte("png"), "image/png")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	reader, err = storage.Open(ctx, "images/user/image/small.png")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	data, err = io.ReadAll(reader)
+	reader.Close()
+	if err != nil || string(data) != "png" {
+		t.Errorf("expected stored data, got %q (err=%v)", data, err)
+	}
+
+	_, err = storage.Open(ctx, "images/user/image/missing.png")
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError for missing object, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4の設定
+const (
+	// s3SigningAlgorithm は署名のアルゴリズム名です
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	// s3ServiceName は署名のスコープに含めるサービス名です
+	s3ServiceName = "s3"
+	// amzDateFormat はx-amz-dateヘッダーの日時の形式です
+	amzDateFormat = "20060102T150405Z"
+	// amzShortDateFormat は署名のスコープに含める日付の形式です
+	amzShortDateFormat = "20060102"
+)
+
+// s3_credentials はS3互換ストレージのアクセスキーとリージョンです
+type s3_credentials struct {
+	access_key_id     string
+	secret_access_key string
+	region            string
+}
+
+// sign_s3_request はリクエストにAWS Signature Version 4の署名（Authorizationヘッダー）を付与します
+// 署名の前に設定されたヘッダーとHostを全て署名の対象にするため、ヘッダーは全て設定してから呼び出してください
+func sign_s3_request(req *http.Request, credentials s3_credentials, payload_hash string, now time.Time) {
+	var amz_date string
+	var signed_headers []string
+	var signature string
+
+	amz_date = now.UTC().Format(amzDateFormat)
+	req.Header.Set("X-Amz-Date", amz_date)
+	req.Header.Set("X-Amz-Content-Sha256", payload_hash)
+	signed_headers = []string{"host"}
+	for name := range req.Header {
+		signed_headers = append(signed_headers, strings.ToLower(name))
+	}
+	sort.Strings(signed_headers)
+	signature = calculate_s3_signature(req, credentials, signed_headers, payload_hash, amz_date)
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, credentials.access_key_id, s3_credential_scope(amz_date, credentials.region),
+		strings.Join(signed_headers, ";"), signature,
+	))
+}
+
+// calculate_s3_signature は署名対象のヘッダー（小文字・昇順）から署名を計算します
+// 受信側（MockS3Server）がAuthorizationヘッダーの署名を検証する場合にも使用します
+func calculate_s3_signature(req *http.Request, credentials s3_credentials, signed_headers []string, payload_hash string, amz_date string) string {
+	var canonical_request string
+	var string_to_sign string
+	var signing_key []byte
+	var request_hash [sha256.Size]byte
+
+	canonical_request = strings.Join([]string{
+		req.Method,
+		s3_uri_encode(req.URL.Path, false),
+		s3_canonical_query(req),
+		s3_canonical_headers(req, signed_headers),
+		strings.Join(signed_headers, ";"),
+		payload_hash,
+	}, "\n")
+	request_hash = sha256.Sum256([]byte(canonical_request))
+	string_to_sign = strings.Join([]string{
+		s3SigningAlgorithm,
+		amz_date,
+		s3_credential_scope(amz_date, credentials.region),
+		hex.EncodeToString(request_hash[:]),
+	}, "\n")
+	signing_key = hmac_sha256([]byte("AWS4"+credentials.secret_access_key), amz_date[:len(amzShortDateFormat)])
+	signing_key = hmac_sha256(signing_key, credentials.region)
+	signing_key = hmac_sha256(signing_key, s3ServiceName)
+	signing_key = hmac_sha256(signing_key, "aws4_request")
+	return hex.EncodeToString(hmac_sha256(signing_key, string_to_sign))
+}
+
+// s3_credential_scope は署名のスコープ（日付/リージョン/サービス/aws4_request）を返します
+func s3_credential_scope(amz_date string, region string) string {
+	return amz_date[:len(amzShortDateFormat)] + "/" + region + "/" + s3ServiceName + "/aws4_request"
+}
+
+// s3_canonical_query はクエリ文字列をキーの昇順に並べた正規形を返します
+func s3_canonical_query(req *http.Request) string {
+	var query map[string][]string
+	var keys []string
+	var pairs []string
+	var values []string
+
+	query = req.URL.Query()
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values = append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, s3_uri_encode(key, true)+"="+s3_uri_encode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3_canonical_headers は署名対象のヘッダーを「名前:値」の行にした正規形を返します
+// 値の前後の空白は除き、連続する空白は1つにまとめます
+func s3_canonical_headers(req *http.Request, signed_headers []string) string {
+	var builder strings.Builder
+	var values []string
+
+	for _, name := range signed_headers {
+		if name == "host" {
+			values = []string{req.Host}
+			if req.Host == "" {
+				values = []string{req.URL.Host}
+			}
+		} else {
+			values = req.Header.Values(name)
+		}
+		builder.WriteString(name)
+		builder.WriteString(":")
+		for i, value := range values {
+			if i > 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(strings.Join(strings.Fields(value), " "))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// s3_uri_encode はRFC 3986の非予約文字以外をパーセントエンコードします
+// パスの場合（encode_slash=false）は区切りの「/」をエンコードしません
+func s3_uri_encode(value string, encode_slash bool) string {
+	var builder strings.Builder
+	var c byte
+
+	for i := 0; i < len(value); i++ {
+		c = value[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encode_slash) {
+			builder.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", c)
+	}
+	return builder.String()
+}
+
+// hmac_sha256 はHMAC-SHA256を計算します
+func hmac_sha256(key []byte, data string) []byte {
+	var mac hash.Hash
+
+	mac = hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// hash_s3_payload はリクエストの本文のSHA-256ハッシュ（x-amz-content-sha256）を返します
+func hash_s3_payload(payload []byte) string {
+	var payload_hash [sha256.Size]byte
+
+	payload_hash = sha256.Sum256(payload)
+	return hex.EncodeToString(payload_hash[:])
+}
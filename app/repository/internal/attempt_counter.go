@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// InMemoryAttemptCounter はキーごとの認証の失敗回数をメモリに保持するカウンターです（ローカル開発・単一インスタンス用）
+// 状態はインスタンスごとに保持されるため、複数インスタンス構成ではAuthAttemptDAO（Postgres）を使用します
+type InMemoryAttemptCounter struct {
+	mutex   sync.Mutex
+	records map[string]*models.AttemptRecord
+}
+
+// NewInMemoryAttemptCounter は新しいInMemoryAttemptCounterを作成します
+func NewInMemoryAttemptCounter() *InMemoryAttemptCounter {
+	return &InMemoryAttemptCounter{
+		records: map[string]*models.AttemptRecord{},
+	}
+}
+
+// Get はキーの記録を返します（記録がない・有効期限切れの場合はnilを返します）
+func (c *InMemoryAttemptCounter) Get(ctx context.Context, key models.AttemptKey, now time.Time) (*models.AttemptRecord, error) {
+	var record *models.AttemptRecord
+	var is_found bool
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	record, is_found = c.records[key.String()]
+	if !is_found || record.IsExpired(now) {
+		return nil, nil
+	}
+	return record, nil
+}
+
+// RecordFailure は失敗回数を1増やし、有効期限を更新した記録を返します（有効期限切れの記録は1回目から数え直します）
+func (c *InMemoryAttemptCounter) RecordFailure(ctx context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error) {
+	var record *models.AttemptRecord
+	var is_found bool
+	var failure_count int
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	record, is_found = c.records[key.String()]
+	if !is_found || record.IsExpired(now) {
+		c.remove_expired(now)
+		failure_count = 1
+	} else {
+		failure_count = record.FailureCount() + 1
+	}
+	record = models.NewAttemptRecord(key, failure_count, now, expires_at)
+	c.records[key.String()] = record
+	return record, nil
+}
+
+// Reset はキーの記録を削除します
+func (c *InMemoryAttemptCounter) Reset(ctx context.Context, key models.AttemptKey) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.records, key.String())
+	return nil
+}
+
+// remove_expired は有効期限切れの記録を削除します（mutexを取得した状態で呼び出すこと）
+func (c *InMemoryAttemptCounter) remove_expired(now time.Time) {
+	for key, record := range c.records {
+		if record.IsExpired(now) {
+			delete(c.records, key)
+		}
+	}
+}
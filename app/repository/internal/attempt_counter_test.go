@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// TestInMemoryAttemptCounter_RecordFailure は失敗回数の加算・有効期限切れ後の数え直し・リセットをテストします
+func TestInMemoryAttemptCounter_RecordFailure(t *testing.T) {
+	var ctx context.Context
+	var counter *InMemoryAttemptCounter
+	var key models.AttemptKey
+	var now time.Time
+	var record *models.AttemptRecord
+	var err error
+
+	ctx = context.Background()
+	counter = NewInMemoryAttemptCounter()
+	key, _ = models.NewAttemptKey(models.AttemptKeyKindEmail, "test@example.com")
+	now = time.Now()
+	for i := 0; i < 3; i++ {
+		record, err = counter.RecordFailure(ctx, key, now, now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if record.FailureCount() != 3 {
+		t.Errorf("expected failure_count 3, got %d", record.FailureCount())
+	}
+	record, _ = counter.Get(ctx, key, now)
+	if record == nil || record.FailureCount() != 3 {
+		t.Errorf("expected stored failure_count 3, got %+v", record)
+	}
+
+	// 有効期限切れの記録は1回目から数え直す
+	record, _ = counter.Get(ctx, key, now.Add(time.Hour))
+	if record != nil {
+		t.Errorf("expected expired record to be nil, got %+v", record)
+	}
+	record, _ = counter.RecordFailure(ctx, key, now.Add(time.Hour), now.Add(2*time.Hour))
+	if record.FailureCount() != 1 {
+		t.Errorf("expected failure_count to restart from 1, got %d", record.FailureCount())
+	}
+
+	err = counter.Reset(ctx, key)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	record, _ = counter.Get(ctx, key, now)
+	if record != nil {
+		t.Errorf("expected record to be reset, got %+v", record)
+	}
+}
@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+)
+
+// AuthAttemptEntClientInterface はAuthAttemptDAOが利用するEnt Clientのインターフェースです
+type AuthAttemptEntClientInterface interface {
+	GetAuthAttemptClient() AuthAttemptClientInterface
+}
+
+// AuthAttemptClientInterface はEnt AuthAttempt Clientのインターフェースです
+type AuthAttemptClientInterface interface {
+	Create() AuthAttemptCreateInterface
+	Query() AuthAttemptQueryInterface
+	Update() AuthAttemptUpdateInterface
+	Delete() AuthAttemptDeleteInterface
+}
+
+// AuthAttemptCreateInterface はEnt AuthAttempt Create Builderのインターフェースです
+type AuthAttemptCreateInterface interface {
+	SetKey(string) AuthAttemptCreateInterface
+	SetFailureCount(int) AuthAttemptCreateInterface
+	SetLastFailedAt(time.Time) AuthAttemptCreateInterface
+	SetExpiresAt(time.Time) AuthAttemptCreateInterface
+	Save(ctx context.Context) (*ent.AuthAttempt, error)
+}
+
+// AuthAttemptQueryInterface はEnt AuthAttempt Query Builderのインターフェースです
+type AuthAttemptQueryInterface interface {
+	Where(predicates ...any) AuthAttemptQueryInterface
+	Only(ctx context.Context) (*ent.AuthAttempt, error)
+}
+
+// AuthAttemptUpdateInterface はEnt AuthAttempt Update Builderのインターフェースです
+type AuthAttemptUpdateInterface interface {
+	Where(predicates ...any) AuthAttemptUpdateInterface
+	// ActiveAt は有効期限が指定日時より後の記録に絞り込みます
+	ActiveAt(now time.Time) AuthAttemptUpdateInterface
+	// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+	ExpiredAt(now time.Time) AuthAttemptUpdateInterface
+	AddFailureCount(int) AuthAttemptUpdateInterface
+	SetFailureCount(int) AuthAttemptUpdateInterface
+	SetLastFailedAt(time.Time) AuthAttemptUpdateInterface
+	SetExpiresAt(time.Time) AuthAttemptUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// AuthAttemptDeleteInterface はEnt AuthAttempt Delete Builderのインターフェースです
+type AuthAttemptDeleteInterface interface {
+	Where(predicates ...any) AuthAttemptDeleteInterface
+	// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+	ExpiredAt(now time.Time) AuthAttemptDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// AuthAttemptDAO は認証の失敗回数のデータアクセスオブジェクトです
+// 複数インスタンスで失敗回数を共有するため、加算は条件付きのUPDATEで行います
+type AuthAttemptDAO struct {
+	client AuthAttemptEntClientInterface
+}
+
+// NewAuthAttemptDAO は新しいAuthAttemptDAOを作成します
+func NewAuthAttemptDAO(client AuthAttemptEntClientInterface) *AuthAttemptDAO {
+	return &AuthAttemptDAO{
+		client: client,
+	}
+}
+
+// Get はキーの記録を返します（記録がない・有効期限切れの場合はnilを返します）
+func (d *AuthAttemptDAO) Get(ctx context.Context, key models.AttemptKey, now time.Time) (*models.AttemptRecord, error) {
+	var record *models.AttemptRecord
+	var err error
+
+	record, err = d.find(ctx, key)
+	if err != nil || record == nil || record.IsExpired(now) {
+		return nil, err
+	}
+	return record, nil
+}
+
+// RecordFailure は失敗回数を1増やし、有効期限を更新した記録を返します（有効期限切れの記録は1回目から数え直します）
+// 同じキーの記録を同時に作成した場合は、ユニーク制約エラーの後に加算をやり直します
+func (d *AuthAttemptDAO) RecordFailure(ctx context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error) {
+	var affected_count int
+	var err error
+
+	for retry := 0; retry < 2; retry++ {
+		// 有効期限内の記録は失敗回数を加算
+		affected_count, err = d.client.GetAuthAttemptClient().
+			Update().
+			Where("key", key.String()).
+			ActiveAt(now).
+			AddFailureCount(1).
+			SetLastFailedAt(now).
+			SetExpiresAt(expires_at).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		if affected_count > 0 {
+			return d.find(ctx, key)
+		}
+		// 有効期限切れの記録は1回目から数え直す
+		affected_count, err = d.client.GetAuthAttemptClient().
+			Update().
+			Where("key", key.String()).
+			ExpiredAt(now).
+			SetFailureCount(1).
+			SetLastFailedAt(now).
+			SetExpiresAt(expires_at).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		if affected_count > 0 {
+			return d.find(ctx, key)
+		}
+		_, err = d.client.GetAuthAttemptClient().
+			Create().
+			SetKey(key.String()).
+			SetFailureCount(1).
+			SetLastFailedAt(now).
+			SetExpiresAt(expires_at).
+			Save(ctx)
+		if err == nil {
+			return models.NewAttemptRecord(key, 1, now, expires_at), nil
+		}
+		if !is_unique_constraint_error(err) {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+	}
+	return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+}
+
+// Reset はキーの記録を削除します
+func (d *AuthAttemptDAO) Reset(ctx context.Context, key models.AttemptKey) error {
+	var err error
+
+	_, err = d.client.GetAuthAttemptClient().
+		Delete().
+		Where("key", key.String()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// DeleteExpired は有効期限切れの記録を物理削除し、削除件数を返します（リセットで論理削除した記録も含みます）
+func (d *AuthAttemptDAO) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	var deleted_count int
+	var err error
+
+	ctx = with_hard_delete(ctx, ent.TypeAuthAttempt)
+	deleted_count, err = d.client.GetAuthAttemptClient().
+		Delete().
+		ExpiredAt(now).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count, nil
+}
+
+// find はキーの記録を返します（記録がない場合はnilを返します）
+func (d *AuthAttemptDAO) find(ctx context.Context, key models.AttemptKey) (*models.AttemptRecord, error) {
+	var ent_attempt *ent.AuthAttempt
+	var err error
+
+	ent_attempt, err = d.client.GetAuthAttemptClient().
+		Query().
+		Where("key", key.String()).
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return models.NewAttemptRecord(key, ent_attempt.FailureCount, ent_attempt.LastFailedAt, ent_attempt.ExpiresAt), nil
+}
@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestAuthAttemptDAO_RecordFailure は失敗回数の加算・有効期限切れ後の数え直し・リセットをテストします
+func TestAuthAttemptDAO_RecordFailure(t *testing.T) {
+	var ctx context.Context
+	var client *MockAuthAttemptEntClient
+	var dao *AuthAttemptDAO
+	var key models.AttemptKey
+	var now time.Time
+	var record *models.AttemptRecord
+	var err error
+
+	ctx = context.Background()
+	client = NewMockAuthAttemptEntClient()
+	dao = NewAuthAttemptDAO(client)
+	key, _ = models.NewAttemptKey(models.AttemptKeyKindEmail, "test@example.com")
+	now = time.Now()
+	for i := 0; i < 3; i++ {
+		record, err = dao.RecordFailure(ctx, key, now, now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if record.FailureCount() != 3 {
+		t.Errorf("expected failure_count 3, got %d", record.FailureCount())
+	}
+	if len(client.Attempts()) != 1 || client.Attempts()[0].Key != "email:test@example.com" {
+		t.Errorf("expected a single row keyed by email:test@example.com, got %+v", client.Attempts())
+	}
+	record, _ = dao.Get(ctx, key, now)
+	if record == nil || record.FailureCount() != 3 {
+		t.Errorf("expected stored failure_count 3, got %+v", record)
+	}
+
+	// 有効期限切れの記録は1回目から数え直す
+	record, _ = dao.Get(ctx, key, now.Add(time.Hour))
+	if record != nil {
+		t.Errorf("expected expired record to be nil, got %+v", record)
+	}
+	record, _ = dao.RecordFailure(ctx, key, now.Add(time.Hour), now.Add(2*time.Hour))
+	if record.FailureCount() != 1 {
+		t.Errorf("expected failure_count to restart from 1, got %d", record.FailureCount())
+	}
+
+	err = dao.Reset(ctx, key)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	record, _ = dao.Get(ctx, key, now)
+	if record != nil {
+		t.Errorf("expected record to be reset, got %+v", record)
+	}
+}
+
+// TestAuthAttemptDAO_DeleteExpired は有効期限切れの記録のみ削除されることをテストします
+func TestAuthAttemptDAO_DeleteExpired(t *testing.T) {
+	var ctx context.Context
+	var client *MockAuthAttemptEntClient
+	var dao *AuthAttemptDAO
+	var expired_key models.AttemptKey
+	var active_key models.AttemptKey
+	var now time.Time
+	var deleted_count int
+	var err error
+
+	ctx = context.Background()
+	client = NewMockAuthAttemptEntClient()
+	dao = NewAuthAttemptDAO(client)
+	expired_key, _ = models.NewAttemptKey(models.AttemptKeyKindIP, "192.0.2.1")
+	active_key, _ = models.NewAttemptKey(models.AttemptKeyKindIP, "192.0.2.2")
+	now = time.Now()
+	_, _ = dao.RecordFailure(ctx, expired_key, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	_, _ = dao.RecordFailure(ctx, active_key, now, now.Add(time.Hour))
+	deleted_count, err = dao.DeleteExpired(ctx, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted_count != 1 {
+		t.Errorf("expected 1 deleted row, got %d", deleted_count)
+	}
+	if len(client.Attempts()) != 1 || client.Attempts()[0].Key != active_key.String() {
+		t.Errorf("expected only the active record to remain, got %+v", client.Attempts())
+	}
+}
+
+// TestAuthAttemptDAO_DatabaseError はDBエラーがErrDatabaseErrorとして返されることをテストします
+func TestAuthAttemptDAO_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var dao *AuthAttemptDAO
+	var key models.AttemptKey
+	var err error
+
+	ctx = context.Background()
+	dao = NewAuthAttemptDAO(NewMockAuthAttemptEntClientWithDatabaseError())
+	key, _ = models.NewAttemptKey(models.AttemptKeyKindEmail, "test@example.com")
+	_, err = dao.RecordFailure(ctx, key, time.Now(), time.Now().Add(time.Hour))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	_, err = dao.Get(ctx, key, time.Now())
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
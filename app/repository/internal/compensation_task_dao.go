@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/compensationtask"
+
+	"github.com/google/uuid"
+)
+
+// CompensationTaskEntClientInterface はCompensationTaskDAOが利用するEnt Clientのインターフェースです
+type CompensationTaskEntClientInterface interface {
+	GetCompensationTaskClient() CompensationTaskClientInterface
+}
+
+// CompensationTaskClientInterface はEnt CompensationTask Clientのインターフェースです
+type CompensationTaskClientInterface interface {
+	Create() CompensationTaskCreateInterface
+	Query() CompensationTaskQueryInterface
+	Update() CompensationTaskUpdateInterface
+	Delete() CompensationTaskDeleteInterface
+}
+
+// CompensationTaskCreateInterface はEnt CompensationTask Create Builderのインターフェースです
+type CompensationTaskCreateInterface interface {
+	SetTaskID(uuid.UUID) CompensationTaskCreateInterface
+	SetKind(compensationtask.Kind) CompensationTaskCreateInterface
+	SetTarget(string) CompensationTaskCreateInterface
+	SetStatus(compensationtask.Status) CompensationTaskCreateInterface
+	SetAttempts(int) CompensationTaskCreateInterface
+	SetLastError(string) CompensationTaskCreateInterface
+	SetNextAttemptAt(time.Time) CompensationTaskCreateInterface
+	SetCreatedAt(time.Time) CompensationTaskCreateInterface
+	Save(ctx context.Context) (*ent.CompensationTask, error)
+}
+
+// CompensationTaskQueryInterface はEnt CompensationTask Query Builderのインターフェースです
+type CompensationTaskQueryInterface interface {
+	Where(predicates ...any) CompensationTaskQueryInterface
+	DueAt(now time.Time) CompensationTaskQueryInterface
+	Limit(limit int) CompensationTaskQueryInterface
+	All(ctx context.Context) ([]*ent.CompensationTask, error)
+}
+
+// CompensationTaskUpdateInterface はEnt CompensationTask Update Builderのインターフェースです
+type CompensationTaskUpdateInterface interface {
+	Where(predicates ...any) CompensationTaskUpdateInterface
+	SetStatus(compensationtask.Status) CompensationTaskUpdateInterface
+	SetAttempts(int) CompensationTaskUpdateInterface
+	SetLastError(string) CompensationTaskUpdateInterface
+	SetNextAttemptAt(time.Time) CompensationTaskUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// CompensationTaskDeleteInterface はEnt CompensationTask Delete Builderのインターフェースです
+type CompensationTaskDeleteInterface interface {
+	Where(predicates ...any) CompensationTaskDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// CompensationTaskDAO は失敗した補償処理のデータアクセスオブジェクトです
+type CompensationTaskDAO struct {
+	client CompensationTaskEntClientInterface
+}
+
+// NewCompensationTaskDAO は新しいCompensationTaskDAOを作成します
+func NewCompensationTaskDAO(client CompensationTaskEntClientInterface) *CompensationTaskDAO {
+	return &CompensationTaskDAO{
+		client: client,
+	}
+}
+
+// Save は補償処理をDBに保存します
+func (d *CompensationTaskDAO) Save(ctx context.Context, task *models.CompensationTask) error {
+	var err error
+
+	_, err = d.client.GetCompensationTaskClient().
+		Create().
+		SetTaskID(task.TaskID()).
+		SetKind(compensationtask.Kind(task.Kind())).
+		SetTarget(task.Target()).
+		SetStatus(compensationtask.Status(task.Status())).
+		SetAttempts(task.Attempts()).
+		SetLastError(task.LastError()).
+		SetNextAttemptAt(task.NextAttemptAt()).
+		SetCreatedAt(task.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindDue は再試行時刻を過ぎた再試行待ちの補償処理を、再試行時刻の古い順に最大limit件返します
+func (d *CompensationTaskDAO) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.CompensationTask, error) {
+	var ent_tasks []*ent.CompensationTask
+	var tasks []*models.CompensationTask
+	var err error
+
+	ent_tasks, err = d.client.GetCompensationTaskClient().
+		Query().
+		Where("status", compensationtask.StatusPending).
+		DueAt(now).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	tasks = make([]*models.CompensationTask, 0, len(ent_tasks))
+	for _, ent_task := range ent_tasks {
+		var task *models.CompensationTask
+
+		task, err = models.NewCompensationTaskWithState(
+			ent_task.TaskID,
+			models.CompensationKind(ent_task.Kind),
+			ent_task.Target,
+			models.CompensationStatus(ent_task.Status),
+			ent_task.Attempts,
+			ent_task.LastError,
+			ent_task.NextAttemptAt,
+			ent_task.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// Update は補償処理の状態、試行回数、エラーメッセージ、次回の試行時刻を更新します
+func (d *CompensationTaskDAO) Update(ctx context.Context, task *models.CompensationTask) error {
+	var err error
+
+	_, err = d.client.GetCompensationTaskClient().
+		Update().
+		Where("task_id", task.TaskID()).
+		SetStatus(compensationtask.Status(task.Status())).
+		SetAttempts(task.Attempts()).
+		SetLastError(task.LastError()).
+		SetNextAttemptAt(task.NextAttemptAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// Delete は完了した補償処理を削除します（存在しない場合も成功とします）
+func (d *CompensationTaskDAO) Delete(ctx context.Context, task_id uuid.UUID) error {
+	var err error
+
+	_, err = d.client.GetCompensationTaskClient().
+		Delete().
+		Where("task_id", task_id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
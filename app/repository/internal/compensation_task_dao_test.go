@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent/compensationtask"
+)
+
+// create_test_compensation_task はテスト用の補償処理を作成します
+func create_test_compensation_task(t *testing.T, target string, now time.Time) *models.CompensationTask {
+	var task *models.CompensationTask
+	var err error
+
+	t.Helper()
+	task, err = models.NewCompensationTask(models.CompensationKindDeleteFirebaseUser, target, "delete failed", now)
+	if err != nil {
+		t.Fatalf("failed to create compensation task: %v", err)
+	}
+	return task
+}
+
+// TestCompensationTaskDAO_FindDue は再試行時刻を過ぎた再試行待ちの補償処理のみが古い順に返されることをテストします
+func TestCompensationTaskDAO_FindDue(t *testing.T) {
+	var ctx context.Context
+	var now time.Time
+	var dao *CompensationTaskDAO
+	var exhausted_task *models.CompensationTask
+	var tasks []*models.CompensationTask
+	var err error
+
+	ctx = context.Background()
+	now = time.Now()
+	dao = NewCompensationTaskDAO(NewMockCompensationTaskEntClient())
+	for _, task := range []*models.CompensationTask{
+		create_test_compensation_task(t, "uid_later", now.Add(-time.Minute)),
+		create_test_compensation_task(t, "uid_earlier", now.Add(-time.Hour)),
+		create_test_compensation_task(t, "uid_not_due", now),
+	} {
+		err = dao.Save(ctx, task)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	exhausted_task = create_test_compensation_task(t, "uid_exhausted", now.Add(-time.Hour))
+	for !exhausted_task.IsExhausted() {
+		exhausted_task.RecordFailure("still failing", now.Add(-2*time.Hour))
+	}
+	err = dao.Save(ctx, exhausted_task)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tasks, err = dao.FindDue(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 due tasks, got %d", len(tasks))
+	}
+	if tasks[0].Target() != "uid_earlier" || tasks[1].Target() != "uid_later" {
+		t.Errorf("expected tasks ordered by next_attempt_at, got %s, %s", tasks[0].Target(), tasks[1].Target())
+	}
+	tasks, err = dao.FindDue(ctx, now, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(tasks) != 1 {
+		t.Errorf("expected limit to be applied, got %d", len(tasks))
+	}
+}
+
+// TestCompensationTaskDAO_UpdateAndDelete は補償処理の更新と削除をテストします
+func TestCompensationTaskDAO_UpdateAndDelete(t *testing.T) {
+	var ctx context.Context
+	var now time.Time
+	var client *MockCompensationTaskEntClient
+	var dao *CompensationTaskDAO
+	var task *models.CompensationTask
+	var err error
+
+	ctx = context.Background()
+	now = time.Now()
+	client = NewMockCompensationTaskEntClient()
+	dao = NewCompensationTaskDAO(client)
+	task = create_test_compensation_task(t, "firebase_uid_123", now)
+	err = dao.Save(ctx, task)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	task.RecordFailure("still failing", now)
+	err = dao.Update(ctx, task)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.Tasks()[0].Attempts != 2 || client.Tasks()[0].LastError != "still failing" {
+		t.Errorf("expected task to be updated, got attempts %d, last_error %s", client.Tasks()[0].Attempts, client.Tasks()[0].LastError)
+	}
+	if client.Tasks()[0].Status != compensationtask.StatusPending {
+		t.Errorf("expected status pending, got %s", client.Tasks()[0].Status)
+	}
+	err = dao.Delete(ctx, task.TaskID())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Tasks()) != 0 {
+		t.Errorf("expected task to be deleted, got %d", len(client.Tasks()))
+	}
+}
+
+// TestCompensationTaskDAO_Save_DatabaseError はDBエラーのケースをテストします
+func TestCompensationTaskDAO_Save_DatabaseError(t *testing.T) {
+	var err error
+
+	err = NewCompensationTaskDAO(NewMockCompensationTaskEntClientWithDatabaseError()).
+		Save(context.Background(), create_test_compensation_task(t, "firebase_uid_123", time.Now()))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
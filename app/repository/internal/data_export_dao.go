@@ -0,0 +1,323 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/dataexport"
+
+	"github.com/google/uuid"
+)
+
+// DataExportEntClientInterface はDataExportDAOが利用するEnt Clientのインターフェースです
+type DataExportEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetDataExportClient() DataExportClientInterface
+}
+
+// DataExportClientInterface はEnt DataExport Clientのインターフェースです
+type DataExportClientInterface interface {
+	Create() DataExportCreateInterface
+	Query() DataExportQueryInterface
+	Update() DataExportUpdateInterface
+	Delete() DataExportDeleteInterface
+}
+
+// DataExportCreateInterface はEnt DataExport Create Builderのインターフェースです
+type DataExportCreateInterface interface {
+	SetExportID(uuid.UUID) DataExportCreateInterface
+	SetUserID(int) DataExportCreateInterface
+	SetStatus(dataexport.Status) DataExportCreateInterface
+	SetCreatedAt(time.Time) DataExportCreateInterface
+	Save(ctx context.Context) (*ent.DataExport, error)
+}
+
+// DataExportQueryInterface はEnt DataExport Query Builderのインターフェースです
+type DataExportQueryInterface interface {
+	Where(predicates ...any) DataExportQueryInterface
+	// ExpiredAt はダウンロードの有効期限が指定日時以前のエクスポートに絞り込みます
+	ExpiredAt(now time.Time) DataExportQueryInterface
+	// OrderByCreatedAtAsc は要求した日時の古い順に並べます
+	OrderByCreatedAtAsc() DataExportQueryInterface
+	WithUser() DataExportQueryInterface
+	Limit(limit int) DataExportQueryInterface
+	All(ctx context.Context) ([]*ent.DataExport, error)
+	First(ctx context.Context) (*ent.DataExport, error)
+	Only(ctx context.Context) (*ent.DataExport, error)
+}
+
+// DataExportUpdateInterface はEnt DataExport Update Builderのインターフェースです
+type DataExportUpdateInterface interface {
+	Where(predicates ...any) DataExportUpdateInterface
+	SetStatus(dataexport.Status) DataExportUpdateInterface
+	SetStorageKey(string) DataExportUpdateInterface
+	SetDownloadTokenHash(string) DataExportUpdateInterface
+	SetLastError(string) DataExportUpdateInterface
+	SetCompletedAt(time.Time) DataExportUpdateInterface
+	SetExpiresAt(time.Time) DataExportUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// DataExportDeleteInterface はEnt DataExport Delete Builderのインターフェースです
+type DataExportDeleteInterface interface {
+	Where(predicates ...any) DataExportDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// DataExportDAO は個人データのエクスポートのデータアクセスオブジェクトです
+// ダウンロードリンクのトークンはハッシュのみを保存し、ドメインモデルには含めません
+type DataExportDAO struct {
+	client DataExportEntClientInterface
+}
+
+// NewDataExportDAO は新しいDataExportDAOを作成します
+func NewDataExportDAO(client DataExportEntClientInterface) *DataExportDAO {
+	return &DataExportDAO{
+		client: client,
+	}
+}
+
+// Create は作成待ちのエクスポートを保存します
+func (d *DataExportDAO) Create(ctx context.Context, data_export *models.DataExport) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, data_export.UserID())
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetDataExportClient().
+		Create().
+		SetExportID(data_export.ExportID()).
+		SetUserID(ent_user.ID).
+		SetStatus(dataexport.Status(data_export.Status())).
+		SetCreatedAt(data_export.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindPendingByUserID はユーザーの作成待ちのエクスポートを返します
+// 作成待ちのエクスポートがない場合はErrDataExportNotFoundを返します
+func (d *DataExportDAO) FindPendingByUserID(ctx context.Context, user_id uuid.UUID) (*models.DataExport, error) {
+	var ent_user *ent.User
+	var ent_export *ent.DataExport
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = with_deleted_users(ctx)
+	ent_export, err = d.client.GetDataExportClient().
+		Query().
+		Where("user_id", ent_user.ID, "status", dataexport.StatusPending).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		return nil, handle_data_export_query_error(err)
+	}
+	return convert_ent_data_export_to_domain(ent_export)
+}
+
+// FindPending は作成待ちのエクスポートを要求した日時の古い順に最大limit件返します
+func (d *DataExportDAO) FindPending(ctx context.Context, limit int) ([]*models.DataExport, error) {
+	var ent_exports []*ent.DataExport
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_exports, err = d.client.GetDataExportClient().
+		Query().
+		Where("status", dataexport.StatusPending).
+		OrderByCreatedAtAsc().
+		WithUser().
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_data_exports_to_domain(ent_exports)
+}
+
+// FindByDownloadToken はエクスポートIDとダウンロードリンクのトークンのハッシュが一致するエクスポートを返します
+// 一致するエクスポートがない場合はErrDataExportNotFoundを返します（有効期限の判定は呼び出し側で行います）
+func (d *DataExportDAO) FindByDownloadToken(ctx context.Context, export_id uuid.UUID, download_token_hash string) (*models.DataExport, error) {
+	var ent_export *ent.DataExport
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_export, err = d.client.GetDataExportClient().
+		Query().
+		Where("export_id", export_id, "download_token_hash", download_token_hash).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, handle_data_export_query_error(err)
+	}
+	return convert_ent_data_export_to_domain(ent_export)
+}
+
+// MarkReady はZIPファイルの作成完了とダウンロードリンクのトークンのハッシュを保存します
+func (d *DataExportDAO) MarkReady(ctx context.Context, data_export *models.DataExport, download_token_hash string) error {
+	var err error
+
+	if data_export.CompletedAt() == nil || data_export.ExpiresAt() == nil {
+		return fmt.Errorf("%w: data export is not completed", domain_errors.ErrDatabaseError)
+	}
+	_, err = d.client.GetDataExportClient().
+		Update().
+		Where("export_id", data_export.ExportID()).
+		SetStatus(dataexport.StatusReady).
+		SetStorageKey(data_export.StorageKey()).
+		SetDownloadTokenHash(download_token_hash).
+		SetCompletedAt(*data_export.CompletedAt()).
+		SetExpiresAt(*data_export.ExpiresAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// MarkFailed はZIPファイルの作成の失敗を保存します
+// 保存済みのZIPファイルがある場合は、期限切れの削除で消せるようにストレージキーも保存します
+func (d *DataExportDAO) MarkFailed(ctx context.Context, data_export *models.DataExport) error {
+	var update DataExportUpdateInterface
+	var err error
+
+	if data_export.CompletedAt() == nil || data_export.ExpiresAt() == nil {
+		return fmt.Errorf("%w: data export is not completed", domain_errors.ErrDatabaseError)
+	}
+	update = d.client.GetDataExportClient().
+		Update().
+		Where("export_id", data_export.ExportID()).
+		SetStatus(dataexport.StatusFailed).
+		SetLastError(data_export.LastError()).
+		SetCompletedAt(*data_export.CompletedAt()).
+		SetExpiresAt(*data_export.ExpiresAt())
+	if data_export.StorageKey() != "" {
+		update = update.SetStorageKey(data_export.StorageKey())
+	}
+	_, err = update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindExpired はダウンロードの有効期限を過ぎたエクスポートを最大limit件返します
+func (d *DataExportDAO) FindExpired(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error) {
+	var ent_exports []*ent.DataExport
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_exports, err = d.client.GetDataExportClient().
+		Query().
+		ExpiredAt(now).
+		WithUser().
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_data_exports_to_domain(ent_exports)
+}
+
+// ListByUserID はユーザーの全てのエクスポートを返します
+// 退会したユーザーの物理削除で記録がCASCADEで消える前に、ストレージ上のZIPファイルを削除するために使用します
+func (d *DataExportDAO) ListByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.DataExport, error) {
+	var ent_user *ent.User
+	var ent_exports []*ent.DataExport
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ent_exports, err = d.client.GetDataExportClient().
+		Query().
+		Where("user_id", ent_user.ID).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_data_exports_to_domain(ent_exports)
+}
+
+// Delete はエクスポートの記録を削除します（存在しない場合も成功とします）
+func (d *DataExportDAO) Delete(ctx context.Context, export_id uuid.UUID) error {
+	var err error
+
+	_, err = d.client.GetDataExportClient().
+		Delete().
+		Where("export_id", export_id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// handle_data_export_query_error はエクスポートが見つからない場合にErrDataExportNotFoundを返します
+func handle_data_export_query_error(err error) error {
+	if is_not_found_error(err) {
+		return domain_errors.ErrDataExportNotFound
+	}
+	return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+}
+
+// convert_ent_data_exports_to_domain はEntのDataExportエンティティの一覧をドメインモデルに変換します
+func convert_ent_data_exports_to_domain(ent_exports []*ent.DataExport) ([]*models.DataExport, error) {
+	var data_exports []*models.DataExport
+
+	data_exports = make([]*models.DataExport, 0, len(ent_exports))
+	for _, ent_export := range ent_exports {
+		var data_export *models.DataExport
+		var err error
+
+		data_export, err = convert_ent_data_export_to_domain(ent_export)
+		if err != nil {
+			return nil, err
+		}
+		data_exports = append(data_exports, data_export)
+	}
+	return data_exports, nil
+}
+
+// convert_ent_data_export_to_domain はEntのDataExportエンティティをドメインモデルに変換します
+func convert_ent_data_export_to_domain(ent_export *ent.DataExport) (*models.DataExport, error) {
+	var ent_user *ent.User
+	var storage_key string
+	var data_export *models.DataExport
+	var err error
+
+	ent_user, err = ent_export.Edges.UserOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if ent_export.StorageKey != nil {
+		storage_key = *ent_export.StorageKey
+	}
+	data_export, err = models.NewDataExportWithState(
+		ent_export.ExportID,
+		ent_user.PublicID,
+		models.DataExportStatus(ent_export.Status),
+		storage_key,
+		ent_export.LastError,
+		ent_export.CompletedAt,
+		ent_export.ExpiresAt,
+		ent_export.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return data_export, nil
+}
@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// TestDataExportDAO_Lifecycle は作成待ち・作成完了・ダウンロード・期限切れ削除の流れをテストします
+func TestDataExportDAO_Lifecycle(t *testing.T) {
+	var ctx context.Context
+	var client *MockDataExportEntClient
+	var dao *DataExportDAO
+	var ent_user *ent.User
+	var now time.Time
+	var data_export *models.DataExport
+	var found *models.DataExport
+	var pending []*models.DataExport
+	var expired []*models.DataExport
+	var token_hash string
+	var err error
+
+	ctx = context.Background()
+	client = NewMockDataExportEntClient()
+	dao = NewDataExportDAO(client)
+	ent_user = client.AddUser(uuid.New())
+	now = time.Now()
+
+	_, err = dao.FindPendingByUserID(ctx, ent_user.PublicID)
+	if !errors.Is(err, domain_errors.ErrDataExportNotFound) {
+		t.Errorf("expected ErrDataExportNotFound, got %v", err)
+	}
+
+	data_export, _ = models.NewDataExport(ent_user.PublicID, now)
+	err = dao.Create(ctx, data_export)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found, err = dao.FindPendingByUserID(ctx, ent_user.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found.ExportID() != data_export.ExportID() || found.UserID() != ent_user.PublicID {
+		t.Errorf("expected export %s of user %s, got %s of %s", data_export.ExportID(), ent_user.PublicID, found.ExportID(), found.UserID())
+	}
+	pending, err = dao.FindPending(ctx, 10)
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("expected 1 pending export, got %d (err=%v)", len(pending), err)
+	}
+
+	token_hash = models.HashDataExportDownloadToken("token")
+	_ = data_export.MarkReady(models.DataExportStorageKey(data_export.ExportID()), now)
+	err = dao.MarkReady(ctx, data_export, token_hash)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	pending, _ = dao.FindPending(ctx, 10)
+	if len(pending) != 0 {
+		t.Errorf("expected no pending export, got %d", len(pending))
+	}
+	found, err = dao.FindByDownloadToken(ctx, data_export.ExportID(), token_hash)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !found.IsDownloadable(now) || found.StorageKey() != data_export.StorageKey() {
+		t.Errorf("expected downloadable export at %s, got status %s at %s", data_export.StorageKey(), found.Status(), found.StorageKey())
+	}
+	_, err = dao.FindByDownloadToken(ctx, data_export.ExportID(), models.HashDataExportDownloadToken("wrong"))
+	if !errors.Is(err, domain_errors.ErrDataExportNotFound) {
+		t.Errorf("expected ErrDataExportNotFound for wrong token, got %v", err)
+	}
+
+	expired, err = dao.FindExpired(ctx, now, 10)
+	if err != nil || len(expired) != 0 {
+		t.Errorf("expected no expired export, got %d (err=%v)", len(expired), err)
+	}
+	expired, err = dao.FindExpired(ctx, now.Add(models.DataExportDownloadPeriod), 10)
+	if err != nil || len(expired) != 1 {
+		t.Fatalf("expected 1 expired export, got %d (err=%v)", len(expired), err)
+	}
+	err = dao.Delete(ctx, expired[0].ExportID())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Exports()) != 0 {
+		t.Errorf("expected export to be deleted, got %d", len(client.Exports()))
+	}
+}
+
+// TestDataExportDAO_ListByUserID はユーザーの全てのエクスポートが状態によらず返されることをテストします
+func TestDataExportDAO_ListByUserID(t *testing.T) {
+	var ctx context.Context
+	var client *MockDataExportEntClient
+	var dao *DataExportDAO
+	var ent_user *ent.User
+	var other_user *ent.User
+	var ready_export *models.DataExport
+	var pending_export *models.DataExport
+	var other_export *models.DataExport
+	var data_exports []*models.DataExport
+	var err error
+
+	ctx = context.Background()
+	client = NewMockDataExportEntClient()
+	dao = NewDataExportDAO(client)
+	ent_user = client.AddUser(uuid.New())
+	other_user = client.AddUser(uuid.New())
+	ready_export, _ = models.NewDataExport(ent_user.PublicID, time.Now())
+	_ = dao.Create(ctx, ready_export)
+	_ = ready_export.MarkReady(models.DataExportStorageKey(ready_export.ExportID()), time.Now())
+	_ = dao.MarkReady(ctx, ready_export, models.HashDataExportDownloadToken("token"))
+	pending_export, _ = models.NewDataExport(ent_user.PublicID, time.Now())
+	_ = dao.Create(ctx, pending_export)
+	other_export, _ = models.NewDataExport(other_user.PublicID, time.Now())
+	_ = dao.Create(ctx, other_export)
+
+	data_exports, err = dao.ListByUserID(ctx, ent_user.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(data_exports) != 2 {
+		t.Fatalf("expected 2 exports, got %d", len(data_exports))
+	}
+	for _, data_export := range data_exports {
+		if data_export.ExportID() == ready_export.ExportID() && data_export.StorageKey() != ready_export.StorageKey() {
+			t.Errorf("expected storage key %s, got %s", ready_export.StorageKey(), data_export.StorageKey())
+		}
+	}
+}
+
+// TestDataExportDAO_MarkFailed は失敗の理由が保存されることをテストします
+func TestDataExportDAO_MarkFailed(t *testing.T) {
+	var ctx context.Context
+	var client *MockDataExportEntClient
+	var dao *DataExportDAO
+	var ent_user *ent.User
+	var data_export *models.DataExport
+	var err error
+
+	ctx = context.Background()
+	client = NewMockDataExportEntClient()
+	dao = NewDataExportDAO(client)
+	ent_user = client.AddUser(uuid.New())
+	data_export, _ = models.NewDataExport(ent_user.PublicID, time.Now())
+	_ = dao.Create(ctx, data_export)
+
+	data_export.MarkFailed("storage unavailable", time.Now())
+	err = dao.MarkFailed(ctx, data_export)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if string(client.Exports()[0].Status) != string(models.DataExportStatusFailed) || client.Exports()[0].LastError != "storage unavailable" {
+		t.Errorf("expected failed export, got status %s (%s)", client.Exports()[0].Status, client.Exports()[0].LastError)
+	}
+}
+
+// TestDataExportDAO_DatabaseError はDBエラーがErrDatabaseErrorに変換されることをテストします
+func TestDataExportDAO_DatabaseError(t *testing.T) {
+	var client *MockDataExportEntClient
+	var dao *DataExportDAO
+	var err error
+
+	client = NewMockDataExportEntClientWithDatabaseError()
+	dao = NewDataExportDAO(client)
+
+	_, err = dao.FindPending(context.Background(), 10)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeRequestEntClientInterface はEmailChangeRequestDAOが利用するEnt Clientのインターフェースです
+type EmailChangeRequestEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetEmailChangeRequestClient() EmailChangeRequestClientInterface
+}
+
+// EmailChangeRequestClientInterface はEnt EmailChangeRequest Clientのインターフェースです
+type EmailChangeRequestClientInterface interface {
+	Create() EmailChangeRequestCreateInterface
+	Query() EmailChangeRequestQueryInterface
+	Delete() EmailChangeRequestDeleteInterface
+}
+
+// EmailChangeRequestCreateInterface はEnt EmailChangeRequest Create Builderのインターフェースです
+type EmailChangeRequestCreateInterface interface {
+	SetUserID(int) EmailChangeRequestCreateInterface
+	SetNewEmail(string) EmailChangeRequestCreateInterface
+	SetTokenHash(string) EmailChangeRequestCreateInterface
+	SetExpiresAt(time.Time) EmailChangeRequestCreateInterface
+	SetCreatedAt(time.Time) EmailChangeRequestCreateInterface
+	Save(ctx context.Context) (*ent.EmailChangeRequest, error)
+}
+
+// EmailChangeRequestQueryInterface はEnt EmailChangeRequest Query Builderのインターフェースです
+type EmailChangeRequestQueryInterface interface {
+	Where(predicates ...any) EmailChangeRequestQueryInterface
+	WithUser() EmailChangeRequestQueryInterface
+	Only(ctx context.Context) (*ent.EmailChangeRequest, error)
+}
+
+// EmailChangeRequestDeleteInterface はEnt EmailChangeRequest Delete Builderのインターフェースです
+type EmailChangeRequestDeleteInterface interface {
+	Where(predicates ...any) EmailChangeRequestDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// EmailChangeRequestDAO は確認待ちのメールアドレスの変更のデータアクセスオブジェクトです
+// 確認リンクのトークンはハッシュのみを保存し、ドメインモデルには含めません
+type EmailChangeRequestDAO struct {
+	client EmailChangeRequestEntClientInterface
+}
+
+// NewEmailChangeRequestDAO は新しいEmailChangeRequestDAOを作成します
+func NewEmailChangeRequestDAO(client EmailChangeRequestEntClientInterface) *EmailChangeRequestDAO {
+	return &EmailChangeRequestDAO{
+		client: client,
+	}
+}
+
+// Replace はユーザーの確認待ちの変更を置き換えて保存します
+// 以前に送信した確認リンクは無効になります
+func (d *EmailChangeRequestDAO) Replace(ctx context.Context, request *models.EmailChangeRequest, token_hash string) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, request.UserID())
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetEmailChangeRequestClient().
+		Delete().
+		Where("user_id", ent_user.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	_, err = d.client.GetEmailChangeRequestClient().
+		Create().
+		SetUserID(ent_user.ID).
+		SetNewEmail(request.NewEmail().Value()).
+		SetTokenHash(token_hash).
+		SetExpiresAt(request.ExpiresAt()).
+		SetCreatedAt(request.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindByTokenHash は確認リンクのトークンのハッシュが一致する変更を返します
+// 一致する変更がない場合はErrInvalidEmailChangeTokenを返します（有効期限の判定は呼び出し側で行います）
+func (d *EmailChangeRequestDAO) FindByTokenHash(ctx context.Context, token_hash string) (*models.EmailChangeRequest, error) {
+	var ent_request *ent.EmailChangeRequest
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_request, err = d.client.GetEmailChangeRequestClient().
+		Query().
+		Where("token_hash", token_hash).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, domain_errors.ErrInvalidEmailChangeToken
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_email_change_request_to_domain(ent_request)
+}
+
+// DeleteByUserID はユーザーの確認待ちの変更を削除します（存在しない場合も成功とします）
+func (d *EmailChangeRequestDAO) DeleteByUserID(ctx context.Context, user_id uuid.UUID) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetEmailChangeRequestClient().
+		Delete().
+		Where("user_id", ent_user.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// convert_ent_email_change_request_to_domain はEntのEmailChangeRequestエンティティをドメインモデルに変換します
+func convert_ent_email_change_request_to_domain(ent_request *ent.EmailChangeRequest) (*models.EmailChangeRequest, error) {
+	var ent_user *ent.User
+	var new_email models.Email
+	var request *models.EmailChangeRequest
+	var err error
+
+	ent_user, err = ent_request.Edges.UserOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	new_email, err = models.NewEmail(ent_request.NewEmail)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	request, err = models.NewEmailChangeRequestWithState(
+		ent_user.PublicID,
+		new_email,
+		ent_request.ExpiresAt,
+		ent_request.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return request, nil
+}
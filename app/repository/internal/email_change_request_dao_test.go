@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// TestEmailChangeRequestDAO_Lifecycle は変更の要求の置き換え・トークンでの検索・削除の流れをテストします
+func TestEmailChangeRequestDAO_Lifecycle(t *testing.T) {
+	var ctx context.Context
+	var client *MockEmailChangeRequestEntClient
+	var dao *EmailChangeRequestDAO
+	var ent_user *ent.User
+	var first_email models.Email
+	var second_email models.Email
+	var first *models.EmailChangeRequest
+	var second *models.EmailChangeRequest
+	var found *models.EmailChangeRequest
+	var err error
+
+	ctx = context.Background()
+	client = NewMockEmailChangeRequestEntClient()
+	dao = NewEmailChangeRequestDAO(client)
+	ent_user = client.AddUser(uuid.New())
+	first_email, _ = models.NewEmail("first@example.com")
+	second_email, _ = models.NewEmail("second@example.com")
+
+	first, _ = models.NewEmailChangeRequest(ent_user.PublicID, first_email, time.Now())
+	err = dao.Replace(ctx, first, models.HashEmailChangeToken("first"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, _ = models.NewEmailChangeRequest(ent_user.PublicID, second_email, time.Now())
+	err = dao.Replace(ctx, second, models.HashEmailChangeToken("second"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Requests()) != 1 {
+		t.Fatalf("expected 1 request after replace, got %d", len(client.Requests()))
+	}
+
+	_, err = dao.FindByTokenHash(ctx, models.HashEmailChangeToken("first"))
+	if !errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+		t.Errorf("expected ErrInvalidEmailChangeToken for replaced token, got %v", err)
+	}
+	found, err = dao.FindByTokenHash(ctx, models.HashEmailChangeToken("second"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found.UserID() != ent_user.PublicID || found.NewEmail().Value() != "second@example.com" {
+		t.Errorf("expected second@example.com of user %s, got %s of %s", ent_user.PublicID, found.NewEmail().Value(), found.UserID())
+	}
+
+	err = dao.DeleteByUserID(ctx, ent_user.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Requests()) != 0 {
+		t.Errorf("expected no request after delete, got %d", len(client.Requests()))
+	}
+}
+
+// TestEmailChangeRequestDAO_UserNotFound は存在しないユーザーの変更の要求がErrUserNotFoundになることをテストします
+func TestEmailChangeRequestDAO_UserNotFound(t *testing.T) {
+	var dao *EmailChangeRequestDAO
+	var new_email models.Email
+	var request *models.EmailChangeRequest
+	var err error
+
+	dao = NewEmailChangeRequestDAO(NewMockEmailChangeRequestEntClient())
+	new_email, _ = models.NewEmail("new@example.com")
+	request, _ = models.NewEmailChangeRequest(uuid.New(), new_email, time.Now())
+	err = dao.Replace(context.Background(), request, models.HashEmailChangeToken("token"))
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestEmailChangeRequestDAO_DatabaseError はDBエラーがErrDatabaseErrorになることをテストします
+func TestEmailChangeRequestDAO_DatabaseError(t *testing.T) {
+	var dao *EmailChangeRequestDAO
+	var err error
+
+	dao = NewEmailChangeRequestDAO(NewMockEmailChangeRequestEntClientWithDatabaseError())
+	_, err = dao.FindByTokenHash(context.Background(), models.HashEmailChangeToken("token"))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,2631 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/authattempt"
+	"sleeve/ent/compensationtask"
+	"sleeve/ent/dataexport"
+	"sleeve/ent/denylistedtoken"
+	"sleeve/ent/follow"
+	"sleeve/ent/followrequest"
+	"sleeve/ent/idempotencyrecord"
+	"sleeve/ent/predicate"
+	"sleeve/ent/schema"
+	"sleeve/ent/schematype"
+	"sleeve/ent/session"
+	"sleeve/ent/totpcredential"
+	"sleeve/ent/uploadedimage"
+	"sleeve/ent/user"
+	"sleeve/ent/userblock"
+	"sleeve/ent/useridentity"
+	"sleeve/ent/usermute"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EntClient は*ent.ClientをDAOのクライアントインターフェースに適合させるアダプターです
+// DAOのWhere(フィールド名, 値, ...)形式の条件をEntの述語に変換します
+type EntClient struct {
+	client *ent.Client
+}
+
+// EntClientが各DAOのクライアントインターフェースを満たすことをコンパイル時に確認します
+var (
+	_ EntClientInterface                   = (*EntClient)(nil)
+	_ RefreshTokenEntClientInterface       = (*EntClient)(nil)
+	_ TokenDenylistEntClientInterface      = (*EntClient)(nil)
+	_ UserIdentityEntClientInterface       = (*EntClient)(nil)
+	_ TotpCredentialEntClientInterface     = (*EntClient)(nil)
+	_ CompensationTaskEntClientInterface   = (*EntClient)(nil)
+	_ SessionEntClientInterface            = (*EntClient)(nil)
+	_ AuthAttemptEntClientInterface        = (*EntClient)(nil)
+	_ IdempotencyRecordEntClientInterface  = (*EntClient)(nil)
+	_ ProfileEntClientInterface            = (*EntClient)(nil)
+	_ UserHandleEntClientInterface         = (*EntClient)(nil)
+	_ FollowEntClientInterface             = (*EntClient)(nil)
+	_ UserBlockEntClientInterface          = (*EntClient)(nil)
+	_ UserMuteEntClientInterface           = (*EntClient)(nil)
+	_ DataExportEntClientInterface         = (*EntClient)(nil)
+	_ EmailChangeRequestEntClientInterface = (*EntClient)(nil)
+	_ UploadedImageEntClientInterface      = (*EntClient)(nil)
+)
+
+// NewEntClient は新しいEntClientを作成します
+func NewEntClient(client *ent.Client) *EntClient {
+	return &EntClient{
+		client: client,
+	}
+}
+
+// GetUserClient はUserClientを返します
+func (c *EntClient) GetUserClient() UserClientInterface {
+	return &ent_user_client{client: c.client.User}
+}
+
+// GetRefreshTokenClient はRefreshTokenClientを返します
+func (c *EntClient) GetRefreshTokenClient() RefreshTokenClientInterface {
+	return &ent_refresh_token_client{client: c.client.RefreshToken}
+}
+
+// GetDenylistedTokenClient はDenylistedTokenClientを返します
+func (c *EntClient) GetDenylistedTokenClient() DenylistedTokenClientInterface {
+	return &ent_denylisted_token_client{client: c.client.DenylistedToken}
+}
+
+// GetUserIdentityClient はUserIdentityClientを返します
+func (c *EntClient) GetUserIdentityClient() UserIdentityClientInterface {
+	return &ent_user_identity_client{client: c.client.UserIdentity}
+}
+
+// GetTotpCredentialClient はTotpCredentialClientを返します
+func (c *EntClient) GetTotpCredentialClient() TotpCredentialClientInterface {
+	return &ent_totp_credential_client{client: c.client.TotpCredential}
+}
+
+// GetCompensationTaskClient はCompensationTaskClientを返します
+func (c *EntClient) GetCompensationTaskClient() CompensationTaskClientInterface {
+	return &ent_compensation_task_client{client: c.client.CompensationTask}
+}
+
+// GetSessionClient はSessionClientを返します
+func (c *EntClient) GetSessionClient() SessionClientInterface {
+	return &ent_session_client{client: c.client.Session}
+}
+
+// GetAuthAttemptClient はAuthAttemptClientを返します
+func (c *EntClient) GetAuthAttemptClient() AuthAttemptClientInterface {
+	return &ent_auth_attempt_client{client: c.client.AuthAttempt}
+}
+
+// GetIdempotencyRecordClient はIdempotencyRecordClientを返します
+func (c *EntClient) GetIdempotencyRecordClient() IdempotencyRecordClientInterface {
+	return &ent_idempotency_record_client{client: c.client.IdempotencyRecord}
+}
+
+// GetProfileClient はProfileClientを返します
+func (c *EntClient) GetProfileClient() ProfileClientInterface {
+	return &ent_profile_client{client: c.client.Profile}
+}
+
+// GetUserHandleClient はUserHandleClientを返します
+func (c *EntClient) GetUserHandleClient() UserHandleClientInterface {
+	return &ent_user_handle_client{client: c.client.UserHandle}
+}
+
+// GetFollowClient はFollowClientを返します
+func (c *EntClient) GetFollowClient() FollowClientInterface {
+	return &ent_follow_client{client: c.client.Follow}
+}
+
+// GetFollowRequestClient はFollowRequestClientを返します
+func (c *EntClient) GetFollowRequestClient() FollowRequestClientInterface {
+	return &ent_follow_request_client{client: c.client.FollowRequest}
+}
+
+// GetUserBlockClient はUserBlockClientを返します
+func (c *EntClient) GetUserBlockClient() UserBlockClientInterface {
+	return &ent_user_block_client{client: c.client.UserBlock}
+}
+
+// GetUserMuteClient はUserMuteClientを返します
+func (c *EntClient) GetUserMuteClient() UserMuteClientInterface {
+	return &ent_user_mute_client{client: c.client.UserMute}
+}
+
+// GetDataExportClient はDataExportClientを返します
+func (c *EntClient) GetDataExportClient() DataExportClientInterface {
+	return &ent_data_export_client{client: c.client.DataExport}
+}
+
+// GetEmailChangeRequestClient はEmailChangeRequestClientを返します
+func (c *EntClient) GetEmailChangeRequestClient() EmailChangeRequestClientInterface {
+	return &ent_email_change_request_client{client: c.client.EmailChangeRequest}
+}
+
+// GetUploadedImageClient はUploadedImageClientを返します
+func (c *EntClient) GetUploadedImageClient() UploadedImageClientInterface {
+	return &ent_uploaded_image_client{client: c.client.UploadedImage}
+}
+
+// WithFollowTx はトランザクション内のEntClientでfnを実行し、fnがエラーを返した場合はロールバックします
+func (c *EntClient) WithFollowTx(ctx context.Context, fn func(client FollowEntClientInterface) error) error {
+	return c.with_tx(ctx, func(tx_client *EntClient) error {
+		return fn(tx_client)
+	})
+}
+
+// with_tx はトランザクション内のEntClientでfnを実行し、fnがエラーを返した場合はロールバック、それ以外はコミットします
+func (c *EntClient) with_tx(ctx context.Context, fn func(tx_client *EntClient) error) error {
+	var tx *ent.Tx
+	var err error
+
+	tx, err = c.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	err = fn(&EntClient{client: tx.Client()})
+	if err != nil {
+		rollback_err := tx.Rollback()
+		if rollback_err != nil {
+			return fmt.Errorf("%w: rolling back transaction: %w", err, rollback_err)
+		}
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// user_visibility は閲覧するユーザーに表示するユーザーの範囲です
+type user_visibility int
+
+const (
+	// visibilityDirect は公開ID・ハンドルで直接指定されたユーザーの範囲です（ブロックがあるユーザーを除外します）
+	visibilityDirect user_visibility = iota
+	// visibilityFeed はフォロー一覧などのフィードの範囲です（閲覧するユーザーがミュートしているユーザーも除外します）
+	visibilityFeed
+	// visibilityContent はアカウントに紐づくコンテンツの所有者の範囲です（非公開アカウントは本人と承認されたフォロワー以外から除外します）
+	visibilityContent
+)
+
+// user_visible_to は閲覧するユーザーから見えるユーザーに絞り込む述語を返します
+// ブロック・ミュート・非公開アカウントはすべてのクエリでこの述語で適用します（HasXxxWithの条件として各エッジに適用します）
+// HasXxxWithの条件は論理削除の自動の絞り込みの対象外のため、関連先のDeletedAtIsNilを条件に含めます
+// どちらの方向のブロックも除外し、visibilityFeedの場合は閲覧するユーザーがミュートしているユーザー、
+// visibilityContentの場合は閲覧するユーザーが本人・承認されたフォロワーではない非公開アカウントも除外します
+// viewer_idが0（未ログイン）の場合はどのユーザーにも一致しないため、ブロック・ミュートは除外せず非公開アカウントのみ除外します
+func user_visible_to(viewer_id int, visibility user_visibility) predicate.User {
+	var predicates []predicate.User
+
+	predicates = []predicate.User{
+		user.Not(user.HasBlockingWith(userblock.BlockedID(viewer_id), userblock.DeletedAtIsNil())),
+		user.Not(user.HasBlockedByWith(userblock.BlockerID(viewer_id), userblock.DeletedAtIsNil())),
+	}
+	switch visibility {
+	case visibilityFeed:
+		predicates = append(predicates, user.Not(user.HasMutedByWith(usermute.MuterID(viewer_id), usermute.DeletedAtIsNil())))
+	case visibilityContent:
+		predicates = append(predicates, user.Or(
+			user.IsPrivate(false),
+			user.ID(viewer_id),
+			user.HasFollowersWith(follow.FollowerID(viewer_id), follow.DeletedAtIsNil()),
+		))
+	}
+	return user.And(predicates...)
+}
+
+// with_hard_delete は指定した種類のエンティティの削除を論理削除ではなく物理削除にしたコンテキストを返します
+// 論理削除された行も対象になるため、有効期限切れの行の定期的な削除でのみ使用します
+func with_hard_delete(ctx context.Context, type_name string) context.Context {
+	return schema.SkipSoftDelete(ctx, type_name)
+}
+
+// build_ent_predicates はWhere(フィールド名, 値, ...)形式の条件をEntの述語に変換します
+// 値がnilの場合はIS NULLとして扱います
+func build_ent_predicates[P ~func(*sql.Selector)](predicates []any) []P {
+	var ent_predicates []P
+
+	if len(predicates)%2 != 0 {
+		panic(fmt.Sprintf("predicates must be field/value pairs: %v", predicates))
+	}
+	ent_predicates = make([]P, 0, len(predicates)/2)
+	for i := 0; i < len(predicates); i += 2 {
+		var field_name string
+		var is_string bool
+
+		field_name, is_string = predicates[i].(string)
+		if !is_string {
+			panic(fmt.Sprintf("predicate field name must be string: %v", predicates[i]))
+		}
+		if predicates[i+1] == nil {
+			ent_predicates = append(ent_predicates, P(sql.FieldIsNull(field_name)))
+			continue
+		}
+		ent_predicates = append(ent_predicates, P(sql.FieldEQ(field_name, predicates[i+1])))
+	}
+	return ent_predicates
+}
+
+// ent_user_client はEnt User Clientのアダプターです
+type ent_user_client struct {
+	client *ent.UserClient
+}
+
+// Create はUserCreate Builderを返します
+func (c *ent_user_client) Create() UserCreateInterface {
+	return &ent_user_create{builder: c.client.Create()}
+}
+
+// Query はUserQuery Builderを返します
+func (c *ent_user_client) Query() UserQueryInterface {
+	return &ent_user_query{builder: c.client.Query()}
+}
+
+// Update はUserUpdate Builderを返します
+func (c *ent_user_client) Update() UserUpdateInterface {
+	return &ent_user_update{builder: c.client.Update()}
+}
+
+// Delete はUserDelete Builderを返します
+func (c *ent_user_client) Delete() UserDeleteInterface {
+	return &ent_user_delete{builder: c.client.Delete()}
+}
+
+// ent_user_create はEnt UserCreate Builderのアダプターです
+type ent_user_create struct {
+	builder *ent.UserCreate
+}
+
+// SetPublicID は公開IDを設定します
+func (b *ent_user_create) SetPublicID(public_id uuid.UUID) UserCreateInterface {
+	b.builder.SetPublicID(public_id)
+	return b
+}
+
+// SetFirebaseUID はFirebase UIDを設定します
+func (b *ent_user_create) SetFirebaseUID(firebase_uid string) UserCreateInterface {
+	b.builder.SetFirebaseUID(firebase_uid)
+	return b
+}
+
+// SetEmail はメールアドレスを設定します
+func (b *ent_user_create) SetEmail(email string) UserCreateInterface {
+	b.builder.SetEmail(email)
+	return b
+}
+
+// SetNillableEmailVerifiedAt はメールアドレスの確認日時を設定します（nilの場合は未確認）
+func (b *ent_user_create) SetNillableEmailVerifiedAt(email_verified_at *time.Time) UserCreateInterface {
+	b.builder.SetNillableEmailVerifiedAt(email_verified_at)
+	return b
+}
+
+// Save はユーザーを保存します
+func (b *ent_user_create) Save(ctx context.Context) (*ent.User, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_query はEnt UserQuery Builderのアダプターです
+type ent_user_query struct {
+	builder *ent.UserQuery
+}
+
+// Where は条件を追加します
+func (b *ent_user_query) Where(predicates ...any) UserQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.User](predicates)...)
+	return b
+}
+
+// Only は条件に一致する単一のユーザーを返します
+func (b *ent_user_query) Only(ctx context.Context) (*ent.User, error) {
+	return b.builder.Only(ctx)
+}
+
+// Exist は条件に一致するユーザーが存在するかを返します
+func (b *ent_user_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// WhereVisibleTo は閲覧するユーザーとの間にどちらの方向にもブロックがないユーザーに絞り込みます
+func (b *ent_user_query) WhereVisibleTo(viewer_id int) UserQueryInterface {
+	b.builder.Where(user_visible_to(viewer_id, visibilityDirect))
+	return b
+}
+
+// WhereContentVisibleTo は閲覧するユーザーがコンテンツを閲覧できるユーザーに絞り込みます（viewer_idは未ログインの場合は0）
+func (b *ent_user_query) WhereContentVisibleTo(viewer_id int) UserQueryInterface {
+	b.builder.Where(user_visible_to(viewer_id, visibilityContent))
+	return b
+}
+
+// WhereFirebaseUIDIn はFirebase UIDが指定した値のいずれかに一致するユーザーに絞り込みます
+func (b *ent_user_query) WhereFirebaseUIDIn(firebase_uids ...string) UserQueryInterface {
+	b.builder.Where(user.FirebaseUIDIn(firebase_uids...))
+	return b
+}
+
+// AfterFirebaseUID はFirebase UIDが指定した値より大きいユーザーに絞り込み、Firebase UIDの昇順に並べます
+func (b *ent_user_query) AfterFirebaseUID(firebase_uid string) UserQueryInterface {
+	b.builder.Where(user.FirebaseUIDGT(firebase_uid)).Order(ent.Asc(user.FieldFirebaseUID))
+	return b
+}
+
+// WhereDeletedBefore は指定した日時より前に論理削除されたユーザーに絞り込みます
+func (b *ent_user_query) WhereDeletedBefore(deleted_before time.Time) UserQueryInterface {
+	b.builder.Where(user.DeletedAtLT(deleted_before))
+	return b
+}
+
+// Limit は取得件数の上限を設定します
+func (b *ent_user_query) Limit(limit int) UserQueryInterface {
+	b.builder.Limit(limit)
+	return b
+}
+
+// All は条件に一致する全てのユーザーを返します
+func (b *ent_user_query) All(ctx context.Context) ([]*ent.User, error) {
+	return b.builder.All(ctx)
+}
+
+// ent_user_update はEnt UserUpdate Builderのアダプターです
+type ent_user_update struct {
+	builder *ent.UserUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_user_update) Where(predicates ...any) UserUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.User](predicates)...)
+	return b
+}
+
+// SetEmail はメールアドレスを設定します
+func (b *ent_user_update) SetEmail(email string) UserUpdateInterface {
+	b.builder.SetEmail(email)
+	return b
+}
+
+// SetEmailVerifiedAt はメールアドレスの確認日時を設定します
+func (b *ent_user_update) SetEmailVerifiedAt(email_verified_at time.Time) UserUpdateInterface {
+	b.builder.SetEmailVerifiedAt(email_verified_at)
+	return b
+}
+
+// ClearEmailVerifiedAt はメールアドレスの確認日時をNULLにします
+func (b *ent_user_update) ClearEmailVerifiedAt() UserUpdateInterface {
+	b.builder.ClearEmailVerifiedAt()
+	return b
+}
+
+// SetMfaEnabledAt は二要素認証を有効にした日時を設定します
+func (b *ent_user_update) SetMfaEnabledAt(mfa_enabled_at time.Time) UserUpdateInterface {
+	b.builder.SetMfaEnabledAt(mfa_enabled_at)
+	return b
+}
+
+// ClearMfaEnabledAt は二要素認証を有効にした日時をNULLにします
+func (b *ent_user_update) ClearMfaEnabledAt() UserUpdateInterface {
+	b.builder.ClearMfaEnabledAt()
+	return b
+}
+
+// SetDeletedAt は論理削除した日時を設定します
+func (b *ent_user_update) SetDeletedAt(deleted_at time.Time) UserUpdateInterface {
+	b.builder.SetDeletedAt(deleted_at)
+	return b
+}
+
+// ClearDeletedAt は論理削除した日時をNULLにします
+func (b *ent_user_update) ClearDeletedAt() UserUpdateInterface {
+	b.builder.ClearDeletedAt()
+	return b
+}
+
+// SetDeletionTokenHash はアカウント削除の取り消しコードのハッシュを設定します
+func (b *ent_user_update) SetDeletionTokenHash(deletion_token_hash string) UserUpdateInterface {
+	b.builder.SetDeletionTokenHash(deletion_token_hash)
+	return b
+}
+
+// ClearDeletionTokenHash はアカウント削除の取り消しコードのハッシュをNULLにします
+func (b *ent_user_update) ClearDeletionTokenHash() UserUpdateInterface {
+	b.builder.ClearDeletionTokenHash()
+	return b
+}
+
+// AddFollowerCount はフォロワー数に加算します
+func (b *ent_user_update) AddFollowerCount(delta int) UserUpdateInterface {
+	b.builder.AddFollowerCount(delta)
+	return b
+}
+
+// AddFollowingCount はフォロー数に加算します
+func (b *ent_user_update) AddFollowingCount(delta int) UserUpdateInterface {
+	b.builder.AddFollowingCount(delta)
+	return b
+}
+
+// SetIsPrivate は非公開アカウントかを設定します
+func (b *ent_user_update) SetIsPrivate(is_private bool) UserUpdateInterface {
+	b.builder.SetIsPrivate(is_private)
+	return b
+}
+
+// SetHideCloset はクローゼットを非表示にするかを設定します
+func (b *ent_user_update) SetHideCloset(hide_closet bool) UserUpdateInterface {
+	b.builder.SetHideCloset(hide_closet)
+	return b
+}
+
+// SetHideLikes はいいねした投稿の一覧を非表示にするかを設定します
+func (b *ent_user_update) SetHideLikes(hide_likes bool) UserUpdateInterface {
+	b.builder.SetHideLikes(hide_likes)
+	return b
+}
+
+// SetCommentPermission は投稿にコメントできるユーザーの範囲を設定します
+func (b *ent_user_update) SetCommentPermission(comment_permission user.CommentPermission) UserUpdateInterface {
+	b.builder.SetCommentPermission(comment_permission)
+	return b
+}
+
+// Save は条件に一致するユーザーを更新し、更新件数を返します
+func (b *ent_user_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_delete はEnt UserDelete Builderのアダプターです
+type ent_user_delete struct {
+	builder *ent.UserDelete
+}
+
+// Where は条件を追加します
+func (b *ent_user_delete) Where(predicates ...any) UserDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.User](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するユーザーを物理削除し、削除件数を返します
+func (b *ent_user_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_refresh_token_client はEnt RefreshToken Clientのアダプターです
+type ent_refresh_token_client struct {
+	client *ent.RefreshTokenClient
+}
+
+// Create はRefreshTokenCreate Builderを返します
+func (c *ent_refresh_token_client) Create() RefreshTokenCreateInterface {
+	return &ent_refresh_token_create{builder: c.client.Create()}
+}
+
+// Query はRefreshTokenQuery Builderを返します
+func (c *ent_refresh_token_client) Query() RefreshTokenQueryInterface {
+	return &ent_refresh_token_query{builder: c.client.Query()}
+}
+
+// Update はRefreshTokenUpdate Builderを返します
+func (c *ent_refresh_token_client) Update() RefreshTokenUpdateInterface {
+	return &ent_refresh_token_update{builder: c.client.Update()}
+}
+
+// ent_refresh_token_create はEnt RefreshTokenCreate Builderのアダプターです
+type ent_refresh_token_create struct {
+	builder *ent.RefreshTokenCreate
+}
+
+// SetTokenID はjtiを設定します
+func (b *ent_refresh_token_create) SetTokenID(token_id string) RefreshTokenCreateInterface {
+	b.builder.SetTokenID(token_id)
+	return b
+}
+
+// SetFamilyID はトークンファミリーIDを設定します
+func (b *ent_refresh_token_create) SetFamilyID(family_id uuid.UUID) RefreshTokenCreateInterface {
+	b.builder.SetFamilyID(family_id)
+	return b
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_refresh_token_create) SetUserID(user_id int) RefreshTokenCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_refresh_token_create) SetExpiresAt(expires_at time.Time) RefreshTokenCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save はリフレッシュトークンを保存します
+func (b *ent_refresh_token_create) Save(ctx context.Context) (*ent.RefreshToken, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_refresh_token_query はEnt RefreshTokenQuery Builderのアダプターです
+type ent_refresh_token_query struct {
+	builder *ent.RefreshTokenQuery
+}
+
+// Where は条件を追加します
+func (b *ent_refresh_token_query) Where(predicates ...any) RefreshTokenQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.RefreshToken](predicates)...)
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_refresh_token_query) WithUser() RefreshTokenQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一のリフレッシュトークンを返します
+func (b *ent_refresh_token_query) Only(ctx context.Context) (*ent.RefreshToken, error) {
+	return b.builder.Only(ctx)
+}
+
+// All は条件に一致する全てのリフレッシュトークンを返します
+func (b *ent_refresh_token_query) All(ctx context.Context) ([]*ent.RefreshToken, error) {
+	return b.builder.All(ctx)
+}
+
+// ent_refresh_token_update はEnt RefreshTokenUpdate Builderのアダプターです
+type ent_refresh_token_update struct {
+	builder *ent.RefreshTokenUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_refresh_token_update) Where(predicates ...any) RefreshTokenUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.RefreshToken](predicates)...)
+	return b
+}
+
+// SetRotatedAt はローテーション日時を設定します
+func (b *ent_refresh_token_update) SetRotatedAt(rotated_at time.Time) RefreshTokenUpdateInterface {
+	b.builder.SetRotatedAt(rotated_at)
+	return b
+}
+
+// SetRevokedAt は失効日時を設定します
+func (b *ent_refresh_token_update) SetRevokedAt(revoked_at time.Time) RefreshTokenUpdateInterface {
+	b.builder.SetRevokedAt(revoked_at)
+	return b
+}
+
+// Save は条件に一致するリフレッシュトークンを更新し、更新件数を返します
+func (b *ent_refresh_token_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_denylisted_token_client はEnt DenylistedToken Clientのアダプターです
+type ent_denylisted_token_client struct {
+	client *ent.DenylistedTokenClient
+}
+
+// Create はDenylistedTokenCreate Builderを返します
+func (c *ent_denylisted_token_client) Create() DenylistedTokenCreateInterface {
+	return &ent_denylisted_token_create{builder: c.client.Create()}
+}
+
+// Query はDenylistedTokenQuery Builderを返します
+func (c *ent_denylisted_token_client) Query() DenylistedTokenQueryInterface {
+	return &ent_denylisted_token_query{builder: c.client.Query()}
+}
+
+// Delete はDenylistedTokenDelete Builderを返します
+func (c *ent_denylisted_token_client) Delete() DenylistedTokenDeleteInterface {
+	return &ent_denylisted_token_delete{builder: c.client.Delete()}
+}
+
+// ent_denylisted_token_create はEnt DenylistedTokenCreate Builderのアダプターです
+type ent_denylisted_token_create struct {
+	builder *ent.DenylistedTokenCreate
+}
+
+// SetKind は種類を設定します
+func (b *ent_denylisted_token_create) SetKind(kind denylistedtoken.Kind) DenylistedTokenCreateInterface {
+	b.builder.SetKind(kind)
+	return b
+}
+
+// SetValue はjtiまたはsidを設定します
+func (b *ent_denylisted_token_create) SetValue(value string) DenylistedTokenCreateInterface {
+	b.builder.SetValue(value)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_denylisted_token_create) SetExpiresAt(expires_at time.Time) DenylistedTokenCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save はdenylistを保存します
+func (b *ent_denylisted_token_create) Save(ctx context.Context) (*ent.DenylistedToken, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_denylisted_token_query はEnt DenylistedTokenQuery Builderのアダプターです
+type ent_denylisted_token_query struct {
+	builder *ent.DenylistedTokenQuery
+}
+
+// Where は条件を追加します
+func (b *ent_denylisted_token_query) Where(predicates ...any) DenylistedTokenQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.DenylistedToken](predicates)...)
+	return b
+}
+
+// Exist は条件に一致する登録が存在するかを返します
+func (b *ent_denylisted_token_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// ent_denylisted_token_delete はEnt DenylistedTokenDelete Builderのアダプターです
+type ent_denylisted_token_delete struct {
+	builder *ent.DenylistedTokenDelete
+}
+
+// ExpiredAt は有効期限が指定日時以前の登録に絞り込みます
+func (b *ent_denylisted_token_delete) ExpiredAt(now time.Time) DenylistedTokenDeleteInterface {
+	b.builder.Where(denylistedtoken.ExpiresAtLTE(now))
+	return b
+}
+
+// Exec は条件に一致する登録を削除し、削除件数を返します
+func (b *ent_denylisted_token_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_user_identity_client はEnt UserIdentity Clientのアダプターです
+type ent_user_identity_client struct {
+	client *ent.UserIdentityClient
+}
+
+// Create はUserIdentityCreate Builderを返します
+func (c *ent_user_identity_client) Create() UserIdentityCreateInterface {
+	return &ent_user_identity_create{builder: c.client.Create()}
+}
+
+// Query はUserIdentityQuery Builderを返します
+func (c *ent_user_identity_client) Query() UserIdentityQueryInterface {
+	return &ent_user_identity_query{builder: c.client.Query()}
+}
+
+// Delete はUserIdentityDelete Builderを返します
+func (c *ent_user_identity_client) Delete() UserIdentityDeleteInterface {
+	return &ent_user_identity_delete{builder: c.client.Delete()}
+}
+
+// ent_user_identity_create はEnt UserIdentityCreate Builderのアダプターです
+type ent_user_identity_create struct {
+	builder *ent.UserIdentityCreate
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_user_identity_create) SetUserID(user_id int) UserIdentityCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetProvider は外部プロバイダを設定します
+func (b *ent_user_identity_create) SetProvider(provider useridentity.Provider) UserIdentityCreateInterface {
+	b.builder.SetProvider(provider)
+	return b
+}
+
+// SetSubject は外部プロバイダでのユーザー識別子を設定します
+func (b *ent_user_identity_create) SetSubject(subject string) UserIdentityCreateInterface {
+	b.builder.SetSubject(subject)
+	return b
+}
+
+// SetLinkedAt は連携日時を設定します
+func (b *ent_user_identity_create) SetLinkedAt(linked_at time.Time) UserIdentityCreateInterface {
+	b.builder.SetLinkedAt(linked_at)
+	return b
+}
+
+// Save は連携を保存します
+func (b *ent_user_identity_create) Save(ctx context.Context) (*ent.UserIdentity, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_identity_query はEnt UserIdentityQuery Builderのアダプターです
+type ent_user_identity_query struct {
+	builder *ent.UserIdentityQuery
+}
+
+// Where は条件を追加します
+func (b *ent_user_identity_query) Where(predicates ...any) UserIdentityQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserIdentity](predicates)...)
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_user_identity_query) WithUser() UserIdentityQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一の連携を返します
+func (b *ent_user_identity_query) Only(ctx context.Context) (*ent.UserIdentity, error) {
+	return b.builder.Only(ctx)
+}
+
+// All は条件に一致する全ての連携を返します
+func (b *ent_user_identity_query) All(ctx context.Context) ([]*ent.UserIdentity, error) {
+	return b.builder.All(ctx)
+}
+
+// ent_user_identity_delete はEnt UserIdentityDelete Builderのアダプターです
+type ent_user_identity_delete struct {
+	builder *ent.UserIdentityDelete
+}
+
+// Where は条件を追加します
+func (b *ent_user_identity_delete) Where(predicates ...any) UserIdentityDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserIdentity](predicates)...)
+	return b
+}
+
+// Exec は条件に一致する連携を削除し、削除件数を返します
+func (b *ent_user_identity_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_totp_credential_client はEnt TotpCredential Clientのアダプターです
+type ent_totp_credential_client struct {
+	client *ent.TotpCredentialClient
+}
+
+// Create はTotpCredentialCreate Builderを返します
+func (c *ent_totp_credential_client) Create() TotpCredentialCreateInterface {
+	return &ent_totp_credential_create{builder: c.client.Create()}
+}
+
+// Query はTotpCredentialQuery Builderを返します
+func (c *ent_totp_credential_client) Query() TotpCredentialQueryInterface {
+	return &ent_totp_credential_query{builder: c.client.Query()}
+}
+
+// Update はTotpCredentialUpdate Builderを返します
+func (c *ent_totp_credential_client) Update() TotpCredentialUpdateInterface {
+	return &ent_totp_credential_update{builder: c.client.Update()}
+}
+
+// Delete はTotpCredentialDelete Builderを返します
+func (c *ent_totp_credential_client) Delete() TotpCredentialDeleteInterface {
+	return &ent_totp_credential_delete{builder: c.client.Delete()}
+}
+
+// ent_totp_credential_create はEnt TotpCredentialCreate Builderのアダプターです
+type ent_totp_credential_create struct {
+	builder *ent.TotpCredentialCreate
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_totp_credential_create) SetUserID(user_id int) TotpCredentialCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetEncryptedSecret は暗号化した共有シークレットを設定します
+func (b *ent_totp_credential_create) SetEncryptedSecret(encrypted_secret string) TotpCredentialCreateInterface {
+	b.builder.SetEncryptedSecret(encrypted_secret)
+	return b
+}
+
+// SetRecoveryCodeHashes はリカバリーコードのハッシュを設定します
+func (b *ent_totp_credential_create) SetRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialCreateInterface {
+	b.builder.SetRecoveryCodeHashes(recovery_code_hashes)
+	return b
+}
+
+// SetLastUsedStep は最後に使用された認証コードのタイムステップを設定します
+func (b *ent_totp_credential_create) SetLastUsedStep(last_used_step int64) TotpCredentialCreateInterface {
+	b.builder.SetLastUsedStep(last_used_step)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_totp_credential_create) SetCreatedAt(created_at time.Time) TotpCredentialCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save は認証情報を保存します
+func (b *ent_totp_credential_create) Save(ctx context.Context) (*ent.TotpCredential, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_totp_credential_query はEnt TotpCredentialQuery Builderのアダプターです
+type ent_totp_credential_query struct {
+	builder *ent.TotpCredentialQuery
+}
+
+// Where は条件を追加します
+func (b *ent_totp_credential_query) Where(predicates ...any) TotpCredentialQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.TotpCredential](predicates)...)
+	return b
+}
+
+// Only は条件に一致する単一の認証情報を返します
+func (b *ent_totp_credential_query) Only(ctx context.Context) (*ent.TotpCredential, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_totp_credential_update はEnt TotpCredentialUpdate Builderのアダプターです
+type ent_totp_credential_update struct {
+	builder *ent.TotpCredentialUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_totp_credential_update) Where(predicates ...any) TotpCredentialUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.TotpCredential](predicates)...)
+	return b
+}
+
+// WhereLastUsedStepBefore は使用済みのタイムステップが指定より前である条件を追加します
+func (b *ent_totp_credential_update) WhereLastUsedStepBefore(step int64) TotpCredentialUpdateInterface {
+	b.builder.Where(totpcredential.LastUsedStepLT(step))
+	return b
+}
+
+// WhereRecoveryCodeHashes はリカバリーコードのハッシュが指定と一致する条件を追加します
+// jsonb列のため、値をJSONにエンコードしてjsonbとして比較します
+func (b *ent_totp_credential_update) WhereRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialUpdateInterface {
+	var encoded []byte
+
+	if recovery_code_hashes == nil {
+		recovery_code_hashes = []string{}
+	}
+	encoded, _ = json.Marshal(recovery_code_hashes)
+	b.builder.Where(func(s *sql.Selector) {
+		s.Where(sql.P(func(builder *sql.Builder) {
+			builder.WriteString(s.C(totpcredential.FieldRecoveryCodeHashes)).WriteOp(sql.OpEQ).Arg(string(encoded)).WriteString("::jsonb")
+		}))
+	})
+	return b
+}
+
+// SetRecoveryCodeHashes はリカバリーコードのハッシュを設定します
+func (b *ent_totp_credential_update) SetRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialUpdateInterface {
+	b.builder.SetRecoveryCodeHashes(recovery_code_hashes)
+	return b
+}
+
+// SetLastUsedStep は最後に使用された認証コードのタイムステップを設定します
+func (b *ent_totp_credential_update) SetLastUsedStep(last_used_step int64) TotpCredentialUpdateInterface {
+	b.builder.SetLastUsedStep(last_used_step)
+	return b
+}
+
+// Save は条件に一致する認証情報を更新し、更新件数を返します
+func (b *ent_totp_credential_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_totp_credential_delete はEnt TotpCredentialDelete Builderのアダプターです
+type ent_totp_credential_delete struct {
+	builder *ent.TotpCredentialDelete
+}
+
+// Where は条件を追加します
+func (b *ent_totp_credential_delete) Where(predicates ...any) TotpCredentialDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.TotpCredential](predicates)...)
+	return b
+}
+
+// Exec は条件に一致する認証情報を削除し、削除件数を返します
+func (b *ent_totp_credential_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_compensation_task_client はEnt CompensationTask Clientのアダプターです
+type ent_compensation_task_client struct {
+	client *ent.CompensationTaskClient
+}
+
+// Create はCompensationTaskCreate Builderを返します
+func (c *ent_compensation_task_client) Create() CompensationTaskCreateInterface {
+	return &ent_compensation_task_create{builder: c.client.Create()}
+}
+
+// Query はCompensationTaskQuery Builderを返します
+func (c *ent_compensation_task_client) Query() CompensationTaskQueryInterface {
+	return &ent_compensation_task_query{builder: c.client.Query()}
+}
+
+// Update はCompensationTaskUpdate Builderを返します
+func (c *ent_compensation_task_client) Update() CompensationTaskUpdateInterface {
+	return &ent_compensation_task_update{builder: c.client.Update()}
+}
+
+// Delete はCompensationTaskDelete Builderを返します
+func (c *ent_compensation_task_client) Delete() CompensationTaskDeleteInterface {
+	return &ent_compensation_task_delete{builder: c.client.Delete()}
+}
+
+// ent_compensation_task_create はEnt CompensationTaskCreate Builderのアダプターです
+type ent_compensation_task_create struct {
+	builder *ent.CompensationTaskCreate
+}
+
+// SetTaskID は補償処理IDを設定します
+func (b *ent_compensation_task_create) SetTaskID(task_id uuid.UUID) CompensationTaskCreateInterface {
+	b.builder.SetTaskID(task_id)
+	return b
+}
+
+// SetKind は補償処理の種類を設定します
+func (b *ent_compensation_task_create) SetKind(kind compensationtask.Kind) CompensationTaskCreateInterface {
+	b.builder.SetKind(kind)
+	return b
+}
+
+// SetTarget は補償処理の対象を設定します
+func (b *ent_compensation_task_create) SetTarget(target string) CompensationTaskCreateInterface {
+	b.builder.SetTarget(target)
+	return b
+}
+
+// SetStatus は状態を設定します
+func (b *ent_compensation_task_create) SetStatus(status compensationtask.Status) CompensationTaskCreateInterface {
+	b.builder.SetStatus(status)
+	return b
+}
+
+// SetAttempts は試行回数を設定します
+func (b *ent_compensation_task_create) SetAttempts(attempts int) CompensationTaskCreateInterface {
+	b.builder.SetAttempts(attempts)
+	return b
+}
+
+// SetLastError は最後に失敗した際のエラーメッセージを設定します
+func (b *ent_compensation_task_create) SetLastError(last_error string) CompensationTaskCreateInterface {
+	b.builder.SetLastError(last_error)
+	return b
+}
+
+// SetNextAttemptAt は次回の試行日時を設定します
+func (b *ent_compensation_task_create) SetNextAttemptAt(next_attempt_at time.Time) CompensationTaskCreateInterface {
+	b.builder.SetNextAttemptAt(next_attempt_at)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_compensation_task_create) SetCreatedAt(created_at time.Time) CompensationTaskCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save は補償処理を保存します
+func (b *ent_compensation_task_create) Save(ctx context.Context) (*ent.CompensationTask, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_compensation_task_query はEnt CompensationTaskQuery Builderのアダプターです
+type ent_compensation_task_query struct {
+	builder *ent.CompensationTaskQuery
+}
+
+// Where は条件を追加します
+func (b *ent_compensation_task_query) Where(predicates ...any) CompensationTaskQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.CompensationTask](predicates)...)
+	return b
+}
+
+// DueAt は次回の試行日時が指定日時以前の補償処理に絞り込み、次回の試行日時の昇順に並べます
+func (b *ent_compensation_task_query) DueAt(now time.Time) CompensationTaskQueryInterface {
+	b.builder.Where(compensationtask.NextAttemptAtLTE(now)).Order(ent.Asc(compensationtask.FieldNextAttemptAt))
+	return b
+}
+
+// Limit は取得件数の上限を設定します
+func (b *ent_compensation_task_query) Limit(limit int) CompensationTaskQueryInterface {
+	b.builder.Limit(limit)
+	return b
+}
+
+// All は条件に一致する全ての補償処理を返します
+func (b *ent_compensation_task_query) All(ctx context.Context) ([]*ent.CompensationTask, error) {
+	return b.builder.All(ctx)
+}
+
+// ent_compensation_task_update はEnt CompensationTaskUpdate Builderのアダプターです
+type ent_compensation_task_update struct {
+	builder *ent.CompensationTaskUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_compensation_task_update) Where(predicates ...any) CompensationTaskUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.CompensationTask](predicates)...)
+	return b
+}
+
+// SetStatus は状態を設定します
+func (b *ent_compensation_task_update) SetStatus(status compensationtask.Status) CompensationTaskUpdateInterface {
+	b.builder.SetStatus(status)
+	return b
+}
+
+// SetAttempts は試行回数を設定します
+func (b *ent_compensation_task_update) SetAttempts(attempts int) CompensationTaskUpdateInterface {
+	b.builder.SetAttempts(attempts)
+	return b
+}
+
+// SetLastError は最後に失敗した際のエラーメッセージを設定します
+func (b *ent_compensation_task_update) SetLastError(last_error string) CompensationTaskUpdateInterface {
+	b.builder.SetLastError(last_error)
+	return b
+}
+
+// SetNextAttemptAt は次回の試行日時を設定します
+func (b *ent_compensation_task_update) SetNextAttemptAt(next_attempt_at time.Time) CompensationTaskUpdateInterface {
+	b.builder.SetNextAttemptAt(next_attempt_at)
+	return b
+}
+
+// Save は条件に一致する補償処理を更新し、更新件数を返します
+func (b *ent_compensation_task_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_compensation_task_delete はEnt CompensationTaskDelete Builderのアダプターです
+type ent_compensation_task_delete struct {
+	builder *ent.CompensationTaskDelete
+}
+
+// Where は条件を追加します
+func (b *ent_compensation_task_delete) Where(predicates ...any) CompensationTaskDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.CompensationTask](predicates)...)
+	return b
+}
+
+// Exec は条件に一致する補償処理を削除し、削除件数を返します
+func (b *ent_compensation_task_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_session_client はEnt Session Clientのアダプターです
+type ent_session_client struct {
+	client *ent.SessionClient
+}
+
+// Create はSessionCreate Builderを返します
+func (c *ent_session_client) Create() SessionCreateInterface {
+	return &ent_session_create{builder: c.client.Create()}
+}
+
+// Query はSessionQuery Builderを返します
+func (c *ent_session_client) Query() SessionQueryInterface {
+	return &ent_session_query{builder: c.client.Query()}
+}
+
+// Update はSessionUpdate Builderを返します
+func (c *ent_session_client) Update() SessionUpdateInterface {
+	return &ent_session_update{builder: c.client.Update()}
+}
+
+// ent_session_create はEnt SessionCreate Builderのアダプターです
+type ent_session_create struct {
+	builder *ent.SessionCreate
+}
+
+// SetSessionID はセッションIDを設定します
+func (b *ent_session_create) SetSessionID(session_id uuid.UUID) SessionCreateInterface {
+	b.builder.SetSessionID(session_id)
+	return b
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_session_create) SetUserID(user_id int) SessionCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetDeviceName は端末名を設定します
+func (b *ent_session_create) SetDeviceName(device_name string) SessionCreateInterface {
+	b.builder.SetDeviceName(device_name)
+	return b
+}
+
+// SetPlatform は端末のプラットフォームを設定します
+func (b *ent_session_create) SetPlatform(platform session.Platform) SessionCreateInterface {
+	b.builder.SetPlatform(platform)
+	return b
+}
+
+// SetIPAddress はIPアドレスを設定します
+func (b *ent_session_create) SetIPAddress(ip_address string) SessionCreateInterface {
+	b.builder.SetIPAddress(ip_address)
+	return b
+}
+
+// SetUserAgent はUser-Agentを設定します
+func (b *ent_session_create) SetUserAgent(user_agent string) SessionCreateInterface {
+	b.builder.SetUserAgent(user_agent)
+	return b
+}
+
+// SetLastSeenAt は最終利用日時を設定します
+func (b *ent_session_create) SetLastSeenAt(last_seen_at time.Time) SessionCreateInterface {
+	b.builder.SetLastSeenAt(last_seen_at)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_session_create) SetExpiresAt(expires_at time.Time) SessionCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_session_create) SetCreatedAt(created_at time.Time) SessionCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はセッションを保存します
+func (b *ent_session_create) Save(ctx context.Context) (*ent.Session, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_session_query はEnt SessionQuery Builderのアダプターです
+type ent_session_query struct {
+	builder *ent.SessionQuery
+}
+
+// Where は条件を追加します
+func (b *ent_session_query) Where(predicates ...any) SessionQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.Session](predicates)...)
+	return b
+}
+
+// ActiveAt は有効期限が指定日時より後のセッションに絞り込み、最終利用日時の降順に並べます
+func (b *ent_session_query) ActiveAt(now time.Time) SessionQueryInterface {
+	b.builder.Where(session.ExpiresAtGT(now)).Order(ent.Desc(session.FieldLastSeenAt))
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_session_query) WithUser() SessionQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一のセッションを返します
+func (b *ent_session_query) Only(ctx context.Context) (*ent.Session, error) {
+	return b.builder.Only(ctx)
+}
+
+// All は条件に一致する全てのセッションを返します
+func (b *ent_session_query) All(ctx context.Context) ([]*ent.Session, error) {
+	return b.builder.All(ctx)
+}
+
+// ent_session_update はEnt SessionUpdate Builderのアダプターです
+type ent_session_update struct {
+	builder *ent.SessionUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_session_update) Where(predicates ...any) SessionUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.Session](predicates)...)
+	return b
+}
+
+// SetLastSeenAt は最終利用日時を設定します
+func (b *ent_session_update) SetLastSeenAt(last_seen_at time.Time) SessionUpdateInterface {
+	b.builder.SetLastSeenAt(last_seen_at)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_session_update) SetExpiresAt(expires_at time.Time) SessionUpdateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// SetRevokedAt は失効日時を設定します
+func (b *ent_session_update) SetRevokedAt(revoked_at time.Time) SessionUpdateInterface {
+	b.builder.SetRevokedAt(revoked_at)
+	return b
+}
+
+// Save は条件に一致するセッションを更新し、更新件数を返します
+func (b *ent_session_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_auth_attempt_client はEnt AuthAttempt Clientのアダプターです
+type ent_auth_attempt_client struct {
+	client *ent.AuthAttemptClient
+}
+
+// Create はAuthAttemptCreate Builderを返します
+func (c *ent_auth_attempt_client) Create() AuthAttemptCreateInterface {
+	return &ent_auth_attempt_create{builder: c.client.Create()}
+}
+
+// Query はAuthAttemptQuery Builderを返します
+func (c *ent_auth_attempt_client) Query() AuthAttemptQueryInterface {
+	return &ent_auth_attempt_query{builder: c.client.Query()}
+}
+
+// Update はAuthAttemptUpdate Builderを返します
+func (c *ent_auth_attempt_client) Update() AuthAttemptUpdateInterface {
+	return &ent_auth_attempt_update{builder: c.client.Update()}
+}
+
+// Delete はAuthAttemptDelete Builderを返します
+func (c *ent_auth_attempt_client) Delete() AuthAttemptDeleteInterface {
+	return &ent_auth_attempt_delete{builder: c.client.Delete()}
+}
+
+// ent_auth_attempt_create はEnt AuthAttemptCreate Builderのアダプターです
+type ent_auth_attempt_create struct {
+	builder *ent.AuthAttemptCreate
+}
+
+// SetKey はキーを設定します
+func (b *ent_auth_attempt_create) SetKey(key string) AuthAttemptCreateInterface {
+	b.builder.SetKey(key)
+	return b
+}
+
+// SetFailureCount は失敗回数を設定します
+func (b *ent_auth_attempt_create) SetFailureCount(failure_count int) AuthAttemptCreateInterface {
+	b.builder.SetFailureCount(failure_count)
+	return b
+}
+
+// SetLastFailedAt は最後の失敗日時を設定します
+func (b *ent_auth_attempt_create) SetLastFailedAt(last_failed_at time.Time) AuthAttemptCreateInterface {
+	b.builder.SetLastFailedAt(last_failed_at)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_auth_attempt_create) SetExpiresAt(expires_at time.Time) AuthAttemptCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save は失敗回数の記録を保存します
+func (b *ent_auth_attempt_create) Save(ctx context.Context) (*ent.AuthAttempt, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_auth_attempt_query はEnt AuthAttemptQuery Builderのアダプターです
+type ent_auth_attempt_query struct {
+	builder *ent.AuthAttemptQuery
+}
+
+// Where は条件を追加します
+func (b *ent_auth_attempt_query) Where(predicates ...any) AuthAttemptQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.AuthAttempt](predicates)...)
+	return b
+}
+
+// Only は条件に一致する単一の失敗回数の記録を返します
+func (b *ent_auth_attempt_query) Only(ctx context.Context) (*ent.AuthAttempt, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_auth_attempt_update はEnt AuthAttemptUpdate Builderのアダプターです
+type ent_auth_attempt_update struct {
+	builder *ent.AuthAttemptUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_auth_attempt_update) Where(predicates ...any) AuthAttemptUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.AuthAttempt](predicates)...)
+	return b
+}
+
+// ActiveAt は有効期限が指定日時より後の記録に絞り込みます
+func (b *ent_auth_attempt_update) ActiveAt(now time.Time) AuthAttemptUpdateInterface {
+	b.builder.Where(authattempt.ExpiresAtGT(now))
+	return b
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (b *ent_auth_attempt_update) ExpiredAt(now time.Time) AuthAttemptUpdateInterface {
+	b.builder.Where(authattempt.ExpiresAtLTE(now))
+	return b
+}
+
+// AddFailureCount は失敗回数を加算します
+func (b *ent_auth_attempt_update) AddFailureCount(failure_count int) AuthAttemptUpdateInterface {
+	b.builder.AddFailureCount(failure_count)
+	return b
+}
+
+// SetFailureCount は失敗回数を設定します
+func (b *ent_auth_attempt_update) SetFailureCount(failure_count int) AuthAttemptUpdateInterface {
+	b.builder.SetFailureCount(failure_count)
+	return b
+}
+
+// SetLastFailedAt は最後の失敗日時を設定します
+func (b *ent_auth_attempt_update) SetLastFailedAt(last_failed_at time.Time) AuthAttemptUpdateInterface {
+	b.builder.SetLastFailedAt(last_failed_at)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_auth_attempt_update) SetExpiresAt(expires_at time.Time) AuthAttemptUpdateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save は条件に一致する失敗回数の記録を更新し、更新件数を返します
+func (b *ent_auth_attempt_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_auth_attempt_delete はEnt AuthAttemptDelete Builderのアダプターです
+type ent_auth_attempt_delete struct {
+	builder *ent.AuthAttemptDelete
+}
+
+// Where は条件を追加します
+func (b *ent_auth_attempt_delete) Where(predicates ...any) AuthAttemptDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.AuthAttempt](predicates)...)
+	return b
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (b *ent_auth_attempt_delete) ExpiredAt(now time.Time) AuthAttemptDeleteInterface {
+	b.builder.Where(authattempt.ExpiresAtLTE(now))
+	return b
+}
+
+// Exec は条件に一致する失敗回数の記録を削除し、削除件数を返します
+func (b *ent_auth_attempt_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_idempotency_record_client はEnt IdempotencyRecord Clientのアダプターです
+type ent_idempotency_record_client struct {
+	client *ent.IdempotencyRecordClient
+}
+
+// Create はIdempotencyRecordCreate Builderを返します
+func (c *ent_idempotency_record_client) Create() IdempotencyRecordCreateInterface {
+	return &ent_idempotency_record_create{builder: c.client.Create()}
+}
+
+// Query はIdempotencyRecordQuery Builderを返します
+func (c *ent_idempotency_record_client) Query() IdempotencyRecordQueryInterface {
+	return &ent_idempotency_record_query{builder: c.client.Query()}
+}
+
+// Update はIdempotencyRecordUpdate Builderを返します
+func (c *ent_idempotency_record_client) Update() IdempotencyRecordUpdateInterface {
+	return &ent_idempotency_record_update{builder: c.client.Update()}
+}
+
+// Delete はIdempotencyRecordDelete Builderを返します
+func (c *ent_idempotency_record_client) Delete() IdempotencyRecordDeleteInterface {
+	return &ent_idempotency_record_delete{builder: c.client.Delete()}
+}
+
+// ent_idempotency_record_create はEnt IdempotencyRecordCreate Builderのアダプターです
+type ent_idempotency_record_create struct {
+	builder *ent.IdempotencyRecordCreate
+}
+
+// SetOperation は操作を設定します
+func (b *ent_idempotency_record_create) SetOperation(operation idempotencyrecord.Operation) IdempotencyRecordCreateInterface {
+	b.builder.SetOperation(operation)
+	return b
+}
+
+// SetKey は冪等キーを設定します
+func (b *ent_idempotency_record_create) SetKey(key string) IdempotencyRecordCreateInterface {
+	b.builder.SetKey(key)
+	return b
+}
+
+// SetFingerprint はリクエストのフィンガープリントを設定します
+func (b *ent_idempotency_record_create) SetFingerprint(fingerprint string) IdempotencyRecordCreateInterface {
+	b.builder.SetFingerprint(fingerprint)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_idempotency_record_create) SetCreatedAt(created_at time.Time) IdempotencyRecordCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// SetExpiresAt は有効期限を設定します
+func (b *ent_idempotency_record_create) SetExpiresAt(expires_at time.Time) IdempotencyRecordCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save は冪等キーの記録を保存します
+func (b *ent_idempotency_record_create) Save(ctx context.Context) (*ent.IdempotencyRecord, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_idempotency_record_query はEnt IdempotencyRecordQuery Builderのアダプターです
+type ent_idempotency_record_query struct {
+	builder *ent.IdempotencyRecordQuery
+}
+
+// Where は条件を追加します
+func (b *ent_idempotency_record_query) Where(predicates ...any) IdempotencyRecordQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.IdempotencyRecord](predicates)...)
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_idempotency_record_query) WithUser() IdempotencyRecordQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一の冪等キーの記録を返します
+func (b *ent_idempotency_record_query) Only(ctx context.Context) (*ent.IdempotencyRecord, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_idempotency_record_update はEnt IdempotencyRecordUpdate Builderのアダプターです
+type ent_idempotency_record_update struct {
+	builder *ent.IdempotencyRecordUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_idempotency_record_update) Where(predicates ...any) IdempotencyRecordUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.IdempotencyRecord](predicates)...)
+	return b
+}
+
+// SetUserID は処理結果のユーザーIDを設定します
+func (b *ent_idempotency_record_update) SetUserID(user_id int) IdempotencyRecordUpdateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// Save は条件に一致する冪等キーの記録を更新し、更新件数を返します
+func (b *ent_idempotency_record_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_idempotency_record_delete はEnt IdempotencyRecordDelete Builderのアダプターです
+type ent_idempotency_record_delete struct {
+	builder *ent.IdempotencyRecordDelete
+}
+
+// Where は条件を追加します
+func (b *ent_idempotency_record_delete) Where(predicates ...any) IdempotencyRecordDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.IdempotencyRecord](predicates)...)
+	return b
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (b *ent_idempotency_record_delete) ExpiredAt(now time.Time) IdempotencyRecordDeleteInterface {
+	b.builder.Where(idempotencyrecord.ExpiresAtLTE(now))
+	return b
+}
+
+// Exec は条件に一致する冪等キーの記録を削除し、削除件数を返します
+func (b *ent_idempotency_record_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_profile_client はEnt Profile Clientのアダプターです
+type ent_profile_client struct {
+	client *ent.ProfileClient
+}
+
+// Create はProfileCreate Builderを返します
+func (c *ent_profile_client) Create() ProfileCreateInterface {
+	return &ent_profile_create{builder: c.client.Create()}
+}
+
+// Query はProfileQuery Builderを返します
+func (c *ent_profile_client) Query() ProfileQueryInterface {
+	return &ent_profile_query{builder: c.client.Query()}
+}
+
+// Update はProfileUpdate Builderを返します
+func (c *ent_profile_client) Update() ProfileUpdateInterface {
+	return &ent_profile_update{builder: c.client.Update()}
+}
+
+// ent_profile_create はEnt ProfileCreate Builderのアダプターです
+type ent_profile_create struct {
+	builder *ent.ProfileCreate
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_profile_create) SetUserID(user_id int) ProfileCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetDisplayName は表示名を設定します
+func (b *ent_profile_create) SetDisplayName(display_name string) ProfileCreateInterface {
+	b.builder.SetDisplayName(display_name)
+	return b
+}
+
+// SetBio は自己紹介文を設定します
+func (b *ent_profile_create) SetBio(bio string) ProfileCreateInterface {
+	b.builder.SetBio(bio)
+	return b
+}
+
+// SetNillableAvatarURL はアバター画像のURLを設定します（nilの場合は未設定）
+func (b *ent_profile_create) SetNillableAvatarURL(avatar_url *string) ProfileCreateInterface {
+	b.builder.SetNillableAvatarURL(avatar_url)
+	return b
+}
+
+// SetNillableHeightCm は身長を設定します（nilの場合は未設定）
+func (b *ent_profile_create) SetNillableHeightCm(height_cm *int) ProfileCreateInterface {
+	b.builder.SetNillableHeightCm(height_cm)
+	return b
+}
+
+// SetFavoriteStyles は好きなスタイルを設定します
+func (b *ent_profile_create) SetFavoriteStyles(favorite_styles []string) ProfileCreateInterface {
+	b.builder.SetFavoriteStyles(favorite_styles)
+	return b
+}
+
+// SetLinks はリンクを設定します
+func (b *ent_profile_create) SetLinks(links []string) ProfileCreateInterface {
+	b.builder.SetLinks(links)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_profile_create) SetCreatedAt(created_at time.Time) ProfileCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// SetUpdatedAt は更新日時を設定します
+func (b *ent_profile_create) SetUpdatedAt(updated_at time.Time) ProfileCreateInterface {
+	b.builder.SetUpdatedAt(updated_at)
+	return b
+}
+
+// Save はプロフィールを保存します
+func (b *ent_profile_create) Save(ctx context.Context) (*ent.Profile, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_profile_query はEnt ProfileQuery Builderのアダプターです
+type ent_profile_query struct {
+	builder *ent.ProfileQuery
+}
+
+// Where は条件を追加します
+func (b *ent_profile_query) Where(predicates ...any) ProfileQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.Profile](predicates)...)
+	return b
+}
+
+// Only は条件に一致する単一のプロフィールを返します
+func (b *ent_profile_query) Only(ctx context.Context) (*ent.Profile, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_profile_update はEnt ProfileUpdate Builderのアダプターです
+type ent_profile_update struct {
+	builder *ent.ProfileUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_profile_update) Where(predicates ...any) ProfileUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.Profile](predicates)...)
+	return b
+}
+
+// SetDisplayName は表示名を設定します
+func (b *ent_profile_update) SetDisplayName(display_name string) ProfileUpdateInterface {
+	b.builder.SetDisplayName(display_name)
+	return b
+}
+
+// SetBio は自己紹介文を設定します
+func (b *ent_profile_update) SetBio(bio string) ProfileUpdateInterface {
+	b.builder.SetBio(bio)
+	return b
+}
+
+// SetAvatarURL はアバター画像のURLを設定します
+func (b *ent_profile_update) SetAvatarURL(avatar_url string) ProfileUpdateInterface {
+	b.builder.SetAvatarURL(avatar_url)
+	return b
+}
+
+// ClearAvatarURL はアバター画像のURLをNULLにします
+func (b *ent_profile_update) ClearAvatarURL() ProfileUpdateInterface {
+	b.builder.ClearAvatarURL()
+	return b
+}
+
+// SetHeightCm は身長を設定します
+func (b *ent_profile_update) SetHeightCm(height_cm int) ProfileUpdateInterface {
+	b.builder.SetHeightCm(height_cm)
+	return b
+}
+
+// ClearHeightCm は身長をNULLにします
+func (b *ent_profile_update) ClearHeightCm() ProfileUpdateInterface {
+	b.builder.ClearHeightCm()
+	return b
+}
+
+// SetFavoriteStyles は好きなスタイルを設定します
+func (b *ent_profile_update) SetFavoriteStyles(favorite_styles []string) ProfileUpdateInterface {
+	b.builder.SetFavoriteStyles(favorite_styles)
+	return b
+}
+
+// SetLinks はリンクを設定します
+func (b *ent_profile_update) SetLinks(links []string) ProfileUpdateInterface {
+	b.builder.SetLinks(links)
+	return b
+}
+
+// SetUpdatedAt は更新日時を設定します
+func (b *ent_profile_update) SetUpdatedAt(updated_at time.Time) ProfileUpdateInterface {
+	b.builder.SetUpdatedAt(updated_at)
+	return b
+}
+
+// Save は条件に一致するプロフィールを更新し、更新件数を返します
+func (b *ent_profile_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_handle_client はEnt UserHandle Clientのアダプターです
+type ent_user_handle_client struct {
+	client *ent.UserHandleClient
+}
+
+// Create はUserHandleCreate Builderを返します
+func (c *ent_user_handle_client) Create() UserHandleCreateInterface {
+	return &ent_user_handle_create{builder: c.client.Create()}
+}
+
+// Query はUserHandleQuery Builderを返します
+func (c *ent_user_handle_client) Query() UserHandleQueryInterface {
+	return &ent_user_handle_query{builder: c.client.Query()}
+}
+
+// Update はUserHandleUpdate Builderを返します
+func (c *ent_user_handle_client) Update() UserHandleUpdateInterface {
+	return &ent_user_handle_update{builder: c.client.Update()}
+}
+
+// Delete はUserHandleDelete Builderを返します
+func (c *ent_user_handle_client) Delete() UserHandleDeleteInterface {
+	return &ent_user_handle_delete{builder: c.client.Delete()}
+}
+
+// ent_user_handle_create はEnt UserHandleCreate Builderのアダプターです
+type ent_user_handle_create struct {
+	builder *ent.UserHandleCreate
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_user_handle_create) SetUserID(user_id int) UserHandleCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetHandle は表示用のハンドルを設定します
+func (b *ent_user_handle_create) SetHandle(handle string) UserHandleCreateInterface {
+	b.builder.SetHandle(handle)
+	return b
+}
+
+// SetHandleKey は重複判定用のハンドルを設定します
+func (b *ent_user_handle_create) SetHandleKey(handle_key string) UserHandleCreateInterface {
+	b.builder.SetHandleKey(handle_key)
+	return b
+}
+
+// SetCreatedAt は作成日時を設定します
+func (b *ent_user_handle_create) SetCreatedAt(created_at time.Time) UserHandleCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はハンドルを保存します
+func (b *ent_user_handle_create) Save(ctx context.Context) (*ent.UserHandle, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_handle_query はEnt UserHandleQuery Builderのアダプターです
+type ent_user_handle_query struct {
+	builder *ent.UserHandleQuery
+}
+
+// Where は条件を追加します
+func (b *ent_user_handle_query) Where(predicates ...any) UserHandleQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserHandle](predicates)...)
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_user_handle_query) WithUser() UserHandleQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一のハンドルを返します
+func (b *ent_user_handle_query) Only(ctx context.Context) (*ent.UserHandle, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_user_handle_update はEnt UserHandleUpdate Builderのアダプターです
+type ent_user_handle_update struct {
+	builder *ent.UserHandleUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_user_handle_update) Where(predicates ...any) UserHandleUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserHandle](predicates)...)
+	return b
+}
+
+// SetHandle は表示用のハンドルを設定します
+func (b *ent_user_handle_update) SetHandle(handle string) UserHandleUpdateInterface {
+	b.builder.SetHandle(handle)
+	return b
+}
+
+// SetRetiredAt は別のハンドルに変更した日時を設定します
+func (b *ent_user_handle_update) SetRetiredAt(retired_at time.Time) UserHandleUpdateInterface {
+	b.builder.SetRetiredAt(retired_at)
+	return b
+}
+
+// Save は条件に一致するハンドルを更新し、更新件数を返します
+func (b *ent_user_handle_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_handle_delete はEnt UserHandleDelete Builderのアダプターです
+type ent_user_handle_delete struct {
+	builder *ent.UserHandleDelete
+}
+
+// Where は条件を追加します
+func (b *ent_user_handle_delete) Where(predicates ...any) UserHandleDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserHandle](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するハンドルを削除し、削除件数を返します
+func (b *ent_user_handle_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_follow_client はEnt Follow Clientのアダプターです
+type ent_follow_client struct {
+	client *ent.FollowClient
+}
+
+// Create はFollowCreate Builderを返します
+func (c *ent_follow_client) Create() FollowCreateInterface {
+	return &ent_follow_create{builder: c.client.Create()}
+}
+
+// Query はFollowQuery Builderを返します
+func (c *ent_follow_client) Query() FollowQueryInterface {
+	return &ent_follow_query{builder: c.client.Query()}
+}
+
+// Delete はFollowDelete Builderを返します
+func (c *ent_follow_client) Delete() FollowDeleteInterface {
+	return &ent_follow_delete{builder: c.client.Delete()}
+}
+
+// ent_follow_create はEnt FollowCreate Builderのアダプターです
+type ent_follow_create struct {
+	builder *ent.FollowCreate
+}
+
+// SetFollowerID はフォローするユーザーのIDを設定します
+func (b *ent_follow_create) SetFollowerID(follower_id int) FollowCreateInterface {
+	b.builder.SetFollowerID(follower_id)
+	return b
+}
+
+// SetFolloweeID はフォローされるユーザーのIDを設定します
+func (b *ent_follow_create) SetFolloweeID(followee_id int) FollowCreateInterface {
+	b.builder.SetFolloweeID(followee_id)
+	return b
+}
+
+// SetCreatedAt はフォローした日時を設定します
+func (b *ent_follow_create) SetCreatedAt(created_at time.Time) FollowCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はフォロー関係を保存します
+func (b *ent_follow_create) Save(ctx context.Context) (*ent.Follow, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_follow_query はEnt FollowQuery Builderのアダプターです
+type ent_follow_query struct {
+	builder *ent.FollowQuery
+}
+
+// Where は条件を追加します
+func (b *ent_follow_query) Where(predicates ...any) FollowQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.Follow](predicates)...)
+	return b
+}
+
+// IDLessThan はIDが指定した値より小さいフォロー関係に絞り込みます
+func (b *ent_follow_query) IDLessThan(id int) FollowQueryInterface {
+	b.builder.Where(follow.IDLT(id))
+	return b
+}
+
+// WhereFollowerActive はフォローするユーザーが削除済み・利用停止中でないフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFollowerActive() FollowQueryInterface {
+	b.builder.Where(follow.HasFollowerWith(user.DeletedAtIsNil(), user.BannedAtIsNil()))
+	return b
+}
+
+// WhereFolloweeActive はフォローされるユーザーが削除済み・利用停止中でないフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFolloweeActive() FollowQueryInterface {
+	b.builder.Where(follow.HasFolloweeWith(user.DeletedAtIsNil(), user.BannedAtIsNil()))
+	return b
+}
+
+// WhereFollowerVisibleTo はフォローするユーザーが閲覧するユーザーのフィードに表示されるフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFollowerVisibleTo(viewer_id int) FollowQueryInterface {
+	b.builder.Where(follow.HasFollowerWith(user_visible_to(viewer_id, visibilityFeed)))
+	return b
+}
+
+// WhereFolloweeVisibleTo はフォローされるユーザーが閲覧するユーザーのフィードに表示されるフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFolloweeVisibleTo(viewer_id int) FollowQueryInterface {
+	b.builder.Where(follow.HasFolloweeWith(user_visible_to(viewer_id, visibilityFeed)))
+	return b
+}
+
+// WhereFollowerContentVisibleTo はフォローするユーザーのコンテンツを閲覧するユーザーが閲覧できるフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFollowerContentVisibleTo(viewer_id int) FollowQueryInterface {
+	b.builder.Where(follow.HasFollowerWith(user_visible_to(viewer_id, visibilityContent)))
+	return b
+}
+
+// WhereFolloweeContentVisibleTo はフォローされるユーザーのコンテンツを閲覧するユーザーが閲覧できるフォロー関係に絞り込みます
+func (b *ent_follow_query) WhereFolloweeContentVisibleTo(viewer_id int) FollowQueryInterface {
+	b.builder.Where(follow.HasFolloweeWith(user_visible_to(viewer_id, visibilityContent)))
+	return b
+}
+
+// WithFollower はフォローするユーザーのEager Loadingを指定します
+func (b *ent_follow_query) WithFollower() FollowQueryInterface {
+	b.builder.WithFollower()
+	return b
+}
+
+// WithFollowee はフォローされるユーザーのEager Loadingを指定します
+func (b *ent_follow_query) WithFollowee() FollowQueryInterface {
+	b.builder.WithFollowee()
+	return b
+}
+
+// OrderByIDDesc はIDの降順に並べます
+func (b *ent_follow_query) OrderByIDDesc() FollowQueryInterface {
+	b.builder.Order(follow.ByID(sql.OrderDesc()))
+	return b
+}
+
+// Limit は取得件数の上限を設定します
+func (b *ent_follow_query) Limit(limit int) FollowQueryInterface {
+	b.builder.Limit(limit)
+	return b
+}
+
+// All は条件に一致するフォロー関係を返します
+func (b *ent_follow_query) All(ctx context.Context) ([]*ent.Follow, error) {
+	return b.builder.All(ctx)
+}
+
+// Exist は条件に一致するフォロー関係が存在するかを返します
+func (b *ent_follow_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// ent_follow_delete はEnt FollowDelete Builderのアダプターです
+type ent_follow_delete struct {
+	builder *ent.FollowDelete
+}
+
+// Where は条件を追加します
+func (b *ent_follow_delete) Where(predicates ...any) FollowDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.Follow](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するフォロー関係を削除し、削除件数を返します
+func (b *ent_follow_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_follow_request_client はEnt FollowRequest Clientのアダプターです
+type ent_follow_request_client struct {
+	client *ent.FollowRequestClient
+}
+
+// Create はFollowRequestCreate Builderを返します
+func (c *ent_follow_request_client) Create() FollowRequestCreateInterface {
+	return &ent_follow_request_create{builder: c.client.Create()}
+}
+
+// Query はFollowRequestQuery Builderを返します
+func (c *ent_follow_request_client) Query() FollowRequestQueryInterface {
+	return &ent_follow_request_query{builder: c.client.Query()}
+}
+
+// Delete はFollowRequestDelete Builderを返します
+func (c *ent_follow_request_client) Delete() FollowRequestDeleteInterface {
+	return &ent_follow_request_delete{builder: c.client.Delete()}
+}
+
+// ent_follow_request_create はEnt FollowRequestCreate Builderのアダプターです
+type ent_follow_request_create struct {
+	builder *ent.FollowRequestCreate
+}
+
+// SetRequesterID はリクエストするユーザーのIDを設定します
+func (b *ent_follow_request_create) SetRequesterID(requester_id int) FollowRequestCreateInterface {
+	b.builder.SetRequesterID(requester_id)
+	return b
+}
+
+// SetTargetID はリクエストされるユーザーのIDを設定します
+func (b *ent_follow_request_create) SetTargetID(target_id int) FollowRequestCreateInterface {
+	b.builder.SetTargetID(target_id)
+	return b
+}
+
+// SetCreatedAt はリクエストした日時を設定します
+func (b *ent_follow_request_create) SetCreatedAt(created_at time.Time) FollowRequestCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はフォローリクエストを保存します
+func (b *ent_follow_request_create) Save(ctx context.Context) (*ent.FollowRequest, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_follow_request_query はEnt FollowRequestQuery Builderのアダプターです
+type ent_follow_request_query struct {
+	builder *ent.FollowRequestQuery
+}
+
+// Where は条件を追加します
+func (b *ent_follow_request_query) Where(predicates ...any) FollowRequestQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.FollowRequest](predicates)...)
+	return b
+}
+
+// IDLessThan はIDが指定した値より小さいリクエストに絞り込みます
+func (b *ent_follow_request_query) IDLessThan(id int) FollowRequestQueryInterface {
+	b.builder.Where(followrequest.IDLT(id))
+	return b
+}
+
+// WhereRequesterActive はリクエストしたユーザーが削除済み・利用停止中でないリクエストに絞り込みます
+func (b *ent_follow_request_query) WhereRequesterActive() FollowRequestQueryInterface {
+	b.builder.Where(followrequest.HasRequesterWith(user.DeletedAtIsNil(), user.BannedAtIsNil()))
+	return b
+}
+
+// WhereRequesterVisibleTo はリクエストしたユーザーと指定したユーザーの間にどちらの方向にもブロックがないリクエストに絞り込みます
+func (b *ent_follow_request_query) WhereRequesterVisibleTo(viewer_id int) FollowRequestQueryInterface {
+	b.builder.Where(followrequest.HasRequesterWith(user_visible_to(viewer_id, visibilityDirect)))
+	return b
+}
+
+// WithRequester はリクエストしたユーザーのEager Loadingを指定します
+func (b *ent_follow_request_query) WithRequester() FollowRequestQueryInterface {
+	b.builder.WithRequester()
+	return b
+}
+
+// OrderByIDDesc はIDの降順に並べます
+func (b *ent_follow_request_query) OrderByIDDesc() FollowRequestQueryInterface {
+	b.builder.Order(followrequest.ByID(sql.OrderDesc()))
+	return b
+}
+
+// Limit は取得件数の上限を設定します
+func (b *ent_follow_request_query) Limit(limit int) FollowRequestQueryInterface {
+	b.builder.Limit(limit)
+	return b
+}
+
+// All は条件に一致するフォローリクエストを返します
+func (b *ent_follow_request_query) All(ctx context.Context) ([]*ent.FollowRequest, error) {
+	return b.builder.All(ctx)
+}
+
+// Exist は条件に一致するフォローリクエストが存在するかを返します
+func (b *ent_follow_request_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// ent_follow_request_delete はEnt FollowRequestDelete Builderのアダプターです
+type ent_follow_request_delete struct {
+	builder *ent.FollowRequestDelete
+}
+
+// Where は条件を追加します
+func (b *ent_follow_request_delete) Where(predicates ...any) FollowRequestDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.FollowRequest](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するフォローリクエストを削除し、削除件数を返します
+func (b *ent_follow_request_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_user_block_client はEnt UserBlock Clientのアダプターです
+type ent_user_block_client struct {
+	client *ent.UserBlockClient
+}
+
+// Create はUserBlockCreate Builderを返します
+func (c *ent_user_block_client) Create() UserBlockCreateInterface {
+	return &ent_user_block_create{builder: c.client.Create()}
+}
+
+// Query はUserBlockQuery Builderを返します
+func (c *ent_user_block_client) Query() UserBlockQueryInterface {
+	return &ent_user_block_query{builder: c.client.Query()}
+}
+
+// Delete はUserBlockDelete Builderを返します
+func (c *ent_user_block_client) Delete() UserBlockDeleteInterface {
+	return &ent_user_block_delete{builder: c.client.Delete()}
+}
+
+// ent_user_block_create はEnt UserBlockCreate Builderのアダプターです
+type ent_user_block_create struct {
+	builder *ent.UserBlockCreate
+}
+
+// SetBlockerID はブロックするユーザーのIDを設定します
+func (b *ent_user_block_create) SetBlockerID(blocker_id int) UserBlockCreateInterface {
+	b.builder.SetBlockerID(blocker_id)
+	return b
+}
+
+// SetBlockedID はブロックされるユーザーのIDを設定します
+func (b *ent_user_block_create) SetBlockedID(blocked_id int) UserBlockCreateInterface {
+	b.builder.SetBlockedID(blocked_id)
+	return b
+}
+
+// SetCreatedAt はブロックした日時を設定します
+func (b *ent_user_block_create) SetCreatedAt(created_at time.Time) UserBlockCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はブロックを保存します
+func (b *ent_user_block_create) Save(ctx context.Context) (*ent.UserBlock, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_block_query はEnt UserBlockQuery Builderのアダプターです
+type ent_user_block_query struct {
+	builder *ent.UserBlockQuery
+}
+
+// Where は条件を追加します
+func (b *ent_user_block_query) Where(predicates ...any) UserBlockQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserBlock](predicates)...)
+	return b
+}
+
+// WithBlocked はブロックされたユーザーのEager Loadingを指定します
+func (b *ent_user_block_query) WithBlocked() UserBlockQueryInterface {
+	b.builder.WithBlocked()
+	return b
+}
+
+// All は条件に一致する全てのブロックをブロックした日時の昇順に返します
+func (b *ent_user_block_query) All(ctx context.Context) ([]*ent.UserBlock, error) {
+	return b.builder.Order(ent.Asc(userblock.FieldCreatedAt)).All(ctx)
+}
+
+// Exist は条件に一致するブロックが存在するかを返します
+func (b *ent_user_block_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// ent_user_block_delete はEnt UserBlockDelete Builderのアダプターです
+type ent_user_block_delete struct {
+	builder *ent.UserBlockDelete
+}
+
+// Where は条件を追加します
+func (b *ent_user_block_delete) Where(predicates ...any) UserBlockDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserBlock](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するブロックを削除し、削除件数を返します
+func (b *ent_user_block_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_user_mute_client はEnt UserMute Clientのアダプターです
+type ent_user_mute_client struct {
+	client *ent.UserMuteClient
+}
+
+// Create はUserMuteCreate Builderを返します
+func (c *ent_user_mute_client) Create() UserMuteCreateInterface {
+	return &ent_user_mute_create{builder: c.client.Create()}
+}
+
+// Query はUserMuteQuery Builderを返します
+func (c *ent_user_mute_client) Query() UserMuteQueryInterface {
+	return &ent_user_mute_query{builder: c.client.Query()}
+}
+
+// Delete はUserMuteDelete Builderを返します
+func (c *ent_user_mute_client) Delete() UserMuteDeleteInterface {
+	return &ent_user_mute_delete{builder: c.client.Delete()}
+}
+
+// ent_user_mute_create はEnt UserMuteCreate Builderのアダプターです
+type ent_user_mute_create struct {
+	builder *ent.UserMuteCreate
+}
+
+// SetMuterID はミュートするユーザーのIDを設定します
+func (b *ent_user_mute_create) SetMuterID(muter_id int) UserMuteCreateInterface {
+	b.builder.SetMuterID(muter_id)
+	return b
+}
+
+// SetMutedID はミュートされるユーザーのIDを設定します
+func (b *ent_user_mute_create) SetMutedID(muted_id int) UserMuteCreateInterface {
+	b.builder.SetMutedID(muted_id)
+	return b
+}
+
+// SetCreatedAt はミュートした日時を設定します
+func (b *ent_user_mute_create) SetCreatedAt(created_at time.Time) UserMuteCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はミュートを保存します
+func (b *ent_user_mute_create) Save(ctx context.Context) (*ent.UserMute, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_user_mute_query はEnt UserMuteQuery Builderのアダプターです
+type ent_user_mute_query struct {
+	builder *ent.UserMuteQuery
+}
+
+// Where は条件を追加します
+func (b *ent_user_mute_query) Where(predicates ...any) UserMuteQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserMute](predicates)...)
+	return b
+}
+
+// WithMuted はミュートされたユーザーのEager Loadingを指定します
+func (b *ent_user_mute_query) WithMuted() UserMuteQueryInterface {
+	b.builder.WithMuted()
+	return b
+}
+
+// All は条件に一致する全てのミュートをミュートした日時の昇順に返します
+func (b *ent_user_mute_query) All(ctx context.Context) ([]*ent.UserMute, error) {
+	return b.builder.Order(ent.Asc(usermute.FieldCreatedAt)).All(ctx)
+}
+
+// Exist は条件に一致するミュートが存在するかを返します
+func (b *ent_user_mute_query) Exist(ctx context.Context) (bool, error) {
+	return b.builder.Exist(ctx)
+}
+
+// ent_user_mute_delete はEnt UserMuteDelete Builderのアダプターです
+type ent_user_mute_delete struct {
+	builder *ent.UserMuteDelete
+}
+
+// Where は条件を追加します
+func (b *ent_user_mute_delete) Where(predicates ...any) UserMuteDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.UserMute](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するミュートを削除し、削除件数を返します
+func (b *ent_user_mute_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_data_export_client はEnt DataExport Clientのアダプターです
+type ent_data_export_client struct {
+	client *ent.DataExportClient
+}
+
+// Create はDataExportCreate Builderを返します
+func (c *ent_data_export_client) Create() DataExportCreateInterface {
+	return &ent_data_export_create{builder: c.client.Create()}
+}
+
+// Query はDataExportQuery Builderを返します
+func (c *ent_data_export_client) Query() DataExportQueryInterface {
+	return &ent_data_export_query{builder: c.client.Query()}
+}
+
+// Update はDataExportUpdate Builderを返します
+func (c *ent_data_export_client) Update() DataExportUpdateInterface {
+	return &ent_data_export_update{builder: c.client.Update()}
+}
+
+// Delete はDataExportDelete Builderを返します
+func (c *ent_data_export_client) Delete() DataExportDeleteInterface {
+	return &ent_data_export_delete{builder: c.client.Delete()}
+}
+
+// ent_data_export_create はEnt DataExportCreate Builderのアダプターです
+type ent_data_export_create struct {
+	builder *ent.DataExportCreate
+}
+
+// SetExportID はエクスポートIDを設定します
+func (b *ent_data_export_create) SetExportID(export_id uuid.UUID) DataExportCreateInterface {
+	b.builder.SetExportID(export_id)
+	return b
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_data_export_create) SetUserID(user_id int) DataExportCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetStatus は状態を設定します
+func (b *ent_data_export_create) SetStatus(status dataexport.Status) DataExportCreateInterface {
+	b.builder.SetStatus(status)
+	return b
+}
+
+// SetCreatedAt は要求日時を設定します
+func (b *ent_data_export_create) SetCreatedAt(created_at time.Time) DataExportCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はエクスポートを保存します
+func (b *ent_data_export_create) Save(ctx context.Context) (*ent.DataExport, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_data_export_query はEnt DataExportQuery Builderのアダプターです
+type ent_data_export_query struct {
+	builder *ent.DataExportQuery
+}
+
+// Where は条件を追加します
+func (b *ent_data_export_query) Where(predicates ...any) DataExportQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.DataExport](predicates)...)
+	return b
+}
+
+// ExpiredAt はダウンロードの有効期限が指定日時以前のエクスポートに絞り込みます
+func (b *ent_data_export_query) ExpiredAt(now time.Time) DataExportQueryInterface {
+	b.builder.Where(dataexport.ExpiresAtLTE(now))
+	return b
+}
+
+// OrderByCreatedAtAsc は要求日時の昇順に並べます
+func (b *ent_data_export_query) OrderByCreatedAtAsc() DataExportQueryInterface {
+	b.builder.Order(ent.Asc(dataexport.FieldCreatedAt))
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_data_export_query) WithUser() DataExportQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Limit は取得件数の上限を設定します
+func (b *ent_data_export_query) Limit(limit int) DataExportQueryInterface {
+	b.builder.Limit(limit)
+	return b
+}
+
+// All は条件に一致する全てのエクスポートを返します
+func (b *ent_data_export_query) All(ctx context.Context) ([]*ent.DataExport, error) {
+	return b.builder.All(ctx)
+}
+
+// First は条件に一致する最初のエクスポートを返します
+func (b *ent_data_export_query) First(ctx context.Context) (*ent.DataExport, error) {
+	return b.builder.First(ctx)
+}
+
+// Only は条件に一致する単一のエクスポートを返します
+func (b *ent_data_export_query) Only(ctx context.Context) (*ent.DataExport, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_data_export_update はEnt DataExportUpdate Builderのアダプターです
+type ent_data_export_update struct {
+	builder *ent.DataExportUpdate
+}
+
+// Where は条件を追加します
+func (b *ent_data_export_update) Where(predicates ...any) DataExportUpdateInterface {
+	b.builder.Where(build_ent_predicates[predicate.DataExport](predicates)...)
+	return b
+}
+
+// SetStatus は状態を設定します
+func (b *ent_data_export_update) SetStatus(status dataexport.Status) DataExportUpdateInterface {
+	b.builder.SetStatus(status)
+	return b
+}
+
+// SetStorageKey はZIPファイルのストレージキーを設定します
+func (b *ent_data_export_update) SetStorageKey(storage_key string) DataExportUpdateInterface {
+	b.builder.SetStorageKey(storage_key)
+	return b
+}
+
+// SetDownloadTokenHash はダウンロードリンクのトークンのハッシュを設定します
+func (b *ent_data_export_update) SetDownloadTokenHash(download_token_hash string) DataExportUpdateInterface {
+	b.builder.SetDownloadTokenHash(download_token_hash)
+	return b
+}
+
+// SetLastError は失敗の理由を設定します
+func (b *ent_data_export_update) SetLastError(last_error string) DataExportUpdateInterface {
+	b.builder.SetLastError(last_error)
+	return b
+}
+
+// SetCompletedAt は処理の完了日時を設定します
+func (b *ent_data_export_update) SetCompletedAt(completed_at time.Time) DataExportUpdateInterface {
+	b.builder.SetCompletedAt(completed_at)
+	return b
+}
+
+// SetExpiresAt はダウンロードの有効期限を設定します
+func (b *ent_data_export_update) SetExpiresAt(expires_at time.Time) DataExportUpdateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// Save は条件に一致するエクスポートを更新し、更新件数を返します
+func (b *ent_data_export_update) Save(ctx context.Context) (int, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_data_export_delete はEnt DataExportDelete Builderのアダプターです
+type ent_data_export_delete struct {
+	builder *ent.DataExportDelete
+}
+
+// Where は条件を追加します
+func (b *ent_data_export_delete) Where(predicates ...any) DataExportDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.DataExport](predicates)...)
+	return b
+}
+
+// Exec は条件に一致するエクスポートを削除し、削除件数を返します
+func (b *ent_data_export_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_email_change_request_client はEnt EmailChangeRequest Clientのアダプターです
+type ent_email_change_request_client struct {
+	client *ent.EmailChangeRequestClient
+}
+
+// Create はEmailChangeRequestCreate Builderを返します
+func (c *ent_email_change_request_client) Create() EmailChangeRequestCreateInterface {
+	return &ent_email_change_request_create{builder: c.client.Create()}
+}
+
+// Query はEmailChangeRequestQuery Builderを返します
+func (c *ent_email_change_request_client) Query() EmailChangeRequestQueryInterface {
+	return &ent_email_change_request_query{builder: c.client.Query()}
+}
+
+// Delete はEmailChangeRequestDelete Builderを返します
+func (c *ent_email_change_request_client) Delete() EmailChangeRequestDeleteInterface {
+	return &ent_email_change_request_delete{builder: c.client.Delete()}
+}
+
+// ent_email_change_request_create はEnt EmailChangeRequestCreate Builderのアダプターです
+type ent_email_change_request_create struct {
+	builder *ent.EmailChangeRequestCreate
+}
+
+// SetUserID はユーザーIDを設定します
+func (b *ent_email_change_request_create) SetUserID(user_id int) EmailChangeRequestCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetNewEmail は変更後のメールアドレスを設定します
+func (b *ent_email_change_request_create) SetNewEmail(new_email string) EmailChangeRequestCreateInterface {
+	b.builder.SetNewEmail(new_email)
+	return b
+}
+
+// SetTokenHash は確認リンクのトークンのハッシュを設定します
+func (b *ent_email_change_request_create) SetTokenHash(token_hash string) EmailChangeRequestCreateInterface {
+	b.builder.SetTokenHash(token_hash)
+	return b
+}
+
+// SetExpiresAt は確認リンクの有効期限を設定します
+func (b *ent_email_change_request_create) SetExpiresAt(expires_at time.Time) EmailChangeRequestCreateInterface {
+	b.builder.SetExpiresAt(expires_at)
+	return b
+}
+
+// SetCreatedAt は要求日時を設定します
+func (b *ent_email_change_request_create) SetCreatedAt(created_at time.Time) EmailChangeRequestCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save は変更の要求を保存します
+func (b *ent_email_change_request_create) Save(ctx context.Context) (*ent.EmailChangeRequest, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_email_change_request_query はEnt EmailChangeRequestQuery Builderのアダプターです
+type ent_email_change_request_query struct {
+	builder *ent.EmailChangeRequestQuery
+}
+
+// Where は条件を追加します
+func (b *ent_email_change_request_query) Where(predicates ...any) EmailChangeRequestQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.EmailChangeRequest](predicates)...)
+	return b
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (b *ent_email_change_request_query) WithUser() EmailChangeRequestQueryInterface {
+	b.builder.WithUser()
+	return b
+}
+
+// Only は条件に一致する単一の変更の要求を返します
+func (b *ent_email_change_request_query) Only(ctx context.Context) (*ent.EmailChangeRequest, error) {
+	return b.builder.Only(ctx)
+}
+
+// ent_email_change_request_delete はEnt EmailChangeRequestDelete Builderのアダプターです
+type ent_email_change_request_delete struct {
+	builder *ent.EmailChangeRequestDelete
+}
+
+// Where は条件を追加します
+func (b *ent_email_change_request_delete) Where(predicates ...any) EmailChangeRequestDeleteInterface {
+	b.builder.Where(build_ent_predicates[predicate.EmailChangeRequest](predicates)...)
+	return b
+}
+
+// Exec は条件に一致する変更の要求を削除し、削除件数を返します
+func (b *ent_email_change_request_delete) Exec(ctx context.Context) (int, error) {
+	return b.builder.Exec(ctx)
+}
+
+// ent_uploaded_image_client はEnt UploadedImage Clientのアダプターです
+type ent_uploaded_image_client struct {
+	client *ent.UploadedImageClient
+}
+
+// Create はUploadedImageCreate Builderを返します
+func (c *ent_uploaded_image_client) Create() UploadedImageCreateInterface {
+	return &ent_uploaded_image_create{builder: c.client.Create()}
+}
+
+// Query はUploadedImageQuery Builderを返します
+func (c *ent_uploaded_image_client) Query() UploadedImageQueryInterface {
+	return &ent_uploaded_image_query{builder: c.client.Query()}
+}
+
+// ent_uploaded_image_create はEnt UploadedImageCreate Builderのアダプターです
+type ent_uploaded_image_create struct {
+	builder *ent.UploadedImageCreate
+}
+
+// SetImageID は画像IDを設定します
+func (b *ent_uploaded_image_create) SetImageID(image_id uuid.UUID) UploadedImageCreateInterface {
+	b.builder.SetImageID(image_id)
+	return b
+}
+
+// SetUserID はアップロードしたユーザーのIDを設定します
+func (b *ent_uploaded_image_create) SetUserID(user_id int) UploadedImageCreateInterface {
+	b.builder.SetUserID(user_id)
+	return b
+}
+
+// SetPurpose は画像の用途を設定します
+func (b *ent_uploaded_image_create) SetPurpose(purpose uploadedimage.Purpose) UploadedImageCreateInterface {
+	b.builder.SetPurpose(purpose)
+	return b
+}
+
+// SetFormat は画像の形式を設定します
+func (b *ent_uploaded_image_create) SetFormat(format uploadedimage.Format) UploadedImageCreateInterface {
+	b.builder.SetFormat(format)
+	return b
+}
+
+// SetVariants はリサイズ画像を設定します
+func (b *ent_uploaded_image_create) SetVariants(variants []schematype.UploadedImageVariant) UploadedImageCreateInterface {
+	b.builder.SetVariants(variants)
+	return b
+}
+
+// SetCreatedAt はアップロード日時を設定します
+func (b *ent_uploaded_image_create) SetCreatedAt(created_at time.Time) UploadedImageCreateInterface {
+	b.builder.SetCreatedAt(created_at)
+	return b
+}
+
+// Save はアップロードした画像を保存します
+func (b *ent_uploaded_image_create) Save(ctx context.Context) (*ent.UploadedImage, error) {
+	return b.builder.Save(ctx)
+}
+
+// ent_uploaded_image_query はEnt UploadedImageQuery Builderのアダプターです
+type ent_uploaded_image_query struct {
+	builder *ent.UploadedImageQuery
+}
+
+// Where は条件を追加します
+func (b *ent_uploaded_image_query) Where(predicates ...any) UploadedImageQueryInterface {
+	b.builder.Where(build_ent_predicates[predicate.UploadedImage](predicates)...)
+	return b
+}
+
+// All は条件に一致する全ての画像をアップロード日時の昇順に返します
+func (b *ent_uploaded_image_query) All(ctx context.Context) ([]*ent.UploadedImage, error) {
+	return b.builder.Order(ent.Asc(uploadedimage.FieldCreatedAt), ent.Asc(uploadedimage.FieldID)).All(ctx)
+}
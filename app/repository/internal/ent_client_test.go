@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"testing"
+
+	"sleeve/ent/predicate"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TestBuildEntPredicates はWhere(フィールド名, 値, ...)形式の条件がSQLに変換されることをテストします
+func TestBuildEntPredicates(t *testing.T) {
+	var selector *sql.Selector
+	var query string
+	var args []any
+
+	selector = sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("refresh_tokens"))
+	for _, ent_predicate := range build_ent_predicates[predicate.RefreshToken]([]any{"token_id", "token_123", "revoked_at", nil}) {
+		ent_predicate(selector)
+	}
+	query, args = selector.Query()
+	if query != `SELECT * FROM "refresh_tokens" WHERE "refresh_tokens"."token_id" = $1 AND "refresh_tokens"."revoked_at" IS NULL` {
+		t.Errorf("unexpected query: %s", query)
+	}
+	if len(args) != 1 || args[0] != "token_123" {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
+
+// TestBuildEntPredicates_InvalidPairs はフィールド名と値の組になっていない条件でpanicすることをテストします
+func TestBuildEntPredicates_InvalidPairs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for invalid predicates")
+		}
+	}()
+	build_ent_predicates[predicate.User]([]any{"public_id"})
+}
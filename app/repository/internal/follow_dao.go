@@ -0,0 +1,505 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// FollowEntClientInterface はFollowDAOが利用するEnt Clientのインターフェースです
+type FollowEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetFollowClient() FollowClientInterface
+	GetFollowRequestClient() FollowRequestClientInterface
+	// WithFollowTx はフォロー関係とフォロワー数・フォロー数（・フォローリクエスト）を同じトランザクションで更新します
+	WithFollowTx(ctx context.Context, fn func(client FollowEntClientInterface) error) error
+}
+
+// FollowClientInterface はEnt Follow Clientのインターフェースです
+type FollowClientInterface interface {
+	Create() FollowCreateInterface
+	Query() FollowQueryInterface
+	Delete() FollowDeleteInterface
+}
+
+// FollowCreateInterface はEnt Follow Create Builderのインターフェースです
+type FollowCreateInterface interface {
+	SetFollowerID(int) FollowCreateInterface
+	SetFolloweeID(int) FollowCreateInterface
+	SetCreatedAt(time.Time) FollowCreateInterface
+	Save(ctx context.Context) (*ent.Follow, error)
+}
+
+// FollowQueryInterface はEnt Follow Query Builderのインターフェースです
+type FollowQueryInterface interface {
+	Where(predicates ...any) FollowQueryInterface
+	// IDLessThan はIDが指定した値より小さいフォロー関係に絞り込みます（カーソルより古いページの取得用）
+	IDLessThan(id int) FollowQueryInterface
+	// WhereFollowerActive・WhereFolloweeActive は相手のユーザーが削除済み・利用停止中でないフォロー関係に絞り込みます
+	WhereFollowerActive() FollowQueryInterface
+	WhereFolloweeActive() FollowQueryInterface
+	// WhereFollowerVisibleTo・WhereFolloweeVisibleTo は相手のユーザーが閲覧するユーザーのフィードに表示されるフォロー関係に絞り込みます（ブロック・ミュートを除外します）
+	WhereFollowerVisibleTo(viewer_id int) FollowQueryInterface
+	WhereFolloweeVisibleTo(viewer_id int) FollowQueryInterface
+	// WhereFollowerContentVisibleTo・WhereFolloweeContentVisibleTo は一覧の所有者のコンテンツを閲覧するユーザーが閲覧できるフォロー関係に絞り込みます
+	// （非公開アカウントの一覧は本人と承認されたフォロワー以外には空になります。viewer_idは未ログインの場合は0）
+	WhereFollowerContentVisibleTo(viewer_id int) FollowQueryInterface
+	WhereFolloweeContentVisibleTo(viewer_id int) FollowQueryInterface
+	WithFollower() FollowQueryInterface
+	WithFollowee() FollowQueryInterface
+	// OrderByIDDesc はIDの降順（フォローした日時の新しい順）に並べます
+	OrderByIDDesc() FollowQueryInterface
+	Limit(limit int) FollowQueryInterface
+	All(ctx context.Context) ([]*ent.Follow, error)
+	Exist(ctx context.Context) (bool, error)
+}
+
+// FollowDeleteInterface はEnt Follow Delete Builderのインターフェースです
+type FollowDeleteInterface interface {
+	Where(predicates ...any) FollowDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// followCursorKind はフォロー関係の一覧のカーソルの種類です
+const followCursorKind = "follow"
+
+// errAlreadyFollowing はフォロー済みのためトランザクションをロールバックすることを表します
+var errAlreadyFollowing = errors.New("already following")
+
+// FollowDAO はユーザー間のフォロー関係のデータアクセスオブジェクトです
+// フォロワー数・フォロー数はusersテーブルに非正規化して保持し、フォロー関係の追加・削除と同じトランザクションで加算・減算します
+type FollowDAO struct {
+	client FollowEntClientInterface
+}
+
+// NewFollowDAO は新しいFollowDAOを作成します
+func NewFollowDAO(client FollowEntClientInterface) *FollowDAO {
+	return &FollowDAO{
+		client: client,
+	}
+}
+
+// Create はフォロー関係を保存し、フォロワー数・フォロー数を加算します
+// 2人の間の承認待ちのフォローリクエストは不要になるため同じトランザクションで削除します
+// 既にフォローしている場合は何も変更せずfalseを返します（同時に同じフォローが実行された場合も(follower_id, followee_id)のユニーク制約で1件のみ保存します）
+func (d *FollowDAO) Create(ctx context.Context, follow *models.Follow) (bool, error) {
+	var err error
+
+	err = d.client.WithFollowTx(ctx, func(client FollowEntClientInterface) error {
+		var follower *ent.User
+		var followee *ent.User
+		var tx_err error
+
+		follower, followee, tx_err = find_ent_user_pair(ctx, client, follow.FollowerID(), follow.FolloweeID())
+		if tx_err != nil {
+			return tx_err
+		}
+		return create_follow(ctx, client, follower, followee, follow.CreatedAt())
+	})
+	if errors.Is(err, errAlreadyFollowing) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return true, nil
+}
+
+// Delete はフォロー関係を削除し、フォロワー数・フォロー数を減算します
+// 承認待ちのフォローリクエストがある場合はリクエストも取り消します
+// フォローしていない場合はフォロー関係を変更せずfalseを返します
+func (d *FollowDAO) Delete(ctx context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	var deleted bool
+	var err error
+
+	err = d.client.WithFollowTx(ctx, func(client FollowEntClientInterface) error {
+		var follower *ent.User
+		var followee *ent.User
+		var deleted_count int
+		var tx_err error
+
+		follower, followee, tx_err = find_ent_user_pair(ctx, client, follower_id, followee_id)
+		if tx_err != nil {
+			return tx_err
+		}
+		_, tx_err = client.GetFollowRequestClient().
+			Delete().
+			Where("requester_id", follower.ID, "target_id", followee.ID).
+			Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		deleted_count, tx_err = client.GetFollowClient().
+			Delete().
+			Where("follower_id", follower.ID, "followee_id", followee.ID).
+			Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		if deleted_count == 0 {
+			return nil
+		}
+		deleted = true
+		return update_follow_counts(ctx, client, follower, followee, -1)
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return deleted, nil
+}
+
+// DeleteAllByUserID はユーザーのフォロー・フォロワーの関係を全て削除し、相手のユーザーのフォロワー数・フォロー数を減算します
+// 退会したユーザーを物理削除する前に使用します（物理削除のCASCADEでは相手のユーザーの非正規化したカウントが減算されないため）
+// ユーザーが既に存在しない場合は0を返します
+func (d *FollowDAO) DeleteAllByUserID(ctx context.Context, user_id uuid.UUID) (int, error) {
+	var deleted_count int
+	var err error
+
+	// 物理削除の前に論理削除されたユーザーのフォロー関係を削除するため、論理削除されたユーザーも対象にする
+	ctx = with_deleted_users(ctx)
+	err = d.client.WithFollowTx(ctx, func(client FollowEntClientInterface) error {
+		var ent_user *ent.User
+		var following []*ent.Follow
+		var followers []*ent.Follow
+		var tx_err error
+
+		ent_user, tx_err = find_ent_user_by_public_id(ctx, client, user_id)
+		if errors.Is(tx_err, domain_errors.ErrUserNotFound) {
+			return nil
+		}
+		if tx_err != nil {
+			return tx_err
+		}
+		following, tx_err = client.GetFollowClient().Query().Where("follower_id", ent_user.ID).All(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		followers, tx_err = client.GetFollowClient().Query().Where("followee_id", ent_user.ID).All(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		for _, ent_follow := range following {
+			_, tx_err = client.GetUserClient().Update().Where("id", ent_follow.FolloweeID).AddFollowerCount(-1).Save(ctx)
+			if tx_err != nil {
+				return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+			}
+		}
+		for _, ent_follow := range followers {
+			_, tx_err = client.GetUserClient().Update().Where("id", ent_follow.FollowerID).AddFollowingCount(-1).Save(ctx)
+			if tx_err != nil {
+				return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+			}
+		}
+		_, tx_err = client.GetFollowClient().Delete().Where("follower_id", ent_user.ID).Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		_, tx_err = client.GetFollowClient().Delete().Where("followee_id", ent_user.ID).Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		deleted_count = len(following) + len(followers)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return deleted_count, nil
+}
+
+// Exists はフォローしているかを返します
+func (d *FollowDAO) Exists(ctx context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	var follower *ent.User
+	var followee *ent.User
+	var exists bool
+	var err error
+
+	follower, followee, err = find_ent_user_pair(ctx, d.client, follower_id, followee_id)
+	if err != nil {
+		return false, err
+	}
+	exists, err = d.client.GetFollowClient().
+		Query().
+		Where("follower_id", follower.ID, "followee_id", followee.ID).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return exists, nil
+}
+
+// CountsByUserID はユーザーのフォロワー数・フォロー数を返します
+func (d *FollowDAO) CountsByUserID(ctx context.Context, user_id uuid.UUID) (models.FollowCounts, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return models.FollowCounts{}, err
+	}
+	return models.NewFollowCounts(ent_user.FollowerCount, ent_user.FollowingCount), nil
+}
+
+// ListFollowers はユーザーのフォロワーをフォローした日時の新しい順に返します（削除済み・利用停止中のユーザーは除きます）
+// viewer_idを指定した場合は閲覧するユーザーとの間にブロックがあるユーザー・閲覧するユーザーがミュートしているユーザーも除きます（未ログインの場合はuuid.Nil）
+// 非公開アカウントの一覧は本人と承認されたフォロワー以外には空のページを返します
+// afterには前のページのEndCursorを指定し、不正なカーソルの場合はErrInvalidPaginationを返します
+func (d *FollowDAO) ListFollowers(ctx context.Context, user_id uuid.UUID, viewer_id uuid.UUID, first int, after string) (*models.FollowPage, error) {
+	var ent_user *ent.User
+	var viewer_ent_id int
+	var query FollowQueryInterface
+	var ent_follows []*ent.Follow
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return nil, err
+	}
+	viewer_ent_id, err = find_viewer_ent_id(ctx, d.client, viewer_id)
+	if err != nil {
+		return nil, err
+	}
+	query = d.client.GetFollowClient().
+		Query().
+		Where("followee_id", ent_user.ID).
+		WhereFolloweeContentVisibleTo(viewer_ent_id).
+		WhereFollowerActive().
+		WithFollower()
+	if viewer_ent_id > 0 {
+		query = query.WhereFollowerVisibleTo(viewer_ent_id)
+	}
+	ent_follows, err = d.list_page(ctx, query, first, after)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_page(ent_follows, first, func(ent_follow *ent.Follow) (*models.Follow, error) {
+		return models.NewFollow(ent_follow.Edges.Follower.PublicID, user_id, ent_follow.CreatedAt)
+	})
+}
+
+// ListFollowing はユーザーがフォローしているユーザーをフォローした日時の新しい順に返します（削除済み・利用停止中のユーザーは除きます）
+// viewer_idを指定した場合は閲覧するユーザーとの間にブロックがあるユーザー・閲覧するユーザーがミュートしているユーザーも除きます（未ログインの場合はuuid.Nil）
+// 非公開アカウントの一覧は本人と承認されたフォロワー以外には空のページを返します
+// afterには前のページのEndCursorを指定し、不正なカーソルの場合はErrInvalidPaginationを返します
+func (d *FollowDAO) ListFollowing(ctx context.Context, user_id uuid.UUID, viewer_id uuid.UUID, first int, after string) (*models.FollowPage, error) {
+	var ent_user *ent.User
+	var viewer_ent_id int
+	var query FollowQueryInterface
+	var ent_follows []*ent.Follow
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return nil, err
+	}
+	viewer_ent_id, err = find_viewer_ent_id(ctx, d.client, viewer_id)
+	if err != nil {
+		return nil, err
+	}
+	query = d.client.GetFollowClient().
+		Query().
+		Where("follower_id", ent_user.ID).
+		WhereFollowerContentVisibleTo(viewer_ent_id).
+		WhereFolloweeActive().
+		WithFollowee()
+	if viewer_ent_id > 0 {
+		query = query.WhereFolloweeVisibleTo(viewer_ent_id)
+	}
+	ent_follows, err = d.list_page(ctx, query, first, after)
+	if err != nil {
+		return nil, err
+	}
+	return build_follow_page(ent_follows, first, func(ent_follow *ent.Follow) (*models.Follow, error) {
+		return models.NewFollow(user_id, ent_follow.Edges.Followee.PublicID, ent_follow.CreatedAt)
+	})
+}
+
+// list_page はカーソルより後のフォロー関係を、次のページの有無の判定用に1件多く取得します
+func (d *FollowDAO) list_page(ctx context.Context, query FollowQueryInterface, first int, after string) ([]*ent.Follow, error) {
+	var ent_follows []*ent.Follow
+	var err error
+
+	if after != "" {
+		var after_id int
+
+		after_id, err = decode_id_cursor(followCursorKind, after)
+		if err != nil {
+			return nil, err
+		}
+		query = query.IDLessThan(after_id)
+	}
+	ent_follows, err = query.
+		OrderByIDDesc().
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return ent_follows, nil
+}
+
+// find_ent_user_by_public_id は公開IDでユーザーを検索します（フォロー・ブロック・ミュートのDAOで共通して使用します）
+func find_ent_user_by_public_id(ctx context.Context, client EntClientInterface, user_id uuid.UUID) (*ent.User, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return ent_user, nil
+}
+
+// find_viewer_ent_id は閲覧するユーザーの内部IDを返します
+// 未ログイン（uuid.Nil）の場合は0を返します（0はuser_visible_toでどのユーザーにも一致しない閲覧ユーザーとして扱います）
+func find_viewer_ent_id(ctx context.Context, client EntClientInterface, viewer_id uuid.UUID) (int, error) {
+	var viewer *ent.User
+	var err error
+
+	if viewer_id == uuid.Nil {
+		return 0, nil
+	}
+	viewer, err = find_ent_user_by_public_id(ctx, client, viewer_id)
+	if err != nil {
+		return 0, err
+	}
+	return viewer.ID, nil
+}
+
+// find_ent_user_pair は関係の起点となるユーザーと対象のユーザーを公開IDで検索します
+func find_ent_user_pair(ctx context.Context, client EntClientInterface, user_id uuid.UUID, other_id uuid.UUID) (*ent.User, *ent.User, error) {
+	var ent_user *ent.User
+	var other *ent.User
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, client, user_id)
+	if err != nil {
+		return nil, nil, err
+	}
+	other, err = find_ent_user_by_public_id(ctx, client, other_id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ent_user, other, nil
+}
+
+// create_follow はフォロー関係を保存してフォロワー数・フォロー数を加算し、2人の間のフォローリクエストを削除します
+// 既にフォローしている場合はerrAlreadyFollowingを返します（PostgreSQLではエラーの後にトランザクションを続けられないため、呼び出し元はロールバックします）
+func create_follow(ctx context.Context, client FollowEntClientInterface, follower *ent.User, followee *ent.User, created_at time.Time) error {
+	var err error
+
+	_, err = client.GetFollowClient().
+		Create().
+		SetFollowerID(follower.ID).
+		SetFolloweeID(followee.ID).
+		SetCreatedAt(created_at).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return errAlreadyFollowing
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	_, err = client.GetFollowRequestClient().
+		Delete().
+		Where("requester_id", follower.ID, "target_id", followee.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return update_follow_counts(ctx, client, follower, followee, 1)
+}
+
+// update_follow_counts はフォローするユーザーのフォロー数とフォローされるユーザーのフォロワー数に加算します
+// 相互フォローが同時に実行された場合のデッドロックを防ぐため、常にIDの小さいユーザーから更新します
+func update_follow_counts(ctx context.Context, client FollowEntClientInterface, follower *ent.User, followee *ent.User, delta int) error {
+	var update_follower func() error
+	var update_followee func() error
+	var err error
+
+	update_follower = func() error {
+		_, update_err := client.GetUserClient().Update().Where("id", follower.ID).AddFollowingCount(delta).Save(ctx)
+		return update_err
+	}
+	update_followee = func() error {
+		_, update_err := client.GetUserClient().Update().Where("id", followee.ID).AddFollowerCount(delta).Save(ctx)
+		return update_err
+	}
+	if follower.ID < followee.ID {
+		err = errors.Join(update_follower(), update_followee())
+	} else {
+		err = errors.Join(update_followee(), update_follower())
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// build_follow_page は1件多く取得したフォロー関係からページを作成します
+func build_follow_page(ent_follows []*ent.Follow, first int, convert func(*ent.Follow) (*models.Follow, error)) (*models.FollowPage, error) {
+	var has_next_page bool
+	var follows []*models.Follow
+	var end_cursor string
+
+	has_next_page = len(ent_follows) > first
+	if has_next_page {
+		ent_follows = ent_follows[:first]
+	}
+	follows = make([]*models.Follow, 0, len(ent_follows))
+	for _, ent_follow := range ent_follows {
+		var follow *models.Follow
+		var err error
+
+		follow, err = convert(ent_follow)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		follows = append(follows, follow)
+	}
+	if len(ent_follows) > 0 {
+		end_cursor = encode_id_cursor(followCursorKind, ent_follows[len(ent_follows)-1].ID)
+	}
+	return models.NewFollowPage(follows, end_cursor, has_next_page), nil
+}
+
+// encode_id_cursor は一覧の最後の行のIDをクライアントに返すカーソル（種類:ID）に変換します
+func encode_id_cursor(kind string, id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + strconv.Itoa(id)))
+}
+
+// decode_id_cursor はカーソルをIDに変換します（種類の異なる一覧のカーソルは不正なカーソルとして扱います）
+func decode_id_cursor(kind string, cursor string) (int, error) {
+	var decoded []byte
+	var id_str string
+	var found bool
+	var id int
+	var err error
+
+	decoded, err = base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid cursor", domain_errors.ErrInvalidPagination)
+	}
+	id_str, found = strings.CutPrefix(string(decoded), kind+":")
+	if !found {
+		return 0, fmt.Errorf("%w: invalid cursor", domain_errors.ErrInvalidPagination)
+	}
+	id, err = strconv.Atoi(id_str)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: invalid cursor", domain_errors.ErrInvalidPagination)
+	}
+	return id, nil
+}
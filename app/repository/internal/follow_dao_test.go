@@ -0,0 +1,328 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// create_test_follow はテスト用のフォロー関係を作成します
+func create_test_follow(t *testing.T, follower_id uuid.UUID, followee_id uuid.UUID) *models.Follow {
+	var follow *models.Follow
+	var err error
+
+	t.Helper()
+	follow, err = models.NewFollow(follower_id, followee_id, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create follow: %v", err)
+	}
+	return follow
+}
+
+// TestFollowDAO_CreateAndDelete はフォロー・フォロー解除の冪等性とフォロワー数・フォロー数の更新をテストします
+func TestFollowDAO_CreateAndDelete(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var follower *ent.User
+	var followee *ent.User
+	var created bool
+	var deleted bool
+	var exists bool
+	var counts models.FollowCounts
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	follower = client.AddUser(uuid.New())
+	followee = client.AddUser(uuid.New())
+
+	for i, expected := range []bool{true, false} {
+		created, err = dao.Create(ctx, create_test_follow(t, follower.PublicID, followee.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created != expected {
+			t.Errorf("attempt %d: expected created %v, got %v", i+1, expected, created)
+		}
+	}
+	if follower.FollowingCount != 1 || followee.FollowerCount != 1 {
+		t.Errorf("expected counts to be incremented once, got following=%d followers=%d", follower.FollowingCount, followee.FollowerCount)
+	}
+	exists, err = dao.Exists(ctx, follower.PublicID, followee.PublicID)
+	if err != nil || !exists {
+		t.Errorf("expected follow to exist, got %v (err=%v)", exists, err)
+	}
+	counts, err = dao.CountsByUserID(ctx, followee.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if counts.Followers() != 1 || counts.Following() != 0 {
+		t.Errorf("expected followers=1 following=0, got %d/%d", counts.Followers(), counts.Following())
+	}
+
+	for i, expected := range []bool{true, false} {
+		deleted, err = dao.Delete(ctx, follower.PublicID, followee.PublicID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != expected {
+			t.Errorf("attempt %d: expected deleted %v, got %v", i+1, expected, deleted)
+		}
+	}
+	if follower.FollowingCount != 0 || followee.FollowerCount != 0 {
+		t.Errorf("expected counts to be decremented once, got following=%d followers=%d", follower.FollowingCount, followee.FollowerCount)
+	}
+}
+
+// TestFollowDAO_DeleteAllByUserID は退会したユーザーのフォロー関係の削除と相手のユーザーのカウントの減算をテストします
+func TestFollowDAO_DeleteAllByUserID(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var deleted_user *ent.User
+	var followee *ent.User
+	var follower *ent.User
+	var deleted_count int
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	deleted_user = client.AddUser(uuid.New())
+	followee = client.AddUser(uuid.New())
+	follower = client.AddUser(uuid.New())
+	for _, follow := range []*models.Follow{
+		create_test_follow(t, deleted_user.PublicID, followee.PublicID),
+		create_test_follow(t, follower.PublicID, deleted_user.PublicID),
+		create_test_follow(t, follower.PublicID, followee.PublicID),
+	} {
+		_, err = dao.Create(ctx, follow)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	deleted_count, err = dao.DeleteAllByUserID(ctx, deleted_user.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted_count != 2 || len(client.Follows()) != 1 {
+		t.Errorf("expected 2 follows deleted and 1 remaining, got deleted=%d remaining=%d", deleted_count, len(client.Follows()))
+	}
+	if followee.FollowerCount != 1 || follower.FollowingCount != 1 {
+		t.Errorf("expected counterpart counts to be decremented, got followers=%d following=%d", followee.FollowerCount, follower.FollowingCount)
+	}
+	deleted_count, err = dao.DeleteAllByUserID(ctx, uuid.New())
+	if err != nil || deleted_count != 0 {
+		t.Errorf("expected no-op for missing user, got %d (err=%v)", deleted_count, err)
+	}
+}
+
+// TestFollowDAO_Create_DatabaseError はDBエラーをErrDatabaseErrorでラップすることをテストします
+func TestFollowDAO_Create_DatabaseError(t *testing.T) {
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var follower *ent.User
+	var followee *ent.User
+	var err error
+
+	client = NewMockFollowEntClientWithDatabaseError()
+	dao = NewFollowDAO(client)
+	follower = client.AddUser(uuid.New())
+	followee = client.AddUser(uuid.New())
+
+	_, err = dao.Create(context.Background(), create_test_follow(t, follower.PublicID, followee.PublicID))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if follower.FollowingCount != 0 || followee.FollowerCount != 0 {
+		t.Errorf("expected counts to be unchanged, got following=%d followers=%d", follower.FollowingCount, followee.FollowerCount)
+	}
+}
+
+// TestFollowDAO_ListFollowers はフォロワーのページネーションと削除済み・利用停止中のユーザーの除外をテストします
+func TestFollowDAO_ListFollowers(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var followee *ent.User
+	var followers []*ent.User
+	var banned_at time.Time
+	var page *models.FollowPage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	followee = client.AddUser(uuid.New())
+	for range 4 {
+		var follower *ent.User
+
+		follower = client.AddUser(uuid.New())
+		followers = append(followers, follower)
+		_, err = dao.Create(ctx, create_test_follow(t, follower.PublicID, followee.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	banned_at = time.Now()
+	followers[2].BannedAt = &banned_at
+
+	page, err = dao.ListFollowers(ctx, followee.PublicID, uuid.Nil, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Follows()) != 2 || !page.HasNextPage() || page.EndCursor() == "" {
+		t.Fatalf("expected first page with 2 follows and next page, got %d follows (hasNext=%v)", len(page.Follows()), page.HasNextPage())
+	}
+	if page.Follows()[0].FollowerID() != followers[3].PublicID || page.Follows()[1].FollowerID() != followers[1].PublicID {
+		t.Errorf("expected newest active followers first")
+	}
+	page, err = dao.ListFollowers(ctx, followee.PublicID, uuid.Nil, 2, page.EndCursor())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Follows()) != 1 || page.HasNextPage() || page.Follows()[0].FollowerID() != followers[0].PublicID {
+		t.Errorf("expected last page with the oldest follower, got %d follows (hasNext=%v)", len(page.Follows()), page.HasNextPage())
+	}
+
+	_, err = dao.ListFollowers(ctx, followee.PublicID, uuid.Nil, 2, "not-a-cursor")
+	if !errors.Is(err, domain_errors.ErrInvalidPagination) {
+		t.Errorf("expected ErrInvalidPagination, got %v", err)
+	}
+}
+
+// TestFollowDAO_ListFollowing はフォローしているユーザーの一覧をテストします
+func TestFollowDAO_ListFollowing(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var follower *ent.User
+	var followee *ent.User
+	var page *models.FollowPage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	follower = client.AddUser(uuid.New())
+	followee = client.AddUser(uuid.New())
+	_, err = dao.Create(ctx, create_test_follow(t, follower.PublicID, followee.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	page, err = dao.ListFollowing(ctx, follower.PublicID, uuid.Nil, 10, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Follows()) != 1 || page.Follows()[0].FolloweeID() != followee.PublicID || page.HasNextPage() {
+		t.Errorf("expected single followee, got %d follows", len(page.Follows()))
+	}
+}
+
+// TestFollowDAO_ListFollowers_VisibilityFilter は閲覧するユーザーとの間にブロックがあるユーザー・閲覧するユーザーがミュートしているユーザーの除外をテストします
+func TestFollowDAO_ListFollowers_VisibilityFilter(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var followee *ent.User
+	var blocking_follower *ent.User
+	var muted_follower *ent.User
+	var visible_follower *ent.User
+	var viewer *ent.User
+	var page *models.FollowPage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	followee = client.AddUser(uuid.New())
+	blocking_follower = client.AddUser(uuid.New())
+	muted_follower = client.AddUser(uuid.New())
+	visible_follower = client.AddUser(uuid.New())
+	viewer = client.AddUser(uuid.New())
+	for _, follower := range []*ent.User{blocking_follower, muted_follower, visible_follower} {
+		_, err = dao.Create(ctx, create_test_follow(t, follower.PublicID, followee.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	client.AddBlock(blocking_follower, viewer)
+	client.AddMute(viewer, muted_follower)
+
+	page, err = dao.ListFollowers(ctx, followee.PublicID, viewer.PublicID, 10, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Follows()) != 1 || page.Follows()[0].FollowerID() != visible_follower.PublicID {
+		t.Errorf("expected only the follower without blocks and mutes, got %d follows", len(page.Follows()))
+	}
+	page, err = dao.ListFollowers(ctx, followee.PublicID, uuid.Nil, 10, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Follows()) != 3 {
+		t.Errorf("expected all followers for anonymous viewer, got %d", len(page.Follows()))
+	}
+}
+
+// TestFollowDAO_ListFollows_PrivateAccount は非公開アカウントのフォロー関係の一覧を本人と承認されたフォロワー以外には返さないことをテストします
+func TestFollowDAO_ListFollows_PrivateAccount(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowDAO
+	var owner *ent.User
+	var follower *ent.User
+	var followee *ent.User
+	var stranger *ent.User
+	var page *models.FollowPage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowDAO(client)
+	owner = client.AddUser(uuid.New())
+	follower = client.AddUser(uuid.New())
+	followee = client.AddUser(uuid.New())
+	stranger = client.AddUser(uuid.New())
+	_, err = dao.Create(ctx, create_test_follow(t, follower.PublicID, owner.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = dao.Create(ctx, create_test_follow(t, owner.PublicID, followee.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	owner.IsPrivate = true
+
+	for _, viewer_id := range []uuid.UUID{uuid.Nil, stranger.PublicID} {
+		page, err = dao.ListFollowers(ctx, owner.PublicID, viewer_id, 10, "")
+		if err != nil || len(page.Follows()) != 0 {
+			t.Errorf("expected no followers for non-follower, got err=%v", err)
+		}
+		page, err = dao.ListFollowing(ctx, owner.PublicID, viewer_id, 10, "")
+		if err != nil || len(page.Follows()) != 0 {
+			t.Errorf("expected no followings for non-follower, got err=%v", err)
+		}
+	}
+	for _, viewer_id := range []uuid.UUID{owner.PublicID, follower.PublicID} {
+		page, err = dao.ListFollowers(ctx, owner.PublicID, viewer_id, 10, "")
+		if err != nil || len(page.Follows()) != 1 {
+			t.Errorf("expected followers to be visible to owner and follower, got err=%v", err)
+		}
+		page, err = dao.ListFollowing(ctx, owner.PublicID, viewer_id, 10, "")
+		if err != nil || len(page.Follows()) != 1 {
+			t.Errorf("expected followings to be visible to owner and follower, got err=%v", err)
+		}
+	}
+}
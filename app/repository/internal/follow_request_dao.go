@@ -0,0 +1,296 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// FollowRequestClientInterface はEnt FollowRequest Clientのインターフェースです
+type FollowRequestClientInterface interface {
+	Create() FollowRequestCreateInterface
+	Query() FollowRequestQueryInterface
+	Delete() FollowRequestDeleteInterface
+}
+
+// FollowRequestCreateInterface はEnt FollowRequest Create Builderのインターフェースです
+type FollowRequestCreateInterface interface {
+	SetRequesterID(int) FollowRequestCreateInterface
+	SetTargetID(int) FollowRequestCreateInterface
+	SetCreatedAt(time.Time) FollowRequestCreateInterface
+	Save(ctx context.Context) (*ent.FollowRequest, error)
+}
+
+// FollowRequestQueryInterface はEnt FollowRequest Query Builderのインターフェースです
+type FollowRequestQueryInterface interface {
+	Where(predicates ...any) FollowRequestQueryInterface
+	// IDLessThan はIDが指定した値より小さいリクエストに絞り込みます（カーソルより古いページの取得用）
+	IDLessThan(id int) FollowRequestQueryInterface
+	// WhereRequesterActive はリクエストしたユーザーが削除済み・利用停止中でないリクエストに絞り込みます
+	WhereRequesterActive() FollowRequestQueryInterface
+	// WhereRequesterVisibleTo はリクエストしたユーザーと指定したユーザーの間にどちらの方向にもブロックがないリクエストに絞り込みます
+	WhereRequesterVisibleTo(viewer_id int) FollowRequestQueryInterface
+	WithRequester() FollowRequestQueryInterface
+	// OrderByIDDesc はIDの降順（リクエストした日時の新しい順）に並べます
+	OrderByIDDesc() FollowRequestQueryInterface
+	Limit(limit int) FollowRequestQueryInterface
+	All(ctx context.Context) ([]*ent.FollowRequest, error)
+	Exist(ctx context.Context) (bool, error)
+}
+
+// FollowRequestDeleteInterface はEnt FollowRequest Delete Builderのインターフェースです
+type FollowRequestDeleteInterface interface {
+	Where(predicates ...any) FollowRequestDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// followRequestCursorKind はフォローリクエストの一覧のカーソルの種類です
+const followRequestCursorKind = "follow_request"
+
+// FollowRequestDAO は非公開アカウントへのフォローリクエストのデータアクセスオブジェクトです
+// 承認はフォロー関係の追加・フォロワー数の加算と同じトランザクションで行うため、FollowDAOと同じクライアントを使用します
+type FollowRequestDAO struct {
+	client FollowEntClientInterface
+}
+
+// NewFollowRequestDAO は新しいFollowRequestDAOを作成します
+func NewFollowRequestDAO(client FollowEntClientInterface) *FollowRequestDAO {
+	return &FollowRequestDAO{
+		client: client,
+	}
+}
+
+// Create はフォローリクエストを保存します
+// 既にリクエストしている場合は何も変更せずfalseを返します（(requester_id, target_id)のユニーク制約で1件のみ保存します）
+func (d *FollowRequestDAO) Create(ctx context.Context, request *models.FollowRequest) (bool, error) {
+	var requester *ent.User
+	var target *ent.User
+	var err error
+
+	requester, target, err = find_ent_user_pair(ctx, d.client, request.RequesterID(), request.TargetID())
+	if err != nil {
+		return false, err
+	}
+	_, err = d.client.GetFollowRequestClient().
+		Create().
+		SetRequesterID(requester.ID).
+		SetTargetID(target.ID).
+		SetCreatedAt(request.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return true, nil
+}
+
+// Delete はフォローリクエストを削除します（拒否）
+// リクエストが存在しない場合はfalseを返します
+func (d *FollowRequestDAO) Delete(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID) (bool, error) {
+	var requester *ent.User
+	var target *ent.User
+	var deleted_count int
+	var err error
+
+	requester, target, err = find_ent_user_pair(ctx, d.client, requester_id, target_id)
+	if err != nil {
+		return false, err
+	}
+	deleted_count, err = d.client.GetFollowRequestClient().
+		Delete().
+		Where("requester_id", requester.ID, "target_id", target.ID).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count > 0, nil
+}
+
+// Approve はフォローリクエストを承認し、リクエストの削除・フォロー関係の保存・フォロワー数とフォロー数の加算を同じトランザクションで行います
+// リクエストが存在しない場合（拒否・取り消し済み、または同時に承認された場合）は何も変更せずfalseを返します
+// リクエストの後にどちらかがブロックした場合は、リクエストの削除のみ行いfalseを返します
+func (d *FollowRequestDAO) Approve(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID, approved_at time.Time) (bool, error) {
+	var approved bool
+	var err error
+
+	err = d.client.WithFollowTx(ctx, func(client FollowEntClientInterface) error {
+		var requester *ent.User
+		var target *ent.User
+		var is_visible bool
+		var deleted_count int
+		var tx_err error
+
+		requester, target, tx_err = find_ent_user_pair(ctx, client, requester_id, target_id)
+		if tx_err != nil {
+			return tx_err
+		}
+		is_visible, tx_err = client.GetFollowRequestClient().
+			Query().
+			Where("requester_id", requester.ID, "target_id", target.ID).
+			WhereRequesterVisibleTo(target.ID).
+			Exist(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		// 先にリクエストを削除し、同時に承認された場合は削除できた方のみフォロー関係を保存する
+		deleted_count, tx_err = client.GetFollowRequestClient().
+			Delete().
+			Where("requester_id", requester.ID, "target_id", target.ID).
+			Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		if deleted_count == 0 || !is_visible {
+			return nil
+		}
+		approved = true
+		return create_follow_if_not_exists(ctx, client, requester, target, approved_at)
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return approved, nil
+}
+
+// ApproveAll はユーザーが受信したフォローリクエストを全て承認し、承認した件数を返します
+// 非公開アカウントを公開アカウントに変更した際に使用します（公開アカウントへのフォローは承認が不要なため）
+// どちらかがブロックしているユーザーのリクエストは承認せずに削除します
+func (d *FollowRequestDAO) ApproveAll(ctx context.Context, target_id uuid.UUID, approved_at time.Time) (int, error) {
+	var approved_count int
+	var err error
+
+	err = d.client.WithFollowTx(ctx, func(client FollowEntClientInterface) error {
+		var target *ent.User
+		var ent_requests []*ent.FollowRequest
+		var tx_err error
+
+		target, tx_err = find_ent_user_by_public_id(ctx, client, target_id)
+		if tx_err != nil {
+			return tx_err
+		}
+		ent_requests, tx_err = client.GetFollowRequestClient().
+			Query().
+			Where("target_id", target.ID).
+			WhereRequesterVisibleTo(target.ID).
+			WithRequester().
+			All(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		for _, ent_request := range ent_requests {
+			// 退会（論理削除）したユーザーは読み込まれないため、リクエストの削除のみ行う
+			if ent_request.Edges.Requester == nil {
+				continue
+			}
+			tx_err = create_follow_if_not_exists(ctx, client, ent_request.Edges.Requester, target, approved_at)
+			if tx_err != nil {
+				return tx_err
+			}
+			approved_count++
+		}
+		_, tx_err = client.GetFollowRequestClient().
+			Delete().
+			Where("target_id", target.ID).
+			Exec(ctx)
+		if tx_err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, tx_err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return approved_count, nil
+}
+
+// ListReceived はユーザーが受信したフォローリクエストをリクエストした日時の新しい順に返します
+// 削除済み・利用停止中のユーザーと、どちらかがブロックしているユーザーのリクエストは除きます
+// afterには前のページのEndCursorを指定し、不正なカーソルの場合はErrInvalidPaginationを返します
+func (d *FollowRequestDAO) ListReceived(ctx context.Context, target_id uuid.UUID, first int, after string) (*models.FollowRequestPage, error) {
+	var target *ent.User
+	var query FollowRequestQueryInterface
+	var ent_requests []*ent.FollowRequest
+	var has_next_page bool
+	var requests []*models.FollowRequest
+	var end_cursor string
+	var err error
+
+	target, err = find_ent_user_by_public_id(ctx, d.client, target_id)
+	if err != nil {
+		return nil, err
+	}
+	query = d.client.GetFollowRequestClient().
+		Query().
+		Where("target_id", target.ID).
+		WhereRequesterActive().
+		WhereRequesterVisibleTo(target.ID).
+		WithRequester()
+	if after != "" {
+		var after_id int
+
+		after_id, err = decode_id_cursor(followRequestCursorKind, after)
+		if err != nil {
+			return nil, err
+		}
+		query = query.IDLessThan(after_id)
+	}
+	// 次のページの有無の判定用に1件多く取得する
+	ent_requests, err = query.
+		OrderByIDDesc().
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	has_next_page = len(ent_requests) > first
+	if has_next_page {
+		ent_requests = ent_requests[:first]
+	}
+	requests = make([]*models.FollowRequest, 0, len(ent_requests))
+	for _, ent_request := range ent_requests {
+		var request *models.FollowRequest
+
+		request, err = models.NewFollowRequest(ent_request.Edges.Requester.PublicID, target_id, ent_request.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		requests = append(requests, request)
+	}
+	if len(ent_requests) > 0 {
+		end_cursor = encode_id_cursor(followRequestCursorKind, ent_requests[len(ent_requests)-1].ID)
+	}
+	return models.NewFollowRequestPage(requests, end_cursor, has_next_page), nil
+}
+
+// create_follow_if_not_exists は承認したリクエストのフォロー関係を保存します
+// リクエスト中に公開アカウントに戻った期間にフォローされていた場合などは、既存のフォロー関係をそのまま使用します
+// （トランザクションを続けるため、ユニーク制約のエラーではなく事前の存在確認で判定します）
+func create_follow_if_not_exists(ctx context.Context, client FollowEntClientInterface, follower *ent.User, followee *ent.User, created_at time.Time) error {
+	var exists bool
+	var err error
+
+	exists, err = client.GetFollowClient().
+		Query().
+		Where("follower_id", follower.ID, "followee_id", followee.ID).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if exists {
+		return nil
+	}
+	err = create_follow(ctx, client, follower, followee, created_at)
+	if errors.Is(err, errAlreadyFollowing) {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return err
+}
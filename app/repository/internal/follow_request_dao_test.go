@@ -0,0 +1,293 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// create_test_follow_request はテスト用のフォローリクエストを作成します
+func create_test_follow_request(t *testing.T, requester_id uuid.UUID, target_id uuid.UUID) *models.FollowRequest {
+	var request *models.FollowRequest
+	var err error
+
+	t.Helper()
+	request, err = models.NewFollowRequest(requester_id, target_id, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create follow request: %v", err)
+	}
+	return request
+}
+
+// TestFollowRequestDAO_CreateAndApprove はリクエストの冪等性と承認時のフォロー関係の保存・フォロワー数の更新をテストします
+func TestFollowRequestDAO_CreateAndApprove(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowRequestDAO
+	var follow_dao *FollowDAO
+	var requester *ent.User
+	var target *ent.User
+	var created bool
+	var approved bool
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowRequestDAO(client)
+	follow_dao = NewFollowDAO(client)
+	requester = client.AddUser(uuid.New())
+	target = client.AddUser(uuid.New())
+
+	for i, expected := range []bool{true, false} {
+		created, err = dao.Create(ctx, create_test_follow_request(t, requester.PublicID, target.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created != expected {
+			t.Errorf("attempt %d: expected created %v, got %v", i+1, expected, created)
+		}
+	}
+	if len(client.FollowRequests()) != 1 {
+		t.Fatalf("expected 1 follow request, got %d", len(client.FollowRequests()))
+	}
+	exists, err = follow_dao.Exists(ctx, requester.PublicID, target.PublicID)
+	if err != nil || exists {
+		t.Errorf("expected follow not to exist before approval, got %v (err=%v)", exists, err)
+	}
+
+	for i, expected := range []bool{true, false} {
+		approved, err = dao.Approve(ctx, requester.PublicID, target.PublicID, time.Now())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if approved != expected {
+			t.Errorf("attempt %d: expected approved %v, got %v", i+1, expected, approved)
+		}
+	}
+	if len(client.FollowRequests()) != 0 {
+		t.Errorf("expected follow request to be deleted, got %d", len(client.FollowRequests()))
+	}
+	exists, err = follow_dao.Exists(ctx, requester.PublicID, target.PublicID)
+	if err != nil || !exists {
+		t.Errorf("expected follow to exist after approval, got %v (err=%v)", exists, err)
+	}
+	if requester.FollowingCount != 1 || target.FollowerCount != 1 {
+		t.Errorf("expected counts to be incremented once, got following=%d followers=%d", requester.FollowingCount, target.FollowerCount)
+	}
+}
+
+// TestFollowRequestDAO_Delete はリクエストの拒否とフォロー解除によるリクエストの取り消しをテストします
+func TestFollowRequestDAO_Delete(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowRequestDAO
+	var follow_dao *FollowDAO
+	var requester *ent.User
+	var target *ent.User
+	var deleted bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowRequestDAO(client)
+	follow_dao = NewFollowDAO(client)
+	requester = client.AddUser(uuid.New())
+	target = client.AddUser(uuid.New())
+
+	_, err = dao.Create(ctx, create_test_follow_request(t, requester.PublicID, target.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for i, expected := range []bool{true, false} {
+		deleted, err = dao.Delete(ctx, requester.PublicID, target.PublicID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != expected {
+			t.Errorf("attempt %d: expected deleted %v, got %v", i+1, expected, deleted)
+		}
+	}
+
+	_, err = dao.Create(ctx, create_test_follow_request(t, requester.PublicID, target.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = follow_dao.Delete(ctx, requester.PublicID, target.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.FollowRequests()) != 0 {
+		t.Errorf("expected unfollow to cancel the pending request, got %d", len(client.FollowRequests()))
+	}
+	if requester.FollowingCount != 0 || target.FollowerCount != 0 {
+		t.Errorf("expected counts to stay 0, got following=%d followers=%d", requester.FollowingCount, target.FollowerCount)
+	}
+
+	_, err = dao.Delete(ctx, uuid.New(), target.PublicID)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestFollowRequestDAO_ApproveAll は公開アカウントへの変更時の一括承認と退会したユーザーのリクエストの削除をテストします
+func TestFollowRequestDAO_ApproveAll(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowRequestDAO
+	var target *ent.User
+	var requesters []*ent.User
+	var deleted_at time.Time
+	var approved_count int
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowRequestDAO(client)
+	target = client.AddUser(uuid.New())
+	for range 3 {
+		var requester *ent.User
+
+		requester = client.AddUser(uuid.New())
+		requesters = append(requesters, requester)
+		_, err = dao.Create(ctx, create_test_follow_request(t, requester.PublicID, target.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	deleted_at = time.Now()
+	requesters[1].DeletedAt = &deleted_at
+
+	approved_count, err = dao.ApproveAll(ctx, target.PublicID, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if approved_count != 2 {
+		t.Errorf("expected 2 approved requests, got %d", approved_count)
+	}
+	if len(client.FollowRequests()) != 0 {
+		t.Errorf("expected all follow requests to be deleted, got %d", len(client.FollowRequests()))
+	}
+	if target.FollowerCount != 2 || requesters[1].FollowingCount != 0 {
+		t.Errorf("expected followers=2 and deleted user not to follow, got followers=%d following=%d", target.FollowerCount, requesters[1].FollowingCount)
+	}
+
+	approved_count, err = dao.ApproveAll(ctx, target.PublicID, time.Now())
+	if err != nil || approved_count != 0 {
+		t.Errorf("expected no requests to approve, got %d (err=%v)", approved_count, err)
+	}
+}
+
+// TestFollowRequestDAO_Approve_Blocked はリクエストの後にブロックしたユーザーのリクエストを承認せずに削除することをテストします
+func TestFollowRequestDAO_Approve_Blocked(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowRequestDAO
+	var target *ent.User
+	var blocked_requester *ent.User
+	var blocking_requester *ent.User
+	var requester *ent.User
+	var approved bool
+	var approved_count int
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowRequestDAO(client)
+	target = client.AddUser(uuid.New())
+	blocked_requester = client.AddUser(uuid.New())
+	blocking_requester = client.AddUser(uuid.New())
+	requester = client.AddUser(uuid.New())
+	for _, ent_user := range []*ent.User{blocked_requester, blocking_requester, requester} {
+		_, err = dao.Create(ctx, create_test_follow_request(t, ent_user.PublicID, target.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	client.AddBlock(target, blocked_requester)
+	client.AddBlock(blocking_requester, target)
+
+	approved, err = dao.Approve(ctx, blocked_requester.PublicID, target.PublicID, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if approved {
+		t.Error("expected request from blocked user not to be approved")
+	}
+	if len(client.FollowRequests()) != 2 {
+		t.Errorf("expected blocked request to be deleted, got %d requests", len(client.FollowRequests()))
+	}
+
+	approved_count, err = dao.ApproveAll(ctx, target.PublicID, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if approved_count != 1 {
+		t.Errorf("expected only unblocked request to be approved, got %d", approved_count)
+	}
+	if len(client.Follows()) != 1 || client.Follows()[0].FollowerID != requester.ID {
+		t.Errorf("expected only unblocked requester to follow, got %d follows", len(client.Follows()))
+	}
+	if len(client.FollowRequests()) != 0 {
+		t.Errorf("expected all follow requests to be deleted, got %d", len(client.FollowRequests()))
+	}
+}
+
+// TestFollowRequestDAO_ListReceived は受信したリクエストのページネーションと利用停止中のユーザーの除外をテストします
+func TestFollowRequestDAO_ListReceived(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *FollowRequestDAO
+	var target *ent.User
+	var requesters []*ent.User
+	var banned_at time.Time
+	var page *models.FollowRequestPage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewFollowRequestDAO(client)
+	target = client.AddUser(uuid.New())
+	for range 4 {
+		var requester *ent.User
+
+		requester = client.AddUser(uuid.New())
+		requesters = append(requesters, requester)
+		_, err = dao.Create(ctx, create_test_follow_request(t, requester.PublicID, target.PublicID))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	banned_at = time.Now()
+	requesters[2].BannedAt = &banned_at
+
+	page, err = dao.ListReceived(ctx, target.PublicID, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Requests()) != 2 || !page.HasNextPage() || page.EndCursor() == "" {
+		t.Fatalf("expected first page with 2 requests and next page, got %d requests (hasNext=%v)", len(page.Requests()), page.HasNextPage())
+	}
+	if page.Requests()[0].RequesterID() != requesters[3].PublicID || page.Requests()[1].RequesterID() != requesters[1].PublicID {
+		t.Errorf("expected newest active requests first")
+	}
+	page, err = dao.ListReceived(ctx, target.PublicID, 2, page.EndCursor())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Requests()) != 1 || page.HasNextPage() || page.Requests()[0].RequesterID() != requesters[0].PublicID {
+		t.Errorf("expected last page with the oldest request, got %d requests (hasNext=%v)", len(page.Requests()), page.HasNextPage())
+	}
+
+	_, err = dao.ListReceived(ctx, target.PublicID, 2, encode_id_cursor(followCursorKind, 1))
+	if !errors.Is(err, domain_errors.ErrInvalidPagination) {
+		t.Errorf("expected ErrInvalidPagination for a follow cursor, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/idempotencyrecord"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyRecordEntClientInterface はIdempotencyRecordDAOが利用するEnt Clientのインターフェースです
+type IdempotencyRecordEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetIdempotencyRecordClient() IdempotencyRecordClientInterface
+}
+
+// IdempotencyRecordClientInterface はEnt IdempotencyRecord Clientのインターフェースです
+type IdempotencyRecordClientInterface interface {
+	Create() IdempotencyRecordCreateInterface
+	Query() IdempotencyRecordQueryInterface
+	Update() IdempotencyRecordUpdateInterface
+	Delete() IdempotencyRecordDeleteInterface
+}
+
+// IdempotencyRecordCreateInterface はEnt IdempotencyRecord Create Builderのインターフェースです
+type IdempotencyRecordCreateInterface interface {
+	SetOperation(idempotencyrecord.Operation) IdempotencyRecordCreateInterface
+	SetKey(string) IdempotencyRecordCreateInterface
+	SetFingerprint(string) IdempotencyRecordCreateInterface
+	SetCreatedAt(time.Time) IdempotencyRecordCreateInterface
+	SetExpiresAt(time.Time) IdempotencyRecordCreateInterface
+	Save(ctx context.Context) (*ent.IdempotencyRecord, error)
+}
+
+// IdempotencyRecordQueryInterface はEnt IdempotencyRecord Query Builderのインターフェースです
+type IdempotencyRecordQueryInterface interface {
+	Where(predicates ...any) IdempotencyRecordQueryInterface
+	WithUser() IdempotencyRecordQueryInterface
+	Only(ctx context.Context) (*ent.IdempotencyRecord, error)
+}
+
+// IdempotencyRecordUpdateInterface はEnt IdempotencyRecord Update Builderのインターフェースです
+type IdempotencyRecordUpdateInterface interface {
+	Where(predicates ...any) IdempotencyRecordUpdateInterface
+	SetUserID(int) IdempotencyRecordUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// IdempotencyRecordDeleteInterface はEnt IdempotencyRecord Delete Builderのインターフェースです
+type IdempotencyRecordDeleteInterface interface {
+	Where(predicates ...any) IdempotencyRecordDeleteInterface
+	// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+	ExpiredAt(now time.Time) IdempotencyRecordDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// IdempotencyRecordDAO は冪等キーごとのリクエストの処理状況のデータアクセスオブジェクトです
+// 同じ冪等キーのリクエストが同時に届いた場合は、(operation, key)のユニーク制約で1件のみ処理を開始します
+type IdempotencyRecordDAO struct {
+	client IdempotencyRecordEntClientInterface
+}
+
+// NewIdempotencyRecordDAO は新しいIdempotencyRecordDAOを作成します
+func NewIdempotencyRecordDAO(client IdempotencyRecordEntClientInterface) *IdempotencyRecordDAO {
+	return &IdempotencyRecordDAO{
+		client: client,
+	}
+}
+
+// Reserve は処理中の記録を保存し、リクエストの処理を開始します
+// 同じ冪等キーの有効な記録が既に存在する場合はfalseを返します（有効期限切れの記録は削除して保存し直します）
+func (d *IdempotencyRecordDAO) Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error) {
+	var err error
+
+	_, err = d.client.GetIdempotencyRecordClient().
+		Delete().
+		Where("operation", string(record.Operation()), "key", record.Key().Value()).
+		ExpiredAt(record.CreatedAt()).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	_, err = d.client.GetIdempotencyRecordClient().
+		Create().
+		SetOperation(idempotencyrecord.Operation(record.Operation())).
+		SetKey(record.Key().Value()).
+		SetFingerprint(record.Fingerprint()).
+		SetCreatedAt(record.CreatedAt()).
+		SetExpiresAt(record.ExpiresAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return true, nil
+}
+
+// FindByKey は冪等キーの記録を返します（記録がない場合はnilを返します）
+func (d *IdempotencyRecordDAO) FindByKey(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey) (*models.IdempotencyRecord, error) {
+	var ent_record *ent.IdempotencyRecord
+	var user_id *uuid.UUID
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_record, err = d.client.GetIdempotencyRecordClient().
+		Query().
+		Where("operation", string(operation), "key", key.Value()).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if ent_record.Edges.User != nil {
+		user_id = &ent_record.Edges.User.PublicID
+	}
+	return models.NewIdempotencyRecordWithResult(
+		operation, key, ent_record.Fingerprint, user_id, ent_record.CreatedAt, ent_record.ExpiresAt,
+	), nil
+}
+
+// Complete は処理中の記録に処理結果のユーザーを記録します
+func (d *IdempotencyRecordDAO) Complete(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey, user_id uuid.UUID) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return handle_query_error(err)
+	}
+	_, err = d.client.GetIdempotencyRecordClient().
+		Update().
+		Where("operation", string(operation), "key", key.Value(), "user_id", nil).
+		SetUserID(ent_user.ID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// Release は処理中の記録を削除し、同じ冪等キーで再試行できるようにします（処理が完了した記録は削除しません）
+func (d *IdempotencyRecordDAO) Release(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey) error {
+	var err error
+
+	_, err = d.client.GetIdempotencyRecordClient().
+		Delete().
+		Where("operation", string(operation), "key", key.Value(), "user_id", nil).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// DeleteExpired は有効期限切れの記録を物理削除し、削除件数を返します（論理削除した記録も含みます）
+func (d *IdempotencyRecordDAO) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	var deleted_count int
+	var err error
+
+	ctx = with_hard_delete(ctx, ent.TypeIdempotencyRecord)
+	deleted_count, err = d.client.GetIdempotencyRecordClient().
+		Delete().
+		ExpiredAt(now).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count, nil
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_idempotency_record はテスト用の処理中の冪等キーの記録を作成します
+func create_test_idempotency_record(key_value string, now time.Time) *models.IdempotencyRecord {
+	var key models.IdempotencyKey
+
+	key, _ = models.NewIdempotencyKey(key_value)
+	return models.NewIdempotencyRecord(models.IdempotencyOperationRegisterUser, key, key.Fingerprint([]byte("test_fingerprint_key"), "test@example.com"), now, now.Add(time.Hour))
+}
+
+// TestIdempotencyRecordDAO_ReserveAndComplete は処理の開始・重複の検出・処理結果の記録をテストします
+func TestIdempotencyRecordDAO_ReserveAndComplete(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *IdempotencyRecordDAO
+	var now time.Time
+	var record *models.IdempotencyRecord
+	var found_record *models.IdempotencyRecord
+	var reserved bool
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewIdempotencyRecordDAO(NewMockIdempotencyRecordEntClient(user_id))
+	now = time.Now()
+	record = create_test_idempotency_record("key-1", now)
+	reserved, err = dao.Reserve(ctx, record)
+	if err != nil || !reserved {
+		t.Fatalf("expected record to be reserved, got %v, %v", reserved, err)
+	}
+	reserved, err = dao.Reserve(ctx, create_test_idempotency_record("key-1", now))
+	if err != nil || reserved {
+		t.Fatalf("expected duplicate key not to be reserved, got %v, %v", reserved, err)
+	}
+	found_record, _ = dao.FindByKey(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	if found_record == nil || found_record.IsCompleted() || !found_record.MatchesFingerprint(record.Fingerprint()) {
+		t.Fatalf("expected in-progress record, got %+v", found_record)
+	}
+
+	err = dao.Complete(ctx, models.IdempotencyOperationRegisterUser, record.Key(), user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_record, _ = dao.FindByKey(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	if found_record == nil || !found_record.IsCompleted() || *found_record.UserID() != user_id {
+		t.Errorf("expected completed record for %s, got %+v", user_id, found_record)
+	}
+
+	// 処理が完了した記録は解放されない
+	_ = dao.Release(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	found_record, _ = dao.FindByKey(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	if found_record == nil {
+		t.Error("expected completed record not to be released")
+	}
+}
+
+// TestIdempotencyRecordDAO_ReleaseAndExpire は処理中の記録の解放と有効期限切れの記録の再利用・削除をテストします
+func TestIdempotencyRecordDAO_ReleaseAndExpire(t *testing.T) {
+	var ctx context.Context
+	var client *MockIdempotencyRecordEntClient
+	var dao *IdempotencyRecordDAO
+	var now time.Time
+	var record *models.IdempotencyRecord
+	var found_record *models.IdempotencyRecord
+	var reserved bool
+	var deleted_count int
+
+	ctx = context.Background()
+	client = NewMockIdempotencyRecordEntClient(uuid.New())
+	dao = NewIdempotencyRecordDAO(client)
+	now = time.Now()
+	record = create_test_idempotency_record("key-1", now)
+	_, _ = dao.Reserve(ctx, record)
+	_ = dao.Release(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	found_record, _ = dao.FindByKey(ctx, models.IdempotencyOperationRegisterUser, record.Key())
+	if found_record != nil {
+		t.Errorf("expected released record to be deleted, got %+v", found_record)
+	}
+
+	// 有効期限切れの記録は新しいリクエストとして保存し直す
+	_, _ = dao.Reserve(ctx, record)
+	reserved, _ = dao.Reserve(ctx, create_test_idempotency_record("key-1", now.Add(time.Hour)))
+	if !reserved {
+		t.Error("expected expired key to be reserved again")
+	}
+	_, _ = dao.Reserve(ctx, create_test_idempotency_record("key-2", now))
+	deleted_count, _ = dao.DeleteExpired(ctx, now.Add(time.Hour))
+	if deleted_count != 1 || len(client.Records()) != 1 || client.Records()[0].Key != "key-1" {
+		t.Errorf("expected only the expired record to be deleted, got %d, %+v", deleted_count, client.Records())
+	}
+}
+
+// TestIdempotencyRecordDAO_DatabaseError はDBエラーがErrDatabaseErrorとして返されることをテストします
+func TestIdempotencyRecordDAO_DatabaseError(t *testing.T) {
+	var dao *IdempotencyRecordDAO
+	var err error
+
+	dao = NewIdempotencyRecordDAO(NewMockIdempotencyRecordEntClientWithDatabaseError(uuid.New()))
+	_, err = dao.Reserve(context.Background(), create_test_idempotency_record("key-1", time.Now()))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
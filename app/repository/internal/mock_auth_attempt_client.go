@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+)
+
+// MockAuthAttemptEntClient はテスト用のインメモリな失敗回数のEntクライアントです
+type MockAuthAttemptEntClient struct {
+	attempts                     []*ent.AuthAttempt
+	should_return_database_error bool
+}
+
+// NewMockAuthAttemptEntClient はモッククライアントを作成します
+func NewMockAuthAttemptEntClient() *MockAuthAttemptEntClient {
+	return &MockAuthAttemptEntClient{
+		attempts:                     []*ent.AuthAttempt{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockAuthAttemptEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockAuthAttemptEntClientWithDatabaseError() *MockAuthAttemptEntClient {
+	var client *MockAuthAttemptEntClient
+
+	client = NewMockAuthAttemptEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// GetAuthAttemptClient はモックのAuthAttemptClientを返します
+func (m *MockAuthAttemptEntClient) GetAuthAttemptClient() AuthAttemptClientInterface {
+	return &MockAuthAttemptClient{store: m}
+}
+
+// Attempts は保存された失敗回数の記録を返します
+func (m *MockAuthAttemptEntClient) Attempts() []*ent.AuthAttempt {
+	return m.attempts
+}
+
+// build_auth_attempt_values はWhere判定用にAuthAttemptのフィールド値を返します
+func build_auth_attempt_values(ent_attempt *ent.AuthAttempt) map[string]any {
+	return map[string]any{
+		"key": ent_attempt.Key,
+	}
+}
+
+// match_mock_auth_attempt は条件と有効期限の絞り込みに記録が一致するかを判定します
+func match_mock_auth_attempt(ent_attempt *ent.AuthAttempt, predicates []any, active_at *time.Time, expired_at *time.Time) bool {
+	if !match_mock_predicates(build_auth_attempt_values(ent_attempt), predicates) {
+		return false
+	}
+	if active_at != nil && !ent_attempt.ExpiresAt.After(*active_at) {
+		return false
+	}
+	if expired_at != nil && ent_attempt.ExpiresAt.After(*expired_at) {
+		return false
+	}
+	return true
+}
+
+// MockAuthAttemptClient はモックのAuthAttemptClientです
+type MockAuthAttemptClient struct {
+	store *MockAuthAttemptEntClient
+}
+
+// Create はモックのAuthAttemptCreate Builderを返します
+func (m *MockAuthAttemptClient) Create() AuthAttemptCreateInterface {
+	return &MockAuthAttemptCreate{store: m.store, attempt: &ent.AuthAttempt{}}
+}
+
+// Query はモックのAuthAttemptQuery Builderを返します
+func (m *MockAuthAttemptClient) Query() AuthAttemptQueryInterface {
+	return &MockAuthAttemptQuery{store: m.store}
+}
+
+// Update はモックのAuthAttemptUpdate Builderを返します
+func (m *MockAuthAttemptClient) Update() AuthAttemptUpdateInterface {
+	return &MockAuthAttemptUpdate{store: m.store}
+}
+
+// Delete はモックのAuthAttemptDelete Builderを返します
+func (m *MockAuthAttemptClient) Delete() AuthAttemptDeleteInterface {
+	return &MockAuthAttemptDelete{store: m.store}
+}
+
+// MockAuthAttemptCreate はモックのAuthAttemptCreate Builderです
+type MockAuthAttemptCreate struct {
+	store   *MockAuthAttemptEntClient
+	attempt *ent.AuthAttempt
+}
+
+// SetKey はキーを設定します
+func (m *MockAuthAttemptCreate) SetKey(key string) AuthAttemptCreateInterface {
+	m.attempt.Key = key
+	return m
+}
+
+// SetFailureCount は失敗回数を設定します
+func (m *MockAuthAttemptCreate) SetFailureCount(failure_count int) AuthAttemptCreateInterface {
+	m.attempt.FailureCount = failure_count
+	return m
+}
+
+// SetLastFailedAt は最後の失敗日時を設定します
+func (m *MockAuthAttemptCreate) SetLastFailedAt(last_failed_at time.Time) AuthAttemptCreateInterface {
+	m.attempt.LastFailedAt = last_failed_at
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockAuthAttemptCreate) SetExpiresAt(expires_at time.Time) AuthAttemptCreateInterface {
+	m.attempt.ExpiresAt = expires_at
+	return m
+}
+
+// Save は失敗回数の記録を保存します（キーが重複する場合はユニーク制約エラーを返します）
+func (m *MockAuthAttemptCreate) Save(_ context.Context) (*ent.AuthAttempt, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_attempt := range m.store.attempts {
+		if ent_attempt.Key == m.attempt.Key {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.attempt.ID = len(m.store.attempts) + 1
+	m.store.attempts = append(m.store.attempts, m.attempt)
+	return m.attempt, nil
+}
+
+// MockAuthAttemptQuery はモックのAuthAttemptQuery Builderです
+type MockAuthAttemptQuery struct {
+	store      *MockAuthAttemptEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockAuthAttemptQuery) Where(predicates ...any) AuthAttemptQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Only は条件に一致する単一の失敗回数の記録を返します
+func (m *MockAuthAttemptQuery) Only(_ context.Context) (*ent.AuthAttempt, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_attempt := range m.store.attempts {
+		if match_mock_auth_attempt(ent_attempt, m.predicates, nil, nil) {
+			return ent_attempt, nil
+		}
+	}
+	return nil, fmt.Errorf("auth attempt not found")
+}
+
+// MockAuthAttemptUpdate はモックのAuthAttemptUpdate Builderです
+type MockAuthAttemptUpdate struct {
+	store             *MockAuthAttemptEntClient
+	predicates        []any
+	active_at         *time.Time
+	expired_at        *time.Time
+	add_failure_count int
+	failure_count     *int
+	last_failed_at    *time.Time
+	expires_at        *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockAuthAttemptUpdate) Where(predicates ...any) AuthAttemptUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// ActiveAt は有効期限が指定日時より後の記録に絞り込みます
+func (m *MockAuthAttemptUpdate) ActiveAt(now time.Time) AuthAttemptUpdateInterface {
+	m.active_at = &now
+	return m
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (m *MockAuthAttemptUpdate) ExpiredAt(now time.Time) AuthAttemptUpdateInterface {
+	m.expired_at = &now
+	return m
+}
+
+// AddFailureCount は失敗回数を加算します
+func (m *MockAuthAttemptUpdate) AddFailureCount(failure_count int) AuthAttemptUpdateInterface {
+	m.add_failure_count += failure_count
+	return m
+}
+
+// SetFailureCount は失敗回数を設定します
+func (m *MockAuthAttemptUpdate) SetFailureCount(failure_count int) AuthAttemptUpdateInterface {
+	m.failure_count = &failure_count
+	return m
+}
+
+// SetLastFailedAt は最後の失敗日時を設定します
+func (m *MockAuthAttemptUpdate) SetLastFailedAt(last_failed_at time.Time) AuthAttemptUpdateInterface {
+	m.last_failed_at = &last_failed_at
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockAuthAttemptUpdate) SetExpiresAt(expires_at time.Time) AuthAttemptUpdateInterface {
+	m.expires_at = &expires_at
+	return m
+}
+
+// Save は条件に一致する失敗回数の記録を更新し、更新件数を返します
+func (m *MockAuthAttemptUpdate) Save(_ context.Context) (int, error) {
+	var affected_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_attempt := range m.store.attempts {
+		if !match_mock_auth_attempt(ent_attempt, m.predicates, m.active_at, m.expired_at) {
+			continue
+		}
+		if m.failure_count != nil {
+			ent_attempt.FailureCount = *m.failure_count
+		}
+		ent_attempt.FailureCount += m.add_failure_count
+		if m.last_failed_at != nil {
+			ent_attempt.LastFailedAt = *m.last_failed_at
+		}
+		if m.expires_at != nil {
+			ent_attempt.ExpiresAt = *m.expires_at
+		}
+		affected_count++
+	}
+	return affected_count, nil
+}
+
+// MockAuthAttemptDelete はモックのAuthAttemptDelete Builderです
+type MockAuthAttemptDelete struct {
+	store      *MockAuthAttemptEntClient
+	predicates []any
+	expired_at *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockAuthAttemptDelete) Where(predicates ...any) AuthAttemptDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (m *MockAuthAttemptDelete) ExpiredAt(now time.Time) AuthAttemptDeleteInterface {
+	m.expired_at = &now
+	return m
+}
+
+// Exec は条件に一致する失敗回数の記録を削除し、削除件数を返します
+func (m *MockAuthAttemptDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.AuthAttempt
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.AuthAttempt{}
+	for _, ent_attempt := range m.store.attempts {
+		if match_mock_auth_attempt(ent_attempt, m.predicates, nil, m.expired_at) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, ent_attempt)
+	}
+	m.store.attempts = remaining
+	return deleted_count, nil
+}
@@ -0,0 +1,290 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/compensationtask"
+
+	"github.com/google/uuid"
+)
+
+// MockCompensationTaskEntClient はテスト用のインメモリな補償処理Entクライアントです
+type MockCompensationTaskEntClient struct {
+	tasks                        []*ent.CompensationTask
+	should_return_database_error bool
+}
+
+// NewMockCompensationTaskEntClient は新しいMockCompensationTaskEntClientを作成します
+func NewMockCompensationTaskEntClient() *MockCompensationTaskEntClient {
+	return &MockCompensationTaskEntClient{
+		tasks:                        []*ent.CompensationTask{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockCompensationTaskEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockCompensationTaskEntClientWithDatabaseError() *MockCompensationTaskEntClient {
+	var client *MockCompensationTaskEntClient
+
+	client = NewMockCompensationTaskEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// GetCompensationTaskClient はモックのCompensationTaskClientを返します
+func (m *MockCompensationTaskEntClient) GetCompensationTaskClient() CompensationTaskClientInterface {
+	return &MockCompensationTaskClient{store: m}
+}
+
+// Tasks は保存された補償処理を返します
+func (m *MockCompensationTaskEntClient) Tasks() []*ent.CompensationTask {
+	return m.tasks
+}
+
+// build_compensation_task_values はWhere判定用にCompensationTaskのフィールド値を返します
+func build_compensation_task_values(task *ent.CompensationTask) map[string]any {
+	return map[string]any{
+		"task_id": task.TaskID,
+		"status":  task.Status,
+	}
+}
+
+// MockCompensationTaskClient はモックのCompensationTaskClientです
+type MockCompensationTaskClient struct {
+	store *MockCompensationTaskEntClient
+}
+
+// Create はモックのCompensationTaskCreate Builderを返します
+func (m *MockCompensationTaskClient) Create() CompensationTaskCreateInterface {
+	return &MockCompensationTaskCreate{store: m.store, task: &ent.CompensationTask{}}
+}
+
+// Query はモックのCompensationTaskQuery Builderを返します
+func (m *MockCompensationTaskClient) Query() CompensationTaskQueryInterface {
+	return &MockCompensationTaskQuery{store: m.store}
+}
+
+// Update はモックのCompensationTaskUpdate Builderを返します
+func (m *MockCompensationTaskClient) Update() CompensationTaskUpdateInterface {
+	return &MockCompensationTaskUpdate{store: m.store}
+}
+
+// Delete はモックのCompensationTaskDelete Builderを返します
+func (m *MockCompensationTaskClient) Delete() CompensationTaskDeleteInterface {
+	return &MockCompensationTaskDelete{store: m.store}
+}
+
+// MockCompensationTaskCreate はモックのCompensationTaskCreate Builderです
+type MockCompensationTaskCreate struct {
+	store *MockCompensationTaskEntClient
+	task  *ent.CompensationTask
+}
+
+// SetTaskID は補償処理IDを設定します
+func (m *MockCompensationTaskCreate) SetTaskID(task_id uuid.UUID) CompensationTaskCreateInterface {
+	m.task.TaskID = task_id
+	return m
+}
+
+// SetKind は補償処理の種類を設定します
+func (m *MockCompensationTaskCreate) SetKind(kind compensationtask.Kind) CompensationTaskCreateInterface {
+	m.task.Kind = kind
+	return m
+}
+
+// SetTarget は補償処理の対象を設定します
+func (m *MockCompensationTaskCreate) SetTarget(target string) CompensationTaskCreateInterface {
+	m.task.Target = target
+	return m
+}
+
+// SetStatus は状態を設定します
+func (m *MockCompensationTaskCreate) SetStatus(status compensationtask.Status) CompensationTaskCreateInterface {
+	m.task.Status = status
+	return m
+}
+
+// SetAttempts は試行回数を設定します
+func (m *MockCompensationTaskCreate) SetAttempts(attempts int) CompensationTaskCreateInterface {
+	m.task.Attempts = attempts
+	return m
+}
+
+// SetLastError は最後に失敗した際のエラーメッセージを設定します
+func (m *MockCompensationTaskCreate) SetLastError(last_error string) CompensationTaskCreateInterface {
+	m.task.LastError = last_error
+	return m
+}
+
+// SetNextAttemptAt は次回の試行日時を設定します
+func (m *MockCompensationTaskCreate) SetNextAttemptAt(next_attempt_at time.Time) CompensationTaskCreateInterface {
+	m.task.NextAttemptAt = next_attempt_at
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockCompensationTaskCreate) SetCreatedAt(created_at time.Time) CompensationTaskCreateInterface {
+	m.task.CreatedAt = created_at
+	return m
+}
+
+// Save は補償処理を保存します
+func (m *MockCompensationTaskCreate) Save(_ context.Context) (*ent.CompensationTask, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	m.task.ID = len(m.store.tasks) + 1
+	m.store.tasks = append(m.store.tasks, m.task)
+	return m.task, nil
+}
+
+// MockCompensationTaskQuery はモックのCompensationTaskQuery Builderです
+type MockCompensationTaskQuery struct {
+	store      *MockCompensationTaskEntClient
+	predicates []any
+	due_at     *time.Time
+	limit      int
+}
+
+// Where は条件を追加します
+func (m *MockCompensationTaskQuery) Where(predicates ...any) CompensationTaskQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// DueAt は次回の試行日時が指定日時以前の補償処理に絞り込み、次回の試行日時の昇順に並べます
+func (m *MockCompensationTaskQuery) DueAt(now time.Time) CompensationTaskQueryInterface {
+	m.due_at = &now
+	return m
+}
+
+// Limit は取得件数の上限を設定します
+func (m *MockCompensationTaskQuery) Limit(limit int) CompensationTaskQueryInterface {
+	m.limit = limit
+	return m
+}
+
+// All は条件に一致する全ての補償処理を返します
+func (m *MockCompensationTaskQuery) All(_ context.Context) ([]*ent.CompensationTask, error) {
+	var tasks []*ent.CompensationTask
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	tasks = []*ent.CompensationTask{}
+	for _, task := range m.store.tasks {
+		if !match_mock_predicates(build_compensation_task_values(task), m.predicates) {
+			continue
+		}
+		if m.due_at != nil && task.NextAttemptAt.After(*m.due_at) {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	if m.due_at != nil {
+		slices.SortFunc(tasks, func(a *ent.CompensationTask, b *ent.CompensationTask) int {
+			return a.NextAttemptAt.Compare(b.NextAttemptAt)
+		})
+	}
+	if m.limit > 0 && len(tasks) > m.limit {
+		tasks = tasks[:m.limit]
+	}
+	return tasks, nil
+}
+
+// MockCompensationTaskUpdate はモックのCompensationTaskUpdate Builderです
+type MockCompensationTaskUpdate struct {
+	store           *MockCompensationTaskEntClient
+	predicates      []any
+	status          compensationtask.Status
+	attempts        int
+	last_error      string
+	next_attempt_at time.Time
+}
+
+// Where は条件を追加します
+func (m *MockCompensationTaskUpdate) Where(predicates ...any) CompensationTaskUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetStatus は状態を設定します
+func (m *MockCompensationTaskUpdate) SetStatus(status compensationtask.Status) CompensationTaskUpdateInterface {
+	m.status = status
+	return m
+}
+
+// SetAttempts は試行回数を設定します
+func (m *MockCompensationTaskUpdate) SetAttempts(attempts int) CompensationTaskUpdateInterface {
+	m.attempts = attempts
+	return m
+}
+
+// SetLastError は最後に失敗した際のエラーメッセージを設定します
+func (m *MockCompensationTaskUpdate) SetLastError(last_error string) CompensationTaskUpdateInterface {
+	m.last_error = last_error
+	return m
+}
+
+// SetNextAttemptAt は次回の試行日時を設定します
+func (m *MockCompensationTaskUpdate) SetNextAttemptAt(next_attempt_at time.Time) CompensationTaskUpdateInterface {
+	m.next_attempt_at = next_attempt_at
+	return m
+}
+
+// Save は条件に一致する補償処理を更新し、更新件数を返します
+func (m *MockCompensationTaskUpdate) Save(_ context.Context) (int, error) {
+	var updated_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	updated_count = 0
+	for _, task := range m.store.tasks {
+		if match_mock_predicates(build_compensation_task_values(task), m.predicates) {
+			task.Status = m.status
+			task.Attempts = m.attempts
+			task.LastError = m.last_error
+			task.NextAttemptAt = m.next_attempt_at
+			updated_count++
+		}
+	}
+	return updated_count, nil
+}
+
+// MockCompensationTaskDelete はモックのCompensationTaskDelete Builderです
+type MockCompensationTaskDelete struct {
+	store      *MockCompensationTaskEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockCompensationTaskDelete) Where(predicates ...any) CompensationTaskDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致する補償処理を削除し、削除件数を返します
+func (m *MockCompensationTaskDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.CompensationTask
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.CompensationTask{}
+	deleted_count = 0
+	for _, task := range m.store.tasks {
+		if match_mock_predicates(build_compensation_task_values(task), m.predicates) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, task)
+	}
+	m.store.tasks = remaining
+	return deleted_count, nil
+}
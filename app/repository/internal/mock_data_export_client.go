@@ -0,0 +1,372 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/dataexport"
+
+	"github.com/google/uuid"
+)
+
+// MockDataExportEntClient はテスト用のインメモリなエクスポートのEntクライアントです
+// ユーザーはAddUserで追加します
+type MockDataExportEntClient struct {
+	users                        []*ent.User
+	exports                      []*ent.DataExport
+	should_return_database_error bool
+}
+
+// NewMockDataExportEntClient はユーザーのいないモッククライアントを作成します
+func NewMockDataExportEntClient() *MockDataExportEntClient {
+	return &MockDataExportEntClient{
+		users:                        []*ent.User{},
+		exports:                      []*ent.DataExport{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockDataExportEntClientWithDatabaseError はエクスポートの操作でDBエラーを返すモッククライアントを作成します
+func NewMockDataExportEntClientWithDatabaseError() *MockDataExportEntClient {
+	var client *MockDataExportEntClient
+
+	client = NewMockDataExportEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します
+func (m *MockDataExportEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// Exports は保存されたエクスポートを返します
+func (m *MockDataExportEntClient) Exports() []*ent.DataExport {
+	return m.exports
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockDataExportEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users}
+}
+
+// GetDataExportClient はモックのDataExportClientを返します
+func (m *MockDataExportEntClient) GetDataExportClient() DataExportClientInterface {
+	return &MockDataExportClient{store: m}
+}
+
+// find_user_by_id は内部IDに一致するユーザーを返します
+func (m *MockDataExportEntClient) find_user_by_id(id int) *ent.User {
+	for _, ent_user := range m.users {
+		if ent_user.ID == id {
+			return ent_user
+		}
+	}
+	return nil
+}
+
+// build_data_export_values はWhere判定用にDataExportのフィールド値を返します
+func build_data_export_values(ent_export *ent.DataExport) map[string]any {
+	var download_token_hash any
+
+	if ent_export.DownloadTokenHash != nil {
+		download_token_hash = *ent_export.DownloadTokenHash
+	}
+	return map[string]any{
+		"export_id":           ent_export.ExportID,
+		"user_id":             ent_export.UserID,
+		"status":              ent_export.Status,
+		"download_token_hash": download_token_hash,
+	}
+}
+
+// MockDataExportClient はモックのDataExportClientです
+type MockDataExportClient struct {
+	store *MockDataExportEntClient
+}
+
+// Create はモックのDataExportCreate Builderを返します
+func (m *MockDataExportClient) Create() DataExportCreateInterface {
+	return &MockDataExportCreate{store: m.store, export: &ent.DataExport{}}
+}
+
+// Query はモックのDataExportQuery Builderを返します
+func (m *MockDataExportClient) Query() DataExportQueryInterface {
+	return &MockDataExportQuery{store: m.store}
+}
+
+// Update はモックのDataExportUpdate Builderを返します
+func (m *MockDataExportClient) Update() DataExportUpdateInterface {
+	return &MockDataExportUpdate{store: m.store}
+}
+
+// Delete はモックのDataExportDelete Builderを返します
+func (m *MockDataExportClient) Delete() DataExportDeleteInterface {
+	return &MockDataExportDelete{store: m.store}
+}
+
+// MockDataExportCreate はモックのDataExportCreate Builderです
+type MockDataExportCreate struct {
+	store  *MockDataExportEntClient
+	export *ent.DataExport
+}
+
+// SetExportID はエクスポートIDを設定します
+func (m *MockDataExportCreate) SetExportID(export_id uuid.UUID) DataExportCreateInterface {
+	m.export.ExportID = export_id
+	return m
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockDataExportCreate) SetUserID(user_id int) DataExportCreateInterface {
+	m.export.UserID = user_id
+	return m
+}
+
+// SetStatus は状態を設定します
+func (m *MockDataExportCreate) SetStatus(status dataexport.Status) DataExportCreateInterface {
+	m.export.Status = status
+	return m
+}
+
+// SetCreatedAt は要求日時を設定します
+func (m *MockDataExportCreate) SetCreatedAt(created_at time.Time) DataExportCreateInterface {
+	m.export.CreatedAt = created_at
+	return m
+}
+
+// Save はエクスポートを保存します（ユーザーのEdgeも設定します）
+func (m *MockDataExportCreate) Save(_ context.Context) (*ent.DataExport, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	m.export.ID = len(m.store.exports) + 1
+	m.export.Edges.User = m.store.find_user_by_id(m.export.UserID)
+	m.store.exports = append(m.store.exports, m.export)
+	return m.export, nil
+}
+
+// MockDataExportQuery はモックのDataExportQuery Builderです
+type MockDataExportQuery struct {
+	store        *MockDataExportEntClient
+	predicates   []any
+	expired_at   *time.Time
+	order_by_asc bool
+	limit        int
+}
+
+// Where は条件を追加します
+func (m *MockDataExportQuery) Where(predicates ...any) DataExportQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// ExpiredAt はダウンロードの有効期限が指定日時以前のエクスポートに絞り込みます
+func (m *MockDataExportQuery) ExpiredAt(now time.Time) DataExportQueryInterface {
+	m.expired_at = &now
+	return m
+}
+
+// OrderByCreatedAtAsc は要求日時の昇順に並べます
+func (m *MockDataExportQuery) OrderByCreatedAtAsc() DataExportQueryInterface {
+	m.order_by_asc = true
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockDataExportQuery) WithUser() DataExportQueryInterface {
+	return m
+}
+
+// Limit は取得件数の上限を設定します
+func (m *MockDataExportQuery) Limit(limit int) DataExportQueryInterface {
+	m.limit = limit
+	return m
+}
+
+// All は条件に一致する全てのエクスポートを返します
+func (m *MockDataExportQuery) All(_ context.Context) ([]*ent.DataExport, error) {
+	var exports []*ent.DataExport
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	exports = []*ent.DataExport{}
+	for _, ent_export := range m.store.exports {
+		if !match_mock_predicates(build_data_export_values(ent_export), m.predicates) {
+			continue
+		}
+		if m.expired_at != nil && (ent_export.ExpiresAt == nil || ent_export.ExpiresAt.After(*m.expired_at)) {
+			continue
+		}
+		exports = append(exports, ent_export)
+	}
+	if m.order_by_asc {
+		sort.SliceStable(exports, func(i, j int) bool {
+			return exports[i].CreatedAt.Before(exports[j].CreatedAt)
+		})
+	}
+	if m.limit > 0 && len(exports) > m.limit {
+		exports = exports[:m.limit]
+	}
+	return exports, nil
+}
+
+// First は条件に一致する最初のエクスポートを返します
+func (m *MockDataExportQuery) First(ctx context.Context) (*ent.DataExport, error) {
+	var exports []*ent.DataExport
+	var err error
+
+	exports, err = m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exports) == 0 {
+		return nil, fmt.Errorf("data export not found")
+	}
+	return exports[0], nil
+}
+
+// Only は条件に一致する単一のエクスポートを返します
+func (m *MockDataExportQuery) Only(ctx context.Context) (*ent.DataExport, error) {
+	var exports []*ent.DataExport
+	var err error
+
+	exports, err = m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exports) == 0 {
+		return nil, fmt.Errorf("data export not found")
+	}
+	if len(exports) > 1 {
+		return nil, fmt.Errorf("data export not singular")
+	}
+	return exports[0], nil
+}
+
+// MockDataExportUpdate はモックのDataExportUpdate Builderです
+type MockDataExportUpdate struct {
+	store               *MockDataExportEntClient
+	predicates          []any
+	status              *dataexport.Status
+	storage_key         *string
+	download_token_hash *string
+	last_error          *string
+	completed_at        *time.Time
+	expires_at          *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockDataExportUpdate) Where(predicates ...any) DataExportUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetStatus は状態を設定します
+func (m *MockDataExportUpdate) SetStatus(status dataexport.Status) DataExportUpdateInterface {
+	m.status = &status
+	return m
+}
+
+// SetStorageKey はZIPファイルのストレージキーを設定します
+func (m *MockDataExportUpdate) SetStorageKey(storage_key string) DataExportUpdateInterface {
+	m.storage_key = &storage_key
+	return m
+}
+
+// SetDownloadTokenHash はダウンロードリンクのトークンのハッシュを設定します
+func (m *MockDataExportUpdate) SetDownloadTokenHash(download_token_hash string) DataExportUpdateInterface {
+	m.download_token_hash = &download_token_hash
+	return m
+}
+
+// SetLastError は失敗の理由を設定します
+func (m *MockDataExportUpdate) SetLastError(last_error string) DataExportUpdateInterface {
+	m.last_error = &last_error
+	return m
+}
+
+// SetCompletedAt は処理の完了日時を設定します
+func (m *MockDataExportUpdate) SetCompletedAt(completed_at time.Time) DataExportUpdateInterface {
+	m.completed_at = &completed_at
+	return m
+}
+
+// SetExpiresAt はダウンロードの有効期限を設定します
+func (m *MockDataExportUpdate) SetExpiresAt(expires_at time.Time) DataExportUpdateInterface {
+	m.expires_at = &expires_at
+	return m
+}
+
+// Save は条件に一致するエクスポートを更新し、更新件数を返します
+func (m *MockDataExportUpdate) Save(_ context.Context) (int, error) {
+	var updated int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_export := range m.store.exports {
+		if !match_mock_predicates(build_data_export_values(ent_export), m.predicates) {
+			continue
+		}
+		if m.status != nil {
+			ent_export.Status = *m.status
+		}
+		if m.storage_key != nil {
+			ent_export.StorageKey = m.storage_key
+		}
+		if m.download_token_hash != nil {
+			ent_export.DownloadTokenHash = m.download_token_hash
+		}
+		if m.last_error != nil {
+			ent_export.LastError = *m.last_error
+		}
+		if m.completed_at != nil {
+			ent_export.CompletedAt = m.completed_at
+		}
+		if m.expires_at != nil {
+			ent_export.ExpiresAt = m.expires_at
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// MockDataExportDelete はモックのDataExportDelete Builderです
+type MockDataExportDelete struct {
+	store      *MockDataExportEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockDataExportDelete) Where(predicates ...any) DataExportDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するエクスポートを削除し、削除件数を返します
+func (m *MockDataExportDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.DataExport
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_export := range m.store.exports {
+		if match_mock_predicates(build_data_export_values(ent_export), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_export)
+	}
+	m.store.exports = remaining
+	return deleted, nil
+}
@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// MockEmailChangeRequestEntClient はテスト用のインメモリなメールアドレスの変更のEntクライアントです
+// ユーザーはAddUserで追加します
+type MockEmailChangeRequestEntClient struct {
+	users                        []*ent.User
+	requests                     []*ent.EmailChangeRequest
+	should_return_database_error bool
+}
+
+// NewMockEmailChangeRequestEntClient はユーザーのいないモッククライアントを作成します
+func NewMockEmailChangeRequestEntClient() *MockEmailChangeRequestEntClient {
+	return &MockEmailChangeRequestEntClient{
+		users:                        []*ent.User{},
+		requests:                     []*ent.EmailChangeRequest{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockEmailChangeRequestEntClientWithDatabaseError は変更の要求の操作でDBエラーを返すモッククライアントを作成します
+func NewMockEmailChangeRequestEntClientWithDatabaseError() *MockEmailChangeRequestEntClient {
+	var client *MockEmailChangeRequestEntClient
+
+	client = NewMockEmailChangeRequestEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します
+func (m *MockEmailChangeRequestEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// Requests は保存された変更の要求を返します
+func (m *MockEmailChangeRequestEntClient) Requests() []*ent.EmailChangeRequest {
+	return m.requests
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockEmailChangeRequestEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users}
+}
+
+// GetEmailChangeRequestClient はモックのEmailChangeRequestClientを返します
+func (m *MockEmailChangeRequestEntClient) GetEmailChangeRequestClient() EmailChangeRequestClientInterface {
+	return &MockEmailChangeRequestClient{store: m}
+}
+
+// find_user_by_id は内部IDに一致するユーザーを返します
+func (m *MockEmailChangeRequestEntClient) find_user_by_id(id int) *ent.User {
+	for _, ent_user := range m.users {
+		if ent_user.ID == id {
+			return ent_user
+		}
+	}
+	return nil
+}
+
+// build_email_change_request_values はWhere判定用にEmailChangeRequestのフィールド値を返します
+func build_email_change_request_values(ent_request *ent.EmailChangeRequest) map[string]any {
+	return map[string]any{
+		"user_id":    ent_request.UserID,
+		"new_email":  ent_request.NewEmail,
+		"token_hash": ent_request.TokenHash,
+	}
+}
+
+// MockEmailChangeRequestClient はモックのEmailChangeRequestClientです
+type MockEmailChangeRequestClient struct {
+	store *MockEmailChangeRequestEntClient
+}
+
+// Create はモックのEmailChangeRequestCreate Builderを返します
+func (m *MockEmailChangeRequestClient) Create() EmailChangeRequestCreateInterface {
+	return &MockEmailChangeRequestCreate{store: m.store, request: &ent.EmailChangeRequest{}}
+}
+
+// Query はモックのEmailChangeRequestQuery Builderを返します
+func (m *MockEmailChangeRequestClient) Query() EmailChangeRequestQueryInterface {
+	return &MockEmailChangeRequestQuery{store: m.store}
+}
+
+// Delete はモックのEmailChangeRequestDelete Builderを返します
+func (m *MockEmailChangeRequestClient) Delete() EmailChangeRequestDeleteInterface {
+	return &MockEmailChangeRequestDelete{store: m.store}
+}
+
+// MockEmailChangeRequestCreate はモックのEmailChangeRequestCreate Builderです
+type MockEmailChangeRequestCreate struct {
+	store   *MockEmailChangeRequestEntClient
+	request *ent.EmailChangeRequest
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockEmailChangeRequestCreate) SetUserID(user_id int) EmailChangeRequestCreateInterface {
+	m.request.UserID = user_id
+	return m
+}
+
+// SetNewEmail は変更後のメールアドレスを設定します
+func (m *MockEmailChangeRequestCreate) SetNewEmail(new_email string) EmailChangeRequestCreateInterface {
+	m.request.NewEmail = new_email
+	return m
+}
+
+// SetTokenHash は確認リンクのトークンのハッシュを設定します
+func (m *MockEmailChangeRequestCreate) SetTokenHash(token_hash string) EmailChangeRequestCreateInterface {
+	m.request.TokenHash = token_hash
+	return m
+}
+
+// SetExpiresAt は確認リンクの有効期限を設定します
+func (m *MockEmailChangeRequestCreate) SetExpiresAt(expires_at time.Time) EmailChangeRequestCreateInterface {
+	m.request.ExpiresAt = expires_at
+	return m
+}
+
+// SetCreatedAt は要求日時を設定します
+func (m *MockEmailChangeRequestCreate) SetCreatedAt(created_at time.Time) EmailChangeRequestCreateInterface {
+	m.request.CreatedAt = created_at
+	return m
+}
+
+// Save は変更の要求を保存します（user_idの一意制約とユーザーのEdgeも再現します）
+func (m *MockEmailChangeRequestCreate) Save(_ context.Context) (*ent.EmailChangeRequest, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.requests {
+		if ent_request.UserID == m.request.UserID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.request.ID = len(m.store.requests) + 1
+	m.request.Edges.User = m.store.find_user_by_id(m.request.UserID)
+	m.store.requests = append(m.store.requests, m.request)
+	return m.request, nil
+}
+
+// MockEmailChangeRequestQuery はモックのEmailChangeRequestQuery Builderです
+type MockEmailChangeRequestQuery struct {
+	store      *MockEmailChangeRequestEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockEmailChangeRequestQuery) Where(predicates ...any) EmailChangeRequestQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockEmailChangeRequestQuery) WithUser() EmailChangeRequestQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一の変更の要求を返します
+func (m *MockEmailChangeRequestQuery) Only(_ context.Context) (*ent.EmailChangeRequest, error) {
+	var requests []*ent.EmailChangeRequest
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.requests {
+		if match_mock_predicates(build_email_change_request_values(ent_request), m.predicates) {
+			requests = append(requests, ent_request)
+		}
+	}
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("email change request not found")
+	}
+	if len(requests) > 1 {
+		return nil, fmt.Errorf("email change request not singular")
+	}
+	return requests[0], nil
+}
+
+// MockEmailChangeRequestDelete はモックのEmailChangeRequestDelete Builderです
+type MockEmailChangeRequestDelete struct {
+	store      *MockEmailChangeRequestEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockEmailChangeRequestDelete) Where(predicates ...any) EmailChangeRequestDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致する変更の要求を削除し、削除件数を返します
+func (m *MockEmailChangeRequestDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.EmailChangeRequest
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.requests {
+		if match_mock_predicates(build_email_change_request_values(ent_request), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_request)
+	}
+	m.store.requests = remaining
+	return deleted, nil
+}
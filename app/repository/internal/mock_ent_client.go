@@ -3,17 +3,22 @@ package internal
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"sleeve/ent"
+	"sleeve/ent/user"
 
 	"github.com/google/uuid"
 )
 
 // MockEntClient はテスト用のモックEntクライアントです
+// mock_usersは一覧取得（All）と物理削除の対象です（Onlyと更新はmock_userを対象とします）
 type MockEntClient struct {
 	should_return_duplicate_error bool
 	mock_user                     *ent.User
+	mock_users                    []*ent.User
 }
 
 // NewMockEntClient は新しいMockEntClientを作成します
@@ -40,13 +45,15 @@ func NewMockEntClientWithUser(public_id uuid.UUID) *MockEntClient {
 	return &MockEntClient{
 		should_return_duplicate_error: false,
 		mock_user: &ent.User{
-			ID:          1,
-			PublicID:    public_id,
-			FirebaseUID: "firebase_uid_123",
-			Email:       "test@example.com",
-			CreatedAt:   now,
-			UpdatedAt:   now,
-			DeletedAt:   nil,
+			ID:                1,
+			PublicID:          public_id,
+			FirebaseUID:       "firebase_uid_123",
+			Email:             "test@example.com",
+			Role:              user.RoleUser,
+			CommentPermission: user.DefaultCommentPermission,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+			DeletedAt:         nil,
 		},
 	}
 }
@@ -63,6 +70,7 @@ func NewMockEntClientWithUserByFirebaseUID(firebase_uid string) *MockEntClient {
 			PublicID:    uuid.New(),
 			FirebaseUID: firebase_uid,
 			Email:       "test@example.com",
+			Role:        user.RoleUser,
 			CreatedAt:   now,
 			UpdatedAt:   now,
 			DeletedAt:   nil,
@@ -82,6 +90,7 @@ func NewMockEntClientWithUserByEmail(email string) *MockEntClient {
 			PublicID:    uuid.New(),
 			FirebaseUID: "firebase_uid_123",
 			Email:       email,
+			Role:        user.RoleUser,
 			CreatedAt:   now,
 			UpdatedAt:   now,
 			DeletedAt:   nil,
@@ -89,18 +98,55 @@ func NewMockEntClientWithUserByEmail(email string) *MockEntClient {
 	}
 }
 
+// NewMockEntClientWithUsers は一覧取得と物理削除の対象となる複数のユーザーを持つモッククライアントを作成します
+func NewMockEntClientWithUsers(users ...*ent.User) *MockEntClient {
+	return &MockEntClient{
+		should_return_duplicate_error: false,
+		mock_user:                     nil,
+		mock_users:                    users,
+	}
+}
+
 // GetUserClient はモックのUserClientを返します
 func (m *MockEntClient) GetUserClient() UserClientInterface {
 	return &MockUserClient{
 		should_return_duplicate_error: m.should_return_duplicate_error,
 		mock_user:                     m.mock_user,
+		store:                         m,
+	}
+}
+
+// Users は物理削除されていないユーザーを返します
+func (m *MockEntClient) Users() []*ent.User {
+	return m.mock_users
+}
+
+// build_user_values はWhere判定用にUserのフィールド値を返します
+func build_user_values(ent_user *ent.User) map[string]any {
+	return map[string]any{
+		"public_id":    ent_user.PublicID,
+		"firebase_uid": ent_user.FirebaseUID,
+		"email":        ent_user.Email,
+		"deleted_at":   build_nullable_time_value(ent_user.DeletedAt),
+		// deletion_token_hashは未設定の場合は型なしnilにします（IS NULL判定用）
+		"deletion_token_hash": build_nullable_string_value(ent_user.DeletionTokenHash),
+	}
+}
+
+// build_nullable_string_value はnilの*stringを型なしnilに変換します（IS NULL判定用）
+func build_nullable_string_value(value *string) any {
+	if value == nil {
+		return nil
 	}
+	return *value
 }
 
 // MockUserClient はモックのUserClientです
+// storeがnilの場合、一覧取得は空の結果を返し、物理削除は何も削除しません
 type MockUserClient struct {
 	should_return_duplicate_error bool
 	mock_user                     *ent.User
+	store                         *MockEntClient
 }
 
 // Create はモックのUserCreate Builderを返します
@@ -114,6 +160,22 @@ func (m *MockUserClient) Create() UserCreateInterface {
 func (m *MockUserClient) Query() UserQueryInterface {
 	return &MockUserQuery{
 		mock_user: m.mock_user,
+		store:     m.store,
+	}
+}
+
+// Update はモックのUserUpdate Builderを返します
+func (m *MockUserClient) Update() UserUpdateInterface {
+	return &MockUserUpdate{
+		should_return_duplicate_error: m.should_return_duplicate_error,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// Delete はモックのUserDelete Builderを返します
+func (m *MockUserClient) Delete() UserDeleteInterface {
+	return &MockUserDelete{
+		store: m.store,
 	}
 }
 
@@ -123,6 +185,7 @@ type MockUserCreate struct {
 	public_id                     uuid.UUID
 	firebase_uid                  string
 	email                         string
+	email_verified_at             *time.Time
 }
 
 // SetPublicID は公開IDを設定します
@@ -143,6 +206,12 @@ func (m *MockUserCreate) SetEmail(email string) UserCreateInterface {
 	return m
 }
 
+// SetNillableEmailVerifiedAt はメールアドレスの確認日時を設定します
+func (m *MockUserCreate) SetNillableEmailVerifiedAt(email_verified_at *time.Time) UserCreateInterface {
+	m.email_verified_at = email_verified_at
+	return m
+}
+
 // Save はユーザーを保存します
 func (m *MockUserCreate) Save(ctx context.Context) (*ent.User, error) {
 	var now time.Time
@@ -152,29 +221,107 @@ func (m *MockUserCreate) Save(ctx context.Context) (*ent.User, error) {
 	}
 	now = time.Now()
 	return &ent.User{
-		ID:          1,
-		PublicID:    m.public_id,
-		FirebaseUID: m.firebase_uid,
-		Email:       m.email,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		DeletedAt:   nil,
+		ID:              1,
+		PublicID:        m.public_id,
+		FirebaseUID:     m.firebase_uid,
+		Email:           m.email,
+		Role:            user.RoleUser,
+		EmailVerifiedAt: m.email_verified_at,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		DeletedAt:       nil,
 	}, nil
 }
 
 // MockUserQuery はモックのUserQuery Builderです
+// 条件はAll・Onlyで評価します（Onlyはmock_user、Allはmock_usersを対象とし、Existは条件に関わらずmock_userを対象とします）
 type MockUserQuery struct {
-	mock_user *ent.User
+	mock_user          *ent.User
+	store              *MockEntClient
+	predicates         []any
+	firebase_uids      []string
+	after_firebase_uid *string
+	deleted_before     *time.Time
+	limit              int
 }
 
 // Where は条件を追加します
 func (m *MockUserQuery) Where(predicates ...any) UserQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
 	return m
 }
 
-// Only は単一のユーザーを返します
+// WhereVisibleTo は何もしません（MockEntClientはブロックを持たないため、すべてのユーザーが見えるものとします）
+func (m *MockUserQuery) WhereVisibleTo(_ int) UserQueryInterface {
+	return m
+}
+
+// WhereContentVisibleTo は何もしません（MockEntClientはフォロー関係を持たないため、すべてのユーザーのコンテンツを閲覧できるものとします）
+func (m *MockUserQuery) WhereContentVisibleTo(_ int) UserQueryInterface {
+	return m
+}
+
+// WhereFirebaseUIDIn はFirebase UIDが指定した値のいずれかに一致するユーザーに絞り込みます
+func (m *MockUserQuery) WhereFirebaseUIDIn(firebase_uids ...string) UserQueryInterface {
+	m.firebase_uids = firebase_uids
+	return m
+}
+
+// AfterFirebaseUID はFirebase UIDが指定した値より大きいユーザーに絞り込み、Firebase UIDの昇順に並べます
+func (m *MockUserQuery) AfterFirebaseUID(firebase_uid string) UserQueryInterface {
+	m.after_firebase_uid = &firebase_uid
+	return m
+}
+
+// WhereDeletedBefore は指定した日時より前に論理削除されたユーザーに絞り込みます
+func (m *MockUserQuery) WhereDeletedBefore(deleted_before time.Time) UserQueryInterface {
+	m.deleted_before = &deleted_before
+	return m
+}
+
+// Limit は取得件数の上限を設定します
+func (m *MockUserQuery) Limit(limit int) UserQueryInterface {
+	m.limit = limit
+	return m
+}
+
+// All は条件に一致する全てのユーザーを返します
+func (m *MockUserQuery) All(ctx context.Context) ([]*ent.User, error) {
+	var users []*ent.User
+
+	users = []*ent.User{}
+	if m.store == nil {
+		return users, nil
+	}
+	for _, ent_user := range m.store.mock_users {
+		if !match_mock_predicates(build_user_values(ent_user), m.predicates) {
+			continue
+		}
+		if m.firebase_uids != nil && !slices.Contains(m.firebase_uids, ent_user.FirebaseUID) {
+			continue
+		}
+		if m.after_firebase_uid != nil && ent_user.FirebaseUID <= *m.after_firebase_uid {
+			continue
+		}
+		if m.deleted_before != nil && (ent_user.DeletedAt == nil || !ent_user.DeletedAt.Before(*m.deleted_before)) {
+			continue
+		}
+		users = append(users, ent_user)
+	}
+	if m.after_firebase_uid != nil {
+		slices.SortFunc(users, func(a *ent.User, b *ent.User) int {
+			return strings.Compare(a.FirebaseUID, b.FirebaseUID)
+		})
+	}
+	if m.limit > 0 && len(users) > m.limit {
+		users = users[:m.limit]
+	}
+	return users, nil
+}
+
+// Only は条件に一致する場合にmock_userを返します
 func (m *MockUserQuery) Only(ctx context.Context) (*ent.User, error) {
-	if m.mock_user == nil {
+	if m.mock_user == nil || !match_mock_predicates(build_user_values(m.mock_user), m.predicates) {
 		return nil, fmt.Errorf("user not found")
 	}
 	return m.mock_user, nil
@@ -184,3 +331,218 @@ func (m *MockUserQuery) Only(ctx context.Context) (*ent.User, error) {
 func (m *MockUserQuery) Exist(ctx context.Context) (bool, error) {
 	return m.mock_user != nil, nil
 }
+
+// MockUserUpdate はモックのUserUpdate Builderです
+// 条件にemail_verified_at・deleted_atのIS NULLが含まれる場合は、未確認・削除されていないユーザーのみを更新します
+type MockUserUpdate struct {
+	should_return_duplicate_error bool
+	mock_user                     *ent.User
+	only_unverified               bool
+	only_active                   bool
+	email                         *string
+	email_verified_at             *time.Time
+	clears_email_verified         bool
+	mfa_enabled_at                *time.Time
+	clears_mfa_enabled            bool
+	follower_delta                int
+	following_delta               int
+	deleted_at                    *time.Time
+	clears_deleted_at             bool
+	deletion_token_hash           *string
+	clears_deletion_token_hash    bool
+	is_private                    *bool
+	hide_closet                   *bool
+	hide_likes                    *bool
+	comment_permission            *user.CommentPermission
+}
+
+// Where は条件を追加します
+func (m *MockUserUpdate) Where(predicates ...any) UserUpdateInterface {
+	for i := 0; i+1 < len(predicates); i += 2 {
+		if predicates[i] == "email_verified_at" && predicates[i+1] == nil {
+			m.only_unverified = true
+		}
+		if predicates[i] == "deleted_at" && predicates[i+1] == nil {
+			m.only_active = true
+		}
+	}
+	return m
+}
+
+// SetEmail はメールアドレスを設定します
+func (m *MockUserUpdate) SetEmail(email string) UserUpdateInterface {
+	m.email = &email
+	return m
+}
+
+// SetEmailVerifiedAt はメールアドレスの確認日時を設定します
+func (m *MockUserUpdate) SetEmailVerifiedAt(email_verified_at time.Time) UserUpdateInterface {
+	m.email_verified_at = &email_verified_at
+	return m
+}
+
+// ClearEmailVerifiedAt はメールアドレスの確認日時をNULLにします
+func (m *MockUserUpdate) ClearEmailVerifiedAt() UserUpdateInterface {
+	m.clears_email_verified = true
+	return m
+}
+
+// SetMfaEnabledAt は二要素認証を有効にした日時を設定します
+func (m *MockUserUpdate) SetMfaEnabledAt(mfa_enabled_at time.Time) UserUpdateInterface {
+	m.mfa_enabled_at = &mfa_enabled_at
+	return m
+}
+
+// ClearMfaEnabledAt は二要素認証を有効にした日時をNULLにします
+func (m *MockUserUpdate) ClearMfaEnabledAt() UserUpdateInterface {
+	m.clears_mfa_enabled = true
+	return m
+}
+
+// AddFollowerCount はフォロワー数に加算します
+func (m *MockUserUpdate) AddFollowerCount(delta int) UserUpdateInterface {
+	m.follower_delta += delta
+	return m
+}
+
+// AddFollowingCount はフォロー数に加算します
+func (m *MockUserUpdate) AddFollowingCount(delta int) UserUpdateInterface {
+	m.following_delta += delta
+	return m
+}
+
+// SetDeletedAt は論理削除した日時を設定します
+func (m *MockUserUpdate) SetDeletedAt(deleted_at time.Time) UserUpdateInterface {
+	m.deleted_at = &deleted_at
+	return m
+}
+
+// ClearDeletedAt は論理削除した日時をNULLにします
+func (m *MockUserUpdate) ClearDeletedAt() UserUpdateInterface {
+	m.clears_deleted_at = true
+	return m
+}
+
+// SetDeletionTokenHash はアカウント削除の取り消しコードのハッシュを設定します
+func (m *MockUserUpdate) SetDeletionTokenHash(deletion_token_hash string) UserUpdateInterface {
+	m.deletion_token_hash = &deletion_token_hash
+	return m
+}
+
+// ClearDeletionTokenHash はアカウント削除の取り消しコードのハッシュをNULLにします
+func (m *MockUserUpdate) ClearDeletionTokenHash() UserUpdateInterface {
+	m.clears_deletion_token_hash = true
+	return m
+}
+
+// SetIsPrivate は非公開アカウントかを設定します
+func (m *MockUserUpdate) SetIsPrivate(is_private bool) UserUpdateInterface {
+	m.is_private = &is_private
+	return m
+}
+
+// SetHideCloset はクローゼットを非表示にするかを設定します
+func (m *MockUserUpdate) SetHideCloset(hide_closet bool) UserUpdateInterface {
+	m.hide_closet = &hide_closet
+	return m
+}
+
+// SetHideLikes はいいねした投稿の一覧を非表示にするかを設定します
+func (m *MockUserUpdate) SetHideLikes(hide_likes bool) UserUpdateInterface {
+	m.hide_likes = &hide_likes
+	return m
+}
+
+// SetCommentPermission は投稿にコメントできるユーザーの範囲を設定します
+func (m *MockUserUpdate) SetCommentPermission(comment_permission user.CommentPermission) UserUpdateInterface {
+	m.comment_permission = &comment_permission
+	return m
+}
+
+// Save はユーザーを更新し、更新件数を返します
+func (m *MockUserUpdate) Save(ctx context.Context) (int, error) {
+	if m.should_return_duplicate_error && m.email != nil {
+		return 0, fmt.Errorf("UNIQUE constraint failed")
+	}
+	if m.mock_user == nil {
+		return 0, nil
+	}
+	if (m.only_unverified && m.mock_user.EmailVerifiedAt != nil) || (m.only_active && m.mock_user.DeletedAt != nil) {
+		return 0, nil
+	}
+	if m.email != nil {
+		m.mock_user.Email = *m.email
+	}
+	if m.email_verified_at != nil {
+		m.mock_user.EmailVerifiedAt = m.email_verified_at
+	}
+	if m.clears_email_verified {
+		m.mock_user.EmailVerifiedAt = nil
+	}
+	if m.mfa_enabled_at != nil {
+		m.mock_user.MfaEnabledAt = m.mfa_enabled_at
+	}
+	if m.clears_mfa_enabled {
+		m.mock_user.MfaEnabledAt = nil
+	}
+	if m.deleted_at != nil {
+		m.mock_user.DeletedAt = m.deleted_at
+	}
+	if m.clears_deleted_at {
+		m.mock_user.DeletedAt = nil
+	}
+	if m.deletion_token_hash != nil {
+		m.mock_user.DeletionTokenHash = m.deletion_token_hash
+	}
+	if m.clears_deletion_token_hash {
+		m.mock_user.DeletionTokenHash = nil
+	}
+	if m.is_private != nil {
+		m.mock_user.IsPrivate = *m.is_private
+	}
+	if m.hide_closet != nil {
+		m.mock_user.HideCloset = *m.hide_closet
+	}
+	if m.hide_likes != nil {
+		m.mock_user.HideLikes = *m.hide_likes
+	}
+	if m.comment_permission != nil {
+		m.mock_user.CommentPermission = *m.comment_permission
+	}
+	m.mock_user.FollowerCount += m.follower_delta
+	m.mock_user.FollowingCount += m.following_delta
+	return 1, nil
+}
+
+// MockUserDelete はモックのUserDelete Builderです
+type MockUserDelete struct {
+	store      *MockEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserDelete) Where(predicates ...any) UserDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するユーザーを物理削除し、削除件数を返します
+func (m *MockUserDelete) Exec(ctx context.Context) (int, error) {
+	var remaining []*ent.User
+	var deleted_count int
+
+	if m.store == nil {
+		return 0, nil
+	}
+	remaining = []*ent.User{}
+	deleted_count = 0
+	for _, ent_user := range m.store.mock_users {
+		if match_mock_predicates(build_user_values(ent_user), m.predicates) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, ent_user)
+	}
+	m.store.mock_users = remaining
+	return deleted_count, nil
+}
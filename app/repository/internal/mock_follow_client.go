@@ -0,0 +1,399 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// MockFollowEntClient はテスト用のインメモリなフォロー関係のEntクライアントです
+// ユーザーはAddUserで追加し、フォロワー数・フォロー数はusersの各ユーザーに加算します
+// 一覧のブロック・ミュートのフィルターの確認用にAddBlock・AddMuteでブロック・ミュートを追加できます
+type MockFollowEntClient struct {
+	users                        []*ent.User
+	follows                      []*ent.Follow
+	follow_requests              []*ent.FollowRequest
+	blocks                       []*ent.UserBlock
+	mutes                        []*ent.UserMute
+	should_return_database_error bool
+}
+
+// NewMockFollowEntClient はユーザーのいないモッククライアントを作成します
+func NewMockFollowEntClient() *MockFollowEntClient {
+	return &MockFollowEntClient{
+		users:                        []*ent.User{},
+		follows:                      []*ent.Follow{},
+		follow_requests:              []*ent.FollowRequest{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockFollowEntClientWithDatabaseError はフォロー関係の操作でDBエラーを返すモッククライアントを作成します
+func NewMockFollowEntClientWithDatabaseError() *MockFollowEntClient {
+	var client *MockFollowEntClient
+
+	client = NewMockFollowEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します（削除・利用停止の状態は返り値を変更して設定します）
+func (m *MockFollowEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// AddBlock はblockerがblockedをブロックしていることを追加します
+func (m *MockFollowEntClient) AddBlock(blocker *ent.User, blocked *ent.User) {
+	m.blocks = append(m.blocks, &ent.UserBlock{
+		ID:        len(m.blocks) + 1,
+		BlockerID: blocker.ID,
+		BlockedID: blocked.ID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// AddMute はmuterがmutedをミュートしていることを追加します
+func (m *MockFollowEntClient) AddMute(muter *ent.User, muted *ent.User) {
+	m.mutes = append(m.mutes, &ent.UserMute{
+		ID:        len(m.mutes) + 1,
+		MuterID:   muter.ID,
+		MutedID:   muted.ID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// Follows は保存されたフォロー関係を返します
+func (m *MockFollowEntClient) Follows() []*ent.Follow {
+	return m.follows
+}
+
+// FollowRequests は保存されたフォローリクエストを返します
+func (m *MockFollowEntClient) FollowRequests() []*ent.FollowRequest {
+	return m.follow_requests
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockFollowEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users, visible_to: m.is_visible_to}
+}
+
+// GetFollowClient はモックのFollowClientを返します
+func (m *MockFollowEntClient) GetFollowClient() FollowClientInterface {
+	return &MockFollowClient{store: m}
+}
+
+// GetFollowRequestClient はモックのFollowRequestClientを返します
+func (m *MockFollowEntClient) GetFollowRequestClient() FollowRequestClientInterface {
+	return &MockFollowRequestClient{store: m}
+}
+
+// WithFollowTx はfnをそのまま実行します（モックはロールバックしません）
+func (m *MockFollowEntClient) WithFollowTx(_ context.Context, fn func(client FollowEntClientInterface) error) error {
+	return fn(m)
+}
+
+// find_user は内部IDでユーザーを検索します
+func (m *MockFollowEntClient) find_user(id int) *ent.User {
+	for _, ent_user := range m.users {
+		if ent_user.ID == id {
+			return ent_user
+		}
+	}
+	return nil
+}
+
+// is_visible_to はuser_visible_toと同じ条件でユーザーが閲覧するユーザーから見えるかを返します
+func (m *MockFollowEntClient) is_visible_to(user_id int, viewer_id int, visibility user_visibility) bool {
+	for _, ent_block := range m.blocks {
+		if (ent_block.BlockerID == user_id && ent_block.BlockedID == viewer_id) ||
+			(ent_block.BlockerID == viewer_id && ent_block.BlockedID == user_id) {
+			return false
+		}
+	}
+	switch visibility {
+	case visibilityFeed:
+		for _, ent_mute := range m.mutes {
+			if ent_mute.MuterID == viewer_id && ent_mute.MutedID == user_id {
+				return false
+			}
+		}
+	case visibilityContent:
+		var owner *ent.User
+
+		owner = m.find_user(user_id)
+		if owner != nil && owner.IsPrivate && user_id != viewer_id && !slices.ContainsFunc(m.follows, func(ent_follow *ent.Follow) bool {
+			return ent_follow.FollowerID == viewer_id && ent_follow.FolloweeID == user_id
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// build_follow_values はWhere判定用にFollowのフィールド値を返します
+func build_follow_values(ent_follow *ent.Follow) map[string]any {
+	return map[string]any{
+		"follower_id": ent_follow.FollowerID,
+		"followee_id": ent_follow.FolloweeID,
+	}
+}
+
+// is_active_mock_user は削除済み・利用停止中でないユーザーかを返します
+func is_active_mock_user(ent_user *ent.User) bool {
+	return ent_user != nil && ent_user.DeletedAt == nil && ent_user.BannedAt == nil
+}
+
+// MockFollowClient はモックのFollowClientです
+type MockFollowClient struct {
+	store *MockFollowEntClient
+}
+
+// Create はモックのFollowCreate Builderを返します
+func (m *MockFollowClient) Create() FollowCreateInterface {
+	return &MockFollowCreate{store: m.store, follow: &ent.Follow{}}
+}
+
+// Query はモックのFollowQuery Builderを返します
+func (m *MockFollowClient) Query() FollowQueryInterface {
+	return &MockFollowQuery{store: m.store}
+}
+
+// Delete はモックのFollowDelete Builderを返します
+func (m *MockFollowClient) Delete() FollowDeleteInterface {
+	return &MockFollowDelete{store: m.store}
+}
+
+// MockFollowCreate はモックのFollowCreate Builderです
+type MockFollowCreate struct {
+	store  *MockFollowEntClient
+	follow *ent.Follow
+}
+
+// SetFollowerID はフォローするユーザーのIDを設定します
+func (m *MockFollowCreate) SetFollowerID(follower_id int) FollowCreateInterface {
+	m.follow.FollowerID = follower_id
+	return m
+}
+
+// SetFolloweeID はフォローされるユーザーのIDを設定します
+func (m *MockFollowCreate) SetFolloweeID(followee_id int) FollowCreateInterface {
+	m.follow.FolloweeID = followee_id
+	return m
+}
+
+// SetCreatedAt はフォローした日時を設定します
+func (m *MockFollowCreate) SetCreatedAt(created_at time.Time) FollowCreateInterface {
+	m.follow.CreatedAt = created_at
+	return m
+}
+
+// Save はフォロー関係を保存します（(follower_id, followee_id)のユニーク制約を再現します）
+func (m *MockFollowCreate) Save(_ context.Context) (*ent.Follow, error) {
+	var next_id int
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_follow := range m.store.follows {
+		if ent_follow.FollowerID == m.follow.FollowerID && ent_follow.FolloweeID == m.follow.FolloweeID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+		next_id = max(next_id, ent_follow.ID)
+	}
+	m.follow.ID = next_id + 1
+	m.store.follows = append(m.store.follows, m.follow)
+	return m.follow, nil
+}
+
+// MockFollowQuery はモックのFollowQuery Builderです
+type MockFollowQuery struct {
+	store           *MockFollowEntClient
+	predicates      []any
+	id_less_than    int
+	follower_active bool
+	followee_active bool
+	// follower_visible_to・followee_visible_to はブロックの判定をする閲覧ユーザーの内部IDです（0の場合は判定しません）
+	follower_visible_to int
+	followee_visible_to int
+	// follower_content_viewer・followee_content_viewer はコンテンツの閲覧可否を判定する閲覧ユーザーの内部IDです（nilの場合は判定しません）
+	follower_content_viewer *int
+	followee_content_viewer *int
+	with_follower           bool
+	with_followee           bool
+	order_desc              bool
+	limit                   int
+}
+
+// Where は条件を追加します
+func (m *MockFollowQuery) Where(predicates ...any) FollowQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// IDLessThan はIDが指定した値より小さいフォロー関係に絞り込みます
+func (m *MockFollowQuery) IDLessThan(id int) FollowQueryInterface {
+	m.id_less_than = id
+	return m
+}
+
+// WhereFollowerActive はフォローするユーザーが削除済み・利用停止中でないフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFollowerActive() FollowQueryInterface {
+	m.follower_active = true
+	return m
+}
+
+// WhereFolloweeActive はフォローされるユーザーが削除済み・利用停止中でないフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFolloweeActive() FollowQueryInterface {
+	m.followee_active = true
+	return m
+}
+
+// WhereFollowerVisibleTo はフォローするユーザーが閲覧するユーザーのフィードに表示されるフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFollowerVisibleTo(viewer_id int) FollowQueryInterface {
+	m.follower_visible_to = viewer_id
+	return m
+}
+
+// WhereFolloweeVisibleTo はフォローされるユーザーが閲覧するユーザーのフィードに表示されるフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFolloweeVisibleTo(viewer_id int) FollowQueryInterface {
+	m.followee_visible_to = viewer_id
+	return m
+}
+
+// WhereFollowerContentVisibleTo はフォローするユーザーのコンテンツを閲覧するユーザーが閲覧できるフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFollowerContentVisibleTo(viewer_id int) FollowQueryInterface {
+	m.follower_content_viewer = &viewer_id
+	return m
+}
+
+// WhereFolloweeContentVisibleTo はフォローされるユーザーのコンテンツを閲覧するユーザーが閲覧できるフォロー関係に絞り込みます
+func (m *MockFollowQuery) WhereFolloweeContentVisibleTo(viewer_id int) FollowQueryInterface {
+	m.followee_content_viewer = &viewer_id
+	return m
+}
+
+// WithFollower はフォローするユーザーのEager Loadingを指定します
+func (m *MockFollowQuery) WithFollower() FollowQueryInterface {
+	m.with_follower = true
+	return m
+}
+
+// WithFollowee はフォローされるユーザーのEager Loadingを指定します
+func (m *MockFollowQuery) WithFollowee() FollowQueryInterface {
+	m.with_followee = true
+	return m
+}
+
+// OrderByIDDesc はIDの降順に並べます
+func (m *MockFollowQuery) OrderByIDDesc() FollowQueryInterface {
+	m.order_desc = true
+	return m
+}
+
+// Limit は取得件数の上限を設定します
+func (m *MockFollowQuery) Limit(limit int) FollowQueryInterface {
+	m.limit = limit
+	return m
+}
+
+// All は条件に一致するフォロー関係を返します
+func (m *MockFollowQuery) All(_ context.Context) ([]*ent.Follow, error) {
+	var ent_follows []*ent.Follow
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_follow := range m.store.follows {
+		var follower *ent.User
+		var followee *ent.User
+
+		if !match_mock_predicates(build_follow_values(ent_follow), m.predicates) {
+			continue
+		}
+		if m.id_less_than > 0 && ent_follow.ID >= m.id_less_than {
+			continue
+		}
+		follower = m.store.find_user(ent_follow.FollowerID)
+		followee = m.store.find_user(ent_follow.FolloweeID)
+		if (m.follower_active && !is_active_mock_user(follower)) || (m.followee_active && !is_active_mock_user(followee)) {
+			continue
+		}
+		if (m.follower_visible_to > 0 && !m.store.is_visible_to(ent_follow.FollowerID, m.follower_visible_to, visibilityFeed)) ||
+			(m.followee_visible_to > 0 && !m.store.is_visible_to(ent_follow.FolloweeID, m.followee_visible_to, visibilityFeed)) {
+			continue
+		}
+		if (m.follower_content_viewer != nil && !m.store.is_visible_to(ent_follow.FollowerID, *m.follower_content_viewer, visibilityContent)) ||
+			(m.followee_content_viewer != nil && !m.store.is_visible_to(ent_follow.FolloweeID, *m.followee_content_viewer, visibilityContent)) {
+			continue
+		}
+		ent_follow.Edges = ent.FollowEdges{}
+		if m.with_follower {
+			ent_follow.Edges.Follower = follower
+		}
+		if m.with_followee {
+			ent_follow.Edges.Followee = followee
+		}
+		ent_follows = append(ent_follows, ent_follow)
+	}
+	if m.order_desc {
+		slices.SortFunc(ent_follows, func(a *ent.Follow, b *ent.Follow) int {
+			return b.ID - a.ID
+		})
+	}
+	if m.limit > 0 && len(ent_follows) > m.limit {
+		ent_follows = ent_follows[:m.limit]
+	}
+	return ent_follows, nil
+}
+
+// Exist は条件に一致するフォロー関係が存在するかを返します
+func (m *MockFollowQuery) Exist(ctx context.Context) (bool, error) {
+	var ent_follows []*ent.Follow
+	var err error
+
+	ent_follows, err = m.All(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(ent_follows) > 0, nil
+}
+
+// MockFollowDelete はモックのFollowDelete Builderです
+type MockFollowDelete struct {
+	store      *MockFollowEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockFollowDelete) Where(predicates ...any) FollowDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するフォロー関係を削除し、削除件数を返します
+func (m *MockFollowDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.Follow
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_follow := range m.store.follows {
+		if match_mock_predicates(build_follow_values(ent_follow), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_follow)
+	}
+	m.store.follows = remaining
+	return deleted, nil
+}
@@ -0,0 +1,218 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+)
+
+// build_follow_request_values はWhere判定用にFollowRequestのフィールド値を返します
+func build_follow_request_values(ent_request *ent.FollowRequest) map[string]any {
+	return map[string]any{
+		"requester_id": ent_request.RequesterID,
+		"target_id":    ent_request.TargetID,
+	}
+}
+
+// MockFollowRequestClient はMockFollowEntClientのフォローリクエストを扱うモックのFollowRequestClientです
+type MockFollowRequestClient struct {
+	store *MockFollowEntClient
+}
+
+// Create はモックのFollowRequestCreate Builderを返します
+func (m *MockFollowRequestClient) Create() FollowRequestCreateInterface {
+	return &MockFollowRequestCreate{store: m.store, request: &ent.FollowRequest{}}
+}
+
+// Query はモックのFollowRequestQuery Builderを返します
+func (m *MockFollowRequestClient) Query() FollowRequestQueryInterface {
+	return &MockFollowRequestQuery{store: m.store}
+}
+
+// Delete はモックのFollowRequestDelete Builderを返します
+func (m *MockFollowRequestClient) Delete() FollowRequestDeleteInterface {
+	return &MockFollowRequestDelete{store: m.store}
+}
+
+// MockFollowRequestCreate はモックのFollowRequestCreate Builderです
+type MockFollowRequestCreate struct {
+	store   *MockFollowEntClient
+	request *ent.FollowRequest
+}
+
+// SetRequesterID はリクエストするユーザーのIDを設定します
+func (m *MockFollowRequestCreate) SetRequesterID(requester_id int) FollowRequestCreateInterface {
+	m.request.RequesterID = requester_id
+	return m
+}
+
+// SetTargetID はリクエストされるユーザーのIDを設定します
+func (m *MockFollowRequestCreate) SetTargetID(target_id int) FollowRequestCreateInterface {
+	m.request.TargetID = target_id
+	return m
+}
+
+// SetCreatedAt はリクエストした日時を設定します
+func (m *MockFollowRequestCreate) SetCreatedAt(created_at time.Time) FollowRequestCreateInterface {
+	m.request.CreatedAt = created_at
+	return m
+}
+
+// Save はフォローリクエストを保存します（(requester_id, target_id)のユニーク制約を再現します）
+func (m *MockFollowRequestCreate) Save(_ context.Context) (*ent.FollowRequest, error) {
+	var next_id int
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.follow_requests {
+		if ent_request.RequesterID == m.request.RequesterID && ent_request.TargetID == m.request.TargetID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+		next_id = max(next_id, ent_request.ID)
+	}
+	m.request.ID = next_id + 1
+	m.store.follow_requests = append(m.store.follow_requests, m.request)
+	return m.request, nil
+}
+
+// MockFollowRequestQuery はモックのFollowRequestQuery Builderです
+type MockFollowRequestQuery struct {
+	store            *MockFollowEntClient
+	predicates       []any
+	id_less_than     int
+	requester_active bool
+	visible_to       int
+	with_requester   bool
+	order_desc       bool
+	limit            int
+}
+
+// Where は条件を追加します
+func (m *MockFollowRequestQuery) Where(predicates ...any) FollowRequestQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// IDLessThan はIDが指定した値より小さいリクエストに絞り込みます
+func (m *MockFollowRequestQuery) IDLessThan(id int) FollowRequestQueryInterface {
+	m.id_less_than = id
+	return m
+}
+
+// WhereRequesterActive はリクエストしたユーザーが削除済み・利用停止中でないリクエストに絞り込みます
+func (m *MockFollowRequestQuery) WhereRequesterActive() FollowRequestQueryInterface {
+	m.requester_active = true
+	return m
+}
+
+// WhereRequesterVisibleTo はリクエストしたユーザーと指定したユーザーの間にどちらの方向にもブロックがないリクエストに絞り込みます
+func (m *MockFollowRequestQuery) WhereRequesterVisibleTo(viewer_id int) FollowRequestQueryInterface {
+	m.visible_to = viewer_id
+	return m
+}
+
+// WithRequester はリクエストしたユーザーのEager Loadingを指定します
+func (m *MockFollowRequestQuery) WithRequester() FollowRequestQueryInterface {
+	m.with_requester = true
+	return m
+}
+
+// OrderByIDDesc はIDの降順に並べます
+func (m *MockFollowRequestQuery) OrderByIDDesc() FollowRequestQueryInterface {
+	m.order_desc = true
+	return m
+}
+
+// Limit は取得件数の上限を設定します
+func (m *MockFollowRequestQuery) Limit(limit int) FollowRequestQueryInterface {
+	m.limit = limit
+	return m
+}
+
+// All は条件に一致するフォローリクエストを返します
+// Eager Loadingでは論理削除されたユーザーを読み込まない動作（SoftDeleteMixinのクエリのフィルター）を再現します
+func (m *MockFollowRequestQuery) All(_ context.Context) ([]*ent.FollowRequest, error) {
+	var ent_requests []*ent.FollowRequest
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.follow_requests {
+		var requester *ent.User
+
+		if !match_mock_predicates(build_follow_request_values(ent_request), m.predicates) {
+			continue
+		}
+		if m.id_less_than > 0 && ent_request.ID >= m.id_less_than {
+			continue
+		}
+		requester = m.store.find_user(ent_request.RequesterID)
+		if m.requester_active && !is_active_mock_user(requester) {
+			continue
+		}
+		if m.visible_to > 0 && !m.store.is_visible_to(ent_request.RequesterID, m.visible_to, visibilityDirect) {
+			continue
+		}
+		ent_request.Edges = ent.FollowRequestEdges{}
+		if m.with_requester && requester != nil && requester.DeletedAt == nil {
+			ent_request.Edges.Requester = requester
+		}
+		ent_requests = append(ent_requests, ent_request)
+	}
+	if m.order_desc {
+		slices.SortFunc(ent_requests, func(a *ent.FollowRequest, b *ent.FollowRequest) int {
+			return b.ID - a.ID
+		})
+	}
+	if m.limit > 0 && len(ent_requests) > m.limit {
+		ent_requests = ent_requests[:m.limit]
+	}
+	return ent_requests, nil
+}
+
+// Exist は条件に一致するフォローリクエストが存在するかを返します
+func (m *MockFollowRequestQuery) Exist(ctx context.Context) (bool, error) {
+	var ent_requests []*ent.FollowRequest
+	var err error
+
+	ent_requests, err = m.All(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(ent_requests) > 0, nil
+}
+
+// MockFollowRequestDelete はモックのFollowRequestDelete Builderです
+type MockFollowRequestDelete struct {
+	store      *MockFollowEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockFollowRequestDelete) Where(predicates ...any) FollowRequestDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するフォローリクエストを削除し、削除件数を返します
+func (m *MockFollowRequestDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.FollowRequest
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_request := range m.store.follow_requests {
+		if match_mock_predicates(build_follow_request_values(ent_request), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_request)
+	}
+	m.store.follow_requests = remaining
+	return deleted, nil
+}
@@ -0,0 +1,271 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/idempotencyrecord"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockIdempotencyRecordEntClient はテスト用のインメモリな冪等キーのEntクライアントです
+type MockIdempotencyRecordEntClient struct {
+	mock_user                    *ent.User
+	records                      []*ent.IdempotencyRecord
+	should_return_database_error bool
+}
+
+// NewMockIdempotencyRecordEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockIdempotencyRecordEntClient(public_id uuid.UUID) *MockIdempotencyRecordEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockIdempotencyRecordEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		records:                      []*ent.IdempotencyRecord{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockIdempotencyRecordEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockIdempotencyRecordEntClientWithDatabaseError(public_id uuid.UUID) *MockIdempotencyRecordEntClient {
+	var client *MockIdempotencyRecordEntClient
+
+	client = NewMockIdempotencyRecordEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockIdempotencyRecordEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetIdempotencyRecordClient はモックのIdempotencyRecordClientを返します
+func (m *MockIdempotencyRecordEntClient) GetIdempotencyRecordClient() IdempotencyRecordClientInterface {
+	return &MockIdempotencyRecordClient{store: m}
+}
+
+// Records は保存された冪等キーの記録を返します
+func (m *MockIdempotencyRecordEntClient) Records() []*ent.IdempotencyRecord {
+	return m.records
+}
+
+// build_idempotency_record_values はWhere判定用にIdempotencyRecordのフィールド値を返します
+func build_idempotency_record_values(ent_record *ent.IdempotencyRecord) map[string]any {
+	var user_id any
+
+	if ent_record.UserID != nil {
+		user_id = *ent_record.UserID
+	}
+	return map[string]any{
+		"operation": string(ent_record.Operation),
+		"key":       ent_record.Key,
+		"user_id":   user_id,
+	}
+}
+
+// MockIdempotencyRecordClient はモックのIdempotencyRecordClientです
+type MockIdempotencyRecordClient struct {
+	store *MockIdempotencyRecordEntClient
+}
+
+// Create はモックのIdempotencyRecordCreate Builderを返します
+func (m *MockIdempotencyRecordClient) Create() IdempotencyRecordCreateInterface {
+	return &MockIdempotencyRecordCreate{store: m.store, record: &ent.IdempotencyRecord{}}
+}
+
+// Query はモックのIdempotencyRecordQuery Builderを返します
+func (m *MockIdempotencyRecordClient) Query() IdempotencyRecordQueryInterface {
+	return &MockIdempotencyRecordQuery{store: m.store}
+}
+
+// Update はモックのIdempotencyRecordUpdate Builderを返します
+func (m *MockIdempotencyRecordClient) Update() IdempotencyRecordUpdateInterface {
+	return &MockIdempotencyRecordUpdate{store: m.store}
+}
+
+// Delete はモックのIdempotencyRecordDelete Builderを返します
+func (m *MockIdempotencyRecordClient) Delete() IdempotencyRecordDeleteInterface {
+	return &MockIdempotencyRecordDelete{store: m.store}
+}
+
+// MockIdempotencyRecordCreate はモックのIdempotencyRecordCreate Builderです
+type MockIdempotencyRecordCreate struct {
+	store  *MockIdempotencyRecordEntClient
+	record *ent.IdempotencyRecord
+}
+
+// SetOperation は操作を設定します
+func (m *MockIdempotencyRecordCreate) SetOperation(operation idempotencyrecord.Operation) IdempotencyRecordCreateInterface {
+	m.record.Operation = operation
+	return m
+}
+
+// SetKey は冪等キーを設定します
+func (m *MockIdempotencyRecordCreate) SetKey(key string) IdempotencyRecordCreateInterface {
+	m.record.Key = key
+	return m
+}
+
+// SetFingerprint はリクエストのフィンガープリントを設定します
+func (m *MockIdempotencyRecordCreate) SetFingerprint(fingerprint string) IdempotencyRecordCreateInterface {
+	m.record.Fingerprint = fingerprint
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockIdempotencyRecordCreate) SetCreatedAt(created_at time.Time) IdempotencyRecordCreateInterface {
+	m.record.CreatedAt = created_at
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockIdempotencyRecordCreate) SetExpiresAt(expires_at time.Time) IdempotencyRecordCreateInterface {
+	m.record.ExpiresAt = expires_at
+	return m
+}
+
+// Save は冪等キーの記録を保存します（操作と冪等キーが重複する場合はユニーク制約エラーを返します）
+func (m *MockIdempotencyRecordCreate) Save(_ context.Context) (*ent.IdempotencyRecord, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_record := range m.store.records {
+		if ent_record.Operation == m.record.Operation && ent_record.Key == m.record.Key {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.record.ID = len(m.store.records) + 1
+	m.store.records = append(m.store.records, m.record)
+	return m.record, nil
+}
+
+// MockIdempotencyRecordQuery はモックのIdempotencyRecordQuery Builderです
+type MockIdempotencyRecordQuery struct {
+	store      *MockIdempotencyRecordEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockIdempotencyRecordQuery) Where(predicates ...any) IdempotencyRecordQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockIdempotencyRecordQuery) WithUser() IdempotencyRecordQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一の冪等キーの記録を返します
+func (m *MockIdempotencyRecordQuery) Only(_ context.Context) (*ent.IdempotencyRecord, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_record := range m.store.records {
+		if !match_mock_predicates(build_idempotency_record_values(ent_record), m.predicates) {
+			continue
+		}
+		ent_record.Edges.User = nil
+		if ent_record.UserID != nil {
+			ent_record.Edges.User = m.store.mock_user
+		}
+		return ent_record, nil
+	}
+	return nil, fmt.Errorf("idempotency record not found")
+}
+
+// MockIdempotencyRecordUpdate はモックのIdempotencyRecordUpdate Builderです
+type MockIdempotencyRecordUpdate struct {
+	store      *MockIdempotencyRecordEntClient
+	predicates []any
+	user_id    *int
+}
+
+// Where は条件を追加します
+func (m *MockIdempotencyRecordUpdate) Where(predicates ...any) IdempotencyRecordUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetUserID は処理結果のユーザーIDを設定します
+func (m *MockIdempotencyRecordUpdate) SetUserID(user_id int) IdempotencyRecordUpdateInterface {
+	m.user_id = &user_id
+	return m
+}
+
+// Save は条件に一致する冪等キーの記録を更新し、更新件数を返します
+func (m *MockIdempotencyRecordUpdate) Save(_ context.Context) (int, error) {
+	var affected_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_record := range m.store.records {
+		if !match_mock_predicates(build_idempotency_record_values(ent_record), m.predicates) {
+			continue
+		}
+		if m.user_id != nil {
+			ent_record.UserID = m.user_id
+		}
+		affected_count++
+	}
+	return affected_count, nil
+}
+
+// MockIdempotencyRecordDelete はモックのIdempotencyRecordDelete Builderです
+type MockIdempotencyRecordDelete struct {
+	store      *MockIdempotencyRecordEntClient
+	predicates []any
+	expired_at *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockIdempotencyRecordDelete) Where(predicates ...any) IdempotencyRecordDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// ExpiredAt は有効期限が指定日時以前の記録に絞り込みます
+func (m *MockIdempotencyRecordDelete) ExpiredAt(now time.Time) IdempotencyRecordDeleteInterface {
+	m.expired_at = &now
+	return m
+}
+
+// Exec は条件に一致する冪等キーの記録を削除し、削除件数を返します
+func (m *MockIdempotencyRecordDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.IdempotencyRecord
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.IdempotencyRecord{}
+	for _, ent_record := range m.store.records {
+		if match_mock_predicates(build_idempotency_record_values(ent_record), m.predicates) &&
+			(m.expired_at == nil || !ent_record.ExpiresAt.After(*m.expired_at)) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, ent_record)
+	}
+	m.store.records = remaining
+	return deleted_count, nil
+}
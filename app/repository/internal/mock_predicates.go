@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"time"
+)
+
+// match_mock_predicates はWhere(フィールド名, 値, ...)形式の条件にレコードが一致するかを判定します
+// モッククライアント用のヘルパーで、値がnilの場合はIS NULLとして扱います
+func match_mock_predicates(values map[string]any, predicates []any) bool {
+	for i := 0; i+1 < len(predicates); i += 2 {
+		var field_name string
+		var is_string bool
+		var actual any
+		var exists bool
+
+		field_name, is_string = predicates[i].(string)
+		if !is_string {
+			return false
+		}
+		actual, exists = values[field_name]
+		if !exists {
+			return false
+		}
+		if actual != predicates[i+1] {
+			return false
+		}
+	}
+	return true
+}
+
+// build_nullable_time_value はnilの*time.Timeを型なしnilに変換します（IS NULL判定用）
+func build_nullable_time_value(value *time.Time) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,285 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockProfileEntClient はテスト用のインメモリなプロフィールEntクライアントです
+type MockProfileEntClient struct {
+	mock_user                    *ent.User
+	profiles                     []*ent.Profile
+	should_return_database_error bool
+}
+
+// NewMockProfileEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockProfileEntClient(public_id uuid.UUID) *MockProfileEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockProfileEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		profiles:                     []*ent.Profile{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockProfileEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockProfileEntClientWithDatabaseError(public_id uuid.UUID) *MockProfileEntClient {
+	var client *MockProfileEntClient
+
+	client = NewMockProfileEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockProfileEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetProfileClient はモックのProfileClientを返します
+func (m *MockProfileEntClient) GetProfileClient() ProfileClientInterface {
+	return &MockProfileClient{store: m}
+}
+
+// Profiles は保存されたプロフィールを返します
+func (m *MockProfileEntClient) Profiles() []*ent.Profile {
+	return m.profiles
+}
+
+// build_profile_values はWhere判定用にProfileのフィールド値を返します
+func build_profile_values(profile *ent.Profile) map[string]any {
+	return map[string]any{
+		"user_id": profile.UserID,
+	}
+}
+
+// MockProfileClient はモックのProfileClientです
+type MockProfileClient struct {
+	store *MockProfileEntClient
+}
+
+// Create はモックのProfileCreate Builderを返します
+func (m *MockProfileClient) Create() ProfileCreateInterface {
+	return &MockProfileCreate{store: m.store, profile: &ent.Profile{}}
+}
+
+// Query はモックのProfileQuery Builderを返します
+func (m *MockProfileClient) Query() ProfileQueryInterface {
+	return &MockProfileQuery{store: m.store}
+}
+
+// Update はモックのProfileUpdate Builderを返します
+func (m *MockProfileClient) Update() ProfileUpdateInterface {
+	return &MockProfileUpdate{store: m.store, changes: &ent.Profile{}}
+}
+
+// MockProfileCreate はモックのProfileCreate Builderです
+type MockProfileCreate struct {
+	store   *MockProfileEntClient
+	profile *ent.Profile
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockProfileCreate) SetUserID(user_id int) ProfileCreateInterface {
+	m.profile.UserID = user_id
+	return m
+}
+
+// SetDisplayName は表示名を設定します
+func (m *MockProfileCreate) SetDisplayName(display_name string) ProfileCreateInterface {
+	m.profile.DisplayName = display_name
+	return m
+}
+
+// SetBio は自己紹介文を設定します
+func (m *MockProfileCreate) SetBio(bio string) ProfileCreateInterface {
+	m.profile.Bio = bio
+	return m
+}
+
+// SetNillableAvatarURL はアバター画像のURLを設定します
+func (m *MockProfileCreate) SetNillableAvatarURL(avatar_url *string) ProfileCreateInterface {
+	m.profile.AvatarURL = avatar_url
+	return m
+}
+
+// SetNillableHeightCm は身長を設定します
+func (m *MockProfileCreate) SetNillableHeightCm(height_cm *int) ProfileCreateInterface {
+	m.profile.HeightCm = height_cm
+	return m
+}
+
+// SetFavoriteStyles は好きなスタイルを設定します
+func (m *MockProfileCreate) SetFavoriteStyles(favorite_styles []string) ProfileCreateInterface {
+	m.profile.FavoriteStyles = slices.Clone(favorite_styles)
+	return m
+}
+
+// SetLinks はリンクを設定します
+func (m *MockProfileCreate) SetLinks(links []string) ProfileCreateInterface {
+	m.profile.Links = slices.Clone(links)
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockProfileCreate) SetCreatedAt(created_at time.Time) ProfileCreateInterface {
+	m.profile.CreatedAt = created_at
+	return m
+}
+
+// SetUpdatedAt は更新日時を設定します
+func (m *MockProfileCreate) SetUpdatedAt(updated_at time.Time) ProfileCreateInterface {
+	m.profile.UpdatedAt = updated_at
+	return m
+}
+
+// Save はプロフィールを保存します（user_idのユニーク制約を再現します）
+func (m *MockProfileCreate) Save(_ context.Context) (*ent.Profile, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, profile := range m.store.profiles {
+		if profile.UserID == m.profile.UserID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.profile.ID = len(m.store.profiles) + 1
+	m.store.profiles = append(m.store.profiles, m.profile)
+	return m.profile, nil
+}
+
+// MockProfileQuery はモックのProfileQuery Builderです
+type MockProfileQuery struct {
+	store      *MockProfileEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockProfileQuery) Where(predicates ...any) ProfileQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Only は条件に一致する単一のプロフィールを返します
+func (m *MockProfileQuery) Only(_ context.Context) (*ent.Profile, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, profile := range m.store.profiles {
+		if match_mock_predicates(build_profile_values(profile), m.predicates) {
+			return profile, nil
+		}
+	}
+	return nil, fmt.Errorf("profile not found")
+}
+
+// MockProfileUpdate はモックのProfileUpdate Builderです
+type MockProfileUpdate struct {
+	store      *MockProfileEntClient
+	predicates []any
+	changes    *ent.Profile
+}
+
+// Where は条件を追加します
+func (m *MockProfileUpdate) Where(predicates ...any) ProfileUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetDisplayName は表示名を設定します
+func (m *MockProfileUpdate) SetDisplayName(display_name string) ProfileUpdateInterface {
+	m.changes.DisplayName = display_name
+	return m
+}
+
+// SetBio は自己紹介文を設定します
+func (m *MockProfileUpdate) SetBio(bio string) ProfileUpdateInterface {
+	m.changes.Bio = bio
+	return m
+}
+
+// SetAvatarURL はアバター画像のURLを設定します
+func (m *MockProfileUpdate) SetAvatarURL(avatar_url string) ProfileUpdateInterface {
+	m.changes.AvatarURL = &avatar_url
+	return m
+}
+
+// ClearAvatarURL はアバター画像のURLをNULLにします
+func (m *MockProfileUpdate) ClearAvatarURL() ProfileUpdateInterface {
+	m.changes.AvatarURL = nil
+	return m
+}
+
+// SetHeightCm は身長を設定します
+func (m *MockProfileUpdate) SetHeightCm(height_cm int) ProfileUpdateInterface {
+	m.changes.HeightCm = &height_cm
+	return m
+}
+
+// ClearHeightCm は身長をNULLにします
+func (m *MockProfileUpdate) ClearHeightCm() ProfileUpdateInterface {
+	m.changes.HeightCm = nil
+	return m
+}
+
+// SetFavoriteStyles は好きなスタイルを設定します
+func (m *MockProfileUpdate) SetFavoriteStyles(favorite_styles []string) ProfileUpdateInterface {
+	m.changes.FavoriteStyles = slices.Clone(favorite_styles)
+	return m
+}
+
+// SetLinks はリンクを設定します
+func (m *MockProfileUpdate) SetLinks(links []string) ProfileUpdateInterface {
+	m.changes.Links = slices.Clone(links)
+	return m
+}
+
+// SetUpdatedAt は更新日時を設定します
+func (m *MockProfileUpdate) SetUpdatedAt(updated_at time.Time) ProfileUpdateInterface {
+	m.changes.UpdatedAt = updated_at
+	return m
+}
+
+// Save は条件に一致するプロフィールを更新し、更新件数を返します
+func (m *MockProfileUpdate) Save(_ context.Context) (int, error) {
+	var updated_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	updated_count = 0
+	for _, profile := range m.store.profiles {
+		if match_mock_predicates(build_profile_values(profile), m.predicates) {
+			profile.DisplayName = m.changes.DisplayName
+			profile.Bio = m.changes.Bio
+			profile.AvatarURL = m.changes.AvatarURL
+			profile.HeightCm = m.changes.HeightCm
+			profile.FavoriteStyles = m.changes.FavoriteStyles
+			profile.Links = m.changes.Links
+			profile.UpdatedAt = m.changes.UpdatedAt
+			updated_count++
+		}
+	}
+	return updated_count, nil
+}
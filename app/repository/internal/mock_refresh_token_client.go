@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockRefreshTokenEntClient はテスト用のインメモリなリフレッシュトークンEntクライアントです
+type MockRefreshTokenEntClient struct {
+	mock_user                    *ent.User
+	tokens                       []*ent.RefreshToken
+	should_return_database_error bool
+}
+
+// NewMockRefreshTokenEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockRefreshTokenEntClient(public_id uuid.UUID) *MockRefreshTokenEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockRefreshTokenEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		tokens:                       []*ent.RefreshToken{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockRefreshTokenEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockRefreshTokenEntClientWithDatabaseError(public_id uuid.UUID) *MockRefreshTokenEntClient {
+	var client *MockRefreshTokenEntClient
+
+	client = NewMockRefreshTokenEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockRefreshTokenEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetRefreshTokenClient はモックのRefreshTokenClientを返します
+func (m *MockRefreshTokenEntClient) GetRefreshTokenClient() RefreshTokenClientInterface {
+	return &MockRefreshTokenClient{store: m}
+}
+
+// Tokens は保存されたリフレッシュトークンを返します
+func (m *MockRefreshTokenEntClient) Tokens() []*ent.RefreshToken {
+	return m.tokens
+}
+
+// build_refresh_token_values はWhere判定用にRefreshTokenのフィールド値を返します
+func build_refresh_token_values(token *ent.RefreshToken) map[string]any {
+	return map[string]any{
+		"token_id":   token.TokenID,
+		"family_id":  token.FamilyID,
+		"user_id":    token.UserID,
+		"rotated_at": build_nullable_time_value(token.RotatedAt),
+		"revoked_at": build_nullable_time_value(token.RevokedAt),
+	}
+}
+
+// MockRefreshTokenClient はモックのRefreshTokenClientです
+type MockRefreshTokenClient struct {
+	store *MockRefreshTokenEntClient
+}
+
+// Create はモックのRefreshTokenCreate Builderを返します
+func (m *MockRefreshTokenClient) Create() RefreshTokenCreateInterface {
+	return &MockRefreshTokenCreate{store: m.store, token: &ent.RefreshToken{}}
+}
+
+// Query はモックのRefreshTokenQuery Builderを返します
+func (m *MockRefreshTokenClient) Query() RefreshTokenQueryInterface {
+	return &MockRefreshTokenQuery{store: m.store}
+}
+
+// Update はモックのRefreshTokenUpdate Builderを返します
+func (m *MockRefreshTokenClient) Update() RefreshTokenUpdateInterface {
+	return &MockRefreshTokenUpdate{store: m.store}
+}
+
+// MockRefreshTokenCreate はモックのRefreshTokenCreate Builderです
+type MockRefreshTokenCreate struct {
+	store *MockRefreshTokenEntClient
+	token *ent.RefreshToken
+}
+
+// SetTokenID はjtiを設定します
+func (m *MockRefreshTokenCreate) SetTokenID(token_id string) RefreshTokenCreateInterface {
+	m.token.TokenID = token_id
+	return m
+}
+
+// SetFamilyID はトークンファミリーIDを設定します
+func (m *MockRefreshTokenCreate) SetFamilyID(family_id uuid.UUID) RefreshTokenCreateInterface {
+	m.token.FamilyID = family_id
+	return m
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockRefreshTokenCreate) SetUserID(user_id int) RefreshTokenCreateInterface {
+	m.token.UserID = user_id
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockRefreshTokenCreate) SetExpiresAt(expires_at time.Time) RefreshTokenCreateInterface {
+	m.token.ExpiresAt = expires_at
+	return m
+}
+
+// Save はリフレッシュトークンを保存します
+func (m *MockRefreshTokenCreate) Save(_ context.Context) (*ent.RefreshToken, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	m.token.ID = len(m.store.tokens) + 1
+	m.token.CreatedAt = time.Now()
+	m.token.Edges.User = m.store.mock_user
+	m.store.tokens = append(m.store.tokens, m.token)
+	return m.token, nil
+}
+
+// MockRefreshTokenQuery はモックのRefreshTokenQuery Builderです
+type MockRefreshTokenQuery struct {
+	store      *MockRefreshTokenEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockRefreshTokenQuery) Where(predicates ...any) RefreshTokenQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockRefreshTokenQuery) WithUser() RefreshTokenQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一のリフレッシュトークンを返します
+func (m *MockRefreshTokenQuery) Only(_ context.Context) (*ent.RefreshToken, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, token := range m.store.tokens {
+		if match_mock_predicates(build_refresh_token_values(token), m.predicates) {
+			return token, nil
+		}
+	}
+	return nil, fmt.Errorf("refresh_token not found")
+}
+
+// All は条件に一致する全てのリフレッシュトークンを返します
+func (m *MockRefreshTokenQuery) All(_ context.Context) ([]*ent.RefreshToken, error) {
+	var tokens []*ent.RefreshToken
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	tokens = []*ent.RefreshToken{}
+	for _, token := range m.store.tokens {
+		if match_mock_predicates(build_refresh_token_values(token), m.predicates) {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+// MockRefreshTokenUpdate はモックのRefreshTokenUpdate Builderです
+type MockRefreshTokenUpdate struct {
+	store      *MockRefreshTokenEntClient
+	predicates []any
+	rotated_at *time.Time
+	revoked_at *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockRefreshTokenUpdate) Where(predicates ...any) RefreshTokenUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetRotatedAt はローテーション日時を設定します
+func (m *MockRefreshTokenUpdate) SetRotatedAt(rotated_at time.Time) RefreshTokenUpdateInterface {
+	m.rotated_at = &rotated_at
+	return m
+}
+
+// SetRevokedAt は失効日時を設定します
+func (m *MockRefreshTokenUpdate) SetRevokedAt(revoked_at time.Time) RefreshTokenUpdateInterface {
+	m.revoked_at = &revoked_at
+	return m
+}
+
+// Save は条件に一致するリフレッシュトークンを更新し、更新件数を返します
+func (m *MockRefreshTokenUpdate) Save(_ context.Context) (int, error) {
+	var affected_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	affected_count = 0
+	for _, token := range m.store.tokens {
+		if !match_mock_predicates(build_refresh_token_values(token), m.predicates) {
+			continue
+		}
+		if m.rotated_at != nil {
+			token.RotatedAt = m.rotated_at
+		}
+		if m.revoked_at != nil {
+			token.RevokedAt = m.revoked_at
+		}
+		affected_count++
+	}
+	return affected_count, nil
+}
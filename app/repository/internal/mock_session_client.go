@@ -0,0 +1,291 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/session"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockSessionEntClient はテスト用のインメモリなセッションEntクライアントです
+type MockSessionEntClient struct {
+	mock_user                    *ent.User
+	sessions                     []*ent.Session
+	should_return_database_error bool
+}
+
+// NewMockSessionEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockSessionEntClient(public_id uuid.UUID) *MockSessionEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockSessionEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		sessions:                     []*ent.Session{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockSessionEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockSessionEntClientWithDatabaseError(public_id uuid.UUID) *MockSessionEntClient {
+	var client *MockSessionEntClient
+
+	client = NewMockSessionEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockSessionEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetSessionClient はモックのSessionClientを返します
+func (m *MockSessionEntClient) GetSessionClient() SessionClientInterface {
+	return &MockSessionClient{store: m}
+}
+
+// Sessions は保存されたセッションを返します
+func (m *MockSessionEntClient) Sessions() []*ent.Session {
+	return m.sessions
+}
+
+// build_session_values はWhere判定用にSessionのフィールド値を返します
+func build_session_values(ent_session *ent.Session) map[string]any {
+	return map[string]any{
+		"session_id": ent_session.SessionID,
+		"user_id":    ent_session.UserID,
+		"revoked_at": build_nullable_time_value(ent_session.RevokedAt),
+	}
+}
+
+// MockSessionClient はモックのSessionClientです
+type MockSessionClient struct {
+	store *MockSessionEntClient
+}
+
+// Create はモックのSessionCreate Builderを返します
+func (m *MockSessionClient) Create() SessionCreateInterface {
+	return &MockSessionCreate{store: m.store, session: &ent.Session{}}
+}
+
+// Query はモックのSessionQuery Builderを返します
+func (m *MockSessionClient) Query() SessionQueryInterface {
+	return &MockSessionQuery{store: m.store}
+}
+
+// Update はモックのSessionUpdate Builderを返します
+func (m *MockSessionClient) Update() SessionUpdateInterface {
+	return &MockSessionUpdate{store: m.store}
+}
+
+// MockSessionCreate はモックのSessionCreate Builderです
+type MockSessionCreate struct {
+	store   *MockSessionEntClient
+	session *ent.Session
+}
+
+// SetSessionID はセッションIDを設定します
+func (m *MockSessionCreate) SetSessionID(session_id uuid.UUID) SessionCreateInterface {
+	m.session.SessionID = session_id
+	return m
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockSessionCreate) SetUserID(user_id int) SessionCreateInterface {
+	m.session.UserID = user_id
+	return m
+}
+
+// SetDeviceName は端末名を設定します
+func (m *MockSessionCreate) SetDeviceName(device_name string) SessionCreateInterface {
+	m.session.DeviceName = device_name
+	return m
+}
+
+// SetPlatform は端末のプラットフォームを設定します
+func (m *MockSessionCreate) SetPlatform(platform session.Platform) SessionCreateInterface {
+	m.session.Platform = platform
+	return m
+}
+
+// SetIPAddress はIPアドレスを設定します
+func (m *MockSessionCreate) SetIPAddress(ip_address string) SessionCreateInterface {
+	m.session.IPAddress = ip_address
+	return m
+}
+
+// SetUserAgent はUser-Agentを設定します
+func (m *MockSessionCreate) SetUserAgent(user_agent string) SessionCreateInterface {
+	m.session.UserAgent = user_agent
+	return m
+}
+
+// SetLastSeenAt は最終利用日時を設定します
+func (m *MockSessionCreate) SetLastSeenAt(last_seen_at time.Time) SessionCreateInterface {
+	m.session.LastSeenAt = last_seen_at
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockSessionCreate) SetExpiresAt(expires_at time.Time) SessionCreateInterface {
+	m.session.ExpiresAt = expires_at
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockSessionCreate) SetCreatedAt(created_at time.Time) SessionCreateInterface {
+	m.session.CreatedAt = created_at
+	return m
+}
+
+// Save はセッションを保存します
+func (m *MockSessionCreate) Save(_ context.Context) (*ent.Session, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	m.session.ID = len(m.store.sessions) + 1
+	m.session.Edges.User = m.store.mock_user
+	m.store.sessions = append(m.store.sessions, m.session)
+	return m.session, nil
+}
+
+// MockSessionQuery はモックのSessionQuery Builderです
+type MockSessionQuery struct {
+	store      *MockSessionEntClient
+	predicates []any
+	active_at  *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockSessionQuery) Where(predicates ...any) SessionQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// ActiveAt は有効期限が指定日時より後のセッションに絞り込み、最終利用日時の降順に並べます
+func (m *MockSessionQuery) ActiveAt(now time.Time) SessionQueryInterface {
+	m.active_at = &now
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockSessionQuery) WithUser() SessionQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一のセッションを返します
+func (m *MockSessionQuery) Only(ctx context.Context) (*ent.Session, error) {
+	var sessions []*ent.Session
+	var err error
+
+	sessions, err = m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, fmt.Errorf("session not found")
+	}
+	return sessions[0], nil
+}
+
+// All は条件に一致する全てのセッションを返します
+func (m *MockSessionQuery) All(_ context.Context) ([]*ent.Session, error) {
+	var sessions []*ent.Session
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	sessions = []*ent.Session{}
+	for _, ent_session := range m.store.sessions {
+		if !match_mock_predicates(build_session_values(ent_session), m.predicates) {
+			continue
+		}
+		if m.active_at != nil && !ent_session.ExpiresAt.After(*m.active_at) {
+			continue
+		}
+		sessions = append(sessions, ent_session)
+	}
+	if m.active_at != nil {
+		slices.SortFunc(sessions, func(a *ent.Session, b *ent.Session) int {
+			return b.LastSeenAt.Compare(a.LastSeenAt)
+		})
+	}
+	return sessions, nil
+}
+
+// MockSessionUpdate はモックのSessionUpdate Builderです
+type MockSessionUpdate struct {
+	store        *MockSessionEntClient
+	predicates   []any
+	last_seen_at *time.Time
+	expires_at   *time.Time
+	revoked_at   *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockSessionUpdate) Where(predicates ...any) SessionUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetLastSeenAt は最終利用日時を設定します
+func (m *MockSessionUpdate) SetLastSeenAt(last_seen_at time.Time) SessionUpdateInterface {
+	m.last_seen_at = &last_seen_at
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockSessionUpdate) SetExpiresAt(expires_at time.Time) SessionUpdateInterface {
+	m.expires_at = &expires_at
+	return m
+}
+
+// SetRevokedAt は失効日時を設定します
+func (m *MockSessionUpdate) SetRevokedAt(revoked_at time.Time) SessionUpdateInterface {
+	m.revoked_at = &revoked_at
+	return m
+}
+
+// Save は条件に一致するセッションを更新し、更新件数を返します
+func (m *MockSessionUpdate) Save(_ context.Context) (int, error) {
+	var affected_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_session := range m.store.sessions {
+		if !match_mock_predicates(build_session_values(ent_session), m.predicates) {
+			continue
+		}
+		if m.last_seen_at != nil {
+			ent_session.LastSeenAt = *m.last_seen_at
+		}
+		if m.expires_at != nil {
+			ent_session.ExpiresAt = *m.expires_at
+		}
+		if m.revoked_at != nil {
+			ent_session.RevokedAt = m.revoked_at
+		}
+		affected_count++
+	}
+	return affected_count, nil
+}
@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/denylistedtoken"
+)
+
+// MockTokenDenylistEntClient はテスト用のインメモリなdenylist Entクライアントです
+type MockTokenDenylistEntClient struct {
+	entries                      []*ent.DenylistedToken
+	should_return_database_error bool
+	ExistCallCount               int
+}
+
+// NewMockTokenDenylistEntClient は新しいMockTokenDenylistEntClientを作成します
+func NewMockTokenDenylistEntClient() *MockTokenDenylistEntClient {
+	return &MockTokenDenylistEntClient{
+		entries:                      []*ent.DenylistedToken{},
+		should_return_database_error: false,
+		ExistCallCount:               0,
+	}
+}
+
+// NewMockTokenDenylistEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockTokenDenylistEntClientWithDatabaseError() *MockTokenDenylistEntClient {
+	var client *MockTokenDenylistEntClient
+
+	client = NewMockTokenDenylistEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// GetDenylistedTokenClient はモックのDenylistedTokenClientを返します
+func (m *MockTokenDenylistEntClient) GetDenylistedTokenClient() DenylistedTokenClientInterface {
+	return &MockDenylistedTokenClient{store: m}
+}
+
+// Entries は登録されたdenylistを返します
+func (m *MockTokenDenylistEntClient) Entries() []*ent.DenylistedToken {
+	return m.entries
+}
+
+// build_denylisted_token_values はWhere判定用にDenylistedTokenのフィールド値を返します
+func build_denylisted_token_values(entry *ent.DenylistedToken) map[string]any {
+	return map[string]any{
+		"kind":  entry.Kind,
+		"value": entry.Value,
+	}
+}
+
+// MockDenylistedTokenClient はモックのDenylistedTokenClientです
+type MockDenylistedTokenClient struct {
+	store *MockTokenDenylistEntClient
+}
+
+// Create はモックのDenylistedTokenCreate Builderを返します
+func (m *MockDenylistedTokenClient) Create() DenylistedTokenCreateInterface {
+	return &MockDenylistedTokenCreate{store: m.store, entry: &ent.DenylistedToken{}}
+}
+
+// Query はモックのDenylistedTokenQuery Builderを返します
+func (m *MockDenylistedTokenClient) Query() DenylistedTokenQueryInterface {
+	return &MockDenylistedTokenQuery{store: m.store}
+}
+
+// Delete はモックのDenylistedTokenDelete Builderを返します
+func (m *MockDenylistedTokenClient) Delete() DenylistedTokenDeleteInterface {
+	return &MockDenylistedTokenDelete{store: m.store}
+}
+
+// MockDenylistedTokenCreate はモックのDenylistedTokenCreate Builderです
+type MockDenylistedTokenCreate struct {
+	store *MockTokenDenylistEntClient
+	entry *ent.DenylistedToken
+}
+
+// SetKind は種類を設定します
+func (m *MockDenylistedTokenCreate) SetKind(kind denylistedtoken.Kind) DenylistedTokenCreateInterface {
+	m.entry.Kind = kind
+	return m
+}
+
+// SetValue はjtiまたはsidを設定します
+func (m *MockDenylistedTokenCreate) SetValue(value string) DenylistedTokenCreateInterface {
+	m.entry.Value = value
+	return m
+}
+
+// SetExpiresAt は有効期限を設定します
+func (m *MockDenylistedTokenCreate) SetExpiresAt(expires_at time.Time) DenylistedTokenCreateInterface {
+	m.entry.ExpiresAt = expires_at
+	return m
+}
+
+// Save はdenylistを保存します（kind, valueのユニーク制約を再現します）
+func (m *MockDenylistedTokenCreate) Save(_ context.Context) (*ent.DenylistedToken, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, entry := range m.store.entries {
+		if entry.Kind == m.entry.Kind && entry.Value == m.entry.Value {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.entry.ID = len(m.store.entries) + 1
+	m.entry.CreatedAt = time.Now()
+	m.store.entries = append(m.store.entries, m.entry)
+	return m.entry, nil
+}
+
+// MockDenylistedTokenQuery はモックのDenylistedTokenQuery Builderです
+type MockDenylistedTokenQuery struct {
+	store      *MockTokenDenylistEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockDenylistedTokenQuery) Where(predicates ...any) DenylistedTokenQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exist は条件に一致する登録が存在するかを返します
+func (m *MockDenylistedTokenQuery) Exist(_ context.Context) (bool, error) {
+	m.store.ExistCallCount++
+	if m.store.should_return_database_error {
+		return false, fmt.Errorf("connection refused")
+	}
+	for _, entry := range m.store.entries {
+		if match_mock_predicates(build_denylisted_token_values(entry), m.predicates) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockDenylistedTokenDelete はモックのDenylistedTokenDelete Builderです
+type MockDenylistedTokenDelete struct {
+	store      *MockTokenDenylistEntClient
+	expired_at *time.Time
+}
+
+// ExpiredAt は有効期限が指定日時以前の登録に絞り込みます
+func (m *MockDenylistedTokenDelete) ExpiredAt(now time.Time) DenylistedTokenDeleteInterface {
+	m.expired_at = &now
+	return m
+}
+
+// Exec は条件に一致する登録を削除し、削除件数を返します
+func (m *MockDenylistedTokenDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.DenylistedToken
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.DenylistedToken{}
+	for _, entry := range m.store.entries {
+		if m.expired_at == nil || !entry.ExpiresAt.After(*m.expired_at) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, entry)
+	}
+	m.store.entries = remaining
+	return deleted_count, nil
+}
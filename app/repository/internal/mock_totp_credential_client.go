@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockTotpCredentialEntClient はテスト用のインメモリな二要素認証の認証情報Entクライアントです
+type MockTotpCredentialEntClient struct {
+	mock_user                    *ent.User
+	credentials                  []*ent.TotpCredential
+	should_return_database_error bool
+}
+
+// NewMockTotpCredentialEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockTotpCredentialEntClient(public_id uuid.UUID) *MockTotpCredentialEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockTotpCredentialEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		credentials:                  []*ent.TotpCredential{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockTotpCredentialEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockTotpCredentialEntClientWithDatabaseError(public_id uuid.UUID) *MockTotpCredentialEntClient {
+	var client *MockTotpCredentialEntClient
+
+	client = NewMockTotpCredentialEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockTotpCredentialEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetTotpCredentialClient はモックのTotpCredentialClientを返します
+func (m *MockTotpCredentialEntClient) GetTotpCredentialClient() TotpCredentialClientInterface {
+	return &MockTotpCredentialClient{store: m}
+}
+
+// Credentials は保存された認証情報を返します
+func (m *MockTotpCredentialEntClient) Credentials() []*ent.TotpCredential {
+	return m.credentials
+}
+
+// build_totp_credential_values はWhere判定用にTotpCredentialのフィールド値を返します
+func build_totp_credential_values(credential *ent.TotpCredential) map[string]any {
+	return map[string]any{
+		"user_id": credential.UserID,
+	}
+}
+
+// MockTotpCredentialClient はモックのTotpCredentialClientです
+type MockTotpCredentialClient struct {
+	store *MockTotpCredentialEntClient
+}
+
+// Create はモックのTotpCredentialCreate Builderを返します
+func (m *MockTotpCredentialClient) Create() TotpCredentialCreateInterface {
+	return &MockTotpCredentialCreate{store: m.store, credential: &ent.TotpCredential{}}
+}
+
+// Query はモックのTotpCredentialQuery Builderを返します
+func (m *MockTotpCredentialClient) Query() TotpCredentialQueryInterface {
+	return &MockTotpCredentialQuery{store: m.store}
+}
+
+// Update はモックのTotpCredentialUpdate Builderを返します
+func (m *MockTotpCredentialClient) Update() TotpCredentialUpdateInterface {
+	return &MockTotpCredentialUpdate{store: m.store}
+}
+
+// Delete はモックのTotpCredentialDelete Builderを返します
+func (m *MockTotpCredentialClient) Delete() TotpCredentialDeleteInterface {
+	return &MockTotpCredentialDelete{store: m.store}
+}
+
+// MockTotpCredentialCreate はモックのTotpCredentialCreate Builderです
+type MockTotpCredentialCreate struct {
+	store      *MockTotpCredentialEntClient
+	credential *ent.TotpCredential
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockTotpCredentialCreate) SetUserID(user_id int) TotpCredentialCreateInterface {
+	m.credential.UserID = user_id
+	return m
+}
+
+// SetEncryptedSecret は暗号化した共有シークレットを設定します
+func (m *MockTotpCredentialCreate) SetEncryptedSecret(encrypted_secret string) TotpCredentialCreateInterface {
+	m.credential.EncryptedSecret = encrypted_secret
+	return m
+}
+
+// SetRecoveryCodeHashes はリカバリーコードのハッシュを設定します
+func (m *MockTotpCredentialCreate) SetRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialCreateInterface {
+	m.credential.RecoveryCodeHashes = slices.Clone(recovery_code_hashes)
+	return m
+}
+
+// SetLastUsedStep は最後に使用された認証コードのタイムステップを設定します
+func (m *MockTotpCredentialCreate) SetLastUsedStep(last_used_step int64) TotpCredentialCreateInterface {
+	m.credential.LastUsedStep = last_used_step
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockTotpCredentialCreate) SetCreatedAt(created_at time.Time) TotpCredentialCreateInterface {
+	m.credential.CreatedAt = created_at
+	return m
+}
+
+// Save は認証情報を保存します（user_idのユニーク制約を再現します）
+func (m *MockTotpCredentialCreate) Save(_ context.Context) (*ent.TotpCredential, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, credential := range m.store.credentials {
+		if credential.UserID == m.credential.UserID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.credential.ID = len(m.store.credentials) + 1
+	m.store.credentials = append(m.store.credentials, m.credential)
+	return m.credential, nil
+}
+
+// MockTotpCredentialQuery はモックのTotpCredentialQuery Builderです
+type MockTotpCredentialQuery struct {
+	store      *MockTotpCredentialEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockTotpCredentialQuery) Where(predicates ...any) TotpCredentialQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Only は条件に一致する単一の認証情報を返します
+func (m *MockTotpCredentialQuery) Only(_ context.Context) (*ent.TotpCredential, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, credential := range m.store.credentials {
+		if match_mock_predicates(build_totp_credential_values(credential), m.predicates) {
+			return credential, nil
+		}
+	}
+	return nil, fmt.Errorf("totp_credential not found")
+}
+
+// MockTotpCredentialUpdate はモックのTotpCredentialUpdate Builderです
+type MockTotpCredentialUpdate struct {
+	store                *MockTotpCredentialEntClient
+	predicates           []any
+	recovery_code_hashes []string
+	last_used_step       int64
+
+	last_used_step_before         *int64
+	expected_recovery_code_hashes []string
+	has_expected_recovery_codes   bool
+}
+
+// Where は条件を追加します
+func (m *MockTotpCredentialUpdate) Where(predicates ...any) TotpCredentialUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WhereLastUsedStepBefore は使用済みのタイムステップが指定より前である条件を追加します
+func (m *MockTotpCredentialUpdate) WhereLastUsedStepBefore(step int64) TotpCredentialUpdateInterface {
+	m.last_used_step_before = &step
+	return m
+}
+
+// WhereRecoveryCodeHashes はリカバリーコードのハッシュが指定と一致する条件を追加します
+func (m *MockTotpCredentialUpdate) WhereRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialUpdateInterface {
+	m.expected_recovery_code_hashes = slices.Clone(recovery_code_hashes)
+	m.has_expected_recovery_codes = true
+	return m
+}
+
+// SetRecoveryCodeHashes はリカバリーコードのハッシュを設定します
+func (m *MockTotpCredentialUpdate) SetRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialUpdateInterface {
+	m.recovery_code_hashes = slices.Clone(recovery_code_hashes)
+	return m
+}
+
+// SetLastUsedStep は最後に使用された認証コードのタイムステップを設定します
+func (m *MockTotpCredentialUpdate) SetLastUsedStep(last_used_step int64) TotpCredentialUpdateInterface {
+	m.last_used_step = last_used_step
+	return m
+}
+
+// Save は条件に一致する認証情報を更新し、更新件数を返します
+func (m *MockTotpCredentialUpdate) Save(_ context.Context) (int, error) {
+	var updated_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	updated_count = 0
+	for _, credential := range m.store.credentials {
+		if !match_mock_predicates(build_totp_credential_values(credential), m.predicates) {
+			continue
+		}
+		if m.last_used_step_before != nil && credential.LastUsedStep >= *m.last_used_step_before {
+			continue
+		}
+		if m.has_expected_recovery_codes && !slices.Equal(credential.RecoveryCodeHashes, m.expected_recovery_code_hashes) {
+			continue
+		}
+		credential.RecoveryCodeHashes = m.recovery_code_hashes
+		credential.LastUsedStep = m.last_used_step
+		updated_count++
+	}
+	return updated_count, nil
+}
+
+// MockTotpCredentialDelete はモックのTotpCredentialDelete Builderです
+type MockTotpCredentialDelete struct {
+	store      *MockTotpCredentialEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockTotpCredentialDelete) Where(predicates ...any) TotpCredentialDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致する認証情報を削除し、削除件数を返します
+func (m *MockTotpCredentialDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.TotpCredential
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.TotpCredential{}
+	deleted_count = 0
+	for _, credential := range m.store.credentials {
+		if match_mock_predicates(build_totp_credential_values(credential), m.predicates) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, credential)
+	}
+	m.store.credentials = remaining
+	return deleted_count, nil
+}
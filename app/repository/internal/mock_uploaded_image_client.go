@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/schematype"
+	"sleeve/ent/uploadedimage"
+
+	"github.com/google/uuid"
+)
+
+// MockUploadedImageEntClient はテスト用のインメモリなアップロードした画像のEntクライアントです
+// ユーザーはAddUserで追加します
+type MockUploadedImageEntClient struct {
+	users                        []*ent.User
+	images                       []*ent.UploadedImage
+	should_return_database_error bool
+}
+
+// NewMockUploadedImageEntClient はユーザーのいないモッククライアントを作成します
+func NewMockUploadedImageEntClient() *MockUploadedImageEntClient {
+	return &MockUploadedImageEntClient{
+		users:                        []*ent.User{},
+		images:                       []*ent.UploadedImage{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockUploadedImageEntClientWithDatabaseError は画像の操作でDBエラーを返すモッククライアントを作成します
+func NewMockUploadedImageEntClientWithDatabaseError() *MockUploadedImageEntClient {
+	var client *MockUploadedImageEntClient
+
+	client = NewMockUploadedImageEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します
+func (m *MockUploadedImageEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockUploadedImageEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users}
+}
+
+// GetUploadedImageClient はモックのUploadedImageClientを返します
+func (m *MockUploadedImageEntClient) GetUploadedImageClient() UploadedImageClientInterface {
+	return &MockUploadedImageClient{store: m}
+}
+
+// MockUploadedImageClient はモックのUploadedImageClientです
+type MockUploadedImageClient struct {
+	store *MockUploadedImageEntClient
+}
+
+// Create はモックのUploadedImageCreate Builderを返します
+func (m *MockUploadedImageClient) Create() UploadedImageCreateInterface {
+	return &MockUploadedImageCreate{store: m.store, image: &ent.UploadedImage{}}
+}
+
+// Query はモックのUploadedImageQuery Builderを返します
+func (m *MockUploadedImageClient) Query() UploadedImageQueryInterface {
+	return &MockUploadedImageQuery{store: m.store}
+}
+
+// MockUploadedImageCreate はモックのUploadedImageCreate Builderです
+type MockUploadedImageCreate struct {
+	store *MockUploadedImageEntClient
+	image *ent.UploadedImage
+}
+
+// SetImageID は画像IDを設定します
+func (m *MockUploadedImageCreate) SetImageID(image_id uuid.UUID) UploadedImageCreateInterface {
+	m.image.ImageID = image_id
+	return m
+}
+
+// SetUserID はアップロードしたユーザーのIDを設定します
+func (m *MockUploadedImageCreate) SetUserID(user_id int) UploadedImageCreateInterface {
+	m.image.UserID = user_id
+	return m
+}
+
+// SetPurpose は画像の用途を設定します
+func (m *MockUploadedImageCreate) SetPurpose(purpose uploadedimage.Purpose) UploadedImageCreateInterface {
+	m.image.Purpose = purpose
+	return m
+}
+
+// SetFormat は画像の形式を設定します
+func (m *MockUploadedImageCreate) SetFormat(format uploadedimage.Format) UploadedImageCreateInterface {
+	m.image.Format = format
+	return m
+}
+
+// SetVariants はリサイズ画像を設定します
+func (m *MockUploadedImageCreate) SetVariants(variants []schematype.UploadedImageVariant) UploadedImageCreateInterface {
+	m.image.Variants = variants
+	return m
+}
+
+// SetCreatedAt はアップロード日時を設定します
+func (m *MockUploadedImageCreate) SetCreatedAt(created_at time.Time) UploadedImageCreateInterface {
+	m.image.CreatedAt = created_at
+	return m
+}
+
+// Save は画像を保存します（image_idのユニーク制約を再現します）
+func (m *MockUploadedImageCreate) Save(_ context.Context) (*ent.UploadedImage, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_image := range m.store.images {
+		if ent_image.ImageID == m.image.ImageID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.image.ID = len(m.store.images) + 1
+	m.store.images = append(m.store.images, m.image)
+	return m.image, nil
+}
+
+// MockUploadedImageQuery はモックのUploadedImageQuery Builderです
+type MockUploadedImageQuery struct {
+	store      *MockUploadedImageEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUploadedImageQuery) Where(predicates ...any) UploadedImageQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// All は条件に一致する全ての画像を保存した順に返します
+func (m *MockUploadedImageQuery) All(_ context.Context) ([]*ent.UploadedImage, error) {
+	var images []*ent.UploadedImage
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	images = []*ent.UploadedImage{}
+	for _, ent_image := range m.store.images {
+		if match_mock_predicates(map[string]any{"user_id": ent_image.UserID}, m.predicates) {
+			images = append(images, ent_image)
+		}
+	}
+	return images, nil
+}
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// MockUserBlockEntClient はテスト用のインメモリなブロックのEntクライアントです
+// ユーザーはAddUserで追加します
+type MockUserBlockEntClient struct {
+	users                        []*ent.User
+	blocks                       []*ent.UserBlock
+	should_return_database_error bool
+}
+
+// NewMockUserBlockEntClient はユーザーのいないモッククライアントを作成します
+func NewMockUserBlockEntClient() *MockUserBlockEntClient {
+	return &MockUserBlockEntClient{
+		users:                        []*ent.User{},
+		blocks:                       []*ent.UserBlock{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockUserBlockEntClientWithDatabaseError はブロックの操作でDBエラーを返すモッククライアントを作成します
+func NewMockUserBlockEntClientWithDatabaseError() *MockUserBlockEntClient {
+	var client *MockUserBlockEntClient
+
+	client = NewMockUserBlockEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します
+func (m *MockUserBlockEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// Blocks は保存されたブロックを返します
+func (m *MockUserBlockEntClient) Blocks() []*ent.UserBlock {
+	return m.blocks
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockUserBlockEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users}
+}
+
+// GetUserBlockClient はモックのUserBlockClientを返します
+func (m *MockUserBlockEntClient) GetUserBlockClient() UserBlockClientInterface {
+	return &MockUserBlockClient{store: m}
+}
+
+// build_user_block_values はWhere判定用にUserBlockのフィールド値を返します
+func build_user_block_values(ent_block *ent.UserBlock) map[string]any {
+	return map[string]any{
+		"blocker_id": ent_block.BlockerID,
+		"blocked_id": ent_block.BlockedID,
+	}
+}
+
+// MockUserBlockClient はモックのUserBlockClientです
+type MockUserBlockClient struct {
+	store *MockUserBlockEntClient
+}
+
+// Create はモックのUserBlockCreate Builderを返します
+func (m *MockUserBlockClient) Create() UserBlockCreateInterface {
+	return &MockUserBlockCreate{store: m.store, block: &ent.UserBlock{}}
+}
+
+// Query はモックのUserBlockQuery Builderを返します
+func (m *MockUserBlockClient) Query() UserBlockQueryInterface {
+	return &MockUserBlockQuery{store: m.store}
+}
+
+// Delete はモックのUserBlockDelete Builderを返します
+func (m *MockUserBlockClient) Delete() UserBlockDeleteInterface {
+	return &MockUserBlockDelete{store: m.store}
+}
+
+// MockUserBlockCreate はモックのUserBlockCreate Builderです
+type MockUserBlockCreate struct {
+	store *MockUserBlockEntClient
+	block *ent.UserBlock
+}
+
+// SetBlockerID はブロックするユーザーのIDを設定します
+func (m *MockUserBlockCreate) SetBlockerID(blocker_id int) UserBlockCreateInterface {
+	m.block.BlockerID = blocker_id
+	return m
+}
+
+// SetBlockedID はブロックされるユーザーのIDを設定します
+func (m *MockUserBlockCreate) SetBlockedID(blocked_id int) UserBlockCreateInterface {
+	m.block.BlockedID = blocked_id
+	return m
+}
+
+// SetCreatedAt はブロックした日時を設定します
+func (m *MockUserBlockCreate) SetCreatedAt(created_at time.Time) UserBlockCreateInterface {
+	m.block.CreatedAt = created_at
+	return m
+}
+
+// Save はブロックを保存します（(blocker_id, blocked_id)のユニーク制約を再現します）
+func (m *MockUserBlockCreate) Save(_ context.Context) (*ent.UserBlock, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_block := range m.store.blocks {
+		if ent_block.BlockerID == m.block.BlockerID && ent_block.BlockedID == m.block.BlockedID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.block.ID = len(m.store.blocks) + 1
+	m.store.blocks = append(m.store.blocks, m.block)
+	return m.block, nil
+}
+
+// MockUserBlockQuery はモックのUserBlockQuery Builderです
+type MockUserBlockQuery struct {
+	store      *MockUserBlockEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserBlockQuery) Where(predicates ...any) UserBlockQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithBlocked はブロックされたユーザーのEager Loadingを指定します
+func (m *MockUserBlockQuery) WithBlocked() UserBlockQueryInterface {
+	return m
+}
+
+// All は条件に一致する全てのブロックを返します（ブロックされたユーザーのEdgeも設定します）
+func (m *MockUserBlockQuery) All(_ context.Context) ([]*ent.UserBlock, error) {
+	var blocks []*ent.UserBlock
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	blocks = []*ent.UserBlock{}
+	for _, ent_block := range m.store.blocks {
+		if !match_mock_predicates(build_user_block_values(ent_block), m.predicates) {
+			continue
+		}
+		for _, ent_user := range m.store.users {
+			if ent_user.ID == ent_block.BlockedID {
+				ent_block.Edges.Blocked = ent_user
+			}
+		}
+		blocks = append(blocks, ent_block)
+	}
+	return blocks, nil
+}
+
+// Exist は条件に一致するブロックが存在するかを返します
+func (m *MockUserBlockQuery) Exist(_ context.Context) (bool, error) {
+	if m.store.should_return_database_error {
+		return false, fmt.Errorf("connection refused")
+	}
+	for _, ent_block := range m.store.blocks {
+		if match_mock_predicates(build_user_block_values(ent_block), m.predicates) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockUserBlockDelete はモックのUserBlockDelete Builderです
+type MockUserBlockDelete struct {
+	store      *MockUserBlockEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserBlockDelete) Where(predicates ...any) UserBlockDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するブロックを削除し、削除件数を返します
+func (m *MockUserBlockDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.UserBlock
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_block := range m.store.blocks {
+		if match_mock_predicates(build_user_block_values(ent_block), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_block)
+	}
+	m.store.blocks = remaining
+	return deleted, nil
+}
@@ -0,0 +1,301 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// MockUserHandleEntClient はテスト用のインメモリなハンドルのEntクライアントです
+// 他のユーザーのハンドルはAddUserHandleで追加します
+type MockUserHandleEntClient struct {
+	mock_user                    *ent.User
+	users                        []*ent.User
+	handles                      []*ent.UserHandle
+	should_return_database_error bool
+	should_fail_update           bool
+}
+
+// NewMockUserHandleEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockUserHandleEntClient(public_id uuid.UUID) *MockUserHandleEntClient {
+	var now time.Time
+	var mock_user *ent.User
+
+	now = time.Now()
+	mock_user = &ent.User{
+		ID:          1,
+		PublicID:    public_id,
+		FirebaseUID: "firebase_uid_123",
+		Email:       "test@example.com",
+		Role:        user.RoleUser,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   nil,
+	}
+	return &MockUserHandleEntClient{
+		mock_user:                    mock_user,
+		users:                        []*ent.User{mock_user},
+		handles:                      []*ent.UserHandle{},
+		should_return_database_error: false,
+		should_fail_update:           false,
+	}
+}
+
+// NewMockUserHandleEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockUserHandleEntClientWithDatabaseError(public_id uuid.UUID) *MockUserHandleEntClient {
+	var client *MockUserHandleEntClient
+
+	client = NewMockUserHandleEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// NewMockUserHandleEntClientWithUpdateError は更新のみDBエラーを返すモッククライアントを作成します（補償処理のテスト用）
+func NewMockUserHandleEntClientWithUpdateError(public_id uuid.UUID) *MockUserHandleEntClient {
+	var client *MockUserHandleEntClient
+
+	client = NewMockUserHandleEntClient(public_id)
+	client.should_fail_update = true
+	return client
+}
+
+// AddUserHandle は指定されたpublic_idのユーザーのハンドルを追加します（未登録のユーザーは追加します）
+func (m *MockUserHandleEntClient) AddUserHandle(public_id uuid.UUID, handle string, handle_key string, created_at time.Time, retired_at *time.Time) {
+	var owner *ent.User
+
+	for _, ent_user := range m.users {
+		if ent_user.PublicID == public_id {
+			owner = ent_user
+		}
+	}
+	if owner == nil {
+		owner = &ent.User{ID: len(m.users) + 1, PublicID: public_id, Role: user.RoleUser}
+		m.users = append(m.users, owner)
+	}
+	m.handles = append(m.handles, &ent.UserHandle{
+		ID:        len(m.handles) + 1,
+		UserID:    owner.ID,
+		Handle:    handle,
+		HandleKey: handle_key,
+		CreatedAt: created_at,
+		RetiredAt: retired_at,
+	})
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockUserHandleEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetUserHandleClient はモックのUserHandleClientを返します
+func (m *MockUserHandleEntClient) GetUserHandleClient() UserHandleClientInterface {
+	return &MockUserHandleClient{store: m}
+}
+
+// Handles は保存されたハンドルを返します
+func (m *MockUserHandleEntClient) Handles() []*ent.UserHandle {
+	return m.handles
+}
+
+// build_user_handle_values はWhere判定用にUserHandleのフィールド値を返します
+func build_user_handle_values(ent_handle *ent.UserHandle) map[string]any {
+	return map[string]any{
+		"user_id":    ent_handle.UserID,
+		"handle_key": ent_handle.HandleKey,
+		"retired_at": build_nullable_time_value(ent_handle.RetiredAt),
+	}
+}
+
+// MockUserHandleClient はモックのUserHandleClientです
+type MockUserHandleClient struct {
+	store *MockUserHandleEntClient
+}
+
+// Create はモックのUserHandleCreate Builderを返します
+func (m *MockUserHandleClient) Create() UserHandleCreateInterface {
+	return &MockUserHandleCreate{store: m.store, handle: &ent.UserHandle{}}
+}
+
+// Query はモックのUserHandleQuery Builderを返します
+func (m *MockUserHandleClient) Query() UserHandleQueryInterface {
+	return &MockUserHandleQuery{store: m.store}
+}
+
+// Update はモックのUserHandleUpdate Builderを返します
+func (m *MockUserHandleClient) Update() UserHandleUpdateInterface {
+	return &MockUserHandleUpdate{store: m.store}
+}
+
+// Delete はモックのUserHandleDelete Builderを返します
+func (m *MockUserHandleClient) Delete() UserHandleDeleteInterface {
+	return &MockUserHandleDelete{store: m.store}
+}
+
+// MockUserHandleCreate はモックのUserHandleCreate Builderです
+type MockUserHandleCreate struct {
+	store  *MockUserHandleEntClient
+	handle *ent.UserHandle
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockUserHandleCreate) SetUserID(user_id int) UserHandleCreateInterface {
+	m.handle.UserID = user_id
+	return m
+}
+
+// SetHandle は表示用のハンドルを設定します
+func (m *MockUserHandleCreate) SetHandle(handle string) UserHandleCreateInterface {
+	m.handle.Handle = handle
+	return m
+}
+
+// SetHandleKey は重複判定用のハンドルを設定します
+func (m *MockUserHandleCreate) SetHandleKey(handle_key string) UserHandleCreateInterface {
+	m.handle.HandleKey = handle_key
+	return m
+}
+
+// SetCreatedAt は作成日時を設定します
+func (m *MockUserHandleCreate) SetCreatedAt(created_at time.Time) UserHandleCreateInterface {
+	m.handle.CreatedAt = created_at
+	return m
+}
+
+// Save はハンドルを保存します（handle_keyのユニーク制約を再現します）
+func (m *MockUserHandleCreate) Save(_ context.Context) (*ent.UserHandle, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_handle := range m.store.handles {
+		if ent_handle.HandleKey == m.handle.HandleKey {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.handle.ID = len(m.store.handles) + 1
+	m.store.handles = append(m.store.handles, m.handle)
+	return m.handle, nil
+}
+
+// MockUserHandleQuery はモックのUserHandleQuery Builderです
+type MockUserHandleQuery struct {
+	store      *MockUserHandleEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserHandleQuery) Where(predicates ...any) UserHandleQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockUserHandleQuery) WithUser() UserHandleQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一のハンドルを返します
+func (m *MockUserHandleQuery) Only(_ context.Context) (*ent.UserHandle, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_handle := range m.store.handles {
+		if !match_mock_predicates(build_user_handle_values(ent_handle), m.predicates) {
+			continue
+		}
+		for _, ent_user := range m.store.users {
+			if ent_user.ID == ent_handle.UserID {
+				ent_handle.Edges.User = ent_user
+			}
+		}
+		return ent_handle, nil
+	}
+	return nil, fmt.Errorf("user handle not found")
+}
+
+// MockUserHandleUpdate はモックのUserHandleUpdate Builderです
+type MockUserHandleUpdate struct {
+	store      *MockUserHandleEntClient
+	predicates []any
+	handle     *string
+	retired_at *time.Time
+}
+
+// Where は条件を追加します
+func (m *MockUserHandleUpdate) Where(predicates ...any) UserHandleUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetHandle は表示用のハンドルを設定します
+func (m *MockUserHandleUpdate) SetHandle(handle string) UserHandleUpdateInterface {
+	m.handle = &handle
+	return m
+}
+
+// SetRetiredAt は別のハンドルに変更した日時を設定します
+func (m *MockUserHandleUpdate) SetRetiredAt(retired_at time.Time) UserHandleUpdateInterface {
+	m.retired_at = &retired_at
+	return m
+}
+
+// Save は条件に一致するハンドルを更新し、更新件数を返します
+func (m *MockUserHandleUpdate) Save(_ context.Context) (int, error) {
+	var affected_count int
+
+	if m.store.should_return_database_error || m.store.should_fail_update {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_handle := range m.store.handles {
+		if !match_mock_predicates(build_user_handle_values(ent_handle), m.predicates) {
+			continue
+		}
+		if m.handle != nil {
+			ent_handle.Handle = *m.handle
+		}
+		if m.retired_at != nil {
+			ent_handle.RetiredAt = m.retired_at
+		}
+		affected_count++
+	}
+	return affected_count, nil
+}
+
+// MockUserHandleDelete はモックのUserHandleDelete Builderです
+type MockUserHandleDelete struct {
+	store      *MockUserHandleEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserHandleDelete) Where(predicates ...any) UserHandleDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するハンドルを削除し、削除件数を返します
+func (m *MockUserHandleDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.UserHandle
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.UserHandle{}
+	for _, ent_handle := range m.store.handles {
+		if match_mock_predicates(build_user_handle_values(ent_handle), m.predicates) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, ent_handle)
+	}
+	m.store.handles = remaining
+	return deleted_count, nil
+}
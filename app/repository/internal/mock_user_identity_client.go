@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+	"sleeve/ent/useridentity"
+
+	"github.com/google/uuid"
+)
+
+// MockUserIdentityEntClient はテスト用のインメモリな外部プロバイダ連携Entクライアントです
+type MockUserIdentityEntClient struct {
+	mock_user                    *ent.User
+	identities                   []*ent.UserIdentity
+	should_return_database_error bool
+}
+
+// NewMockUserIdentityEntClient は指定されたpublic_idのユーザーを持つモッククライアントを作成します
+func NewMockUserIdentityEntClient(public_id uuid.UUID) *MockUserIdentityEntClient {
+	var now time.Time
+
+	now = time.Now()
+	return &MockUserIdentityEntClient{
+		mock_user: &ent.User{
+			ID:          1,
+			PublicID:    public_id,
+			FirebaseUID: "firebase_uid_123",
+			Email:       "test@example.com",
+			Role:        user.RoleUser,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   nil,
+		},
+		identities:                   []*ent.UserIdentity{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockUserIdentityEntClientWithDatabaseError はDBエラーを返すモッククライアントを作成します
+func NewMockUserIdentityEntClientWithDatabaseError(public_id uuid.UUID) *MockUserIdentityEntClient {
+	var client *MockUserIdentityEntClient
+
+	client = NewMockUserIdentityEntClient(public_id)
+	client.should_return_database_error = true
+	return client
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockUserIdentityEntClient) GetUserClient() UserClientInterface {
+	return &MockUserClient{
+		should_return_duplicate_error: false,
+		mock_user:                     m.mock_user,
+	}
+}
+
+// GetUserIdentityClient はモックのUserIdentityClientを返します
+func (m *MockUserIdentityEntClient) GetUserIdentityClient() UserIdentityClientInterface {
+	return &MockUserIdentityClient{store: m}
+}
+
+// Identities は保存された連携を返します
+func (m *MockUserIdentityEntClient) Identities() []*ent.UserIdentity {
+	return m.identities
+}
+
+// build_user_identity_values はWhere判定用にUserIdentityのフィールド値を返します
+func build_user_identity_values(identity *ent.UserIdentity) map[string]any {
+	return map[string]any{
+		"user_id":  identity.UserID,
+		"provider": identity.Provider.String(),
+		"subject":  identity.Subject,
+	}
+}
+
+// MockUserIdentityClient はモックのUserIdentityClientです
+type MockUserIdentityClient struct {
+	store *MockUserIdentityEntClient
+}
+
+// Create はモックのUserIdentityCreate Builderを返します
+func (m *MockUserIdentityClient) Create() UserIdentityCreateInterface {
+	return &MockUserIdentityCreate{store: m.store, identity: &ent.UserIdentity{}}
+}
+
+// Query はモックのUserIdentityQuery Builderを返します
+func (m *MockUserIdentityClient) Query() UserIdentityQueryInterface {
+	return &MockUserIdentityQuery{store: m.store}
+}
+
+// Delete はモックのUserIdentityDelete Builderを返します
+func (m *MockUserIdentityClient) Delete() UserIdentityDeleteInterface {
+	return &MockUserIdentityDelete{store: m.store}
+}
+
+// MockUserIdentityCreate はモックのUserIdentityCreate Builderです
+type MockUserIdentityCreate struct {
+	store    *MockUserIdentityEntClient
+	identity *ent.UserIdentity
+}
+
+// SetUserID はユーザーIDを設定します
+func (m *MockUserIdentityCreate) SetUserID(user_id int) UserIdentityCreateInterface {
+	m.identity.UserID = user_id
+	return m
+}
+
+// SetProvider は外部プロバイダを設定します
+func (m *MockUserIdentityCreate) SetProvider(provider useridentity.Provider) UserIdentityCreateInterface {
+	m.identity.Provider = provider
+	return m
+}
+
+// SetSubject は外部プロバイダでのユーザー識別子を設定します
+func (m *MockUserIdentityCreate) SetSubject(subject string) UserIdentityCreateInterface {
+	m.identity.Subject = subject
+	return m
+}
+
+// SetLinkedAt は連携日時を設定します
+func (m *MockUserIdentityCreate) SetLinkedAt(linked_at time.Time) UserIdentityCreateInterface {
+	m.identity.LinkedAt = linked_at
+	return m
+}
+
+// Save は連携を保存します
+// (provider, subject) と (user_id, provider) のユニーク制約を再現します
+func (m *MockUserIdentityCreate) Save(_ context.Context) (*ent.UserIdentity, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, identity := range m.store.identities {
+		if identity.Provider != m.identity.Provider {
+			continue
+		}
+		if identity.Subject == m.identity.Subject || identity.UserID == m.identity.UserID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.identity.ID = len(m.store.identities) + 1
+	m.identity.Edges.User = m.store.mock_user
+	m.store.identities = append(m.store.identities, m.identity)
+	return m.identity, nil
+}
+
+// MockUserIdentityQuery はモックのUserIdentityQuery Builderです
+type MockUserIdentityQuery struct {
+	store      *MockUserIdentityEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserIdentityQuery) Where(predicates ...any) UserIdentityQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithUser はユーザーのEager Loadingを指定します
+func (m *MockUserIdentityQuery) WithUser() UserIdentityQueryInterface {
+	return m
+}
+
+// Only は条件に一致する単一の連携を返します
+func (m *MockUserIdentityQuery) Only(_ context.Context) (*ent.UserIdentity, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, identity := range m.store.identities {
+		if match_mock_predicates(build_user_identity_values(identity), m.predicates) {
+			return identity, nil
+		}
+	}
+	return nil, fmt.Errorf("user_identity not found")
+}
+
+// All は条件に一致する全ての連携を返します
+func (m *MockUserIdentityQuery) All(_ context.Context) ([]*ent.UserIdentity, error) {
+	var identities []*ent.UserIdentity
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	identities = []*ent.UserIdentity{}
+	for _, identity := range m.store.identities {
+		if match_mock_predicates(build_user_identity_values(identity), m.predicates) {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+// MockUserIdentityDelete はモックのUserIdentityDelete Builderです
+type MockUserIdentityDelete struct {
+	store      *MockUserIdentityEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserIdentityDelete) Where(predicates ...any) UserIdentityDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致する連携を削除し、削除件数を返します
+func (m *MockUserIdentityDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.UserIdentity
+	var deleted_count int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	remaining = []*ent.UserIdentity{}
+	deleted_count = 0
+	for _, identity := range m.store.identities {
+		if match_mock_predicates(build_user_identity_values(identity), m.predicates) {
+			deleted_count++
+			continue
+		}
+		remaining = append(remaining, identity)
+	}
+	m.store.identities = remaining
+	return deleted_count, nil
+}
@@ -0,0 +1,262 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+	"sleeve/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// new_mock_user は指定された内部IDとpublic_idのテスト用のユーザーを作成します
+func new_mock_user(id int, public_id uuid.UUID) *ent.User {
+	var now time.Time
+
+	now = time.Now()
+	return &ent.User{
+		ID:          id,
+		PublicID:    public_id,
+		FirebaseUID: fmt.Sprintf("firebase_uid_%d", id),
+		Email:       fmt.Sprintf("user%d@example.com", id),
+		Role:        user.RoleUser,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// build_user_list_values はWhere判定用にUserのフィールド値を返します
+func build_user_list_values(ent_user *ent.User) map[string]any {
+	return map[string]any{
+		"id":        ent_user.ID,
+		"public_id": ent_user.PublicID,
+	}
+}
+
+// MockUserListClient は複数のユーザーを扱うモックのUserClientです
+// visible_toはWhereVisibleTo・WhereContentVisibleToの判定に使用します（nilの場合はすべてのユーザーが見えるものとします）
+type MockUserListClient struct {
+	users      []*ent.User
+	visible_to func(user_id int, viewer_id int, visibility user_visibility) bool
+}
+
+// Create はモックのUserCreate Builderを返します
+func (m *MockUserListClient) Create() UserCreateInterface {
+	return &MockUserCreate{}
+}
+
+// Query はモックのUserQuery Builderを返します
+func (m *MockUserListClient) Query() UserQueryInterface {
+	return &MockUserListQuery{users: m.users, visible_to: m.visible_to}
+}
+
+// Update はモックのUserUpdate Builderを返します
+func (m *MockUserListClient) Update() UserUpdateInterface {
+	return &MockUserListUpdate{users: m.users}
+}
+
+// Delete はモックのUserDelete Builderを返します
+func (m *MockUserListClient) Delete() UserDeleteInterface {
+	return &MockUserDelete{}
+}
+
+// MockUserListQuery はWhereの条件でユーザーを絞り込むモックのUserQuery Builderです
+type MockUserListQuery struct {
+	users      []*ent.User
+	visible_to func(user_id int, viewer_id int, visibility user_visibility) bool
+	predicates []any
+	// visibilityはWhereVisibleTo・WhereContentVisibleToで指定した範囲です（nilの場合は判定しません）
+	visibility *user_visibility
+	viewer_id  int
+}
+
+// Where は条件を追加します
+func (m *MockUserListQuery) Where(predicates ...any) UserQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WhereVisibleTo は閲覧するユーザーとの間にどちらの方向にもブロックがないユーザーに絞り込みます
+func (m *MockUserListQuery) WhereVisibleTo(viewer_id int) UserQueryInterface {
+	var visibility user_visibility
+
+	visibility = visibilityDirect
+	m.visibility = &visibility
+	m.viewer_id = viewer_id
+	return m
+}
+
+// WhereContentVisibleTo は閲覧するユーザーがコンテンツを閲覧できるユーザーに絞り込みます
+func (m *MockUserListQuery) WhereContentVisibleTo(viewer_id int) UserQueryInterface {
+	var visibility user_visibility
+
+	visibility = visibilityContent
+	m.visibility = &visibility
+	m.viewer_id = viewer_id
+	return m
+}
+
+// matches はユーザーが条件に一致するかを返します
+func (m *MockUserListQuery) matches(ent_user *ent.User) bool {
+	if m.visibility != nil && m.visible_to != nil && !m.visible_to(ent_user.ID, m.viewer_id, *m.visibility) {
+		return false
+	}
+	return match_mock_predicates(build_user_list_values(ent_user), m.predicates)
+}
+
+// Only は条件に一致する単一のユーザーを返します
+func (m *MockUserListQuery) Only(_ context.Context) (*ent.User, error) {
+	for _, ent_user := range m.users {
+		if m.matches(ent_user) {
+			return ent_user, nil
+		}
+	}
+	return nil, fmt.Errorf("user not found")
+}
+
+// Exist は条件に一致するユーザーが存在するかを返します
+func (m *MockUserListQuery) Exist(ctx context.Context) (bool, error) {
+	var err error
+
+	_, err = m.Only(ctx)
+	return err == nil, nil
+}
+
+// WhereFirebaseUIDIn は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListQuery) WhereFirebaseUIDIn(_ ...string) UserQueryInterface {
+	return m
+}
+
+// AfterFirebaseUID は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListQuery) AfterFirebaseUID(_ string) UserQueryInterface {
+	return m
+}
+
+// WhereDeletedBefore は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListQuery) WhereDeletedBefore(_ time.Time) UserQueryInterface {
+	return m
+}
+
+// Limit は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListQuery) Limit(_ int) UserQueryInterface {
+	return m
+}
+
+// All は条件に一致するユーザーを返します
+func (m *MockUserListQuery) All(_ context.Context) ([]*ent.User, error) {
+	var ent_users []*ent.User
+
+	for _, ent_user := range m.users {
+		if m.matches(ent_user) {
+			ent_users = append(ent_users, ent_user)
+		}
+	}
+	return ent_users, nil
+}
+
+// MockUserListUpdate はフォロワー数・フォロー数を加算するモックのUserUpdate Builderです
+type MockUserListUpdate struct {
+	users           []*ent.User
+	predicates      []any
+	follower_delta  int
+	following_delta int
+}
+
+// Where は条件を追加します
+func (m *MockUserListUpdate) Where(predicates ...any) UserUpdateInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// SetEmail は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetEmail(_ string) UserUpdateInterface {
+	return m
+}
+
+// SetEmailVerifiedAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetEmailVerifiedAt(_ time.Time) UserUpdateInterface {
+	return m
+}
+
+// ClearEmailVerifiedAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) ClearEmailVerifiedAt() UserUpdateInterface {
+	return m
+}
+
+// SetMfaEnabledAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetMfaEnabledAt(_ time.Time) UserUpdateInterface {
+	return m
+}
+
+// ClearMfaEnabledAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) ClearMfaEnabledAt() UserUpdateInterface {
+	return m
+}
+
+// SetDeletedAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetDeletedAt(_ time.Time) UserUpdateInterface {
+	return m
+}
+
+// ClearDeletedAt は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) ClearDeletedAt() UserUpdateInterface {
+	return m
+}
+
+// SetDeletionTokenHash は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetDeletionTokenHash(_ string) UserUpdateInterface {
+	return m
+}
+
+// ClearDeletionTokenHash は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) ClearDeletionTokenHash() UserUpdateInterface {
+	return m
+}
+
+// AddFollowerCount はフォロワー数に加算します
+func (m *MockUserListUpdate) AddFollowerCount(delta int) UserUpdateInterface {
+	m.follower_delta += delta
+	return m
+}
+
+// AddFollowingCount はフォロー数に加算します
+func (m *MockUserListUpdate) AddFollowingCount(delta int) UserUpdateInterface {
+	m.following_delta += delta
+	return m
+}
+
+// SetIsPrivate は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetIsPrivate(_ bool) UserUpdateInterface {
+	return m
+}
+
+// SetHideCloset は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetHideCloset(_ bool) UserUpdateInterface {
+	return m
+}
+
+// SetHideLikes は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetHideLikes(_ bool) UserUpdateInterface {
+	return m
+}
+
+// SetCommentPermission は何もしません（複数のユーザーを扱うテストでは使用しません）
+func (m *MockUserListUpdate) SetCommentPermission(_ user.CommentPermission) UserUpdateInterface {
+	return m
+}
+
+// Save は条件に一致するユーザーのフォロワー数・フォロー数を更新し、更新件数を返します
+func (m *MockUserListUpdate) Save(_ context.Context) (int, error) {
+	var updated int
+
+	for _, ent_user := range m.users {
+		if match_mock_predicates(build_user_list_values(ent_user), m.predicates) {
+			ent_user.FollowerCount += m.follower_delta
+			ent_user.FollowingCount += m.following_delta
+			updated++
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// MockUserMuteEntClient はテスト用のインメモリなミュートのEntクライアントです
+// ユーザーはAddUserで追加します
+type MockUserMuteEntClient struct {
+	users                        []*ent.User
+	mutes                        []*ent.UserMute
+	should_return_database_error bool
+}
+
+// NewMockUserMuteEntClient はユーザーのいないモッククライアントを作成します
+func NewMockUserMuteEntClient() *MockUserMuteEntClient {
+	return &MockUserMuteEntClient{
+		users:                        []*ent.User{},
+		mutes:                        []*ent.UserMute{},
+		should_return_database_error: false,
+	}
+}
+
+// NewMockUserMuteEntClientWithDatabaseError はミュートの操作でDBエラーを返すモッククライアントを作成します
+func NewMockUserMuteEntClientWithDatabaseError() *MockUserMuteEntClient {
+	var client *MockUserMuteEntClient
+
+	client = NewMockUserMuteEntClient()
+	client.should_return_database_error = true
+	return client
+}
+
+// AddUser は指定されたpublic_idのユーザーを追加して返します
+func (m *MockUserMuteEntClient) AddUser(public_id uuid.UUID) *ent.User {
+	var ent_user *ent.User
+
+	ent_user = new_mock_user(len(m.users)+1, public_id)
+	m.users = append(m.users, ent_user)
+	return ent_user
+}
+
+// Mutes は保存されたミュートを返します
+func (m *MockUserMuteEntClient) Mutes() []*ent.UserMute {
+	return m.mutes
+}
+
+// GetUserClient はモックのUserClientを返します
+func (m *MockUserMuteEntClient) GetUserClient() UserClientInterface {
+	return &MockUserListClient{users: m.users}
+}
+
+// GetUserMuteClient はモックのUserMuteClientを返します
+func (m *MockUserMuteEntClient) GetUserMuteClient() UserMuteClientInterface {
+	return &MockUserMuteClient{store: m}
+}
+
+// build_user_mute_values はWhere判定用にUserMuteのフィールド値を返します
+func build_user_mute_values(ent_mute *ent.UserMute) map[string]any {
+	return map[string]any{
+		"muter_id": ent_mute.MuterID,
+		"muted_id": ent_mute.MutedID,
+	}
+}
+
+// MockUserMuteClient はモックのUserMuteClientです
+type MockUserMuteClient struct {
+	store *MockUserMuteEntClient
+}
+
+// Create はモックのUserMuteCreate Builderを返します
+func (m *MockUserMuteClient) Create() UserMuteCreateInterface {
+	return &MockUserMuteCreate{store: m.store, mute: &ent.UserMute{}}
+}
+
+// Query はモックのUserMuteQuery Builderを返します
+func (m *MockUserMuteClient) Query() UserMuteQueryInterface {
+	return &MockUserMuteQuery{store: m.store}
+}
+
+// Delete はモックのUserMuteDelete Builderを返します
+func (m *MockUserMuteClient) Delete() UserMuteDeleteInterface {
+	return &MockUserMuteDelete{store: m.store}
+}
+
+// MockUserMuteCreate はモックのUserMuteCreate Builderです
+type MockUserMuteCreate struct {
+	store *MockUserMuteEntClient
+	mute  *ent.UserMute
+}
+
+// SetMuterID はミュートするユーザーのIDを設定します
+func (m *MockUserMuteCreate) SetMuterID(muter_id int) UserMuteCreateInterface {
+	m.mute.MuterID = muter_id
+	return m
+}
+
+// SetMutedID はミュートされるユーザーのIDを設定します
+func (m *MockUserMuteCreate) SetMutedID(muted_id int) UserMuteCreateInterface {
+	m.mute.MutedID = muted_id
+	return m
+}
+
+// SetCreatedAt はミュートした日時を設定します
+func (m *MockUserMuteCreate) SetCreatedAt(created_at time.Time) UserMuteCreateInterface {
+	m.mute.CreatedAt = created_at
+	return m
+}
+
+// Save はミュートを保存します（(muter_id, muted_id)のユニーク制約を再現します）
+func (m *MockUserMuteCreate) Save(_ context.Context) (*ent.UserMute, error) {
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	for _, ent_mute := range m.store.mutes {
+		if ent_mute.MuterID == m.mute.MuterID && ent_mute.MutedID == m.mute.MutedID {
+			return nil, fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	m.mute.ID = len(m.store.mutes) + 1
+	m.store.mutes = append(m.store.mutes, m.mute)
+	return m.mute, nil
+}
+
+// MockUserMuteQuery はモックのUserMuteQuery Builderです
+type MockUserMuteQuery struct {
+	store      *MockUserMuteEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserMuteQuery) Where(predicates ...any) UserMuteQueryInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// WithMuted はミュートされたユーザーのEager Loadingを指定します
+func (m *MockUserMuteQuery) WithMuted() UserMuteQueryInterface {
+	return m
+}
+
+// All は条件に一致する全てのミュートを返します（ミュートされたユーザーのEdgeも設定します）
+func (m *MockUserMuteQuery) All(_ context.Context) ([]*ent.UserMute, error) {
+	var mutes []*ent.UserMute
+
+	if m.store.should_return_database_error {
+		return nil, fmt.Errorf("connection refused")
+	}
+	mutes = []*ent.UserMute{}
+	for _, ent_mute := range m.store.mutes {
+		if !match_mock_predicates(build_user_mute_values(ent_mute), m.predicates) {
+			continue
+		}
+		for _, ent_user := range m.store.users {
+			if ent_user.ID == ent_mute.MutedID {
+				ent_mute.Edges.Muted = ent_user
+			}
+		}
+		mutes = append(mutes, ent_mute)
+	}
+	return mutes, nil
+}
+
+// Exist は条件に一致するミュートが存在するかを返します
+func (m *MockUserMuteQuery) Exist(_ context.Context) (bool, error) {
+	if m.store.should_return_database_error {
+		return false, fmt.Errorf("connection refused")
+	}
+	for _, ent_mute := range m.store.mutes {
+		if match_mock_predicates(build_user_mute_values(ent_mute), m.predicates) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockUserMuteDelete はモックのUserMuteDelete Builderです
+type MockUserMuteDelete struct {
+	store      *MockUserMuteEntClient
+	predicates []any
+}
+
+// Where は条件を追加します
+func (m *MockUserMuteDelete) Where(predicates ...any) UserMuteDeleteInterface {
+	m.predicates = append(m.predicates, predicates...)
+	return m
+}
+
+// Exec は条件に一致するミュートを削除し、削除件数を返します
+func (m *MockUserMuteDelete) Exec(_ context.Context) (int, error) {
+	var remaining []*ent.UserMute
+	var deleted int
+
+	if m.store.should_return_database_error {
+		return 0, fmt.Errorf("connection refused")
+	}
+	for _, ent_mute := range m.store.mutes {
+		if match_mock_predicates(build_user_mute_values(ent_mute), m.predicates) {
+			deleted++
+			continue
+		}
+		remaining = append(remaining, ent_mute)
+	}
+	m.store.mutes = remaining
+	return deleted, nil
+}
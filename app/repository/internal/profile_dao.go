@@ -0,0 +1,298 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// ProfileEntClientInterface はProfileDAOが利用するEnt Clientのインターフェースです
+type ProfileEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetProfileClient() ProfileClientInterface
+}
+
+// ProfileClientInterface はEnt Profile Clientのインターフェースです
+type ProfileClientInterface interface {
+	Create() ProfileCreateInterface
+	Query() ProfileQueryInterface
+	Update() ProfileUpdateInterface
+}
+
+// ProfileCreateInterface はEnt Profile Create Builderのインターフェースです
+type ProfileCreateInterface interface {
+	SetUserID(int) ProfileCreateInterface
+	SetDisplayName(string) ProfileCreateInterface
+	SetBio(string) ProfileCreateInterface
+	SetNillableAvatarURL(*string) ProfileCreateInterface
+	SetNillableHeightCm(*int) ProfileCreateInterface
+	SetFavoriteStyles([]string) ProfileCreateInterface
+	SetLinks([]string) ProfileCreateInterface
+	SetCreatedAt(time.Time) ProfileCreateInterface
+	SetUpdatedAt(time.Time) ProfileCreateInterface
+	Save(ctx context.Context) (*ent.Profile, error)
+}
+
+// ProfileQueryInterface はEnt Profile Query Builderのインターフェースです
+type ProfileQueryInterface interface {
+	Where(predicates ...any) ProfileQueryInterface
+	Only(ctx context.Context) (*ent.Profile, error)
+}
+
+// ProfileUpdateInterface はEnt Profile Update Builderのインターフェースです
+type ProfileUpdateInterface interface {
+	Where(predicates ...any) ProfileUpdateInterface
+	SetDisplayName(string) ProfileUpdateInterface
+	SetBio(string) ProfileUpdateInterface
+	SetAvatarURL(string) ProfileUpdateInterface
+	ClearAvatarURL() ProfileUpdateInterface
+	SetHeightCm(int) ProfileUpdateInterface
+	ClearHeightCm() ProfileUpdateInterface
+	SetFavoriteStyles([]string) ProfileUpdateInterface
+	SetLinks([]string) ProfileUpdateInterface
+	SetUpdatedAt(time.Time) ProfileUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// ProfileDAO はユーザーのプロフィールのデータアクセスオブジェクトです
+type ProfileDAO struct {
+	client ProfileEntClientInterface
+}
+
+// NewProfileDAO は新しいProfileDAOを作成します
+func NewProfileDAO(client ProfileEntClientInterface) *ProfileDAO {
+	return &ProfileDAO{
+		client: client,
+	}
+}
+
+// Create はプロフィールをDBに保存します
+// ユーザーのプロフィールが既に存在する場合はErrProfileAlreadyExistsを返します
+func (d *ProfileDAO) Create(ctx context.Context, profile *models.Profile) error {
+	var ent_user *ent.User
+	var avatar_url *string
+	var height_cm *int
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, profile.UserID())
+	if err != nil {
+		return err
+	}
+	avatar_url, height_cm = build_profile_nillable_values(profile)
+	_, err = d.client.GetProfileClient().
+		Create().
+		SetUserID(ent_user.ID).
+		SetDisplayName(profile.DisplayName().Value()).
+		SetBio(profile.Bio().Value()).
+		SetNillableAvatarURL(avatar_url).
+		SetNillableHeightCm(height_cm).
+		SetFavoriteStyles(build_favorite_style_values(profile.FavoriteStyles())).
+		SetLinks(build_profile_link_values(profile.Links())).
+		SetCreatedAt(profile.CreatedAt()).
+		SetUpdatedAt(profile.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return fmt.Errorf("%w: %s", domain_errors.ErrProfileAlreadyExists, profile.UserID())
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindByUserID はユーザーのプロフィールを検索します
+// プロフィールが存在しない場合はErrProfileNotFoundを返します
+func (d *ProfileDAO) FindByUserID(ctx context.Context, user_id uuid.UUID) (*models.Profile, error) {
+	var ent_user *ent.User
+	var ent_profile *ent.Profile
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ent_profile, err = d.client.GetProfileClient().
+		Query().
+		Where("user_id", ent_user.ID).
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, user_id)
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_profile_to_domain(user_id, ent_profile)
+}
+
+// Update はプロフィールの全ての項目を更新します
+// プロフィールが存在しない場合はErrProfileNotFoundを返します
+func (d *ProfileDAO) Update(ctx context.Context, profile *models.Profile) error {
+	var ent_user *ent.User
+	var builder ProfileUpdateInterface
+	var updated_count int
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, profile.UserID())
+	if err != nil {
+		return err
+	}
+	builder = d.client.GetProfileClient().
+		Update().
+		Where("user_id", ent_user.ID).
+		SetDisplayName(profile.DisplayName().Value()).
+		SetBio(profile.Bio().Value()).
+		SetFavoriteStyles(build_favorite_style_values(profile.FavoriteStyles())).
+		SetLinks(build_profile_link_values(profile.Links())).
+		SetUpdatedAt(profile.UpdatedAt())
+	if profile.AvatarURL() != nil {
+		builder = builder.SetAvatarURL(profile.AvatarURL().Value())
+	} else {
+		builder = builder.ClearAvatarURL()
+	}
+	if profile.Height() != nil {
+		builder = builder.SetHeightCm(profile.Height().Centimeters())
+	} else {
+		builder = builder.ClearHeightCm()
+	}
+	updated_count, err = builder.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, profile.UserID())
+	}
+	return nil
+}
+
+// find_ent_user は公開IDでプロフィールの所有者を検索します
+func (d *ProfileDAO) find_ent_user(ctx context.Context, user_id uuid.UUID) (*ent.User, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return ent_user, nil
+}
+
+// build_profile_nillable_values は未設定の場合にNULLとして保存する項目の値を返します
+func build_profile_nillable_values(profile *models.Profile) (*string, *int) {
+	var avatar_url *string
+	var height_cm *int
+
+	if profile.AvatarURL() != nil {
+		avatar_url = new(string)
+		*avatar_url = profile.AvatarURL().Value()
+	}
+	if profile.Height() != nil {
+		height_cm = new(int)
+		*height_cm = profile.Height().Centimeters()
+	}
+	return avatar_url, height_cm
+}
+
+// build_favorite_style_values は好きなスタイルを保存用の文字列に変換します
+func build_favorite_style_values(favorite_styles []models.FavoriteStyle) []string {
+	var values []string
+
+	values = make([]string, 0, len(favorite_styles))
+	for _, style := range favorite_styles {
+		values = append(values, style.Value())
+	}
+	return values
+}
+
+// build_profile_link_values はリンクを保存用の文字列に変換します
+func build_profile_link_values(links []models.ProfileURL) []string {
+	var values []string
+
+	values = make([]string, 0, len(links))
+	for _, link := range links {
+		values = append(values, link.Value())
+	}
+	return values
+}
+
+// convert_ent_profile_to_domain はEntのProfileをドメインモデルに変換します
+func convert_ent_profile_to_domain(user_id uuid.UUID, ent_profile *ent.Profile) (*models.Profile, error) {
+	var display_name models.DisplayName
+	var bio models.Bio
+	var avatar_url *models.ProfileURL
+	var height *models.Height
+	var favorite_styles []models.FavoriteStyle
+	var links []models.ProfileURL
+	var profile *models.Profile
+	var err error
+
+	display_name, err = models.NewDisplayName(ent_profile.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	bio, err = models.NewBio(ent_profile.Bio)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if ent_profile.AvatarURL != nil {
+		var parsed_avatar_url models.ProfileURL
+
+		parsed_avatar_url, err = models.NewProfileURL(*ent_profile.AvatarURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		avatar_url = &parsed_avatar_url
+	}
+	if ent_profile.HeightCm != nil {
+		var parsed_height models.Height
+
+		parsed_height, err = models.NewHeight(*ent_profile.HeightCm)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		height = &parsed_height
+	}
+	favorite_styles = make([]models.FavoriteStyle, 0, len(ent_profile.FavoriteStyles))
+	for _, value := range ent_profile.FavoriteStyles {
+		var style models.FavoriteStyle
+
+		style, err = models.NewFavoriteStyle(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		favorite_styles = append(favorite_styles, style)
+	}
+	links = make([]models.ProfileURL, 0, len(ent_profile.Links))
+	for _, value := range ent_profile.Links {
+		var link models.ProfileURL
+
+		link, err = models.NewProfileURL(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		links = append(links, link)
+	}
+	profile, err = models.NewProfileWithState(
+		user_id,
+		display_name,
+		bio,
+		avatar_url,
+		height,
+		favorite_styles,
+		links,
+		ent_profile.CreatedAt,
+		ent_profile.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return profile, nil
+}
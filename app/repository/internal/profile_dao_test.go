@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_profile はテスト用のプロフィールを作成します
+func create_test_profile(t *testing.T, user_id uuid.UUID, display_name string) *models.Profile {
+	var name models.DisplayName
+	var profile *models.Profile
+	var err error
+
+	t.Helper()
+	name, err = models.NewDisplayName(display_name)
+	if err != nil {
+		t.Fatalf("failed to create display name: %v", err)
+	}
+	profile, err = models.NewProfile(user_id, name)
+	if err != nil {
+		t.Fatalf("failed to create profile: %v", err)
+	}
+	return profile
+}
+
+// TestProfileDAO_Create はプロフィールの保存と検索をテストします
+func TestProfileDAO_Create(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *ProfileDAO
+	var profile *models.Profile
+	var style models.FavoriteStyle
+	var found_profile *models.Profile
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewProfileDAO(NewMockProfileEntClient(user_id))
+	profile = create_test_profile(t, user_id, "taro")
+	style, _ = models.NewFavoriteStyle("casual")
+	_ = profile.ReplaceFavoriteStyles([]models.FavoriteStyle{style})
+	err = dao.Create(ctx, profile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_profile, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_profile.DisplayName().Value() != "taro" {
+		t.Errorf("expected display name taro, got %s", found_profile.DisplayName().Value())
+	}
+	if len(found_profile.FavoriteStyles()) != 1 || found_profile.FavoriteStyles()[0].Value() != "casual" {
+		t.Errorf("expected favorite styles [casual], got %v", found_profile.FavoriteStyles())
+	}
+	if found_profile.AvatarURL() != nil || found_profile.Height() != nil {
+		t.Error("expected avatar_url and height to be unset")
+	}
+
+	err = dao.Create(ctx, create_test_profile(t, user_id, "jiro"))
+	if !errors.Is(err, domain_errors.ErrProfileAlreadyExists) {
+		t.Errorf("expected ErrProfileAlreadyExists, got %v", err)
+	}
+}
+
+// TestProfileDAO_Update はプロフィールの更新と任意項目のクリアをテストします
+func TestProfileDAO_Update(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *ProfileDAO
+	var profile *models.Profile
+	var avatar_url models.ProfileURL
+	var height models.Height
+	var found_profile *models.Profile
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewProfileDAO(NewMockProfileEntClient(user_id))
+	profile = create_test_profile(t, user_id, "taro")
+	err = dao.Create(ctx, profile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	avatar_url, _ = models.NewProfileURL("https://example.com/avatar.png")
+	height, _ = models.NewHeight(172)
+	profile.ChangeAvatarURL(&avatar_url)
+	profile.ChangeHeight(&height)
+	err = dao.Update(ctx, profile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_profile, _ = dao.FindByUserID(ctx, user_id)
+	if found_profile.AvatarURL() == nil || found_profile.AvatarURL().Value() != avatar_url.Value() {
+		t.Errorf("expected avatar_url %s, got %v", avatar_url.Value(), found_profile.AvatarURL())
+	}
+	if found_profile.Height() == nil || found_profile.Height().Centimeters() != 172 {
+		t.Errorf("expected height 172, got %v", found_profile.Height())
+	}
+
+	profile.ChangeAvatarURL(nil)
+	profile.ChangeHeight(nil)
+	err = dao.Update(ctx, profile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_profile, _ = dao.FindByUserID(ctx, user_id)
+	if found_profile.AvatarURL() != nil || found_profile.Height() != nil {
+		t.Error("expected avatar_url and height to be cleared")
+	}
+}
+
+// TestProfileDAO_FindByUserID_NotFound はプロフィールが存在しないケースをテストします
+func TestProfileDAO_FindByUserID_NotFound(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *ProfileDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewProfileDAO(NewMockProfileEntClient(user_id))
+	_, err = dao.FindByUserID(ctx, user_id)
+	if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+	err = dao.Update(ctx, create_test_profile(t, user_id, "taro"))
+	if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+}
+
+// TestProfileDAO_Create_DatabaseError はDBエラーのケースをテストします
+func TestProfileDAO_Create_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *ProfileDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewProfileDAO(NewMockProfileEntClientWithDatabaseError(user_id))
+	err = dao.Create(ctx, create_test_profile(t, user_id, "taro"))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
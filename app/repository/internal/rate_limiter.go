@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rate_limit_window はキーごとの現在のウィンドウの状態です
+type rate_limit_window struct {
+	count      int
+	started_at time.Time
+}
+
+// FixedWindowRateLimiter はキーごとに一定期間内の実行回数を制限するインメモリのレートリミッターです
+// 状態はインスタンスごとに保持されるため、複数インスタンス構成では上限はインスタンス数倍まで緩くなります
+type FixedWindowRateLimiter struct {
+	limit   int
+	window  time.Duration
+	now     func() time.Time
+	mutex   sync.Mutex
+	windows map[string]rate_limit_window
+}
+
+// NewFixedWindowRateLimiter は新しいFixedWindowRateLimiterを作成します
+func NewFixedWindowRateLimiter(limit int, window time.Duration) *FixedWindowRateLimiter {
+	return &FixedWindowRateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		windows: map[string]rate_limit_window{},
+	}
+}
+
+// Allow は実行を許可するかを判定し、許可した場合は回数を記録します
+func (l *FixedWindowRateLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	var now time.Time
+	var current rate_limit_window
+	var is_found bool
+
+	now = l.now()
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	current, is_found = l.windows[key]
+	if !is_found || !now.Before(current.started_at.Add(l.window)) {
+		l.remove_expired(now)
+		current = rate_limit_window{count: 0, started_at: now}
+	}
+	if current.count >= l.limit {
+		return false, nil
+	}
+	current.count++
+	l.windows[key] = current
+	return true, nil
+}
+
+// remove_expired は期間が終了したウィンドウを削除します（mutexを取得した状態で呼び出すこと）
+func (l *FixedWindowRateLimiter) remove_expired(now time.Time) {
+	for key, current := range l.windows {
+		if !now.Before(current.started_at.Add(l.window)) {
+			delete(l.windows, key)
+		}
+	}
+}
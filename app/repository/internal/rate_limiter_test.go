@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestFixedWindowRateLimiter_Allow は上限までは許可し、上限を超えると拒否することをテストします
+func TestFixedWindowRateLimiter_Allow(t *testing.T) {
+	var ctx context.Context
+	var limiter *FixedWindowRateLimiter
+	var is_allowed bool
+	var err error
+
+	ctx = context.Background()
+	limiter = NewFixedWindowRateLimiter(2, time.Hour)
+	for i := 0; i < 2; i++ {
+		is_allowed, err = limiter.Allow(ctx, "key_1")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !is_allowed {
+			t.Errorf("expected request %d to be allowed", i+1)
+		}
+	}
+	is_allowed, _ = limiter.Allow(ctx, "key_1")
+	if is_allowed {
+		t.Error("expected request over the limit to be rejected")
+	}
+	// 別のキーには影響しない
+	is_allowed, _ = limiter.Allow(ctx, "key_2")
+	if !is_allowed {
+		t.Error("expected request for another key to be allowed")
+	}
+}
+
+// TestFixedWindowRateLimiter_WindowExpired はウィンドウの期間が終了すると再び許可することをテストします
+func TestFixedWindowRateLimiter_WindowExpired(t *testing.T) {
+	var ctx context.Context
+	var limiter *FixedWindowRateLimiter
+	var now time.Time
+	var is_allowed bool
+
+	ctx = context.Background()
+	limiter = NewFixedWindowRateLimiter(1, time.Hour)
+	now = time.Now()
+	limiter.now = func() time.Time { return now }
+	is_allowed, _ = limiter.Allow(ctx, "key_1")
+	if !is_allowed {
+		t.Fatal("expected first request to be allowed")
+	}
+	is_allowed, _ = limiter.Allow(ctx, "key_1")
+	if is_allowed {
+		t.Error("expected second request to be rejected")
+	}
+	now = now.Add(time.Hour)
+	is_allowed, _ = limiter.Allow(ctx, "key_1")
+	if !is_allowed {
+		t.Error("expected request after the window to be allowed")
+	}
+	if len(limiter.windows) != 1 {
+		t.Errorf("expected expired windows to be removed, got %d", len(limiter.windows))
+	}
+}
@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenEntClientInterface はRefreshTokenDAOが利用するEnt Clientのインターフェースです
+type RefreshTokenEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetRefreshTokenClient() RefreshTokenClientInterface
+}
+
+// RefreshTokenClientInterface はEnt RefreshToken Clientのインターフェースです
+type RefreshTokenClientInterface interface {
+	Create() RefreshTokenCreateInterface
+	Query() RefreshTokenQueryInterface
+	Update() RefreshTokenUpdateInterface
+}
+
+// RefreshTokenCreateInterface はEnt RefreshToken Create Builderのインターフェースです
+type RefreshTokenCreateInterface interface {
+	SetTokenID(string) RefreshTokenCreateInterface
+	SetFamilyID(uuid.UUID) RefreshTokenCreateInterface
+	SetUserID(int) RefreshTokenCreateInterface
+	SetExpiresAt(time.Time) RefreshTokenCreateInterface
+	Save(ctx context.Context) (*ent.RefreshToken, error)
+}
+
+// RefreshTokenQueryInterface はEnt RefreshToken Query Builderのインターフェースです
+type RefreshTokenQueryInterface interface {
+	Where(predicates ...any) RefreshTokenQueryInterface
+	WithUser() RefreshTokenQueryInterface
+	Only(ctx context.Context) (*ent.RefreshToken, error)
+	All(ctx context.Context) ([]*ent.RefreshToken, error)
+}
+
+// RefreshTokenUpdateInterface はEnt RefreshToken Update Builderのインターフェースです
+type RefreshTokenUpdateInterface interface {
+	Where(predicates ...any) RefreshTokenUpdateInterface
+	SetRotatedAt(time.Time) RefreshTokenUpdateInterface
+	SetRevokedAt(time.Time) RefreshTokenUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// RefreshTokenDAO はリフレッシュトークンのデータアクセスオブジェクトです
+type RefreshTokenDAO struct {
+	client RefreshTokenEntClientInterface
+}
+
+// NewRefreshTokenDAO は新しいRefreshTokenDAOを作成します
+func NewRefreshTokenDAO(client RefreshTokenEntClientInterface) *RefreshTokenDAO {
+	return &RefreshTokenDAO{
+		client: client,
+	}
+}
+
+// Save はリフレッシュトークンをDBに保存します
+func (d *RefreshTokenDAO) Save(ctx context.Context, token *models.RefreshToken) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", token.UserID()).
+		Only(ctx)
+	if err != nil {
+		return handle_query_error(err)
+	}
+	_, err = d.client.GetRefreshTokenClient().
+		Create().
+		SetTokenID(token.TokenID()).
+		SetFamilyID(token.FamilyID()).
+		SetUserID(ent_user.ID).
+		SetExpiresAt(token.ExpiresAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindByTokenID はjtiでリフレッシュトークンを検索します
+func (d *RefreshTokenDAO) FindByTokenID(ctx context.Context, token_id string) (*models.RefreshToken, error) {
+	var ent_token *ent.RefreshToken
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_token, err = d.client.GetRefreshTokenClient().
+		Query().
+		Where("token_id", token_id).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, fmt.Errorf("%w: unknown token", domain_errors.ErrInvalidRefreshToken)
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_refresh_token_to_domain(ent_token)
+}
+
+// MarkRotated は未使用かつ未失効のトークンを使用済みにします
+// 既に使用済み・失効済みで更新されなかった場合はfalseを返します
+func (d *RefreshTokenDAO) MarkRotated(ctx context.Context, token_id string, rotated_at time.Time) (bool, error) {
+	var affected_count int
+	var err error
+
+	// 条件付きUPDATEにすることで、同一トークンの並行リフレッシュでも1件しか成功しない
+	affected_count, err = d.client.GetRefreshTokenClient().
+		Update().
+		Where("token_id", token_id, "rotated_at", nil, "revoked_at", nil).
+		SetRotatedAt(rotated_at).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return affected_count == 1, nil
+}
+
+// RevokeFamily はトークンファミリーに属する未失効のトークンを全て失効させます
+func (d *RefreshTokenDAO) RevokeFamily(ctx context.Context, family_id uuid.UUID, revoked_at time.Time) error {
+	var err error
+
+	_, err = d.client.GetRefreshTokenClient().
+		Update().
+		Where("family_id", family_id, "revoked_at", nil).
+		SetRevokedAt(revoked_at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindActiveFamilyIDsByUserID はユーザーの未失効のトークンファミリーIDを重複なく返します
+func (d *RefreshTokenDAO) FindActiveFamilyIDsByUserID(ctx context.Context, user_id uuid.UUID) ([]uuid.UUID, error) {
+	var ent_user *ent.User
+	var ent_tokens []*ent.RefreshToken
+	var family_ids []uuid.UUID
+	var seen map[uuid.UUID]bool
+	var err error
+
+	// 退会時は論理削除の後にセッションを失効させるため、論理削除されたユーザーも対象にする
+	ctx = with_deleted_users(ctx)
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	ent_tokens, err = d.client.GetRefreshTokenClient().
+		Query().
+		Where("user_id", ent_user.ID, "revoked_at", nil).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	family_ids = []uuid.UUID{}
+	seen = map[uuid.UUID]bool{}
+	for _, ent_token := range ent_tokens {
+		if seen[ent_token.FamilyID] {
+			continue
+		}
+		seen[ent_token.FamilyID] = true
+		family_ids = append(family_ids, ent_token.FamilyID)
+	}
+	return family_ids, nil
+}
+
+// convert_ent_refresh_token_to_domain はEntのRefreshTokenエンティティをドメインモデルに変換します
+func convert_ent_refresh_token_to_domain(ent_token *ent.RefreshToken) (*models.RefreshToken, error) {
+	var ent_user *ent.User
+	var domain_token *models.RefreshToken
+	var err error
+
+	ent_user, err = ent_token.Edges.UserOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	domain_token, err = models.NewRefreshTokenWithStatus(
+		ent_token.TokenID,
+		ent_token.FamilyID,
+		ent_user.PublicID,
+		ent_token.ExpiresAt,
+		ent_token.RotatedAt,
+		ent_token.RevokedAt,
+		ent_token.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return domain_token, nil
+}
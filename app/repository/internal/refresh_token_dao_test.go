@@ -0,0 +1,233 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_refresh_token はテスト用のリフレッシュトークンを作成します
+func create_test_refresh_token(t *testing.T, family_id uuid.UUID, user_id uuid.UUID) *models.RefreshToken {
+	var token *models.RefreshToken
+	var err error
+
+	t.Helper()
+	token, err = models.NewRefreshToken(uuid.NewString(), family_id, user_id, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create refresh token: %v", err)
+	}
+	return token
+}
+
+// TestRefreshTokenDAO_Save_Success はリフレッシュトークンの保存が成功するケースをテストします
+func TestRefreshTokenDAO_Save_Success(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockRefreshTokenEntClient
+	var dao *RefreshTokenDAO
+	var token *models.RefreshToken
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockRefreshTokenEntClient(user_id)
+	dao = NewRefreshTokenDAO(client)
+	token = create_test_refresh_token(t, uuid.New(), user_id)
+	err = dao.Save(ctx, token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Tokens()) != 1 {
+		t.Fatalf("expected 1 stored token, got %d", len(client.Tokens()))
+	}
+	if client.Tokens()[0].TokenID != token.TokenID() {
+		t.Errorf("expected token_id %s, got %s", token.TokenID(), client.Tokens()[0].TokenID)
+	}
+}
+
+// TestRefreshTokenDAO_Save_DatabaseError はDBエラー時にエラーを返すケースをテストします
+func TestRefreshTokenDAO_Save_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *RefreshTokenDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClientWithDatabaseError(user_id))
+	err = dao.Save(ctx, create_test_refresh_token(t, uuid.New(), user_id))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
+
+// TestRefreshTokenDAO_FindByTokenID_Success はjtiでリフレッシュトークンを取得するケースをテストします
+func TestRefreshTokenDAO_FindByTokenID_Success(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *RefreshTokenDAO
+	var token *models.RefreshToken
+	var found_token *models.RefreshToken
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClient(user_id))
+	token = create_test_refresh_token(t, uuid.New(), user_id)
+	err = dao.Save(ctx, token)
+	if err != nil {
+		t.Fatalf("failed to save token: %v", err)
+	}
+	found_token, err = dao.FindByTokenID(ctx, token.TokenID())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_token.FamilyID() != token.FamilyID() {
+		t.Errorf("expected family_id %s, got %s", token.FamilyID(), found_token.FamilyID())
+	}
+	if found_token.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, found_token.UserID())
+	}
+}
+
+// TestRefreshTokenDAO_FindByTokenID_NotFound は未登録のjtiでエラーを返すケースをテストします
+func TestRefreshTokenDAO_FindByTokenID_NotFound(t *testing.T) {
+	var ctx context.Context
+	var dao *RefreshTokenDAO
+	var err error
+
+	ctx = context.Background()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClient(uuid.New()))
+	_, err = dao.FindByTokenID(ctx, uuid.NewString())
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+}
+
+// TestRefreshTokenDAO_MarkRotated_OnlyOnce は同じトークンが一度しかローテーションできないことをテストします
+func TestRefreshTokenDAO_MarkRotated_OnlyOnce(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *RefreshTokenDAO
+	var token *models.RefreshToken
+	var is_rotated bool
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClient(user_id))
+	token = create_test_refresh_token(t, uuid.New(), user_id)
+	err = dao.Save(ctx, token)
+	if err != nil {
+		t.Fatalf("failed to save token: %v", err)
+	}
+	is_rotated, err = dao.MarkRotated(ctx, token.TokenID(), time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !is_rotated {
+		t.Error("expected first rotation to succeed")
+	}
+	is_rotated, err = dao.MarkRotated(ctx, token.TokenID(), time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if is_rotated {
+		t.Error("expected second rotation to fail")
+	}
+}
+
+// TestRefreshTokenDAO_RevokeFamily はファミリー内の全トークンが失効することをテストします
+func TestRefreshTokenDAO_RevokeFamily(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var family_id uuid.UUID
+	var dao *RefreshTokenDAO
+	var tokens []*models.RefreshToken
+	var other_token *models.RefreshToken
+	var found_token *models.RefreshToken
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	family_id = uuid.New()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClient(user_id))
+	tokens = []*models.RefreshToken{
+		create_test_refresh_token(t, family_id, user_id),
+		create_test_refresh_token(t, family_id, user_id),
+	}
+	other_token = create_test_refresh_token(t, uuid.New(), user_id)
+	for _, token := range append(tokens, other_token) {
+		err = dao.Save(ctx, token)
+		if err != nil {
+			t.Fatalf("failed to save token: %v", err)
+		}
+	}
+	err = dao.RevokeFamily(ctx, family_id, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, token := range tokens {
+		found_token, err = dao.FindByTokenID(ctx, token.TokenID())
+		if err != nil {
+			t.Fatalf("failed to find token: %v", err)
+		}
+		if !found_token.IsRevoked() {
+			t.Errorf("expected token %s to be revoked", token.TokenID())
+		}
+	}
+	found_token, err = dao.FindByTokenID(ctx, other_token.TokenID())
+	if err != nil {
+		t.Fatalf("failed to find token: %v", err)
+	}
+	if found_token.IsRevoked() {
+		t.Error("expected token of another family to not be revoked")
+	}
+}
+
+// TestRefreshTokenDAO_FindActiveFamilyIDsByUserID は未失効のファミリーIDのみを返すことをテストします
+func TestRefreshTokenDAO_FindActiveFamilyIDsByUserID(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var active_family_id uuid.UUID
+	var revoked_family_id uuid.UUID
+	var dao *RefreshTokenDAO
+	var family_ids []uuid.UUID
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	active_family_id = uuid.New()
+	revoked_family_id = uuid.New()
+	dao = NewRefreshTokenDAO(NewMockRefreshTokenEntClient(user_id))
+	for _, token := range []*models.RefreshToken{
+		create_test_refresh_token(t, active_family_id, user_id),
+		create_test_refresh_token(t, active_family_id, user_id),
+		create_test_refresh_token(t, revoked_family_id, user_id),
+	} {
+		err = dao.Save(ctx, token)
+		if err != nil {
+			t.Fatalf("failed to save token: %v", err)
+		}
+	}
+	err = dao.RevokeFamily(ctx, revoked_family_id, time.Now())
+	if err != nil {
+		t.Fatalf("failed to revoke family: %v", err)
+	}
+	family_ids, err = dao.FindActiveFamilyIDsByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(family_ids) != 1 {
+		t.Fatalf("expected 1 family, got %d", len(family_ids))
+	}
+	if family_ids[0] != active_family_id {
+		t.Errorf("expected family_id %s, got %s", active_family_id, family_ids[0])
+	}
+}
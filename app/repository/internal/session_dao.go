@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/session"
+
+	"github.com/google/uuid"
+)
+
+// SessionEntClientInterface はSessionDAOが利用するEnt Clientのインターフェースです
+type SessionEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetSessionClient() SessionClientInterface
+}
+
+// SessionClientInterface はEnt Session Clientのインターフェースです
+type SessionClientInterface interface {
+	Create() SessionCreateInterface
+	Query() SessionQueryInterface
+	Update() SessionUpdateInterface
+}
+
+// SessionCreateInterface はEnt Session Create Builderのインターフェースです
+type SessionCreateInterface interface {
+	SetSessionID(uuid.UUID) SessionCreateInterface
+	SetUserID(int) SessionCreateInterface
+	SetDeviceName(string) SessionCreateInterface
+	SetPlatform(session.Platform) SessionCreateInterface
+	SetIPAddress(string) SessionCreateInterface
+	SetUserAgent(string) SessionCreateInterface
+	SetLastSeenAt(time.Time) SessionCreateInterface
+	SetExpiresAt(time.Time) SessionCreateInterface
+	SetCreatedAt(time.Time) SessionCreateInterface
+	Save(ctx context.Context) (*ent.Session, error)
+}
+
+// SessionQueryInterface はEnt Session Query Builderのインターフェースです
+type SessionQueryInterface interface {
+	Where(predicates ...any) SessionQueryInterface
+	// ActiveAt は指定時刻に有効期限内のセッションに絞り込み、最終利用日時の新しい順に並べます
+	ActiveAt(now time.Time) SessionQueryInterface
+	WithUser() SessionQueryInterface
+	Only(ctx context.Context) (*ent.Session, error)
+	All(ctx context.Context) ([]*ent.Session, error)
+}
+
+// SessionUpdateInterface はEnt Session Update Builderのインターフェースです
+type SessionUpdateInterface interface {
+	Where(predicates ...any) SessionUpdateInterface
+	SetLastSeenAt(time.Time) SessionUpdateInterface
+	SetExpiresAt(time.Time) SessionUpdateInterface
+	SetRevokedAt(time.Time) SessionUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// SessionDAO はログイン中の端末（セッション）のデータアクセスオブジェクトです
+type SessionDAO struct {
+	client SessionEntClientInterface
+}
+
+// NewSessionDAO は新しいSessionDAOを作成します
+func NewSessionDAO(client SessionEntClientInterface) *SessionDAO {
+	return &SessionDAO{
+		client: client,
+	}
+}
+
+// Save はセッションをDBに保存します
+func (d *SessionDAO) Save(ctx context.Context, domain_session *models.Session) error {
+	var ent_user *ent.User
+	var device models.SessionDevice
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", domain_session.UserID()).
+		Only(ctx)
+	if err != nil {
+		return handle_query_error(err)
+	}
+	device = domain_session.Device()
+	_, err = d.client.GetSessionClient().
+		Create().
+		SetSessionID(domain_session.SessionID()).
+		SetUserID(ent_user.ID).
+		SetDeviceName(device.Name()).
+		SetPlatform(session.Platform(device.Platform().String())).
+		SetIPAddress(device.IPAddress()).
+		SetUserAgent(device.UserAgent()).
+		SetLastSeenAt(domain_session.LastSeenAt()).
+		SetExpiresAt(domain_session.ExpiresAt()).
+		SetCreatedAt(domain_session.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindBySessionID はセッションIDでセッションを検索します
+// セッションが存在しない場合はErrSessionNotFoundを返します
+func (d *SessionDAO) FindBySessionID(ctx context.Context, session_id uuid.UUID) (*models.Session, error) {
+	var ent_session *ent.Session
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_session, err = d.client.GetSessionClient().
+		Query().
+		Where("session_id", session_id).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrSessionNotFound, session_id.String())
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_session_to_domain(ent_session)
+}
+
+// FindActiveByUserID はユーザーの未失効かつ有効期限内のセッションを最終利用日時の新しい順に返します
+func (d *SessionDAO) FindActiveByUserID(ctx context.Context, user_id uuid.UUID, now time.Time) ([]*models.Session, error) {
+	var ent_user *ent.User
+	var ent_sessions []*ent.Session
+	var sessions []*models.Session
+	var domain_session *models.Session
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	ctx = with_deleted_users(ctx)
+	ent_sessions, err = d.client.GetSessionClient().
+		Query().
+		Where("user_id", ent_user.ID, "revoked_at", nil).
+		ActiveAt(now).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	sessions = make([]*models.Session, 0, len(ent_sessions))
+	for _, ent_session := range ent_sessions {
+		domain_session, err = convert_ent_session_to_domain(ent_session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, domain_session)
+	}
+	return sessions, nil
+}
+
+// Touch はトークンのリフレッシュ時に未失効のセッションの最終利用日時と有効期限を更新します
+func (d *SessionDAO) Touch(ctx context.Context, session_id uuid.UUID, last_seen_at time.Time, expires_at time.Time) error {
+	var err error
+
+	_, err = d.client.GetSessionClient().
+		Update().
+		Where("session_id", session_id, "revoked_at", nil).
+		SetLastSeenAt(last_seen_at).
+		SetExpiresAt(expires_at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// Revoke は未失効のセッションを失効させます（既に失効済みの場合は何もしません）
+func (d *SessionDAO) Revoke(ctx context.Context, session_id uuid.UUID, revoked_at time.Time) error {
+	var err error
+
+	_, err = d.client.GetSessionClient().
+		Update().
+		Where("session_id", session_id, "revoked_at", nil).
+		SetRevokedAt(revoked_at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// convert_ent_session_to_domain はEntのSessionエンティティをドメインモデルに変換します
+func convert_ent_session_to_domain(ent_session *ent.Session) (*models.Session, error) {
+	var ent_user *ent.User
+	var platform models.SessionPlatform
+	var domain_session *models.Session
+	var err error
+
+	ent_user, err = ent_session.Edges.UserOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	platform, err = models.NewSessionPlatform(ent_session.Platform.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	domain_session, err = models.NewSessionWithStatus(
+		ent_session.SessionID,
+		ent_user.PublicID,
+		models.NewSessionDeviceWithPlatform(ent_session.DeviceName, platform, ent_session.IPAddress, ent_session.UserAgent),
+		ent_session.LastSeenAt,
+		ent_session.ExpiresAt,
+		ent_session.RevokedAt,
+		ent_session.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return domain_session, nil
+}
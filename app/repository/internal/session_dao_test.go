@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_session はテスト用のセッションを作成します
+func create_test_session(t *testing.T, user_id uuid.UUID, now time.Time) *models.Session {
+	var domain_session *models.Session
+	var err error
+
+	t.Helper()
+	domain_session, err = models.NewSession(
+		uuid.New(), user_id, models.NewSessionDevice("My iPhone", "ios", "192.0.2.1", "SleeveApp/1.0"), now, now.Add(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	return domain_session
+}
+
+// TestSessionDAO_SaveAndFindBySessionID はセッションの保存と検索が成功するケースをテストします
+func TestSessionDAO_SaveAndFindBySessionID(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *SessionDAO
+	var saved_session *models.Session
+	var found_session *models.Session
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewSessionDAO(NewMockSessionEntClient(user_id))
+	saved_session = create_test_session(t, user_id, time.Now())
+	err = dao.Save(ctx, saved_session)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_session, err = dao.FindBySessionID(ctx, saved_session.SessionID())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_session.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, found_session.UserID())
+	}
+	if found_session.Device().Platform() != models.SessionPlatformIOS || found_session.Device().IPAddress() != "192.0.2.1" {
+		t.Errorf("unexpected device: %+v", found_session.Device())
+	}
+}
+
+// TestSessionDAO_FindBySessionID_NotFound はセッションが存在しないケースをテストします
+func TestSessionDAO_FindBySessionID_NotFound(t *testing.T) {
+	var dao *SessionDAO
+	var err error
+
+	dao = NewSessionDAO(NewMockSessionEntClient(uuid.New()))
+	_, err = dao.FindBySessionID(context.Background(), uuid.New())
+	if !errors.Is(err, domain_errors.ErrSessionNotFound) {
+		t.Errorf("expected ErrSessionNotFound, got %v", err)
+	}
+}
+
+// TestSessionDAO_FindActiveByUserID は失効済み・期限切れのセッションを除き、最終利用日時の新しい順に返すことをテストします
+func TestSessionDAO_FindActiveByUserID(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *SessionDAO
+	var now time.Time
+	var old_session *models.Session
+	var recent_session *models.Session
+	var revoked_session *models.Session
+	var expired_session *models.Session
+	var sessions []*models.Session
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewSessionDAO(NewMockSessionEntClient(user_id))
+	now = time.Now()
+	old_session = create_test_session(t, user_id, now.Add(-10*time.Minute))
+	recent_session = create_test_session(t, user_id, now.Add(-10*time.Minute))
+	revoked_session = create_test_session(t, user_id, now)
+	expired_session = create_test_session(t, user_id, now.Add(-2*time.Hour))
+	for _, domain_session := range []*models.Session{old_session, recent_session, revoked_session, expired_session} {
+		err = dao.Save(ctx, domain_session)
+		if err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+	}
+	err = dao.Touch(ctx, recent_session.SessionID(), now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Revoke(ctx, revoked_session.SessionID(), now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	sessions, err = dao.FindActiveByUserID(ctx, user_id, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 active sessions, got %d", len(sessions))
+	}
+	if sessions[0].SessionID() != recent_session.SessionID() || sessions[1].SessionID() != old_session.SessionID() {
+		t.Error("expected sessions to be ordered by last_seen_at desc")
+	}
+}
+
+// TestSessionDAO_Save_DatabaseError はDBエラーのケースをテストします
+func TestSessionDAO_Save_DatabaseError(t *testing.T) {
+	var user_id uuid.UUID
+	var dao *SessionDAO
+	var err error
+
+	user_id = uuid.New()
+	dao = NewSessionDAO(NewMockSessionEntClientWithDatabaseError(user_id))
+	err = dao.Save(context.Background(), create_test_session(t, user_id, time.Now()))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// DefaultDenylistNegativeCacheTTL は「未登録」の判定結果をキャッシュする期間です
+// 他インスタンスでのログアウトは最大この期間だけ反映が遅れます
+const DefaultDenylistNegativeCacheTTL = 30 * time.Second
+
+// TokenDenylistStoreInterface はdenylistの永続化先のインターフェースです
+type TokenDenylistStoreInterface interface {
+	Add(ctx context.Context, entry *models.DenylistedToken) error
+	Exists(ctx context.Context, kind models.DenylistKind, value string) (bool, error)
+}
+
+// denylist_cache_entry はキャッシュされた判定結果です
+type denylist_cache_entry struct {
+	is_denylisted bool
+	cached_until  time.Time
+}
+
+// TokenDenylistCache はdenylistの前段に置くTTL付きのインメモリキャッシュです
+// 登録済みの判定は登録の有効期限まで、未登録の判定はnegative_ttlの間キャッシュします
+// 参照されなくなった判定が残り続けないよう、登録時にnegative_ttlごとに期限切れの判定をまとめて削除します
+type TokenDenylistCache struct {
+	store        TokenDenylistStoreInterface
+	negative_ttl time.Duration
+	now          func() time.Time
+	mutex        sync.Mutex
+	entries      map[string]denylist_cache_entry
+	next_sweep   time.Time
+}
+
+// NewTokenDenylistCache は新しいTokenDenylistCacheを作成します
+func NewTokenDenylistCache(store TokenDenylistStoreInterface, negative_ttl time.Duration) *TokenDenylistCache {
+	return &TokenDenylistCache{
+		store:        store,
+		negative_ttl: negative_ttl,
+		now:          time.Now,
+		entries:      map[string]denylist_cache_entry{},
+	}
+}
+
+// Add はdenylistに登録し、キャッシュにも反映します
+func (c *TokenDenylistCache) Add(ctx context.Context, entry *models.DenylistedToken) error {
+	var err error
+
+	err = c.store.Add(ctx, entry)
+	if err != nil {
+		return err
+	}
+	c.set(build_denylist_cache_key(entry.Kind(), entry.Value()), true, entry.ExpiresAt())
+	return nil
+}
+
+// IsDenylisted はjtiまたはsidのいずれかがdenylistに登録されているかを返します
+func (c *TokenDenylistCache) IsDenylisted(ctx context.Context, token_id, session_id string) (bool, error) {
+	var is_denylisted bool
+	var err error
+
+	if token_id != "" {
+		is_denylisted, err = c.exists(ctx, models.DenylistKindToken, token_id)
+		if err != nil || is_denylisted {
+			return is_denylisted, err
+		}
+	}
+	if session_id != "" {
+		return c.exists(ctx, models.DenylistKindSession, session_id)
+	}
+	return false, nil
+}
+
+// exists はキャッシュを参照し、キャッシュにない場合は永続化先に問い合わせます
+func (c *TokenDenylistCache) exists(ctx context.Context, kind models.DenylistKind, value string) (bool, error) {
+	var key string
+	var cached denylist_cache_entry
+	var is_cached bool
+	var is_denylisted bool
+	var err error
+
+	key = build_denylist_cache_key(kind, value)
+	cached, is_cached = c.get(key)
+	if is_cached {
+		return cached.is_denylisted, nil
+	}
+	is_denylisted, err = c.store.Exists(ctx, kind, value)
+	if err != nil {
+		return false, err
+	}
+	// 永続化先からは登録の有効期限を取得しないため、登録済みの場合も同じ期間だけキャッシュする
+	c.set(key, is_denylisted, c.now().Add(c.negative_ttl))
+	return is_denylisted, nil
+}
+
+// get は有効期限内のキャッシュを返します
+func (c *TokenDenylistCache) get(key string) (denylist_cache_entry, bool) {
+	var cached denylist_cache_entry
+	var is_cached bool
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	cached, is_cached = c.entries[key]
+	if !is_cached {
+		return denylist_cache_entry{}, false
+	}
+	if !c.now().Before(cached.cached_until) {
+		delete(c.entries, key)
+		return denylist_cache_entry{}, false
+	}
+	return cached, true
+}
+
+// set はキャッシュを登録します
+func (c *TokenDenylistCache) set(key string, is_denylisted bool, cached_until time.Time) {
+	var now time.Time
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now = c.now()
+	if !now.Before(c.next_sweep) {
+		c.sweep(now)
+		c.next_sweep = now.Add(c.negative_ttl)
+	}
+	c.entries[key] = denylist_cache_entry{
+		is_denylisted: is_denylisted,
+		cached_until:  cached_until,
+	}
+}
+
+// sweep は期限切れのキャッシュを削除します（呼び出し元でロックを取得してください）
+func (c *TokenDenylistCache) sweep(now time.Time) {
+	for key, cached := range c.entries {
+		if !now.Before(cached.cached_until) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// build_denylist_cache_key はキャッシュのキーを作成します
+func build_denylist_cache_key(kind models.DenylistKind, value string) string {
+	return string(kind) + ":" + value
+}
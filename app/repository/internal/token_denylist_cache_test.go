@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// TestTokenDenylistCache_IsDenylisted_CachesResult は判定結果がキャッシュされることをテストします
+func TestTokenDenylistCache_IsDenylisted_CachesResult(t *testing.T) {
+	var ctx context.Context
+	var client *MockTokenDenylistEntClient
+	var cache *TokenDenylistCache
+	var is_denylisted bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockTokenDenylistEntClient()
+	cache = NewTokenDenylistCache(NewTokenDenylistDAO(client), time.Minute)
+	for i := 0; i < 3; i++ {
+		is_denylisted, err = cache.IsDenylisted(ctx, "token_123", "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if is_denylisted {
+			t.Error("expected token to not be denylisted")
+		}
+	}
+	if client.ExistCallCount != 1 {
+		t.Errorf("expected store to be queried once, got %d", client.ExistCallCount)
+	}
+}
+
+// TestTokenDenylistCache_IsDenylisted_NegativeTTLExpired は未登録キャッシュの期限切れ後に再参照することをテストします
+func TestTokenDenylistCache_IsDenylisted_NegativeTTLExpired(t *testing.T) {
+	var ctx context.Context
+	var client *MockTokenDenylistEntClient
+	var dao *TokenDenylistDAO
+	var cache *TokenDenylistCache
+	var now time.Time
+	var is_denylisted bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockTokenDenylistEntClient()
+	dao = NewTokenDenylistDAO(client)
+	cache = NewTokenDenylistCache(dao, time.Minute)
+	now = time.Now()
+	cache.now = func() time.Time { return now }
+	_, err = cache.IsDenylisted(ctx, "", "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// 別インスタンスでログアウトされた状況を再現（キャッシュを経由せずに登録）
+	err = dao.Add(ctx, create_test_denylisted_token(t, models.DenylistKindSession, "session_123"))
+	if err != nil {
+		t.Fatalf("failed to add entry: %v", err)
+	}
+	is_denylisted, err = cache.IsDenylisted(ctx, "", "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if is_denylisted {
+		t.Error("expected cached negative result within ttl")
+	}
+	now = now.Add(time.Minute)
+	is_denylisted, err = cache.IsDenylisted(ctx, "", "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !is_denylisted {
+		t.Error("expected session to be denylisted after ttl expired")
+	}
+}
+
+// TestTokenDenylistCache_Add_UpdatesCache は登録がキャッシュに即時反映されることをテストします
+func TestTokenDenylistCache_Add_UpdatesCache(t *testing.T) {
+	var ctx context.Context
+	var client *MockTokenDenylistEntClient
+	var cache *TokenDenylistCache
+	var is_denylisted bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockTokenDenylistEntClient()
+	cache = NewTokenDenylistCache(NewTokenDenylistDAO(client), time.Minute)
+	_, err = cache.IsDenylisted(ctx, "token_123", "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = cache.Add(ctx, create_test_denylisted_token(t, models.DenylistKindSession, "session_123"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	is_denylisted, err = cache.IsDenylisted(ctx, "token_123", "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !is_denylisted {
+		t.Error("expected session to be denylisted immediately after Add")
+	}
+}
+
+// TestTokenDenylistCache_SweepsExpiredEntries は参照されなくなった期限切れの判定が削除されることをテストします
+func TestTokenDenylistCache_SweepsExpiredEntries(t *testing.T) {
+	var ctx context.Context
+	var cache *TokenDenylistCache
+	var now time.Time
+	var err error
+
+	ctx = context.Background()
+	cache = NewTokenDenylistCache(NewTokenDenylistDAO(NewMockTokenDenylistEntClient()), time.Minute)
+	now = time.Now()
+	cache.now = func() time.Time { return now }
+	for _, token_id := range []string{"token_1", "token_2", "token_3"} {
+		_, err = cache.IsDenylisted(ctx, token_id, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(cache.entries) != 3 {
+		t.Fatalf("expected 3 cached entries, got %d", len(cache.entries))
+	}
+	now = now.Add(time.Minute)
+	_, err = cache.IsDenylisted(ctx, "token_4", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cache.entries) != 1 {
+		t.Errorf("expected expired entries to be swept, got %d entries", len(cache.entries))
+	}
+}
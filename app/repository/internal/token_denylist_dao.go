@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/denylistedtoken"
+)
+
+// TokenDenylistEntClientInterface はTokenDenylistDAOが利用するEnt Clientのインターフェースです
+type TokenDenylistEntClientInterface interface {
+	GetDenylistedTokenClient() DenylistedTokenClientInterface
+}
+
+// DenylistedTokenClientInterface はEnt DenylistedToken Clientのインターフェースです
+type DenylistedTokenClientInterface interface {
+	Create() DenylistedTokenCreateInterface
+	Query() DenylistedTokenQueryInterface
+	Delete() DenylistedTokenDeleteInterface
+}
+
+// DenylistedTokenCreateInterface はEnt DenylistedToken Create Builderのインターフェースです
+type DenylistedTokenCreateInterface interface {
+	SetKind(denylistedtoken.Kind) DenylistedTokenCreateInterface
+	SetValue(string) DenylistedTokenCreateInterface
+	SetExpiresAt(time.Time) DenylistedTokenCreateInterface
+	Save(ctx context.Context) (*ent.DenylistedToken, error)
+}
+
+// DenylistedTokenQueryInterface はEnt DenylistedToken Query Builderのインターフェースです
+type DenylistedTokenQueryInterface interface {
+	Where(predicates ...any) DenylistedTokenQueryInterface
+	Exist(ctx context.Context) (bool, error)
+}
+
+// DenylistedTokenDeleteInterface はEnt DenylistedToken Delete Builderのインターフェースです
+type DenylistedTokenDeleteInterface interface {
+	// ExpiredAt は有効期限が指定日時以前の登録に絞り込みます
+	ExpiredAt(now time.Time) DenylistedTokenDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// TokenDenylistDAO は失効済みトークン・セッションのデータアクセスオブジェクトです
+type TokenDenylistDAO struct {
+	client TokenDenylistEntClientInterface
+}
+
+// NewTokenDenylistDAO は新しいTokenDenylistDAOを作成します
+func NewTokenDenylistDAO(client TokenDenylistEntClientInterface) *TokenDenylistDAO {
+	return &TokenDenylistDAO{
+		client: client,
+	}
+}
+
+// Add はjtiまたはsidをdenylistに登録します
+// 既に登録済みの場合は何もせず成功として扱います（ログアウトの再実行を許容するため）
+func (d *TokenDenylistDAO) Add(ctx context.Context, entry *models.DenylistedToken) error {
+	var err error
+
+	_, err = d.client.GetDenylistedTokenClient().
+		Create().
+		SetKind(denylistedtoken.Kind(entry.Kind())).
+		SetValue(entry.Value()).
+		SetExpiresAt(entry.ExpiresAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// Exists は指定されたjtiまたはsidがdenylistに登録されているかを返します
+func (d *TokenDenylistDAO) Exists(ctx context.Context, kind models.DenylistKind, value string) (bool, error) {
+	var exists bool
+	var err error
+
+	exists, err = d.client.GetDenylistedTokenClient().
+		Query().
+		Where("kind", denylistedtoken.Kind(kind), "value", value).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return exists, nil
+}
+
+// DeleteExpired は有効期限切れの登録を物理削除し、削除件数を返します
+// 有効期限を過ぎた登録の対象トークンは署名の検証で拒否されるため、削除しても判定は変わりません
+func (d *TokenDenylistDAO) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	var deleted_count int
+	var err error
+
+	ctx = with_hard_delete(ctx, ent.TypeDenylistedToken)
+	deleted_count, err = d.client.GetDenylistedTokenClient().
+		Delete().
+		ExpiredAt(now).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count, nil
+}
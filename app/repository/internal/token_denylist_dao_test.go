@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// create_test_denylisted_token はテスト用のdenylist登録を作成します
+func create_test_denylisted_token(t *testing.T, kind models.DenylistKind, value string) *models.DenylistedToken {
+	var entry *models.DenylistedToken
+	var err error
+
+	t.Helper()
+	entry, err = models.NewDenylistedToken(kind, value, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create denylisted token: %v", err)
+	}
+	return entry
+}
+
+// TestTokenDenylistDAO_Add_Success はdenylistへの登録と参照をテストします
+func TestTokenDenylistDAO_Add_Success(t *testing.T) {
+	var ctx context.Context
+	var dao *TokenDenylistDAO
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	dao = NewTokenDenylistDAO(NewMockTokenDenylistEntClient())
+	err = dao.Add(ctx, create_test_denylisted_token(t, models.DenylistKindSession, "session_123"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	exists, err = dao.Exists(ctx, models.DenylistKindSession, "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !exists {
+		t.Error("expected session to be denylisted")
+	}
+	// 種類が異なる場合は一致しない
+	exists, err = dao.Exists(ctx, models.DenylistKindToken, "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if exists {
+		t.Error("expected token kind to not match session entry")
+	}
+}
+
+// TestTokenDenylistDAO_Add_Duplicate は登録済みの値を再登録しても成功することをテストします
+func TestTokenDenylistDAO_Add_Duplicate(t *testing.T) {
+	var ctx context.Context
+	var client *MockTokenDenylistEntClient
+	var dao *TokenDenylistDAO
+	var err error
+
+	ctx = context.Background()
+	client = NewMockTokenDenylistEntClient()
+	dao = NewTokenDenylistDAO(client)
+	for i := 0; i < 2; i++ {
+		err = dao.Add(ctx, create_test_denylisted_token(t, models.DenylistKindToken, "token_123"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(client.Entries()) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(client.Entries()))
+	}
+}
+
+// TestTokenDenylistDAO_DatabaseError はDBエラー時にエラーを返すケースをテストします
+func TestTokenDenylistDAO_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var dao *TokenDenylistDAO
+	var err error
+
+	ctx = context.Background()
+	dao = NewTokenDenylistDAO(NewMockTokenDenylistEntClientWithDatabaseError())
+	err = dao.Add(ctx, create_test_denylisted_token(t, models.DenylistKindToken, "token_123"))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	_, err = dao.Exists(ctx, models.DenylistKindToken, "token_123")
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
+
+// TestTokenDenylistDAO_DeleteExpired は有効期限切れの登録のみ削除されることをテストします
+func TestTokenDenylistDAO_DeleteExpired(t *testing.T) {
+	var ctx context.Context
+	var client *MockTokenDenylistEntClient
+	var dao *TokenDenylistDAO
+	var expired_entry *models.DenylistedToken
+	var deleted_count int
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockTokenDenylistEntClient()
+	dao = NewTokenDenylistDAO(client)
+	expired_entry, err = models.NewDenylistedToken(models.DenylistKindToken, "token_expired", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("failed to create denylisted token: %v", err)
+	}
+	err = dao.Add(ctx, expired_entry)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Add(ctx, create_test_denylisted_token(t, models.DenylistKindSession, "session_123"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	deleted_count, err = dao.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted_count != 1 {
+		t.Errorf("expected 1 deleted entry, got %d", deleted_count)
+	}
+	exists, err = dao.Exists(ctx, models.DenylistKindSession, "session_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !exists {
+		t.Error("expected unexpired entry to remain")
+	}
+}
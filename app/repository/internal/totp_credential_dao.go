@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// TotpCredentialEntClientInterface はTotpCredentialDAOが利用するEnt Clientのインターフェースです
+type TotpCredentialEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetTotpCredentialClient() TotpCredentialClientInterface
+}
+
+// TotpCredentialClientInterface はEnt TotpCredential Clientのインターフェースです
+type TotpCredentialClientInterface interface {
+	Create() TotpCredentialCreateInterface
+	Query() TotpCredentialQueryInterface
+	Update() TotpCredentialUpdateInterface
+	Delete() TotpCredentialDeleteInterface
+}
+
+// TotpCredentialCreateInterface はEnt TotpCredential Create Builderのインターフェースです
+type TotpCredentialCreateInterface interface {
+	SetUserID(int) TotpCredentialCreateInterface
+	SetEncryptedSecret(string) TotpCredentialCreateInterface
+	SetRecoveryCodeHashes([]string) TotpCredentialCreateInterface
+	SetLastUsedStep(int64) TotpCredentialCreateInterface
+	SetCreatedAt(time.Time) TotpCredentialCreateInterface
+	Save(ctx context.Context) (*ent.TotpCredential, error)
+}
+
+// TotpCredentialQueryInterface はEnt TotpCredential Query Builderのインターフェースです
+type TotpCredentialQueryInterface interface {
+	Where(predicates ...any) TotpCredentialQueryInterface
+	Only(ctx context.Context) (*ent.TotpCredential, error)
+}
+
+// TotpCredentialUpdateInterface はEnt TotpCredential Update Builderのインターフェースです
+type TotpCredentialUpdateInterface interface {
+	Where(predicates ...any) TotpCredentialUpdateInterface
+	WhereLastUsedStepBefore(step int64) TotpCredentialUpdateInterface
+	WhereRecoveryCodeHashes(recovery_code_hashes []string) TotpCredentialUpdateInterface
+	SetRecoveryCodeHashes([]string) TotpCredentialUpdateInterface
+	SetLastUsedStep(int64) TotpCredentialUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// TotpCredentialDeleteInterface はEnt TotpCredential Delete Builderのインターフェースです
+type TotpCredentialDeleteInterface interface {
+	Where(predicates ...any) TotpCredentialDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// TotpCredentialDAO は二要素認証（TOTP）の認証情報のデータアクセスオブジェクトです
+type TotpCredentialDAO struct {
+	client TotpCredentialEntClientInterface
+}
+
+// NewTotpCredentialDAO は新しいTotpCredentialDAOを作成します
+func NewTotpCredentialDAO(client TotpCredentialEntClientInterface) *TotpCredentialDAO {
+	return &TotpCredentialDAO{
+		client: client,
+	}
+}
+
+// Save は認証情報をDBに保存します
+// ユーザーの既存の認証情報（確認前の登録など）は置き換えます
+func (d *TotpCredentialDAO) Save(ctx context.Context, credential *models.TotpCredential) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, credential.UserID())
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetTotpCredentialClient().
+		Delete().
+		Where("user_id", ent_user.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	_, err = d.client.GetTotpCredentialClient().
+		Create().
+		SetUserID(ent_user.ID).
+		SetEncryptedSecret(credential.EncryptedSecret()).
+		SetRecoveryCodeHashes(credential.RecoveryCodeHashes()).
+		SetLastUsedStep(credential.LastUsedStep()).
+		SetCreatedAt(credential.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	credential.MarkPersisted()
+	return nil
+}
+
+// FindByUserID はユーザーの認証情報を検索します
+// 認証情報が存在しない場合はErrMfaEnrollmentNotFoundを返します
+func (d *TotpCredentialDAO) FindByUserID(ctx context.Context, user_id uuid.UUID) (*models.TotpCredential, error) {
+	var ent_user *ent.User
+	var ent_credential *ent.TotpCredential
+	var credential *models.TotpCredential
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ent_credential, err = d.client.GetTotpCredentialClient().
+		Query().
+		Where("user_id", ent_user.ID).
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, domain_errors.ErrMfaEnrollmentNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	credential, err = models.NewTotpCredentialWithState(
+		user_id,
+		ent_credential.EncryptedSecret,
+		ent_credential.RecoveryCodeHashes,
+		ent_credential.LastUsedStep,
+		ent_credential.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return credential, nil
+}
+
+// Update は認証情報のリカバリーコードと使用済みのタイムステップを更新します
+// 読み込み後に同じ認証コード・リカバリーコードが並行して使用された場合に二重に成功しないよう、
+// 使用済みのタイムステップが今回より前であることとリカバリーコードが読み込み時から変わっていないことを条件に更新します
+// 条件に一致する認証情報がない場合（並行する使用・登録の解除）はErrInvalidMfaCodeを返します
+func (d *TotpCredentialDAO) Update(ctx context.Context, credential *models.TotpCredential) error {
+	var ent_user *ent.User
+	var update TotpCredentialUpdateInterface
+	var updated_count int
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, credential.UserID())
+	if err != nil {
+		return err
+	}
+	update = d.client.GetTotpCredentialClient().
+		Update().
+		Where("user_id", ent_user.ID).
+		WhereRecoveryCodeHashes(credential.PersistedRecoveryCodeHashes())
+	if credential.LastUsedStep() != credential.PersistedLastUsedStep() {
+		update = update.WhereLastUsedStepBefore(credential.LastUsedStep())
+	}
+	updated_count, err = update.
+		SetRecoveryCodeHashes(credential.RecoveryCodeHashes()).
+		SetLastUsedStep(credential.LastUsedStep()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return fmt.Errorf("%w: totp credential was changed concurrently: user_id=%s", domain_errors.ErrInvalidMfaCode, credential.UserID())
+	}
+	credential.MarkPersisted()
+	return nil
+}
+
+// Delete はユーザーの認証情報を削除します（存在しない場合も成功とします）
+func (d *TotpCredentialDAO) Delete(ctx context.Context, user_id uuid.UUID) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, user_id)
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetTotpCredentialClient().
+		Delete().
+		Where("user_id", ent_user.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// find_ent_user は公開IDで認証情報の所有者を検索します
+func (d *TotpCredentialDAO) find_ent_user(ctx context.Context, user_id uuid.UUID) (*ent.User, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return ent_user, nil
+}
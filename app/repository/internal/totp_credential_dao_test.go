@@ -0,0 +1,225 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_totp_credential はテスト用の二要素認証の認証情報を作成します
+func create_test_totp_credential(t *testing.T, user_id uuid.UUID, encrypted_secret string) *models.TotpCredential {
+	var credential *models.TotpCredential
+	var err error
+
+	t.Helper()
+	credential, err = models.NewTotpCredential(user_id, encrypted_secret)
+	if err != nil {
+		t.Fatalf("failed to create totp credential: %v", err)
+	}
+	return credential
+}
+
+// TestTotpCredentialDAO_Save_ReplacesPending は登録のやり直しで認証情報が置き換えられることをテストします
+func TestTotpCredentialDAO_Save_ReplacesPending(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockTotpCredentialEntClient
+	var dao *TotpCredentialDAO
+	var found_credential *models.TotpCredential
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockTotpCredentialEntClient(user_id)
+	dao = NewTotpCredentialDAO(client)
+	err = dao.Save(ctx, create_test_totp_credential(t, user_id, "first_secret"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Save(ctx, create_test_totp_credential(t, user_id, "second_secret"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Credentials()) != 1 {
+		t.Fatalf("expected 1 credential, got %d", len(client.Credentials()))
+	}
+	found_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_credential.EncryptedSecret() != "second_secret" {
+		t.Errorf("expected second_secret, got %s", found_credential.EncryptedSecret())
+	}
+	if found_credential.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, found_credential.UserID())
+	}
+}
+
+// TestTotpCredentialDAO_Update はリカバリーコードと使用済みタイムステップの更新をテストします
+func TestTotpCredentialDAO_Update(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *TotpCredentialDAO
+	var credential *models.TotpCredential
+	var found_credential *models.TotpCredential
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewTotpCredentialDAO(NewMockTotpCredentialEntClient(user_id))
+	credential = create_test_totp_credential(t, user_id, "encrypted_secret")
+	err = dao.Save(ctx, credential)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	credential.ReplaceRecoveryCodes([]string{"aaaaa-bbbbb"})
+	credential.RecordUsedStep(42)
+	err = dao.Update(ctx, credential)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_credential.LastUsedStep() != 42 {
+		t.Errorf("expected last_used_step 42, got %d", found_credential.LastUsedStep())
+	}
+	if !found_credential.ConsumeRecoveryCode("aaaaa-bbbbb") {
+		t.Error("expected stored recovery code to be usable")
+	}
+}
+
+// TestTotpCredentialDAO_Update_ConcurrentUse は同じ認証情報を読み込んだ並行する更新の一方のみが成功することをテストします
+func TestTotpCredentialDAO_Update_ConcurrentUse(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *TotpCredentialDAO
+	var credential *models.TotpCredential
+	var first_credential *models.TotpCredential
+	var second_credential *models.TotpCredential
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewTotpCredentialDAO(NewMockTotpCredentialEntClient(user_id))
+	credential = create_test_totp_credential(t, user_id, "encrypted_secret")
+	credential.ReplaceRecoveryCodes([]string{"aaaaa-bbbbb", "ccccc-ddddd"})
+	err = dao.Save(ctx, credential)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// 同じ認証コードの並行した使用
+	first_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	first_credential.RecordUsedStep(42)
+	second_credential.RecordUsedStep(42)
+	err = dao.Update(ctx, first_credential)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Update(ctx, second_credential)
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode for reused step, got %v", err)
+	}
+
+	// 同じリカバリーコードの並行した使用
+	first_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second_credential, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	first_credential.ConsumeRecoveryCode("aaaaa-bbbbb")
+	second_credential.ConsumeRecoveryCode("aaaaa-bbbbb")
+	err = dao.Update(ctx, first_credential)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Update(ctx, second_credential)
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode for reused recovery code, got %v", err)
+	}
+
+	// 更新に成功した認証情報は続けて更新できる
+	first_credential.ConsumeRecoveryCode("ccccc-ddddd")
+	err = dao.Update(ctx, first_credential)
+	if err != nil {
+		t.Errorf("expected no error for sequential update, got %v", err)
+	}
+}
+
+// TestTotpCredentialDAO_FindByUserID_NotFound は認証情報が存在しないケースをテストします
+func TestTotpCredentialDAO_FindByUserID_NotFound(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *TotpCredentialDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewTotpCredentialDAO(NewMockTotpCredentialEntClient(user_id))
+	_, err = dao.FindByUserID(ctx, user_id)
+	if !errors.Is(err, domain_errors.ErrMfaEnrollmentNotFound) {
+		t.Errorf("expected ErrMfaEnrollmentNotFound, got %v", err)
+	}
+	err = dao.Update(ctx, create_test_totp_credential(t, user_id, "encrypted_secret"))
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode, got %v", err)
+	}
+}
+
+// TestTotpCredentialDAO_Delete は認証情報の削除をテストします
+func TestTotpCredentialDAO_Delete(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockTotpCredentialEntClient
+	var dao *TotpCredentialDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockTotpCredentialEntClient(user_id)
+	dao = NewTotpCredentialDAO(client)
+	err = dao.Save(ctx, create_test_totp_credential(t, user_id, "encrypted_secret"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Delete(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Credentials()) != 0 {
+		t.Errorf("expected credential to be deleted, got %d", len(client.Credentials()))
+	}
+}
+
+// TestTotpCredentialDAO_Save_DatabaseError はDBエラーのケースをテストします
+func TestTotpCredentialDAO_Save_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *TotpCredentialDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewTotpCredentialDAO(NewMockTotpCredentialEntClientWithDatabaseError(user_id))
+	err = dao.Save(ctx, create_test_totp_credential(t, user_id, "encrypted_secret"))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/schematype"
+	"sleeve/ent/uploadedimage"
+
+	"github.com/google/uuid"
+)
+
+// UploadedImageEntClientInterface はUploadedImageDAOが利用するEnt Clientのインターフェースです
+type UploadedImageEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetUploadedImageClient() UploadedImageClientInterface
+}
+
+// UploadedImageClientInterface はEnt UploadedImage Clientのインターフェースです
+type UploadedImageClientInterface interface {
+	Create() UploadedImageCreateInterface
+	Query() UploadedImageQueryInterface
+}
+
+// UploadedImageCreateInterface はEnt UploadedImage Create Builderのインターフェースです
+type UploadedImageCreateInterface interface {
+	SetImageID(uuid.UUID) UploadedImageCreateInterface
+	SetUserID(int) UploadedImageCreateInterface
+	SetPurpose(uploadedimage.Purpose) UploadedImageCreateInterface
+	SetFormat(uploadedimage.Format) UploadedImageCreateInterface
+	SetVariants([]schematype.UploadedImageVariant) UploadedImageCreateInterface
+	SetCreatedAt(time.Time) UploadedImageCreateInterface
+	Save(ctx context.Context) (*ent.UploadedImage, error)
+}
+
+// UploadedImageQueryInterface はEnt UploadedImage Query Builderのインターフェースです
+type UploadedImageQueryInterface interface {
+	Where(predicates ...any) UploadedImageQueryInterface
+	All(ctx context.Context) ([]*ent.UploadedImage, error)
+}
+
+// UploadedImageDAO はアップロードした画像のデータアクセスオブジェクトです
+// ストレージに保存したリサイズ画像のキーをアップロードしたユーザーと紐づけて保存します
+type UploadedImageDAO struct {
+	client UploadedImageEntClientInterface
+}
+
+// NewUploadedImageDAO は新しいUploadedImageDAOを作成します
+func NewUploadedImageDAO(client UploadedImageEntClientInterface) *UploadedImageDAO {
+	return &UploadedImageDAO{
+		client: client,
+	}
+}
+
+// Create はアップロードした画像を保存します
+func (d *UploadedImageDAO) Create(ctx context.Context, uploaded_image *models.UploadedImage) error {
+	var ent_user *ent.User
+	var variants []schematype.UploadedImageVariant
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, uploaded_image.OwnerID())
+	if err != nil {
+		return err
+	}
+	variants = make([]schematype.UploadedImageVariant, 0, len(uploaded_image.Variants()))
+	for _, variant := range uploaded_image.Variants() {
+		variants = append(variants, schematype.UploadedImageVariant{
+			Name:       variant.Name(),
+			StorageKey: variant.StorageKey(),
+			URL:        variant.URL(),
+			Width:      variant.Width(),
+			Height:     variant.Height(),
+		})
+	}
+	_, err = d.client.GetUploadedImageClient().
+		Create().
+		SetImageID(uploaded_image.ImageID()).
+		SetUserID(ent_user.ID).
+		SetPurpose(uploadedimage.Purpose(uploaded_image.Purpose())).
+		SetFormat(uploadedimage.Format(uploaded_image.Format())).
+		SetVariants(variants).
+		SetCreatedAt(uploaded_image.UploadedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// ListByUserID はユーザーがアップロードした画像をアップロード日時の順に返します
+// データのエクスポートと退会ユーザーの物理削除で使用するため、論理削除されたユーザーの画像も返します
+func (d *UploadedImageDAO) ListByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.UploadedImage, error) {
+	var ent_user *ent.User
+	var ent_images []*ent.UploadedImage
+	var uploaded_images []*models.UploadedImage
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ent_images, err = d.client.GetUploadedImageClient().
+		Query().
+		Where("user_id", ent_user.ID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	uploaded_images = make([]*models.UploadedImage, 0, len(ent_images))
+	for _, ent_image := range ent_images {
+		var uploaded_image *models.UploadedImage
+
+		uploaded_image, err = convert_ent_uploaded_image_to_domain(ent_image, user_id)
+		if err != nil {
+			return nil, err
+		}
+		uploaded_images = append(uploaded_images, uploaded_image)
+	}
+	return uploaded_images, nil
+}
+
+// convert_ent_uploaded_image_to_domain はEntのUploadedImageエンティティをドメインモデルに変換します
+func convert_ent_uploaded_image_to_domain(ent_image *ent.UploadedImage, owner_id uuid.UUID) (*models.UploadedImage, error) {
+	var purpose models.ImagePurpose
+	var variants []models.ImageVariant
+	var uploaded_image *models.UploadedImage
+	var err error
+
+	purpose, err = models.NewImagePurpose(string(ent_image.Purpose))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	variants = make([]models.ImageVariant, 0, len(ent_image.Variants))
+	for _, variant := range ent_image.Variants {
+		variants = append(variants, models.NewImageVariant(variant.Name, variant.StorageKey, variant.URL, variant.Width, variant.Height))
+	}
+	uploaded_image, err = models.NewUploadedImage(
+		ent_image.ImageID, owner_id, purpose, models.ImageFormat(ent_image.Format), variants, ent_image.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return uploaded_image, nil
+}
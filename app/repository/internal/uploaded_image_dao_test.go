@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// create_test_uploaded_image はテスト用のアップロードした画像を作成します
+func create_test_uploaded_image(t *testing.T, owner_id uuid.UUID) *models.UploadedImage {
+	var image_id uuid.UUID
+	var storage_key string
+	var uploaded_image *models.UploadedImage
+	var err error
+
+	t.Helper()
+	image_id = uuid.New()
+	storage_key = models.ImageStorageKey(owner_id, image_id, "small", models.ImageFormatPNG)
+	uploaded_image, err = models.NewUploadedImage(
+		image_id, owner_id, models.ImagePurposeAvatar, models.ImageFormatPNG,
+		[]models.ImageVariant{models.NewImageVariant("small", storage_key, "https://cdn.example.com/"+storage_key, 96, 48)},
+		time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create uploaded image: %v", err)
+	}
+	return uploaded_image
+}
+
+// TestUploadedImageDAO_CreateAndListByUserID は保存した画像がアップロードしたユーザーごとに返されることをテストします
+func TestUploadedImageDAO_CreateAndListByUserID(t *testing.T) {
+	var ctx context.Context
+	var client *MockUploadedImageEntClient
+	var dao *UploadedImageDAO
+	var owner *ent.User
+	var other *ent.User
+	var uploaded_image *models.UploadedImage
+	var listed []*models.UploadedImage
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUploadedImageEntClient()
+	dao = NewUploadedImageDAO(client)
+	owner = client.AddUser(uuid.New())
+	other = client.AddUser(uuid.New())
+	uploaded_image = create_test_uploaded_image(t, owner.PublicID)
+
+	err = dao.Create(ctx, uploaded_image)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Create(ctx, create_test_uploaded_image(t, other.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	listed, err = dao.ListByUserID(ctx, owner.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(listed) != 1 {
+		t.Fatalf("expected 1 image, got %d", len(listed))
+	}
+	if listed[0].ImageID() != uploaded_image.ImageID() || listed[0].OwnerID() != owner.PublicID ||
+		listed[0].Purpose() != models.ImagePurposeAvatar || listed[0].Format() != models.ImageFormatPNG {
+		t.Errorf("unexpected image: %+v", listed[0])
+	}
+	if len(listed[0].Variants()) != 1 || listed[0].Variants()[0].StorageKey() != uploaded_image.Variants()[0].StorageKey() {
+		t.Errorf("expected variants to be restored, got %+v", listed[0].Variants())
+	}
+}
+
+// TestUploadedImageDAO_Create_UserNotFound は存在しないユーザーの画像を保存できないことをテストします
+func TestUploadedImageDAO_Create_UserNotFound(t *testing.T) {
+	var err error
+
+	err = NewUploadedImageDAO(NewMockUploadedImageEntClient()).Create(context.Background(), create_test_uploaded_image(t, uuid.New()))
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestUploadedImageDAO_DatabaseError はDBエラーをErrDatabaseErrorとして返すことをテストします
+func TestUploadedImageDAO_DatabaseError(t *testing.T) {
+	var client *MockUploadedImageEntClient
+	var owner *ent.User
+	var err error
+
+	client = NewMockUploadedImageEntClientWithDatabaseError()
+	owner = client.AddUser(uuid.New())
+
+	err = NewUploadedImageDAO(client).Create(context.Background(), create_test_uploaded_image(t, owner.PublicID))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError on create, got %v", err)
+	}
+	_, err = NewUploadedImageDAO(client).ListByUserID(context.Background(), owner.PublicID)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError on list, got %v", err)
+	}
+}
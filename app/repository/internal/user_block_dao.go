@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// UserBlockEntClientInterface はUserBlockDAOが利用するEnt Clientのインターフェースです
+type UserBlockEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetUserBlockClient() UserBlockClientInterface
+}
+
+// UserBlockClientInterface はEnt UserBlock Clientのインターフェースです
+type UserBlockClientInterface interface {
+	Create() UserBlockCreateInterface
+	Query() UserBlockQueryInterface
+	Delete() UserBlockDeleteInterface
+}
+
+// UserBlockCreateInterface はEnt UserBlock Create Builderのインターフェースです
+type UserBlockCreateInterface interface {
+	SetBlockerID(int) UserBlockCreateInterface
+	SetBlockedID(int) UserBlockCreateInterface
+	SetCreatedAt(time.Time) UserBlockCreateInterface
+	Save(ctx context.Context) (*ent.UserBlock, error)
+}
+
+// UserBlockQueryInterface はEnt UserBlock Query Builderのインターフェースです
+type UserBlockQueryInterface interface {
+	Where(predicates ...any) UserBlockQueryInterface
+	WithBlocked() UserBlockQueryInterface
+	Exist(ctx context.Context) (bool, error)
+	All(ctx context.Context) ([]*ent.UserBlock, error)
+}
+
+// UserBlockDeleteInterface はEnt UserBlock Delete Builderのインターフェースです
+type UserBlockDeleteInterface interface {
+	Where(predicates ...any) UserBlockDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// UserBlockDAO はユーザー間のブロックのデータアクセスオブジェクトです
+type UserBlockDAO struct {
+	client UserBlockEntClientInterface
+}
+
+// NewUserBlockDAO は新しいUserBlockDAOを作成します
+func NewUserBlockDAO(client UserBlockEntClientInterface) *UserBlockDAO {
+	return &UserBlockDAO{
+		client: client,
+	}
+}
+
+// Create はブロックを保存します
+// 既にブロックしている場合は何も変更せずfalseを返します
+func (d *UserBlockDAO) Create(ctx context.Context, user_block *models.UserBlock) (bool, error) {
+	var blocker *ent.User
+	var blocked *ent.User
+	var err error
+
+	blocker, blocked, err = find_ent_user_pair(ctx, d.client, user_block.BlockerID(), user_block.BlockedID())
+	if err != nil {
+		return false, err
+	}
+	_, err = d.client.GetUserBlockClient().
+		Create().
+		SetBlockerID(blocker.ID).
+		SetBlockedID(blocked.ID).
+		SetCreatedAt(user_block.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return true, nil
+}
+
+// Delete はブロックを解除します
+// ブロックしていない場合は何も変更せずfalseを返します
+func (d *UserBlockDAO) Delete(ctx context.Context, blocker_id uuid.UUID, blocked_id uuid.UUID) (bool, error) {
+	var blocker *ent.User
+	var blocked *ent.User
+	var deleted_count int
+	var err error
+
+	blocker, blocked, err = find_ent_user_pair(ctx, d.client, blocker_id, blocked_id)
+	if err != nil {
+		return false, err
+	}
+	deleted_count, err = d.client.GetUserBlockClient().
+		Delete().
+		Where("blocker_id", blocker.ID, "blocked_id", blocked.ID).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count > 0, nil
+}
+
+// ExistsBetween は2人のユーザーの間にどちらの方向にもブロックがあるかを返します
+func (d *UserBlockDAO) ExistsBetween(ctx context.Context, user_id uuid.UUID, other_id uuid.UUID) (bool, error) {
+	var ent_user *ent.User
+	var other *ent.User
+	var exists bool
+	var err error
+
+	ent_user, other, err = find_ent_user_pair(ctx, d.client, user_id, other_id)
+	if err != nil {
+		return false, err
+	}
+	for _, pair := range [][2]int{{ent_user.ID, other.ID}, {other.ID, ent_user.ID}} {
+		exists, err = d.client.GetUserBlockClient().
+			Query().
+			Where("blocker_id", pair[0], "blocked_id", pair[1]).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListBlockedIDs はブロックしたユーザーのpublic_idの一覧をブロックした日時の順に返します（データのエクスポート用）
+func (d *UserBlockDAO) ListBlockedIDs(ctx context.Context, blocker_id uuid.UUID) ([]uuid.UUID, error) {
+	var ent_user *ent.User
+	var ent_blocks []*ent.UserBlock
+	var blocked_ids []uuid.UUID
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, blocker_id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = with_deleted_users(ctx)
+	ent_blocks, err = d.client.GetUserBlockClient().
+		Query().
+		Where("blocker_id", ent_user.ID).
+		WithBlocked().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	blocked_ids = make([]uuid.UUID, 0, len(ent_blocks))
+	for _, ent_block := range ent_blocks {
+		var blocked *ent.User
+
+		blocked, err = ent_block.Edges.BlockedOrErr()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		blocked_ids = append(blocked_ids, blocked.PublicID)
+	}
+	return blocked_ids, nil
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// TestUserBlockDAO_CreateAndDelete はブロック・ブロック解除の冪等性と双方向の判定をテストします
+func TestUserBlockDAO_CreateAndDelete(t *testing.T) {
+	var ctx context.Context
+	var client *MockUserBlockEntClient
+	var dao *UserBlockDAO
+	var blocker *ent.User
+	var blocked *ent.User
+	var user_block *models.UserBlock
+	var created bool
+	var deleted bool
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUserBlockEntClient()
+	dao = NewUserBlockDAO(client)
+	blocker = client.AddUser(uuid.New())
+	blocked = client.AddUser(uuid.New())
+	user_block, _ = models.NewUserBlock(blocker.PublicID, blocked.PublicID, time.Now())
+
+	for i, expected := range []bool{true, false} {
+		created, err = dao.Create(ctx, user_block)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created != expected {
+			t.Errorf("attempt %d: expected created %v, got %v", i+1, expected, created)
+		}
+	}
+	// ブロックされた側から見てもブロックがあると判定する
+	exists, err = dao.ExistsBetween(ctx, blocked.PublicID, blocker.PublicID)
+	if err != nil || !exists {
+		t.Errorf("expected block to exist in both directions, got %v (err=%v)", exists, err)
+	}
+
+	for i, expected := range []bool{true, false} {
+		deleted, err = dao.Delete(ctx, blocker.PublicID, blocked.PublicID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != expected {
+			t.Errorf("attempt %d: expected deleted %v, got %v", i+1, expected, deleted)
+		}
+	}
+	exists, err = dao.ExistsBetween(ctx, blocker.PublicID, blocked.PublicID)
+	if err != nil || exists {
+		t.Errorf("expected no block after delete, got %v (err=%v)", exists, err)
+	}
+}
+
+// TestUserBlockDAO_Create_Errors は存在しないユーザーとDBエラーをテストします
+func TestUserBlockDAO_Create_Errors(t *testing.T) {
+	var ctx context.Context
+	var client *MockUserBlockEntClient
+	var blocker *ent.User
+	var blocked *ent.User
+	var user_block *models.UserBlock
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUserBlockEntClient()
+	blocker = client.AddUser(uuid.New())
+	user_block, _ = models.NewUserBlock(blocker.PublicID, uuid.New(), time.Now())
+	_, err = NewUserBlockDAO(client).Create(ctx, user_block)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+
+	client = NewMockUserBlockEntClientWithDatabaseError()
+	blocker = client.AddUser(uuid.New())
+	blocked = client.AddUser(uuid.New())
+	user_block, _ = models.NewUserBlock(blocker.PublicID, blocked.PublicID, time.Now())
+	_, err = NewUserBlockDAO(client).Create(ctx, user_block)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
+
+// TestUserBlockDAO_ListBlockedIDs はblocker側のユーザーから見た一覧のみが返されることをテストします
+func TestUserBlockDAO_ListBlockedIDs(t *testing.T) {
+	var ctx context.Context
+	var client *MockUserBlockEntClient
+	var dao *UserBlockDAO
+	var blocker *ent.User
+	var first *ent.User
+	var second *ent.User
+	var user_block *models.UserBlock
+	var ids []uuid.UUID
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUserBlockEntClient()
+	dao = NewUserBlockDAO(client)
+	blocker = client.AddUser(uuid.New())
+	first = client.AddUser(uuid.New())
+	second = client.AddUser(uuid.New())
+	for _, target := range []*ent.User{first, second} {
+		user_block, _ = models.NewUserBlock(blocker.PublicID, target.PublicID, time.Now())
+		_, _ = dao.Create(ctx, user_block)
+	}
+	user_block, _ = models.NewUserBlock(first.PublicID, blocker.PublicID, time.Now())
+	_, _ = dao.Create(ctx, user_block)
+
+	ids, err = dao.ListBlockedIDs(ctx, blocker.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(ids) != 2 || ids[0] != first.PublicID || ids[1] != second.PublicID {
+		t.Errorf("expected [%s %s], got %v", first.PublicID, second.PublicID, ids)
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
 	"sleeve/ent"
+	"sleeve/ent/schema"
+	"sleeve/ent/user"
 
 	"github.com/google/uuid"
 )
@@ -21,6 +24,8 @@ type EntClientInterface interface {
 type UserClientInterface interface {
 	Create() UserCreateInterface
 	Query() UserQueryInterface
+	Update() UserUpdateInterface
+	Delete() UserDeleteInterface
 }
 
 // UserCreateInterface はEnt User Create Builderのインターフェースです
@@ -28,6 +33,7 @@ type UserCreateInterface interface {
 	SetPublicID(uuid.UUID) UserCreateInterface
 	SetFirebaseUID(string) UserCreateInterface
 	SetEmail(string) UserCreateInterface
+	SetNillableEmailVerifiedAt(*time.Time) UserCreateInterface
 	Save(ctx context.Context) (*ent.User, error)
 }
 
@@ -36,6 +42,43 @@ type UserQueryInterface interface {
 	Where(predicates ...any) UserQueryInterface
 	Only(ctx context.Context) (*ent.User, error)
 	Exist(ctx context.Context) (bool, error)
+	// WhereVisibleTo は閲覧するユーザーとの間にどちらの方向にもブロックがないユーザーに絞り込みます
+	WhereVisibleTo(viewer_id int) UserQueryInterface
+	// WhereContentVisibleTo は閲覧するユーザーがコンテンツを閲覧できるユーザーに絞り込みます（非公開アカウントは本人と承認されたフォロワーのみ）
+	WhereContentVisibleTo(viewer_id int) UserQueryInterface
+	WhereFirebaseUIDIn(firebase_uids ...string) UserQueryInterface
+	AfterFirebaseUID(firebase_uid string) UserQueryInterface
+	WhereDeletedBefore(deleted_before time.Time) UserQueryInterface
+	Limit(limit int) UserQueryInterface
+	All(ctx context.Context) ([]*ent.User, error)
+}
+
+// UserUpdateInterface はEnt User Update Builderのインターフェースです
+type UserUpdateInterface interface {
+	Where(predicates ...any) UserUpdateInterface
+	SetEmail(string) UserUpdateInterface
+	SetEmailVerifiedAt(time.Time) UserUpdateInterface
+	ClearEmailVerifiedAt() UserUpdateInterface
+	SetMfaEnabledAt(time.Time) UserUpdateInterface
+	ClearMfaEnabledAt() UserUpdateInterface
+	SetDeletedAt(time.Time) UserUpdateInterface
+	ClearDeletedAt() UserUpdateInterface
+	SetDeletionTokenHash(string) UserUpdateInterface
+	ClearDeletionTokenHash() UserUpdateInterface
+	// AddFollowerCount・AddFollowingCount は現在の値に加算します（UPDATE ... SET follower_count = follower_count + n）
+	AddFollowerCount(int) UserUpdateInterface
+	AddFollowingCount(int) UserUpdateInterface
+	SetIsPrivate(bool) UserUpdateInterface
+	SetHideCloset(bool) UserUpdateInterface
+	SetHideLikes(bool) UserUpdateInterface
+	SetCommentPermission(user.CommentPermission) UserUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// UserDeleteInterface はEnt User Delete Builderのインターフェースです
+type UserDeleteInterface interface {
+	Where(predicates ...any) UserDeleteInterface
+	Exec(ctx context.Context) (int, error)
 }
 
 // UserDAO はユーザーのデータアクセスオブジェクトです
@@ -59,6 +102,7 @@ func (d *UserDAO) Save(ctx context.Context, user *models.User) error {
 		SetPublicID(user.PublicID()).
 		SetFirebaseUID(user.FirebaseUID()).
 		SetEmail(user.Email().Value()).
+		SetNillableEmailVerifiedAt(user.EmailVerifiedAt()).
 		Save(ctx)
 	if err != nil {
 		if is_unique_constraint_error(err) {
@@ -69,7 +113,7 @@ func (d *UserDAO) Save(ctx context.Context, user *models.User) error {
 	return nil
 }
 
-// FindByPublicID は公開IDでユーザーを検索します
+// FindByPublicID は公開IDでユーザーを検索します（論理削除されたユーザーは対象外です）
 func (d *UserDAO) FindByPublicID(ctx context.Context, public_id uuid.UUID) (*models.User, error) {
 	var ent_user *ent.User
 	var err error
@@ -84,11 +128,98 @@ func (d *UserDAO) FindByPublicID(ctx context.Context, public_id uuid.UUID) (*mod
 	return convert_ent_user_to_domain(ent_user)
 }
 
+// FindVisibleByPublicID は公開IDで閲覧するユーザーから見えるユーザーを検索します（論理削除されたユーザーは対象外です）
+// どちらかの方向にブロックがある場合は存在しないユーザーとしてErrUserNotFoundを返します（viewer_idは未ログインの場合はuuid.Nil）
+func (d *UserDAO) FindVisibleByPublicID(ctx context.Context, public_id uuid.UUID, viewer_id uuid.UUID) (*models.User, error) {
+	var query UserQueryInterface
+	var ent_user *ent.User
+	var err error
+
+	query = d.client.GetUserClient().
+		Query().
+		Where("public_id", public_id)
+	if viewer_id != uuid.Nil {
+		var viewer *ent.User
+
+		viewer, err = find_ent_user_by_public_id(ctx, d.client, viewer_id)
+		if err != nil {
+			return nil, err
+		}
+		query = query.WhereVisibleTo(viewer.ID)
+	}
+	ent_user, err = query.Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return convert_ent_user_to_domain(ent_user)
+}
+
+// CanViewContent は閲覧するユーザーがアカウントのコンテンツを閲覧できるかを返します（viewer_idは未ログインの場合はuuid.Nil）
+// 非公開アカウントは本人と承認されたフォロワーのみ閲覧でき、どちらかの方向にブロックがある場合は閲覧できません
+func (d *UserDAO) CanViewContent(ctx context.Context, owner_id uuid.UUID, viewer_id uuid.UUID) (bool, error) {
+	var viewer_ent_id int
+	var can_view bool
+	var err error
+
+	viewer_ent_id, err = find_viewer_ent_id(ctx, d.client, viewer_id)
+	if err != nil {
+		return false, err
+	}
+	can_view, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", owner_id).
+		WhereContentVisibleTo(viewer_ent_id).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return can_view, nil
+}
+
+// FindPrivacySettings は公開IDでユーザーのプライバシー設定を返します（論理削除されたユーザーは対象外です）
+func (d *UserDAO) FindPrivacySettings(ctx context.Context, public_id uuid.UUID) (models.PrivacySettings, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", public_id).
+		Only(ctx)
+	if err != nil {
+		return models.PrivacySettings{}, handle_query_error(err)
+	}
+	return convert_ent_privacy_settings(ent_user)
+}
+
+// UpdatePrivacySettings はユーザーのプライバシー設定を保存します
+func (d *UserDAO) UpdatePrivacySettings(ctx context.Context, public_id uuid.UUID, settings models.PrivacySettings) error {
+	var updated_count int
+	var err error
+
+	updated_count, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id).
+		SetIsPrivate(settings.IsPrivate()).
+		SetHideCloset(settings.HideCloset()).
+		SetHideLikes(settings.HideLikes()).
+		SetCommentPermission(user.CommentPermission(settings.CommentPermission().String())).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
 // FindByFirebaseUID はFirebase UIDでユーザーを検索します
+// ログイン・トークンの更新で退会済み（ErrUserDeleted）と未登録を区別するため、論理削除されたユーザーも返します
 func (d *UserDAO) FindByFirebaseUID(ctx context.Context, firebase_uid string) (*models.User, error) {
 	var ent_user *ent.User
 	var err error
 
+	ctx = with_deleted_users(ctx)
 	ent_user, err = d.client.GetUserClient().
 		Query().
 		Where("firebase_uid", firebase_uid).
@@ -99,7 +230,7 @@ func (d *UserDAO) FindByFirebaseUID(ctx context.Context, firebase_uid string) (*
 	return convert_ent_user_to_domain(ent_user)
 }
 
-// FindByEmail はメールアドレスでユーザーを検索します
+// FindByEmail はメールアドレスでユーザーを検索します（論理削除されたユーザーは対象外です）
 func (d *UserDAO) FindByEmail(ctx context.Context, email models.Email) (*models.User, error) {
 	var ent_user *ent.User
 	var err error
@@ -115,10 +246,12 @@ func (d *UserDAO) FindByEmail(ctx context.Context, email models.Email) (*models.
 }
 
 // ExistsByEmail はメールアドレスが存在するかをチェックします
+// usersテーブルのメールアドレスのユニーク制約に合わせ、物理削除されるまでの論理削除されたユーザーも対象にします
 func (d *UserDAO) ExistsByEmail(ctx context.Context, email models.Email) (bool, error) {
 	var exists bool
 	var err error
 
+	ctx = with_deleted_users(ctx)
 	exists, err = d.client.GetUserClient().
 		Query().
 		Where("email", email.Value()).
@@ -129,23 +262,294 @@ func (d *UserDAO) ExistsByEmail(ctx context.Context, email models.Email) (bool,
 	return exists, nil
 }
 
+// MarkEmailVerified はユーザーのメールアドレスを確認済みとして記録します
+// 確認済みのユーザーは確認日時を上書きしません
+func (d *UserDAO) MarkEmailVerified(ctx context.Context, public_id uuid.UUID, verified_at time.Time) error {
+	var err error
+
+	_, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id, "email_verified_at", nil).
+		SetEmailVerifiedAt(verified_at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// UpdateEmail はユーザーのメールアドレスと確認日時を更新します（email_verified_atがnilの場合は未確認に戻します）
+// 他のユーザーが同じメールアドレスを登録済みの場合（一意制約違反）はErrDuplicateEmailを返します
+func (d *UserDAO) UpdateEmail(ctx context.Context, public_id uuid.UUID, email models.Email, email_verified_at *time.Time) error {
+	var update UserUpdateInterface
+	var updated_count int
+	var err error
+
+	update = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id).
+		SetEmail(email.Value())
+	if email_verified_at != nil {
+		update = update.SetEmailVerifiedAt(*email_verified_at)
+	} else {
+		update = update.ClearEmailVerifiedAt()
+	}
+	updated_count, err = update.Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return fmt.Errorf("%w: %s", domain_errors.ErrDuplicateEmail, email.Value())
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// EnableMfa はユーザーの二要素認証を有効にした日時を記録します
+func (d *UserDAO) EnableMfa(ctx context.Context, public_id uuid.UUID, enabled_at time.Time) error {
+	var updated_count int
+	var err error
+
+	updated_count, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id).
+		SetMfaEnabledAt(enabled_at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// DisableMfa はユーザーの二要素認証を無効にします
+func (d *UserDAO) DisableMfa(ctx context.Context, public_id uuid.UUID) error {
+	var updated_count int
+	var err error
+
+	updated_count, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id).
+		ClearMfaEnabledAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// ListActiveAfterFirebaseUID は削除されていないユーザーを、Firebase UIDが指定した値より大きいものから昇順に最大limit件返します
+// 整合性チェックでusersテーブルを順に走査するためのキーセットページングです（最初のページは空文字を指定します）
+func (d *UserDAO) ListActiveAfterFirebaseUID(ctx context.Context, after_firebase_uid string, limit int) ([]*models.User, error) {
+	var ent_users []*ent.User
+	var users []*models.User
+	var err error
+
+	ent_users, err = d.client.GetUserClient().
+		Query().
+		Where("deleted_at", nil).
+		AfterFirebaseUID(after_firebase_uid).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	users = make([]*models.User, 0, len(ent_users))
+	for _, ent_user := range ent_users {
+		var domain_user *models.User
+
+		domain_user, err = convert_ent_user_to_domain(ent_user)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, domain_user)
+	}
+	return users, nil
+}
+
+// FindExistingFirebaseUIDs は指定したFirebase UIDのうち、usersテーブルに行が存在するものを返します
+// 削除済みのユーザーの行も存在するものとして扱います
+func (d *UserDAO) FindExistingFirebaseUIDs(ctx context.Context, firebase_uids []string) (map[string]bool, error) {
+	var ent_users []*ent.User
+	var existing map[string]bool
+	var err error
+
+	existing = map[string]bool{}
+	if len(firebase_uids) == 0 {
+		return existing, nil
+	}
+	ctx = with_deleted_users(ctx)
+	ent_users, err = d.client.GetUserClient().
+		Query().
+		WhereFirebaseUIDIn(firebase_uids...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	for _, ent_user := range ent_users {
+		existing[ent_user.FirebaseUID] = true
+	}
+	return existing, nil
+}
+
+// HardDeleteByFirebaseUID は削除されていないユーザーの行を物理削除します
+// Firebaseアカウントが存在しない行を整合性チェックで修復するために使用します（メールアドレスのユニーク制約を解放するため論理削除にはしません）
+func (d *UserDAO) HardDeleteByFirebaseUID(ctx context.Context, firebase_uid string) error {
+	var deleted_count int
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	deleted_count, err = d.client.GetUserClient().
+		Delete().
+		Where("firebase_uid", firebase_uid, "deleted_at", nil).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if deleted_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// MarkDeleted は削除されていないユーザーを論理削除し、アカウント削除の取り消しコードのハッシュを保存します
+// 存在しない・既に削除済みのユーザーの場合はErrUserNotFoundを返します
+func (d *UserDAO) MarkDeleted(ctx context.Context, public_id uuid.UUID, deleted_at time.Time, deletion_token_hash string) error {
+	var updated_count int
+	var err error
+
+	updated_count, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id, "deleted_at", nil).
+		SetDeletedAt(deleted_at).
+		SetDeletionTokenHash(deletion_token_hash).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// FindByDeletionTokenHash はアカウント削除の取り消しコードのハッシュで論理削除されたユーザーを検索します
+// 該当するユーザーがいない場合はErrUserNotFoundを返します
+func (d *UserDAO) FindByDeletionTokenHash(ctx context.Context, deletion_token_hash string) (*models.User, error) {
+	var ent_user *ent.User
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("deletion_token_hash", deletion_token_hash).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return convert_ent_user_to_domain(ent_user)
+}
+
+// RestoreDeleted は論理削除を取り消し、アカウント削除の取り消しコードのハッシュを削除します
+func (d *UserDAO) RestoreDeleted(ctx context.Context, public_id uuid.UUID) error {
+	var updated_count int
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	updated_count, err = d.client.GetUserClient().
+		Update().
+		Where("public_id", public_id).
+		ClearDeletedAt().
+		ClearDeletionTokenHash().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	return nil
+}
+
+// ListDeletedBefore は指定した日時より前に論理削除されたユーザーを最大limit件返します
+// 削除を取り消せる期間を過ぎたユーザーを物理削除するために使用します
+func (d *UserDAO) ListDeletedBefore(ctx context.Context, deleted_before time.Time, limit int) ([]*models.User, error) {
+	var ent_users []*ent.User
+	var users []*models.User
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_users, err = d.client.GetUserClient().
+		Query().
+		WhereDeletedBefore(deleted_before).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	users = make([]*models.User, 0, len(ent_users))
+	for _, ent_user := range ent_users {
+		var domain_user *models.User
+
+		domain_user, err = convert_ent_user_to_domain(ent_user)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, domain_user)
+	}
+	return users, nil
+}
+
+// HardDeleteByPublicID はユーザーの行を物理削除します
+// ユーザーに紐づくセッション・プロフィール・フォローなどの行は外部キーのCASCADEで削除されます
+// 既に削除されている場合も成功とします（物理削除の再試行のため）
+func (d *UserDAO) HardDeleteByPublicID(ctx context.Context, public_id uuid.UUID) error {
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	_, err = d.client.GetUserClient().
+		Delete().
+		Where("public_id", public_id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
 // convert_ent_user_to_domain はEntのUserエンティティをドメインモデルに変換します
 func convert_ent_user_to_domain(ent_user *ent.User) (*models.User, error) {
 	var domain_user *models.User
 	var email models.Email
+	var role models.Role
 	var err error
 
 	email, err = models.NewEmail(ent_user.Email)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
 	}
+	role, err = models.NewRole(ent_user.Role.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
 	domain_user, err = models.NewUserWithPublicID(
 		ent_user.PublicID,
 		ent_user.FirebaseUID,
 		email,
+		role,
+		ent_user.EmailVerifiedAt,
+		ent_user.MfaEnabledAt,
 		ent_user.CreatedAt,
 		ent_user.UpdatedAt,
 		ent_user.DeletedAt,
+		ent_user.BannedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
@@ -153,6 +557,14 @@ func convert_ent_user_to_domain(ent_user *ent.User) (*models.User, error) {
 	return domain_user, nil
 }
 
+// with_deleted_users は論理削除されたユーザーも検索・更新・関連の読み込みの対象にしたコンテキストを返します
+// 行の所有者・関係の相手の公開IDの読み込みと、退会ユーザーの削除の取り消し・物理削除の処理で使用します
+// 論理削除されたユーザーを扱うかは、ユースケースがUserDAOで取得したユーザーの状態で判断します
+// ユーザー以外のテーブルの論理削除された行は引き続き対象外です
+func with_deleted_users(ctx context.Context) context.Context {
+	return schema.SkipSoftDelete(ctx, ent.TypeUser)
+}
+
 // handle_query_error はクエリエラーをドメインエラーに変換します
 func handle_query_error(err error) error {
 	if is_not_found_error(err) {
@@ -179,3 +591,20 @@ func is_not_found_error(err error) bool {
 	return strings.Contains(error_message, "not found") ||
 		strings.Contains(error_message, "no rows")
 }
+
+// convert_ent_privacy_settings はEntのユーザーのプライバシー設定をドメインのPrivacySettingsに変換します
+func convert_ent_privacy_settings(ent_user *ent.User) (models.PrivacySettings, error) {
+	var comment_permission models.CommentPermission
+	var settings models.PrivacySettings
+	var err error
+
+	comment_permission, err = models.NewCommentPermission(ent_user.CommentPermission.String())
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	settings, err = models.NewPrivacySettings(ent_user.IsPrivate, ent_user.HideCloset, ent_user.HideLikes, comment_permission)
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return settings, nil
+}
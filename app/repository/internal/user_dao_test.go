@@ -2,10 +2,14 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/user"
 
 	"github.com/google/uuid"
 )
@@ -103,6 +107,100 @@ func TestUserDAO_FindByPublicID_NotFound(t *testing.T) {
 	}
 }
 
+// TestUserDAO_FindVisibleByPublicID はどちらの方向のブロックも存在しないユーザーとして扱い、ミュートは除外しないことをテストします
+func TestUserDAO_FindVisibleByPublicID(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *UserDAO
+	var viewer *ent.User
+	var blocker *ent.User
+	var blocked *ent.User
+	var muted *ent.User
+	var found *models.User
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewUserDAO(client)
+	viewer = client.AddUser(uuid.New())
+	blocker = client.AddUser(uuid.New())
+	blocked = client.AddUser(uuid.New())
+	muted = client.AddUser(uuid.New())
+	client.AddBlock(blocker, viewer)
+	client.AddBlock(viewer, blocked)
+	client.AddMute(viewer, muted)
+
+	for _, target := range []*ent.User{blocker, blocked} {
+		_, err = dao.FindVisibleByPublicID(ctx, target.PublicID, viewer.PublicID)
+		if !errors.Is(err, domain_errors.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound for blocked pair, got %v", err)
+		}
+		found, err = dao.FindVisibleByPublicID(ctx, target.PublicID, uuid.Nil)
+		if err != nil || found.PublicID() != target.PublicID {
+			t.Errorf("expected anonymous viewer to find the user, got err=%v", err)
+		}
+	}
+	for _, target := range []*ent.User{muted, viewer} {
+		found, err = dao.FindVisibleByPublicID(ctx, target.PublicID, viewer.PublicID)
+		if err != nil || found.PublicID() != target.PublicID {
+			t.Errorf("expected muted user and viewer to be found, got err=%v", err)
+		}
+	}
+}
+
+// TestUserDAO_CanViewContent は非公開アカウントのコンテンツを本人と承認されたフォロワーのみ閲覧でき、ブロックがある場合は閲覧できないことをテストします
+func TestUserDAO_CanViewContent(t *testing.T) {
+	var ctx context.Context
+	var client *MockFollowEntClient
+	var dao *UserDAO
+	var follow_dao *FollowDAO
+	var owner *ent.User
+	var follower *ent.User
+	var stranger *ent.User
+	var blocked *ent.User
+	var can_view bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockFollowEntClient()
+	dao = NewUserDAO(client)
+	follow_dao = NewFollowDAO(client)
+	owner = client.AddUser(uuid.New())
+	follower = client.AddUser(uuid.New())
+	stranger = client.AddUser(uuid.New())
+	blocked = client.AddUser(uuid.New())
+	_, err = follow_dao.Create(ctx, create_test_follow(t, follower.PublicID, owner.PublicID))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	client.AddBlock(owner, blocked)
+
+	for _, viewer_id := range []uuid.UUID{uuid.Nil, stranger.PublicID, follower.PublicID} {
+		can_view, err = dao.CanViewContent(ctx, owner.PublicID, viewer_id)
+		if err != nil || !can_view {
+			t.Errorf("expected public account to be visible, got %v (err=%v)", can_view, err)
+		}
+	}
+	can_view, err = dao.CanViewContent(ctx, owner.PublicID, blocked.PublicID)
+	if err != nil || can_view {
+		t.Errorf("expected blocked user not to view content, got %v (err=%v)", can_view, err)
+	}
+
+	owner.IsPrivate = true
+	for _, viewer_id := range []uuid.UUID{uuid.Nil, stranger.PublicID} {
+		can_view, err = dao.CanViewContent(ctx, owner.PublicID, viewer_id)
+		if err != nil || can_view {
+			t.Errorf("expected private account to be hidden, got %v (err=%v)", can_view, err)
+		}
+	}
+	for _, viewer_id := range []uuid.UUID{owner.PublicID, follower.PublicID} {
+		can_view, err = dao.CanViewContent(ctx, owner.PublicID, viewer_id)
+		if err != nil || !can_view {
+			t.Errorf("expected owner and follower to view content, got %v (err=%v)", can_view, err)
+		}
+	}
+}
+
 // TestUserDAO_FindByFirebaseUID_Success はFirebase UIDでユーザーを取得するケースをテストします
 func TestUserDAO_FindByFirebaseUID_Success(t *testing.T) {
 	var ctx context.Context
@@ -197,3 +295,261 @@ func TestUserDAO_ExistsByEmail_NotExists(t *testing.T) {
 		t.Error("expected email to not exist")
 	}
 }
+
+// TestUserDAO_MarkEmailVerified はメールアドレスの確認日時が記録され、確認済みの場合は上書きされないことをテストします
+func TestUserDAO_MarkEmailVerified(t *testing.T) {
+	var ctx context.Context
+	var dao *UserDAO
+	var found_user *models.User
+	var public_id uuid.UUID
+	var verified_at time.Time
+	var err error
+
+	ctx = context.Background()
+	public_id = uuid.New()
+	verified_at = time.Now().Truncate(time.Second)
+	dao = NewUserDAO(NewMockEntClientWithUser(public_id))
+	err = dao.MarkEmailVerified(ctx, public_id, verified_at)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.MarkEmailVerified(ctx, public_id, verified_at.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_user, err = dao.FindByPublicID(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !found_user.IsEmailVerified() || !found_user.EmailVerifiedAt().Equal(verified_at) {
+		t.Errorf("expected email_verified_at to be %v, got %v", verified_at, found_user.EmailVerifiedAt())
+	}
+}
+
+// TestUserDAO_UpdateEmail はメールアドレスと確認日時が更新され、nilの場合は未確認に戻ることをテストします
+func TestUserDAO_UpdateEmail(t *testing.T) {
+	var ctx context.Context
+	var dao *UserDAO
+	var found_user *models.User
+	var public_id uuid.UUID
+	var new_email models.Email
+	var verified_at time.Time
+	var err error
+
+	ctx = context.Background()
+	public_id = uuid.New()
+	new_email, _ = models.NewEmail("new@example.com")
+	verified_at = time.Now().Truncate(time.Second)
+	dao = NewUserDAO(NewMockEntClientWithUser(public_id))
+	err = dao.UpdateEmail(ctx, public_id, new_email, &verified_at)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_user, _ = dao.FindByPublicID(ctx, public_id)
+	if found_user.Email().Value() != "new@example.com" || !found_user.EmailVerifiedAt().Equal(verified_at) {
+		t.Errorf("expected verified new@example.com, got %s (%v)", found_user.Email().Value(), found_user.EmailVerifiedAt())
+	}
+	err = dao.UpdateEmail(ctx, public_id, new_email, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_user, _ = dao.FindByPublicID(ctx, public_id)
+	if found_user.IsEmailVerified() {
+		t.Error("expected email to be unverified")
+	}
+}
+
+// TestUserDAO_UpdateEmail_Duplicate は一意制約違反がErrDuplicateEmailになることをテストします
+func TestUserDAO_UpdateEmail_Duplicate(t *testing.T) {
+	var dao *UserDAO
+	var new_email models.Email
+	var err error
+
+	new_email, _ = models.NewEmail("taken@example.com")
+	dao = NewUserDAO(NewMockEntClientWithDuplicateError())
+	err = dao.UpdateEmail(context.Background(), uuid.New(), new_email, nil)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+}
+
+// TestUserDAO_EnableMfa は二要素認証の有効化と無効化が記録されることをテストします
+func TestUserDAO_EnableMfa(t *testing.T) {
+	var ctx context.Context
+	var dao *UserDAO
+	var found_user *models.User
+	var public_id uuid.UUID
+	var enabled_at time.Time
+	var err error
+
+	ctx = context.Background()
+	public_id = uuid.New()
+	enabled_at = time.Now().Truncate(time.Second)
+	dao = NewUserDAO(NewMockEntClientWithUser(public_id))
+	err = dao.EnableMfa(ctx, public_id, enabled_at)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_user, err = dao.FindByPublicID(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !found_user.IsMfaEnabled() || !found_user.MfaEnabledAt().Equal(enabled_at) {
+		t.Errorf("expected mfa_enabled_at to be %v, got %v", enabled_at, found_user.MfaEnabledAt())
+	}
+	err = dao.DisableMfa(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_user, err = dao.FindByPublicID(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_user.IsMfaEnabled() {
+		t.Error("expected mfa to be disabled")
+	}
+}
+
+// TestUserDAO_UpdatePrivacySettings はプライバシー設定の初期値と保存をテストします
+func TestUserDAO_UpdatePrivacySettings(t *testing.T) {
+	var ctx context.Context
+	var dao *UserDAO
+	var public_id uuid.UUID
+	var settings models.PrivacySettings
+	var found_settings models.PrivacySettings
+	var err error
+
+	ctx = context.Background()
+	public_id = uuid.New()
+	dao = NewUserDAO(NewMockEntClientWithUser(public_id))
+	found_settings, err = dao.FindPrivacySettings(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_settings != models.DefaultPrivacySettings() {
+		t.Errorf("expected default privacy settings, got %+v", found_settings)
+	}
+
+	settings, err = models.NewPrivacySettings(true, true, false, models.CommentPermissionFollowers)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.UpdatePrivacySettings(ctx, public_id, settings)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_settings, err = dao.FindPrivacySettings(ctx, public_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_settings != settings {
+		t.Errorf("expected %+v, got %+v", settings, found_settings)
+	}
+
+	_, err = dao.FindPrivacySettings(ctx, uuid.New())
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// create_test_ent_user はテスト用のEntのユーザーを作成します（deleted_atがnilでない場合は削除済み）
+func create_test_ent_user(id int, firebase_uid string, deleted_at *time.Time) *ent.User {
+	var now time.Time
+
+	now = time.Now()
+	return &ent.User{
+		ID:          id,
+		PublicID:    uuid.New(),
+		FirebaseUID: firebase_uid,
+		Email:       firebase_uid + "@example.com",
+		Role:        user.RoleUser,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   deleted_at,
+	}
+}
+
+// TestUserDAO_ListActiveAfterFirebaseUID は削除されていないユーザーをFirebase UIDの順にページングできることをテストします
+func TestUserDAO_ListActiveAfterFirebaseUID(t *testing.T) {
+	var ctx context.Context
+	var deleted_at time.Time
+	var dao *UserDAO
+	var first_page []*models.User
+	var second_page []*models.User
+	var err error
+
+	ctx = context.Background()
+	deleted_at = time.Now()
+	dao = NewUserDAO(NewMockEntClientWithUsers(
+		create_test_ent_user(1, "uid_c", nil),
+		create_test_ent_user(2, "uid_a", nil),
+		create_test_ent_user(3, "uid_b", &deleted_at),
+		create_test_ent_user(4, "uid_d", nil),
+	))
+	first_page, err = dao.ListActiveAfterFirebaseUID(ctx, "", 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(first_page) != 2 || first_page[0].FirebaseUID() != "uid_a" || first_page[1].FirebaseUID() != "uid_c" {
+		t.Fatalf("expected uid_a and uid_c in first page, got %v", first_page)
+	}
+	second_page, err = dao.ListActiveAfterFirebaseUID(ctx, first_page[1].FirebaseUID(), 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(second_page) != 1 || second_page[0].FirebaseUID() != "uid_d" {
+		t.Errorf("expected uid_d in second page, got %v", second_page)
+	}
+}
+
+// TestUserDAO_FindExistingFirebaseUIDs は削除済みを含めてusersテーブルに存在するFirebase UIDが返されることをテストします
+func TestUserDAO_FindExistingFirebaseUIDs(t *testing.T) {
+	var deleted_at time.Time
+	var dao *UserDAO
+	var existing map[string]bool
+	var err error
+
+	deleted_at = time.Now()
+	dao = NewUserDAO(NewMockEntClientWithUsers(
+		create_test_ent_user(1, "uid_active", nil),
+		create_test_ent_user(2, "uid_deleted", &deleted_at),
+	))
+	existing, err = dao.FindExistingFirebaseUIDs(context.Background(), []string{"uid_active", "uid_deleted", "uid_missing"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !existing["uid_active"] || !existing["uid_deleted"] {
+		t.Errorf("expected active and deleted users to exist, got %v", existing)
+	}
+	if existing["uid_missing"] {
+		t.Error("expected uid_missing to not exist")
+	}
+}
+
+// TestUserDAO_HardDeleteByFirebaseUID は削除されていないユーザーの行のみが物理削除されることをテストします
+func TestUserDAO_HardDeleteByFirebaseUID(t *testing.T) {
+	var ctx context.Context
+	var deleted_at time.Time
+	var client *MockEntClient
+	var dao *UserDAO
+	var err error
+
+	ctx = context.Background()
+	deleted_at = time.Now()
+	client = NewMockEntClientWithUsers(
+		create_test_ent_user(1, "uid_active", nil),
+		create_test_ent_user(2, "uid_deleted", &deleted_at),
+	)
+	dao = NewUserDAO(client)
+	err = dao.HardDeleteByFirebaseUID(ctx, "uid_active")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.HardDeleteByFirebaseUID(ctx, "uid_deleted")
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for deleted user, got %v", err)
+	}
+	if len(client.Users()) != 1 || client.Users()[0].FirebaseUID != "uid_deleted" {
+		t.Errorf("expected only uid_deleted to remain, got %d users", len(client.Users()))
+	}
+}
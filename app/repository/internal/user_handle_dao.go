@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// UserHandleEntClientInterface はUserHandleDAOが利用するEnt Clientのインターフェースです
+type UserHandleEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetUserHandleClient() UserHandleClientInterface
+}
+
+// UserHandleClientInterface はEnt UserHandle Clientのインターフェースです
+type UserHandleClientInterface interface {
+	Create() UserHandleCreateInterface
+	Query() UserHandleQueryInterface
+	Update() UserHandleUpdateInterface
+	Delete() UserHandleDeleteInterface
+}
+
+// UserHandleCreateInterface はEnt UserHandle Create Builderのインターフェースです
+type UserHandleCreateInterface interface {
+	SetUserID(int) UserHandleCreateInterface
+	SetHandle(string) UserHandleCreateInterface
+	SetHandleKey(string) UserHandleCreateInterface
+	SetCreatedAt(time.Time) UserHandleCreateInterface
+	Save(ctx context.Context) (*ent.UserHandle, error)
+}
+
+// UserHandleQueryInterface はEnt UserHandle Query Builderのインターフェースです
+type UserHandleQueryInterface interface {
+	Where(predicates ...any) UserHandleQueryInterface
+	WithUser() UserHandleQueryInterface
+	Only(ctx context.Context) (*ent.UserHandle, error)
+}
+
+// UserHandleUpdateInterface はEnt UserHandle Update Builderのインターフェースです
+type UserHandleUpdateInterface interface {
+	Where(predicates ...any) UserHandleUpdateInterface
+	SetHandle(string) UserHandleUpdateInterface
+	SetRetiredAt(time.Time) UserHandleUpdateInterface
+	Save(ctx context.Context) (int, error)
+}
+
+// UserHandleDeleteInterface はEnt UserHandle Delete Builderのインターフェースです
+type UserHandleDeleteInterface interface {
+	Where(predicates ...any) UserHandleDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// UserHandleDAO はユーザーのハンドルのデータアクセスオブジェクトです
+// 変更前のハンドルも削除されるまでhandle_keyのユニーク制約で他のユーザーによる使用を防ぎます
+type UserHandleDAO struct {
+	client UserHandleEntClientInterface
+}
+
+// NewUserHandleDAO は新しいUserHandleDAOを作成します
+func NewUserHandleDAO(client UserHandleEntClientInterface) *UserHandleDAO {
+	return &UserHandleDAO{
+		client: client,
+	}
+}
+
+// FindCurrentByUserID はユーザーの現在のハンドルを返します（ハンドルが未設定の場合はnilを返します）
+func (d *UserHandleDAO) FindCurrentByUserID(ctx context.Context, user_id uuid.UUID) (*models.UserHandle, error) {
+	var ent_user *ent.User
+	var ent_handle *ent.UserHandle
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, user_id)
+	if err != nil {
+		return nil, err
+	}
+	ent_handle, err = d.client.GetUserHandleClient().
+		Query().
+		Where("user_id", ent_user.ID, "retired_at", nil).
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_user_handle_to_domain(user_id, ent_handle)
+}
+
+// FindByHandle は大文字・小文字を区別せずにハンドルの記録を返します（変更前のハンドルを含み、記録がない場合はnilを返します）
+func (d *UserHandleDAO) FindByHandle(ctx context.Context, handle models.Handle) (*models.UserHandle, error) {
+	var ent_handle *ent.UserHandle
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_handle, err = d.client.GetUserHandleClient().
+		Query().
+		Where("handle_key", handle.Key()).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if ent_handle.Edges.User == nil {
+		return nil, fmt.Errorf("%w: user of handle %s is not loaded", domain_errors.ErrDatabaseError, handle.Key())
+	}
+	return convert_ent_user_handle_to_domain(ent_handle.Edges.User.PublicID, ent_handle)
+}
+
+// Change は新しいハンドルを保存し、変更前のハンドルを変更済みにします
+// 新しいハンドルが既に使用されている場合はErrHandleUnavailableを返します
+// 変更前のハンドルの更新に失敗した場合は、保存した新しいハンドルを削除して元の状態に戻します
+func (d *UserHandleDAO) Change(ctx context.Context, current *models.UserHandle, next *models.UserHandle) error {
+	var ent_user *ent.User
+	var updated_count int
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, next.UserID())
+	if err != nil {
+		return err
+	}
+	_, err = d.client.GetUserHandleClient().
+		Create().
+		SetUserID(ent_user.ID).
+		SetHandle(next.Handle().Value()).
+		SetHandleKey(next.Handle().Key()).
+		SetCreatedAt(next.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return fmt.Errorf("%w: %s", domain_errors.ErrHandleUnavailable, next.Handle().Value())
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if current == nil || current.RetiredAt() == nil {
+		return nil
+	}
+	updated_count, err = d.client.GetUserHandleClient().
+		Update().
+		Where("user_id", ent_user.ID, "handle_key", current.Handle().Key(), "retired_at", nil).
+		SetRetiredAt(*current.RetiredAt()).
+		Save(ctx)
+	if err == nil && updated_count == 1 {
+		return nil
+	}
+	if err == nil {
+		err = fmt.Errorf("current handle %s is not found", current.Handle().Key())
+	}
+	_, _ = d.client.GetUserHandleClient().
+		Delete().
+		Where("user_id", ent_user.ID, "handle_key", next.Handle().Key()).
+		Exec(ctx)
+	return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+}
+
+// Rename は現在のハンドルの表示（大文字・小文字）を更新します
+func (d *UserHandleDAO) Rename(ctx context.Context, user_handle *models.UserHandle) error {
+	var ent_user *ent.User
+	var updated_count int
+	var err error
+
+	ent_user, err = d.find_ent_user(ctx, user_handle.UserID())
+	if err != nil {
+		return err
+	}
+	updated_count, err = d.client.GetUserHandleClient().
+		Update().
+		Where("user_id", ent_user.ID, "handle_key", user_handle.Handle().Key(), "retired_at", nil).
+		SetHandle(user_handle.Handle().Value()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if updated_count == 0 {
+		return fmt.Errorf("%w: current handle %s is not found", domain_errors.ErrDatabaseError, user_handle.Handle().Key())
+	}
+	return nil
+}
+
+// Release は変更済みのハンドルの記録を削除し、再び使用できるようにします（現在のハンドルは削除しません）
+func (d *UserHandleDAO) Release(ctx context.Context, user_handle *models.UserHandle) error {
+	var err error
+
+	if user_handle.RetiredAt() == nil {
+		return fmt.Errorf("%w: current handle cannot be released: %s", domain_errors.ErrDatabaseError, user_handle.Handle().Key())
+	}
+	_, err = d.client.GetUserHandleClient().
+		Delete().
+		Where("handle_key", user_handle.Handle().Key(), "retired_at", *user_handle.RetiredAt()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// find_ent_user は公開IDでハンドルの所有者を検索します
+func (d *UserHandleDAO) find_ent_user(ctx context.Context, user_id uuid.UUID) (*ent.User, error) {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	return ent_user, nil
+}
+
+// convert_ent_user_handle_to_domain はEntのUserHandleをドメインモデルに変換します
+func convert_ent_user_handle_to_domain(user_id uuid.UUID, ent_handle *ent.UserHandle) (*models.UserHandle, error) {
+	var handle models.Handle
+	var user_handle *models.UserHandle
+	var err error
+
+	handle, err = models.NewHandle(ent_handle.Handle)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	user_handle, err = models.NewUserHandleWithStatus(user_id, handle, ent_handle.CreatedAt, ent_handle.RetiredAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return user_handle, nil
+}
@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_user_handle はテスト用のハンドルを作成します
+func create_test_user_handle(t *testing.T, user_id uuid.UUID, value string, now time.Time) *models.UserHandle {
+	var handle models.Handle
+	var user_handle *models.UserHandle
+	var err error
+
+	t.Helper()
+	handle, err = models.NewHandle(value)
+	if err != nil {
+		t.Fatalf("failed to create handle: %v", err)
+	}
+	user_handle, err = models.NewUserHandle(user_id, handle, now)
+	if err != nil {
+		t.Fatalf("failed to create user handle: %v", err)
+	}
+	return user_handle
+}
+
+// TestUserHandleDAO_Change はハンドルの変更と変更前のハンドルの検索をテストします
+func TestUserHandleDAO_Change(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserHandleEntClient
+	var dao *UserHandleDAO
+	var now time.Time
+	var first_handle *models.UserHandle
+	var second_handle *models.UserHandle
+	var found_handle *models.UserHandle
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserHandleEntClient(user_id)
+	dao = NewUserHandleDAO(client)
+	now = time.Now()
+	first_handle = create_test_user_handle(t, user_id, "Taro", now)
+	err = dao.Change(ctx, nil, first_handle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second_handle = create_test_user_handle(t, user_id, "jiro", now)
+	first_handle.Retire(now)
+	err = dao.Change(ctx, first_handle, second_handle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	found_handle, err = dao.FindCurrentByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_handle == nil || found_handle.Handle().Value() != "jiro" {
+		t.Fatalf("expected current handle jiro, got %v", found_handle)
+	}
+	found_handle, err = dao.FindByHandle(ctx, first_handle.Handle())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_handle == nil || found_handle.IsCurrent() || found_handle.UserID() != user_id {
+		t.Errorf("expected retired handle of the user, got %v", found_handle)
+	}
+}
+
+// TestUserHandleDAO_Change_Taken は大文字・小文字が異なる同じハンドルを使用できないことをテストします
+func TestUserHandleDAO_Change_Taken(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserHandleEntClient
+	var dao *UserHandleDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserHandleEntClient(user_id)
+	client.AddUserHandle(uuid.New(), "taro", "taro", time.Now(), nil)
+	dao = NewUserHandleDAO(client)
+
+	err = dao.Change(ctx, nil, create_test_user_handle(t, user_id, "TARO", time.Now()))
+	if !errors.Is(err, domain_errors.ErrHandleUnavailable) {
+		t.Errorf("expected ErrHandleUnavailable, got %v", err)
+	}
+}
+
+// TestUserHandleDAO_Change_RetireFailure は変更前のハンドルの更新に失敗した場合に新しいハンドルを削除することをテストします
+func TestUserHandleDAO_Change_RetireFailure(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserHandleEntClient
+	var dao *UserHandleDAO
+	var now time.Time
+	var current_handle *models.UserHandle
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserHandleEntClientWithUpdateError(user_id)
+	now = time.Now()
+	client.AddUserHandle(user_id, "taro", "taro", now, nil)
+	dao = NewUserHandleDAO(client)
+	current_handle = create_test_user_handle(t, user_id, "taro", now)
+	current_handle.Retire(now)
+
+	err = dao.Change(ctx, current_handle, create_test_user_handle(t, user_id, "jiro", now))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if len(client.Handles()) != 1 || client.Handles()[0].HandleKey != "taro" || client.Handles()[0].RetiredAt != nil {
+		t.Errorf("expected only current handle taro to remain, got %v", client.Handles())
+	}
+}
+
+// TestUserHandleDAO_RenameAndRelease は大文字・小文字の変更と変更済みのハンドルの削除をテストします
+func TestUserHandleDAO_RenameAndRelease(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserHandleEntClient
+	var dao *UserHandleDAO
+	var now time.Time
+	var retired_at time.Time
+	var current_handle *models.UserHandle
+	var renamed_handle models.Handle
+	var retired_handle *models.UserHandle
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserHandleEntClient(user_id)
+	now = time.Now()
+	retired_at = now.Add(-models.HandleRedirectPeriod)
+	client.AddUserHandle(user_id, "taro", "taro", now, nil)
+	client.AddUserHandle(uuid.New(), "jiro", "jiro", now, &retired_at)
+	dao = NewUserHandleDAO(client)
+
+	current_handle, _ = dao.FindCurrentByUserID(ctx, user_id)
+	renamed_handle, _ = models.NewHandle("Taro")
+	_ = current_handle.Rename(renamed_handle)
+	err = dao.Rename(ctx, current_handle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.Handles()[0].Handle != "Taro" {
+		t.Errorf("expected handle to be renamed to Taro, got %s", client.Handles()[0].Handle)
+	}
+
+	retired_handle, _ = dao.FindByHandle(ctx, create_test_user_handle(t, user_id, "jiro", now).Handle())
+	err = dao.Release(ctx, retired_handle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Handles()) != 1 {
+		t.Errorf("expected retired handle to be deleted, got %d handles", len(client.Handles()))
+	}
+	err = dao.Release(ctx, current_handle)
+	if err == nil {
+		t.Error("expected error when releasing current handle, got nil")
+	}
+}
+
+// TestUserHandleDAO_NotFound はハンドルが存在しない場合にnilを返すことをテストします
+func TestUserHandleDAO_NotFound(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *UserHandleDAO
+	var found_handle *models.UserHandle
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewUserHandleDAO(NewMockUserHandleEntClient(user_id))
+
+	found_handle, err = dao.FindCurrentByUserID(ctx, user_id)
+	if err != nil || found_handle != nil {
+		t.Errorf("expected nil handle and no error, got %v, %v", found_handle, err)
+	}
+	found_handle, err = dao.FindByHandle(ctx, create_test_user_handle(t, user_id, "taro", time.Now()).Handle())
+	if err != nil || found_handle != nil {
+		t.Errorf("expected nil handle and no error, got %v, %v", found_handle, err)
+	}
+}
+
+// TestUserHandleDAO_DatabaseError はDBエラーがErrDatabaseErrorとして返されることをテストします
+func TestUserHandleDAO_DatabaseError(t *testing.T) {
+	var dao *UserHandleDAO
+	var err error
+
+	dao = NewUserHandleDAO(NewMockUserHandleEntClientWithDatabaseError(uuid.New()))
+	_, err = dao.FindByHandle(context.Background(), create_test_user_handle(t, uuid.New(), "taro", time.Now()).Handle())
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+	"sleeve/ent/useridentity"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentityEntClientInterface はUserIdentityDAOが利用するEnt Clientのインターフェースです
+type UserIdentityEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetUserIdentityClient() UserIdentityClientInterface
+}
+
+// UserIdentityClientInterface はEnt UserIdentity Clientのインターフェースです
+type UserIdentityClientInterface interface {
+	Create() UserIdentityCreateInterface
+	Query() UserIdentityQueryInterface
+	Delete() UserIdentityDeleteInterface
+}
+
+// UserIdentityCreateInterface はEnt UserIdentity Create Builderのインターフェースです
+type UserIdentityCreateInterface interface {
+	SetUserID(int) UserIdentityCreateInterface
+	SetProvider(useridentity.Provider) UserIdentityCreateInterface
+	SetSubject(string) UserIdentityCreateInterface
+	SetLinkedAt(time.Time) UserIdentityCreateInterface
+	Save(ctx context.Context) (*ent.UserIdentity, error)
+}
+
+// UserIdentityQueryInterface はEnt UserIdentity Query Builderのインターフェースです
+type UserIdentityQueryInterface interface {
+	Where(predicates ...any) UserIdentityQueryInterface
+	WithUser() UserIdentityQueryInterface
+	Only(ctx context.Context) (*ent.UserIdentity, error)
+	All(ctx context.Context) ([]*ent.UserIdentity, error)
+}
+
+// UserIdentityDeleteInterface はEnt UserIdentity Delete Builderのインターフェースです
+type UserIdentityDeleteInterface interface {
+	Where(predicates ...any) UserIdentityDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// UserIdentityDAO はユーザーに連携された外部プロバイダアカウントのデータアクセスオブジェクトです
+type UserIdentityDAO struct {
+	client UserIdentityEntClientInterface
+}
+
+// NewUserIdentityDAO は新しいUserIdentityDAOを作成します
+func NewUserIdentityDAO(client UserIdentityEntClientInterface) *UserIdentityDAO {
+	return &UserIdentityDAO{
+		client: client,
+	}
+}
+
+// Save は外部プロバイダアカウントの連携をDBに保存します
+// 外部アカウントが連携済み、またはユーザーが同じプロバイダを連携済みの場合はErrProviderAlreadyLinkedを返します
+func (d *UserIdentityDAO) Save(ctx context.Context, identity *models.UserIdentity) error {
+	var ent_user *ent.User
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", identity.UserID()).
+		Only(ctx)
+	if err != nil {
+		return handle_query_error(err)
+	}
+	_, err = d.client.GetUserIdentityClient().
+		Create().
+		SetUserID(ent_user.ID).
+		SetProvider(useridentity.Provider(identity.Provider().String())).
+		SetSubject(identity.Subject()).
+		SetLinkedAt(identity.LinkedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return fmt.Errorf("%w: %s", domain_errors.ErrProviderAlreadyLinked, identity.Provider())
+		}
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// FindByProviderSubject は外部プロバイダとsubjectで連携を検索します
+// 連携が存在しない場合はErrProviderNotLinkedを返します
+func (d *UserIdentityDAO) FindByProviderSubject(ctx context.Context, provider models.AuthProvider, subject string) (*models.UserIdentity, error) {
+	var ent_identity *ent.UserIdentity
+	var err error
+
+	ctx = with_deleted_users(ctx)
+	ent_identity, err = d.client.GetUserIdentityClient().
+		Query().
+		Where("provider", provider.String(), "subject", subject).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if is_not_found_error(err) {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrProviderNotLinked, provider)
+		}
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return convert_ent_user_identity_to_domain(ent_identity)
+}
+
+// FindByUserID はユーザーに連携された外部プロバイダアカウントを全て返します
+func (d *UserIdentityDAO) FindByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.UserIdentity, error) {
+	var ent_user *ent.User
+	var ent_identities []*ent.UserIdentity
+	var identities []*models.UserIdentity
+	var identity *models.UserIdentity
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return nil, handle_query_error(err)
+	}
+	ctx = with_deleted_users(ctx)
+	ent_identities, err = d.client.GetUserIdentityClient().
+		Query().
+		Where("user_id", ent_user.ID).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	identities = make([]*models.UserIdentity, 0, len(ent_identities))
+	for _, ent_identity := range ent_identities {
+		identity, err = convert_ent_user_identity_to_domain(ent_identity)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, nil
+}
+
+// Delete はユーザーと外部プロバイダの連携を削除します
+// 連携が存在しない場合はErrProviderNotLinkedを返します
+func (d *UserIdentityDAO) Delete(ctx context.Context, user_id uuid.UUID, provider models.AuthProvider) error {
+	var ent_user *ent.User
+	var deleted_count int
+	var err error
+
+	ent_user, err = d.client.GetUserClient().
+		Query().
+		Where("public_id", user_id).
+		Only(ctx)
+	if err != nil {
+		return handle_query_error(err)
+	}
+	deleted_count, err = d.client.GetUserIdentityClient().
+		Delete().
+		Where("user_id", ent_user.ID, "provider", provider.String()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	if deleted_count == 0 {
+		return fmt.Errorf("%w: %s", domain_errors.ErrProviderNotLinked, provider)
+	}
+	return nil
+}
+
+// convert_ent_user_identity_to_domain はEntのUserIdentityエンティティをドメインモデルに変換します
+func convert_ent_user_identity_to_domain(ent_identity *ent.UserIdentity) (*models.UserIdentity, error) {
+	var ent_user *ent.User
+	var provider models.AuthProvider
+	var domain_identity *models.UserIdentity
+	var err error
+
+	ent_user, err = ent_identity.Edges.UserOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	provider, err = models.NewAuthProvider(ent_identity.Provider.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	domain_identity, err = models.NewUserIdentity(ent_user.PublicID, provider, ent_identity.Subject, ent_identity.LinkedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return domain_identity, nil
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_user_identity はテスト用の外部プロバイダ連携を作成します
+func create_test_user_identity(t *testing.T, user_id uuid.UUID, provider models.AuthProvider, subject string) *models.UserIdentity {
+	var identity *models.UserIdentity
+	var err error
+
+	t.Helper()
+	identity, err = models.NewUserIdentity(user_id, provider, subject, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create user identity: %v", err)
+	}
+	return identity
+}
+
+// TestUserIdentityDAO_Save_Success は連携の保存と検索が成功するケースをテストします
+func TestUserIdentityDAO_Save_Success(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserIdentityEntClient
+	var dao *UserIdentityDAO
+	var found_identity *models.UserIdentity
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserIdentityEntClient(user_id)
+	dao = NewUserIdentityDAO(client)
+	err = dao.Save(ctx, create_test_user_identity(t, user_id, models.AuthProviderGoogle, "google_sub_123"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	found_identity, err = dao.FindByProviderSubject(ctx, models.AuthProviderGoogle, "google_sub_123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found_identity.UserID() != user_id {
+		t.Errorf("expected user_id %s, got %s", user_id, found_identity.UserID())
+	}
+	if found_identity.Provider() != models.AuthProviderGoogle {
+		t.Errorf("expected provider google, got %s", found_identity.Provider())
+	}
+}
+
+// TestUserIdentityDAO_Save_AlreadyLinked は同じ外部アカウントを重複して連携するケースをテストします
+func TestUserIdentityDAO_Save_AlreadyLinked(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *UserIdentityDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewUserIdentityDAO(NewMockUserIdentityEntClient(user_id))
+	err = dao.Save(ctx, create_test_user_identity(t, user_id, models.AuthProviderLINE, "U123"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = dao.Save(ctx, create_test_user_identity(t, user_id, models.AuthProviderLINE, "U123"))
+	if !errors.Is(err, domain_errors.ErrProviderAlreadyLinked) {
+		t.Errorf("expected ErrProviderAlreadyLinked, got %v", err)
+	}
+}
+
+// TestUserIdentityDAO_FindByProviderSubject_NotLinked は連携が存在しないケースをテストします
+func TestUserIdentityDAO_FindByProviderSubject_NotLinked(t *testing.T) {
+	var ctx context.Context
+	var dao *UserIdentityDAO
+	var err error
+
+	ctx = context.Background()
+	dao = NewUserIdentityDAO(NewMockUserIdentityEntClient(uuid.New()))
+	_, err = dao.FindByProviderSubject(ctx, models.AuthProviderApple, "apple_sub_123")
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		t.Errorf("expected ErrProviderNotLinked, got %v", err)
+	}
+}
+
+// TestUserIdentityDAO_FindByUserID はユーザーの連携一覧を取得するケースをテストします
+func TestUserIdentityDAO_FindByUserID(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *UserIdentityDAO
+	var identities []*models.UserIdentity
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewUserIdentityDAO(NewMockUserIdentityEntClient(user_id))
+	for _, provider := range []models.AuthProvider{models.AuthProviderGoogle, models.AuthProviderX} {
+		err = dao.Save(ctx, create_test_user_identity(t, user_id, provider, "sub_"+provider.String()))
+		if err != nil {
+			t.Fatalf("failed to save identity: %v", err)
+		}
+	}
+	identities, err = dao.FindByUserID(ctx, user_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(identities) != 2 {
+		t.Errorf("expected 2 identities, got %d", len(identities))
+	}
+}
+
+// TestUserIdentityDAO_Delete は連携の削除と、未連携のプロバイダの削除をテストします
+func TestUserIdentityDAO_Delete(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var client *MockUserIdentityEntClient
+	var dao *UserIdentityDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	client = NewMockUserIdentityEntClient(user_id)
+	dao = NewUserIdentityDAO(client)
+	err = dao.Save(ctx, create_test_user_identity(t, user_id, models.AuthProviderGoogle, "google_sub_123"))
+	if err != nil {
+		t.Fatalf("failed to save identity: %v", err)
+	}
+	err = dao.Delete(ctx, user_id, models.AuthProviderGoogle)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(client.Identities()) != 0 {
+		t.Errorf("expected 0 identities, got %d", len(client.Identities()))
+	}
+	err = dao.Delete(ctx, user_id, models.AuthProviderGoogle)
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		t.Errorf("expected ErrProviderNotLinked, got %v", err)
+	}
+}
+
+// TestUserIdentityDAO_Save_DatabaseError はDBエラー時にエラーを返すケースをテストします
+func TestUserIdentityDAO_Save_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var user_id uuid.UUID
+	var dao *UserIdentityDAO
+	var err error
+
+	ctx = context.Background()
+	user_id = uuid.New()
+	dao = NewUserIdentityDAO(NewMockUserIdentityEntClientWithDatabaseError(user_id))
+	err = dao.Save(ctx, create_test_user_identity(t, user_id, models.AuthProviderGoogle, "google_sub_123"))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// UserMuteEntClientInterface はUserMuteDAOが利用するEnt Clientのインターフェースです
+type UserMuteEntClientInterface interface {
+	GetUserClient() UserClientInterface
+	GetUserMuteClient() UserMuteClientInterface
+}
+
+// UserMuteClientInterface はEnt UserMute Clientのインターフェースです
+type UserMuteClientInterface interface {
+	Create() UserMuteCreateInterface
+	Query() UserMuteQueryInterface
+	Delete() UserMuteDeleteInterface
+}
+
+// UserMuteCreateInterface はEnt UserMute Create Builderのインターフェースです
+type UserMuteCreateInterface interface {
+	SetMuterID(int) UserMuteCreateInterface
+	SetMutedID(int) UserMuteCreateInterface
+	SetCreatedAt(time.Time) UserMuteCreateInterface
+	Save(ctx context.Context) (*ent.UserMute, error)
+}
+
+// UserMuteQueryInterface はEnt UserMute Query Builderのインターフェースです
+type UserMuteQueryInterface interface {
+	Where(predicates ...any) UserMuteQueryInterface
+	WithMuted() UserMuteQueryInterface
+	Exist(ctx context.Context) (bool, error)
+	All(ctx context.Context) ([]*ent.UserMute, error)
+}
+
+// UserMuteDeleteInterface はEnt UserMute Delete Builderのインターフェースです
+type UserMuteDeleteInterface interface {
+	Where(predicates ...any) UserMuteDeleteInterface
+	Exec(ctx context.Context) (int, error)
+}
+
+// UserMuteDAO はユーザー間のミュートのデータアクセスオブジェクトです
+type UserMuteDAO struct {
+	client UserMuteEntClientInterface
+}
+
+// NewUserMuteDAO は新しいUserMuteDAOを作成します
+func NewUserMuteDAO(client UserMuteEntClientInterface) *UserMuteDAO {
+	return &UserMuteDAO{
+		client: client,
+	}
+}
+
+// Create はミュートを保存します
+// 既にミュートしている場合は何も変更せずfalseを返します
+func (d *UserMuteDAO) Create(ctx context.Context, user_mute *models.UserMute) (bool, error) {
+	var muter *ent.User
+	var muted *ent.User
+	var err error
+
+	muter, muted, err = find_ent_user_pair(ctx, d.client, user_mute.MuterID(), user_mute.MutedID())
+	if err != nil {
+		return false, err
+	}
+	_, err = d.client.GetUserMuteClient().
+		Create().
+		SetMuterID(muter.ID).
+		SetMutedID(muted.ID).
+		SetCreatedAt(user_mute.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if is_unique_constraint_error(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return true, nil
+}
+
+// Delete はミュートを解除します
+// ミュートしていない場合は何も変更せずfalseを返します
+func (d *UserMuteDAO) Delete(ctx context.Context, muter_id uuid.UUID, muted_id uuid.UUID) (bool, error) {
+	var muter *ent.User
+	var muted *ent.User
+	var deleted_count int
+	var err error
+
+	muter, muted, err = find_ent_user_pair(ctx, d.client, muter_id, muted_id)
+	if err != nil {
+		return false, err
+	}
+	deleted_count, err = d.client.GetUserMuteClient().
+		Delete().
+		Where("muter_id", muter.ID, "muted_id", muted.ID).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return deleted_count > 0, nil
+}
+
+// Exists はミュートしているかを返します
+func (d *UserMuteDAO) Exists(ctx context.Context, muter_id uuid.UUID, muted_id uuid.UUID) (bool, error) {
+	var muter *ent.User
+	var muted *ent.User
+	var exists bool
+	var err error
+
+	muter, muted, err = find_ent_user_pair(ctx, d.client, muter_id, muted_id)
+	if err != nil {
+		return false, err
+	}
+	exists, err = d.client.GetUserMuteClient().
+		Query().
+		Where("muter_id", muter.ID, "muted_id", muted.ID).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	return exists, nil
+}
+
+// ListMutedIDs はミュートしたユーザーのpublic_idの一覧をミュートした日時の順に返します（データのエクスポート用）
+func (d *UserMuteDAO) ListMutedIDs(ctx context.Context, muter_id uuid.UUID) ([]uuid.UUID, error) {
+	var ent_user *ent.User
+	var ent_mutes []*ent.UserMute
+	var muted_ids []uuid.UUID
+	var err error
+
+	ent_user, err = find_ent_user_by_public_id(ctx, d.client, muter_id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = with_deleted_users(ctx)
+	ent_mutes, err = d.client.GetUserMuteClient().
+		Query().
+		Where("muter_id", ent_user.ID).
+		WithMuted().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+	}
+	muted_ids = make([]uuid.UUID, 0, len(ent_mutes))
+	for _, ent_mute := range ent_mutes {
+		var muted *ent.User
+
+		muted, err = ent_mute.Edges.MutedOrErr()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
+		}
+		muted_ids = append(muted_ids, muted.PublicID)
+	}
+	return muted_ids, nil
+}
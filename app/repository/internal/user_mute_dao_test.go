@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/ent"
+
+	"github.com/google/uuid"
+)
+
+// TestUserMuteDAO_CreateAndDelete はミュート・ミュート解除の冪等性と片方向の判定をテストします
+func TestUserMuteDAO_CreateAndDelete(t *testing.T) {
+	var ctx context.Context
+	var client *MockUserMuteEntClient
+	var dao *UserMuteDAO
+	var muter *ent.User
+	var muted *ent.User
+	var user_mute *models.UserMute
+	var created bool
+	var deleted bool
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUserMuteEntClient()
+	dao = NewUserMuteDAO(client)
+	muter = client.AddUser(uuid.New())
+	muted = client.AddUser(uuid.New())
+	user_mute, _ = models.NewUserMute(muter.PublicID, muted.PublicID, time.Now())
+
+	for i, expected := range []bool{true, false} {
+		created, err = dao.Create(ctx, user_mute)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created != expected {
+			t.Errorf("attempt %d: expected created %v, got %v", i+1, expected, created)
+		}
+	}
+	exists, err = dao.Exists(ctx, muter.PublicID, muted.PublicID)
+	if err != nil || !exists {
+		t.Errorf("expected mute to exist, got %v (err=%v)", exists, err)
+	}
+	// ミュートは片方向のみ
+	exists, err = dao.Exists(ctx, muted.PublicID, muter.PublicID)
+	if err != nil || exists {
+		t.Errorf("expected reverse mute not to exist, got %v (err=%v)", exists, err)
+	}
+
+	for i, expected := range []bool{true, false} {
+		deleted, err = dao.Delete(ctx, muter.PublicID, muted.PublicID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != expected {
+			t.Errorf("attempt %d: expected deleted %v, got %v", i+1, expected, deleted)
+		}
+	}
+}
+
+// TestUserMuteDAO_ListMutedIDs はmuter側のユーザーから見た一覧のみが返されることをテストします
+func TestUserMuteDAO_ListMutedIDs(t *testing.T) {
+	var ctx context.Context
+	var client *MockUserMuteEntClient
+	var dao *UserMuteDAO
+	var muter *ent.User
+	var first *ent.User
+	var second *ent.User
+	var user_mute *models.UserMute
+	var ids []uuid.UUID
+	var err error
+
+	ctx = context.Background()
+	client = NewMockUserMuteEntClient()
+	dao = NewUserMuteDAO(client)
+	muter = client.AddUser(uuid.New())
+	first = client.AddUser(uuid.New())
+	second = client.AddUser(uuid.New())
+	for _, target := range []*ent.User{first, second} {
+		user_mute, _ = models.NewUserMute(muter.PublicID, target.PublicID, time.Now())
+		_, _ = dao.Create(ctx, user_mute)
+	}
+	user_mute, _ = models.NewUserMute(first.PublicID, muter.PublicID, time.Now())
+	_, _ = dao.Create(ctx, user_mute)
+
+	ids, err = dao.ListMutedIDs(ctx, muter.PublicID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(ids) != 2 || ids[0] != first.PublicID || ids[1] != second.PublicID {
+		t.Errorf("expected [%s %s], got %v", first.PublicID, second.PublicID, ids)
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"time"
+
+	"sleeve/ent"
+	"sleeve/repository/internal"
+)
+
+// パスワードリセットの要求をメールアドレスごとに、確認メールの再送信・メールアドレスの変更要求をユーザーごとに制限する回数と期間
+const (
+	passwordResetRequestLimit      = 3
+	passwordResetRequestWindow     = time.Hour
+	verificationEmailRequestLimit  = 3
+	verificationEmailRequestWindow = time.Hour
+	emailChangeRequestLimit        = 3
+	emailChangeRequestWindow       = time.Hour
+)
+
+// Repositories はサーバー起動時に組み立てる内部サービス用のリポジトリ一式です
+// repository/internal はこのパッケージ配下からのみ参照できるため、ここで生成して公開します
+type Repositories struct {
+	UserDAO           *internal.UserDAO
+	RefreshTokenDAO   *internal.RefreshTokenDAO
+	UserIdentityDAO   *internal.UserIdentityDAO
+	TotpCredentialDAO *internal.TotpCredentialDAO
+	TokenDenylist     *internal.TokenDenylistCache
+	// TokenDenylistDAO はTokenDenylistのキャッシュを経由せずに期限切れの登録を削除するために使用します
+	TokenDenylistDAO *internal.TokenDenylistDAO
+	// SessionDAO はログイン中の端末（セッション）を保存します
+	SessionDAO *internal.SessionDAO
+	// CompensationTaskDAO は失敗したFirebaseアカウントの削除などの補償処理を再試行のために保存します
+	CompensationTaskDAO *internal.CompensationTaskDAO
+	// PasswordResetRateLimiter はパスワードリセット要求のメールアドレスごとのレートリミッターです
+	PasswordResetRateLimiter *internal.FixedWindowRateLimiter
+	// VerificationEmailRateLimiter は確認メール再送信のユーザーごとのレートリミッターです
+	VerificationEmailRateLimiter *internal.FixedWindowRateLimiter
+	// AuthAttemptDAO は認証の失敗回数を複数インスタンスで共有するためにPostgresへ保存します
+	AuthAttemptDAO *internal.AuthAttemptDAO
+	// InMemoryAttemptCounter は認証の失敗回数をメモリに保持します（ローカル開発・単一インスタンス用）
+	InMemoryAttemptCounter *internal.InMemoryAttemptCounter
+	// IdempotencyRecordDAO は冪等キーごとのリクエストの処理状況を保存します（登録の再送信の検出）
+	IdempotencyRecordDAO *internal.IdempotencyRecordDAO
+	// ProfileDAO はユーザーの公開プロフィールを保存します
+	ProfileDAO *internal.ProfileDAO
+	// UserHandleDAO はユーザーの@ハンドルと変更前のハンドルを保存します
+	UserHandleDAO *internal.UserHandleDAO
+	// FollowDAO はユーザー間のフォロー関係とフォロワー数・フォロー数を保存します
+	FollowDAO *internal.FollowDAO
+	// FollowRequestDAO は非公開アカウントへの承認待ちのフォローリクエストを保存します
+	FollowRequestDAO *internal.FollowRequestDAO
+	// UserBlockDAO はユーザー間のブロックを保存します
+	UserBlockDAO *internal.UserBlockDAO
+	// UserMuteDAO はユーザー間のミュートを保存します
+	UserMuteDAO *internal.UserMuteDAO
+	// DataExportDAO は個人データのエクスポートの状況とダウンロードリンクのトークンのハッシュを保存します
+	DataExportDAO *internal.DataExportDAO
+	// EmailChangeRequestDAO は確認待ちのメールアドレスの変更と確認リンクのトークンのハッシュを保存します
+	EmailChangeRequestDAO *internal.EmailChangeRequestDAO
+	// EmailChangeRateLimiter はメールアドレスの変更要求のユーザーごとのレートリミッターです
+	EmailChangeRateLimiter *internal.FixedWindowRateLimiter
+	// UploadedImageDAO はアップロードした画像のストレージ上のキーとアップロードしたユーザーを保存します
+	UploadedImageDAO *internal.UploadedImageDAO
+}
+
+// NewRepositories はEnt Clientからリポジトリ一式を作成します
+func NewRepositories(client *ent.Client) *Repositories {
+	var ent_client *internal.EntClient
+	var token_denylist_dao *internal.TokenDenylistDAO
+
+	ent_client = internal.NewEntClient(client)
+	token_denylist_dao = internal.NewTokenDenylistDAO(ent_client)
+	return &Repositories{
+		UserDAO:           internal.NewUserDAO(ent_client),
+		RefreshTokenDAO:   internal.NewRefreshTokenDAO(ent_client),
+		UserIdentityDAO:   internal.NewUserIdentityDAO(ent_client),
+		TotpCredentialDAO: internal.NewTotpCredentialDAO(ent_client),
+		TokenDenylist: internal.NewTokenDenylistCache(
+			token_denylist_dao,
+			internal.DefaultDenylistNegativeCacheTTL,
+		),
+		TokenDenylistDAO:             token_denylist_dao,
+		SessionDAO:                   internal.NewSessionDAO(ent_client),
+		CompensationTaskDAO:          internal.NewCompensationTaskDAO(ent_client),
+		PasswordResetRateLimiter:     internal.NewFixedWindowRateLimiter(passwordResetRequestLimit, passwordResetRequestWindow),
+		VerificationEmailRateLimiter: internal.NewFixedWindowRateLimiter(verificationEmailRequestLimit, verificationEmailRequestWindow),
+		AuthAttemptDAO:               internal.NewAuthAttemptDAO(ent_client),
+		InMemoryAttemptCounter:       internal.NewInMemoryAttemptCounter(),
+		IdempotencyRecordDAO:         internal.NewIdempotencyRecordDAO(ent_client),
+		ProfileDAO:                   internal.NewProfileDAO(ent_client),
+		UserHandleDAO:                internal.NewUserHandleDAO(ent_client),
+		FollowDAO:                    internal.NewFollowDAO(ent_client),
+		FollowRequestDAO:             internal.NewFollowRequestDAO(ent_client),
+		UserBlockDAO:                 internal.NewUserBlockDAO(ent_client),
+		UserMuteDAO:                  internal.NewUserMuteDAO(ent_client),
+		DataExportDAO:                internal.NewDataExportDAO(ent_client),
+		EmailChangeRequestDAO:        internal.NewEmailChangeRequestDAO(ent_client),
+		EmailChangeRateLimiter:       internal.NewFixedWindowRateLimiter(emailChangeRequestLimit, emailChangeRequestWindow),
+		UploadedImageDAO:             internal.NewUploadedImageDAO(ent_client),
+	}
+}
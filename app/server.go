@@ -1,14 +1,37 @@
 package main
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
 	"log"
+	"mime"
 	"net/http"
 	"os"
+	"path"
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
 	"sleeve/ent"
 	"sleeve/graph"
+	"sleeve/jobs"
+	"sleeve/middlewares"
+	"sleeve/repository"
 	entdb "sleeve/repository/external/ent"
+	"sleeve/repository/external/firebase"
+	"sleeve/repository/external/line"
+	"sleeve/repository/external/mail"
+	"sleeve/repository/external/security"
+	"sleeve/repository/external/storage"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+	"strings"
 	"time"
 
+	"firebase.google.com/go/v4/auth"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -25,11 +48,49 @@ const (
 	serverWriteTimeout      = 15 * time.Second
 	serverIdleTimeout       = 60 * time.Second
 	serverReadHeaderTimeout = 10 * time.Second
+	jwksCacheMaxAge         = 5 * time.Minute
+	defaultSMTPPort         = "587"
+	defaultDataExportDir    = "data/exports"
+	defaultMediaDir         = "data/media"
+	defaultAppBaseURL       = "http://localhost:" + defaultPort
+	// idempotencyFingerprintKeyMinBytes は冪等キーの記録のフィンガープリントの秘密鍵の最小バイト数です
+	idempotencyFingerprintKeyMinBytes = 32
 )
 
+// 画像のアップロード・配信の設定
+const (
+	// multipartFormOverhead は画像以外のフォームの項目（operations・map）と区切りに許容するサイズです
+	multipartFormOverhead = 1 << 20
+	// multipartMaxMemory はアップロードされたファイルをメモリに保持する上限です（超えた分は一時ファイルに書き込まれます）
+	multipartMaxMemory = 2 << 20
+	// mediaCacheControl はローカルに保存した画像を配信する際のCache-Controlです（キーはアップロードごとに異なるため長期間キャッシュさせる）
+	mediaCacheControl = "public, max-age=31536000, immutable"
+)
+
+// emailChangeConfirmPage はメールアドレスの変更の確認リンクを開いた際に表示するページです
+// メールのリンクを開いただけでは確定せず、ページのボタンからPOSTした場合のみ確定します
+var emailChangeConfirmPage = template.Must(template.New("email_change_confirm").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>メールアドレスの変更</title></head>
+<body>
+<p>下のボタンを押すと、メールアドレスの変更が完了します。</p>
+<form method="post" action="/email-change/confirm">
+<input type="hidden" name="token" value="{{.}}">
+<button type="submit">メールアドレスを変更する</button>
+</form>
+</body>
+</html>
+`))
+
 func main() {
 	var port string
 	var client *ent.Client
+	var dependencies *server_dependencies
+	var key_ring *utils.KeyRing
+	var use_cases *maintenance_use_cases
+	var media_storage user.BlobStorage
+	var local_media_storage *storage.LocalBlobStorage
+	var is_local_media bool
 	var err error
 
 	port = os.Getenv("PORT")
@@ -46,11 +107,54 @@ func main() {
 
 	log.Println("DB connected successfully!")
 
-	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{Client: client}}))
+	// サブコマンドが指定された場合はサーバーを起動せずにメンテナンス処理を実行する
+	if len(os.Args) > 1 {
+		err = run_command(context.Background(), client, os.Args[1:])
+		if err != nil {
+			log.Fatalf("コマンドの実行エラー: %v", err)
+		}
+		return
+	}
+
+	// 依存関係を組み立てる
+	key_ring, err = load_key_ring()
+	if err != nil {
+		log.Fatalf("JWT鍵の読み込みエラー: %v", err)
+	}
+	media_storage, err = new_media_storage()
+	if err != nil {
+		log.Fatalf("初期化エラー: %v", err)
+	}
+	dependencies, err = build_dependencies(client, key_ring, media_storage)
+	if err != nil {
+		log.Fatalf("初期化エラー: %v", err)
+	}
+
+	// 補償処理の再試行・Firebaseとusersテーブルの整合性チェック・有効期限切れの認証の失敗回数と冪等キーの記録の削除・
+	// 退会ユーザーの物理削除・個人データのエクスポートの作成と期限切れのエクスポートの削除を定期実行する
+	// 複数のインスタンスを起動する場合はDISABLE_SCHEDULED_JOBS=trueで1台以外の定期実行を無効にする
+	if os.Getenv("DISABLE_SCHEDULED_JOBS") != "true" {
+		use_cases, err = build_maintenance_use_cases(client)
+		if err != nil {
+			log.Fatalf("初期化エラー: %v", err)
+		}
+		jobs.NewScheduler(new_scheduled_jobs(use_cases)...).Start(context.Background())
+	}
+
+	srv := handler.New(graph.NewExecutableSchema(dependencies.config))
 
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
+	// uploadImageの画像をGraphQL multipart request（multipart/form-data）で受け付ける
+	// 認証はAuthorizationヘッダーのみで行うため、フォームの送信によるCSRFの対象にはならない
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: models.MaxImageUploadSize + multipartFormOverhead,
+		MaxMemory:     multipartMaxMemory,
+	})
+
+	// 認可エラー（@auth / @hasRole / @verifiedEmail）にエラーコードを付与
+	srv.SetErrorPresenter(error_presenter)
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](queryCacheSize))
 
@@ -60,7 +164,24 @@ func main() {
 	})
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	// 認証ミドルウェア（Authorizationヘッダーがない場合は未認証のまま通過）
+	// 端末情報ミドルウェア（ログイン時にセッションへ記録する端末名・IPアドレス・User-Agentを取得）
+	// ロードバランサー経由の場合はTRUST_PROXY_HEADERS=trueでX-Forwarded-ForのIPアドレスを使用する
+	// 冪等キーミドルウェア（registerUserの再送信を識別するIdempotency-Keyヘッダーを取得）
+	http.Handle("/query", middlewares.NewClientInfoMiddleware(os.Getenv("TRUST_PROXY_HEADERS") == "true").Handler(
+		middlewares.NewIdempotencyKeyMiddleware().Handler(dependencies.auth_middleware.Handler(srv)),
+	))
+	// 他サービスがアクセストークンを検証するための公開鍵
+	http.Handle("/.well-known/jwks.json", jwks_handler(key_ring))
+	// メールで送信したダウンロードリンクから個人データのエクスポートのZIPファイルを返す（リンクのトークンで認可する）
+	http.Handle("/data-exports/", data_export_download_handler(dependencies.download_data_export))
+	// 変更後のメールアドレスに送信した確認リンクからメールアドレスの変更を確定する（リンクのトークンで認可する）
+	http.Handle("/email-change/confirm", email_change_confirm_handler(dependencies.confirm_email_change))
+	// ローカルのディレクトリに保存した画像を配信する（S3互換ストレージの場合はストレージ・CDNから直接配信する）
+	local_media_storage, is_local_media = media_storage.(*storage.LocalBlobStorage)
+	if is_local_media {
+		http.Handle("/media/", media_handler(local_media_storage))
+	}
 
 	server := &http.Server{
 		Addr:              ":" + port,
@@ -75,3 +196,505 @@ func main() {
 		log.Printf("Server error: %v", err)
 	}
 }
+
+// server_dependencies はHTTPサーバーのハンドラーで使用する依存関係です
+type server_dependencies struct {
+	config               graph.Config
+	auth_middleware      *middlewares.AuthMiddleware
+	download_data_export *user.DownloadDataExportUseCase
+	confirm_email_change *user.ConfirmEmailChangeUseCase
+}
+
+// build_dependencies はGraphQLの設定（Resolver・ディレクティブ）・認証ミドルウェア・HTTPハンドラーのユースケースの依存関係を組み立てます
+// リポジトリ（レート制限・トークンの失効リストのキャッシュを含む）とFirebaseクライアントは全てのハンドラーで共有します
+func build_dependencies(client *ent.Client, key_ring *utils.KeyRing, media_storage user.BlobStorage) (*server_dependencies, error) {
+	var repositories *repository.Repositories
+	var jwt_service *utils.JWTService
+	var auth_client *auth.Client
+	var firebase_user_repo *firebase.FirebaseUserRepository
+	var token_issuer *user.TokenIssuer
+	var session_revoker *user.UserSessionRevoker
+	var mail_sender mail_sender_interface
+	var verification_mailer *user.EmailVerificationMailer
+	var provider_token_verifier *user.ProviderTokenVerifier
+	var secret_cipher *utils.SecretCipher
+	var fingerprint_key []byte
+	var mfa_code_verifier *user.MfaCodeVerifier
+	var attempt_guard *user.AuthAttemptGuard
+	var compensator *user.FirebaseUserCompensator
+	var privacy_guard *user.PrivacyGuard
+	var summary_builder *user.UserSummaryBuilder
+	var resolver *graph.Resolver
+	var err error
+
+	// Repository層
+	repositories = repository.NewRepositories(client)
+	auth_client, err = firebase.NewAuthClient()
+	if err != nil {
+		return nil, fmt.Errorf("Firebase初期化エラー: %w", err)
+	}
+	firebase_user_repo = firebase.NewFirebaseUserRepository(auth_client)
+
+	// UseCase層
+	jwt_service = utils.NewJWTService(key_ring, repositories.TokenDenylist)
+	// ログイン・登録ごとにログイン中の端末（セッション）を記録し、失効時は記録も失効済みにする
+	token_issuer = user.NewTokenIssuer(jwt_service, repositories.RefreshTokenDAO, repositories.SessionDAO)
+	session_revoker = user.NewUserSessionRevoker(
+		repositories.TokenDenylist, repositories.RefreshTokenDAO, repositories.SessionDAO,
+	)
+	mail_sender = new_mail_sender()
+	verification_mailer = user.NewEmailVerificationMailer(firebase_user_repo, mail_sender)
+	provider_token_verifier = new_provider_token_verifier(firebase_user_repo)
+	secret_cipher, err = new_secret_cipher()
+	if err != nil {
+		return nil, err
+	}
+	mfa_code_verifier = user.NewMfaCodeVerifier(repositories.TotpCredentialDAO, secret_cipher)
+	fingerprint_key, err = new_idempotency_fingerprint_key()
+	if err != nil {
+		return nil, err
+	}
+	// 登録・ログイン・二要素認証の失敗回数に応じて試行を遅延・ロックアウトし、セキュリティイベントをログに出力する
+	attempt_guard = user.NewAuthAttemptGuard(
+		new_attempt_counter(repositories), security.NewLogSecurityEventLogger(nil),
+	)
+	// Firebaseユーザーの削除・有効化し直しに失敗した場合は、補償処理として再試行を登録する
+	compensator = user.NewFirebaseUserCompensator(firebase_user_repo, repositories.CompensationTaskDAO)
+	// ブロックしている・されているユーザーとのフォロー・プロフィールの閲覧などを拒否する
+	// 非公開アカウントのプロフィール・フォロー関係の一覧を承認されたフォロワー以外に公開しない
+	privacy_guard = user.NewPrivacyGuard(repositories.UserDAO, repositories.FollowDAO, repositories.UserDAO)
+	summary_builder = user.NewUserSummaryBuilder(repositories.UserHandleDAO, repositories.ProfileDAO, repositories.FollowDAO)
+
+	resolver = &graph.Resolver{
+		Client: client,
+		// 冪等キー付きで再送信された登録は、最初の登録のユーザーにトークンを再発行して返す
+		RegisterUserUseCase: user.NewRegisterUserUseCase(
+			firebase_user_repo, repositories.UserDAO, token_issuer, verification_mailer, compensator, attempt_guard,
+			repositories.IdempotencyRecordDAO, repositories.UserDAO, fingerprint_key,
+		),
+		// ログイン時にFirebaseのメールアドレス確認状態をusers.email_verified_atへ同期する
+		LoginUserUseCase: user.NewLoginUserUseCase(
+			firebase_user_repo, repositories.UserDAO, token_issuer, firebase_user_repo, repositories.UserDAO, attempt_guard,
+		),
+		// パスワード再設定などでFirebaseの認証情報が変更された場合、変更前のセッションを全て失効させる
+		RefreshTokensUseCase: user.NewRefreshTokensUseCase(
+			jwt_service, token_issuer, repositories.RefreshTokenDAO, repositories.UserDAO,
+			firebase_user_repo, session_revoker,
+		),
+		LogoutUseCase: user.NewLogoutUseCase(
+			jwt_service, repositories.TokenDenylist, session_revoker, firebase_user_repo,
+		),
+		RequestPasswordResetUseCase: user.NewRequestPasswordResetUseCase(
+			firebase_user_repo, mail_sender, repositories.PasswordResetRateLimiter,
+		),
+		ResendVerificationEmailUseCase: user.NewResendVerificationEmailUseCase(
+			verification_mailer, repositories.VerificationEmailRateLimiter,
+		),
+		SignInWithProviderUseCase: user.NewSignInWithProviderUseCase(
+			provider_token_verifier, repositories.UserIdentityDAO, repositories.UserDAO, firebase_user_repo, token_issuer,
+			attempt_guard,
+		),
+		LinkProviderUseCase:   user.NewLinkProviderUseCase(provider_token_verifier, repositories.UserIdentityDAO),
+		UnlinkProviderUseCase: user.NewUnlinkProviderUseCase(repositories.UserIdentityDAO, firebase_user_repo),
+		EnrollTotpUseCase:     user.NewEnrollTotpUseCase(repositories.TotpCredentialDAO, secret_cipher),
+		ConfirmTotpUseCase: user.NewConfirmTotpUseCase(
+			mfa_code_verifier, repositories.TotpCredentialDAO, repositories.UserDAO,
+		),
+		DisableTotpUseCase: user.NewDisableTotpUseCase(
+			mfa_code_verifier, repositories.TotpCredentialDAO, repositories.UserDAO,
+		),
+		RegenerateRecoveryCodesUseCase: user.NewRegenerateRecoveryCodesUseCase(mfa_code_verifier, repositories.TotpCredentialDAO),
+		VerifyMfaUseCase: user.NewVerifyMfaUseCase(
+			jwt_service, repositories.UserDAO, mfa_code_verifier, token_issuer, attempt_guard,
+		),
+		ListSessionsUseCase:            user.NewListSessionsUseCase(repositories.SessionDAO),
+		RevokeSessionUseCase:           user.NewRevokeSessionUseCase(repositories.SessionDAO, session_revoker),
+		CreateProfileUseCase:           user.NewCreateProfileUseCase(repositories.ProfileDAO),
+		UpdateProfileUseCase:           user.NewUpdateProfileUseCase(repositories.ProfileDAO),
+		GetProfileUseCase:              user.NewGetProfileUseCase(repositories.UserDAO, repositories.ProfileDAO, privacy_guard),
+		CheckHandleAvailabilityUseCase: user.NewCheckHandleAvailabilityUseCase(repositories.UserHandleDAO),
+		ChangeHandleUseCase:            user.NewChangeHandleUseCase(repositories.UserHandleDAO),
+		FindUserByHandleUseCase: user.NewFindUserByHandleUseCase(
+			repositories.UserDAO, repositories.UserHandleDAO, repositories.ProfileDAO, privacy_guard,
+		),
+		// 非公開アカウントへのフォローはフォローリクエストとして保存し、承認されるまでフォロワーに含めない
+		FollowUserUseCase: user.NewFollowUserUseCase(
+			repositories.UserDAO, repositories.FollowDAO, repositories.FollowRequestDAO, privacy_guard,
+		),
+		UnfollowUserUseCase: user.NewUnfollowUserUseCase(repositories.UserDAO, repositories.FollowDAO),
+		ListFollowsUseCase: user.NewListFollowsUseCase(
+			repositories.UserDAO, repositories.FollowDAO, privacy_guard,
+		),
+		ApproveFollowRequestUseCase: user.NewApproveFollowRequestUseCase(repositories.FollowRequestDAO),
+		RejectFollowRequestUseCase:  user.NewRejectFollowRequestUseCase(repositories.FollowRequestDAO),
+		ListFollowRequestsUseCase:   user.NewListFollowRequestsUseCase(repositories.FollowRequestDAO),
+		GetPrivacySettingsUseCase:   user.NewGetPrivacySettingsUseCase(repositories.UserDAO),
+		// 公開アカウントに変更した場合は承認待ちのフォローリクエストを全て承認する
+		UpdatePrivacySettingsUseCase: user.NewUpdatePrivacySettingsUseCase(repositories.UserDAO, repositories.FollowRequestDAO),
+		// ブロックした場合はお互いのフォローと承認待ちのフォローリクエストを解除する
+		BlockUserUseCase: user.NewBlockUserUseCase(
+			repositories.UserDAO, repositories.UserBlockDAO, repositories.FollowDAO, repositories.FollowRequestDAO,
+		),
+		UnblockUserUseCase: user.NewUnblockUserUseCase(repositories.UserDAO, repositories.UserBlockDAO),
+		MuteUserUseCase:    user.NewMuteUserUseCase(repositories.UserDAO, repositories.UserMuteDAO),
+		UnmuteUserUseCase:  user.NewUnmuteUserUseCase(repositories.UserDAO, repositories.UserMuteDAO),
+		DeleteMyAccountUseCase: user.NewDeleteMyAccountUseCase(
+			firebase_user_repo, repositories.UserDAO, session_revoker, mail_sender, compensator,
+		),
+		CancelAccountDeletionUseCase: user.NewCancelAccountDeletionUseCase(repositories.UserDAO, firebase_user_repo),
+		RequestDataExportUseCase:     user.NewRequestDataExportUseCase(repositories.DataExportDAO),
+		RequestEmailChangeUseCase: user.NewRequestEmailChangeUseCase(
+			repositories.UserDAO, repositories.EmailChangeRequestDAO, mail_sender,
+			repositories.EmailChangeRateLimiter, app_base_url(),
+		),
+		GetMeUseCase:          user.NewGetMeUseCase(repositories.UserDAO, summary_builder),
+		GetUserSummaryUseCase: user.NewGetUserSummaryUseCase(repositories.UserDAO, summary_builder),
+		UploadImageUseCase:    user.NewUploadImageUseCase(media_storage, repositories.UploadedImageDAO),
+	}
+	return &server_dependencies{
+		config: graph.Config{
+			Resolvers:  resolver,
+			Directives: graph.NewDirectiveRoot(),
+		},
+		auth_middleware:      middlewares.NewAuthMiddleware(jwt_service, repositories.UserDAO),
+		download_data_export: user.NewDownloadDataExportUseCase(repositories.DataExportDAO, new_data_export_storage()),
+		confirm_email_change: user.NewConfirmEmailChangeUseCase(
+			repositories.EmailChangeRequestDAO, repositories.UserDAO, firebase_user_repo, compensator,
+		),
+	}, nil
+}
+
+// new_attempt_counter は環境変数から認証の失敗回数の保存先を選択します
+// AUTH_ATTEMPT_BACKEND=memoryの場合はメモリに保持し（ローカル開発・単一インスタンス用）、それ以外はPostgresで複数インスタンス間で共有します
+func new_attempt_counter(repositories *repository.Repositories) user.AttemptCounterInterface {
+	if os.Getenv("AUTH_ATTEMPT_BACKEND") == "memory" {
+		log.Println("AUTH_ATTEMPT_BACKEND=memoryのため、認証の失敗回数をメモリに保持します")
+		return repositories.InMemoryAttemptCounter
+	}
+	return repositories.AuthAttemptDAO
+}
+
+// mail_sender_interface はパスワード再設定メール・メールアドレス確認メール・アカウント削除受付メール・
+// データのエクスポート完了メール・メールアドレスの変更の確認メールと通知メールを送信するメール送信サービスです
+type mail_sender_interface interface {
+	user.PasswordResetMailSenderInterface
+	user.EmailVerificationMailSenderInterface
+	user.AccountDeletionMailSenderInterface
+	user.DataExportMailSenderInterface
+	user.EmailChangeMailSenderInterface
+}
+
+// new_mail_sender は環境変数からメール送信サービスを作成します
+// SMTP_HOSTが未設定の場合（ローカル開発など）は送信せずにログへ出力します
+func new_mail_sender() mail_sender_interface {
+	var smtp_host string
+	var smtp_port string
+
+	smtp_host = os.Getenv("SMTP_HOST")
+	if smtp_host == "" {
+		log.Println("SMTP_HOSTが未設定のため、メールは送信せずにログへ出力します")
+		return mail.NewLogMailSender()
+	}
+	smtp_port = os.Getenv("SMTP_PORT")
+	if smtp_port == "" {
+		smtp_port = defaultSMTPPort
+	}
+	return mail.NewSMTPMailSender(mail.SMTPConfig{
+		Host:     smtp_host,
+		Port:     smtp_port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+	})
+}
+
+// new_data_export_storage は環境変数DATA_EXPORT_DIR（未設定の場合はdata/exports）に個人データのエクスポートを保存するストレージを作成します
+func new_data_export_storage() *storage.LocalFileStorage {
+	var data_export_dir string
+
+	data_export_dir = os.Getenv("DATA_EXPORT_DIR")
+	if data_export_dir == "" {
+		data_export_dir = defaultDataExportDir
+	}
+	return storage.NewLocalFileStorage(data_export_dir)
+}
+
+// new_media_storage は環境変数から画像を保存するストレージを作成します
+// S3_BUCKETが設定されている場合はS3互換のオブジェクトストレージ（S3_ENDPOINT・S3_REGION・S3_ACCESS_KEY_ID・
+// S3_SECRET_ACCESS_KEY、配信URLを変える場合はS3_PUBLIC_BASE_URL）に保存します
+// 未設定の場合（ローカル開発など）はMEDIA_DIR（未設定の場合はdata/media）に保存し、このサーバーの/media/で配信します
+func new_media_storage() (user.BlobStorage, error) {
+	var media_dir string
+	var s3_storage *storage.S3BlobStorage
+	var err error
+
+	if os.Getenv("S3_BUCKET") == "" {
+		log.Println("S3_BUCKETが未設定のため、画像をローカルのディレクトリに保存します")
+		media_dir = os.Getenv("MEDIA_DIR")
+		if media_dir == "" {
+			media_dir = defaultMediaDir
+		}
+		return storage.NewLocalBlobStorage(media_dir, app_base_url()+"/media"), nil
+	}
+	s3_storage, err = storage.NewS3BlobStorage(storage.S3Config{
+		Endpoint:        os.Getenv("S3_ENDPOINT"),
+		Region:          os.Getenv("S3_REGION"),
+		Bucket:          os.Getenv("S3_BUCKET"),
+		AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		PublicBaseURL:   os.Getenv("S3_PUBLIC_BASE_URL"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("S3互換ストレージの設定が不正です: %w", err)
+	}
+	return s3_storage, nil
+}
+
+// app_base_url は環境変数APP_BASE_URL（メールで送信するリンクのURLの先頭、未設定の場合はhttp://localhost:8080）を返します
+func app_base_url() string {
+	var base_url string
+
+	base_url = os.Getenv("APP_BASE_URL")
+	if base_url == "" {
+		return defaultAppBaseURL
+	}
+	return base_url
+}
+
+// new_provider_token_verifier は環境変数からソーシャルログインのIDトークン検証を作成します
+// LINE_CHANNEL_IDが未設定の場合はLINEログインを無効にします（LINE_JWKS_URLでJWKSの取得先を差し替えられます）
+func new_provider_token_verifier(firebase_user_repo *firebase.FirebaseUserRepository) *user.ProviderTokenVerifier {
+	var line_channel_id string
+	var line_jwks_url string
+
+	line_channel_id = os.Getenv("LINE_CHANNEL_ID")
+	if line_channel_id == "" {
+		log.Println("LINE_CHANNEL_IDが未設定のため、LINEログインは無効です")
+		return user.NewProviderTokenVerifier(firebase_user_repo, nil)
+	}
+	line_jwks_url = os.Getenv("LINE_JWKS_URL")
+	if line_jwks_url == "" {
+		line_jwks_url = line.DefaultJWKSURL
+	}
+	return user.NewProviderTokenVerifier(firebase_user_repo, line.NewLINEIDTokenVerifier(line_jwks_url, line_channel_id))
+}
+
+// new_secret_cipher は環境変数MFA_ENCRYPTION_KEY（Base64の32バイト鍵）からTOTPシークレットの暗号化サービスを作成します
+func new_secret_cipher() (*utils.SecretCipher, error) {
+	var encryption_key string
+	var secret_cipher *utils.SecretCipher
+	var err error
+
+	encryption_key = os.Getenv("MFA_ENCRYPTION_KEY")
+	if encryption_key == "" {
+		return nil, fmt.Errorf("必要な環境変数が設定されていません: MFA_ENCRYPTION_KEY")
+	}
+	secret_cipher, err = utils.NewSecretCipherFromBase64(encryption_key)
+	if err != nil {
+		return nil, fmt.Errorf("MFA_ENCRYPTION_KEYが不正です: %w", err)
+	}
+	return secret_cipher, nil
+}
+
+// new_idempotency_fingerprint_key は環境変数IDEMPOTENCY_FINGERPRINT_KEY（Base64の32バイト以上の鍵）から
+// 冪等キーの記録に保存するリクエストのフィンガープリントの秘密鍵を読み込みます
+func new_idempotency_fingerprint_key() ([]byte, error) {
+	var encoded_key string
+	var fingerprint_key []byte
+	var err error
+
+	encoded_key = os.Getenv("IDEMPOTENCY_FINGERPRINT_KEY")
+	if encoded_key == "" {
+		return nil, fmt.Errorf("必要な環境変数が設定されていません: IDEMPOTENCY_FINGERPRINT_KEY")
+	}
+	fingerprint_key, err = base64.StdEncoding.DecodeString(encoded_key)
+	if err != nil {
+		return nil, fmt.Errorf("IDEMPOTENCY_FINGERPRINT_KEYが不正です: %w", err)
+	}
+	if len(fingerprint_key) < idempotencyFingerprintKeyMinBytes {
+		return nil, fmt.Errorf("IDEMPOTENCY_FINGERPRINT_KEYは%dバイト以上である必要があります", idempotencyFingerprintKeyMinBytes)
+	}
+	return fingerprint_key, nil
+}
+
+// load_key_ring は環境変数からJWTの署名・検証に使うKeyRingを読み込みます
+// JWT_KEYS_DIRが設定されている場合は「<kid>.pem」の鍵をJWT_SIGNING_KEY_IDの鍵で署名し、
+// JWT_SECRET_KEYは移行期間中の旧HS256トークンの検証にのみ使用します
+func load_key_ring() (*utils.KeyRing, error) {
+	var keys_dir string
+	var signing_kid string
+	var jwt_secret_key string
+	var hmac_key *utils.SigningKey
+	var err error
+
+	keys_dir = os.Getenv("JWT_KEYS_DIR")
+	signing_kid = os.Getenv("JWT_SIGNING_KEY_ID")
+	jwt_secret_key = os.Getenv("JWT_SECRET_KEY")
+	if keys_dir != "" {
+		if signing_kid == "" {
+			return nil, fmt.Errorf("必要な環境変数が設定されていません: JWT_SIGNING_KEY_ID")
+		}
+		return utils.LoadKeyRingFromDir(keys_dir, signing_kid, jwt_secret_key)
+	}
+	if jwt_secret_key == "" {
+		return nil, fmt.Errorf("必要な環境変数が設定されていません: JWT_KEYS_DIR または JWT_SECRET_KEY")
+	}
+	log.Println("JWT_KEYS_DIRが未設定のため、HS256の共通鍵で署名します")
+	hmac_key, err = utils.NewHMACSigningKey("", []byte(jwt_secret_key))
+	if err != nil {
+		return nil, err
+	}
+	return utils.NewKeyRing(hmac_key)
+}
+
+// jwks_handler はKeyRingの公開鍵をJWKS形式で返すハンドラーです
+func jwks_handler(key_ring *utils.KeyRing) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(jwksCacheMaxAge.Seconds())))
+		_ = json.NewEncoder(w).Encode(key_ring.JWKS())
+	})
+}
+
+// data_export_downloader_interface はダウンロードリンクから個人データのエクスポートのZIPファイルを取得するインターフェースです
+type data_export_downloader_interface interface {
+	Execute(ctx context.Context, export_id string, download_token string) (io.ReadCloser, error)
+}
+
+// data_export_download_handler は「/data-exports/<エクスポートID>?token=<トークン>」のZIPファイルを返すハンドラーです
+// リンクが不正・有効期限切れの場合はどちらか区別せずに404を返します
+func data_export_download_handler(downloader data_export_downloader_interface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reader io.ReadCloser
+		var err error
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		// リンクのトークンが他サイトへ漏れないよう、キャッシュとRefererを無効にする
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		reader, err = downloader.Execute(r.Context(), strings.TrimPrefix(r.URL.Path, "/data-exports/"), r.URL.Query().Get("token"))
+		if errors.Is(err, domain_errors.ErrDataExportNotFound) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("データのエクスポートのダウンロードに失敗しました: %v", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		defer reader.Close()
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="sleeve-data-export.zip"`)
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			log.Printf("データのエクスポートの送信に失敗しました: %v", err)
+		}
+	})
+}
+
+// email_change_confirmer_interface は確認リンクのトークンでメールアドレスの変更を確定するインターフェースです
+type email_change_confirmer_interface interface {
+	Execute(ctx context.Context, token string) error
+}
+
+// email_change_confirm_handler は「/email-change/confirm」でメールアドレスの変更を確定するハンドラーです
+// メールのリンク（GET ?token=<トークン>）では確定ボタンのページを返すのみで、ページからのPOSTで確定します
+// メールのセキュリティスキャナーなどがリンクを先読みしても変更が確定されないようにするためです
+func email_change_confirm_handler(confirmer email_change_confirmer_interface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+
+		// リンクのトークンが他サイトへ漏れないよう、キャッシュとRefererを無効にする
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			if r.Method == http.MethodHead {
+				return
+			}
+			err = emailChangeConfirmPage.Execute(w, r.URL.Query().Get("token"))
+			if err != nil {
+				log.Printf("メールアドレスの変更の確認ページの表示に失敗しました: %v", err)
+			}
+			return
+		case http.MethodPost:
+		default:
+			w.Header().Set("Allow", "GET, HEAD, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		err = confirmer.Execute(r.Context(), r.PostFormValue("token"))
+		if errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+			http.Error(w, domain_errors.ErrInvalidEmailChangeToken.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, domain_errors.ErrDuplicateEmail) {
+			http.Error(w, domain_errors.ErrDuplicateEmail.Error(), http.StatusConflict)
+			return
+		}
+		if err != nil {
+			log.Printf("メールアドレスの変更の確定に失敗しました: %v", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = io.WriteString(w, "メールアドレスの変更が完了しました。次回から新しいメールアドレスでログインできます。\n")
+	})
+}
+
+// media_opener_interface はローカルに保存した画像を読み出すインターフェースです
+type media_opener_interface interface {
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// media_handler は「/media/<キー>」でローカルに保存した画像を返すハンドラーです
+// 画像（JPEG・PNG）以外の拡張子・存在しないキーは404を返し、ブラウザがContent-Typeを推測しないようにします
+func media_handler(opener media_opener_interface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var key string
+		var content_type string
+		var reader io.ReadCloser
+		var err error
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		key = strings.TrimPrefix(r.URL.Path, "/media/")
+		content_type = mime.TypeByExtension(path.Ext(key))
+		if content_type != models.ImageFormatJPEG.ContentType() && content_type != models.ImageFormatPNG.ContentType() {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		reader, err = opener.Open(r.Context(), key)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		defer reader.Close()
+		w.Header().Set("Content-Type", content_type)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", mediaCacheControl)
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			log.Printf("画像の送信に失敗しました: %v", err)
+		}
+	})
+}
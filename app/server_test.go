@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/usecase/utils"
+)
+
+// TestJWKSHandler は公開鍵がJWKS形式で返されることをテストします
+func TestJWKSHandler(t *testing.T) {
+	var private_key ed25519.PrivateKey
+	var signing_key *utils.SigningKey
+	var key_ring *utils.KeyRing
+	var recorder *httptest.ResponseRecorder
+	var key_set utils.JSONWebKeySet
+	var err error
+
+	_, private_key, err = ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	signing_key, err = utils.NewEd25519SigningKey("ed25519-test", private_key)
+	if err != nil {
+		t.Fatalf("failed to create signing key: %v", err)
+	}
+	key_ring, err = utils.NewKeyRing(signing_key)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	recorder = httptest.NewRecorder()
+	jwks_handler(key_ring).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("expected application/json, got %s", recorder.Header().Get("Content-Type"))
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &key_set)
+	if err != nil {
+		t.Fatalf("failed to decode jwks: %v", err)
+	}
+	if len(key_set.Keys) != 1 || key_set.Keys[0].KeyID != "ed25519-test" || key_set.Keys[0].Algorithm != "EdDSA" {
+		t.Errorf("unexpected jwks: %+v", key_set)
+	}
+	recorder = httptest.NewRecorder()
+	jwks_handler(key_ring).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405, got %d", recorder.Code)
+	}
+}
+
+// MockDataExportDownloader はエクスポートIDとトークンが一致する場合のみZIPファイルを返すモックです
+type MockDataExportDownloader struct {
+	export_id      string
+	download_token string
+}
+
+// Execute はエクスポートIDとトークンが一致する場合にZIPファイルの内容を返します
+func (m *MockDataExportDownloader) Execute(_ context.Context, export_id string, download_token string) (io.ReadCloser, error) {
+	if export_id != m.export_id || download_token != m.download_token {
+		return nil, domain_errors.ErrDataExportNotFound
+	}
+	return io.NopCloser(strings.NewReader("zip")), nil
+}
+
+// TestDataExportDownloadHandler はリンクのエクスポートIDとトークンが一致する場合のみZIPファイルが返されることをテストします
+func TestDataExportDownloadHandler(t *testing.T) {
+	var handler http.Handler
+	var recorder *httptest.ResponseRecorder
+
+	handler = data_export_download_handler(&MockDataExportDownloader{export_id: "export-id", download_token: "token"})
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/data-exports/export-id?token=token", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != "application/zip" || recorder.Header().Get("Cache-Control") != "no-store" {
+		t.Errorf("unexpected headers: %v", recorder.Header())
+	}
+	if recorder.Body.String() != "zip" {
+		t.Errorf("expected zip content, got %q", recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/data-exports/export-id?token=wrong", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/data-exports/export-id?token=token", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405, got %d", recorder.Code)
+	}
+}
+
+// MockMediaOpener はキーに対応する画像を返すモックです
+type MockMediaOpener struct {
+	files map[string]string
+}
+
+// Open はキーに対応する画像を返し、存在しない場合はErrStorageErrorを返します
+func (m *MockMediaOpener) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	var content string
+	var exists bool
+
+	content, exists = m.files[key]
+	if !exists {
+		return nil, domain_errors.ErrStorageError
+	}
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+// TestMediaHandler は保存した画像が画像のContent-Typeで返され、それ以外のキーは404になることをテストします
+func TestMediaHandler(t *testing.T) {
+	var handler http.Handler
+	var recorder *httptest.ResponseRecorder
+
+	handler = media_handler(&MockMediaOpener{files: map[string]string{
+		"images/user/image/small.jpg": "jpeg",
+		"images/user/image/page.html": "<script></script>",
+	}})
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/media/images/user/image/small.jpg", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != "image/jpeg" || recorder.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("unexpected headers: %v", recorder.Header())
+	}
+	if recorder.Body.String() != "jpeg" {
+		t.Errorf("expected jpeg content, got %q", recorder.Body.String())
+	}
+
+	for _, target := range []string{"/media/images/user/image/medium.jpg", "/media/images/user/image/page.html"} {
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		if recorder.Code != http.StatusNotFound {
+			t.Errorf("%s: expected status 404, got %d", target, recorder.Code)
+		}
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/media/images/user/image/small.jpg", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405, got %d", recorder.Code)
+	}
+}
+
+// MockEmailChangeConfirmer はトークンが一致する場合のみメールアドレスの変更を確定するモックです
+type MockEmailChangeConfirmer struct {
+	token           string
+	duplicate       bool
+	confirmed_count int
+}
+
+// Execute はトークンが一致する場合に確定した回数を記録します
+func (m *MockEmailChangeConfirmer) Execute(_ context.Context, token string) error {
+	if token != m.token {
+		return domain_errors.ErrInvalidEmailChangeToken
+	}
+	if m.duplicate {
+		return domain_errors.ErrDuplicateEmail
+	}
+	m.confirmed_count++
+	return nil
+}
+
+// TestEmailChangeConfirmHandler は確認リンクのGETでは確定せず、確認ページからトークンが一致するPOSTでのみメールアドレスの変更が確定されることをテストします
+func TestEmailChangeConfirmHandler(t *testing.T) {
+	var confirmer *MockEmailChangeConfirmer
+	var handler http.Handler
+	var recorder *httptest.ResponseRecorder
+
+	confirmer = &MockEmailChangeConfirmer{token: "token"}
+	handler = email_change_confirm_handler(confirmer)
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, "/email-change/confirm?token=token", nil))
+		if recorder.Code != http.StatusOK || confirmer.confirmed_count != 0 {
+			t.Errorf("expected %s to return the page without confirmation, got %d (%d)", method, recorder.Code, confirmer.confirmed_count)
+		}
+	}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/email-change/confirm?token=%22%3E%3Cscript%3E", nil))
+	if !strings.Contains(recorder.Body.String(), `method="post"`) || strings.Contains(recorder.Body.String(), "<script>") {
+		t.Errorf("expected escaped confirmation form, got %s", recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, new_email_change_confirm_request("token"))
+	if recorder.Code != http.StatusOK || confirmer.confirmed_count != 1 {
+		t.Fatalf("expected status 200 and confirmation, got %d (%d)", recorder.Code, confirmer.confirmed_count)
+	}
+	if recorder.Header().Get("Cache-Control") != "no-store" || recorder.Header().Get("Referrer-Policy") != "no-referrer" {
+		t.Errorf("unexpected headers: %v", recorder.Header())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, new_email_change_confirm_request("wrong"))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/email-change/confirm?token=token", nil))
+	if recorder.Code != http.StatusMethodNotAllowed || confirmer.confirmed_count != 1 {
+		t.Errorf("expected status 405 without confirmation, got %d (%d)", recorder.Code, confirmer.confirmed_count)
+	}
+
+	confirmer.duplicate = true
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, new_email_change_confirm_request("token"))
+	if recorder.Code != http.StatusConflict {
+		t.Errorf("expected status 409, got %d", recorder.Code)
+	}
+}
+
+// new_email_change_confirm_request は確認ページのフォームから送信されるPOSTリクエストを作成します
+func new_email_change_confirm_request(token string) *http.Request {
+	var request *http.Request
+
+	request = httptest.NewRequest(http.MethodPost, "/email-change/confirm", strings.NewReader(url.Values{"token": {token}}.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return request
+}
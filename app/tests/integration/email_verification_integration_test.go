@@ -0,0 +1,165 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+)
+
+// testVerificationLink は結合テスト用のメールアドレス確認リンクです
+const testVerificationLink = "https://sleeve.example.com/verify-email?oobCode=integration"
+
+// メールアドレス確認結合テスト用のセットアップ
+func setupEmailVerificationIntegrationTest() (*graph.Resolver, *MockUserFinder, *MockEmailVerificationChecker, *MockEmailVerificationMailSender) {
+	var user_finder *MockUserFinder
+	var email_checker *MockEmailVerificationChecker
+	var mail_sender *MockEmailVerificationMailSender
+	var token_issuer *user.TokenIssuer
+	var resolver *graph.Resolver
+
+	user_finder = NewMockUserFinder()
+	email_checker = NewMockEmailVerificationChecker()
+	mail_sender = NewMockEmailVerificationMailSender()
+	token_issuer = user.NewTokenIssuer(createTestJWTService(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository())
+	resolver = &graph.Resolver{
+		LoginUserUseCase: user.NewLoginUserUseCase(
+			NewMockFirebaseTokenVerifier(), user_finder, token_issuer, email_checker, user_finder, createTestAttemptGuard(),
+		),
+		ResendVerificationEmailUseCase: user.NewResendVerificationEmailUseCase(
+			user.NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), mail_sender),
+			NewMockRateLimiter(testPasswordResetLimit),
+		),
+	}
+	return resolver, user_finder, email_checker, mail_sender
+}
+
+// authenticated_context_for_test はMockUserFinderのユーザーでログイン済みのcontextを作成します
+func authenticated_context_for_test(t *testing.T, user_finder *MockUserFinder) context.Context {
+	var current_user *models.User
+	var err error
+
+	t.Helper()
+	current_user, err = user_finder.FindByFirebaseUID(context.Background(), testLoginFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	return utils.WithAuthInfo(context.Background(), &utils.JWTClaims{UserID: current_user.PublicID().String()}, current_user)
+}
+
+// TestIntegration_EmailVerification_NormalFlow はメールアドレス確認の一連の流れをテストします
+// 通過条件:
+// - 未確認のユーザーはログイン結果のemailVerifiedがfalseで、確認済みが必要なユースケースはErrEmailNotVerifiedになる
+// - resendVerificationEmailで確認リンクがメールで送信される
+// - Firebaseで確認が完了した後のログインでemail_verified_atが記録され、emailVerifiedがtrueになる
+// - 確認済みのユーザーが再送信するとErrEmailAlreadyVerifiedになる
+func TestIntegration_EmailVerification_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var user_finder *MockUserFinder
+	var email_checker *MockEmailVerificationChecker
+	var mail_sender *MockEmailVerificationMailSender
+	var mutation_resolver graph.MutationResolver
+	var login_result *model.LoginPayload
+	var result bool
+	var err error
+
+	resolver, user_finder, email_checker, mail_sender = setupEmailVerificationIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+
+	// 未確認のままログイン
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	if login_result.User.EmailVerified {
+		t.Error("expected emailVerified to be false before verification")
+	}
+	ctx = authenticated_context_for_test(t, user_finder)
+	_, err = utils.RequireVerifiedEmail(ctx)
+	if !errors.Is(err, domain_errors.ErrEmailNotVerified) {
+		t.Errorf("expected ErrEmailNotVerified, got %v", err)
+	}
+
+	// 確認メールの再送信
+	result, err = mutation_resolver.ResendVerificationEmail(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+	if mail_sender.SentLinks[testLoginEmail] != testVerificationLink {
+		t.Errorf("expected verification link to be sent to %s, got %v", testLoginEmail, mail_sender.SentLinks)
+	}
+
+	// Firebaseで確認完了後にログインすると確認日時が同期される
+	email_checker.IsVerified = true
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	if !login_result.User.EmailVerified {
+		t.Error("expected emailVerified to be true after verification")
+	}
+	if user_finder.EmailVerifiedAt == nil {
+		t.Error("expected email_verified_at to be recorded")
+	}
+	ctx = authenticated_context_for_test(t, user_finder)
+	_, err = utils.RequireVerifiedEmail(ctx)
+	if err != nil {
+		t.Errorf("expected no error for verified user, got %v", err)
+	}
+	_, err = mutation_resolver.ResendVerificationEmail(ctx)
+	if !errors.Is(err, domain_errors.ErrEmailAlreadyVerified) {
+		t.Errorf("expected ErrEmailAlreadyVerified, got %v", err)
+	}
+}
+
+// MockEmailVerificationChecker は結合テスト用のFirebaseのメールアドレス確認状態モックです
+type MockEmailVerificationChecker struct {
+	IsVerified bool
+}
+
+// NewMockEmailVerificationChecker は新しいMockEmailVerificationCheckerを作成します（初期状態は未確認）
+func NewMockEmailVerificationChecker() *MockEmailVerificationChecker {
+	return &MockEmailVerificationChecker{
+		IsVerified: false,
+	}
+}
+
+// IsEmailVerified はモックの確認状態を返します
+func (m *MockEmailVerificationChecker) IsEmailVerified(_ context.Context, _ string) (bool, error) {
+	return m.IsVerified, nil
+}
+
+// MockEmailVerificationLinkGenerator は結合テスト用のメールアドレス確認リンク生成モックです
+type MockEmailVerificationLinkGenerator struct{}
+
+// NewMockEmailVerificationLinkGenerator は新しいMockEmailVerificationLinkGeneratorを作成します
+func NewMockEmailVerificationLinkGenerator() *MockEmailVerificationLinkGenerator {
+	return &MockEmailVerificationLinkGenerator{}
+}
+
+// GenerateEmailVerificationLink はモックの確認リンクを返します
+func (m *MockEmailVerificationLinkGenerator) GenerateEmailVerificationLink(_ context.Context, _ models.Email) (string, error) {
+	return testVerificationLink, nil
+}
+
+// MockEmailVerificationMailSender は結合テスト用の確認メール送信モックです
+type MockEmailVerificationMailSender struct {
+	SentLinks map[string]string
+}
+
+// NewMockEmailVerificationMailSender は新しいMockEmailVerificationMailSenderを作成します
+func NewMockEmailVerificationMailSender() *MockEmailVerificationMailSender {
+	return &MockEmailVerificationMailSender{
+		SentLinks: map[string]string{},
+	}
+}
+
+// SendEmailVerificationMail は送信先と確認リンクを記録します
+func (m *MockEmailVerificationMailSender) SendEmailVerificationMail(_ context.Context, email models.Email, verification_link string) error {
+	m.SentLinks[email.Value()] = verification_link
+	return nil
+}
@@ -0,0 +1,352 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// テスト用定数
+const (
+	testLoginEmail       = "login_test@example.com"
+	testLoginFirebaseUID = "integration_login_firebase_uid_123"
+)
+
+// ログイン結合テスト用のセットアップ
+func setupLoginIntegrationTest() (*graph.Resolver, *MockFirebaseTokenVerifier, *MockUserFinder, *utils.JWTService) {
+	var token_verifier *MockFirebaseTokenVerifier
+	var user_finder *MockUserFinder
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var use_case *user.LoginUserUseCase
+	var resolver *graph.Resolver
+
+	token_verifier = NewMockFirebaseTokenVerifier()
+	user_finder = NewMockUserFinder()
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	token_issuer = user.NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository())
+	use_case = createTestLoginUserUseCase(token_verifier, user_finder, token_issuer)
+	resolver = &graph.Resolver{
+		LoginUserUseCase: use_case,
+	}
+	return resolver, token_verifier, user_finder, jwt_service
+}
+
+// TestIntegration_LoginWithIDToken_NormalFlow は正常なログインフローをテストします
+// 通過条件:
+// - 有効なFirebase IDトークンを渡した場合、トークンが検証される
+// - Firebase UIDで自社DBのユーザーが検索される
+// - JWT（アクセストークン + リフレッシュトークン）が返される
+// - 発行されたJWTのuser_idが自社DBのpublic_idと一致する
+func TestIntegration_LoginWithIDToken_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var token_verifier *MockFirebaseTokenVerifier
+	var user_finder *MockUserFinder
+	var jwt_service *utils.JWTService
+	var mutation_resolver graph.MutationResolver
+	var input model.LoginWithIDTokenInput
+	var result *model.LoginPayload
+	var access_claims *utils.JWTClaims
+	var err error
+
+	ctx = context.Background()
+	resolver, token_verifier, user_finder, jwt_service = setupLoginIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	input = model.LoginWithIDTokenInput{
+		IDToken: "valid_firebase_id_token",
+	}
+
+	// ログインを実行
+	result, err = mutation_resolver.LoginWithIDToken(ctx, input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// IDトークンが検証されたことを確認
+	if !token_verifier.VerifyIDTokenCalled {
+		t.Error("expected Firebase VerifyIDToken to be called")
+	}
+
+	// 自社DBのユーザーが検索されたことを確認
+	if user_finder.LastFirebaseUID != testLoginFirebaseUID {
+		t.Errorf("expected FindByFirebaseUID with %s, got %s", testLoginFirebaseUID, user_finder.LastFirebaseUID)
+	}
+
+	// User情報が返されることを確認
+	if result.User == nil {
+		t.Fatal("expected user to be non-nil")
+	}
+	if result.User.Email != testLoginEmail {
+		t.Errorf("expected email %s, got %s", testLoginEmail, result.User.Email)
+	}
+
+	// JWTのクレームを確認
+	if result.Tokens == nil {
+		t.Fatal("expected tokens to be non-nil")
+	}
+	access_claims, err = jwt_service.ValidateToken(ctx, result.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate access token: %v", err)
+	}
+	if access_claims.UserID != result.User.ID {
+		t.Errorf("user_id mismatch: token=%s, user=%s", access_claims.UserID, result.User.ID)
+	}
+	if access_claims.FirebaseUID != testLoginFirebaseUID {
+		t.Errorf("expected firebase_uid %s, got %s", testLoginFirebaseUID, access_claims.FirebaseUID)
+	}
+}
+
+// TestIntegration_LoginWithIDToken_InvalidIDToken は不正なIDトークンのエラーハンドリングをテストします
+// 通過条件:
+// - 検証に失敗したIDトークンの場合、ErrInvalidIDTokenが返される
+// - 自社DBのユーザー検索が行われない
+func TestIntegration_LoginWithIDToken_InvalidIDToken(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var token_verifier *MockFirebaseTokenVerifier
+	var user_finder *MockUserFinder
+	var mutation_resolver graph.MutationResolver
+	var input model.LoginWithIDTokenInput
+	var err error
+
+	ctx = context.Background()
+	resolver, token_verifier, user_finder, _ = setupLoginIntegrationTest()
+	token_verifier.ShouldReturnError = true
+	mutation_resolver = resolver.Mutation()
+	input = model.LoginWithIDTokenInput{
+		IDToken: "expired_firebase_id_token",
+	}
+
+	// ログインを実行
+	_, err = mutation_resolver.LoginWithIDToken(ctx, input)
+
+	// エラーが返されることを確認
+	if !errors.Is(err, domain_errors.ErrInvalidIDToken) {
+		t.Fatalf("expected ErrInvalidIDToken, got %v", err)
+	}
+
+	// 自社DBのユーザー検索が行われていないことを確認
+	if user_finder.LastFirebaseUID != "" {
+		t.Error("expected FindByFirebaseUID NOT to be called for invalid id token")
+	}
+}
+
+// TestIntegration_LoginWithIDToken_UnregisteredUser はFirebaseのみに存在するユーザーのログインをテストします
+// 通過条件:
+// - 自社DBにユーザーが存在しない場合、ErrUserNotFoundが返される
+func TestIntegration_LoginWithIDToken_UnregisteredUser(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var user_finder *MockUserFinder
+	var mutation_resolver graph.MutationResolver
+	var input model.LoginWithIDTokenInput
+	var err error
+
+	ctx = context.Background()
+	resolver, _, user_finder, _ = setupLoginIntegrationTest()
+	user_finder.ShouldReturnNotFound = true
+	mutation_resolver = resolver.Mutation()
+	input = model.LoginWithIDTokenInput{
+		IDToken: "valid_firebase_id_token",
+	}
+
+	// ログインを実行
+	_, err = mutation_resolver.LoginWithIDToken(ctx, input)
+
+	// エラーが返されることを確認
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestIntegration_LoginWithIDToken_DeletedUser は論理削除済みユーザーのログイン拒否をテストします
+// 通過条件:
+// - deleted_atが設定されたユーザーの場合、ErrUserDeletedが返される
+// - トークンが発行されない
+func TestIntegration_LoginWithIDToken_DeletedUser(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var user_finder *MockUserFinder
+	var mutation_resolver graph.MutationResolver
+	var input model.LoginWithIDTokenInput
+	var result *model.LoginPayload
+	var err error
+
+	ctx = context.Background()
+	resolver, _, user_finder, _ = setupLoginIntegrationTest()
+	user_finder.IsDeleted = true
+	mutation_resolver = resolver.Mutation()
+	input = model.LoginWithIDTokenInput{
+		IDToken: "valid_firebase_id_token",
+	}
+
+	// ログインを実行
+	result, err = mutation_resolver.LoginWithIDToken(ctx, input)
+
+	// エラーが返されることを確認
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Fatalf("expected ErrUserDeleted, got %v", err)
+	}
+
+	// トークンが発行されていないことを確認
+	if result != nil {
+		t.Error("expected result to be nil for deleted user")
+	}
+}
+
+// MockFirebaseTokenVerifier は結合テスト用のIDトークン検証モックです
+type MockFirebaseTokenVerifier struct {
+	ShouldReturnError   bool
+	VerifyIDTokenCalled bool
+}
+
+// NewMockFirebaseTokenVerifier は新しいMockFirebaseTokenVerifierを作成します
+func NewMockFirebaseTokenVerifier() *MockFirebaseTokenVerifier {
+	return &MockFirebaseTokenVerifier{
+		ShouldReturnError:   false,
+		VerifyIDTokenCalled: false,
+	}
+}
+
+// VerifyIDToken はモックのIDトークン検証を行います
+func (m *MockFirebaseTokenVerifier) VerifyIDToken(_ context.Context, _ string) (string, error) {
+	m.VerifyIDTokenCalled = true
+	if m.ShouldReturnError {
+		return "", domain_errors.ErrInvalidIDToken
+	}
+	return testLoginFirebaseUID, nil
+}
+
+// MockUserFinder は結合テスト用のユーザー検索モックです
+type MockUserFinder struct {
+	ShouldReturnNotFound bool
+	IsDeleted            bool
+	LastFirebaseUID      string
+	PublicID             uuid.UUID
+	EmailVerifiedAt      *time.Time
+	MfaEnabledAt         *time.Time
+}
+
+// NewMockUserFinder は新しいMockUserFinderを作成します
+func NewMockUserFinder() *MockUserFinder {
+	return &MockUserFinder{
+		ShouldReturnNotFound: false,
+		IsDeleted:            false,
+		LastFirebaseUID:      "",
+		PublicID:             uuid.New(),
+		EmailVerifiedAt:      nil,
+		MfaEnabledAt:         nil,
+	}
+}
+
+// FindByFirebaseUID はモックのユーザー検索を行います
+func (m *MockUserFinder) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	var email models.Email
+	var now time.Time
+	var deleted_at *time.Time
+	var err error
+
+	m.LastFirebaseUID = firebase_uid
+	if m.ShouldReturnNotFound {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	email, err = models.NewEmail(testLoginEmail)
+	if err != nil {
+		return nil, err
+	}
+	now = time.Now()
+	if m.IsDeleted {
+		deleted_at = &now
+	}
+	return models.NewUserWithPublicID(m.PublicID, firebase_uid, email, models.RoleUser, m.EmailVerifiedAt, m.MfaEnabledAt, now, now, deleted_at, nil)
+}
+
+// MarkEmailVerified はモックのメールアドレス確認日時を記録します
+func (m *MockUserFinder) MarkEmailVerified(_ context.Context, public_id uuid.UUID, verified_at time.Time) error {
+	if public_id != m.PublicID {
+		return domain_errors.ErrUserNotFound
+	}
+	if m.EmailVerifiedAt == nil {
+		m.EmailVerifiedAt = &verified_at
+	}
+	return nil
+}
+
+// EnableMfa はモックの二要素認証の有効化日時を記録します
+func (m *MockUserFinder) EnableMfa(_ context.Context, public_id uuid.UUID, enabled_at time.Time) error {
+	if public_id != m.PublicID {
+		return domain_errors.ErrUserNotFound
+	}
+	m.MfaEnabledAt = &enabled_at
+	return nil
+}
+
+// DisableMfa はモックの二要素認証の有効化日時を削除します
+func (m *MockUserFinder) DisableMfa(_ context.Context, public_id uuid.UUID) error {
+	if public_id != m.PublicID {
+		return domain_errors.ErrUserNotFound
+	}
+	m.MfaEnabledAt = nil
+	return nil
+}
+
+// createTestJWTService はHS256の共通鍵のみのKeyRingで署名・検証する結合テスト用のJWTServiceを作成します
+func createTestJWTService(denylist utils.TokenDenylistInterface) *utils.JWTService {
+	var signing_key *utils.SigningKey
+	var key_ring *utils.KeyRing
+
+	signing_key, _ = utils.NewHMACSigningKey("", []byte(testSecretKey))
+	key_ring, _ = utils.NewKeyRing(signing_key)
+	return utils.NewJWTService(key_ring, denylist)
+}
+
+// createTestLoginUserUseCase はメールアドレスが未確認のFirebaseユーザーとしてログインするLoginUserUseCaseを作成します
+func createTestLoginUserUseCase(
+	token_verifier user.FirebaseTokenVerifierInterface,
+	user_finder *MockUserFinder,
+	token_issuer *user.TokenIssuer,
+) *user.LoginUserUseCase {
+	return user.NewLoginUserUseCase(
+		token_verifier, user_finder, token_issuer, NewMockEmailVerificationChecker(), user_finder, createTestAttemptGuard(),
+	)
+}
+
+// MockAttemptCounter は結合テスト用の失敗を蓄積しない試行回数カウンターです（ロックアウトされないよう毎回1回目の失敗として返します）
+type MockAttemptCounter struct{}
+
+// Get は記録がないものとしてnilを返します
+func (m *MockAttemptCounter) Get(_ context.Context, _ models.AttemptKey, _ time.Time) (*models.AttemptRecord, error) {
+	return nil, nil
+}
+
+// RecordFailure は1回目の失敗の記録を返します
+func (m *MockAttemptCounter) RecordFailure(_ context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error) {
+	return models.NewAttemptRecord(key, 1, now, expires_at), nil
+}
+
+// Reset は何もしません
+func (m *MockAttemptCounter) Reset(_ context.Context, _ models.AttemptKey) error {
+	return nil
+}
+
+// MockSecurityEventLogger は結合テスト用のセキュリティイベントを破棄するロガーです
+type MockSecurityEventLogger struct{}
+
+// LogSecurityEvent は何もしません
+func (m *MockSecurityEventLogger) LogSecurityEvent(_ context.Context, _ *models.SecurityEvent) {}
+
+// createTestAttemptGuard は結合テスト用のAuthAttemptGuardを作成します
+func createTestAttemptGuard() *user.AuthAttemptGuard {
+	return user.NewAuthAttemptGuard(&MockAttemptCounter{}, &MockSecurityEventLogger{})
+}
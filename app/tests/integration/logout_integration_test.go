@@ -0,0 +1,187 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+)
+
+// ログアウト結合テスト用のセットアップ
+func setupLogoutIntegrationTest() (*graph.Resolver, *MockTokenDenylist, *MockFirebaseRefreshTokenRevoker, *utils.JWTService) {
+	var user_finder *MockUserFinder
+	var refresh_token_repo *MockRefreshTokenRepository
+	var session_repo *MockSessionRepository
+	var denylist *MockTokenDenylist
+	var firebase_revoker *MockFirebaseRefreshTokenRevoker
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var session_revoker *user.UserSessionRevoker
+	var resolver *graph.Resolver
+
+	user_finder = NewMockUserFinder()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	session_repo = NewMockSessionRepository()
+	denylist = NewMockTokenDenylist()
+	firebase_revoker = NewMockFirebaseRefreshTokenRevoker()
+	jwt_service = createTestJWTService(denylist)
+	token_issuer = user.NewTokenIssuer(jwt_service, refresh_token_repo, session_repo)
+	session_revoker = user.NewUserSessionRevoker(denylist, refresh_token_repo, session_repo)
+	resolver = &graph.Resolver{
+		LoginUserUseCase: createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		RefreshTokensUseCase: user.NewRefreshTokensUseCase(
+			jwt_service, token_issuer, refresh_token_repo, user_finder, NewMockCredentialChangeChecker(), session_revoker,
+		),
+		LogoutUseCase: user.NewLogoutUseCase(jwt_service, denylist, session_revoker, firebase_revoker),
+	}
+	return resolver, denylist, firebase_revoker, jwt_service
+}
+
+// TestIntegration_Logout_NormalFlow は現在のセッションからのログアウトをテストします
+// 通過条件:
+// - アクセストークンのjtiとセッションのsidがdenylistに登録される
+// - ログアウトしたアクセストークンはValidateTokenでTOKEN_REVOKEDになる
+// - 同じセッションのリフレッシュトークンは使用できない
+// - Firebaseのリフレッシュトークンは失効しない
+func TestIntegration_Logout_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var denylist *MockTokenDenylist
+	var firebase_revoker *MockFirebaseRefreshTokenRevoker
+	var jwt_service *utils.JWTService
+	var mutation_resolver graph.MutationResolver
+	var login_result *model.LoginPayload
+	var access_claims *utils.JWTClaims
+	var result bool
+	var err error
+
+	ctx = context.Background()
+	resolver, denylist, firebase_revoker, jwt_service = setupLogoutIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	access_claims, err = jwt_service.ValidateToken(ctx, login_result.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate access token: %v", err)
+	}
+	result, err = mutation_resolver.Logout(ctx, login_result.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result {
+		t.Error("expected result to be true")
+	}
+	if !denylist.Contains(models.DenylistKindToken, access_claims.ID) {
+		t.Error("expected jti to be denylisted")
+	}
+	if !denylist.Contains(models.DenylistKindSession, access_claims.SessionID) {
+		t.Error("expected sid to be denylisted")
+	}
+	_, err = jwt_service.ValidateToken(ctx, login_result.Tokens.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked, got %v", err)
+	}
+	_, err = mutation_resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+	if firebase_revoker.RevokeCalled {
+		t.Error("expected Firebase RevokeRefreshTokens NOT to be called")
+	}
+}
+
+// TestIntegration_LogoutAllSessions_NormalFlow は全端末からのログアウトをテストします
+// 通過条件:
+// - ユーザーの全セッションのトークンが失効する
+// - FirebaseのRevokeRefreshTokensがログインユーザーのFirebase UIDで呼ばれる
+func TestIntegration_LogoutAllSessions_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var firebase_revoker *MockFirebaseRefreshTokenRevoker
+	var jwt_service *utils.JWTService
+	var mutation_resolver graph.MutationResolver
+	var sessions []*model.LoginPayload
+	var err error
+
+	ctx = context.Background()
+	resolver, _, firebase_revoker, jwt_service = setupLogoutIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	sessions = []*model.LoginPayload{
+		login_for_refresh_test(t, mutation_resolver),
+		login_for_refresh_test(t, mutation_resolver),
+	}
+	_, err = mutation_resolver.LogoutAllSessions(ctx, sessions[0].Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, session := range sessions {
+		_, err = jwt_service.ValidateToken(ctx, session.Tokens.AccessToken)
+		if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+			t.Errorf("expected ErrTokenRevoked for access token, got %v", err)
+		}
+		_, err = mutation_resolver.RefreshTokens(ctx, session.Tokens.RefreshToken)
+		if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+			t.Errorf("expected ErrInvalidRefreshToken for refresh token, got %v", err)
+		}
+	}
+	if !firebase_revoker.RevokeCalled {
+		t.Fatal("expected Firebase RevokeRefreshTokens to be called")
+	}
+	if firebase_revoker.LastFirebaseUID != testLoginFirebaseUID {
+		t.Errorf("expected firebase_uid %s, got %s", testLoginFirebaseUID, firebase_revoker.LastFirebaseUID)
+	}
+}
+
+// MockTokenDenylist は結合テスト用のインメモリなdenylistです
+type MockTokenDenylist struct {
+	values map[string]bool
+}
+
+// NewMockTokenDenylist は新しいMockTokenDenylistを作成します
+func NewMockTokenDenylist() *MockTokenDenylist {
+	return &MockTokenDenylist{
+		values: map[string]bool{},
+	}
+}
+
+// Add はモックのdenylist登録を行います
+func (m *MockTokenDenylist) Add(_ context.Context, entry *models.DenylistedToken) error {
+	m.values[string(entry.Kind())+":"+entry.Value()] = true
+	return nil
+}
+
+// IsDenylisted はjtiまたはsidが登録済みかを返します
+func (m *MockTokenDenylist) IsDenylisted(_ context.Context, token_id, session_id string) (bool, error) {
+	return m.Contains(models.DenylistKindToken, token_id) || m.Contains(models.DenylistKindSession, session_id), nil
+}
+
+// Contains は指定された値が登録済みかを返します
+func (m *MockTokenDenylist) Contains(kind models.DenylistKind, value string) bool {
+	return m.values[string(kind)+":"+value]
+}
+
+// MockFirebaseRefreshTokenRevoker は結合テスト用のFirebaseリフレッシュトークン失効モックです
+type MockFirebaseRefreshTokenRevoker struct {
+	RevokeCalled    bool
+	LastFirebaseUID string
+}
+
+// NewMockFirebaseRefreshTokenRevoker は新しいMockFirebaseRefreshTokenRevokerを作成します
+func NewMockFirebaseRefreshTokenRevoker() *MockFirebaseRefreshTokenRevoker {
+	return &MockFirebaseRefreshTokenRevoker{
+		RevokeCalled:    false,
+		LastFirebaseUID: "",
+	}
+}
+
+// RevokeRefreshTokens はモックのFirebaseリフレッシュトークン失効を行います
+func (m *MockFirebaseRefreshTokenRevoker) RevokeRefreshTokens(_ context.Context, firebase_uid string) error {
+	m.RevokeCalled = true
+	m.LastFirebaseUID = firebase_uid
+	return nil
+}
@@ -0,0 +1,263 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// testMfaEncryptionKey は結合テスト用のTOTPシークレットの暗号鍵（32バイト）です
+const testMfaEncryptionKey = "integration_mfa_encryption_key!!"
+
+// 二要素認証結合テスト用のセットアップ
+func setupMfaIntegrationTest(t *testing.T) (*graph.Resolver, *MockUserFinder, *MockTotpCredentialRepository, *utils.JWTService) {
+	var user_finder *MockUserFinder
+	var credential_repo *MockTotpCredentialRepository
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var secret_cipher *utils.SecretCipher
+	var code_verifier *user.MfaCodeVerifier
+	var resolver *graph.Resolver
+	var err error
+
+	t.Helper()
+	user_finder = NewMockUserFinder()
+	credential_repo = NewMockTotpCredentialRepository()
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	token_issuer = user.NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository())
+	secret_cipher, err = utils.NewSecretCipher([]byte(testMfaEncryptionKey))
+	if err != nil {
+		t.Fatalf("failed to create secret cipher: %v", err)
+	}
+	code_verifier = user.NewMfaCodeVerifier(credential_repo, secret_cipher)
+	resolver = &graph.Resolver{
+		LoginUserUseCase:               createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		EnrollTotpUseCase:              user.NewEnrollTotpUseCase(credential_repo, secret_cipher),
+		ConfirmTotpUseCase:             user.NewConfirmTotpUseCase(code_verifier, credential_repo, user_finder),
+		DisableTotpUseCase:             user.NewDisableTotpUseCase(code_verifier, credential_repo, user_finder),
+		RegenerateRecoveryCodesUseCase: user.NewRegenerateRecoveryCodesUseCase(code_verifier, credential_repo),
+		VerifyMfaUseCase:               user.NewVerifyMfaUseCase(jwt_service, user_finder, code_verifier, token_issuer, createTestAttemptGuard()),
+	}
+	return resolver, user_finder, credential_repo, jwt_service
+}
+
+// authenticated_mfa_context はMockUserFinderのユーザーでログイン済みのcontextを作成します
+func authenticated_mfa_context(t *testing.T, user_finder *MockUserFinder) context.Context {
+	var current_user *models.User
+	var err error
+
+	t.Helper()
+	current_user, err = user_finder.FindByFirebaseUID(context.Background(), testLoginFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	return utils.WithAuthInfo(context.Background(), &utils.JWTClaims{UserID: current_user.PublicID().String()}, current_user)
+}
+
+// totp_code は共有シークレットから指定時刻の認証コードを生成します
+func totp_code(t *testing.T, secret string, at time.Time) string {
+	var code string
+	var err error
+
+	t.Helper()
+	code, err = utils.GenerateTotpCode(secret, at)
+	if err != nil {
+		t.Fatalf("failed to generate totp code: %v", err)
+	}
+	return code
+}
+
+// TestIntegration_Mfa_NormalFlow は二要素認証の登録からログイン時のチャレンジ完了までのフローをテストします
+// 通過条件:
+// - enrollTotpで共有シークレットが発行され、暗号化して保存される
+// - confirmTotpで二要素認証が有効になり、リカバリーコードが返される（DBにはハッシュのみ保存される）
+// - 二要素認証が有効なユーザーのログインではトークンの代わりにチャレンジが返される
+// - verifyMfaで認証コードを確認するとアクセストークンが発行される
+// - 使用済みの認証コードは再利用できない
+func TestIntegration_Mfa_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var user_finder *MockUserFinder
+	var credential_repo *MockTotpCredentialRepository
+	var jwt_service *utils.JWTService
+	var mutation_resolver graph.MutationResolver
+	var enrollment *model.TotpEnrollment
+	var recovery_codes []string
+	var credential *models.TotpCredential
+	var login_result *model.LoginPayload
+	var verify_result *model.LoginPayload
+	var code string
+	var access_claims *utils.JWTClaims
+	var err error
+
+	resolver, user_finder, credential_repo, jwt_service = setupMfaIntegrationTest(t)
+	mutation_resolver = resolver.Mutation()
+	ctx = authenticated_mfa_context(t, user_finder)
+
+	// 登録を開始
+	enrollment, err = mutation_resolver.EnrollTotp(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	credential = credential_repo.Credentials[user_finder.PublicID]
+	if credential == nil || credential.EncryptedSecret() == enrollment.Secret {
+		t.Fatal("expected secret to be stored encrypted")
+	}
+
+	// 認証コードを確認して有効化
+	recovery_codes, err = mutation_resolver.ConfirmTotp(ctx, totp_code(t, enrollment.Secret, time.Now()))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_finder.MfaEnabledAt == nil {
+		t.Fatal("expected mfa_enabled_at to be recorded")
+	}
+	for _, hash := range credential_repo.Credentials[user_finder.PublicID].RecoveryCodeHashes() {
+		if hash == recovery_codes[0] {
+			t.Error("expected recovery codes to be stored hashed")
+		}
+	}
+
+	// ログインではチャレンジが返される
+	login_result, err = mutation_resolver.LoginWithIDToken(context.Background(), model.LoginWithIDTokenInput{IDToken: "valid_firebase_id_token"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if login_result.Tokens != nil {
+		t.Error("expected tokens to be nil before mfa verification")
+	}
+	if login_result.MfaChallenge == nil {
+		t.Fatal("expected mfa challenge to be non-nil")
+	}
+
+	// 認証コードでチャレンジを完了
+	code = totp_code(t, enrollment.Secret, time.Now().Add(30*time.Second))
+	verify_result, err = mutation_resolver.VerifyMfa(context.Background(), *login_result.MfaChallenge, code)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if verify_result.Tokens == nil {
+		t.Fatal("expected tokens to be non-nil")
+	}
+	access_claims, err = jwt_service.ValidateToken(context.Background(), verify_result.Tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate access token: %v", err)
+	}
+	if access_claims.UserID != user_finder.PublicID.String() {
+		t.Errorf("expected user_id %s, got %s", user_finder.PublicID, access_claims.UserID)
+	}
+
+	// 同じ認証コードは再利用できない
+	_, err = mutation_resolver.VerifyMfa(context.Background(), *login_result.MfaChallenge, code)
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode for reused code, got %v", err)
+	}
+}
+
+// TestIntegration_Mfa_DisableWithRecoveryCode はリカバリーコードで二要素認証を無効にするフローをテストします
+// 通過条件:
+// - リカバリーコードで二要素認証を無効にできる
+// - 無効にした後のログインではチャレンジなしでトークンが返される
+func TestIntegration_Mfa_DisableWithRecoveryCode(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var user_finder *MockUserFinder
+	var credential_repo *MockTotpCredentialRepository
+	var mutation_resolver graph.MutationResolver
+	var enrollment *model.TotpEnrollment
+	var recovery_codes []string
+	var login_result *model.LoginPayload
+	var is_disabled bool
+	var err error
+
+	resolver, user_finder, credential_repo, _ = setupMfaIntegrationTest(t)
+	mutation_resolver = resolver.Mutation()
+	ctx = authenticated_mfa_context(t, user_finder)
+	enrollment, err = mutation_resolver.EnrollTotp(ctx)
+	if err != nil {
+		t.Fatalf("failed to enroll totp: %v", err)
+	}
+	recovery_codes, err = mutation_resolver.ConfirmTotp(ctx, totp_code(t, enrollment.Secret, time.Now()))
+	if err != nil {
+		t.Fatalf("failed to confirm totp: %v", err)
+	}
+
+	// 二要素認証を有効にした後のユーザーでcontextを作り直す
+	ctx = authenticated_mfa_context(t, user_finder)
+	is_disabled, err = mutation_resolver.DisableTotp(ctx, recovery_codes[0])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !is_disabled || user_finder.MfaEnabledAt != nil {
+		t.Error("expected mfa to be disabled")
+	}
+	if len(credential_repo.Credentials) != 0 {
+		t.Errorf("expected credential to be deleted, got %d", len(credential_repo.Credentials))
+	}
+
+	login_result, err = mutation_resolver.LoginWithIDToken(context.Background(), model.LoginWithIDTokenInput{IDToken: "valid_firebase_id_token"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if login_result.Tokens == nil || login_result.MfaChallenge != nil {
+		t.Error("expected tokens without mfa challenge after disabling mfa")
+	}
+}
+
+// MockTotpCredentialRepository は結合テスト用のインメモリな二要素認証の認証情報リポジトリです
+type MockTotpCredentialRepository struct {
+	Credentials map[uuid.UUID]*models.TotpCredential
+}
+
+// NewMockTotpCredentialRepository は新しいMockTotpCredentialRepositoryを作成します
+func NewMockTotpCredentialRepository() *MockTotpCredentialRepository {
+	return &MockTotpCredentialRepository{
+		Credentials: map[uuid.UUID]*models.TotpCredential{},
+	}
+}
+
+// Save はモックの認証情報の保存を行います（既存の認証情報は置き換えます）
+func (m *MockTotpCredentialRepository) Save(_ context.Context, credential *models.TotpCredential) error {
+	m.Credentials[credential.UserID()] = credential
+	return nil
+}
+
+// FindByUserID はモックの認証情報の検索を行います
+func (m *MockTotpCredentialRepository) FindByUserID(_ context.Context, user_id uuid.UUID) (*models.TotpCredential, error) {
+	var credential *models.TotpCredential
+	var is_found bool
+
+	credential, is_found = m.Credentials[user_id]
+	if !is_found {
+		return nil, domain_errors.ErrMfaEnrollmentNotFound
+	}
+	return credential, nil
+}
+
+// Update はモックの認証情報の更新を行います
+func (m *MockTotpCredentialRepository) Update(_ context.Context, credential *models.TotpCredential) error {
+	var is_found bool
+
+	_, is_found = m.Credentials[credential.UserID()]
+	if !is_found {
+		return domain_errors.ErrMfaEnrollmentNotFound
+	}
+	m.Credentials[credential.UserID()] = credential
+	return nil
+}
+
+// Delete はモックの認証情報の削除を行います
+func (m *MockTotpCredentialRepository) Delete(_ context.Context, user_id uuid.UUID) error {
+	delete(m.Credentials, user_id)
+	return nil
+}
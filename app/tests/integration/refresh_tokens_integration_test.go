@@ -0,0 +1,305 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// リフレッシュ結合テスト用のセットアップ
+func setupRefreshTokensIntegrationTest() (*graph.Resolver, *MockRefreshTokenRepository, *MockUserFinder, *utils.JWTService) {
+	var user_finder *MockUserFinder
+	var refresh_token_repo *MockRefreshTokenRepository
+	var session_repo *MockSessionRepository
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var resolver *graph.Resolver
+
+	user_finder = NewMockUserFinder()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	session_repo = NewMockSessionRepository()
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	token_issuer = user.NewTokenIssuer(jwt_service, refresh_token_repo, session_repo)
+	resolver = &graph.Resolver{
+		LoginUserUseCase: createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		RefreshTokensUseCase: user.NewRefreshTokensUseCase(
+			jwt_service,
+			token_issuer,
+			refresh_token_repo,
+			user_finder,
+			NewMockCredentialChangeChecker(),
+			user.NewUserSessionRevoker(NewMockTokenDenylist(), refresh_token_repo, session_repo),
+		),
+	}
+	return resolver, refresh_token_repo, user_finder, jwt_service
+}
+
+// login_for_refresh_test はリフレッシュテストの前提となるログインを行います
+func login_for_refresh_test(t *testing.T, mutation_resolver graph.MutationResolver) *model.LoginPayload {
+	var result *model.LoginPayload
+	var err error
+
+	t.Helper()
+	result, err = mutation_resolver.LoginWithIDToken(context.Background(), model.LoginWithIDTokenInput{
+		IDToken: "valid_firebase_id_token",
+	})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	return result
+}
+
+// TestIntegration_RefreshTokens_NormalFlow は正常なトークンリフレッシュフローをテストします
+// 通過条件:
+// - ログインで発行されたリフレッシュトークンで新しいトークンペアが発行される
+// - 新しいリフレッシュトークンは同じトークンファミリーとして記録される
+// - 新しいトークンのuser_idがログインユーザーのpublic_idと一致する
+func TestIntegration_RefreshTokens_NormalFlow(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var refresh_token_repo *MockRefreshTokenRepository
+	var user_finder *MockUserFinder
+	var jwt_service *utils.JWTService
+	var mutation_resolver graph.MutationResolver
+	var login_result *model.LoginPayload
+	var result *model.AuthTokens
+	var access_claims *utils.JWTClaims
+	var err error
+
+	ctx = context.Background()
+	resolver, refresh_token_repo, user_finder, jwt_service = setupRefreshTokensIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	result, err = mutation_resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.RefreshToken == login_result.Tokens.RefreshToken {
+		t.Error("expected refresh_token to be rotated")
+	}
+	access_claims, err = jwt_service.ValidateToken(ctx, result.AccessToken)
+	if err != nil {
+		t.Fatalf("expected valid access token, got error: %v", err)
+	}
+	if access_claims.UserID != user_finder.PublicID.String() {
+		t.Errorf("expected user_id %s, got %s", user_finder.PublicID.String(), access_claims.UserID)
+	}
+	if len(refresh_token_repo.Tokens) != 2 {
+		t.Fatalf("expected 2 stored tokens, got %d", len(refresh_token_repo.Tokens))
+	}
+	if refresh_token_repo.Tokens[0].FamilyID() != refresh_token_repo.Tokens[1].FamilyID() {
+		t.Error("expected rotated token to belong to the same family")
+	}
+	if !refresh_token_repo.Tokens[0].IsRotated() {
+		t.Error("expected used refresh token to be marked as rotated")
+	}
+}
+
+// TestIntegration_RefreshTokens_ReuseDetection はリフレッシュトークン再利用の検知をテストします
+// 通過条件:
+// - 使用済みのリフレッシュトークンを再提示するとREFRESH_TOKEN_REUSEDエラーになる
+// - トークンファミリー全体が失効し、正規クライアントの最新トークンも使えなくなる
+func TestIntegration_RefreshTokens_ReuseDetection(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var refresh_token_repo *MockRefreshTokenRepository
+	var mutation_resolver graph.MutationResolver
+	var login_result *model.LoginPayload
+	var result *model.AuthTokens
+	var err error
+
+	ctx = context.Background()
+	resolver, refresh_token_repo, _, _ = setupRefreshTokensIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	result, err = mutation_resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected first refresh to succeed, got %v", err)
+	}
+	_, err = mutation_resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrRefreshTokenReused) {
+		t.Fatalf("expected ErrRefreshTokenReused, got %v", err)
+	}
+	for _, token := range refresh_token_repo.Tokens {
+		if !token.IsRevoked() {
+			t.Errorf("expected token %s to be revoked", token.TokenID())
+		}
+	}
+	_, err = mutation_resolver.RefreshTokens(ctx, result.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+}
+
+// TestIntegration_RefreshTokens_DeletedUser は論理削除済みユーザーのリフレッシュが拒否されることをテストします
+// 通過条件:
+// - ログイン後にユーザーが論理削除された場合、リフレッシュはUSER_DELETEDエラーになる
+// - 新しいトークンは発行されない
+func TestIntegration_RefreshTokens_DeletedUser(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var refresh_token_repo *MockRefreshTokenRepository
+	var user_finder *MockUserFinder
+	var mutation_resolver graph.MutationResolver
+	var login_result *model.LoginPayload
+	var result *model.AuthTokens
+	var err error
+
+	ctx = context.Background()
+	resolver, refresh_token_repo, user_finder, _ = setupRefreshTokensIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	login_result = login_for_refresh_test(t, mutation_resolver)
+	user_finder.IsDeleted = true
+	result, err = mutation_resolver.RefreshTokens(ctx, login_result.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Errorf("expected ErrUserDeleted, got %v", err)
+	}
+	if result != nil {
+		t.Error("expected result to be nil for deleted user")
+	}
+	if len(refresh_token_repo.Tokens) != 1 {
+		t.Errorf("expected no new token to be stored, got %d tokens", len(refresh_token_repo.Tokens))
+	}
+}
+
+// MockRefreshTokenRepository は結合テスト用のインメモリなリフレッシュトークンリポジトリです
+type MockRefreshTokenRepository struct {
+	Tokens []*models.RefreshToken
+}
+
+// NewMockRefreshTokenRepository は新しいMockRefreshTokenRepositoryを作成します
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		Tokens: []*models.RefreshToken{},
+	}
+}
+
+// Save はモックのリフレッシュトークン保存を行います
+func (m *MockRefreshTokenRepository) Save(_ context.Context, token *models.RefreshToken) error {
+	m.Tokens = append(m.Tokens, token)
+	return nil
+}
+
+// FindByTokenID はモックのリフレッシュトークン検索を行います
+func (m *MockRefreshTokenRepository) FindByTokenID(_ context.Context, token_id string) (*models.RefreshToken, error) {
+	for _, token := range m.Tokens {
+		if token.TokenID() == token_id {
+			return token, nil
+		}
+	}
+	return nil, domain_errors.ErrInvalidRefreshToken
+}
+
+// MarkRotated はモックのローテーション処理を行います
+func (m *MockRefreshTokenRepository) MarkRotated(_ context.Context, token_id string, rotated_at time.Time) (bool, error) {
+	for i, token := range m.Tokens {
+		var err error
+
+		if token.TokenID() != token_id || token.IsRotated() || token.IsRevoked() {
+			continue
+		}
+		m.Tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), &rotated_at, nil, token.CreatedAt(),
+		)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// RevokeFamily はモックのファミリー失効処理を行います
+func (m *MockRefreshTokenRepository) RevokeFamily(_ context.Context, family_id uuid.UUID, revoked_at time.Time) error {
+	for i, token := range m.Tokens {
+		var err error
+
+		if token.FamilyID() != family_id || token.IsRevoked() {
+			continue
+		}
+		m.Tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), token.RotatedAt(), &revoked_at, token.CreatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindActiveFamilyIDsByUserID はモックの未失効ファミリー検索を行います
+func (m *MockRefreshTokenRepository) FindActiveFamilyIDsByUserID(_ context.Context, user_id uuid.UUID) ([]uuid.UUID, error) {
+	var family_ids []uuid.UUID
+	var seen map[uuid.UUID]bool
+
+	family_ids = []uuid.UUID{}
+	seen = map[uuid.UUID]bool{}
+	for _, token := range m.Tokens {
+		if token.UserID() != user_id || token.IsRevoked() || seen[token.FamilyID()] {
+			continue
+		}
+		seen[token.FamilyID()] = true
+		family_ids = append(family_ids, token.FamilyID())
+	}
+	return family_ids, nil
+}
+
+// MockSessionRepository は結合テスト用のインメモリなセッションリポジトリです
+type MockSessionRepository struct {
+	Sessions []*models.Session
+}
+
+// NewMockSessionRepository は新しいMockSessionRepositoryを作成します
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		Sessions: []*models.Session{},
+	}
+}
+
+// Save はモックのセッション保存を行います
+func (m *MockSessionRepository) Save(_ context.Context, session *models.Session) error {
+	m.Sessions = append(m.Sessions, session)
+	return nil
+}
+
+// Touch はモックのセッションの最終利用日時と有効期限の更新を行います
+func (m *MockSessionRepository) Touch(_ context.Context, session_id uuid.UUID, last_seen_at time.Time, expires_at time.Time) error {
+	for i, session := range m.Sessions {
+		var err error
+
+		if session.SessionID() != session_id || session.RevokedAt() != nil {
+			continue
+		}
+		m.Sessions[i], err = models.NewSessionWithStatus(
+			session.SessionID(), session.UserID(), session.Device(), last_seen_at, expires_at, nil, session.CreatedAt(),
+		)
+		return err
+	}
+	return nil
+}
+
+// Revoke はモックのセッション失効を行います
+func (m *MockSessionRepository) Revoke(_ context.Context, session_id uuid.UUID, revoked_at time.Time) error {
+	for i, session := range m.Sessions {
+		var err error
+
+		if session.SessionID() != session_id || session.RevokedAt() != nil {
+			continue
+		}
+		m.Sessions[i], err = models.NewSessionWithStatus(
+			session.SessionID(), session.UserID(), session.Device(), session.LastSeenAt(), session.ExpiresAt(), &revoked_at, session.CreatedAt(),
+		)
+		return err
+	}
+	return nil
+}
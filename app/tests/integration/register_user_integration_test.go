@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
@@ -10,11 +11,14 @@ import (
 	"sleeve/graph/model"
 	"sleeve/usecase/user"
 	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
 )
 
 // テスト用定数
 const (
-	testSecretKey = "test_secret_key_for_integration_testing_1234567890"
+	testSecretKey      = "test_secret_key_for_integration_testing_1234567890"
+	testFingerprintKey = "test_idempotency_fingerprint_key_for_integration_testing"
 )
 
 // 結合テスト用のセットアップ
@@ -27,8 +31,18 @@ func setupIntegrationTest() (*graph.Resolver, *MockFirebaseUserRepository, *Mock
 
 	firebase_repo = NewMockFirebaseUserRepository()
 	user_dao = NewMockUserDAO()
-	jwt_service = utils.NewJWTService(testSecretKey)
-	use_case = user.NewRegisterUserUseCase(firebase_repo, user_dao, jwt_service)
+	jwt_service = createTestJWTService(NewMockTokenDenylist())
+	use_case = user.NewRegisterUserUseCase(
+		firebase_repo,
+		user_dao,
+		user.NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		user.NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		user.NewFirebaseUserCompensator(firebase_repo, &MockCompensationTaskRepository{}),
+		createTestAttemptGuard(),
+		&MockIdempotencyRecordRepository{},
+		&MockRegisteredUserFinder{},
+		[]byte(testFingerprintKey),
+	)
 	resolver = &graph.Resolver{
 		RegisterUserUseCase: use_case,
 	}
@@ -280,7 +294,7 @@ func TestIntegration_RegisterUser_JWTValidity(t *testing.T) {
 	}
 
 	// アクセストークンの検証
-	access_claims, err = jwt_service.ValidateToken(result.Tokens.AccessToken)
+	access_claims, err = jwt_service.ValidateToken(ctx, result.Tokens.AccessToken)
 	if err != nil {
 		t.Fatalf("failed to validate access token: %v", err)
 	}
@@ -297,7 +311,7 @@ func TestIntegration_RegisterUser_JWTValidity(t *testing.T) {
 	}
 
 	// リフレッシュトークンの検証
-	refresh_claims, err = jwt_service.ValidateToken(result.Tokens.RefreshToken)
+	refresh_claims, err = jwt_service.ValidateToken(ctx, result.Tokens.RefreshToken)
 	if err != nil {
 		t.Fatalf("failed to validate refresh token: %v", err)
 	}
@@ -362,6 +376,16 @@ func (m *MockFirebaseUserRepository) DeleteUser(_ context.Context, _ string) err
 	return nil
 }
 
+// EnableUser はモックのユーザー有効化を行います
+func (m *MockFirebaseUserRepository) EnableUser(_ context.Context, _ string) error {
+	return nil
+}
+
+// UpdateEmail はモックのメールアドレス変更を行います
+func (m *MockFirebaseUserRepository) UpdateEmail(_ context.Context, _ string, _ models.Email, _ bool) error {
+	return nil
+}
+
 // MockUserDAO は結合テスト用のUserDAOモックです
 type MockUserDAO struct {
 	ShouldReturnError bool
@@ -384,3 +408,62 @@ func (m *MockUserDAO) Save(_ context.Context, _ *models.User) error {
 	}
 	return nil
 }
+
+// HardDeleteByPublicID はモックのユーザーの物理削除を行います
+func (m *MockUserDAO) HardDeleteByPublicID(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// MockCompensationTaskRepository は結合テスト用の補償処理を保存しないリポジトリモックです
+type MockCompensationTaskRepository struct{}
+
+// Save は何もしません
+func (m *MockCompensationTaskRepository) Save(_ context.Context, _ *models.CompensationTask) error {
+	return nil
+}
+
+// FindDue は再試行する補償処理がないものとして返します
+func (m *MockCompensationTaskRepository) FindDue(_ context.Context, _ time.Time, _ int) ([]*models.CompensationTask, error) {
+	return nil, nil
+}
+
+// Update は何もしません
+func (m *MockCompensationTaskRepository) Update(_ context.Context, _ *models.CompensationTask) error {
+	return nil
+}
+
+// Delete は何もしません
+func (m *MockCompensationTaskRepository) Delete(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// MockIdempotencyRecordRepository は結合テスト用の冪等キーの記録を保存しないリポジトリモックです
+type MockIdempotencyRecordRepository struct{}
+
+// Reserve は常に予約できたものとして返します
+func (m *MockIdempotencyRecordRepository) Reserve(_ context.Context, _ *models.IdempotencyRecord) (bool, error) {
+	return true, nil
+}
+
+// FindByKey は記録がないものとしてnilを返します
+func (m *MockIdempotencyRecordRepository) FindByKey(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey) (*models.IdempotencyRecord, error) {
+	return nil, nil
+}
+
+// Complete は何もしません
+func (m *MockIdempotencyRecordRepository) Complete(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey, _ uuid.UUID) error {
+	return nil
+}
+
+// Release は何もしません
+func (m *MockIdempotencyRecordRepository) Release(_ context.Context, _ models.IdempotencyOperation, _ models.IdempotencyKey) error {
+	return nil
+}
+
+// MockRegisteredUserFinder は結合テスト用の登録済みのユーザーがいないものとして扱うモックです
+type MockRegisteredUserFinder struct{}
+
+// FindByPublicID は常にErrUserNotFoundを返します
+func (m *MockRegisteredUserFinder) FindByPublicID(_ context.Context, _ uuid.UUID) (*models.User, error) {
+	return nil, domain_errors.ErrUserNotFound
+}
@@ -0,0 +1,222 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+)
+
+// テスト用定数
+const (
+	testPasswordResetLimit = 3
+	testPasswordResetLink  = "https://sleeve.example.com/reset-password?oobCode=integration"
+)
+
+// パスワードリセット結合テスト用のセットアップ
+func setupRequestPasswordResetIntegrationTest() (*graph.Resolver, *MockPasswordResetMailSender, *MockCredentialChangeChecker) {
+	var user_finder *MockUserFinder
+	var refresh_token_repo *MockRefreshTokenRepository
+	var session_repo *MockSessionRepository
+	var denylist *MockTokenDenylist
+	var jwt_service *utils.JWTService
+	var token_issuer *user.TokenIssuer
+	var mail_sender *MockPasswordResetMailSender
+	var credential_checker *MockCredentialChangeChecker
+	var resolver *graph.Resolver
+
+	user_finder = NewMockUserFinder()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	session_repo = NewMockSessionRepository()
+	denylist = NewMockTokenDenylist()
+	jwt_service = createTestJWTService(denylist)
+	token_issuer = user.NewTokenIssuer(jwt_service, refresh_token_repo, session_repo)
+	mail_sender = NewMockPasswordResetMailSender()
+	credential_checker = NewMockCredentialChangeChecker()
+	resolver = &graph.Resolver{
+		LoginUserUseCase: createTestLoginUserUseCase(NewMockFirebaseTokenVerifier(), user_finder, token_issuer),
+		RefreshTokensUseCase: user.NewRefreshTokensUseCase(
+			jwt_service,
+			token_issuer,
+			refresh_token_repo,
+			user_finder,
+			credential_checker,
+			user.NewUserSessionRevoker(denylist, refresh_token_repo, session_repo),
+		),
+		RequestPasswordResetUseCase: user.NewRequestPasswordResetUseCase(
+			NewMockPasswordResetLinkGenerator(),
+			mail_sender,
+			NewMockRateLimiter(testPasswordResetLimit),
+		),
+	}
+	return resolver, mail_sender, credential_checker
+}
+
+// TestIntegration_RequestPasswordReset_NoEnumeration は登録の有無でレスポンスが変わらないことをテストします
+// 通過条件:
+// - 登録済み・未登録のどちらのメールアドレスでもtrueが返る
+// - 登録済みのメールアドレスにのみ再設定リンクが送信される
+func TestIntegration_RequestPasswordReset_NoEnumeration(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var mail_sender *MockPasswordResetMailSender
+	var mutation_resolver graph.MutationResolver
+	var result bool
+	var err error
+
+	ctx = context.Background()
+	resolver, mail_sender, _ = setupRequestPasswordResetIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	for _, email := range []string{testLoginEmail, "unregistered@example.com"} {
+		result, err = mutation_resolver.RequestPasswordReset(ctx, email)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %v", email, err)
+		}
+		if !result {
+			t.Errorf("expected result to be true for %s", email)
+		}
+	}
+	if len(mail_sender.SentLinks) != 1 || mail_sender.SentLinks[testLoginEmail] != testPasswordResetLink {
+		t.Errorf("expected reset link to be sent only to %s, got %v", testLoginEmail, mail_sender.SentLinks)
+	}
+}
+
+// TestIntegration_RequestPasswordReset_RateLimit はメールアドレスごとのレート制限をテストします
+// 通過条件:
+// - 上限回数まではtrueが返る
+// - 上限を超えるとErrTooManyPasswordResetRequestsが返る（未登録のメールアドレスでも同様）
+func TestIntegration_RequestPasswordReset_RateLimit(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var mutation_resolver graph.MutationResolver
+	var err error
+
+	ctx = context.Background()
+	resolver, _, _ = setupRequestPasswordResetIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	for _, email := range []string{testLoginEmail, "unregistered@example.com"} {
+		for i := 0; i < testPasswordResetLimit; i++ {
+			_, err = mutation_resolver.RequestPasswordReset(ctx, email)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+		_, err = mutation_resolver.RequestPasswordReset(ctx, email)
+		if !errors.Is(err, domain_errors.ErrTooManyPasswordResetRequests) {
+			t.Errorf("expected ErrTooManyPasswordResetRequests for %s, got %v", email, err)
+		}
+	}
+}
+
+// TestIntegration_RequestPasswordReset_RevokesSessionsAfterReset はパスワード再設定後に既存セッションが失効することをテストします
+// 通過条件:
+// - パスワード再設定前に発行されたリフレッシュトークンはErrTokenRevokedになる
+// - 同じユーザーの他のセッションも失効する
+func TestIntegration_RequestPasswordReset_RevokesSessionsAfterReset(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var credential_checker *MockCredentialChangeChecker
+	var mutation_resolver graph.MutationResolver
+	var current_session *model.LoginPayload
+	var other_session *model.LoginPayload
+	var err error
+
+	ctx = context.Background()
+	resolver, _, credential_checker = setupRequestPasswordResetIntegrationTest()
+	mutation_resolver = resolver.Mutation()
+	current_session = login_for_refresh_test(t, mutation_resolver)
+	other_session = login_for_refresh_test(t, mutation_resolver)
+
+	// Firebase側でパスワードが再設定された状態
+	credential_checker.TokensValidAfterTime = time.Now().Add(2 * time.Second)
+	_, err = mutation_resolver.RefreshTokens(ctx, current_session.Tokens.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Fatalf("expected ErrTokenRevoked, got %v", err)
+	}
+	_, err = mutation_resolver.RefreshTokens(ctx, other_session.Tokens.RefreshToken)
+	if err == nil {
+		t.Error("expected other session to be revoked")
+	}
+}
+
+// MockPasswordResetLinkGenerator は結合テスト用のパスワード再設定リンク生成モックです
+type MockPasswordResetLinkGenerator struct{}
+
+// NewMockPasswordResetLinkGenerator は新しいMockPasswordResetLinkGeneratorを作成します
+func NewMockPasswordResetLinkGenerator() *MockPasswordResetLinkGenerator {
+	return &MockPasswordResetLinkGenerator{}
+}
+
+// GeneratePasswordResetLink はtestLoginEmail以外を未登録として扱います
+func (m *MockPasswordResetLinkGenerator) GeneratePasswordResetLink(_ context.Context, email models.Email) (string, error) {
+	if !strings.EqualFold(email.Value(), testLoginEmail) {
+		return "", domain_errors.ErrUserNotFound
+	}
+	return testPasswordResetLink, nil
+}
+
+// MockPasswordResetMailSender は結合テスト用のメール送信モックです
+type MockPasswordResetMailSender struct {
+	SentLinks map[string]string
+}
+
+// NewMockPasswordResetMailSender は新しいMockPasswordResetMailSenderを作成します
+func NewMockPasswordResetMailSender() *MockPasswordResetMailSender {
+	return &MockPasswordResetMailSender{
+		SentLinks: map[string]string{},
+	}
+}
+
+// SendPasswordResetMail は送信先と再設定リンクを記録します
+func (m *MockPasswordResetMailSender) SendPasswordResetMail(_ context.Context, email models.Email, reset_link string) error {
+	m.SentLinks[email.Value()] = reset_link
+	return nil
+}
+
+// MockRateLimiter は結合テスト用のレートリミッターモックです
+type MockRateLimiter struct {
+	limit  int
+	counts map[string]int
+}
+
+// NewMockRateLimiter は新しいMockRateLimiterを作成します
+func NewMockRateLimiter(limit int) *MockRateLimiter {
+	return &MockRateLimiter{
+		limit:  limit,
+		counts: map[string]int{},
+	}
+}
+
+// Allow はキーごとの回数が上限未満であれば許可します
+func (m *MockRateLimiter) Allow(_ context.Context, key string) (bool, error) {
+	if m.counts[key] >= m.limit {
+		return false, nil
+	}
+	m.counts[key]++
+	return true, nil
+}
+
+// MockCredentialChangeChecker は結合テスト用の認証情報変更日時モックです
+type MockCredentialChangeChecker struct {
+	TokensValidAfterTime time.Time
+}
+
+// NewMockCredentialChangeChecker は新しいMockCredentialChangeCheckerを作成します（初期状態では認証情報の変更なし）
+func NewMockCredentialChangeChecker() *MockCredentialChangeChecker {
+	return &MockCredentialChangeChecker{
+		TokensValidAfterTime: time.Time{},
+	}
+}
+
+// TokensValidAfter はモックの認証情報変更日時を返します
+func (m *MockCredentialChangeChecker) TokensValidAfter(_ context.Context, _ string) (time.Time, error) {
+	return m.TokensValidAfterTime, nil
+}
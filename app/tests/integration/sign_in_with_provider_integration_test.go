@@ -0,0 +1,292 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/graph"
+	"sleeve/graph/model"
+	"sleeve/repository/external/line"
+	"sleeve/usecase/user"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// 結合テスト用のLINEログインチャネルIDとLINEアカウント
+const (
+	testLINEChannelID = "2000000000"
+	testLINESubject   = "U0123456789abcdef"
+	testLINEEmail     = "line-user@example.com"
+)
+
+// ソーシャルログイン結合テスト用のセットアップ
+// LINEのIDトークンはローカルのJWKSサーバー（line.MockJWKSServer）の鍵で署名・検証します
+func setupSignInWithProviderIntegrationTest(t *testing.T) (*graph.Resolver, *line.MockJWKSServer, *MockProviderUserRepository, *MockUserIdentityRepository) {
+	var jwks_server *line.MockJWKSServer
+	var user_repo *MockProviderUserRepository
+	var identity_repo *MockUserIdentityRepository
+	var firebase_provider *MockFirebaseProviderRepository
+	var token_verifier *user.ProviderTokenVerifier
+	var resolver *graph.Resolver
+	var err error
+
+	t.Helper()
+	jwks_server, err = line.NewMockJWKSServer()
+	if err != nil {
+		t.Fatalf("failed to start jwks server: %v", err)
+	}
+	t.Cleanup(jwks_server.Close)
+	user_repo = NewMockProviderUserRepository()
+	identity_repo = NewMockUserIdentityRepository()
+	firebase_provider = NewMockFirebaseProviderRepository()
+	token_verifier = user.NewProviderTokenVerifier(
+		firebase_provider,
+		line.NewLINEIDTokenVerifier(jwks_server.URL(), testLINEChannelID),
+	)
+	resolver = &graph.Resolver{
+		SignInWithProviderUseCase: user.NewSignInWithProviderUseCase(
+			token_verifier,
+			identity_repo,
+			user_repo,
+			firebase_provider,
+			user.NewTokenIssuer(createTestJWTService(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+			createTestAttemptGuard(),
+		),
+		LinkProviderUseCase:   user.NewLinkProviderUseCase(token_verifier, identity_repo),
+		UnlinkProviderUseCase: user.NewUnlinkProviderUseCase(identity_repo, firebase_provider),
+	}
+	return resolver, jwks_server, user_repo, identity_repo
+}
+
+// sign_line_id_token はローカルのJWKSサーバーでLINEのIDトークンを署名します
+func sign_line_id_token(t *testing.T, jwks_server *line.MockJWKSServer, channel_id string) string {
+	var id_token string
+	var err error
+
+	t.Helper()
+	id_token, err = jwks_server.SignIDToken(channel_id, testLINESubject, testLINEEmail, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to sign line id token: %v", err)
+	}
+	return id_token
+}
+
+// TestIntegration_SignInWithProvider_LINE はLINEログインの一連の流れをテストします
+// 通過条件:
+// - 初回のLINEログインでユーザーが新規登録され、LINEの連携とFirebaseのカスタムトークンが返る
+// - 2回目のLINEログインでは同じユーザーとしてログインし、isNewUserがfalseになる
+// - 別チャネル向けのIDトークンはErrInvalidProviderTokenになる
+// - パスワードも他の連携も無いユーザーはLINEの連携を解除できない
+func TestIntegration_SignInWithProvider_LINE(t *testing.T) {
+	var ctx context.Context
+	var resolver *graph.Resolver
+	var jwks_server *line.MockJWKSServer
+	var user_repo *MockProviderUserRepository
+	var identity_repo *MockUserIdentityRepository
+	var mutation_resolver graph.MutationResolver
+	var first_result *model.SignInWithProviderPayload
+	var second_result *model.SignInWithProviderPayload
+	var current_user *models.User
+	var err error
+
+	ctx = context.Background()
+	resolver, jwks_server, user_repo, identity_repo = setupSignInWithProviderIntegrationTest(t)
+	mutation_resolver = resolver.Mutation()
+
+	// 初回ログイン（新規登録）
+	first_result, err = mutation_resolver.SignInWithProvider(ctx, model.SignInWithProviderInput{
+		Provider: model.AuthProviderLine,
+		IDToken:  sign_line_id_token(t, jwks_server, testLINEChannelID),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !first_result.IsNewUser {
+		t.Error("expected isNewUser to be true on first sign in")
+	}
+	if first_result.User.Email != testLINEEmail {
+		t.Errorf("expected email %s, got %s", testLINEEmail, first_result.User.Email)
+	}
+	if first_result.FirebaseCustomToken == nil || *first_result.FirebaseCustomToken != "mock_custom_token_line:"+testLINESubject {
+		t.Errorf("expected custom token for line:%s, got %v", testLINESubject, first_result.FirebaseCustomToken)
+	}
+	if len(identity_repo.Identities) != 1 || identity_repo.Identities[0].Subject() != testLINESubject {
+		t.Fatalf("expected line identity to be linked, got %d identities", len(identity_repo.Identities))
+	}
+
+	// 2回目のログイン（既存ユーザー）
+	second_result, err = mutation_resolver.SignInWithProvider(ctx, model.SignInWithProviderInput{
+		Provider: model.AuthProviderLine,
+		IDToken:  sign_line_id_token(t, jwks_server, testLINEChannelID),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if second_result.IsNewUser {
+		t.Error("expected isNewUser to be false on second sign in")
+	}
+	if second_result.User.ID != first_result.User.ID {
+		t.Errorf("expected same user %s, got %s", first_result.User.ID, second_result.User.ID)
+	}
+
+	// 別チャネル向けのIDトークン
+	_, err = mutation_resolver.SignInWithProvider(ctx, model.SignInWithProviderInput{
+		Provider: model.AuthProviderLine,
+		IDToken:  sign_line_id_token(t, jwks_server, "other_channel"),
+	})
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+
+	// 最後のログイン手段の連携解除
+	current_user, err = user_repo.FindByPublicID(ctx, uuid.MustParse(first_result.User.ID))
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	_, err = mutation_resolver.UnlinkProvider(
+		utils.WithAuthInfo(ctx, &utils.JWTClaims{UserID: first_result.User.ID}, current_user),
+		model.AuthProviderLine,
+	)
+	if !errors.Is(err, domain_errors.ErrCannotUnlinkLastProvider) {
+		t.Errorf("expected ErrCannotUnlinkLastProvider, got %v", err)
+	}
+}
+
+// MockFirebaseProviderRepository はソーシャルログイン結合テスト用のFirebaseリポジトリのモックです
+// Google・Apple・XのIDトークン検証は常に失敗し、パスワード認証は無いものとして扱います
+type MockFirebaseProviderRepository struct{}
+
+// NewMockFirebaseProviderRepository は新しいMockFirebaseProviderRepositoryを作成します
+func NewMockFirebaseProviderRepository() *MockFirebaseProviderRepository {
+	return &MockFirebaseProviderRepository{}
+}
+
+// VerifyProviderIDToken はモックのFirebase IDトークン検証を行います
+func (m *MockFirebaseProviderRepository) VerifyProviderIDToken(_ context.Context, _ string, _ models.AuthProvider) (*models.ProviderIdentity, error) {
+	return nil, domain_errors.ErrInvalidProviderToken
+}
+
+// CreateCustomToken はFirebase UIDを含むモックのカスタムトークンを返します
+func (m *MockFirebaseProviderRepository) CreateCustomToken(_ context.Context, firebase_uid string) (string, error) {
+	return "mock_custom_token_" + firebase_uid, nil
+}
+
+// HasPasswordProvider はパスワード認証なしとして返します
+func (m *MockFirebaseProviderRepository) HasPasswordProvider(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+
+// UnlinkProvider はモックの連携解除を行います
+func (m *MockFirebaseProviderRepository) UnlinkProvider(_ context.Context, _ string, _ models.AuthProvider) error {
+	return nil
+}
+
+// MockUserIdentityRepository は結合テスト用のインメモリな外部プロバイダ連携リポジトリです
+type MockUserIdentityRepository struct {
+	Identities []*models.UserIdentity
+}
+
+// NewMockUserIdentityRepository は新しいMockUserIdentityRepositoryを作成します
+func NewMockUserIdentityRepository() *MockUserIdentityRepository {
+	return &MockUserIdentityRepository{
+		Identities: []*models.UserIdentity{},
+	}
+}
+
+// Save はモックの連携保存を行います
+func (m *MockUserIdentityRepository) Save(_ context.Context, identity *models.UserIdentity) error {
+	for _, existing := range m.Identities {
+		if existing.Provider() == identity.Provider() && existing.Subject() == identity.Subject() {
+			return domain_errors.ErrProviderAlreadyLinked
+		}
+	}
+	m.Identities = append(m.Identities, identity)
+	return nil
+}
+
+// FindByProviderSubject はモックの連携検索を行います
+func (m *MockUserIdentityRepository) FindByProviderSubject(_ context.Context, provider models.AuthProvider, subject string) (*models.UserIdentity, error) {
+	for _, identity := range m.Identities {
+		if identity.Provider() == provider && identity.Subject() == subject {
+			return identity, nil
+		}
+	}
+	return nil, domain_errors.ErrProviderNotLinked
+}
+
+// FindByUserID はモックのユーザーの連携一覧取得を行います
+func (m *MockUserIdentityRepository) FindByUserID(_ context.Context, user_id uuid.UUID) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+
+	identities = []*models.UserIdentity{}
+	for _, identity := range m.Identities {
+		if identity.UserID() == user_id {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+// Delete はモックの連携削除を行います
+func (m *MockUserIdentityRepository) Delete(_ context.Context, user_id uuid.UUID, provider models.AuthProvider) error {
+	for i, identity := range m.Identities {
+		if identity.UserID() == user_id && identity.Provider() == provider {
+			m.Identities = append(m.Identities[:i], m.Identities[i+1:]...)
+			return nil
+		}
+	}
+	return domain_errors.ErrProviderNotLinked
+}
+
+// MockProviderUserRepository は結合テスト用のインメモリなユーザーリポジトリです
+type MockProviderUserRepository struct {
+	Users []*models.User
+}
+
+// NewMockProviderUserRepository は新しいMockProviderUserRepositoryを作成します
+func NewMockProviderUserRepository() *MockProviderUserRepository {
+	return &MockProviderUserRepository{
+		Users: []*models.User{},
+	}
+}
+
+// Save はモックのユーザー保存を行います
+func (m *MockProviderUserRepository) Save(_ context.Context, user *models.User) error {
+	m.Users = append(m.Users, user)
+	return nil
+}
+
+// FindByPublicID はモックの公開IDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	for _, user := range m.Users {
+		if user.PublicID() == public_id {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// FindByFirebaseUID はモックのFirebase UIDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	for _, user := range m.Users {
+		if user.FirebaseUID() == firebase_uid {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// ExistsByEmail はモックのメールアドレスの存在確認を行います
+func (m *MockProviderUserRepository) ExistsByEmail(_ context.Context, email models.Email) (bool, error) {
+	for _, user := range m.Users {
+		if user.Email().Value() == email.Value() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FollowRequestApproverInterface はフォローリクエストを承認するインターフェースです
+type FollowRequestApproverInterface interface {
+	// Approve はリクエストが存在しない場合はfalseを返します
+	Approve(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID, approved_at time.Time) (bool, error)
+}
+
+// ApproveFollowRequestUseCase は非公開アカウントのユーザーが受信したフォローリクエストを承認するユースケースです
+type ApproveFollowRequestUseCase struct {
+	follow_request_repo FollowRequestApproverInterface
+}
+
+// NewApproveFollowRequestUseCase は新しいApproveFollowRequestUseCaseを作成します
+func NewApproveFollowRequestUseCase(follow_request_repo FollowRequestApproverInterface) *ApproveFollowRequestUseCase {
+	return &ApproveFollowRequestUseCase{
+		follow_request_repo: follow_request_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーからのフォローリクエストを承認し、フォロワーに追加します
+// 不正な公開ID・存在しないユーザー・リクエストが存在しない（拒否・取り消し・承認済み）場合はErrFollowRequestNotFoundを返します
+func (uc *ApproveFollowRequestUseCase) Execute(ctx context.Context, user *models.User, requester_id_str string) error {
+	var requester_id uuid.UUID
+	var approved bool
+	var err error
+
+	requester_id, err = uuid.Parse(requester_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	approved, err = uc.follow_request_repo.Approve(ctx, requester_id, user.PublicID(), time.Now())
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !approved {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	return nil
+}
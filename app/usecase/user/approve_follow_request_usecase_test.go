@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestApproveFollowRequestUseCase_Execute はリクエストの承認によるフォロワーの追加と、存在しないリクエストをテストします
+func TestApproveFollowRequestUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var requester_id uuid.UUID
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var use_case *ApproveFollowRequestUseCase
+	var request *models.FollowRequest
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	requester_id = uuid.New()
+	follow_repo = NewMockFollowRepository()
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	request, _ = models.NewFollowRequest(requester_id, user.PublicID(), time.Now())
+	_, _ = follow_request_repo.Create(ctx, request)
+	use_case = NewApproveFollowRequestUseCase(follow_request_repo)
+
+	err = use_case.Execute(ctx, user, requester_id.String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	exists, _ = follow_repo.Exists(ctx, requester_id, user.PublicID())
+	if !exists {
+		t.Error("expected requester to follow after approval")
+	}
+	for _, target := range []string{requester_id.String(), "invalid", uuid.New().String()} {
+		err = use_case.Execute(ctx, user, target)
+		if !errors.Is(err, domain_errors.ErrFollowRequestNotFound) {
+			t.Errorf("expected ErrFollowRequestNotFound for %s, got %v", target, err)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// AttemptCounterInterface はキーごとの認証の失敗回数を記録するインターフェースです
+// インメモリ（単一インスタンス・ローカル開発用）とPostgres（複数インスタンスで共有）の実装を切り替えられます
+type AttemptCounterInterface interface {
+	// Get はキーの記録を返します（記録がない・有効期限切れの場合はnilを返します）
+	Get(ctx context.Context, key models.AttemptKey, now time.Time) (*models.AttemptRecord, error)
+	// RecordFailure は失敗回数を1増やし、有効期限を更新した記録を返します（有効期限切れの記録は1回目から数え直します）
+	RecordFailure(ctx context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error)
+	// Reset はキーの記録を削除します
+	Reset(ctx context.Context, key models.AttemptKey) error
+}
+
+// SecurityEventLoggerInterface はセキュリティイベントを不正検知の処理へ連携するインターフェースです
+type SecurityEventLoggerInterface interface {
+	LogSecurityEvent(ctx context.Context, event *models.SecurityEvent)
+}
+
+// attempt_uncounted_errors は認証の失敗として数えないエラーです
+// サーバー側のエラーは障害時にユーザーがロックアウトされないよう、試行の拒否と処理中のリクエストの再送信は待ち時間が延びないよう除外します
+var attempt_uncounted_errors = []error{
+	domain_errors.ErrFirebaseAuthFailed,
+	domain_errors.ErrDatabaseError,
+	domain_errors.ErrJWTGenerationFailed,
+	domain_errors.ErrMailSendFailed,
+	domain_errors.ErrTooManyAttempts,
+	domain_errors.ErrIdempotentRequestInProgress,
+}
+
+// AuthAttemptGuard は認証の失敗回数をメールアドレス・IPアドレス・端末IDごとに数え、
+// 失敗回数に応じた待ち時間（段階的な遅延とロックアウト）の間は試行をErrTooManyAttemptsで拒否します
+type AuthAttemptGuard struct {
+	counter      AttemptCounterInterface
+	event_logger SecurityEventLoggerInterface
+	now          func() time.Time
+}
+
+// NewAuthAttemptGuard は新しいAuthAttemptGuardを作成します
+func NewAuthAttemptGuard(counter AttemptCounterInterface, event_logger SecurityEventLoggerInterface) *AuthAttemptGuard {
+	return &AuthAttemptGuard{
+		counter:      counter,
+		event_logger: event_logger,
+		now:          time.Now,
+	}
+}
+
+// AuthAttempt は1回の認証の試行です
+type AuthAttempt struct {
+	guard       *AuthAttemptGuard
+	action      models.AuthAction
+	client_info *utils.ClientInfo
+	keys        []models.AttemptKey
+}
+
+// Begin はリクエスト元のIPアドレス・端末IDの待ち時間を確認して試行を開始します
+// 待ち時間中の場合はTooManyAttemptsError（ErrTooManyAttempts）を返します
+func (g *AuthAttemptGuard) Begin(ctx context.Context, action models.AuthAction) (*AuthAttempt, error) {
+	var attempt *AuthAttempt
+	var err error
+
+	attempt = &AuthAttempt{
+		guard:       g,
+		action:      action,
+		client_info: utils.GetClientInfo(ctx),
+		keys:        []models.AttemptKey{},
+	}
+	err = attempt.add_key(ctx, models.AttemptKeyKindIP, attempt.client_info.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = attempt.add_key(ctx, models.AttemptKeyKindDevice, attempt.client_info.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// IdentifyEmail は試行の対象のメールアドレスを追加し、その待ち時間を確認します
+// 待ち時間中の場合はTooManyAttemptsError（ErrTooManyAttempts）を返します
+func (a *AuthAttempt) IdentifyEmail(ctx context.Context, email string) error {
+	return a.add_key(ctx, models.AttemptKeyKindEmail, email)
+}
+
+// Fail は認証の失敗を記録し、errをそのまま返します
+// サーバー側のエラーは失敗として数えません。記録自体に失敗した場合も元のエラーを返します
+func (a *AuthAttempt) Fail(ctx context.Context, err error) error {
+	var now time.Time
+	var record *models.AttemptRecord
+	var policy models.AttemptPolicy
+	var failure_count int
+	var retry_after time.Duration
+	var is_locked_out bool
+	var record_err error
+
+	if !is_attempt_failure(err) {
+		return err
+	}
+	now = a.guard.now()
+	for _, key := range a.keys {
+		policy = models.DefaultAttemptPolicy(key.Kind())
+		record, record_err = a.guard.counter.RecordFailure(ctx, key, now, now.Add(policy.ResetAfter))
+		if record_err != nil {
+			continue
+		}
+		failure_count = max(failure_count, record.FailureCount())
+		retry_after = max(retry_after, policy.RetryAfter(record, now))
+		// 閾値に達した失敗でのみロックアウトのイベントを記録する
+		if record.FailureCount() == policy.LockoutThreshold {
+			is_locked_out = true
+		}
+	}
+	a.log_event(ctx, models.SecurityEventAuthFailure, failure_count, retry_after)
+	if is_locked_out {
+		a.log_event(ctx, models.SecurityEventAuthLockout, failure_count, retry_after)
+	}
+	return err
+}
+
+// Succeed は認証の成功を記録し、メールアドレス・端末IDの失敗回数をリセットします
+// IPアドレスは複数のユーザーで共有され、攻撃者が自分のアカウントの成功でリセットできるため、有効期限まで保持します
+func (a *AuthAttempt) Succeed(ctx context.Context) {
+	for _, key := range a.keys {
+		if key.Kind() == models.AttemptKeyKindIP {
+			continue
+		}
+		_ = a.guard.counter.Reset(ctx, key)
+	}
+}
+
+// add_key はキーを試行の対象に追加し、待ち時間中の場合はTooManyAttemptsErrorを返します（値が空のキーは無視します）
+func (a *AuthAttempt) add_key(ctx context.Context, kind models.AttemptKeyKind, value string) error {
+	var key models.AttemptKey
+	var now time.Time
+	var record *models.AttemptRecord
+	var retry_after time.Duration
+	var err error
+
+	if value == "" {
+		return nil
+	}
+	key, err = models.NewAttemptKey(kind, value)
+	if err != nil {
+		return nil
+	}
+	a.keys = append(a.keys, key)
+	now = a.guard.now()
+	record, err = a.guard.counter.Get(ctx, key, now)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	retry_after = models.DefaultAttemptPolicy(kind).RetryAfter(record, now)
+	if retry_after <= 0 {
+		return nil
+	}
+	a.log_event(ctx, models.SecurityEventAuthThrottled, record.FailureCount(), retry_after)
+	return domain_errors.NewTooManyAttemptsError(retry_after)
+}
+
+// log_event はこの試行のセキュリティイベントを記録します
+func (a *AuthAttempt) log_event(ctx context.Context, event_type models.SecurityEventType, failure_count int, retry_after time.Duration) {
+	a.guard.event_logger.LogSecurityEvent(ctx, models.NewSecurityEvent(
+		event_type,
+		a.action,
+		a.keys,
+		a.client_info.IPAddress,
+		a.client_info.UserAgent,
+		failure_count,
+		retry_after,
+		a.guard.now(),
+	))
+}
+
+// is_attempt_failure はエラーを認証の失敗として数えるかを返します（クライアント側のユーザードメインのエラーのみ数えます）
+func is_attempt_failure(err error) bool {
+	if !domain_errors.IsUserDomainError(err) {
+		return false
+	}
+	for _, uncounted_error := range attempt_uncounted_errors {
+		if errors.Is(err, uncounted_error) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,248 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// MockAttemptCounter はテスト用のインメモリな失敗回数のカウンターです
+type MockAttemptCounter struct {
+	mutex   sync.Mutex
+	records map[string]*models.AttemptRecord
+}
+
+// NewMockAttemptCounter は新しいMockAttemptCounterを作成します
+func NewMockAttemptCounter() *MockAttemptCounter {
+	return &MockAttemptCounter{records: map[string]*models.AttemptRecord{}}
+}
+
+// Get はキーの記録を返します
+func (m *MockAttemptCounter) Get(ctx context.Context, key models.AttemptKey, now time.Time) (*models.AttemptRecord, error) {
+	var record *models.AttemptRecord
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	record = m.records[key.String()]
+	if record == nil || record.IsExpired(now) {
+		return nil, nil
+	}
+	return record, nil
+}
+
+// RecordFailure は失敗回数を1増やします
+func (m *MockAttemptCounter) RecordFailure(ctx context.Context, key models.AttemptKey, now time.Time, expires_at time.Time) (*models.AttemptRecord, error) {
+	var record *models.AttemptRecord
+	var failure_count int
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	record = m.records[key.String()]
+	failure_count = 1
+	if record != nil && !record.IsExpired(now) {
+		failure_count = record.FailureCount() + 1
+	}
+	m.records[key.String()] = models.NewAttemptRecord(key, failure_count, now, expires_at)
+	return m.records[key.String()], nil
+}
+
+// Reset はキーの記録を削除します
+func (m *MockAttemptCounter) Reset(ctx context.Context, key models.AttemptKey) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.records, key.String())
+	return nil
+}
+
+// failure_count はテスト用にキーの失敗回数を返します
+func (m *MockAttemptCounter) failure_count(kind models.AttemptKeyKind, value string) int {
+	var key models.AttemptKey
+
+	key, _ = models.NewAttemptKey(kind, value)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.records[key.String()] == nil {
+		return 0
+	}
+	return m.records[key.String()].FailureCount()
+}
+
+// MockSecurityEventLogger は記録されたセキュリティイベントを保持するモックです
+type MockSecurityEventLogger struct {
+	events []*models.SecurityEvent
+}
+
+// LogSecurityEvent はセキュリティイベントを保持します
+func (m *MockSecurityEventLogger) LogSecurityEvent(ctx context.Context, event *models.SecurityEvent) {
+	m.events = append(m.events, event)
+}
+
+// count_events はテスト用に指定された種類のイベント数を返します
+func (m *MockSecurityEventLogger) count_events(event_type models.SecurityEventType) int {
+	var count int
+
+	for _, event := range m.events {
+		if event.Type() == event_type {
+			count++
+		}
+	}
+	return count
+}
+
+// new_test_attempt_guard はインメモリのカウンターを使うAuthAttemptGuardを作成します
+func new_test_attempt_guard() *AuthAttemptGuard {
+	return NewAuthAttemptGuard(NewMockAttemptCounter(), &MockSecurityEventLogger{})
+}
+
+// setupAuthAttemptGuardTest は現在時刻を固定したAuthAttemptGuardと端末情報を設定したcontextを作成します
+func setupAuthAttemptGuardTest(now time.Time) (*AuthAttemptGuard, *MockAttemptCounter, *MockSecurityEventLogger, context.Context) {
+	var counter *MockAttemptCounter
+	var event_logger *MockSecurityEventLogger
+	var guard *AuthAttemptGuard
+	var ctx context.Context
+
+	counter = NewMockAttemptCounter()
+	event_logger = &MockSecurityEventLogger{}
+	guard = NewAuthAttemptGuard(counter, event_logger)
+	guard.now = func() time.Time { return now }
+	ctx = utils.WithClientInfo(context.Background(), &utils.ClientInfo{
+		IPAddress: "192.0.2.1",
+		UserAgent: "SleeveApp/1.0",
+		DeviceID:  "device-123",
+	})
+	return guard, counter, event_logger, ctx
+}
+
+// fail_attempt はテスト用にメールアドレスを指定した試行を失敗させます
+func fail_attempt(t *testing.T, ctx context.Context, guard *AuthAttemptGuard, email string, err error) {
+	var attempt *AuthAttempt
+	var begin_err error
+
+	t.Helper()
+	attempt, begin_err = guard.Begin(ctx, models.AuthActionRegister)
+	if begin_err != nil {
+		t.Fatalf("expected attempt to begin, got %v", begin_err)
+	}
+	begin_err = attempt.IdentifyEmail(ctx, email)
+	if begin_err != nil {
+		t.Fatalf("expected email to be accepted, got %v", begin_err)
+	}
+	_ = attempt.Fail(ctx, err)
+}
+
+// TestAuthAttemptGuard_DelaysAfterFreeFailures は無料の失敗回数を超えるとretry-after付きで拒否されることをテストします
+func TestAuthAttemptGuard_DelaysAfterFreeFailures(t *testing.T) {
+	var now time.Time
+	var guard *AuthAttemptGuard
+	var event_logger *MockSecurityEventLogger
+	var ctx context.Context
+	var policy models.AttemptPolicy
+	var attempt *AuthAttempt
+	var too_many_attempts_err *domain_errors.TooManyAttemptsError
+	var err error
+
+	now = time.Now()
+	guard, _, event_logger, ctx = setupAuthAttemptGuardTest(now)
+	policy = models.DefaultAttemptPolicy(models.AttemptKeyKindEmail)
+	for i := 0; i < policy.FreeFailures+1; i++ {
+		fail_attempt(t, ctx, guard, "test@example.com", domain_errors.ErrWeakPassword)
+	}
+
+	attempt, err = guard.Begin(ctx, models.AuthActionRegister)
+	if err != nil {
+		t.Fatalf("expected ip/device to be below the threshold, got %v", err)
+	}
+	err = attempt.IdentifyEmail(ctx, "TEST@example.com")
+	if !errors.Is(err, domain_errors.ErrTooManyAttempts) {
+		t.Fatalf("expected ErrTooManyAttempts, got %v", err)
+	}
+	if !errors.As(err, &too_many_attempts_err) || too_many_attempts_err.RetryAfter != policy.BaseDelay {
+		t.Errorf("expected retry_after %v, got %v", policy.BaseDelay, err)
+	}
+	if event_logger.count_events(models.SecurityEventAuthThrottled) != 1 {
+		t.Errorf("expected 1 throttled event, got %d", event_logger.count_events(models.SecurityEventAuthThrottled))
+	}
+
+	// 待ち時間を過ぎると再び試行できる
+	guard.now = func() time.Time { return now.Add(policy.BaseDelay) }
+	attempt, _ = guard.Begin(ctx, models.AuthActionRegister)
+	err = attempt.IdentifyEmail(ctx, "test@example.com")
+	if err != nil {
+		t.Errorf("expected attempt to be accepted after the delay, got %v", err)
+	}
+}
+
+// TestAuthAttemptGuard_LockoutEvent は閾値に達した失敗でロックアウトのイベントが記録されることをテストします
+func TestAuthAttemptGuard_LockoutEvent(t *testing.T) {
+	var now time.Time
+	var guard *AuthAttemptGuard
+	var counter *MockAttemptCounter
+	var event_logger *MockSecurityEventLogger
+	var ctx context.Context
+	var policy models.AttemptPolicy
+
+	now = time.Now()
+	guard, counter, event_logger, ctx = setupAuthAttemptGuardTest(now)
+	policy = models.DefaultAttemptPolicy(models.AttemptKeyKindEmail)
+	for i := 0; i < policy.LockoutThreshold; i++ {
+		// 待ち時間を過ぎてから次の試行を行う
+		guard.now = func() time.Time { return now.Add(time.Duration(i) * policy.MaxDelay) }
+		fail_attempt(t, ctx, guard, "test@example.com", domain_errors.ErrWeakPassword)
+	}
+	if counter.failure_count(models.AttemptKeyKindEmail, "test@example.com") != policy.LockoutThreshold {
+		t.Errorf("expected %d failures, got %d", policy.LockoutThreshold, counter.failure_count(models.AttemptKeyKindEmail, "test@example.com"))
+	}
+	if event_logger.count_events(models.SecurityEventAuthLockout) != 1 {
+		t.Errorf("expected 1 lockout event, got %d", event_logger.count_events(models.SecurityEventAuthLockout))
+	}
+	if event_logger.count_events(models.SecurityEventAuthFailure) != policy.LockoutThreshold {
+		t.Errorf("expected %d failure events, got %d", policy.LockoutThreshold, event_logger.count_events(models.SecurityEventAuthFailure))
+	}
+}
+
+// TestAuthAttemptGuard_ServerErrorsAreNotCounted はサーバー側のエラーが失敗として数えられないことをテストします
+func TestAuthAttemptGuard_ServerErrorsAreNotCounted(t *testing.T) {
+	var guard *AuthAttemptGuard
+	var counter *MockAttemptCounter
+	var event_logger *MockSecurityEventLogger
+	var ctx context.Context
+
+	guard, counter, event_logger, ctx = setupAuthAttemptGuardTest(time.Now())
+	fail_attempt(t, ctx, guard, "test@example.com", domain_errors.ErrDatabaseError)
+	fail_attempt(t, ctx, guard, "test@example.com", domain_errors.ErrFirebaseAuthFailed)
+	if counter.failure_count(models.AttemptKeyKindEmail, "test@example.com") != 0 {
+		t.Errorf("expected no failures, got %d", counter.failure_count(models.AttemptKeyKindEmail, "test@example.com"))
+	}
+	if len(event_logger.events) != 0 {
+		t.Errorf("expected no events, got %d", len(event_logger.events))
+	}
+}
+
+// TestAuthAttemptGuard_SucceedResetsExceptIP は成功時にメールアドレス・端末IDのみリセットされることをテストします
+func TestAuthAttemptGuard_SucceedResetsExceptIP(t *testing.T) {
+	var guard *AuthAttemptGuard
+	var counter *MockAttemptCounter
+	var ctx context.Context
+	var attempt *AuthAttempt
+
+	guard, counter, _, ctx = setupAuthAttemptGuardTest(time.Now())
+	fail_attempt(t, ctx, guard, "test@example.com", domain_errors.ErrWeakPassword)
+	attempt, _ = guard.Begin(ctx, models.AuthActionRegister)
+	_ = attempt.IdentifyEmail(ctx, "test@example.com")
+	attempt.Succeed(ctx)
+	if counter.failure_count(models.AttemptKeyKindEmail, "test@example.com") != 0 {
+		t.Error("expected email failures to be reset")
+	}
+	if counter.failure_count(models.AttemptKeyKindDevice, "device-123") != 0 {
+		t.Error("expected device failures to be reset")
+	}
+	if counter.failure_count(models.AttemptKeyKindIP, "192.0.2.1") != 1 {
+		t.Errorf("expected ip failures to be kept, got %d", counter.failure_count(models.AttemptKeyKindIP, "192.0.2.1"))
+	}
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UserBlockRepositoryInterface はブロックの保存・解除のインターフェースです
+type UserBlockRepositoryInterface interface {
+	// Create は既にブロックしている場合はfalseを返します
+	Create(ctx context.Context, user_block *models.UserBlock) (bool, error)
+	// Delete はブロックしていない場合はfalseを返します
+	Delete(ctx context.Context, blocker_id uuid.UUID, blocked_id uuid.UUID) (bool, error)
+}
+
+// BlockUserUseCase はログイン中のユーザーが他のユーザーをブロックするユースケースです
+type BlockUserUseCase struct {
+	user_finder         RegisteredUserFinderInterface
+	block_repo          UserBlockRepositoryInterface
+	follow_repo         FollowRepositoryInterface
+	follow_request_repo FollowRequestDeleterInterface
+}
+
+// NewBlockUserUseCase は新しいBlockUserUseCaseを作成します
+func NewBlockUserUseCase(
+	user_finder RegisteredUserFinderInterface,
+	block_repo UserBlockRepositoryInterface,
+	follow_repo FollowRepositoryInterface,
+	follow_request_repo FollowRequestDeleterInterface,
+) *BlockUserUseCase {
+	return &BlockUserUseCase{
+		user_finder:         user_finder,
+		block_repo:          block_repo,
+		follow_repo:         follow_repo,
+		follow_request_repo: follow_request_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーをブロックし、お互いのフォローと承認待ちのフォローリクエストを解除します
+// 既にブロックしている場合もフォロー・フォローリクエストの解除のみ行います（削除済み・利用停止中のユーザーもブロックできます）
+// 自分自身の場合はErrCannotBlockSelf、不正な公開ID・存在しないユーザーの場合はErrUserNotFoundを返します
+func (uc *BlockUserUseCase) Execute(ctx context.Context, user *models.User, blocked_id_str string) error {
+	var blocked_id uuid.UUID
+	var user_block *models.UserBlock
+	var err error
+
+	blocked_id, err = uuid.Parse(blocked_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, blocked_id_str)
+	}
+	if blocked_id == user.PublicID() {
+		return domain_errors.ErrCannotBlockSelf
+	}
+	_, err = uc.user_finder.FindByPublicID(ctx, blocked_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	user_block, err = models.NewUserBlock(user.PublicID(), blocked_id, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrCannotBlockSelf, err)
+	}
+	_, err = uc.block_repo.Create(ctx, user_block)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	// ブロック後はお互いにフォローできないため、既存のフォローを両方向とも解除する
+	_, err = uc.follow_repo.Delete(ctx, user.PublicID(), blocked_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	_, err = uc.follow_repo.Delete(ctx, blocked_id, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	// 承認待ちのフォローリクエストも両方向とも削除し、ブロック後に承認されないようにする
+	_, err = uc.follow_request_repo.Delete(ctx, user.PublicID(), blocked_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	_, err = uc.follow_request_repo.Delete(ctx, blocked_id, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockUserBlockRepository はテスト用のインメモリなブロックのリポジトリです
+type MockUserBlockRepository struct {
+	blocks []*models.UserBlock
+}
+
+// NewMockUserBlockRepository は新しいMockUserBlockRepositoryを作成します
+func NewMockUserBlockRepository() *MockUserBlockRepository {
+	return &MockUserBlockRepository{blocks: []*models.UserBlock{}}
+}
+
+// add はblocker_idがblocked_idをブロックしていることを追加します
+func (m *MockUserBlockRepository) add(blocker_id uuid.UUID, blocked_id uuid.UUID) {
+	var user_block *models.UserBlock
+
+	user_block, _ = models.NewUserBlock(blocker_id, blocked_id, time.Now())
+	_, _ = m.Create(context.Background(), user_block)
+}
+
+// Create はブロックを保存します（ブロック済みの場合はfalseを返します）
+func (m *MockUserBlockRepository) Create(_ context.Context, user_block *models.UserBlock) (bool, error) {
+	for _, block := range m.blocks {
+		if block.BlockerID() == user_block.BlockerID() && block.BlockedID() == user_block.BlockedID() {
+			return false, nil
+		}
+	}
+	m.blocks = append(m.blocks, user_block)
+	return true, nil
+}
+
+// Delete はブロックを削除します（ブロックしていない場合はfalseを返します）
+func (m *MockUserBlockRepository) Delete(_ context.Context, blocker_id uuid.UUID, blocked_id uuid.UUID) (bool, error) {
+	for i, block := range m.blocks {
+		if block.BlockerID() == blocker_id && block.BlockedID() == blocked_id {
+			m.blocks = append(m.blocks[:i], m.blocks[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExistsBetween はどちらかの方向にブロックがあるかを返します
+func (m *MockUserBlockRepository) ExistsBetween(_ context.Context, user_id uuid.UUID, other_id uuid.UUID) (bool, error) {
+	for _, block := range m.blocks {
+		if (block.BlockerID() == user_id && block.BlockedID() == other_id) ||
+			(block.BlockerID() == other_id && block.BlockedID() == user_id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListBlockedIDs はblocker_idがブロックしたユーザーの公開IDを返します
+func (m *MockUserBlockRepository) ListBlockedIDs(_ context.Context, blocker_id uuid.UUID) ([]uuid.UUID, error) {
+	var blocked_ids []uuid.UUID
+
+	blocked_ids = []uuid.UUID{}
+	for _, block := range m.blocks {
+		if block.BlockerID() == blocker_id {
+			blocked_ids = append(blocked_ids, block.BlockedID())
+		}
+	}
+	return blocked_ids, nil
+}
+
+// TestBlockUserUseCase_Execute はブロックの冪等性とお互いのフォロー・フォローリクエストの解除をテストします
+func TestBlockUserUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var target *models.User
+	var user_store *MockRegisteredUserStore
+	var block_repo *MockUserBlockRepository
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var follow *models.Follow
+	var request *models.FollowRequest
+	var use_case *BlockUserUseCase
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	target = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[target.PublicID()] = target
+	block_repo = NewMockUserBlockRepository()
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(user.PublicID(), target.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	follow, _ = models.NewFollow(target.PublicID(), user.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	request, _ = models.NewFollowRequest(user.PublicID(), target.PublicID(), time.Now())
+	_, _ = follow_request_repo.Create(ctx, request)
+	request, _ = models.NewFollowRequest(target.PublicID(), user.PublicID(), time.Now())
+	_, _ = follow_request_repo.Create(ctx, request)
+	use_case = NewBlockUserUseCase(user_store, block_repo, follow_repo, follow_request_repo)
+
+	for range 2 {
+		err = use_case.Execute(ctx, user, target.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(block_repo.blocks) != 1 {
+		t.Errorf("expected 1 block, got %d", len(block_repo.blocks))
+	}
+	exists, _ = block_repo.ExistsBetween(ctx, target.PublicID(), user.PublicID())
+	if !exists {
+		t.Errorf("expected block to be found from the blocked user side")
+	}
+	if len(follow_repo.follows) != 0 {
+		t.Errorf("expected follows in both directions to be removed, got %d", len(follow_repo.follows))
+	}
+	if len(follow_request_repo.requests) != 0 {
+		t.Errorf("expected follow requests in both directions to be removed, got %d", len(follow_request_repo.requests))
+	}
+
+	err = use_case.Execute(ctx, user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotBlockSelf) {
+		t.Errorf("expected ErrCannotBlockSelf, got %v", err)
+	}
+	for _, target_id := range []string{"invalid", uuid.New().String()} {
+		err = use_case.Execute(ctx, user, target_id)
+		if !errors.Is(err, domain_errors.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound for %s, got %v", target_id, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// AccountDeletionRestorerInterface は論理削除したユーザーを取り消しコードで検索し、削除を取り消すインターフェースです
+type AccountDeletionRestorerInterface interface {
+	FindByDeletionTokenHash(ctx context.Context, deletion_token_hash string) (*models.User, error)
+	RestoreDeleted(ctx context.Context, public_id uuid.UUID) error
+}
+
+// CancelAccountDeletionUseCase はメールで送信した取り消しコードでアカウント削除を取り消すユースケースです
+// 退会時に全セッションを失効させているため、取り消し後は改めてログインが必要です
+type CancelAccountDeletionUseCase struct {
+	user_repo        AccountDeletionRestorerInterface
+	firebase_enabler FirebaseUserEnablerInterface
+}
+
+// NewCancelAccountDeletionUseCase は新しいCancelAccountDeletionUseCaseを作成します
+func NewCancelAccountDeletionUseCase(
+	user_repo AccountDeletionRestorerInterface,
+	firebase_enabler FirebaseUserEnablerInterface,
+) *CancelAccountDeletionUseCase {
+	return &CancelAccountDeletionUseCase{
+		user_repo:        user_repo,
+		firebase_enabler: firebase_enabler,
+	}
+}
+
+// Execute はアカウント削除を取り消します
+// コードが一致しない・取り消し期限を過ぎている場合はErrInvalidAccountDeletionTokenを返します
+func (uc *CancelAccountDeletionUseCase) Execute(ctx context.Context, cancellation_token string) error {
+	var user *models.User
+	var err error
+
+	if strings.TrimSpace(cancellation_token) == "" {
+		return domain_errors.ErrInvalidAccountDeletionToken
+	}
+	user, err = uc.user_repo.FindByDeletionTokenHash(ctx, models.HashAccountDeletionToken(cancellation_token))
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return domain_errors.ErrInvalidAccountDeletionToken
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if user.DeletedAt() == nil || time.Now().After(models.AccountPurgeAt(*user.DeletedAt())) {
+		return domain_errors.ErrInvalidAccountDeletionToken
+	}
+
+	// Firebase側を先に有効化し、失敗した場合は論理削除を残して同じコードで再試行できるようにする
+	err = uc.firebase_enabler.EnableUser(ctx, user.FirebaseUID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.user_repo.RestoreDeleted(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
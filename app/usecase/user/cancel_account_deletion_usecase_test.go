@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestCancelAccountDeletionUseCase_Execute は取り消しコードで論理削除とFirebaseユーザーの無効化が取り消されることをテストします
+func TestCancelAccountDeletionUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var firebase_disabler *MockFirebaseUserDisabler
+	var use_case *CancelAccountDeletionUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	firebase_disabler = NewMockFirebaseUserDisabler()
+	firebase_disabler.disabled[testFirebaseUID] = true
+	_ = user_repo.MarkDeleted(ctx, user.PublicID(), time.Now(), models.HashAccountDeletionToken("valid-token"))
+	use_case = NewCancelAccountDeletionUseCase(user_repo, firebase_disabler)
+
+	err = use_case.Execute(ctx, "invalid-token")
+	if !errors.Is(err, domain_errors.ErrInvalidAccountDeletionToken) {
+		t.Errorf("expected ErrInvalidAccountDeletionToken, got %v", err)
+	}
+	err = use_case.Execute(ctx, "valid-token")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_repo.users[user.PublicID()].IsDeleted() || firebase_disabler.disabled[testFirebaseUID] {
+		t.Error("expected user and Firebase user to be restored")
+	}
+	// 取り消しコードは1回のみ使用できる
+	err = use_case.Execute(ctx, "valid-token")
+	if !errors.Is(err, domain_errors.ErrInvalidAccountDeletionToken) {
+		t.Errorf("expected ErrInvalidAccountDeletionToken for reused token, got %v", err)
+	}
+}
+
+// TestCancelAccountDeletionUseCase_Execute_Expired は取り消し期限を過ぎた場合に取り消せないことをテストします
+func TestCancelAccountDeletionUseCase_Execute_Expired(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var use_case *CancelAccountDeletionUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	_ = user_repo.MarkDeleted(ctx, user.PublicID(), time.Now().Add(-models.AccountDeletionGracePeriod-time.Minute), models.HashAccountDeletionToken("valid-token"))
+	use_case = NewCancelAccountDeletionUseCase(user_repo, NewMockFirebaseUserDisabler())
+
+	err = use_case.Execute(ctx, "valid-token")
+	if !errors.Is(err, domain_errors.ErrInvalidAccountDeletionToken) {
+		t.Errorf("expected ErrInvalidAccountDeletionToken, got %v", err)
+	}
+	if !user_repo.users[user.PublicID()].IsDeleted() {
+		t.Error("expected user to remain deleted")
+	}
+}
@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UserHandleFinderInterface はハンドルを検索するインターフェースです
+type UserHandleFinderInterface interface {
+	FindCurrentByUserID(ctx context.Context, user_id uuid.UUID) (*models.UserHandle, error)
+	FindByHandle(ctx context.Context, handle models.Handle) (*models.UserHandle, error)
+}
+
+// UserHandleRepositoryInterface はハンドルの検索・変更のインターフェースです
+type UserHandleRepositoryInterface interface {
+	UserHandleFinderInterface
+	Change(ctx context.Context, current *models.UserHandle, next *models.UserHandle) error
+	Rename(ctx context.Context, user_handle *models.UserHandle) error
+	Release(ctx context.Context, user_handle *models.UserHandle) error
+}
+
+// ChangeHandleUseCase はログイン中のユーザーのハンドルを設定・変更するユースケースです
+// 変更前のハンドルはリダイレクト期間中は残し、期間中は他のユーザーが使用できません
+type ChangeHandleUseCase struct {
+	handle_repo UserHandleRepositoryInterface
+}
+
+// NewChangeHandleUseCase は新しいChangeHandleUseCaseを作成します
+func NewChangeHandleUseCase(handle_repo UserHandleRepositoryInterface) *ChangeHandleUseCase {
+	return &ChangeHandleUseCase{
+		handle_repo: handle_repo,
+	}
+}
+
+// Execute はハンドルを変更し、変更後のハンドルを返します
+// 大文字・小文字のみの変更は変更間隔の制限を受けません
+// 形式が不正な場合はErrInvalidHandle、予約済み・使用中の場合はErrHandleUnavailable、前回の変更から30日以内の場合はErrHandleChangeCooldownを返します
+func (uc *ChangeHandleUseCase) Execute(ctx context.Context, user *models.User, handle_str string) (*models.UserHandle, error) {
+	var handle models.Handle
+	var now time.Time
+	var current *models.UserHandle
+	var existing *models.UserHandle
+	var next *models.UserHandle
+	var err error
+
+	handle, err = models.NewHandle(handle_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidHandle, err)
+	}
+	if handle.IsReserved() {
+		return nil, fmt.Errorf("%w: %s is reserved", domain_errors.ErrHandleUnavailable, handle.Value())
+	}
+	now = time.Now()
+	current, err = uc.handle_repo.FindCurrentByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if current != nil && current.Handle().Equals(handle) {
+		return uc.rename(ctx, current, handle)
+	}
+	if current != nil && !current.CanChange(now) {
+		return nil, fmt.Errorf("%w: next change available at %s", domain_errors.ErrHandleChangeCooldown, current.NextChangeAvailableAt().Format(time.RFC3339))
+	}
+	existing, err = uc.handle_repo.FindByHandle(ctx, handle)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if existing != nil {
+		if !existing.IsClaimableBy(user.PublicID(), now) {
+			return nil, fmt.Errorf("%w: %s is taken", domain_errors.ErrHandleUnavailable, handle.Value())
+		}
+		// 自分の変更前のハンドル・リダイレクト期間が過ぎたハンドルは記録を削除して割り当て直します
+		err = uc.handle_repo.Release(ctx, existing)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+	next, err = models.NewUserHandle(user.PublicID(), handle, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidHandle, err)
+	}
+	if current != nil {
+		current.Retire(now)
+	}
+	err = uc.handle_repo.Change(ctx, current, next)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return next, nil
+}
+
+// rename は現在のハンドルの大文字・小文字のみを変更します
+func (uc *ChangeHandleUseCase) rename(ctx context.Context, current *models.UserHandle, handle models.Handle) (*models.UserHandle, error) {
+	var err error
+
+	if current.Handle().Value() == handle.Value() {
+		return current, nil
+	}
+	err = current.Rename(handle)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidHandle, err)
+	}
+	err = uc.handle_repo.Rename(ctx, current)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return current, nil
+}
@@ -0,0 +1,163 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockUserHandleRepository はテスト用のインメモリなハンドルのリポジトリです
+type MockUserHandleRepository struct {
+	handles []*models.UserHandle
+}
+
+// NewMockUserHandleRepository は新しいMockUserHandleRepositoryを作成します
+func NewMockUserHandleRepository() *MockUserHandleRepository {
+	return &MockUserHandleRepository{handles: []*models.UserHandle{}}
+}
+
+// Add はハンドルの記録を追加します
+func (m *MockUserHandleRepository) Add(user_id uuid.UUID, value string, created_at time.Time, retired_at *time.Time) {
+	var handle models.Handle
+	var user_handle *models.UserHandle
+
+	handle, _ = models.NewHandle(value)
+	user_handle, _ = models.NewUserHandleWithStatus(user_id, handle, created_at, retired_at)
+	m.handles = append(m.handles, user_handle)
+}
+
+// FindCurrentByUserID はユーザーの現在のハンドルを返します
+func (m *MockUserHandleRepository) FindCurrentByUserID(_ context.Context, user_id uuid.UUID) (*models.UserHandle, error) {
+	for _, user_handle := range m.handles {
+		if user_handle.UserID() == user_id && user_handle.IsCurrent() {
+			return user_handle, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByHandle は大文字・小文字を区別せずにハンドルの記録を返します
+func (m *MockUserHandleRepository) FindByHandle(_ context.Context, handle models.Handle) (*models.UserHandle, error) {
+	for _, user_handle := range m.handles {
+		if user_handle.Handle().Equals(handle) {
+			return user_handle, nil
+		}
+	}
+	return nil, nil
+}
+
+// Change は新しいハンドルを保存します（handle_keyのユニーク制約を再現します）
+func (m *MockUserHandleRepository) Change(_ context.Context, _ *models.UserHandle, next *models.UserHandle) error {
+	for _, user_handle := range m.handles {
+		if user_handle.Handle().Equals(next.Handle()) {
+			return domain_errors.ErrHandleUnavailable
+		}
+	}
+	m.handles = append(m.handles, next)
+	return nil
+}
+
+// Rename は何もしません（ハンドルはポインタで保持しているため変更済みです）
+func (m *MockUserHandleRepository) Rename(_ context.Context, _ *models.UserHandle) error {
+	return nil
+}
+
+// Release はハンドルの記録を削除します
+func (m *MockUserHandleRepository) Release(_ context.Context, released *models.UserHandle) error {
+	var remaining []*models.UserHandle
+
+	for _, user_handle := range m.handles {
+		if user_handle != released {
+			remaining = append(remaining, user_handle)
+		}
+	}
+	m.handles = remaining
+	return nil
+}
+
+// TestChangeHandleUseCase_Execute はハンドルの設定・変更と変更前のハンドルの保持をテストします
+func TestChangeHandleUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var handle_repo *MockUserHandleRepository
+	var use_case *ChangeHandleUseCase
+	var user_handle *models.UserHandle
+	var taro_handle models.Handle
+	var retired_handle *models.UserHandle
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	handle_repo = NewMockUserHandleRepository()
+	handle_repo.Add(user.PublicID(), "taro", time.Now().Add(-models.HandleChangeCooldown), nil)
+	use_case = NewChangeHandleUseCase(handle_repo)
+
+	user_handle, err = use_case.Execute(ctx, user, "@Jiro_Sleeve")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user_handle.Handle().Value() != "Jiro_Sleeve" {
+		t.Errorf("expected handle Jiro_Sleeve, got %s", user_handle.Handle().Value())
+	}
+	taro_handle, _ = models.NewHandle("taro")
+	retired_handle, _ = handle_repo.FindByHandle(ctx, taro_handle)
+	if retired_handle == nil || retired_handle.IsCurrent() {
+		t.Error("expected previous handle to be retired and kept for redirect")
+	}
+
+	// 大文字・小文字のみの変更は変更間隔の制限を受けない
+	user_handle, err = use_case.Execute(ctx, user, "jiro_sleeve")
+	if err != nil {
+		t.Fatalf("expected no error for case-only change, got %v", err)
+	}
+	if user_handle.Handle().Value() != "jiro_sleeve" {
+		t.Errorf("expected handle jiro_sleeve, got %s", user_handle.Handle().Value())
+	}
+
+	_, err = use_case.Execute(ctx, user, "saburo")
+	if !errors.Is(err, domain_errors.ErrHandleChangeCooldown) {
+		t.Errorf("expected ErrHandleChangeCooldown, got %v", err)
+	}
+}
+
+// TestChangeHandleUseCase_Unavailable は使用できないハンドルへの変更をテストします
+func TestChangeHandleUseCase_Unavailable(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var handle_repo *MockUserHandleRepository
+	var use_case *ChangeHandleUseCase
+	var recently_retired_at time.Time
+	var long_ago_retired_at time.Time
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	handle_repo = NewMockUserHandleRepository()
+	recently_retired_at = time.Now().Add(-time.Hour)
+	long_ago_retired_at = time.Now().Add(-models.HandleRedirectPeriod - time.Hour)
+	handle_repo.Add(uuid.New(), "taken", time.Now(), nil)
+	handle_repo.Add(uuid.New(), "redirecting", time.Now(), &recently_retired_at)
+	handle_repo.Add(uuid.New(), "expired", time.Now(), &long_ago_retired_at)
+	use_case = NewChangeHandleUseCase(handle_repo)
+
+	_, err = use_case.Execute(ctx, user, "a.b")
+	if !errors.Is(err, domain_errors.ErrInvalidHandle) {
+		t.Errorf("expected ErrInvalidHandle, got %v", err)
+	}
+	for _, handle := range []string{"ADMIN", "Taken", "redirecting"} {
+		_, err = use_case.Execute(ctx, user, handle)
+		if !errors.Is(err, domain_errors.ErrHandleUnavailable) {
+			t.Errorf("expected ErrHandleUnavailable for %s, got %v", handle, err)
+		}
+	}
+	_, err = use_case.Execute(ctx, user, "Expired")
+	if err != nil {
+		t.Errorf("expected handle with expired redirect to be claimable, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// HandleUnavailableReason はハンドルを使用できない理由です
+type HandleUnavailableReason string
+
+// ハンドルを使用できない理由の一覧です
+const (
+	HandleUnavailableReasonInvalid  HandleUnavailableReason = "invalid"
+	HandleUnavailableReasonReserved HandleUnavailableReason = "reserved"
+	HandleUnavailableReasonTaken    HandleUnavailableReason = "taken"
+)
+
+// HandleAvailability はハンドルの使用可否の確認結果です
+type HandleAvailability struct {
+	// Handle は正規化後のハンドルです（形式が不正な場合は入力値）
+	Handle    string
+	Available bool
+	// Reason は使用できない理由です（使用できる場合は空文字）
+	Reason HandleUnavailableReason
+}
+
+// CheckHandleAvailabilityUseCase はログイン中のユーザーがハンドルを使用できるかを確認するユースケースです
+type CheckHandleAvailabilityUseCase struct {
+	handle_finder UserHandleFinderInterface
+}
+
+// NewCheckHandleAvailabilityUseCase は新しいCheckHandleAvailabilityUseCaseを作成します
+func NewCheckHandleAvailabilityUseCase(handle_finder UserHandleFinderInterface) *CheckHandleAvailabilityUseCase {
+	return &CheckHandleAvailabilityUseCase{
+		handle_finder: handle_finder,
+	}
+}
+
+// Execute はハンドルの使用可否を返します
+// 自分の現在・変更前のハンドルは使用できると判定します（変更間隔の制限はchangeHandleで判定します）
+func (uc *CheckHandleAvailabilityUseCase) Execute(ctx context.Context, user *models.User, handle_str string) (*HandleAvailability, error) {
+	var handle models.Handle
+	var existing *models.UserHandle
+	var err error
+
+	handle, err = models.NewHandle(handle_str)
+	if err != nil {
+		return &HandleAvailability{Handle: handle_str, Available: false, Reason: HandleUnavailableReasonInvalid}, nil
+	}
+	if handle.IsReserved() {
+		return &HandleAvailability{Handle: handle.Value(), Available: false, Reason: HandleUnavailableReasonReserved}, nil
+	}
+	existing, err = uc.handle_finder.FindByHandle(ctx, handle)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if existing != nil && !existing.IsClaimableBy(user.PublicID(), time.Now()) {
+		return &HandleAvailability{Handle: handle.Value(), Available: false, Reason: HandleUnavailableReasonTaken}, nil
+	}
+	return &HandleAvailability{Handle: handle.Value(), Available: true}, nil
+}
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestCheckHandleAvailabilityUseCase_Execute はハンドルの使用可否と使用できない理由をテストします
+func TestCheckHandleAvailabilityUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var handle_repo *MockUserHandleRepository
+	var use_case *CheckHandleAvailabilityUseCase
+	var retired_at time.Time
+	var test_cases []struct {
+		handle    string
+		available bool
+		reason    HandleUnavailableReason
+	}
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	handle_repo = NewMockUserHandleRepository()
+	retired_at = time.Now()
+	handle_repo.Add(uuid.New(), "taken", time.Now(), nil)
+	handle_repo.Add(user.PublicID(), "mine", time.Now(), &retired_at)
+	use_case = NewCheckHandleAvailabilityUseCase(handle_repo)
+	test_cases = []struct {
+		handle    string
+		available bool
+		reason    HandleUnavailableReason
+	}{
+		{handle: "free_handle", available: true, reason: ""},
+		{handle: "MINE", available: true, reason: ""},
+		{handle: "ab", available: false, reason: HandleUnavailableReasonInvalid},
+		{handle: "Support", available: false, reason: HandleUnavailableReasonReserved},
+		{handle: "TAKEN", available: false, reason: HandleUnavailableReasonTaken},
+	}
+
+	for _, test_case := range test_cases {
+		var availability *HandleAvailability
+		var err error
+
+		availability, err = use_case.Execute(ctx, user, test_case.handle)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %v", test_case.handle, err)
+		}
+		if availability.Available != test_case.available || availability.Reason != test_case.reason {
+			t.Errorf("expected %v/%q for %s, got %v/%q", test_case.available, test_case.reason, test_case.handle, availability.Available, availability.Reason)
+		}
+	}
+}
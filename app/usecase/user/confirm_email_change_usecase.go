@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeRequestFinderInterface は確認待ちのメールアドレスの変更を確認リンクのトークンで検索・削除するインターフェースです
+type EmailChangeRequestFinderInterface interface {
+	// FindByTokenHash は一致する変更がない場合はErrInvalidEmailChangeTokenを返します
+	FindByTokenHash(ctx context.Context, token_hash string) (*models.EmailChangeRequest, error)
+	DeleteByUserID(ctx context.Context, user_id uuid.UUID) error
+}
+
+// UserEmailUpdaterInterface はusersテーブルのメールアドレスを更新するインターフェースです
+type UserEmailUpdaterInterface interface {
+	FindByPublicID(ctx context.Context, public_id uuid.UUID) (*models.User, error)
+	// UpdateEmail は他のユーザーが登録済みのメールアドレス（一意制約違反）の場合はErrDuplicateEmailを返します
+	UpdateEmail(ctx context.Context, public_id uuid.UUID, email models.Email, email_verified_at *time.Time) error
+}
+
+// FirebaseEmailUpdaterInterface はFirebaseユーザーのメールアドレスを更新するインターフェースです
+type FirebaseEmailUpdaterInterface interface {
+	UpdateEmail(ctx context.Context, firebase_uid string, email models.Email, email_verified bool) error
+}
+
+// FirebaseEmailCompensatorInterface はFirebaseのメールアドレスを元に戻し、失敗した場合は補償処理として記録するインターフェースです
+type FirebaseEmailCompensatorInterface interface {
+	SyncFirebaseEmail(ctx context.Context, firebase_uid string, email models.Email, email_verified bool) error
+}
+
+// ConfirmEmailChangeUseCase は変更後のメールアドレスに送信した確認リンクでメールアドレスの変更を確定するユースケースです
+// Firebaseとusersテーブルのメールアドレスを、失敗時に元に戻す補償処理付きで更新します
+type ConfirmEmailChangeUseCase struct {
+	request_repo      EmailChangeRequestFinderInterface
+	user_repo         UserEmailUpdaterInterface
+	firebase_updater  FirebaseEmailUpdaterInterface
+	email_compensator FirebaseEmailCompensatorInterface
+}
+
+// NewConfirmEmailChangeUseCase は新しいConfirmEmailChangeUseCaseを作成します
+func NewConfirmEmailChangeUseCase(
+	request_repo EmailChangeRequestFinderInterface,
+	user_repo UserEmailUpdaterInterface,
+	firebase_updater FirebaseEmailUpdaterInterface,
+	email_compensator FirebaseEmailCompensatorInterface,
+) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		request_repo:      request_repo,
+		user_repo:         user_repo,
+		firebase_updater:  firebase_updater,
+		email_compensator: email_compensator,
+	}
+}
+
+// Execute はメールアドレスの変更を確定します
+// 確認リンクを開いたことで変更後のメールアドレスの所有を確認できたため、確認済みとして更新します
+// トークンが一致しない・有効期限を過ぎている場合はErrInvalidEmailChangeToken、
+// 確認待ちの間に他のユーザーが同じメールアドレスを登録した場合はErrDuplicateEmailを返します
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, token string) error {
+	var request *models.EmailChangeRequest
+	var user *models.User
+	var now time.Time
+	var err error
+
+	if strings.TrimSpace(token) == "" {
+		return domain_errors.ErrInvalidEmailChangeToken
+	}
+	request, err = uc.request_repo.FindByTokenHash(ctx, models.HashEmailChangeToken(token))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	now = time.Now()
+	if request.IsExpired(now) {
+		return domain_errors.ErrInvalidEmailChangeToken
+	}
+	user, err = uc.user_repo.FindByPublicID(ctx, request.UserID())
+	// 申請後に退会したユーザーの確認リンクは無効として扱う
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return domain_errors.ErrInvalidEmailChangeToken
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() {
+		return domain_errors.ErrInvalidEmailChangeToken
+	}
+
+	// Firebase側を先に更新し、usersテーブルの更新に失敗した場合は変更前のメールアドレスに戻す
+	// 戻すのに失敗した場合は補償処理として記録し、記録にも失敗した場合は両方のエラーを返す
+	err = uc.firebase_updater.UpdateEmail(ctx, user.FirebaseUID(), request.NewEmail(), true)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.user_repo.UpdateEmail(ctx, user.PublicID(), request.NewEmail(), &now)
+	if err != nil {
+		return fmt.Errorf("%w", errors.Join(err, uc.email_compensator.SyncFirebaseEmail(ctx, user.FirebaseUID(), user.Email(), user.IsEmailVerified())))
+	}
+	err = uc.request_repo.DeleteByUserID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockEmailChangeUserRepository はテスト用のメールアドレスを更新できるユーザーのリポジトリです
+type MockEmailChangeUserRepository struct {
+	users                  map[uuid.UUID]*models.User
+	should_return_conflict bool
+}
+
+// NewMockEmailChangeUserRepository は指定したユーザーを保持するMockEmailChangeUserRepositoryを作成します
+func NewMockEmailChangeUserRepository(users ...*models.User) *MockEmailChangeUserRepository {
+	var repo *MockEmailChangeUserRepository
+
+	repo = &MockEmailChangeUserRepository{users: map[uuid.UUID]*models.User{}}
+	for _, user := range users {
+		repo.users[user.PublicID()] = user
+	}
+	return repo
+}
+
+// ExistsByEmail はメールアドレスが登録済みかを返します
+func (m *MockEmailChangeUserRepository) ExistsByEmail(_ context.Context, email models.Email) (bool, error) {
+	for _, user := range m.users {
+		if user.Email().Value() == email.Value() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FindByPublicID は公開IDでユーザーを検索します
+func (m *MockEmailChangeUserRepository) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	var user *models.User
+	var exists bool
+
+	user, exists = m.users[public_id]
+	if !exists {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// UpdateEmail はユーザーのメールアドレスを更新します（競合を再現する場合はErrDuplicateEmailを返します）
+func (m *MockEmailChangeUserRepository) UpdateEmail(_ context.Context, public_id uuid.UUID, email models.Email, email_verified_at *time.Time) error {
+	var user *models.User
+
+	if m.should_return_conflict {
+		return domain_errors.ErrDuplicateEmail
+	}
+	user = m.users[public_id]
+	m.users[public_id], _ = models.NewUserWithPublicID(
+		user.PublicID(), user.FirebaseUID(), email, user.Role(), email_verified_at,
+		user.MfaEnabledAt(), user.CreatedAt(), user.UpdatedAt(), user.DeletedAt(), user.BannedAt(),
+	)
+	return nil
+}
+
+// MockFirebaseEmailUpdater はFirebaseユーザーのメールアドレスを記録するモックです
+type MockFirebaseEmailUpdater struct {
+	emails                 map[string]string
+	should_return_conflict bool
+}
+
+// NewMockFirebaseEmailUpdater は新しいMockFirebaseEmailUpdaterを作成します
+func NewMockFirebaseEmailUpdater() *MockFirebaseEmailUpdater {
+	return &MockFirebaseEmailUpdater{emails: map[string]string{}}
+}
+
+// UpdateEmail はFirebaseユーザーのメールアドレスを記録します
+func (m *MockFirebaseEmailUpdater) UpdateEmail(_ context.Context, firebase_uid string, email models.Email, _ bool) error {
+	if m.should_return_conflict {
+		return domain_errors.ErrDuplicateEmail
+	}
+	m.emails[firebase_uid] = email.Value()
+	return nil
+}
+
+// create_test_email_compensator はテスト用にFirebaseのメールアドレスを戻す補償処理を作成します
+func create_test_email_compensator(firebase_repo *MockFirebaseUserRepository) *FirebaseUserCompensator {
+	return NewFirebaseUserCompensator(firebase_repo, NewMockCompensationTaskRepository())
+}
+
+// setup_email_change は確認待ちの変更を保存し、確認リンクのトークンを返します
+func setup_email_change(t *testing.T, request_repo *MockEmailChangeRequestRepository, user *models.User, requested_at time.Time) string {
+	var new_email models.Email
+	var request *models.EmailChangeRequest
+
+	t.Helper()
+	new_email, _ = models.NewEmail("new@example.com")
+	request, _ = models.NewEmailChangeRequest(user.PublicID(), new_email, requested_at)
+	_ = request_repo.Replace(context.Background(), request, models.HashEmailChangeToken("valid-token"))
+	return "valid-token"
+}
+
+// TestConfirmEmailChangeUseCase_Execute は確認リンクでFirebaseとusersテーブルのメールアドレスが確認済みとして更新されることをテストします
+func TestConfirmEmailChangeUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockEmailChangeUserRepository
+	var request_repo *MockEmailChangeRequestRepository
+	var firebase_updater *MockFirebaseEmailUpdater
+	var use_case *ConfirmEmailChangeUseCase
+	var token string
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockEmailChangeUserRepository(user)
+	request_repo = NewMockEmailChangeRequestRepository()
+	firebase_updater = NewMockFirebaseEmailUpdater()
+	token = setup_email_change(t, request_repo, user, time.Now())
+	use_case = NewConfirmEmailChangeUseCase(request_repo, user_repo, firebase_updater, create_test_email_compensator(NewMockFirebaseUserRepository()))
+
+	err = use_case.Execute(ctx, "invalid-token")
+	if !errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+		t.Errorf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+	err = use_case.Execute(ctx, token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if firebase_updater.emails[testFirebaseUID] != "new@example.com" {
+		t.Errorf("expected Firebase email to be new@example.com, got %s", firebase_updater.emails[testFirebaseUID])
+	}
+	if user_repo.users[user.PublicID()].Email().Value() != "new@example.com" || !user_repo.users[user.PublicID()].IsEmailVerified() {
+		t.Error("expected users row to have verified new@example.com")
+	}
+	// 確認リンクは1回のみ使用できる
+	err = use_case.Execute(ctx, token)
+	if !errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+		t.Errorf("expected ErrInvalidEmailChangeToken for reused token, got %v", err)
+	}
+}
+
+// TestConfirmEmailChangeUseCase_Execute_Expired は有効期限を過ぎた確認リンクで変更できないことをテストします
+func TestConfirmEmailChangeUseCase_Execute_Expired(t *testing.T) {
+	var user *models.User
+	var request_repo *MockEmailChangeRequestRepository
+	var firebase_updater *MockFirebaseEmailUpdater
+	var use_case *ConfirmEmailChangeUseCase
+	var token string
+	var err error
+
+	user = create_test_account_user(t)
+	request_repo = NewMockEmailChangeRequestRepository()
+	firebase_updater = NewMockFirebaseEmailUpdater()
+	token = setup_email_change(t, request_repo, user, time.Now().Add(-models.EmailChangeConfirmationPeriod-time.Minute))
+	use_case = NewConfirmEmailChangeUseCase(
+		request_repo, NewMockEmailChangeUserRepository(user), firebase_updater, create_test_email_compensator(NewMockFirebaseUserRepository()),
+	)
+
+	err = use_case.Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+		t.Errorf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+	if len(firebase_updater.emails) != 0 {
+		t.Errorf("expected Firebase not to be updated, got %v", firebase_updater.emails)
+	}
+}
+
+// TestConfirmEmailChangeUseCase_Execute_DeletedUser は申請後に退会（論理削除）したユーザーの確認リンクが無効になることをテストします
+func TestConfirmEmailChangeUseCase_Execute_DeletedUser(t *testing.T) {
+	var user *models.User
+	var request_repo *MockEmailChangeRequestRepository
+	var firebase_updater *MockFirebaseEmailUpdater
+	var use_case *ConfirmEmailChangeUseCase
+	var token string
+	var err error
+
+	user = create_test_account_user(t)
+	request_repo = NewMockEmailChangeRequestRepository()
+	firebase_updater = NewMockFirebaseEmailUpdater()
+	token = setup_email_change(t, request_repo, user, time.Now())
+	// 論理削除されたユーザーは検索されないため、ユーザーを保持しないリポジトリを使用する
+	use_case = NewConfirmEmailChangeUseCase(
+		request_repo, NewMockEmailChangeUserRepository(), firebase_updater, create_test_email_compensator(NewMockFirebaseUserRepository()),
+	)
+
+	err = use_case.Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrInvalidEmailChangeToken) {
+		t.Errorf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+	if len(firebase_updater.emails) != 0 {
+		t.Errorf("expected Firebase not to be updated, got %v", firebase_updater.emails)
+	}
+}
+
+// TestConfirmEmailChangeUseCase_Execute_Conflict はusersテーブルの一意制約違反でFirebaseのメールアドレスが元に戻ることをテストします
+func TestConfirmEmailChangeUseCase_Execute_Conflict(t *testing.T) {
+	var user *models.User
+	var user_repo *MockEmailChangeUserRepository
+	var request_repo *MockEmailChangeRequestRepository
+	var mock_firebase *MockFirebaseUserRepository
+	var use_case *ConfirmEmailChangeUseCase
+	var token string
+	var err error
+
+	user = create_test_account_user(t)
+	user_repo = NewMockEmailChangeUserRepository(user)
+	user_repo.should_return_conflict = true
+	request_repo = NewMockEmailChangeRequestRepository()
+	mock_firebase = NewMockFirebaseUserRepository()
+	token = setup_email_change(t, request_repo, user, time.Now())
+	use_case = NewConfirmEmailChangeUseCase(request_repo, user_repo, NewMockFirebaseEmailUpdater(), create_test_email_compensator(mock_firebase))
+
+	err = use_case.Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+	if mock_firebase.UpdatedEmail != testEmail {
+		t.Errorf("expected Firebase email to be restored to %s, got %s", testEmail, mock_firebase.UpdatedEmail)
+	}
+	if user_repo.users[user.PublicID()].Email().Value() != testEmail {
+		t.Errorf("expected users row to keep %s", testEmail)
+	}
+}
+
+// TestConfirmEmailChangeUseCase_Execute_RestoreFailed はFirebaseのメールアドレスを戻すのに失敗した場合に補償処理を記録し、記録にも失敗した場合は両方のエラーを返すことをテストします
+func TestConfirmEmailChangeUseCase_Execute_RestoreFailed(t *testing.T) {
+	var user *models.User
+	var user_repo *MockEmailChangeUserRepository
+	var request_repo *MockEmailChangeRequestRepository
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var token string
+	var err error
+
+	user = create_test_account_user(t)
+	user_repo = NewMockEmailChangeUserRepository(user)
+	user_repo.should_return_conflict = true
+	request_repo = NewMockEmailChangeRequestRepository()
+	mock_firebase = NewMockFirebaseUserRepository()
+	mock_firebase.should_return_update_error = true
+	task_repo = NewMockCompensationTaskRepository()
+	token = setup_email_change(t, request_repo, user, time.Now())
+
+	err = NewConfirmEmailChangeUseCase(
+		request_repo, user_repo, NewMockFirebaseEmailUpdater(), NewFirebaseUserCompensator(mock_firebase, task_repo),
+	).Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) || errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected only ErrDuplicateEmail when the compensation is recorded, got %v", err)
+	}
+	if len(task_repo.tasks) != 1 {
+		t.Fatalf("expected 1 compensation task, got %d", len(task_repo.tasks))
+	}
+	for _, task := range task_repo.tasks {
+		if task.Kind() != models.CompensationKindSyncFirebaseEmail || task.Target() != testFirebaseUID {
+			t.Errorf("expected sync_firebase_email task for %s, got %s (%s)", testFirebaseUID, task.Kind(), task.Target())
+		}
+	}
+
+	err = NewConfirmEmailChangeUseCase(
+		request_repo, user_repo, NewMockFirebaseEmailUpdater(), NewFirebaseUserCompensator(mock_firebase, NewMockCompensationTaskRepositoryWithError()),
+	).Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) || !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) || !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDuplicateEmail, ErrFirebaseAuthFailed and ErrDatabaseError, got %v", err)
+	}
+}
+
+// TestConfirmEmailChangeUseCase_Execute_FirebaseConflict はFirebase側の重複でusersテーブルを更新しないことをテストします
+func TestConfirmEmailChangeUseCase_Execute_FirebaseConflict(t *testing.T) {
+	var user *models.User
+	var user_repo *MockEmailChangeUserRepository
+	var request_repo *MockEmailChangeRequestRepository
+	var firebase_updater *MockFirebaseEmailUpdater
+	var use_case *ConfirmEmailChangeUseCase
+	var token string
+	var err error
+
+	user = create_test_account_user(t)
+	user_repo = NewMockEmailChangeUserRepository(user)
+	request_repo = NewMockEmailChangeRequestRepository()
+	firebase_updater = NewMockFirebaseEmailUpdater()
+	firebase_updater.should_return_conflict = true
+	token = setup_email_change(t, request_repo, user, time.Now())
+	use_case = NewConfirmEmailChangeUseCase(request_repo, user_repo, firebase_updater, create_test_email_compensator(NewMockFirebaseUserRepository()))
+
+	err = use_case.Execute(context.Background(), token)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+	if user_repo.users[user.PublicID()].Email().Value() != testEmail {
+		t.Errorf("expected users row to keep %s", testEmail)
+	}
+}
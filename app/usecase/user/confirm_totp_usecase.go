@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MfaStatusRecorderInterface はユーザーの二要素認証の有効・無効を記録するインターフェースです
+type MfaStatusRecorderInterface interface {
+	EnableMfa(ctx context.Context, public_id uuid.UUID, enabled_at time.Time) error
+	DisableMfa(ctx context.Context, public_id uuid.UUID) error
+}
+
+// ConfirmTotpUseCase は認証アプリの認証コードを確認して二要素認証を有効にするユースケースです
+type ConfirmTotpUseCase struct {
+	code_verifier   *MfaCodeVerifier
+	credential_repo TotpCredentialRepositoryInterface
+	status_recorder MfaStatusRecorderInterface
+}
+
+// NewConfirmTotpUseCase は新しいConfirmTotpUseCaseを作成します
+func NewConfirmTotpUseCase(
+	code_verifier *MfaCodeVerifier,
+	credential_repo TotpCredentialRepositoryInterface,
+	status_recorder MfaStatusRecorderInterface,
+) *ConfirmTotpUseCase {
+	return &ConfirmTotpUseCase{
+		code_verifier:   code_verifier,
+		credential_repo: credential_repo,
+		status_recorder: status_recorder,
+	}
+}
+
+// Execute は認証コードを確認して二要素認証を有効にし、リカバリーコードを返します
+// リカバリーコードの平文はこの時だけ返し、DBにはハッシュのみを保存します
+func (uc *ConfirmTotpUseCase) Execute(ctx context.Context, user *models.User, code string) ([]string, error) {
+	var credential *models.TotpCredential
+	var recovery_codes []string
+	var enabled_at time.Time
+	var err error
+
+	if user.IsMfaEnabled() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrMfaAlreadyEnabled, user.PublicID())
+	}
+	credential, err = uc.code_verifier.VerifyTotpCode(ctx, user.PublicID(), code)
+	if err != nil {
+		return nil, err
+	}
+	recovery_codes, err = models.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	credential.ReplaceRecoveryCodes(recovery_codes)
+	err = uc.credential_repo.Update(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	enabled_at = time.Now()
+	err = uc.status_recorder.EnableMfa(ctx, user.PublicID(), enabled_at)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	user.EnableMfa(enabled_at)
+	return recovery_codes, nil
+}
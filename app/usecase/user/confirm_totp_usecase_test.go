@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestConfirmTotpUseCase_Execute_Success は認証コードの確認で二要素認証が有効になり、リカバリーコードがハッシュで保存されることをテストします
+func TestConfirmTotpUseCase_Execute_Success(t *testing.T) {
+	var test_context *mfaTestContext
+	var user *models.User
+	var recovery_codes []string
+	var credential *models.TotpCredential
+
+	test_context = setupMfaTest(t)
+	user = create_test_user_with_role(t, models.RoleUser)
+	_, recovery_codes = test_context.enable_mfa(t, user)
+	if len(recovery_codes) != models.RecoveryCodeCount {
+		t.Fatalf("expected %d recovery codes, got %d", models.RecoveryCodeCount, len(recovery_codes))
+	}
+	if !user.IsMfaEnabled() {
+		t.Error("expected mfa to be enabled")
+	}
+	if test_context.status_recorder.EnabledPublicID != user.PublicID() {
+		t.Errorf("expected mfa enabled to be recorded for %s, got %s", user.PublicID(), test_context.status_recorder.EnabledPublicID)
+	}
+	credential = test_context.credential_repo.credentials[user.PublicID()]
+	for _, hash := range credential.RecoveryCodeHashes() {
+		if hash == recovery_codes[0] {
+			t.Error("expected recovery codes to be stored hashed")
+		}
+	}
+}
+
+// TestConfirmTotpUseCase_Execute_InvalidCode は誤った認証コードでErrInvalidMfaCodeを返すことをテストします
+func TestConfirmTotpUseCase_Execute_InvalidCode(t *testing.T) {
+	var test_context *mfaTestContext
+	var user *models.User
+	var enrollment *TotpEnrollment
+	var code string
+	var err error
+
+	test_context = setupMfaTest(t)
+	user = create_test_user_with_role(t, models.RoleUser)
+	enrollment, err = test_context.enroll_use_case.Execute(context.Background(), user)
+	if err != nil {
+		t.Fatalf("failed to enroll totp: %v", err)
+	}
+	// 許容範囲（前後1ステップ）の外の認証コード
+	code = generate_test_totp_code(t, enrollment.Secret, time.Now().Add(-10*time.Minute))
+	_, err = test_context.confirm_use_case.Execute(context.Background(), user, code)
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode, got %v", err)
+	}
+	if user.IsMfaEnabled() {
+		t.Error("expected mfa to not be enabled")
+	}
+}
+
+// TestConfirmTotpUseCase_Execute_NotEnrolled は登録を開始していない場合にErrMfaEnrollmentNotFoundを返すことをテストします
+func TestConfirmTotpUseCase_Execute_NotEnrolled(t *testing.T) {
+	var test_context *mfaTestContext
+	var err error
+
+	test_context = setupMfaTest(t)
+	_, err = test_context.confirm_use_case.Execute(context.Background(), create_test_user_with_role(t, models.RoleUser), "123456")
+	if !errors.Is(err, domain_errors.ErrMfaEnrollmentNotFound) {
+		t.Errorf("expected ErrMfaEnrollmentNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// ProfileRepositoryInterface はプロフィールの保存・検索のインターフェースです
+type ProfileRepositoryInterface interface {
+	Create(ctx context.Context, profile *models.Profile) error
+	FindByUserID(ctx context.Context, user_id uuid.UUID) (*models.Profile, error)
+	Update(ctx context.Context, profile *models.Profile) error
+}
+
+// ProfileInput はプロフィールの作成・更新の入力です
+// nilの項目は変更せず、AvatarURLの空文字・HeightCmの0は未設定に戻します
+type ProfileInput struct {
+	DisplayName    *string
+	Bio            *string
+	AvatarURL      *string
+	HeightCm       *int
+	FavoriteStyles []string
+	Links          []string
+}
+
+// CreateProfileUseCase はログイン中のユーザーのプロフィールを作成するユースケースです
+type CreateProfileUseCase struct {
+	profile_repo ProfileRepositoryInterface
+}
+
+// NewCreateProfileUseCase は新しいCreateProfileUseCaseを作成します
+func NewCreateProfileUseCase(profile_repo ProfileRepositoryInterface) *CreateProfileUseCase {
+	return &CreateProfileUseCase{
+		profile_repo: profile_repo,
+	}
+}
+
+// Execute はプロフィールを作成します
+// 表示名は必須で、入力値が不正な場合はErrInvalidProfile、作成済みの場合はErrProfileAlreadyExistsを返します
+func (uc *CreateProfileUseCase) Execute(ctx context.Context, user *models.User, input ProfileInput) (*models.Profile, error) {
+	var display_name models.DisplayName
+	var profile *models.Profile
+	var err error
+
+	if input.DisplayName == nil {
+		return nil, fmt.Errorf("%w: display name is required", domain_errors.ErrInvalidProfile)
+	}
+	display_name, err = models.NewDisplayName(*input.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+	}
+	profile, err = models.NewProfile(user.PublicID(), display_name)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = apply_profile_input(profile, input)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.profile_repo.Create(ctx, profile)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return profile, nil
+}
+
+// apply_profile_input は入力された項目をプロフィールに反映します（入力値が不正な場合はErrInvalidProfileを返します）
+func apply_profile_input(profile *models.Profile, input ProfileInput) error {
+	var err error
+
+	if input.DisplayName != nil {
+		var display_name models.DisplayName
+
+		display_name, err = models.NewDisplayName(*input.DisplayName)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+		}
+		profile.ChangeDisplayName(display_name)
+	}
+	if input.Bio != nil {
+		var bio models.Bio
+
+		bio, err = models.NewBio(*input.Bio)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+		}
+		profile.ChangeBio(bio)
+	}
+	if input.AvatarURL != nil {
+		var avatar_url models.ProfileURL
+
+		if *input.AvatarURL == "" {
+			profile.ChangeAvatarURL(nil)
+		} else {
+			avatar_url, err = models.NewProfileURL(*input.AvatarURL)
+			if err != nil {
+				return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+			}
+			profile.ChangeAvatarURL(&avatar_url)
+		}
+	}
+	if input.HeightCm != nil {
+		var height models.Height
+
+		if *input.HeightCm == 0 {
+			profile.ChangeHeight(nil)
+		} else {
+			height, err = models.NewHeight(*input.HeightCm)
+			if err != nil {
+				return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+			}
+			profile.ChangeHeight(&height)
+		}
+	}
+	if input.FavoriteStyles != nil {
+		err = apply_favorite_styles(profile, input.FavoriteStyles)
+		if err != nil {
+			return err
+		}
+	}
+	if input.Links != nil {
+		err = apply_profile_links(profile, input.Links)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply_favorite_styles は好きなスタイルを置き換えます
+func apply_favorite_styles(profile *models.Profile, values []string) error {
+	var favorite_styles []models.FavoriteStyle
+	var err error
+
+	favorite_styles = make([]models.FavoriteStyle, 0, len(values))
+	for _, value := range values {
+		var style models.FavoriteStyle
+
+		style, err = models.NewFavoriteStyle(value)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+		}
+		favorite_styles = append(favorite_styles, style)
+	}
+	err = profile.ReplaceFavoriteStyles(favorite_styles)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+	}
+	return nil
+}
+
+// apply_profile_links はリンクを置き換えます
+func apply_profile_links(profile *models.Profile, values []string) error {
+	var links []models.ProfileURL
+	var err error
+
+	links = make([]models.ProfileURL, 0, len(values))
+	for _, value := range values {
+		var link models.ProfileURL
+
+		link, err = models.NewProfileURL(value)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+		}
+		links = append(links, link)
+	}
+	err = profile.ReplaceLinks(links)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidProfile, err)
+	}
+	return nil
+}
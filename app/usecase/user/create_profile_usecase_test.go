@@ -0,0 +1,131 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockProfileRepository はテスト用のインメモリなプロフィールのリポジトリモックです
+type MockProfileRepository struct {
+	profiles map[uuid.UUID]*models.Profile
+}
+
+// NewMockProfileRepository は新しいMockProfileRepositoryを作成します
+func NewMockProfileRepository() *MockProfileRepository {
+	return &MockProfileRepository{profiles: map[uuid.UUID]*models.Profile{}}
+}
+
+// Create はプロフィールを保存します
+func (m *MockProfileRepository) Create(_ context.Context, profile *models.Profile) error {
+	if m.profiles[profile.UserID()] != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrProfileAlreadyExists, profile.UserID())
+	}
+	m.profiles[profile.UserID()] = profile
+	return nil
+}
+
+// FindByUserID はユーザーのプロフィールを返します
+func (m *MockProfileRepository) FindByUserID(_ context.Context, user_id uuid.UUID) (*models.Profile, error) {
+	if m.profiles[user_id] == nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, user_id)
+	}
+	return m.profiles[user_id], nil
+}
+
+// Update はプロフィールを更新します
+func (m *MockProfileRepository) Update(_ context.Context, profile *models.Profile) error {
+	if m.profiles[profile.UserID()] == nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, profile.UserID())
+	}
+	m.profiles[profile.UserID()] = profile
+	return nil
+}
+
+// TestCreateProfileUseCase_Execute_Success はプロフィールの作成をテストします
+func TestCreateProfileUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var profile_repo *MockProfileRepository
+	var use_case *CreateProfileUseCase
+	var display_name string
+	var height_cm int
+	var profile *models.Profile
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	profile_repo = NewMockProfileRepository()
+	use_case = NewCreateProfileUseCase(profile_repo)
+	display_name = "スリーブ太郎"
+	height_cm = 175
+	profile, err = use_case.Execute(ctx, user, ProfileInput{
+		DisplayName:    &display_name,
+		HeightCm:       &height_cm,
+		FavoriteStyles: []string{"casual", "street"},
+		Links:          []string{"https://instagram.com/sleeve"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.UserID() != user.PublicID() {
+		t.Errorf("expected user_id %s, got %s", user.PublicID(), profile.UserID())
+	}
+	if profile.Height() == nil || profile.Height().Centimeters() != 175 {
+		t.Errorf("expected height 175, got %v", profile.Height())
+	}
+	if len(profile.FavoriteStyles()) != 2 || len(profile.Links()) != 1 {
+		t.Errorf("expected 2 styles and 1 link, got %d and %d", len(profile.FavoriteStyles()), len(profile.Links()))
+	}
+	if profile_repo.profiles[user.PublicID()] == nil {
+		t.Error("expected profile to be saved")
+	}
+
+	_, err = use_case.Execute(ctx, user, ProfileInput{DisplayName: &display_name})
+	if !errors.Is(err, domain_errors.ErrProfileAlreadyExists) {
+		t.Errorf("expected ErrProfileAlreadyExists, got %v", err)
+	}
+}
+
+// TestCreateProfileUseCase_Execute_InvalidInput は不正な入力値でプロフィールが作成されないことをテストします
+func TestCreateProfileUseCase_Execute_InvalidInput(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var profile_repo *MockProfileRepository
+	var use_case *CreateProfileUseCase
+	var display_name string
+	var long_display_name string
+	var avatar_url string
+	var inputs []ProfileInput
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	profile_repo = NewMockProfileRepository()
+	use_case = NewCreateProfileUseCase(profile_repo)
+	display_name = "taro"
+	long_display_name = strings.Repeat("a", 51)
+	avatar_url = "javascript:alert(1)"
+	inputs = []ProfileInput{
+		{},
+		{DisplayName: &long_display_name},
+		{DisplayName: &display_name, AvatarURL: &avatar_url},
+		{DisplayName: &display_name, Links: []string{"https://a.example.com", "https://b.example.com", "https://c.example.com", "https://d.example.com", "https://e.example.com", "https://f.example.com"}},
+	}
+	for _, input := range inputs {
+		_, err = use_case.Execute(ctx, user, input)
+		if !errors.Is(err, domain_errors.ErrInvalidProfile) {
+			t.Errorf("expected ErrInvalidProfile for %+v, got %v", input, err)
+		}
+	}
+	if len(profile_repo.profiles) != 0 {
+		t.Errorf("expected no profile to be saved, got %d", len(profile_repo.profiles))
+	}
+}
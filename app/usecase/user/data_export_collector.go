@@ -0,0 +1,427 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// dataExportFollowPageSize はフォロワー・フォローの一覧を取得する1ページあたりの件数です
+const dataExportFollowPageSize = 100
+
+// dataExportReadmeText はZIPファイルに同梱する説明です
+const dataExportReadmeText = "SLEEVE データのエクスポート\r\n\r\n" +
+	"data.json: アカウント・プロフィール・ハンドル・連携済みのログイン方法・ログイン中の端末・フォロワー・フォロー・ブロック・ミュート・アップロードした画像の一覧\r\n" +
+	"media/: アップロードした画像（画像ごとのディレクトリにリサイズ画像を格納します。アップロードした画像がない場合は空です）\r\n"
+
+// BlockedUserListerInterface はユーザーがブロックしたユーザーの一覧を取得するインターフェースです
+type BlockedUserListerInterface interface {
+	ListBlockedIDs(ctx context.Context, blocker_id uuid.UUID) ([]uuid.UUID, error)
+}
+
+// MutedUserListerInterface はユーザーがミュートしたユーザーの一覧を取得するインターフェースです
+type MutedUserListerInterface interface {
+	ListMutedIDs(ctx context.Context, muter_id uuid.UUID) ([]uuid.UUID, error)
+}
+
+// UploadedImageListerInterface はユーザーがアップロードした画像の一覧を取得するインターフェースです
+type UploadedImageListerInterface interface {
+	ListByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.UploadedImage, error)
+}
+
+// ImageBlobOpenerInterface はストレージに保存した画像を読み出すインターフェースです
+type ImageBlobOpenerInterface interface {
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// DataExportCollector はユーザーの個人データを集めてZIPファイルを作成します
+// 投稿・出品・注文・メッセージはまだ存在しないため、追加された時点で収集対象に加えます
+type DataExportCollector struct {
+	profile_repo  ProfileFinderInterface
+	handle_repo   UserHandleFinderInterface
+	identity_repo UserIdentityRepositoryInterface
+	session_repo  SessionFinderInterface
+	follow_repo   FollowPageFinderInterface
+	block_repo    BlockedUserListerInterface
+	mute_repo     MutedUserListerInterface
+	image_repo    UploadedImageListerInterface
+	image_storage ImageBlobOpenerInterface
+}
+
+// NewDataExportCollector は新しいDataExportCollectorを作成します
+func NewDataExportCollector(
+	profile_repo ProfileFinderInterface,
+	handle_repo UserHandleFinderInterface,
+	identity_repo UserIdentityRepositoryInterface,
+	session_repo SessionFinderInterface,
+	follow_repo FollowPageFinderInterface,
+	block_repo BlockedUserListerInterface,
+	mute_repo MutedUserListerInterface,
+	image_repo UploadedImageListerInterface,
+	image_storage ImageBlobOpenerInterface,
+) *DataExportCollector {
+	return &DataExportCollector{
+		profile_repo:  profile_repo,
+		handle_repo:   handle_repo,
+		identity_repo: identity_repo,
+		session_repo:  session_repo,
+		follow_repo:   follow_repo,
+		block_repo:    block_repo,
+		mute_repo:     mute_repo,
+		image_repo:    image_repo,
+		image_storage: image_storage,
+	}
+}
+
+// data_export_document はdata.jsonの内容です
+type data_export_document struct {
+	ExportedAt      time.Time                     `json:"exported_at"`
+	Account         data_export_account           `json:"account"`
+	Profile         *data_export_profile          `json:"profile"`
+	Handle          *string                       `json:"handle"`
+	LinkedProviders []data_export_linked_provider `json:"linked_providers"`
+	Sessions        []data_export_session         `json:"sessions"`
+	Followers       []data_export_follow          `json:"followers"`
+	Following       []data_export_follow          `json:"following"`
+	BlockedUserIDs  []uuid.UUID                   `json:"blocked_user_ids"`
+	MutedUserIDs    []uuid.UUID                   `json:"muted_user_ids"`
+	Images          []data_export_image           `json:"images"`
+}
+
+// data_export_account はアカウントの情報です（認証情報・内部IDは含めません）
+type data_export_account struct {
+	ID              uuid.UUID  `json:"id"`
+	Email           string     `json:"email"`
+	Role            string     `json:"role"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	MfaEnabledAt    *time.Time `json:"mfa_enabled_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// data_export_profile は公開プロフィールです
+type data_export_profile struct {
+	DisplayName    string    `json:"display_name"`
+	Bio            string    `json:"bio"`
+	AvatarURL      *string   `json:"avatar_url"`
+	HeightCm       *int      `json:"height_cm"`
+	FavoriteStyles []string  `json:"favorite_styles"`
+	Links          []string  `json:"links"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// data_export_linked_provider は連携済みのログイン方法です
+type data_export_linked_provider struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+// data_export_session はログイン中の端末です
+type data_export_session struct {
+	DeviceName string    `json:"device_name"`
+	Platform   string    `json:"platform"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// data_export_follow はフォロー関係の相手のユーザーとフォローした日時です
+type data_export_follow struct {
+	UserID     uuid.UUID `json:"user_id"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// data_export_image はアップロードした画像と、ZIPファイル内のリサイズ画像のパスです
+type data_export_image struct {
+	ID          uuid.UUID `json:"id"`
+	Purpose     string    `json:"purpose"`
+	ContentType string    `json:"content_type"`
+	Files       []string  `json:"files"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+// Collect はユーザーの個人データをdata.jsonにまとめたZIPファイルを作成します
+func (c *DataExportCollector) Collect(ctx context.Context, user *models.User, now time.Time) ([]byte, error) {
+	var document *data_export_document
+	var images []*models.UploadedImage
+	var data []byte
+	var buffer bytes.Buffer
+	var writer *zip.Writer
+	var err error
+
+	document, err = c.collect_document(ctx, user, now)
+	if err != nil {
+		return nil, err
+	}
+	images, err = c.image_repo.ListByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	document.Images = []data_export_image{}
+	for _, image := range images {
+		document.Images = append(document.Images, convert_image_to_data_export(image))
+	}
+	data, err = json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode data export: %w", err)
+	}
+	writer = zip.NewWriter(&buffer)
+	err = write_zip_file(writer, "data.json", data, now)
+	if err != nil {
+		return nil, err
+	}
+	err = write_zip_file(writer, "README.txt", []byte(dataExportReadmeText), now)
+	if err != nil {
+		return nil, err
+	}
+	// アップロードした画像はmedia/以下に格納する（画像がない場合も空のディレクトリを作成する）
+	_, err = writer.CreateHeader(&zip.FileHeader{Name: "media/", Modified: now})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write data export: %w", err)
+	}
+	for _, image := range images {
+		err = c.write_image_files(ctx, writer, image)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write data export: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// write_image_files はアップロードした画像のリサイズ画像をストレージから読み出してmedia/以下に書き込みます
+func (c *DataExportCollector) write_image_files(ctx context.Context, writer *zip.Writer, image *models.UploadedImage) error {
+	var reader io.ReadCloser
+	var data []byte
+	var err error
+
+	for _, variant := range image.Variants() {
+		reader, err = c.image_storage.Open(ctx, variant.StorageKey())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		data, err = io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain_errors.ErrStorageError, err)
+		}
+		err = write_zip_file(writer, data_export_image_path(image, variant), data, image.UploadedAt())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collect_document は各リポジトリからユーザーの個人データを集めます
+func (c *DataExportCollector) collect_document(ctx context.Context, user *models.User, now time.Time) (*data_export_document, error) {
+	var document *data_export_document
+	var profile *models.Profile
+	var user_handle *models.UserHandle
+	var identities []*models.UserIdentity
+	var sessions []*models.Session
+	var err error
+
+	document = &data_export_document{
+		ExportedAt: now,
+		Account: data_export_account{
+			ID:              user.PublicID(),
+			Email:           user.Email().Value(),
+			Role:            user.Role().String(),
+			EmailVerifiedAt: user.EmailVerifiedAt(),
+			MfaEnabledAt:    user.MfaEnabledAt(),
+			CreatedAt:       user.CreatedAt(),
+			UpdatedAt:       user.UpdatedAt(),
+		},
+		LinkedProviders: []data_export_linked_provider{},
+		Sessions:        []data_export_session{},
+	}
+
+	profile, err = c.profile_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil && !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if profile != nil {
+		document.Profile = convert_profile_to_data_export(profile)
+	}
+
+	user_handle, err = c.handle_repo.FindCurrentByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user_handle != nil {
+		var handle string
+
+		handle = user_handle.Handle().Value()
+		document.Handle = &handle
+	}
+
+	identities, err = c.identity_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	for _, identity := range identities {
+		document.LinkedProviders = append(document.LinkedProviders, data_export_linked_provider{
+			Provider: identity.Provider().String(),
+			Subject:  identity.Subject(),
+			LinkedAt: identity.LinkedAt(),
+		})
+	}
+
+	sessions, err = c.session_repo.FindActiveByUserID(ctx, user.PublicID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	for _, session := range sessions {
+		document.Sessions = append(document.Sessions, data_export_session{
+			DeviceName: session.Device().Name(),
+			Platform:   session.Device().Platform().String(),
+			IPAddress:  session.Device().IPAddress(),
+			UserAgent:  session.Device().UserAgent(),
+			LastSeenAt: session.LastSeenAt(),
+			CreatedAt:  session.CreatedAt(),
+		})
+	}
+
+	document.Followers, err = c.collect_follows(ctx, user.PublicID(), c.follow_repo.ListFollowers, func(follow *models.Follow) uuid.UUID {
+		return follow.FollowerID()
+	})
+	if err != nil {
+		return nil, err
+	}
+	document.Following, err = c.collect_follows(ctx, user.PublicID(), c.follow_repo.ListFollowing, func(follow *models.Follow) uuid.UUID {
+		return follow.FolloweeID()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	document.BlockedUserIDs, err = c.block_repo.ListBlockedIDs(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	document.MutedUserIDs, err = c.mute_repo.ListMutedIDs(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return document, nil
+}
+
+// collect_follows はフォロワー・フォローの一覧を最後のページまで取得します
+// ブロックによる絞り込みを行わないよう、閲覧するユーザーは指定しません
+func (c *DataExportCollector) collect_follows(
+	ctx context.Context,
+	user_id uuid.UUID,
+	list_page func(ctx context.Context, user_id uuid.UUID, viewer_id uuid.UUID, first int, after string) (*models.FollowPage, error),
+	counterpart_id func(follow *models.Follow) uuid.UUID,
+) ([]data_export_follow, error) {
+	var follows []data_export_follow
+	var page *models.FollowPage
+	var after string
+	var err error
+
+	follows = []data_export_follow{}
+	for {
+		page, err = list_page(ctx, user_id, uuid.Nil, dataExportFollowPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		for _, follow := range page.Follows() {
+			follows = append(follows, data_export_follow{
+				UserID:     counterpart_id(follow),
+				FollowedAt: follow.CreatedAt(),
+			})
+		}
+		if !page.HasNextPage() {
+			return follows, nil
+		}
+		after = page.EndCursor()
+	}
+}
+
+// convert_profile_to_data_export はプロフィールをdata.jsonの形式に変換します
+func convert_profile_to_data_export(profile *models.Profile) *data_export_profile {
+	var exported *data_export_profile
+
+	exported = &data_export_profile{
+		DisplayName:    profile.DisplayName().Value(),
+		Bio:            profile.Bio().Value(),
+		FavoriteStyles: []string{},
+		Links:          []string{},
+		CreatedAt:      profile.CreatedAt(),
+		UpdatedAt:      profile.UpdatedAt(),
+	}
+	if profile.AvatarURL() != nil {
+		var avatar_url string
+
+		avatar_url = profile.AvatarURL().Value()
+		exported.AvatarURL = &avatar_url
+	}
+	if profile.Height() != nil {
+		var height_cm int
+
+		height_cm = profile.Height().Centimeters()
+		exported.HeightCm = &height_cm
+	}
+	for _, style := range profile.FavoriteStyles() {
+		exported.FavoriteStyles = append(exported.FavoriteStyles, style.Value())
+	}
+	for _, link := range profile.Links() {
+		exported.Links = append(exported.Links, link.Value())
+	}
+	return exported
+}
+
+// convert_image_to_data_export はアップロードした画像をdata.jsonの形式に変換します
+func convert_image_to_data_export(image *models.UploadedImage) data_export_image {
+	var exported data_export_image
+
+	exported = data_export_image{
+		ID:          image.ImageID(),
+		Purpose:     image.Purpose().String(),
+		ContentType: image.Format().ContentType(),
+		Files:       []string{},
+		UploadedAt:  image.UploadedAt(),
+	}
+	for _, variant := range image.Variants() {
+		exported.Files = append(exported.Files, data_export_image_path(image, variant))
+	}
+	return exported
+}
+
+// data_export_image_path はリサイズ画像のZIPファイル内のパスを返します
+func data_export_image_path(image *models.UploadedImage, variant models.ImageVariant) string {
+	return "media/" + image.ImageID().String() + "/" + variant.Name() + "." + image.Format().Extension()
+}
+
+// write_zip_file はZIPファイルにファイルを1つ書き込みます
+func write_zip_file(writer *zip.Writer, name string, data []byte, modified time.Time) error {
+	var file_writer io.Writer
+	var err error
+
+	file_writer, err = writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return fmt.Errorf("failed to write data export: %w", err)
+	}
+	_, err = file_writer.Write(data)
+	if err != nil {
+		return fmt.Errorf("failed to write data export: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// new_test_data_export_collector はモックのリポジトリを使うDataExportCollectorを作成します
+func new_test_data_export_collector(
+	profile_repo *MockProfileRepository,
+	handle_repo *MockUserHandleRepository,
+	follow_repo *MockFollowRepository,
+	block_repo *MockUserBlockRepository,
+	mute_repo *MockUserMuteRepository,
+) *DataExportCollector {
+	return NewDataExportCollector(
+		profile_repo, handle_repo, NewMockUserIdentityRepository(), NewMockSessionRepository(), follow_repo, block_repo, mute_repo,
+		NewMockUploadedImageRepository(), NewMockBlobStorage(),
+	)
+}
+
+// read_data_export_document はZIPファイルからdata.jsonを読み出します
+func read_data_export_document(t *testing.T, archive []byte) map[string]any {
+	var document map[string]any
+	var err error
+
+	t.Helper()
+	err = json.Unmarshal(read_data_export_file(t, archive, "data.json"), &document)
+	if err != nil {
+		t.Fatalf("failed to decode data.json: %v", err)
+	}
+	return document
+}
+
+// read_data_export_file はZIPファイルから指定したファイルを読み出します
+func read_data_export_file(t *testing.T, archive []byte, name string) []byte {
+	var reader *zip.Reader
+	var err error
+
+	t.Helper()
+	reader, err = zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open zip: %v", err)
+	}
+	for _, file := range reader.File {
+		var file_reader io.ReadCloser
+		var data []byte
+
+		if file.Name != name {
+			continue
+		}
+		file_reader, err = file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", name, err)
+		}
+		data, _ = io.ReadAll(file_reader)
+		file_reader.Close()
+		return data
+	}
+	t.Fatalf("expected %s in zip", name)
+	return nil
+}
+
+// TestDataExportCollector_Collect はアカウント・プロフィール・ハンドル・フォロー・ブロック・ミュートがdata.jsonに含まれることをテストします
+func TestDataExportCollector_Collect(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var other_id uuid.UUID
+	var profile_repo *MockProfileRepository
+	var handle_repo *MockUserHandleRepository
+	var follow_repo *MockFollowRepository
+	var block_repo *MockUserBlockRepository
+	var mute_repo *MockUserMuteRepository
+	var display_name models.DisplayName
+	var profile *models.Profile
+	var follow *models.Follow
+	var user_mute *models.UserMute
+	var archive []byte
+	var document map[string]any
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	other_id = uuid.New()
+	profile_repo = NewMockProfileRepository()
+	display_name, _ = models.NewDisplayName("テストユーザー")
+	profile, _ = models.NewProfile(user.PublicID(), display_name)
+	_ = profile_repo.Create(ctx, profile)
+	handle_repo = NewMockUserHandleRepository()
+	handle_repo.Add(user.PublicID(), "test_user", time.Now(), nil)
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(other_id, user.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	block_repo = NewMockUserBlockRepository()
+	block_repo.add(user.PublicID(), other_id)
+	mute_repo = NewMockUserMuteRepository()
+	user_mute, _ = models.NewUserMute(user.PublicID(), other_id, time.Now())
+	_, _ = mute_repo.Create(ctx, user_mute)
+
+	archive, err = new_test_data_export_collector(profile_repo, handle_repo, follow_repo, block_repo, mute_repo).Collect(ctx, user, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	document = read_data_export_document(t, archive)
+
+	if document["account"].(map[string]any)["email"] != testEmail {
+		t.Errorf("expected account email %s, got %v", testEmail, document["account"])
+	}
+	if document["profile"].(map[string]any)["display_name"] != "テストユーザー" {
+		t.Errorf("expected profile display name, got %v", document["profile"])
+	}
+	if document["handle"] != "test_user" {
+		t.Errorf("expected handle test_user, got %v", document["handle"])
+	}
+	if len(document["followers"].([]any)) != 1 || len(document["following"].([]any)) != 0 {
+		t.Errorf("expected 1 follower and no following, got %v / %v", document["followers"], document["following"])
+	}
+	for _, key := range []string{"blocked_user_ids", "muted_user_ids"} {
+		var ids []any
+
+		ids = document[key].([]any)
+		if len(ids) != 1 || ids[0] != other_id.String() {
+			t.Errorf("expected %s to be [%s], got %v", key, other_id, ids)
+		}
+	}
+}
+
+// TestDataExportCollector_Collect_WithoutProfile はプロフィール・ハンドルが未作成の場合もエクスポートできることをテストします
+func TestDataExportCollector_Collect_WithoutProfile(t *testing.T) {
+	var archive []byte
+	var document map[string]any
+	var err error
+
+	archive, err = new_test_data_export_collector(
+		NewMockProfileRepository(), NewMockUserHandleRepository(), NewMockFollowRepository(),
+		NewMockUserBlockRepository(), NewMockUserMuteRepository(),
+	).Collect(context.Background(), create_test_account_user(t), time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	document = read_data_export_document(t, archive)
+	if document["profile"] != nil || document["handle"] != nil {
+		t.Errorf("expected null profile and handle, got %v / %v", document["profile"], document["handle"])
+	}
+}
+
+// TestDataExportCollector_Collect_Images はアップロードした画像がdata.jsonの一覧とmedia/以下のファイルに含まれることをテストします
+func TestDataExportCollector_Collect_Images(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var storage *MockBlobStorage
+	var image_repo *MockUploadedImageRepository
+	var uploaded *models.UploadedImage
+	var archive []byte
+	var document map[string]any
+	var images []any
+	var files []any
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	storage = NewMockBlobStorage()
+	image_repo = NewMockUploadedImageRepository()
+	uploaded, err = NewUploadImageUseCase(storage, image_repo).Execute(
+		ctx, user, "avatar", bytes.NewReader(encode_test_image(t, 64, 64, models.ImageFormatPNG)),
+	)
+	if err != nil {
+		t.Fatalf("failed to upload test image: %v", err)
+	}
+
+	archive, err = NewDataExportCollector(
+		NewMockProfileRepository(), NewMockUserHandleRepository(), NewMockUserIdentityRepository(), NewMockSessionRepository(),
+		NewMockFollowRepository(), NewMockUserBlockRepository(), NewMockUserMuteRepository(), image_repo, storage,
+	).Collect(ctx, user, time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	document = read_data_export_document(t, archive)
+
+	images = document["images"].([]any)
+	if len(images) != 1 || images[0].(map[string]any)["id"] != uploaded.ImageID().String() {
+		t.Fatalf("expected the uploaded image in data.json, got %v", images)
+	}
+	files = images[0].(map[string]any)["files"].([]any)
+	if len(files) != len(uploaded.Variants()) {
+		t.Fatalf("expected %d files, got %v", len(uploaded.Variants()), files)
+	}
+	for i, variant := range uploaded.Variants() {
+		if !bytes.Equal(read_data_export_file(t, archive, files[i].(string)), storage.objects[variant.StorageKey()]) {
+			t.Errorf("expected %s to contain the stored variant %s", files[i], variant.Name())
+		}
+	}
+}
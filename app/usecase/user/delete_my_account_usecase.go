@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FirebaseUserEnablerInterface は無効化したFirebaseユーザーを再度有効化するインターフェースです
+type FirebaseUserEnablerInterface interface {
+	EnableUser(ctx context.Context, firebase_uid string) error
+}
+
+// FirebaseUserDisablerInterface はFirebaseユーザーを無効化するインターフェースです
+// LINEのユーザーはカスタムトークンでサインインするまでFirebaseに存在しないため、存在しないユーザーの無効化は成功として扱う必要があります
+type FirebaseUserDisablerInterface interface {
+	DisableUser(ctx context.Context, firebase_uid string) error
+}
+
+// AccountDeletionRecorderInterface はユーザーを論理削除するインターフェースです
+type AccountDeletionRecorderInterface interface {
+	MarkDeleted(ctx context.Context, public_id uuid.UUID, deleted_at time.Time, deletion_token_hash string) error
+}
+
+// AccountDeletionMailSenderInterface はアカウント削除受付メールを送信するインターフェースです
+type AccountDeletionMailSenderInterface interface {
+	SendAccountDeletionMail(ctx context.Context, email models.Email, cancellation_token string, purge_at time.Time) error
+}
+
+// DeleteMyAccountUseCase はログイン中のユーザーが自身のアカウントを削除（退会）するユースケースです
+// 退会後AccountDeletionGracePeriodの間は論理削除のみとし、メールで送信した取り消しコードで削除を取り消せます
+type DeleteMyAccountUseCase struct {
+	firebase_disabler FirebaseUserDisablerInterface
+	user_repo         AccountDeletionRecorderInterface
+	session_revoker   *UserSessionRevoker
+	mail_sender       AccountDeletionMailSenderInterface
+	compensator       *FirebaseUserCompensator
+}
+
+// NewDeleteMyAccountUseCase は新しいDeleteMyAccountUseCaseを作成します
+func NewDeleteMyAccountUseCase(
+	firebase_disabler FirebaseUserDisablerInterface,
+	user_repo AccountDeletionRecorderInterface,
+	session_revoker *UserSessionRevoker,
+	mail_sender AccountDeletionMailSenderInterface,
+	compensator *FirebaseUserCompensator,
+) *DeleteMyAccountUseCase {
+	return &DeleteMyAccountUseCase{
+		firebase_disabler: firebase_disabler,
+		user_repo:         user_repo,
+		session_revoker:   session_revoker,
+		mail_sender:       mail_sender,
+		compensator:       compensator,
+	}
+}
+
+// Execute はユーザーを論理削除し、Firebaseユーザーの無効化と全セッションの失効を行い、物理削除される日時を返します
+// 論理削除後の取り消しコードのメールの送信は、失敗しても退会を完了させるためログの出力のみとします
+func (uc *DeleteMyAccountUseCase) Execute(ctx context.Context, user *models.User) (time.Time, error) {
+	var cancellation_token string
+	var now time.Time
+	var purge_at time.Time
+	var rollback_err error
+	var err error
+
+	cancellation_token, err = models.GenerateAccountDeletionToken()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w", err)
+	}
+	now = time.Now()
+	purge_at = models.AccountPurgeAt(now)
+
+	// Firebase側を先に無効化し、論理削除に失敗した場合は有効化し直して退会前の状態に戻す
+	// 有効化し直せなかった場合は補償処理として記録し、後から再試行する
+	err = uc.firebase_disabler.DisableUser(ctx, user.FirebaseUID())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w", err)
+	}
+	err = uc.user_repo.MarkDeleted(ctx, user.PublicID(), now, models.HashAccountDeletionToken(cancellation_token))
+	if err != nil {
+		rollback_err = uc.compensator.EnableFirebaseUser(ctx, user.FirebaseUID())
+		if rollback_err != nil {
+			return time.Time{}, fmt.Errorf("%w", errors.Join(err, rollback_err))
+		}
+		return time.Time{}, fmt.Errorf("%w", err)
+	}
+	err = uc.session_revoker.RevokeAllSessions(ctx, user.PublicID(), now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w", err)
+	}
+	err = uc.mail_sender.SendAccountDeletionMail(ctx, user.Email(), cancellation_token, purge_at)
+	if err != nil {
+		log.Printf("アカウント削除受付メールの送信に失敗しました: public_id=%s: %v", user.PublicID(), err)
+	}
+	return purge_at, nil
+}
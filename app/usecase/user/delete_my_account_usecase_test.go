@@ -0,0 +1,315 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockAccountDeletionRepository はテスト用のインメモリな退会ユーザーのリポジトリです
+type MockAccountDeletionRepository struct {
+	users               map[uuid.UUID]*models.User
+	token_hashes        map[uuid.UUID]string
+	should_return_error bool
+}
+
+// NewMockAccountDeletionRepository は指定したユーザーを保持するMockAccountDeletionRepositoryを作成します
+func NewMockAccountDeletionRepository(users ...*models.User) *MockAccountDeletionRepository {
+	var repo *MockAccountDeletionRepository
+
+	repo = &MockAccountDeletionRepository{
+		users:        map[uuid.UUID]*models.User{},
+		token_hashes: map[uuid.UUID]string{},
+	}
+	for _, user := range users {
+		repo.users[user.PublicID()] = user
+	}
+	return repo
+}
+
+// set_deleted_at はユーザーの論理削除日時を更新します
+func (m *MockAccountDeletionRepository) set_deleted_at(user *models.User, deleted_at *time.Time) {
+	m.users[user.PublicID()], _ = models.NewUserWithPublicID(
+		user.PublicID(), user.FirebaseUID(), user.Email(), user.Role(), user.EmailVerifiedAt(),
+		user.MfaEnabledAt(), user.CreatedAt(), user.UpdatedAt(), deleted_at, user.BannedAt(),
+	)
+}
+
+// MarkDeleted はモックの論理削除を行います
+func (m *MockAccountDeletionRepository) MarkDeleted(_ context.Context, public_id uuid.UUID, deleted_at time.Time, deletion_token_hash string) error {
+	var user *models.User
+	var is_found bool
+
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	user, is_found = m.users[public_id]
+	if !is_found || user.IsDeleted() {
+		return domain_errors.ErrUserNotFound
+	}
+	m.set_deleted_at(user, &deleted_at)
+	m.token_hashes[public_id] = deletion_token_hash
+	return nil
+}
+
+// FindByDeletionTokenHash はモックの取り消しコードのハッシュによる検索を行います
+func (m *MockAccountDeletionRepository) FindByDeletionTokenHash(_ context.Context, deletion_token_hash string) (*models.User, error) {
+	for public_id, token_hash := range m.token_hashes {
+		if token_hash == deletion_token_hash {
+			return m.users[public_id], nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// RestoreDeleted はモックの論理削除の取り消しを行います
+func (m *MockAccountDeletionRepository) RestoreDeleted(_ context.Context, public_id uuid.UUID) error {
+	var user *models.User
+	var is_found bool
+
+	user, is_found = m.users[public_id]
+	if !is_found {
+		return domain_errors.ErrUserNotFound
+	}
+	m.set_deleted_at(user, nil)
+	delete(m.token_hashes, public_id)
+	return nil
+}
+
+// ListDeletedBefore はモックの取り消し期間を過ぎた退会ユーザーの検索を行います
+func (m *MockAccountDeletionRepository) ListDeletedBefore(_ context.Context, deleted_before time.Time, limit int) ([]*models.User, error) {
+	var users []*models.User
+
+	users = []*models.User{}
+	for _, user := range m.users {
+		if user.DeletedAt() != nil && user.DeletedAt().Before(deleted_before) && len(users) < limit {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// HardDeleteByPublicID はモックの物理削除を行います
+func (m *MockAccountDeletionRepository) HardDeleteByPublicID(_ context.Context, public_id uuid.UUID) error {
+	delete(m.users, public_id)
+	delete(m.token_hashes, public_id)
+	return nil
+}
+
+// MockFirebaseUserDisabler はテスト用のFirebaseユーザーの無効化・削除のモックです
+type MockFirebaseUserDisabler struct {
+	disabled                   map[string]bool
+	deleted_uids               []string
+	should_return_enable_error bool
+}
+
+// NewMockFirebaseUserDisabler は新しいMockFirebaseUserDisablerを作成します
+func NewMockFirebaseUserDisabler() *MockFirebaseUserDisabler {
+	return &MockFirebaseUserDisabler{
+		disabled:     map[string]bool{},
+		deleted_uids: []string{},
+	}
+}
+
+// DisableUser はモックのFirebaseユーザーの無効化を行います
+func (m *MockFirebaseUserDisabler) DisableUser(_ context.Context, firebase_uid string) error {
+	m.disabled[firebase_uid] = true
+	return nil
+}
+
+// EnableUser はモックのFirebaseユーザーの有効化を行います
+func (m *MockFirebaseUserDisabler) EnableUser(_ context.Context, firebase_uid string) error {
+	if m.should_return_enable_error {
+		return domain_errors.ErrFirebaseAuthFailed
+	}
+	m.disabled[firebase_uid] = false
+	return nil
+}
+
+// DeleteUser はモックのFirebaseユーザーの削除を行います
+func (m *MockFirebaseUserDisabler) DeleteUser(_ context.Context, firebase_uid string) error {
+	m.deleted_uids = append(m.deleted_uids, firebase_uid)
+	return nil
+}
+
+// UpdateEmail は何もしません（退会では使用しません）
+func (m *MockFirebaseUserDisabler) UpdateEmail(_ context.Context, _ string, _ models.Email, _ bool) error {
+	return nil
+}
+
+// MockAccountDeletionMailSender はテスト用のアカウント削除受付メール送信モックです
+type MockAccountDeletionMailSender struct {
+	cancellation_token  string
+	purge_at            time.Time
+	should_return_error bool
+}
+
+// SendAccountDeletionMail は送信した取り消しコードと物理削除の日時を記録します
+func (m *MockAccountDeletionMailSender) SendAccountDeletionMail(_ context.Context, _ models.Email, cancellation_token string, purge_at time.Time) error {
+	if m.should_return_error {
+		return domain_errors.ErrMailSendFailed
+	}
+	m.cancellation_token = cancellation_token
+	m.purge_at = purge_at
+	return nil
+}
+
+// create_test_account_user はテスト用のユーザーを作成します
+func create_test_account_user(t *testing.T) *models.User {
+	var email models.Email
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, _ = models.NewEmail(testEmail)
+	user, err = models.NewUser(testFirebaseUID, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestDeleteMyAccountUseCase_Execute は退会でユーザーの論理削除・Firebaseユーザーの無効化・全セッションの失効・取り消しコードの送信が行われることをテストします
+func TestDeleteMyAccountUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var firebase_disabler *MockFirebaseUserDisabler
+	var refresh_token_repo *MockRefreshTokenRepository
+	var mail_sender *MockAccountDeletionMailSender
+	var refresh_token *models.RefreshToken
+	var use_case *DeleteMyAccountUseCase
+	var purge_at time.Time
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	firebase_disabler = NewMockFirebaseUserDisabler()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	mail_sender = &MockAccountDeletionMailSender{}
+	refresh_token, _ = models.NewRefreshToken("token-1", uuid.New(), user.PublicID(), time.Now().Add(time.Hour))
+	_ = refresh_token_repo.Save(ctx, refresh_token)
+	use_case = NewDeleteMyAccountUseCase(
+		firebase_disabler, user_repo, NewUserSessionRevoker(NewMockTokenDenylist(), refresh_token_repo, NewMockSessionRepository()), mail_sender,
+		NewFirebaseUserCompensator(firebase_disabler, NewMockCompensationTaskRepository()),
+	)
+
+	purge_at, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !user_repo.users[user.PublicID()].IsDeleted() {
+		t.Error("expected user to be soft-deleted")
+	}
+	if !firebase_disabler.disabled[testFirebaseUID] {
+		t.Error("expected Firebase user to be disabled")
+	}
+	if !refresh_token_repo.tokens[0].IsRevoked() {
+		t.Error("expected sessions to be revoked")
+	}
+	if user_repo.token_hashes[user.PublicID()] != models.HashAccountDeletionToken(mail_sender.cancellation_token) {
+		t.Error("expected hash of mailed cancellation token to be stored")
+	}
+	if !purge_at.Equal(mail_sender.purge_at) || purge_at.Before(time.Now().Add(models.AccountDeletionGracePeriod-time.Minute)) {
+		t.Errorf("expected purge time after grace period, got %v", purge_at)
+	}
+}
+
+// TestDeleteMyAccountUseCase_Execute_DatabaseError は論理削除に失敗した場合にFirebaseユーザーを有効化し直すことをテストします
+func TestDeleteMyAccountUseCase_Execute_DatabaseError(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var firebase_disabler *MockFirebaseUserDisabler
+	var use_case *DeleteMyAccountUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	user_repo.should_return_error = true
+	firebase_disabler = NewMockFirebaseUserDisabler()
+	use_case = NewDeleteMyAccountUseCase(
+		firebase_disabler, user_repo, NewUserSessionRevoker(NewMockTokenDenylist(), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		&MockAccountDeletionMailSender{}, NewFirebaseUserCompensator(firebase_disabler, NewMockCompensationTaskRepository()),
+	)
+
+	_, err = use_case.Execute(ctx, user)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if firebase_disabler.disabled[testFirebaseUID] {
+		t.Error("expected Firebase user to be re-enabled")
+	}
+}
+
+// TestDeleteMyAccountUseCase_Execute_RollbackFailed はFirebaseユーザーを有効化し直せなかった場合に補償処理を記録することをテストします
+func TestDeleteMyAccountUseCase_Execute_RollbackFailed(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var firebase_disabler *MockFirebaseUserDisabler
+	var task_repo *MockCompensationTaskRepository
+	var use_case *DeleteMyAccountUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	user_repo.should_return_error = true
+	firebase_disabler = NewMockFirebaseUserDisabler()
+	firebase_disabler.should_return_enable_error = true
+	task_repo = NewMockCompensationTaskRepository()
+	use_case = NewDeleteMyAccountUseCase(
+		firebase_disabler, user_repo, NewUserSessionRevoker(NewMockTokenDenylist(), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		&MockAccountDeletionMailSender{}, NewFirebaseUserCompensator(firebase_disabler, task_repo),
+	)
+
+	_, err = use_case.Execute(ctx, user)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if len(task_repo.tasks) != 1 {
+		t.Fatalf("expected 1 compensation task, got %d", len(task_repo.tasks))
+	}
+	for _, task := range task_repo.tasks {
+		if task.Kind() != models.CompensationKindEnableFirebaseUser || task.Target() != testFirebaseUID {
+			t.Errorf("expected enable_firebase_user task for %s, got %s for %s", testFirebaseUID, task.Kind(), task.Target())
+		}
+	}
+}
+
+// TestDeleteMyAccountUseCase_Execute_MailFailed はメールの送信に失敗しても退会が完了することをテストします
+func TestDeleteMyAccountUseCase_Execute_MailFailed(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var firebase_disabler *MockFirebaseUserDisabler
+	var use_case *DeleteMyAccountUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(user)
+	firebase_disabler = NewMockFirebaseUserDisabler()
+	use_case = NewDeleteMyAccountUseCase(
+		firebase_disabler, user_repo, NewUserSessionRevoker(NewMockTokenDenylist(), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		&MockAccountDeletionMailSender{should_return_error: true},
+		NewFirebaseUserCompensator(firebase_disabler, NewMockCompensationTaskRepository()),
+	)
+
+	_, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !user_repo.users[user.PublicID()].IsDeleted() {
+		t.Error("expected user to be soft-deleted")
+	}
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// DisableTotpUseCase は二要素認証（TOTP）を無効にするユースケースです
+type DisableTotpUseCase struct {
+	code_verifier   *MfaCodeVerifier
+	credential_repo TotpCredentialRepositoryInterface
+	status_recorder MfaStatusRecorderInterface
+}
+
+// NewDisableTotpUseCase は新しいDisableTotpUseCaseを作成します
+func NewDisableTotpUseCase(
+	code_verifier *MfaCodeVerifier,
+	credential_repo TotpCredentialRepositoryInterface,
+	status_recorder MfaStatusRecorderInterface,
+) *DisableTotpUseCase {
+	return &DisableTotpUseCase{
+		code_verifier:   code_verifier,
+		credential_repo: credential_repo,
+		status_recorder: status_recorder,
+	}
+}
+
+// Execute は認証コードまたはリカバリーコードを確認して二要素認証を無効にします
+// 二要素認証が必須の管理者は無効にできません（ErrMfaRequired）
+func (uc *DisableTotpUseCase) Execute(ctx context.Context, user *models.User, code string) error {
+	var err error
+
+	if !user.IsMfaEnabled() {
+		return fmt.Errorf("%w: %s", domain_errors.ErrMfaNotEnabled, user.PublicID())
+	}
+	if user.IsMfaRequired() {
+		return fmt.Errorf("%w: role=%s", domain_errors.ErrMfaRequired, user.Role())
+	}
+	err = uc.code_verifier.VerifyCode(ctx, user.PublicID(), code)
+	if err != nil {
+		return err
+	}
+
+	// 先にユーザーの状態を無効にする（認証情報の削除に失敗しても、次回の登録開始時に置き換えられる）
+	err = uc.status_recorder.DisableMfa(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	user.DisableMfa()
+	err = uc.credential_repo.Delete(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
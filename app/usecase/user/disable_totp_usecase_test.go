@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestDisableTotpUseCase_Execute_WithRecoveryCode はリカバリーコードで二要素認証を無効にできることをテストします
+func TestDisableTotpUseCase_Execute_WithRecoveryCode(t *testing.T) {
+	var test_context *mfaTestContext
+	var use_case *DisableTotpUseCase
+	var user *models.User
+	var recovery_codes []string
+	var is_found bool
+	var err error
+
+	test_context = setupMfaTest(t)
+	use_case = NewDisableTotpUseCase(test_context.code_verifier, test_context.credential_repo, test_context.status_recorder)
+	user = create_test_user_with_role(t, models.RoleUser)
+	_, recovery_codes = test_context.enable_mfa(t, user)
+	err = use_case.Execute(context.Background(), user, recovery_codes[0])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.IsMfaEnabled() {
+		t.Error("expected mfa to be disabled")
+	}
+	if test_context.status_recorder.DisabledPublicID != user.PublicID() {
+		t.Errorf("expected mfa disabled to be recorded for %s, got %s", user.PublicID(), test_context.status_recorder.DisabledPublicID)
+	}
+	_, is_found = test_context.credential_repo.credentials[user.PublicID()]
+	if is_found {
+		t.Error("expected credential to be deleted")
+	}
+}
+
+// TestDisableTotpUseCase_Execute_InvalidCode は誤ったコードでErrInvalidMfaCodeを返し、二要素認証が有効なままであることをテストします
+func TestDisableTotpUseCase_Execute_InvalidCode(t *testing.T) {
+	var test_context *mfaTestContext
+	var use_case *DisableTotpUseCase
+	var user *models.User
+	var err error
+
+	test_context = setupMfaTest(t)
+	use_case = NewDisableTotpUseCase(test_context.code_verifier, test_context.credential_repo, test_context.status_recorder)
+	user = create_test_user_with_role(t, models.RoleUser)
+	test_context.enable_mfa(t, user)
+	err = use_case.Execute(context.Background(), user, "aaaaa-aaaaa")
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode, got %v", err)
+	}
+	if !user.IsMfaEnabled() {
+		t.Error("expected mfa to remain enabled")
+	}
+}
+
+// TestDisableTotpUseCase_Execute_Admin は二要素認証が必須の管理者にErrMfaRequiredを返すことをテストします
+func TestDisableTotpUseCase_Execute_Admin(t *testing.T) {
+	var test_context *mfaTestContext
+	var use_case *DisableTotpUseCase
+	var user *models.User
+	var recovery_codes []string
+	var err error
+
+	test_context = setupMfaTest(t)
+	use_case = NewDisableTotpUseCase(test_context.code_verifier, test_context.credential_repo, test_context.status_recorder)
+	user = create_test_user_with_role(t, models.RoleAdmin)
+	_, recovery_codes = test_context.enable_mfa(t, user)
+	err = use_case.Execute(context.Background(), user, recovery_codes[0])
+	if !errors.Is(err, domain_errors.ErrMfaRequired) {
+		t.Errorf("expected ErrMfaRequired, got %v", err)
+	}
+}
+
+// TestDisableTotpUseCase_Execute_NotEnabled は二要素認証が無効なユーザーにErrMfaNotEnabledを返すことをテストします
+func TestDisableTotpUseCase_Execute_NotEnabled(t *testing.T) {
+	var test_context *mfaTestContext
+	var use_case *DisableTotpUseCase
+	var err error
+
+	test_context = setupMfaTest(t)
+	use_case = NewDisableTotpUseCase(test_context.code_verifier, test_context.credential_repo, test_context.status_recorder)
+	err = use_case.Execute(context.Background(), create_test_user_with_role(t, models.RoleUser), "123456")
+	if !errors.Is(err, domain_errors.ErrMfaNotEnabled) {
+		t.Errorf("expected ErrMfaNotEnabled, got %v", err)
+	}
+}
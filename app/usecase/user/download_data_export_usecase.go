@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// DataExportDownloadFinderInterface はダウンロードリンクのトークンからエクスポートを検索するインターフェースです
+type DataExportDownloadFinderInterface interface {
+	// FindByDownloadToken は一致するエクスポートがない場合はErrDataExportNotFoundを返します
+	FindByDownloadToken(ctx context.Context, export_id uuid.UUID, download_token_hash string) (*models.DataExport, error)
+}
+
+// DataExportFileOpenerInterface はエクスポートのZIPファイルを読み出すインターフェースです
+type DataExportFileOpenerInterface interface {
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// DownloadDataExportUseCase はダウンロードリンクからエクスポートのZIPファイルを取得するユースケースです
+// リンクはメールで通知するため、ログインは求めずにリンクのトークンで認可します
+type DownloadDataExportUseCase struct {
+	export_repo DataExportDownloadFinderInterface
+	storage     DataExportFileOpenerInterface
+}
+
+// NewDownloadDataExportUseCase は新しいDownloadDataExportUseCaseを作成します
+func NewDownloadDataExportUseCase(export_repo DataExportDownloadFinderInterface, storage DataExportFileOpenerInterface) *DownloadDataExportUseCase {
+	return &DownloadDataExportUseCase{
+		export_repo: export_repo,
+		storage:     storage,
+	}
+}
+
+// Execute はZIPファイルを読み出し用に開いて返します（呼び出し側でCloseしてください）
+// エクスポートIDやトークンが不正な場合・有効期限を過ぎた場合はErrDataExportNotFoundを返します
+func (uc *DownloadDataExportUseCase) Execute(ctx context.Context, export_id string, download_token string) (io.ReadCloser, error) {
+	var parsed_id uuid.UUID
+	var data_export *models.DataExport
+	var reader io.ReadCloser
+	var err error
+
+	parsed_id, err = uuid.Parse(export_id)
+	if err != nil || download_token == "" {
+		return nil, domain_errors.ErrDataExportNotFound
+	}
+	data_export, err = uc.export_repo.FindByDownloadToken(ctx, parsed_id, models.HashDataExportDownloadToken(download_token))
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !data_export.IsDownloadable(time.Now()) {
+		return nil, domain_errors.ErrDataExportNotFound
+	}
+	reader, err = uc.storage.Open(ctx, data_export.StorageKey())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return reader, nil
+}
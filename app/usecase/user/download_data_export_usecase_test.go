@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestDownloadDataExportUseCase_Execute はトークンが一致し有効期限内の場合のみダウンロードできることをテストします
+func TestDownloadDataExportUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var export_repo *MockDataExportRepository
+	var storage *MockDataExportStorage
+	var data_export *models.DataExport
+	var use_case *DownloadDataExportUseCase
+	var reader io.ReadCloser
+	var data []byte
+	var err error
+
+	ctx = context.Background()
+	export_repo = NewMockDataExportRepository()
+	storage = NewMockDataExportStorage()
+	data_export, _ = NewRequestDataExportUseCase(export_repo).Execute(ctx, create_test_account_user(t))
+	_ = data_export.MarkReady(models.DataExportStorageKey(data_export.ExportID()), time.Now())
+	_ = export_repo.MarkReady(ctx, data_export, models.HashDataExportDownloadToken("download-token"))
+	_ = storage.Save(ctx, data_export.StorageKey(), []byte("zip"))
+	use_case = NewDownloadDataExportUseCase(export_repo, storage)
+
+	reader, err = use_case.Execute(ctx, data_export.ExportID().String(), "download-token")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	data, _ = io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "zip" {
+		t.Errorf("expected zip content, got %q", data)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		export_id string
+		token     string
+	}{
+		{name: "wrong token", export_id: data_export.ExportID().String(), token: "wrong-token"},
+		{name: "empty token", export_id: data_export.ExportID().String(), token: ""},
+		{name: "invalid export id", export_id: "not-a-uuid", token: "download-token"},
+	} {
+		_, err = use_case.Execute(ctx, tc.export_id, tc.token)
+		if !errors.Is(err, domain_errors.ErrDataExportNotFound) {
+			t.Errorf("%s: expected ErrDataExportNotFound, got %v", tc.name, err)
+		}
+	}
+
+	// 有効期限を過ぎたリンクではダウンロードできない
+	_ = data_export.MarkReady(data_export.StorageKey(), time.Now().Add(-models.DataExportDownloadPeriod-time.Minute))
+	_, err = use_case.Execute(ctx, data_export.ExportID().String(), "download-token")
+	if !errors.Is(err, domain_errors.ErrDataExportNotFound) {
+		t.Errorf("expected ErrDataExportNotFound after expiration, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// TotpEnrollment は二要素認証の登録開始時に認証アプリへ登録する情報です
+type TotpEnrollment struct {
+	// Secret は手入力用の共有シークレット（Base32）です
+	Secret string
+	// ProvisioningURI はQRコードで読み取るotpauth URIです
+	ProvisioningURI string
+}
+
+// EnrollTotpUseCase は二要素認証（TOTP）の登録を開始するユースケースです
+// confirmTotpで認証コードを確認するまで二要素認証は有効になりません
+type EnrollTotpUseCase struct {
+	credential_repo TotpCredentialRepositoryInterface
+	secret_cipher   SecretCipherInterface
+}
+
+// NewEnrollTotpUseCase は新しいEnrollTotpUseCaseを作成します
+func NewEnrollTotpUseCase(credential_repo TotpCredentialRepositoryInterface, secret_cipher SecretCipherInterface) *EnrollTotpUseCase {
+	return &EnrollTotpUseCase{
+		credential_repo: credential_repo,
+		secret_cipher:   secret_cipher,
+	}
+}
+
+// Execute は共有シークレットを発行し、暗号化して保存します
+// 確認前の登録がある場合は新しいシークレットで置き換えます
+func (uc *EnrollTotpUseCase) Execute(ctx context.Context, user *models.User) (*TotpEnrollment, error) {
+	var secret string
+	var encrypted_secret string
+	var credential *models.TotpCredential
+	var err error
+
+	if user.IsMfaEnabled() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrMfaAlreadyEnabled, user.PublicID())
+	}
+	secret, err = utils.GenerateTotpSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	encrypted_secret, err = uc.secret_cipher.Encrypt(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+	credential, err = models.NewTotpCredential(user.PublicID(), encrypted_secret)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = uc.credential_repo.Save(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &TotpEnrollment{
+		Secret:          secret,
+		ProvisioningURI: utils.TotpProvisioningURI(user.Email().Value(), secret),
+	}, nil
+}
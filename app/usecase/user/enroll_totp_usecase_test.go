@@ -0,0 +1,223 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// testMfaEncryptionKey はテスト用のTOTPシークレットの暗号鍵（32バイト）です
+const testMfaEncryptionKey = "test_mfa_encryption_key_32bytes!"
+
+// mfaTestContext は二要素認証のテストで共有する依存関係です
+type mfaTestContext struct {
+	credential_repo  *MockTotpCredentialRepository
+	status_recorder  *MockMfaStatusRecorder
+	code_verifier    *MfaCodeVerifier
+	enroll_use_case  *EnrollTotpUseCase
+	confirm_use_case *ConfirmTotpUseCase
+}
+
+// setupMfaTest は二要素認証のテスト用の依存関係を作成します
+func setupMfaTest(t *testing.T) *mfaTestContext {
+	var test_context *mfaTestContext
+	var secret_cipher *utils.SecretCipher
+	var err error
+
+	t.Helper()
+	secret_cipher, err = utils.NewSecretCipher([]byte(testMfaEncryptionKey))
+	if err != nil {
+		t.Fatalf("failed to create secret cipher: %v", err)
+	}
+	test_context = &mfaTestContext{
+		credential_repo: NewMockTotpCredentialRepository(),
+		status_recorder: NewMockMfaStatusRecorder(),
+	}
+	test_context.code_verifier = NewMfaCodeVerifier(test_context.credential_repo, secret_cipher)
+	test_context.enroll_use_case = NewEnrollTotpUseCase(test_context.credential_repo, secret_cipher)
+	test_context.confirm_use_case = NewConfirmTotpUseCase(
+		test_context.code_verifier, test_context.credential_repo, test_context.status_recorder,
+	)
+	return test_context
+}
+
+// enable_mfa はテスト用に二要素認証の登録と確認を行い、共有シークレットとリカバリーコードを返します
+func (c *mfaTestContext) enable_mfa(t *testing.T, user *models.User) (string, []string) {
+	var enrollment *TotpEnrollment
+	var recovery_codes []string
+	var err error
+
+	t.Helper()
+	enrollment, err = c.enroll_use_case.Execute(context.Background(), user)
+	if err != nil {
+		t.Fatalf("failed to enroll totp: %v", err)
+	}
+	recovery_codes, err = c.confirm_use_case.Execute(context.Background(), user, generate_test_totp_code(t, enrollment.Secret, time.Now()))
+	if err != nil {
+		t.Fatalf("failed to confirm totp: %v", err)
+	}
+	return enrollment.Secret, recovery_codes
+}
+
+// generate_test_totp_code はテスト用に指定時刻の認証コードを生成します
+// 同じ時間ステップのコードは再利用できないため、2回目以降の検証には次のステップ（30秒後）のコードを使用します
+func generate_test_totp_code(t *testing.T, secret string, at time.Time) string {
+	var code string
+	var err error
+
+	t.Helper()
+	code, err = utils.GenerateTotpCode(secret, at)
+	if err != nil {
+		t.Fatalf("failed to generate totp code: %v", err)
+	}
+	return code
+}
+
+// create_test_user_with_role はテスト用のユーザーを指定したロールで作成します
+func create_test_user_with_role(t *testing.T, role models.Role) *models.User {
+	var email models.Email
+	var now time.Time
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, role, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestEnrollTotpUseCase_Execute_Success は共有シークレットが暗号化されて保存されることをテストします
+func TestEnrollTotpUseCase_Execute_Success(t *testing.T) {
+	var test_context *mfaTestContext
+	var user *models.User
+	var enrollment *TotpEnrollment
+	var credential *models.TotpCredential
+	var err error
+
+	test_context = setupMfaTest(t)
+	user = create_test_user_with_role(t, models.RoleUser)
+	enrollment, err = test_context.enroll_use_case.Execute(context.Background(), user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if enrollment.Secret == "" {
+		t.Error("expected secret to be non-empty")
+	}
+	if !strings.HasPrefix(enrollment.ProvisioningURI, "otpauth://totp/") {
+		t.Errorf("expected otpauth uri, got %s", enrollment.ProvisioningURI)
+	}
+	credential = test_context.credential_repo.credentials[user.PublicID()]
+	if credential == nil {
+		t.Fatal("expected credential to be saved")
+	}
+	if strings.Contains(credential.EncryptedSecret(), enrollment.Secret) {
+		t.Error("expected secret to be stored encrypted")
+	}
+	if user.IsMfaEnabled() {
+		t.Error("expected mfa to not be enabled before confirmation")
+	}
+}
+
+// TestEnrollTotpUseCase_Execute_AlreadyEnabled は二要素認証が有効なユーザーにErrMfaAlreadyEnabledを返すことをテストします
+func TestEnrollTotpUseCase_Execute_AlreadyEnabled(t *testing.T) {
+	var test_context *mfaTestContext
+	var user *models.User
+	var err error
+
+	test_context = setupMfaTest(t)
+	user = create_test_user_with_role(t, models.RoleUser)
+	test_context.enable_mfa(t, user)
+	_, err = test_context.enroll_use_case.Execute(context.Background(), user)
+	if !errors.Is(err, domain_errors.ErrMfaAlreadyEnabled) {
+		t.Errorf("expected ErrMfaAlreadyEnabled, got %v", err)
+	}
+}
+
+// MockTotpCredentialRepository はテスト用の二要素認証の認証情報リポジトリのモックです
+type MockTotpCredentialRepository struct {
+	credentials map[uuid.UUID]*models.TotpCredential
+}
+
+// NewMockTotpCredentialRepository は新しいMockTotpCredentialRepositoryを作成します
+func NewMockTotpCredentialRepository() *MockTotpCredentialRepository {
+	return &MockTotpCredentialRepository{
+		credentials: map[uuid.UUID]*models.TotpCredential{},
+	}
+}
+
+// Save はモックの認証情報の保存を行います（既存の認証情報は置き換えます）
+func (m *MockTotpCredentialRepository) Save(_ context.Context, credential *models.TotpCredential) error {
+	m.credentials[credential.UserID()] = credential
+	return nil
+}
+
+// FindByUserID はモックの認証情報の検索を行います
+func (m *MockTotpCredentialRepository) FindByUserID(_ context.Context, user_id uuid.UUID) (*models.TotpCredential, error) {
+	var credential *models.TotpCredential
+	var is_found bool
+
+	credential, is_found = m.credentials[user_id]
+	if !is_found {
+		return nil, domain_errors.ErrMfaEnrollmentNotFound
+	}
+	return credential, nil
+}
+
+// Update はモックの認証情報の更新を行います
+func (m *MockTotpCredentialRepository) Update(_ context.Context, credential *models.TotpCredential) error {
+	var is_found bool
+
+	_, is_found = m.credentials[credential.UserID()]
+	if !is_found {
+		return domain_errors.ErrMfaEnrollmentNotFound
+	}
+	m.credentials[credential.UserID()] = credential
+	return nil
+}
+
+// Delete はモックの認証情報の削除を行います
+func (m *MockTotpCredentialRepository) Delete(_ context.Context, user_id uuid.UUID) error {
+	delete(m.credentials, user_id)
+	return nil
+}
+
+// MockMfaStatusRecorder はテスト用の二要素認証の状態記録のモックです
+type MockMfaStatusRecorder struct {
+	EnabledPublicID  uuid.UUID
+	DisabledPublicID uuid.UUID
+}
+
+// NewMockMfaStatusRecorder は新しいMockMfaStatusRecorderを作成します
+func NewMockMfaStatusRecorder() *MockMfaStatusRecorder {
+	return &MockMfaStatusRecorder{
+		EnabledPublicID:  uuid.Nil,
+		DisabledPublicID: uuid.Nil,
+	}
+}
+
+// EnableMfa はモックの二要素認証の有効化を記録します
+func (m *MockMfaStatusRecorder) EnableMfa(_ context.Context, public_id uuid.UUID, _ time.Time) error {
+	m.EnabledPublicID = public_id
+	return nil
+}
+
+// DisableMfa はモックの二要素認証の無効化を記録します
+func (m *MockMfaStatusRecorder) DisableMfa(_ context.Context, public_id uuid.UUID) error {
+	m.DisabledPublicID = public_id
+	return nil
+}
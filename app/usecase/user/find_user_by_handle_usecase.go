@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// UserByHandleResult はハンドルでのユーザー検索の結果です
+type UserByHandleResult struct {
+	User *models.User
+	// Handle はユーザーの現在のハンドルです
+	Handle *models.UserHandle
+	// Profile は公開プロフィールです（未作成の場合はnil、閲覧できない非公開アカウントの場合は制限されたプロフィール）
+	Profile *models.Profile
+	// Redirected は変更前のハンドルで検索され、現在のハンドルにリダイレクトしたかを表します
+	Redirected bool
+}
+
+// FindUserByHandleUseCase はハンドルでユーザーと公開プロフィールを検索するユースケースです
+type FindUserByHandleUseCase struct {
+	user_finder   VisibleUserFinderInterface
+	handle_finder UserHandleFinderInterface
+	profile_repo  ProfileFinderInterface
+	privacy_guard *PrivacyGuard
+}
+
+// NewFindUserByHandleUseCase は新しいFindUserByHandleUseCaseを作成します
+func NewFindUserByHandleUseCase(
+	user_finder VisibleUserFinderInterface,
+	handle_finder UserHandleFinderInterface,
+	profile_repo ProfileFinderInterface,
+	privacy_guard *PrivacyGuard,
+) *FindUserByHandleUseCase {
+	return &FindUserByHandleUseCase{
+		user_finder:   user_finder,
+		handle_finder: handle_finder,
+		profile_repo:  profile_repo,
+		privacy_guard: privacy_guard,
+	}
+}
+
+// Execute は大文字・小文字を区別せずにハンドルでユーザーを検索します
+// 変更前のハンドルはリダイレクト期間中のみ現在のハンドルのユーザーを返します
+// 不正なハンドル・存在しないハンドル・リダイレクト期間が過ぎたハンドル・削除済みのユーザー・viewerとの間にブロックがあるユーザーの場合はErrUserNotFoundを返します
+// viewerはログイン中のユーザーです（未ログインの場合はnil）
+func (uc *FindUserByHandleUseCase) Execute(ctx context.Context, viewer *models.User, handle_str string) (*UserByHandleResult, error) {
+	var handle models.Handle
+	var user_handle *models.UserHandle
+	var user *models.User
+	var profile *models.Profile
+	var redirected bool
+	var err error
+
+	handle, err = models.NewHandle(handle_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, handle_str)
+	}
+	user_handle, err = uc.handle_finder.FindByHandle(ctx, handle)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user_handle == nil || (!user_handle.IsCurrent() && !user_handle.IsRedirectActive(time.Now())) {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, handle.Value())
+	}
+	user, err = uc.user_finder.FindVisibleByPublicID(ctx, user_handle.UserID(), viewer_public_id(viewer))
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, handle.Value())
+	}
+	if !user_handle.IsCurrent() {
+		redirected = true
+		user_handle, err = uc.handle_finder.FindCurrentByUserID(ctx, user.PublicID())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if user_handle == nil {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, handle.Value())
+		}
+	}
+	profile, err = uc.profile_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil && !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if profile != nil {
+		profile, err = restrict_profile(ctx, uc.privacy_guard, viewer, profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &UserByHandleResult{
+		User:       user,
+		Handle:     user_handle,
+		Profile:    profile,
+		Redirected: redirected,
+	}, nil
+}
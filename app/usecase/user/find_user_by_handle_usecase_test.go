@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestFindUserByHandleUseCase_Execute はハンドルでの検索と変更前のハンドルからのリダイレクトをテストします
+func TestFindUserByHandleUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_store *MockRegisteredUserStore
+	var handle_repo *MockUserHandleRepository
+	var profile_repo *MockProfileRepository
+	var use_case *FindUserByHandleUseCase
+	var retired_at time.Time
+	var expired_at time.Time
+	var display_name string
+	var result *UserByHandleResult
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	user_store = NewMockRegisteredUserStore()
+	_ = user_store.Save(ctx, user)
+	handle_repo = NewMockUserHandleRepository()
+	retired_at = time.Now().Add(-time.Hour)
+	expired_at = time.Now().Add(-models.HandleRedirectPeriod - time.Hour)
+	handle_repo.Add(user.PublicID(), "Taro", time.Now(), nil)
+	handle_repo.Add(user.PublicID(), "old_taro", time.Now(), &retired_at)
+	handle_repo.Add(user.PublicID(), "older_taro", time.Now(), &expired_at)
+	handle_repo.Add(uuid.New(), "ghost", time.Now(), nil)
+	profile_repo = NewMockProfileRepository()
+	use_case = NewFindUserByHandleUseCase(
+		user_store,
+		handle_repo,
+		profile_repo,
+		create_test_privacy_guard(NewMockPrivacySettingsStore(), NewMockFollowRepository()),
+	)
+
+	result, err = use_case.Execute(ctx, nil, "taro")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.User.PublicID() != user.PublicID() || result.Handle.Handle().Value() != "Taro" || result.Redirected {
+		t.Errorf("expected current handle Taro without redirect, got %s, %v", result.Handle.Handle().Value(), result.Redirected)
+	}
+	if result.Profile != nil {
+		t.Error("expected profile to be nil when not created")
+	}
+
+	display_name = "taro"
+	_, _ = NewCreateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{DisplayName: &display_name})
+	result, err = use_case.Execute(ctx, nil, "@OLD_TARO")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.Redirected || result.Handle.Handle().Value() != "Taro" || result.Profile == nil {
+		t.Errorf("expected redirect to current handle Taro with profile, got %v", result)
+	}
+
+	for _, handle := range []string{"a.b", "nobody", "older_taro", "ghost"} {
+		_, err = use_case.Execute(ctx, nil, handle)
+		if !errors.Is(err, domain_errors.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound for %s, got %v", handle, err)
+		}
+	}
+}
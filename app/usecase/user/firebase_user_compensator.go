@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FirebaseUserDeleterInterface はFirebaseユーザーを削除するインターフェースです
+// 補償処理の再試行で繰り返し呼び出すため、既に存在しないユーザーの削除は成功として扱う必要があります
+type FirebaseUserDeleterInterface interface {
+	DeleteUser(ctx context.Context, firebase_uid string) error
+}
+
+// FirebaseUserCompensationInterface は補償処理で実行するFirebaseユーザーの操作のインターフェースです
+// 補償処理の再試行で繰り返し呼び出すため、いずれの操作も既に存在しないユーザーに対しては成功として扱う必要があります
+type FirebaseUserCompensationInterface interface {
+	FirebaseUserDeleterInterface
+	FirebaseUserEnablerInterface
+	FirebaseEmailUpdaterInterface
+}
+
+// CompensationTaskRepositoryInterface は失敗した補償処理を永続化するインターフェースです
+type CompensationTaskRepositoryInterface interface {
+	Save(ctx context.Context, task *models.CompensationTask) error
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*models.CompensationTask, error)
+	Update(ctx context.Context, task *models.CompensationTask) error
+	Delete(ctx context.Context, task_id uuid.UUID) error
+}
+
+// FirebaseUserCompensator はDBと整合しないFirebaseユーザーを削除・有効化し直し、メールアドレスを戻す補償処理を実行します
+// 失敗した場合は補償処理として記録し、RetryCompensationsUseCaseで後から再試行します
+type FirebaseUserCompensator struct {
+	firebase_repo FirebaseUserCompensationInterface
+	task_repo     CompensationTaskRepositoryInterface
+}
+
+// NewFirebaseUserCompensator は新しいFirebaseUserCompensatorを作成します
+func NewFirebaseUserCompensator(firebase_repo FirebaseUserCompensationInterface, task_repo CompensationTaskRepositoryInterface) *FirebaseUserCompensator {
+	return &FirebaseUserCompensator{
+		firebase_repo: firebase_repo,
+		task_repo:     task_repo,
+	}
+}
+
+// DeleteFirebaseUser はFirebaseユーザーを削除し、失敗した場合は再試行用に補償処理を記録します
+// リクエストがキャンセルされても記録できるよう、キャンセルを引き継がないcontextで実行します
+// 削除と記録の両方に失敗した場合のみエラーを返します
+func (c *FirebaseUserCompensator) DeleteFirebaseUser(ctx context.Context, firebase_uid string) error {
+	ctx = context.WithoutCancel(ctx)
+	return c.record_on_failure(ctx, models.CompensationKindDeleteFirebaseUser, firebase_uid, c.firebase_repo.DeleteUser(ctx, firebase_uid))
+}
+
+// EnableFirebaseUser は無効化したFirebaseユーザーを有効化し直し、失敗した場合は再試行用に補償処理を記録します
+// 有効化と記録の両方に失敗した場合のみエラーを返します
+func (c *FirebaseUserCompensator) EnableFirebaseUser(ctx context.Context, firebase_uid string) error {
+	ctx = context.WithoutCancel(ctx)
+	return c.record_on_failure(ctx, models.CompensationKindEnableFirebaseUser, firebase_uid, c.firebase_repo.EnableUser(ctx, firebase_uid))
+}
+
+// SyncFirebaseEmail はFirebaseのメールアドレスをusersテーブルのメールアドレスに戻し、失敗した場合は再試行用に補償処理を記録します
+// 再試行ではその時点のusersテーブルのメールアドレスに合わせるため、記録するのはFirebase UIDのみです
+// 更新と記録の両方に失敗した場合のみエラーを返します
+func (c *FirebaseUserCompensator) SyncFirebaseEmail(ctx context.Context, firebase_uid string, email models.Email, email_verified bool) error {
+	ctx = context.WithoutCancel(ctx)
+	return c.record_on_failure(
+		ctx, models.CompensationKindSyncFirebaseEmail, firebase_uid, c.firebase_repo.UpdateEmail(ctx, firebase_uid, email, email_verified),
+	)
+}
+
+// record_on_failure は操作が失敗した場合に補償処理を記録します
+func (c *FirebaseUserCompensator) record_on_failure(ctx context.Context, kind models.CompensationKind, firebase_uid string, cause error) error {
+	var task *models.CompensationTask
+	var err error
+
+	if cause == nil {
+		return nil
+	}
+	task, err = models.NewCompensationTask(kind, firebase_uid, cause.Error(), time.Now())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = c.task_repo.Save(ctx, task)
+	if err != nil {
+		return fmt.Errorf("%w: %w", cause, err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestFirebaseUserCompensator_DeleteFirebaseUser_Success は削除に成功した場合に補償処理が記録されないことをテストします
+func TestFirebaseUserCompensator_DeleteFirebaseUser_Success(t *testing.T) {
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var err error
+
+	mock_firebase = NewMockFirebaseUserRepository()
+	task_repo = NewMockCompensationTaskRepository()
+	err = NewFirebaseUserCompensator(mock_firebase, task_repo).DeleteFirebaseUser(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !mock_firebase.DeleteUserCalled {
+		t.Error("expected DeleteUser to be called")
+	}
+	if len(task_repo.tasks) != 0 {
+		t.Errorf("expected no compensation task, got %d", len(task_repo.tasks))
+	}
+}
+
+// TestFirebaseUserCompensator_DeleteFirebaseUser_RecordsTask は削除に失敗した場合に再試行用の補償処理が記録されることをテストします
+func TestFirebaseUserCompensator_DeleteFirebaseUser_RecordsTask(t *testing.T) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var task_repo *MockCompensationTaskRepository
+	var err error
+
+	// リクエストがキャンセルされていても記録できることを確認する
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	task_repo = NewMockCompensationTaskRepository()
+	err = NewFirebaseUserCompensator(NewMockFirebaseUserRepositoryWithDeleteError(), task_repo).DeleteFirebaseUser(ctx, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(task_repo.tasks) != 1 {
+		t.Fatalf("expected 1 compensation task, got %d", len(task_repo.tasks))
+	}
+	for _, task := range task_repo.tasks {
+		if task.Target() != testFirebaseUID || task.LastError() == "" {
+			t.Errorf("expected task for %s with last_error, got %s (%s)", testFirebaseUID, task.Target(), task.LastError())
+		}
+	}
+}
+
+// TestFirebaseUserCompensator_DeleteFirebaseUser_RecordFailed は削除と記録の両方に失敗した場合にエラーを返すことをテストします
+func TestFirebaseUserCompensator_DeleteFirebaseUser_RecordFailed(t *testing.T) {
+	var err error
+
+	err = NewFirebaseUserCompensator(NewMockFirebaseUserRepositoryWithDeleteError(), NewMockCompensationTaskRepositoryWithError()).
+		DeleteFirebaseUser(context.Background(), testFirebaseUID)
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) || !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrFirebaseAuthFailed and ErrDatabaseError, got %v", err)
+	}
+}
+
+// TestFirebaseUserCompensator_SyncFirebaseEmail はメールアドレスを戻すのに失敗した場合にFirebase UIDを対象とする補償処理が記録されることをテストします
+func TestFirebaseUserCompensator_SyncFirebaseEmail(t *testing.T) {
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var email models.Email
+	var err error
+
+	mock_firebase = NewMockFirebaseUserRepository()
+	mock_firebase.should_return_update_error = true
+	task_repo = NewMockCompensationTaskRepository()
+	email, _ = models.NewEmail(testEmail)
+	err = NewFirebaseUserCompensator(mock_firebase, task_repo).SyncFirebaseEmail(context.Background(), testFirebaseUID, email, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(task_repo.tasks) != 1 {
+		t.Fatalf("expected 1 compensation task, got %d", len(task_repo.tasks))
+	}
+	for _, task := range task_repo.tasks {
+		if task.Kind() != models.CompensationKindSyncFirebaseEmail || task.Target() != testFirebaseUID {
+			t.Errorf("expected sync_firebase_email task for %s, got %s (%s)", testFirebaseUID, task.Kind(), task.Target())
+		}
+	}
+}
+
+// MockCompensationTaskRepository はテスト用のインメモリな補償処理リポジトリです
+type MockCompensationTaskRepository struct {
+	tasks               map[uuid.UUID]*models.CompensationTask
+	should_return_error bool
+}
+
+// NewMockCompensationTaskRepository は新しいMockCompensationTaskRepositoryを作成します
+func NewMockCompensationTaskRepository() *MockCompensationTaskRepository {
+	return &MockCompensationTaskRepository{
+		tasks:               map[uuid.UUID]*models.CompensationTask{},
+		should_return_error: false,
+	}
+}
+
+// NewMockCompensationTaskRepositoryWithError はDBエラーを返すモックを作成します
+func NewMockCompensationTaskRepositoryWithError() *MockCompensationTaskRepository {
+	var repo *MockCompensationTaskRepository
+
+	repo = NewMockCompensationTaskRepository()
+	repo.should_return_error = true
+	return repo
+}
+
+// Save はモックの補償処理の保存を行います
+func (m *MockCompensationTaskRepository) Save(_ context.Context, task *models.CompensationTask) error {
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	m.tasks[task.TaskID()] = task
+	return nil
+}
+
+// FindDue はモックの再試行時刻を過ぎた再試行待ちの補償処理の検索を行います
+func (m *MockCompensationTaskRepository) FindDue(_ context.Context, now time.Time, limit int) ([]*models.CompensationTask, error) {
+	var tasks []*models.CompensationTask
+
+	if m.should_return_error {
+		return nil, domain_errors.ErrDatabaseError
+	}
+	for _, task := range m.tasks {
+		if task.IsExhausted() || task.NextAttemptAt().After(now) || len(tasks) >= limit {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// Update はモックの補償処理の更新を行います
+func (m *MockCompensationTaskRepository) Update(_ context.Context, task *models.CompensationTask) error {
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	m.tasks[task.TaskID()] = task
+	return nil
+}
+
+// Delete はモックの補償処理の削除を行います
+func (m *MockCompensationTaskRepository) Delete(_ context.Context, task_id uuid.UUID) error {
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	delete(m.tasks, task_id)
+	return nil
+}
@@ -0,0 +1,148 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FollowRepositoryInterface はフォロー関係の保存・削除とフォロワー数・フォロー数の取得のインターフェースです
+type FollowRepositoryInterface interface {
+	// Create は既にフォローしている場合はfalseを返します
+	Create(ctx context.Context, follow *models.Follow) (bool, error)
+	// Delete はフォローしていない場合はfalseを返します
+	Delete(ctx context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error)
+	Exists(ctx context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error)
+	CountsByUserID(ctx context.Context, user_id uuid.UUID) (models.FollowCounts, error)
+}
+
+// FollowRequestCreatorInterface は非公開アカウントへのフォローリクエストを保存するインターフェースです
+type FollowRequestCreatorInterface interface {
+	// Create は既にリクエストしている場合はfalseを返します
+	Create(ctx context.Context, request *models.FollowRequest) (bool, error)
+}
+
+// FollowStatus はフォロー・フォロー解除後の対象ユーザーとのフォロー状態です
+type FollowStatus struct {
+	// UserID は対象ユーザーの公開IDです
+	UserID uuid.UUID
+	// Following はログイン中のユーザーが対象ユーザーをフォローしているかを表します
+	Following bool
+	// Requested は非公開アカウントへのフォローリクエストが承認待ちかを表します
+	Requested bool
+	// Counts は対象ユーザーのフォロワー数・フォロー数です
+	Counts models.FollowCounts
+}
+
+// FollowUserUseCase はログイン中のユーザーが他のユーザーをフォローするユースケースです
+// 非公開アカウントの場合はフォローせず、フォローリクエストを送信します
+type FollowUserUseCase struct {
+	user_finder         VisibleUserFinderInterface
+	follow_repo         FollowRepositoryInterface
+	follow_request_repo FollowRequestCreatorInterface
+	privacy_guard       *PrivacyGuard
+}
+
+// NewFollowUserUseCase は新しいFollowUserUseCaseを作成します
+func NewFollowUserUseCase(
+	user_finder VisibleUserFinderInterface,
+	follow_repo FollowRepositoryInterface,
+	follow_request_repo FollowRequestCreatorInterface,
+	privacy_guard *PrivacyGuard,
+) *FollowUserUseCase {
+	return &FollowUserUseCase{
+		user_finder:         user_finder,
+		follow_repo:         follow_repo,
+		follow_request_repo: follow_request_repo,
+		privacy_guard:       privacy_guard,
+	}
+}
+
+// Execute は公開IDで指定したユーザーをフォローします
+// 既にフォローしている場合は何も変更せず現在の状態を返します
+// 非公開アカウントの場合はフォローリクエストを送信し、承認待ち（Requested）の状態を返します（リクエスト済みの場合も同じ状態を返します）
+// 自分自身の場合はErrCannotFollowSelf、削除済み・利用停止中のユーザーの場合はErrFollowTargetUnavailable、
+// 不正な公開ID・存在しないユーザー・どちらかがブロックしているユーザーの場合はErrUserNotFoundを返します
+func (uc *FollowUserUseCase) Execute(ctx context.Context, user *models.User, followee_id_str string) (*FollowStatus, error) {
+	var followee_id uuid.UUID
+	var followee *models.User
+	var settings models.PrivacySettings
+	var relation models.ViewerRelation
+	var follow *models.Follow
+	var err error
+
+	followee_id, err = uuid.Parse(followee_id_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, followee_id_str)
+	}
+	if followee_id == user.PublicID() {
+		return nil, domain_errors.ErrCannotFollowSelf
+	}
+	followee, err = uc.user_finder.FindVisibleByPublicID(ctx, followee_id, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if followee.IsDeleted() || followee.IsBanned() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrFollowTargetUnavailable, followee_id_str)
+	}
+	settings, relation, err = uc.privacy_guard.Resolve(ctx, user, followee_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if settings.IsPrivate() && relation != models.ViewerRelationFollower {
+		return uc.request_follow(ctx, user, followee_id)
+	}
+	follow, err = models.NewFollow(user.PublicID(), followee_id, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrCannotFollowSelf, err)
+	}
+	_, err = uc.follow_repo.Create(ctx, follow)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return build_follow_status(ctx, uc.follow_repo, followee_id, true)
+}
+
+// request_follow は非公開アカウントへのフォローリクエストを送信し、承認待ちの状態を返します
+func (uc *FollowUserUseCase) request_follow(ctx context.Context, user *models.User, followee_id uuid.UUID) (*FollowStatus, error) {
+	var request *models.FollowRequest
+	var status *FollowStatus
+	var err error
+
+	request, err = models.NewFollowRequest(user.PublicID(), followee_id, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrCannotFollowSelf, err)
+	}
+	_, err = uc.follow_request_repo.Create(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	status, err = build_follow_status(ctx, uc.follow_repo, followee_id, false)
+	if err != nil {
+		return nil, err
+	}
+	status.Requested = true
+	return status, nil
+}
+
+// build_follow_status は対象ユーザーの最新のフォロワー数・フォロー数を含むフォロー状態を作成します
+func build_follow_status(ctx context.Context, follow_repo FollowRepositoryInterface, user_id uuid.UUID, following bool) (*FollowStatus, error) {
+	var counts models.FollowCounts
+	var err error
+
+	counts, err = follow_repo.CountsByUserID(ctx, user_id)
+	if err != nil && !errors.Is(err, domain_errors.ErrUserNotFound) {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &FollowStatus{
+		UserID:    user_id,
+		Following: following,
+		Counts:    counts,
+	}, nil
+}
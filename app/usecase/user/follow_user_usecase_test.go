@@ -0,0 +1,341 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockFollowRepository はテスト用のインメモリなフォロー関係のリポジトリです
+type MockFollowRepository struct {
+	follows []*models.Follow
+}
+
+// NewMockFollowRepository は新しいMockFollowRepositoryを作成します
+func NewMockFollowRepository() *MockFollowRepository {
+	return &MockFollowRepository{follows: []*models.Follow{}}
+}
+
+// Create はフォロー関係を保存します（フォロー済みの場合はfalseを返します）
+func (m *MockFollowRepository) Create(ctx context.Context, follow *models.Follow) (bool, error) {
+	var exists bool
+
+	exists, _ = m.Exists(ctx, follow.FollowerID(), follow.FolloweeID())
+	if exists {
+		return false, nil
+	}
+	m.follows = append(m.follows, follow)
+	return true, nil
+}
+
+// Delete はフォロー関係を削除します（フォローしていない場合はfalseを返します）
+func (m *MockFollowRepository) Delete(_ context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	for i, follow := range m.follows {
+		if follow.FollowerID() == follower_id && follow.FolloweeID() == followee_id {
+			m.follows = append(m.follows[:i], m.follows[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Exists はフォローしているかを返します
+func (m *MockFollowRepository) Exists(_ context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error) {
+	for _, follow := range m.follows {
+		if follow.FollowerID() == follower_id && follow.FolloweeID() == followee_id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CountsByUserID は保持しているフォロー関係からフォロワー数・フォロー数を数えます
+func (m *MockFollowRepository) CountsByUserID(_ context.Context, user_id uuid.UUID) (models.FollowCounts, error) {
+	var followers int
+	var following int
+
+	for _, follow := range m.follows {
+		if follow.FolloweeID() == user_id {
+			followers++
+		}
+		if follow.FollowerID() == user_id {
+			following++
+		}
+	}
+	return models.NewFollowCounts(followers, following), nil
+}
+
+// ListFollowers はフォロワーを先頭からfirst件返します（閲覧するユーザー・カーソルは使用しません）
+func (m *MockFollowRepository) ListFollowers(_ context.Context, user_id uuid.UUID, _ uuid.UUID, first int, _ string) (*models.FollowPage, error) {
+	return m.list(first, func(follow *models.Follow) bool { return follow.FolloweeID() == user_id }), nil
+}
+
+// ListFollowing はフォローしているユーザーを先頭からfirst件返します（閲覧するユーザー・カーソルは使用しません）
+func (m *MockFollowRepository) ListFollowing(_ context.Context, user_id uuid.UUID, _ uuid.UUID, first int, _ string) (*models.FollowPage, error) {
+	return m.list(first, func(follow *models.Follow) bool { return follow.FollowerID() == user_id }), nil
+}
+
+// list は条件に一致するフォロー関係を先頭からfirst件返します
+func (m *MockFollowRepository) list(first int, matches func(*models.Follow) bool) *models.FollowPage {
+	var follows []*models.Follow
+
+	for _, follow := range m.follows {
+		if matches(follow) {
+			follows = append(follows, follow)
+		}
+	}
+	if len(follows) > first {
+		return models.NewFollowPage(follows[:first], "next", true)
+	}
+	return models.NewFollowPage(follows, "", false)
+}
+
+// MockFollowRequestRepository はテスト用のインメモリなフォローリクエストのリポジトリです
+// 承認したリクエストはfollow_repoのフォロー関係に追加します
+type MockFollowRequestRepository struct {
+	requests    []*models.FollowRequest
+	follow_repo *MockFollowRepository
+}
+
+// NewMockFollowRequestRepository は新しいMockFollowRequestRepositoryを作成します
+func NewMockFollowRequestRepository(follow_repo *MockFollowRepository) *MockFollowRequestRepository {
+	return &MockFollowRequestRepository{requests: []*models.FollowRequest{}, follow_repo: follow_repo}
+}
+
+// Create はフォローリクエストを保存します（リクエスト済みの場合はfalseを返します）
+func (m *MockFollowRequestRepository) Create(_ context.Context, request *models.FollowRequest) (bool, error) {
+	if m.find(request.RequesterID(), request.TargetID()) >= 0 {
+		return false, nil
+	}
+	m.requests = append(m.requests, request)
+	return true, nil
+}
+
+// Delete はフォローリクエストを削除します（リクエストが存在しない場合はfalseを返します）
+func (m *MockFollowRequestRepository) Delete(_ context.Context, requester_id uuid.UUID, target_id uuid.UUID) (bool, error) {
+	var index int
+
+	index = m.find(requester_id, target_id)
+	if index < 0 {
+		return false, nil
+	}
+	m.requests = append(m.requests[:index], m.requests[index+1:]...)
+	return true, nil
+}
+
+// Approve はフォローリクエストを削除し、フォロー関係を追加します
+func (m *MockFollowRequestRepository) Approve(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID, approved_at time.Time) (bool, error) {
+	var deleted bool
+	var follow *models.Follow
+
+	deleted, _ = m.Delete(ctx, requester_id, target_id)
+	if !deleted {
+		return false, nil
+	}
+	follow, _ = models.NewFollow(requester_id, target_id, approved_at)
+	_, _ = m.follow_repo.Create(ctx, follow)
+	return true, nil
+}
+
+// ApproveAll は受信したフォローリクエストを全て承認します
+func (m *MockFollowRequestRepository) ApproveAll(ctx context.Context, target_id uuid.UUID, approved_at time.Time) (int, error) {
+	var approved_count int
+
+	for _, request := range slices.Clone(m.requests) {
+		if request.TargetID() != target_id {
+			continue
+		}
+		_, _ = m.Approve(ctx, request.RequesterID(), target_id, approved_at)
+		approved_count++
+	}
+	return approved_count, nil
+}
+
+// ListReceived は受信したフォローリクエストを先頭からfirst件返します（カーソルは使用しません）
+func (m *MockFollowRequestRepository) ListReceived(_ context.Context, target_id uuid.UUID, first int, _ string) (*models.FollowRequestPage, error) {
+	var requests []*models.FollowRequest
+
+	for _, request := range m.requests {
+		if request.TargetID() == target_id {
+			requests = append(requests, request)
+		}
+	}
+	if len(requests) > first {
+		return models.NewFollowRequestPage(requests[:first], "next", true), nil
+	}
+	return models.NewFollowRequestPage(requests, "", false), nil
+}
+
+// find はフォローリクエストの位置を返します（存在しない場合は-1）
+func (m *MockFollowRequestRepository) find(requester_id uuid.UUID, target_id uuid.UUID) int {
+	return slices.IndexFunc(m.requests, func(request *models.FollowRequest) bool {
+		return request.RequesterID() == requester_id && request.TargetID() == target_id
+	})
+}
+
+// create_test_follow_user_usecase はテスト用のFollowUserUseCaseを作成します
+func create_test_follow_user_usecase(
+	user_store *MockRegisteredUserStore,
+	follow_repo *MockFollowRepository,
+	follow_request_repo *MockFollowRequestRepository,
+	settings_store *MockPrivacySettingsStore,
+) *FollowUserUseCase {
+	return NewFollowUserUseCase(
+		user_store,
+		follow_repo,
+		follow_request_repo,
+		create_test_privacy_guard(settings_store, follow_repo),
+	)
+}
+
+// create_test_user_with_status は削除済み・利用停止中の状態を指定してテスト用のユーザーを作成します
+func create_test_user_with_status(t *testing.T, deleted_at *time.Time, banned_at *time.Time) *models.User {
+	var email models.Email
+	var now time.Time
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, models.RoleUser, nil, nil, now, now, deleted_at, banned_at)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestFollowUserUseCase_Execute はフォローの冪等性とフォロワー数をテストします
+func TestFollowUserUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var followee *models.User
+	var user_store *MockRegisteredUserStore
+	var follow_repo *MockFollowRepository
+	var use_case *FollowUserUseCase
+	var status *FollowStatus
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	followee = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[followee.PublicID()] = followee
+	follow_repo = NewMockFollowRepository()
+	use_case = create_test_follow_user_usecase(
+		user_store, follow_repo, NewMockFollowRequestRepository(follow_repo), NewMockPrivacySettingsStore(),
+	)
+
+	for range 2 {
+		status, err = use_case.Execute(ctx, user, followee.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !status.Following || status.UserID != followee.PublicID() || status.Counts.Followers() != 1 {
+			t.Errorf("expected following with 1 follower, got following=%v followers=%d", status.Following, status.Counts.Followers())
+		}
+	}
+}
+
+// TestFollowUserUseCase_Execute_PrivateAccount は非公開アカウントへのフォローがリクエストになり、承認後はフォロー済みになることをテストします
+func TestFollowUserUseCase_Execute_PrivateAccount(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var followee *models.User
+	var user_store *MockRegisteredUserStore
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var settings_store *MockPrivacySettingsStore
+	var use_case *FollowUserUseCase
+	var status *FollowStatus
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	followee = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[followee.PublicID()] = followee
+	follow_repo = NewMockFollowRepository()
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	settings_store = NewMockPrivacySettingsStore()
+	settings_store.set_private(followee.PublicID())
+	use_case = create_test_follow_user_usecase(user_store, follow_repo, follow_request_repo, settings_store)
+
+	for range 2 {
+		status, err = use_case.Execute(ctx, user, followee.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if status.Following || !status.Requested || status.Counts.Followers() != 0 {
+			t.Errorf("expected pending request, got following=%v requested=%v followers=%d", status.Following, status.Requested, status.Counts.Followers())
+		}
+	}
+	if len(follow_request_repo.requests) != 1 {
+		t.Fatalf("expected 1 follow request, got %d", len(follow_request_repo.requests))
+	}
+
+	_, _ = follow_request_repo.Approve(ctx, user.PublicID(), followee.PublicID(), time.Now())
+	status, err = use_case.Execute(ctx, user, followee.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !status.Following || status.Requested || len(follow_request_repo.requests) != 0 {
+		t.Errorf("expected approved follower not to request again, got following=%v requested=%v", status.Following, status.Requested)
+	}
+}
+
+// TestFollowUserUseCase_Execute_Rejected は自分自身・削除済み・利用停止中・ブロックしているユーザーのフォローをテストします
+func TestFollowUserUseCase_Execute_Rejected(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var now time.Time
+	var deleted_user *models.User
+	var banned_user *models.User
+	var blocking_user *models.User
+	var user_store *MockRegisteredUserStore
+	var use_case *FollowUserUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	now = time.Now()
+	deleted_user = create_test_user_with_status(t, &now, nil)
+	banned_user = create_test_user_with_status(t, nil, &now)
+	blocking_user = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[deleted_user.PublicID()] = deleted_user
+	user_store.users[banned_user.PublicID()] = banned_user
+	user_store.users[blocking_user.PublicID()] = blocking_user
+	user_store.add_block(blocking_user.PublicID(), user.PublicID())
+	use_case = create_test_follow_user_usecase(
+		user_store, NewMockFollowRepository(), NewMockFollowRequestRepository(nil), NewMockPrivacySettingsStore(),
+	)
+
+	_, err = use_case.Execute(ctx, user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotFollowSelf) {
+		t.Errorf("expected ErrCannotFollowSelf, got %v", err)
+	}
+	for _, target := range []*models.User{deleted_user, banned_user} {
+		_, err = use_case.Execute(ctx, user, target.PublicID().String())
+		if !errors.Is(err, domain_errors.ErrFollowTargetUnavailable) {
+			t.Errorf("expected ErrFollowTargetUnavailable, got %v", err)
+		}
+	}
+	// ブロックしている・されているユーザーは存在しないユーザーとして扱う
+	for _, target := range []string{"invalid", uuid.New().String(), blocking_user.PublicID().String()} {
+		_, err = use_case.Execute(ctx, user, target)
+		if !errors.Is(err, domain_errors.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound for %s, got %v", target, err)
+		}
+	}
+}
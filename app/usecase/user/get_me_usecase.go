@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// GetMeUseCase はログイン中のユーザー自身の情報を取得するユースケースです
+type GetMeUseCase struct {
+	user_finder     RegisteredUserFinderInterface
+	summary_builder *UserSummaryBuilder
+}
+
+// NewGetMeUseCase は新しいGetMeUseCaseを作成します
+func NewGetMeUseCase(user_finder RegisteredUserFinderInterface, summary_builder *UserSummaryBuilder) *GetMeUseCase {
+	return &GetMeUseCase{
+		user_finder:     user_finder,
+		summary_builder: summary_builder,
+	}
+}
+
+// Execute はログイン中のユーザーの最新の情報と概要を返します
+// アクセストークンの発行後に変更されたメールアドレス・二要素認証の状態を反映するため、ユーザーを再取得します
+// 削除済みのユーザーの場合はErrUserNotFoundを返します
+func (uc *GetMeUseCase) Execute(ctx context.Context, user *models.User) (*models.Me, error) {
+	var current *models.User
+	var summary *models.UserSummary
+	var err error
+
+	current, err = uc.user_finder.FindByPublicID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if current.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, current.PublicID())
+	}
+	summary, err = uc.summary_builder.Build(ctx, current)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return models.NewMe(current, summary)
+}
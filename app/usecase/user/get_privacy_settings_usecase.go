@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"sleeve/domain/models"
+)
+
+// GetPrivacySettingsUseCase はログイン中のユーザーのプライバシー設定を取得するユースケースです
+type GetPrivacySettingsUseCase struct {
+	settings_finder PrivacySettingsFinderInterface
+}
+
+// NewGetPrivacySettingsUseCase は新しいGetPrivacySettingsUseCaseを作成します
+func NewGetPrivacySettingsUseCase(settings_finder PrivacySettingsFinderInterface) *GetPrivacySettingsUseCase {
+	return &GetPrivacySettingsUseCase{
+		settings_finder: settings_finder,
+	}
+}
+
+// Execute はログイン中のユーザーのプライバシー設定を返します
+func (uc *GetPrivacySettingsUseCase) Execute(ctx context.Context, user *models.User) (models.PrivacySettings, error) {
+	var settings models.PrivacySettings
+	var err error
+
+	settings, err = uc.settings_finder.FindPrivacySettings(ctx, user.PublicID())
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w", err)
+	}
+	return settings, nil
+}
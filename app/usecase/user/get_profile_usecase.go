@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// ProfileFinderInterface はユーザーのプロフィールを検索するインターフェースです
+type ProfileFinderInterface interface {
+	FindByUserID(ctx context.Context, user_id uuid.UUID) (*models.Profile, error)
+}
+
+// GetProfileUseCase は公開IDで指定したユーザーの公開プロフィールを取得するユースケースです
+type GetProfileUseCase struct {
+	user_finder   VisibleUserFinderInterface
+	profile_repo  ProfileFinderInterface
+	privacy_guard *PrivacyGuard
+}
+
+// NewGetProfileUseCase は新しいGetProfileUseCaseを作成します
+func NewGetProfileUseCase(
+	user_finder VisibleUserFinderInterface,
+	profile_repo ProfileFinderInterface,
+	privacy_guard *PrivacyGuard,
+) *GetProfileUseCase {
+	return &GetProfileUseCase{
+		user_finder:   user_finder,
+		profile_repo:  profile_repo,
+		privacy_guard: privacy_guard,
+	}
+}
+
+// Execute はユーザーのプロフィールを返します
+// 不正な公開ID・存在しないユーザー・削除済みのユーザー・viewerとの間にブロックがあるユーザー・プロフィールが未作成の場合はErrProfileNotFoundを返します
+// viewerはログイン中のユーザーです（未ログインの場合はnil）
+// 非公開アカウントの場合、本人と承認されたフォロワー以外には表示名・自己紹介・アイコンのみの制限されたプロフィールを返します
+func (uc *GetProfileUseCase) Execute(ctx context.Context, viewer *models.User, public_id_str string) (*models.Profile, error) {
+	var public_id uuid.UUID
+	var user *models.User
+	var profile *models.Profile
+	var err error
+
+	public_id, err = uuid.Parse(public_id_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, public_id_str)
+	}
+	user, err = uc.user_finder.FindVisibleByPublicID(ctx, public_id, viewer_public_id(viewer))
+	if err != nil {
+		if errors.Is(err, domain_errors.ErrUserNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, public_id_str)
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrProfileNotFound, public_id_str)
+	}
+	profile, err = uc.profile_repo.FindByUserID(ctx, public_id)
+	if err != nil {
+		return nil, err
+	}
+	return restrict_profile(ctx, uc.privacy_guard, viewer, profile)
+}
+
+// restrict_profile はviewerが非公開アカウントのコンテンツを閲覧できない場合に制限されたプロフィールを返します
+func restrict_profile(ctx context.Context, privacy_guard *PrivacyGuard, viewer *models.User, profile *models.Profile) (*models.Profile, error) {
+	var can_view bool
+	var err error
+
+	can_view, err = privacy_guard.CanViewContent(ctx, viewer, profile.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !can_view {
+		return profile.RestrictedView(), nil
+	}
+	return profile, nil
+}
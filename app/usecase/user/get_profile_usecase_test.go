@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestGetProfileUseCase_Execute は公開IDでのプロフィールの取得と、取得できないケースをテストします
+func TestGetProfileUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var deleted_user *models.User
+	var user_store *MockRegisteredUserStore
+	var profile_repo *MockProfileRepository
+	var settings_store *MockPrivacySettingsStore
+	var use_case *GetProfileUseCase
+	var display_name string
+	var deleted_at time.Time
+	var profile *models.Profile
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	deleted_at = time.Now()
+	deleted_user, _ = models.NewUserWithPublicID(
+		uuid.New(), "deleted_firebase_uid", user.Email(), models.RoleUser, nil, nil, deleted_at, deleted_at, &deleted_at, nil,
+	)
+	user_store = NewMockRegisteredUserStore()
+	_ = user_store.Save(ctx, user)
+	_ = user_store.Save(ctx, deleted_user)
+	profile_repo = NewMockProfileRepository()
+	display_name = "taro"
+	_, _ = NewCreateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{DisplayName: &display_name})
+	_, _ = NewCreateProfileUseCase(profile_repo).Execute(ctx, deleted_user, ProfileInput{DisplayName: &display_name})
+	settings_store = NewMockPrivacySettingsStore()
+	use_case = NewGetProfileUseCase(user_store, profile_repo, create_test_privacy_guard(settings_store, NewMockFollowRepository()))
+
+	profile, err = use_case.Execute(ctx, nil, user.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.DisplayName().Value() != display_name {
+		t.Errorf("expected display name %s, got %s", display_name, profile.DisplayName().Value())
+	}
+	if profile.IsRestricted() {
+		t.Error("expected public profile not to be restricted")
+	}
+	for _, public_id := range []string{"invalid", uuid.New().String(), deleted_user.PublicID().String()} {
+		_, err = use_case.Execute(ctx, nil, public_id)
+		if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+			t.Errorf("expected ErrProfileNotFound for %s, got %v", public_id, err)
+		}
+	}
+
+	// 非公開アカウントはフォロワー以外には制限されたプロフィールを返す
+	settings_store.set_private(user.PublicID())
+	profile, err = use_case.Execute(ctx, nil, user.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !profile.IsRestricted() || profile.DisplayName().Value() != display_name {
+		t.Errorf("expected restricted profile with display name, got restricted=%v", profile.IsRestricted())
+	}
+	profile, err = use_case.Execute(ctx, user, user.PublicID().String())
+	if err != nil || profile.IsRestricted() {
+		t.Errorf("expected owner to see full profile, got err=%v", err)
+	}
+
+	// ブロックされているユーザーには存在しないプロフィールとして扱う
+	user_store.add_block(user.PublicID(), deleted_user.PublicID())
+	_, err = use_case.Execute(ctx, deleted_user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for blocked viewer, got %v", err)
+	}
+}
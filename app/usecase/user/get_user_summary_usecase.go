@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// GetUserSummaryUseCase は公開IDで指定したユーザーの概要を取得するユースケースです
+type GetUserSummaryUseCase struct {
+	user_finder     VisibleUserFinderInterface
+	summary_builder *UserSummaryBuilder
+}
+
+// NewGetUserSummaryUseCase は新しいGetUserSummaryUseCaseを作成します
+func NewGetUserSummaryUseCase(user_finder VisibleUserFinderInterface, summary_builder *UserSummaryBuilder) *GetUserSummaryUseCase {
+	return &GetUserSummaryUseCase{
+		user_finder:     user_finder,
+		summary_builder: summary_builder,
+	}
+}
+
+// Execute はユーザーの概要を返します
+// 不正な公開ID・存在しないユーザー・削除済みのユーザー・viewerとの間にブロックがあるユーザーの場合はErrUserNotFoundを返します
+// viewerはログイン中のユーザーです（未ログインの場合はnil）
+func (uc *GetUserSummaryUseCase) Execute(ctx context.Context, viewer *models.User, public_id_str string) (*models.UserSummary, error) {
+	var public_id uuid.UUID
+	var user *models.User
+	var err error
+
+	public_id, err = uuid.Parse(public_id_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, public_id_str)
+	}
+	user, err = uc.user_finder.FindVisibleByPublicID(ctx, public_id, viewer_public_id(viewer))
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, public_id_str)
+	}
+	return uc.summary_builder.Build(ctx, user)
+}
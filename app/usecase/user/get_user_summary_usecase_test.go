@@ -0,0 +1,125 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestGetUserSummaryUseCase_Execute は公開IDでのユーザーの概要の取得と、取得できないケースをテストします
+func TestGetUserSummaryUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var follower *models.User
+	var deleted_user *models.User
+	var user_store *MockRegisteredUserStore
+	var handle_repo *MockUserHandleRepository
+	var profile_repo *MockProfileRepository
+	var follow_repo *MockFollowRepository
+	var use_case *GetUserSummaryUseCase
+	var display_name string
+	var deleted_at time.Time
+	var follow *models.Follow
+	var summary *models.UserSummary
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	follower = create_test_user(t)
+	deleted_at = time.Now()
+	deleted_user, _ = models.NewUserWithPublicID(
+		uuid.New(), "deleted_firebase_uid", user.Email(), models.RoleUser, nil, nil, deleted_at, deleted_at, &deleted_at, nil,
+	)
+	user_store = NewMockRegisteredUserStore()
+	_ = user_store.Save(ctx, user)
+	_ = user_store.Save(ctx, follower)
+	_ = user_store.Save(ctx, deleted_user)
+	handle_repo = NewMockUserHandleRepository()
+	handle_repo.Add(user.PublicID(), "taro", time.Now(), nil)
+	profile_repo = NewMockProfileRepository()
+	display_name = "taro"
+	_, _ = NewCreateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{DisplayName: &display_name})
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(follower.PublicID(), user.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	use_case = NewGetUserSummaryUseCase(user_store, NewUserSummaryBuilder(handle_repo, profile_repo, follow_repo))
+
+	summary, err = use_case.Execute(ctx, nil, user.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.Handle() == nil || summary.Handle().Handle().Value() != "taro" {
+		t.Errorf("expected handle taro, got %v", summary.Handle())
+	}
+	if summary.Profile() == nil || summary.Profile().DisplayName().Value() != display_name {
+		t.Errorf("expected profile with display name %s, got %v", display_name, summary.Profile())
+	}
+	if summary.Counts().Followers() != 1 {
+		t.Errorf("expected 1 follower, got %d", summary.Counts().Followers())
+	}
+	if summary.Counts().Posts() != 0 || summary.Counts().Listings() != 0 || summary.Counts().Ratings() != 0 {
+		t.Errorf("expected no posts, listings and ratings, got %+v", summary.Counts())
+	}
+
+	// ハンドル・プロフィールが未設定のユーザーはnilのまま返す
+	summary, err = use_case.Execute(ctx, nil, follower.PublicID().String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.Handle() != nil || summary.Profile() != nil {
+		t.Errorf("expected no handle and profile, got %v and %v", summary.Handle(), summary.Profile())
+	}
+
+	for _, public_id := range []string{"invalid", uuid.New().String(), deleted_user.PublicID().String()} {
+		_, err = use_case.Execute(ctx, nil, public_id)
+		if !errors.Is(err, domain_errors.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound for %s, got %v", public_id, err)
+		}
+	}
+
+	// ブロックされているユーザーには存在しないユーザーとして扱う
+	user_store.add_block(user.PublicID(), follower.PublicID())
+	_, err = use_case.Execute(ctx, follower, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for blocked viewer, got %v", err)
+	}
+}
+
+// TestGetMeUseCase_Execute はログイン中のユーザーの最新の情報と概要の取得をテストします
+func TestGetMeUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_store *MockRegisteredUserStore
+	var use_case *GetMeUseCase
+	var me *models.Me
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	user_store = NewMockRegisteredUserStore()
+	_ = user_store.Save(ctx, user)
+	use_case = NewGetMeUseCase(
+		user_store,
+		NewUserSummaryBuilder(NewMockUserHandleRepository(), NewMockProfileRepository(), NewMockFollowRepository()),
+	)
+
+	me, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if me.User().PublicID() != user.PublicID() || me.Summary().UserID() != user.PublicID() {
+		t.Errorf("expected me of %s, got %s", user.PublicID(), me.User().PublicID())
+	}
+
+	// 保存されていないユーザー（削除済みで物理削除された場合など）はErrUserNotFound
+	_, err = use_case.Execute(ctx, create_test_user(t))
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// LinkProviderUseCase はログイン中のユーザーに外部プロバイダのアカウントを連携するユースケースです
+type LinkProviderUseCase struct {
+	token_verifier *ProviderTokenVerifier
+	identity_repo  UserIdentityRepositoryInterface
+}
+
+// NewLinkProviderUseCase は新しいLinkProviderUseCaseを作成します
+func NewLinkProviderUseCase(token_verifier *ProviderTokenVerifier, identity_repo UserIdentityRepositoryInterface) *LinkProviderUseCase {
+	return &LinkProviderUseCase{
+		token_verifier: token_verifier,
+		identity_repo:  identity_repo,
+	}
+}
+
+// Execute は外部プロバイダのIDトークンを検証し、ユーザーに連携します
+// 既に同じユーザーに連携済みの場合は既存の連携を返します
+func (uc *LinkProviderUseCase) Execute(ctx context.Context, user *models.User, provider_str string, id_token string) (*models.UserIdentity, error) {
+	var provider models.AuthProvider
+	var provider_identity *models.ProviderIdentity
+	var identity *models.UserIdentity
+	var err error
+
+	provider, err = parse_auth_provider(provider_str)
+	if err != nil {
+		return nil, err
+	}
+	provider_identity, err = uc.token_verifier.Verify(ctx, provider, id_token)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	// ネイティブプロバイダはクライアントがFirebaseでログイン中のアカウントに連携（linkWithCredential）してからトークンを送る
+	// 別のFirebaseユーザーのトークンは、その外部アカウントが別のアカウントで使われていることを意味する
+	if provider.IsFirebaseNative() && provider_identity.FirebaseUID() != user.FirebaseUID() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrProviderAlreadyLinked, provider)
+	}
+
+	identity, err = uc.identity_repo.FindByProviderSubject(ctx, provider, provider_identity.Subject())
+	if err == nil {
+		if identity.UserID() != user.PublicID() {
+			return nil, fmt.Errorf("%w: %s", domain_errors.ErrProviderAlreadyLinked, provider)
+		}
+		return identity, nil
+	}
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	identity, err = models.NewUserIdentity(user.PublicID(), provider, provider_identity.Subject(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	err = uc.identity_repo.Save(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return identity, nil
+}
@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestLinkProviderUseCase_Execute_Success はログイン中のユーザーに外部アカウントを連携するケースをテストします
+func TestLinkProviderUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var verifier *MockProviderTokenVerifier
+	var user *models.User
+	var identity *models.UserIdentity
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	verifier = NewMockProviderTokenVerifier(testFirebaseUID, testProviderSubject, testEmail)
+	identity, err = NewLinkProviderUseCase(NewProviderTokenVerifier(verifier, verifier), identity_repo).
+		Execute(ctx, user, "google", testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if identity.UserID() != user.PublicID() || identity.Provider() != models.AuthProviderGoogle {
+		t.Errorf("unexpected identity: user_id=%s provider=%s", identity.UserID(), identity.Provider())
+	}
+	// 同じ外部アカウントの再連携は既存の連携を返す
+	_, err = NewLinkProviderUseCase(NewProviderTokenVerifier(verifier, verifier), identity_repo).
+		Execute(ctx, user, "google", testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error for relinking, got %v", err)
+	}
+	if len(identity_repo.Identities) != 1 {
+		t.Errorf("expected 1 identity, got %d", len(identity_repo.Identities))
+	}
+}
+
+// TestLinkProviderUseCase_Execute_LinkedToOtherUser は別ユーザーに連携済みの外部アカウントを拒否するケースをテストします
+func TestLinkProviderUseCase_Execute_LinkedToOtherUser(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var verifier *MockProviderTokenVerifier
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	identity_repo.AddIdentity(t, uuid.New(), models.AuthProviderLINE, testProviderSubject)
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	verifier = NewMockProviderTokenVerifier("", testProviderSubject, "")
+	_, err = NewLinkProviderUseCase(NewProviderTokenVerifier(verifier, verifier), identity_repo).
+		Execute(ctx, user, "line", testIDToken)
+	if !errors.Is(err, domain_errors.ErrProviderAlreadyLinked) {
+		t.Errorf("expected ErrProviderAlreadyLinked, got %v", err)
+	}
+}
+
+// TestLinkProviderUseCase_Execute_OtherFirebaseUser は別のFirebaseユーザーのIDトークンを拒否するケースをテストします
+func TestLinkProviderUseCase_Execute_OtherFirebaseUser(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var verifier *MockProviderTokenVerifier
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	verifier = NewMockProviderTokenVerifier("other_firebase_uid", testProviderSubject, testEmail)
+	_, err = NewLinkProviderUseCase(NewProviderTokenVerifier(verifier, verifier), identity_repo).
+		Execute(ctx, user, "apple", testIDToken)
+	if !errors.Is(err, domain_errors.ErrProviderAlreadyLinked) {
+		t.Errorf("expected ErrProviderAlreadyLinked, got %v", err)
+	}
+	if len(identity_repo.Identities) != 0 {
+		t.Error("expected identity NOT to be linked")
+	}
+}
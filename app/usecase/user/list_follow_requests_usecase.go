@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FollowRequestListerInterface は受信したフォローリクエストをページ単位で検索するインターフェースです
+type FollowRequestListerInterface interface {
+	ListReceived(ctx context.Context, target_id uuid.UUID, first int, after string) (*models.FollowRequestPage, error)
+}
+
+// ListFollowRequestsUseCase はログイン中のユーザーが受信した承認待ちのフォローリクエストの一覧を取得するユースケースです
+type ListFollowRequestsUseCase struct {
+	follow_request_repo FollowRequestListerInterface
+}
+
+// NewListFollowRequestsUseCase は新しいListFollowRequestsUseCaseを作成します
+func NewListFollowRequestsUseCase(follow_request_repo FollowRequestListerInterface) *ListFollowRequestsUseCase {
+	return &ListFollowRequestsUseCase{
+		follow_request_repo: follow_request_repo,
+	}
+}
+
+// Execute はリクエストした日時の新しい順にフォローリクエストの一覧を返します
+// ページサイズはフォロー関係の一覧と同じく、firstがnilの場合はDefaultFollowPageSize件を返し、
+// 1〜MaxFollowPageSizeの範囲外・不正なカーソルの場合はErrInvalidPaginationを返します
+func (uc *ListFollowRequestsUseCase) Execute(ctx context.Context, user *models.User, first *int, after *string) (*models.FollowRequestPage, error) {
+	var page_size int
+	var cursor string
+	var page *models.FollowRequestPage
+	var err error
+
+	page_size = DefaultFollowPageSize
+	if first != nil {
+		page_size = *first
+	}
+	if page_size < 1 || page_size > MaxFollowPageSize {
+		return nil, fmt.Errorf("%w: first must be between 1 and %d", domain_errors.ErrInvalidPagination, MaxFollowPageSize)
+	}
+	if after != nil {
+		cursor = *after
+	}
+	page, err = uc.follow_request_repo.ListReceived(ctx, user.PublicID(), page_size, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return page, nil
+}
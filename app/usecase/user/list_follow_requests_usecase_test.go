@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestListFollowRequestsUseCase_Execute は受信したリクエストの一覧とページサイズの範囲外をテストします
+func TestListFollowRequestsUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var follow_request_repo *MockFollowRequestRepository
+	var use_case *ListFollowRequestsUseCase
+	var first int
+	var page *models.FollowRequestPage
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	follow_request_repo = NewMockFollowRequestRepository(NewMockFollowRepository())
+	for range 3 {
+		var request *models.FollowRequest
+
+		request, _ = models.NewFollowRequest(uuid.New(), user.PublicID(), time.Now())
+		_, _ = follow_request_repo.Create(ctx, request)
+	}
+	use_case = NewListFollowRequestsUseCase(follow_request_repo)
+
+	first = 2
+	page, err = use_case.Execute(ctx, user, &first, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page.Requests()) != 2 || !page.HasNextPage() {
+		t.Errorf("expected 2 requests with next page, got %d (hasNext=%v)", len(page.Requests()), page.HasNextPage())
+	}
+	for _, first = range []int{0, MaxFollowPageSize + 1} {
+		_, err = use_case.Execute(ctx, user, &first, nil)
+		if !errors.Is(err, domain_errors.ErrInvalidPagination) {
+			t.Errorf("expected ErrInvalidPagination for first=%d, got %v", first, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// フォロワー・フォローの一覧の1ページあたりの件数の既定値と上限
+const (
+	DefaultFollowPageSize = 20
+	MaxFollowPageSize     = 100
+)
+
+// FollowDirection はフォロー関係の一覧の種類です
+type FollowDirection string
+
+// フォロー関係の一覧の種類の一覧です
+const (
+	// FollowDirectionFollowers はユーザーをフォローしているユーザーの一覧です
+	FollowDirectionFollowers FollowDirection = "followers"
+	// FollowDirectionFollowing はユーザーがフォローしているユーザーの一覧です
+	FollowDirectionFollowing FollowDirection = "following"
+)
+
+// FollowPageFinderInterface はフォロー関係をページ単位で検索するインターフェースです
+// viewer_idを指定した場合は閲覧するユーザーとの間にブロックがあるユーザー・閲覧するユーザーがミュートしているユーザーを除外します（uuid.Nilの場合は除外しません）
+type FollowPageFinderInterface interface {
+	ListFollowers(ctx context.Context, user_id uuid.UUID, viewer_id uuid.UUID, first int, after string) (*models.FollowPage, error)
+	ListFollowing(ctx context.Context, user_id uuid.UUID, viewer_id uuid.UUID, first int, after string) (*models.FollowPage, error)
+	CountsByUserID(ctx context.Context, user_id uuid.UUID) (models.FollowCounts, error)
+}
+
+// FollowConnection はフォロー関係の一覧の1ページと総件数です
+type FollowConnection struct {
+	Page *models.FollowPage
+	// TotalCount は非正規化したフォロワー数・フォロー数です（削除済み・利用停止中のユーザーも含みます）
+	TotalCount int
+}
+
+// ListFollowsUseCase はユーザーのフォロワー・フォローしているユーザーの一覧を取得するユースケースです
+type ListFollowsUseCase struct {
+	user_finder   VisibleUserFinderInterface
+	follow_repo   FollowPageFinderInterface
+	privacy_guard *PrivacyGuard
+}
+
+// NewListFollowsUseCase は新しいListFollowsUseCaseを作成します
+func NewListFollowsUseCase(
+	user_finder VisibleUserFinderInterface,
+	follow_repo FollowPageFinderInterface,
+	privacy_guard *PrivacyGuard,
+) *ListFollowsUseCase {
+	return &ListFollowsUseCase{
+		user_finder:   user_finder,
+		follow_repo:   follow_repo,
+		privacy_guard: privacy_guard,
+	}
+}
+
+// Execute はフォローした日時の新しい順にフォロー関係の一覧を返します
+// firstがnilの場合はDefaultFollowPageSize件を返し、1〜MaxFollowPageSizeの範囲外・不正なカーソルの場合はErrInvalidPaginationを返します
+// 不正な公開ID・存在しないユーザー・削除済み・利用停止中のユーザー・viewerとの間にブロックがあるユーザーの場合はErrUserNotFoundを返します
+// viewerはログイン中のユーザーです（未ログインの場合はnil）。一覧からはviewerとの間にブロックがあるユーザー・viewerがミュートしているユーザーを除外します
+// 非公開アカウントの一覧は本人と承認されたフォロワー以外にはErrPrivateAccountを返します（総件数はプロフィールの概要で公開しています）
+func (uc *ListFollowsUseCase) Execute(
+	ctx context.Context,
+	viewer *models.User,
+	direction FollowDirection,
+	user_id_str string,
+	first *int,
+	after *string,
+) (*FollowConnection, error) {
+	var user_id uuid.UUID
+	var viewer_id uuid.UUID
+	var user *models.User
+	var page_size int
+	var cursor string
+	var page *models.FollowPage
+	var counts models.FollowCounts
+	var total_count int
+	var err error
+
+	page_size = DefaultFollowPageSize
+	if first != nil {
+		page_size = *first
+	}
+	if page_size < 1 || page_size > MaxFollowPageSize {
+		return nil, fmt.Errorf("%w: first must be between 1 and %d", domain_errors.ErrInvalidPagination, MaxFollowPageSize)
+	}
+	if after != nil {
+		cursor = *after
+	}
+	user_id, err = uuid.Parse(user_id_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, user_id_str)
+	}
+	viewer_id = viewer_public_id(viewer)
+	// ブロックしている・されているユーザーは存在しないユーザーとして扱う
+	user, err = uc.user_finder.FindVisibleByPublicID(ctx, user_id, viewer_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() || user.IsBanned() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, user_id_str)
+	}
+	err = uc.privacy_guard.EnsureCanViewContent(ctx, viewer, user_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	switch direction {
+	case FollowDirectionFollowers:
+		page, err = uc.follow_repo.ListFollowers(ctx, user_id, viewer_id, page_size, cursor)
+	case FollowDirectionFollowing:
+		page, err = uc.follow_repo.ListFollowing(ctx, user_id, viewer_id, page_size, cursor)
+	default:
+		return nil, fmt.Errorf("unknown follow direction: %s", direction)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	counts, err = uc.follow_repo.CountsByUserID(ctx, user_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	total_count = counts.Followers()
+	if direction == FollowDirectionFollowing {
+		total_count = counts.Following()
+	}
+	return &FollowConnection{
+		Page:       page,
+		TotalCount: total_count,
+	}, nil
+}
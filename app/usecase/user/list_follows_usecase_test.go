@@ -0,0 +1,140 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestListFollowsUseCase_Execute はフォロワー・フォローの一覧と総件数をテストします
+func TestListFollowsUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_store *MockRegisteredUserStore
+	var follow_repo *MockFollowRepository
+	var use_case *ListFollowsUseCase
+	var first int
+	var connection *FollowConnection
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[user.PublicID()] = user
+	follow_repo = NewMockFollowRepository()
+	for range 3 {
+		var follower *models.User
+		var follow *models.Follow
+
+		follower = create_test_user_with_status(t, nil, nil)
+		follow, _ = models.NewFollow(follower.PublicID(), user.PublicID(), time.Now())
+		_, _ = follow_repo.Create(ctx, follow)
+	}
+	use_case = NewListFollowsUseCase(
+		user_store, follow_repo, create_test_privacy_guard(NewMockPrivacySettingsStore(), follow_repo),
+	)
+
+	first = 2
+	connection, err = use_case.Execute(ctx, nil, FollowDirectionFollowers, user.PublicID().String(), &first, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(connection.Page.Follows()) != 2 || !connection.Page.HasNextPage() || connection.TotalCount != 3 {
+		t.Errorf("expected 2 of 3 followers with next page, got %d of %d", len(connection.Page.Follows()), connection.TotalCount)
+	}
+	connection, err = use_case.Execute(ctx, nil, FollowDirectionFollowing, user.PublicID().String(), nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(connection.Page.Follows()) != 0 || connection.TotalCount != 0 {
+		t.Errorf("expected no following, got %d", connection.TotalCount)
+	}
+}
+
+// TestListFollowsUseCase_Execute_Invalid はページサイズの範囲外と利用できない・ブロックしているユーザーをテストします
+func TestListFollowsUseCase_Execute_Invalid(t *testing.T) {
+	var ctx context.Context
+	var now time.Time
+	var banned_user *models.User
+	var blocking_user *models.User
+	var viewer *models.User
+	var user_store *MockRegisteredUserStore
+	var use_case *ListFollowsUseCase
+	var err error
+
+	ctx = context.Background()
+	now = time.Now()
+	banned_user = create_test_user_with_status(t, nil, &now)
+	blocking_user = create_test_user_with_status(t, nil, nil)
+	viewer = create_test_user(t)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[banned_user.PublicID()] = banned_user
+	user_store.users[blocking_user.PublicID()] = blocking_user
+	user_store.add_block(blocking_user.PublicID(), viewer.PublicID())
+	use_case = NewListFollowsUseCase(
+		user_store, NewMockFollowRepository(), create_test_privacy_guard(NewMockPrivacySettingsStore(), NewMockFollowRepository()),
+	)
+
+	for _, first := range []int{0, MaxFollowPageSize + 1} {
+		_, err = use_case.Execute(ctx, nil, FollowDirectionFollowers, banned_user.PublicID().String(), &first, nil)
+		if !errors.Is(err, domain_errors.ErrInvalidPagination) {
+			t.Errorf("expected ErrInvalidPagination for first=%d, got %v", first, err)
+		}
+	}
+	_, err = use_case.Execute(ctx, nil, FollowDirectionFollowers, banned_user.PublicID().String(), nil, nil)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for banned user, got %v", err)
+	}
+	_, err = use_case.Execute(ctx, viewer, FollowDirectionFollowing, blocking_user.PublicID().String(), nil, nil)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for blocked viewer, got %v", err)
+	}
+}
+
+// TestListFollowsUseCase_Execute_PrivateAccount は非公開アカウントの一覧を承認されたフォロワーのみ閲覧できることをテストします
+func TestListFollowsUseCase_Execute_PrivateAccount(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var follower *models.User
+	var stranger *models.User
+	var user_store *MockRegisteredUserStore
+	var follow_repo *MockFollowRepository
+	var settings_store *MockPrivacySettingsStore
+	var use_case *ListFollowsUseCase
+	var follow *models.Follow
+	var connection *FollowConnection
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user_with_status(t, nil, nil)
+	follower = create_test_user_with_status(t, nil, nil)
+	stranger = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[user.PublicID()] = user
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(follower.PublicID(), user.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	settings_store = NewMockPrivacySettingsStore()
+	settings_store.set_private(user.PublicID())
+	use_case = NewListFollowsUseCase(
+		user_store, follow_repo, create_test_privacy_guard(settings_store, follow_repo),
+	)
+
+	for _, viewer := range []*models.User{nil, stranger} {
+		_, err = use_case.Execute(ctx, viewer, FollowDirectionFollowers, user.PublicID().String(), nil, nil)
+		if !errors.Is(err, domain_errors.ErrPrivateAccount) {
+			t.Errorf("expected ErrPrivateAccount, got %v", err)
+		}
+	}
+	connection, err = use_case.Execute(ctx, follower, FollowDirectionFollowers, user.PublicID().String(), nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(connection.Page.Follows()) != 1 {
+		t.Errorf("expected 1 follower, got %d", len(connection.Page.Follows()))
+	}
+}
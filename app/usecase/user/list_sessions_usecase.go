@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// SessionFinderInterface はログイン中の端末（セッション）を検索するインターフェースです
+type SessionFinderInterface interface {
+	FindBySessionID(ctx context.Context, session_id uuid.UUID) (*models.Session, error)
+	FindActiveByUserID(ctx context.Context, user_id uuid.UUID, now time.Time) ([]*models.Session, error)
+}
+
+// ListSessionsUseCase はログイン中のユーザーの有効なセッション（ログイン中の端末）の一覧を取得するユースケースです
+type ListSessionsUseCase struct {
+	session_repo SessionFinderInterface
+}
+
+// NewListSessionsUseCase は新しいListSessionsUseCaseを作成します
+func NewListSessionsUseCase(session_repo SessionFinderInterface) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		session_repo: session_repo,
+	}
+}
+
+// Execute は未失効かつ有効期限内のセッションを最終利用日時の新しい順に返します
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, user *models.User) ([]*models.Session, error) {
+	var sessions []*models.Session
+	var err error
+
+	sessions, err = uc.session_repo.FindActiveByUserID(ctx, user.PublicID(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return sessions, nil
+}
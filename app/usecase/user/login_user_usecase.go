@@ -0,0 +1,181 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// FirebaseTokenVerifierInterface はFirebase IDトークン検証のインターフェースです
+type FirebaseTokenVerifierInterface interface {
+	VerifyIDToken(ctx context.Context, id_token string) (string, error)
+}
+
+// UserFinderInterface はFirebase UIDでユーザーを検索するインターフェースです
+type UserFinderInterface interface {
+	FindByFirebaseUID(ctx context.Context, firebase_uid string) (*models.User, error)
+}
+
+// EmailVerificationCheckerInterface はFirebase上のメールアドレス確認状態を取得するインターフェースです
+type EmailVerificationCheckerInterface interface {
+	IsEmailVerified(ctx context.Context, firebase_uid string) (bool, error)
+}
+
+// EmailVerificationRecorderInterface はメールアドレスの確認日時を記録するインターフェースです
+type EmailVerificationRecorderInterface interface {
+	MarkEmailVerified(ctx context.Context, public_id uuid.UUID, verified_at time.Time) error
+}
+
+// LoginUserResult はログインの結果を表します
+type LoginUserResult struct {
+	User         *models.User
+	AccessToken  string
+	RefreshToken string
+	// MfaChallenge は二要素認証が有効なユーザーの場合に設定され、トークンペアは発行されません（verifyMfaで完了）
+	MfaChallenge string
+}
+
+// LoginUserUseCase はFirebase IDトークンを使ったログインのユースケースです
+type LoginUserUseCase struct {
+	token_verifier FirebaseTokenVerifierInterface
+	user_finder    UserFinderInterface
+	token_issuer   *TokenIssuer
+	// email_checker・verification_recorder はログイン時にメールアドレスの確認状態をFirebaseから同期します
+	email_checker         EmailVerificationCheckerInterface
+	verification_recorder EmailVerificationRecorderInterface
+	// attempt_guard はIPアドレス・端末IDごとの失敗回数に応じてログインを制限します
+	attempt_guard *AuthAttemptGuard
+}
+
+// NewLoginUserUseCase は新しいLoginUserUseCaseを作成します
+func NewLoginUserUseCase(
+	token_verifier FirebaseTokenVerifierInterface,
+	user_finder UserFinderInterface,
+	token_issuer *TokenIssuer,
+	email_checker EmailVerificationCheckerInterface,
+	verification_recorder EmailVerificationRecorderInterface,
+	attempt_guard *AuthAttemptGuard,
+) *LoginUserUseCase {
+	return &LoginUserUseCase{
+		token_verifier:        token_verifier,
+		user_finder:           user_finder,
+		token_issuer:          token_issuer,
+		email_checker:         email_checker,
+		verification_recorder: verification_recorder,
+		attempt_guard:         attempt_guard,
+	}
+}
+
+// Execute はFirebase IDトークンを検証し、SLEEVEのJWTを発行します
+// 失敗回数が上限に達している場合はErrTooManyAttemptsを返します
+func (uc *LoginUserUseCase) Execute(ctx context.Context, id_token string) (*LoginUserResult, error) {
+	var attempt *AuthAttempt
+	var result *LoginUserResult
+	var err error
+
+	attempt, err = uc.attempt_guard.Begin(ctx, models.AuthActionLogin)
+	if err != nil {
+		return nil, err
+	}
+	result, err = uc.execute(ctx, id_token)
+	if err != nil {
+		return nil, attempt.Fail(ctx, err)
+	}
+	attempt.Succeed(ctx)
+	return result, nil
+}
+
+// execute はFirebase IDトークンを検証し、SLEEVEのJWTを発行します
+func (uc *LoginUserUseCase) execute(ctx context.Context, id_token string) (*LoginUserResult, error) {
+	var firebase_uid string
+	var user *models.User
+	var token_pair *utils.TokenPair
+	var err error
+
+	// Firebase IDトークンの検証
+	firebase_uid, err = uc.token_verifier.VerifyIDToken(ctx, id_token)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// 自社DBからユーザーを取得
+	user, err = uc.user_finder.FindByFirebaseUID(ctx, firebase_uid)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// 論理削除済みユーザーはログイン不可
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserDeleted, user.PublicID().String())
+	}
+
+	// メールアドレスの確認状態をFirebaseから同期
+	err = uc.sync_email_verification(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// 二要素認証が有効な場合はトークンペアの代わりにチャレンジトークンを返す
+	if user.IsMfaEnabled() {
+		return issue_mfa_challenge_result(uc.token_issuer, user)
+	}
+
+	// JWTを発行（リフレッシュトークンは新しいトークンファミリーとして記録）
+	token_pair, err = uc.token_issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &LoginUserResult{
+		User:         user,
+		AccessToken:  token_pair.AccessToken,
+		RefreshToken: token_pair.RefreshToken,
+	}, nil
+}
+
+// sync_email_verification は未確認のユーザーについて、Firebaseで確認済みになっていればDBに確認日時を記録します
+// 確認済みのユーザーはFirebaseへ問い合わせません
+func (uc *LoginUserUseCase) sync_email_verification(ctx context.Context, user *models.User) error {
+	var is_verified bool
+	var verified_at time.Time
+	var err error
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+	is_verified, err = uc.email_checker.IsEmailVerified(ctx, user.FirebaseUID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !is_verified {
+		return nil
+	}
+	verified_at = time.Now()
+	err = uc.verification_recorder.MarkEmailVerified(ctx, user.PublicID(), verified_at)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	user.MarkEmailVerified(verified_at)
+	return nil
+}
+
+// issue_mfa_challenge_result は二要素認証のチャレンジトークンのみを含むログイン結果を作成します
+func issue_mfa_challenge_result(token_issuer *TokenIssuer, user *models.User) (*LoginUserResult, error) {
+	var challenge string
+	var err error
+
+	challenge, err = token_issuer.IssueMfaChallenge(user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &LoginUserResult{
+		User:         user,
+		MfaChallenge: challenge,
+	}, nil
+}
@@ -0,0 +1,376 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// テスト用定数
+const (
+	testIDToken = "valid_id_token"
+)
+
+// new_test_login_user_use_case はFirebaseでメールアドレスが未確認のモックと、インメモリのAuthAttemptGuardを使うLoginUserUseCaseを作成します
+func new_test_login_user_use_case(
+	token_verifier FirebaseTokenVerifierInterface,
+	user_finder UserFinderInterface,
+	token_issuer *TokenIssuer,
+) *LoginUserUseCase {
+	return NewLoginUserUseCase(
+		token_verifier,
+		user_finder,
+		token_issuer,
+		NewMockEmailVerificationChecker(false),
+		NewMockEmailVerificationRecorder(),
+		new_test_attempt_guard(),
+	)
+}
+
+// TestLoginUserUseCase_Execute_Success は正常なログインをテストします
+func TestLoginUserUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var jwt_service *utils.JWTService
+	var result *LoginUserResult
+	var access_claims *utils.JWTClaims
+	var err error
+
+	ctx = context.Background()
+	jwt_service = new_test_jwt_service(NewMockTokenDenylist())
+	use_case = new_test_login_user_use_case(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+		NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+	)
+	result, err = use_case.Execute(ctx, testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.User == nil {
+		t.Fatal("expected user to be non-nil")
+	}
+	if result.User.FirebaseUID() != testFirebaseUID {
+		t.Errorf("expected firebase_uid %s, got %s", testFirebaseUID, result.User.FirebaseUID())
+	}
+	if result.AccessToken == "" {
+		t.Error("expected access_token to be non-empty")
+	}
+	if result.RefreshToken == "" {
+		t.Error("expected refresh_token to be non-empty")
+	}
+	access_claims, err = jwt_service.ValidateToken(ctx, result.AccessToken)
+	if err != nil {
+		t.Fatalf("expected valid access token, got error: %v", err)
+	}
+	if access_claims.UserID != result.User.PublicID().String() {
+		t.Errorf("expected user_id %s, got %s", result.User.PublicID().String(), access_claims.UserID)
+	}
+}
+
+// TestLoginUserUseCase_Execute_InvalidIDToken は不正なIDトークンでエラーを返すケースをテストします
+func TestLoginUserUseCase_Execute_InvalidIDToken(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var user_finder *MockUserFinder
+	var err error
+
+	ctx = context.Background()
+	user_finder = NewMockUserFinder()
+	use_case = new_test_login_user_use_case(
+		NewMockFirebaseTokenVerifierWithError(),
+		user_finder,
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+	)
+	_, err = use_case.Execute(ctx, "invalid_id_token")
+	if !errors.Is(err, domain_errors.ErrInvalidIDToken) {
+		t.Errorf("expected ErrInvalidIDToken, got %v", err)
+	}
+	if user_finder.FindCalled {
+		t.Error("expected FindByFirebaseUID NOT to be called for invalid id token")
+	}
+}
+
+// TestLoginUserUseCase_Execute_UserNotFound は未登録ユーザーでエラーを返すケースをテストします
+func TestLoginUserUseCase_Execute_UserNotFound(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case = new_test_login_user_use_case(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinderWithNotFound(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+	)
+	_, err = use_case.Execute(ctx, testIDToken)
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+// TestLoginUserUseCase_Execute_DeletedUser は論理削除済みユーザーでエラーを返すケースをテストします
+func TestLoginUserUseCase_Execute_DeletedUser(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	use_case = new_test_login_user_use_case(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinderWithDeletedUser(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+	)
+	result, err = use_case.Execute(ctx, testIDToken)
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Errorf("expected ErrUserDeleted, got %v", err)
+	}
+	if result != nil {
+		t.Error("expected result to be nil for deleted user")
+	}
+}
+
+// TestLoginUserUseCase_Execute_SyncEmailVerification はFirebaseで確認済みのメールアドレスがログイン時にDBへ同期されることをテストします
+func TestLoginUserUseCase_Execute_SyncEmailVerification(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var recorder *MockEmailVerificationRecorder
+	var result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	recorder = NewMockEmailVerificationRecorder()
+	use_case = NewLoginUserUseCase(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewMockEmailVerificationChecker(true),
+		recorder,
+		new_test_attempt_guard(),
+	)
+	result, err = use_case.Execute(ctx, testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.User.IsEmailVerified() {
+		t.Error("expected user to be email verified")
+	}
+	if recorder.RecordedPublicID != result.User.PublicID() {
+		t.Errorf("expected verification to be recorded for %s, got %s", result.User.PublicID(), recorder.RecordedPublicID)
+	}
+}
+
+// TestLoginUserUseCase_Execute_EmailNotVerified はFirebaseで未確認の場合に確認日時を記録しないことをテストします
+func TestLoginUserUseCase_Execute_EmailNotVerified(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var recorder *MockEmailVerificationRecorder
+	var result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	recorder = NewMockEmailVerificationRecorder()
+	use_case = NewLoginUserUseCase(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinder(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewMockEmailVerificationChecker(false),
+		recorder,
+		new_test_attempt_guard(),
+	)
+	result, err = use_case.Execute(ctx, testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.User.IsEmailVerified() {
+		t.Error("expected user to not be email verified")
+	}
+	if recorder.RecordedPublicID != uuid.Nil {
+		t.Error("expected verification to not be recorded")
+	}
+}
+
+// TestLoginUserUseCase_Execute_MfaEnabled は二要素認証が有効な場合にトークンペアの代わりにチャレンジを返すことをテストします
+func TestLoginUserUseCase_Execute_MfaEnabled(t *testing.T) {
+	var ctx context.Context
+	var use_case *LoginUserUseCase
+	var jwt_service *utils.JWTService
+	var refresh_token_repo *MockRefreshTokenRepository
+	var result *LoginUserResult
+	var claims *utils.JWTClaims
+	var err error
+
+	ctx = context.Background()
+	jwt_service = new_test_jwt_service(NewMockTokenDenylist())
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	use_case = new_test_login_user_use_case(
+		NewMockFirebaseTokenVerifier(),
+		NewMockUserFinderWithMfaEnabled(),
+		NewTokenIssuer(jwt_service, refresh_token_repo, NewMockSessionRepository()),
+	)
+	result, err = use_case.Execute(ctx, testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.AccessToken != "" || result.RefreshToken != "" {
+		t.Error("expected no token pair to be issued before mfa verification")
+	}
+	if len(refresh_token_repo.tokens) != 0 {
+		t.Errorf("expected no refresh token to be saved, got %d", len(refresh_token_repo.tokens))
+	}
+	claims, err = jwt_service.ValidateToken(ctx, result.MfaChallenge)
+	if err != nil {
+		t.Fatalf("expected valid mfa challenge, got error: %v", err)
+	}
+	if claims.TokenType != utils.TokenTypeMfaChallenge {
+		t.Errorf("expected token type %s, got %s", utils.TokenTypeMfaChallenge, claims.TokenType)
+	}
+}
+
+// MockEmailVerificationChecker はテスト用のFirebaseのメールアドレス確認状態のモックです
+type MockEmailVerificationChecker struct {
+	is_verified bool
+}
+
+// NewMockEmailVerificationChecker は新しいMockEmailVerificationCheckerを作成します
+func NewMockEmailVerificationChecker(is_verified bool) *MockEmailVerificationChecker {
+	return &MockEmailVerificationChecker{
+		is_verified: is_verified,
+	}
+}
+
+// IsEmailVerified はモックの確認状態を返します
+func (m *MockEmailVerificationChecker) IsEmailVerified(_ context.Context, _ string) (bool, error) {
+	return m.is_verified, nil
+}
+
+// MockEmailVerificationRecorder はテスト用のメールアドレス確認日時の記録モックです
+type MockEmailVerificationRecorder struct {
+	RecordedPublicID uuid.UUID
+}
+
+// NewMockEmailVerificationRecorder は新しいMockEmailVerificationRecorderを作成します
+func NewMockEmailVerificationRecorder() *MockEmailVerificationRecorder {
+	return &MockEmailVerificationRecorder{
+		RecordedPublicID: uuid.Nil,
+	}
+}
+
+// MarkEmailVerified は確認日時を記録したユーザーの公開IDを保持します
+func (m *MockEmailVerificationRecorder) MarkEmailVerified(_ context.Context, public_id uuid.UUID, _ time.Time) error {
+	m.RecordedPublicID = public_id
+	return nil
+}
+
+// MockFirebaseTokenVerifier はテスト用のIDトークン検証モックです
+type MockFirebaseTokenVerifier struct {
+	should_return_error bool
+}
+
+// NewMockFirebaseTokenVerifier は新しいMockFirebaseTokenVerifierを作成します
+func NewMockFirebaseTokenVerifier() *MockFirebaseTokenVerifier {
+	return &MockFirebaseTokenVerifier{
+		should_return_error: false,
+	}
+}
+
+// NewMockFirebaseTokenVerifierWithError は検証エラーを返すモックを作成します
+func NewMockFirebaseTokenVerifierWithError() *MockFirebaseTokenVerifier {
+	return &MockFirebaseTokenVerifier{
+		should_return_error: true,
+	}
+}
+
+// VerifyIDToken はモックのIDトークン検証を行います
+func (m *MockFirebaseTokenVerifier) VerifyIDToken(_ context.Context, _ string) (string, error) {
+	if m.should_return_error {
+		return "", domain_errors.ErrInvalidIDToken
+	}
+	return testFirebaseUID, nil
+}
+
+// MockUserFinder はテスト用のユーザー検索モックです
+type MockUserFinder struct {
+	should_return_not_found bool
+	is_deleted              bool
+	mfa_enabled_at          *time.Time
+	public_id               uuid.UUID
+	FindCalled              bool
+}
+
+// NewMockUserFinder は新しいMockUserFinderを作成します
+func NewMockUserFinder() *MockUserFinder {
+	return &MockUserFinder{
+		should_return_not_found: false,
+		is_deleted:              false,
+		mfa_enabled_at:          nil,
+		public_id:               uuid.New(),
+		FindCalled:              false,
+	}
+}
+
+// NewMockUserFinderWithNotFound はユーザー未検出エラーを返すモックを作成します
+func NewMockUserFinderWithNotFound() *MockUserFinder {
+	return &MockUserFinder{
+		should_return_not_found: true,
+		is_deleted:              false,
+		mfa_enabled_at:          nil,
+		public_id:               uuid.New(),
+		FindCalled:              false,
+	}
+}
+
+// NewMockUserFinderWithDeletedUser は論理削除済みユーザーを返すモックを作成します
+func NewMockUserFinderWithDeletedUser() *MockUserFinder {
+	return &MockUserFinder{
+		should_return_not_found: false,
+		is_deleted:              true,
+		mfa_enabled_at:          nil,
+		public_id:               uuid.New(),
+		FindCalled:              false,
+	}
+}
+
+// NewMockUserFinderWithMfaEnabled は二要素認証が有効なユーザーを返すモックを作成します
+func NewMockUserFinderWithMfaEnabled() *MockUserFinder {
+	var enabled_at time.Time
+
+	enabled_at = time.Now()
+	return &MockUserFinder{
+		should_return_not_found: false,
+		is_deleted:              false,
+		mfa_enabled_at:          &enabled_at,
+		public_id:               uuid.New(),
+		FindCalled:              false,
+	}
+}
+
+// FindByFirebaseUID はモックのユーザー検索を行います
+func (m *MockUserFinder) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	var email models.Email
+	var now time.Time
+	var deleted_at *time.Time
+	var err error
+
+	m.FindCalled = true
+	if m.should_return_not_found {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		return nil, err
+	}
+	now = time.Now()
+	if m.is_deleted {
+		deleted_at = &now
+	}
+	return models.NewUserWithPublicID(m.public_id, firebase_uid, email, models.RoleUser, nil, m.mfa_enabled_at, now, now, deleted_at, nil)
+}
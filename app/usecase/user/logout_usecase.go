@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// TokenDenylistWriterInterface はjti・sidをdenylistに登録するインターフェースです
+type TokenDenylistWriterInterface interface {
+	Add(ctx context.Context, entry *models.DenylistedToken) error
+}
+
+// SessionRevokerInterface はトークンファミリー（セッション）を失効させるインターフェースです
+type SessionRevokerInterface interface {
+	FindActiveFamilyIDsByUserID(ctx context.Context, user_id uuid.UUID) ([]uuid.UUID, error)
+	RevokeFamily(ctx context.Context, family_id uuid.UUID, revoked_at time.Time) error
+}
+
+// FirebaseRefreshTokenRevokerInterface はFirebaseのリフレッシュトークンを失効させるインターフェースです
+type FirebaseRefreshTokenRevokerInterface interface {
+	RevokeRefreshTokens(ctx context.Context, firebase_uid string) error
+}
+
+// LogoutUseCase はログアウトのユースケースです
+type LogoutUseCase struct {
+	jwt_service      *utils.JWTService
+	denylist         TokenDenylistWriterInterface
+	session_revoker  *UserSessionRevoker
+	firebase_revoker FirebaseRefreshTokenRevokerInterface
+}
+
+// NewLogoutUseCase は新しいLogoutUseCaseを作成します
+func NewLogoutUseCase(
+	jwt_service *utils.JWTService,
+	denylist TokenDenylistWriterInterface,
+	session_revoker *UserSessionRevoker,
+	firebase_revoker FirebaseRefreshTokenRevokerInterface,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		jwt_service:      jwt_service,
+		denylist:         denylist,
+		session_revoker:  session_revoker,
+		firebase_revoker: firebase_revoker,
+	}
+}
+
+// Execute はアクセストークンのセッションを失効させます
+// all_sessionsがtrueの場合はユーザーの全セッションとFirebaseのリフレッシュトークンも失効させます
+func (uc *LogoutUseCase) Execute(ctx context.Context, access_token string, all_sessions bool) error {
+	var claims *utils.JWTClaims
+	var user_id uuid.UUID
+	var entry *models.DenylistedToken
+	var now time.Time
+	var err error
+
+	// 署名・有効期限・失効状態の検証
+	claims, err = uc.jwt_service.ValidateToken(ctx, access_token)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	if claims.TokenType != utils.TokenTypeAccess {
+		return fmt.Errorf("%w: unexpected token type %s", domain_errors.ErrInvalidAccessToken, claims.TokenType)
+	}
+
+	// 提示されたアクセストークン自体を失効（sidを持たないトークンもこれで無効化される）
+	now = time.Now()
+	entry, err = models.NewDenylistedToken(models.DenylistKindToken, claims.ID, claims.ExpiresAt.Time)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	err = uc.denylist.Add(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if !all_sessions {
+		if claims.SessionID == "" {
+			return nil
+		}
+		return uc.session_revoker.RevokeSession(ctx, claims.SessionID, now)
+	}
+
+	// 全端末からのログアウト
+	user_id, err = uuid.Parse(claims.UserID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	err = uc.session_revoker.RevokeAllSessions(ctx, user_id, now)
+	if err != nil {
+		return err
+	}
+	err = uc.firebase_revoker.RevokeRefreshTokens(ctx, claims.FirebaseUID)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// logoutTestContext はログアウトテストで共有する依存関係です
+type logoutTestContext struct {
+	jwt_service        *utils.JWTService
+	denylist           *MockTokenDenylist
+	refresh_token_repo *MockRefreshTokenRepository
+	firebase_revoker   *MockFirebaseRefreshTokenRevoker
+	login_use_case     *LoginUserUseCase
+	refresh_use_case   *RefreshTokensUseCase
+	use_case           *LogoutUseCase
+}
+
+// setupLogoutTest はログアウトテスト用の依存関係を作成します
+func setupLogoutTest(firebase_revoker *MockFirebaseRefreshTokenRevoker) *logoutTestContext {
+	var test_context *logoutTestContext
+	var user_finder *MockUserFinder
+	var token_issuer *TokenIssuer
+	var session_revoker *UserSessionRevoker
+
+	test_context = &logoutTestContext{
+		denylist:           NewMockTokenDenylist(),
+		refresh_token_repo: NewMockRefreshTokenRepository(),
+		firebase_revoker:   firebase_revoker,
+	}
+	test_context.jwt_service = new_test_jwt_service(test_context.denylist)
+	user_finder = NewMockUserFinder()
+	token_issuer = NewTokenIssuer(test_context.jwt_service, test_context.refresh_token_repo, NewMockSessionRepository())
+	test_context.login_use_case = new_test_login_user_use_case(NewMockFirebaseTokenVerifier(), user_finder, token_issuer)
+	session_revoker = NewUserSessionRevoker(test_context.denylist, test_context.refresh_token_repo, NewMockSessionRepository())
+	test_context.refresh_use_case = NewRefreshTokensUseCase(
+		test_context.jwt_service, token_issuer, test_context.refresh_token_repo, user_finder,
+		NewMockCredentialChangeChecker(time.Time{}), session_revoker,
+	)
+	test_context.use_case = NewLogoutUseCase(
+		test_context.jwt_service, test_context.denylist, session_revoker, firebase_revoker,
+	)
+	return test_context
+}
+
+// login はテスト用にログインしてトークンを取得します
+func (c *logoutTestContext) login(t *testing.T) *LoginUserResult {
+	var result *LoginUserResult
+	var err error
+
+	t.Helper()
+	result, err = c.login_use_case.Execute(context.Background(), testIDToken)
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	return result
+}
+
+// TestLogoutUseCase_Execute_CurrentSession は現在のセッションのみが失効することをテストします
+func TestLogoutUseCase_Execute_CurrentSession(t *testing.T) {
+	var ctx context.Context
+	var test_context *logoutTestContext
+	var current_session *LoginUserResult
+	var other_session *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupLogoutTest(NewMockFirebaseRefreshTokenRevoker())
+	current_session = test_context.login(t)
+	other_session = test_context.login(t)
+	err = test_context.use_case.Execute(ctx, current_session.AccessToken, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// ログアウトしたセッションのトークンは使えない
+	_, err = test_context.jwt_service.ValidateToken(ctx, current_session.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked for access token, got %v", err)
+	}
+	_, err = test_context.refresh_use_case.Execute(ctx, current_session.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken for refresh token, got %v", err)
+	}
+	// 別セッションは影響を受けない
+	_, err = test_context.jwt_service.ValidateToken(ctx, other_session.AccessToken)
+	if err != nil {
+		t.Errorf("expected other session to remain valid, got %v", err)
+	}
+	if len(test_context.firebase_revoker.revoked_uids) != 0 {
+		t.Error("expected Firebase refresh tokens NOT to be revoked for single session logout")
+	}
+}
+
+// TestLogoutUseCase_Execute_AllSessions は全セッションとFirebaseのリフレッシュトークンが失効することをテストします
+func TestLogoutUseCase_Execute_AllSessions(t *testing.T) {
+	var ctx context.Context
+	var test_context *logoutTestContext
+	var current_session *LoginUserResult
+	var other_session *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupLogoutTest(NewMockFirebaseRefreshTokenRevoker())
+	current_session = test_context.login(t)
+	other_session = test_context.login(t)
+	err = test_context.use_case.Execute(ctx, current_session.AccessToken, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, access_token := range []string{current_session.AccessToken, other_session.AccessToken} {
+		_, err = test_context.jwt_service.ValidateToken(ctx, access_token)
+		if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+			t.Errorf("expected ErrTokenRevoked, got %v", err)
+		}
+	}
+	_, err = test_context.refresh_use_case.Execute(ctx, other_session.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+	if len(test_context.firebase_revoker.revoked_uids) != 1 || test_context.firebase_revoker.revoked_uids[0] != testFirebaseUID {
+		t.Errorf("expected Firebase refresh tokens of %s to be revoked, got %v", testFirebaseUID, test_context.firebase_revoker.revoked_uids)
+	}
+}
+
+// TestLogoutUseCase_Execute_RefreshTokenRejected はリフレッシュトークンでのログアウトが拒否されることをテストします
+func TestLogoutUseCase_Execute_RefreshTokenRejected(t *testing.T) {
+	var ctx context.Context
+	var test_context *logoutTestContext
+	var session *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupLogoutTest(NewMockFirebaseRefreshTokenRevoker())
+	session = test_context.login(t)
+	err = test_context.use_case.Execute(ctx, session.RefreshToken, false)
+	if !errors.Is(err, domain_errors.ErrInvalidAccessToken) {
+		t.Errorf("expected ErrInvalidAccessToken, got %v", err)
+	}
+}
+
+// TestLogoutUseCase_Execute_AlreadyLoggedOut はログアウト済みのトークンでエラーを返すケースをテストします
+func TestLogoutUseCase_Execute_AlreadyLoggedOut(t *testing.T) {
+	var ctx context.Context
+	var test_context *logoutTestContext
+	var session *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupLogoutTest(NewMockFirebaseRefreshTokenRevoker())
+	session = test_context.login(t)
+	err = test_context.use_case.Execute(ctx, session.AccessToken, false)
+	if err != nil {
+		t.Fatalf("expected first logout to succeed, got %v", err)
+	}
+	err = test_context.use_case.Execute(ctx, session.AccessToken, false)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked, got %v", err)
+	}
+}
+
+// TestLogoutUseCase_Execute_FirebaseError はFirebaseの失効に失敗した場合にエラーを返すケースをテストします
+func TestLogoutUseCase_Execute_FirebaseError(t *testing.T) {
+	var ctx context.Context
+	var test_context *logoutTestContext
+	var session *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupLogoutTest(NewMockFirebaseRefreshTokenRevokerWithError())
+	session = test_context.login(t)
+	err = test_context.use_case.Execute(ctx, session.AccessToken, true)
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
+
+// MockTokenDenylist はテスト用のインメモリなdenylistです
+type MockTokenDenylist struct {
+	values map[string]bool
+}
+
+// NewMockTokenDenylist は新しいMockTokenDenylistを作成します
+func NewMockTokenDenylist() *MockTokenDenylist {
+	return &MockTokenDenylist{
+		values: map[string]bool{},
+	}
+}
+
+// Add はモックのdenylist登録を行います
+func (m *MockTokenDenylist) Add(_ context.Context, entry *models.DenylistedToken) error {
+	m.values[string(entry.Kind())+":"+entry.Value()] = true
+	return nil
+}
+
+// IsDenylisted はjtiまたはsidが登録済みかを返します
+func (m *MockTokenDenylist) IsDenylisted(_ context.Context, token_id, session_id string) (bool, error) {
+	return m.values[string(models.DenylistKindToken)+":"+token_id] ||
+		m.values[string(models.DenylistKindSession)+":"+session_id], nil
+}
+
+// MockFirebaseRefreshTokenRevoker はテスト用のFirebaseリフレッシュトークン失効モックです
+type MockFirebaseRefreshTokenRevoker struct {
+	revoked_uids        []string
+	should_return_error bool
+}
+
+// NewMockFirebaseRefreshTokenRevoker は新しいMockFirebaseRefreshTokenRevokerを作成します
+func NewMockFirebaseRefreshTokenRevoker() *MockFirebaseRefreshTokenRevoker {
+	return &MockFirebaseRefreshTokenRevoker{
+		revoked_uids:        []string{},
+		should_return_error: false,
+	}
+}
+
+// NewMockFirebaseRefreshTokenRevokerWithError は失効エラーを返すモックを作成します
+func NewMockFirebaseRefreshTokenRevokerWithError() *MockFirebaseRefreshTokenRevoker {
+	return &MockFirebaseRefreshTokenRevoker{
+		revoked_uids:        []string{},
+		should_return_error: true,
+	}
+}
+
+// RevokeRefreshTokens はモックのFirebaseリフレッシュトークン失効を行います
+func (m *MockFirebaseRefreshTokenRevoker) RevokeRefreshTokens(_ context.Context, firebase_uid string) error {
+	if m.should_return_error {
+		return domain_errors.ErrFirebaseAuthFailed
+	}
+	m.revoked_uids = append(m.revoked_uids, firebase_uid)
+	return nil
+}
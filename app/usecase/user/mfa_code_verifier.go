@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// TotpCredentialRepositoryInterface は二要素認証（TOTP）の認証情報を永続化するインターフェースです
+type TotpCredentialRepositoryInterface interface {
+	Save(ctx context.Context, credential *models.TotpCredential) error
+	FindByUserID(ctx context.Context, user_id uuid.UUID) (*models.TotpCredential, error)
+	Update(ctx context.Context, credential *models.TotpCredential) error
+	Delete(ctx context.Context, user_id uuid.UUID) error
+}
+
+// SecretCipherInterface はTOTPの共有シークレットを暗号化・復号するインターフェースです
+type SecretCipherInterface interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// MfaCodeVerifier はTOTPの認証コードとリカバリーコードを検証します
+// 検証に成功したコードは使用済みとして記録し、同じコードの再利用を防ぎます
+type MfaCodeVerifier struct {
+	credential_repo TotpCredentialRepositoryInterface
+	secret_cipher   SecretCipherInterface
+}
+
+// NewMfaCodeVerifier は新しいMfaCodeVerifierを作成します
+func NewMfaCodeVerifier(credential_repo TotpCredentialRepositoryInterface, secret_cipher SecretCipherInterface) *MfaCodeVerifier {
+	return &MfaCodeVerifier{
+		credential_repo: credential_repo,
+		secret_cipher:   secret_cipher,
+	}
+}
+
+// VerifyTotpCode は認証アプリの認証コードのみを検証し、更新後の認証情報を返します
+// 二要素認証の有効化・リカバリーコードの再発行など、認証アプリを持っていることを確認する操作に使用します
+func (v *MfaCodeVerifier) VerifyTotpCode(ctx context.Context, user_id uuid.UUID, code string) (*models.TotpCredential, error) {
+	var credential *models.TotpCredential
+	var secret string
+	var step int64
+	var is_valid bool
+	var err error
+
+	credential, err = v.credential_repo.FindByUserID(ctx, user_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	secret, err = v.secret_cipher.Decrypt(credential.EncryptedSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	step, is_valid = utils.VerifyTotpCode(secret, strings.TrimSpace(code), time.Now())
+	if !is_valid || !credential.RecordUsedStep(step) {
+		return nil, fmt.Errorf("%w: user_id=%s", domain_errors.ErrInvalidMfaCode, user_id)
+	}
+	err = v.credential_repo.Update(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return credential, nil
+}
+
+// VerifyCode は認証コードまたはリカバリーコードを検証します（ログイン時・二要素認証の無効化時）
+// 6桁の数字は認証コード、それ以外はリカバリーコードとして扱い、使用したリカバリーコードは無効になります
+func (v *MfaCodeVerifier) VerifyCode(ctx context.Context, user_id uuid.UUID, code string) error {
+	var credential *models.TotpCredential
+	var err error
+
+	code = strings.TrimSpace(code)
+	if is_totp_code_format(code) {
+		_, err = v.VerifyTotpCode(ctx, user_id, code)
+		return err
+	}
+	credential, err = v.credential_repo.FindByUserID(ctx, user_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !credential.ConsumeRecoveryCode(code) {
+		return fmt.Errorf("%w: user_id=%s", domain_errors.ErrInvalidMfaCode, user_id)
+	}
+	err = v.credential_repo.Update(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// is_totp_code_format は入力が認証アプリの認証コード（6桁の数字）の形式かどうかを返します
+func is_totp_code_format(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, char := range code {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
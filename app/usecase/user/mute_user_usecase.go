@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UserMuteRepositoryInterface はミュートの保存・解除のインターフェースです
+type UserMuteRepositoryInterface interface {
+	// Create は既にミュートしている場合はfalseを返します
+	Create(ctx context.Context, user_mute *models.UserMute) (bool, error)
+	// Delete はミュートしていない場合はfalseを返します
+	Delete(ctx context.Context, muter_id uuid.UUID, muted_id uuid.UUID) (bool, error)
+}
+
+// MuteUserUseCase はログイン中のユーザーが他のユーザーをミュートするユースケースです
+// ミュートはフィードから非表示にするだけで、フォローややり取りは制限しません（相手には通知しません）
+type MuteUserUseCase struct {
+	user_finder RegisteredUserFinderInterface
+	mute_repo   UserMuteRepositoryInterface
+}
+
+// NewMuteUserUseCase は新しいMuteUserUseCaseを作成します
+func NewMuteUserUseCase(user_finder RegisteredUserFinderInterface, mute_repo UserMuteRepositoryInterface) *MuteUserUseCase {
+	return &MuteUserUseCase{
+		user_finder: user_finder,
+		mute_repo:   mute_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーをミュートします
+// 既にミュートしている場合は何もしません
+// 自分自身の場合はErrCannotMuteSelf、不正な公開ID・存在しないユーザーの場合はErrUserNotFoundを返します
+func (uc *MuteUserUseCase) Execute(ctx context.Context, user *models.User, muted_id_str string) error {
+	var muted_id uuid.UUID
+	var user_mute *models.UserMute
+	var err error
+
+	muted_id, err = uuid.Parse(muted_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, muted_id_str)
+	}
+	if muted_id == user.PublicID() {
+		return domain_errors.ErrCannotMuteSelf
+	}
+	_, err = uc.user_finder.FindByPublicID(ctx, muted_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	user_mute, err = models.NewUserMute(user.PublicID(), muted_id, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrCannotMuteSelf, err)
+	}
+	_, err = uc.mute_repo.Create(ctx, user_mute)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockUserMuteRepository はテスト用のインメモリなミュートのリポジトリです
+type MockUserMuteRepository struct {
+	mutes []*models.UserMute
+}
+
+// NewMockUserMuteRepository は新しいMockUserMuteRepositoryを作成します
+func NewMockUserMuteRepository() *MockUserMuteRepository {
+	return &MockUserMuteRepository{mutes: []*models.UserMute{}}
+}
+
+// Create はミュートを保存します（ミュート済みの場合はfalseを返します）
+func (m *MockUserMuteRepository) Create(_ context.Context, user_mute *models.UserMute) (bool, error) {
+	for _, mute := range m.mutes {
+		if mute.MuterID() == user_mute.MuterID() && mute.MutedID() == user_mute.MutedID() {
+			return false, nil
+		}
+	}
+	m.mutes = append(m.mutes, user_mute)
+	return true, nil
+}
+
+// Delete はミュートを削除します（ミュートしていない場合はfalseを返します）
+func (m *MockUserMuteRepository) Delete(_ context.Context, muter_id uuid.UUID, muted_id uuid.UUID) (bool, error) {
+	for i, mute := range m.mutes {
+		if mute.MuterID() == muter_id && mute.MutedID() == muted_id {
+			m.mutes = append(m.mutes[:i], m.mutes[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListMutedIDs はmuter_idがミュートしたユーザーの公開IDを返します
+func (m *MockUserMuteRepository) ListMutedIDs(_ context.Context, muter_id uuid.UUID) ([]uuid.UUID, error) {
+	var muted_ids []uuid.UUID
+
+	muted_ids = []uuid.UUID{}
+	for _, mute := range m.mutes {
+		if mute.MuterID() == muter_id {
+			muted_ids = append(muted_ids, mute.MutedID())
+		}
+	}
+	return muted_ids, nil
+}
+
+// TestMuteUserUseCase_Execute はミュート・ミュート解除の冪等性と自分自身のミュートをテストします
+func TestMuteUserUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var target *models.User
+	var user_store *MockRegisteredUserStore
+	var mute_repo *MockUserMuteRepository
+	var mute_use_case *MuteUserUseCase
+	var unmute_use_case *UnmuteUserUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	target = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[target.PublicID()] = target
+	mute_repo = NewMockUserMuteRepository()
+	mute_use_case = NewMuteUserUseCase(user_store, mute_repo)
+	unmute_use_case = NewUnmuteUserUseCase(user_store, mute_repo)
+
+	for range 2 {
+		err = mute_use_case.Execute(ctx, user, target.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(mute_repo.mutes) != 1 {
+		t.Errorf("expected 1 mute, got %d", len(mute_repo.mutes))
+	}
+	for range 2 {
+		err = unmute_use_case.Execute(ctx, user, target.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(mute_repo.mutes) != 0 {
+		t.Errorf("expected mute to be removed, got %d", len(mute_repo.mutes))
+	}
+
+	err = mute_use_case.Execute(ctx, user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotMuteSelf) {
+		t.Errorf("expected ErrCannotMuteSelf, got %v", err)
+	}
+	err = mute_use_case.Execute(ctx, user, uuid.New().String())
+	if !errors.Is(err, domain_errors.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// PrivacySettingsFinderInterface はユーザーのプライバシー設定を検索するインターフェースです
+type PrivacySettingsFinderInterface interface {
+	FindPrivacySettings(ctx context.Context, user_id uuid.UUID) (models.PrivacySettings, error)
+}
+
+// FollowExistenceCheckerInterface はフォローしているかを判定するインターフェースです
+type FollowExistenceCheckerInterface interface {
+	Exists(ctx context.Context, follower_id uuid.UUID, followee_id uuid.UUID) (bool, error)
+}
+
+// ContentVisibilityCheckerInterface はアカウントのコンテンツを閲覧できるかをリポジトリの絞り込み条件で判定するインターフェースです
+// viewer_idは未ログインの場合はuuid.Nilです
+type ContentVisibilityCheckerInterface interface {
+	CanViewContent(ctx context.Context, owner_id uuid.UUID, viewer_id uuid.UUID) (bool, error)
+}
+
+// PrivacyGuard は非公開アカウントのコンテンツを承認されたフォロワー以外に公開しないように判定します
+// コンテンツの閲覧可否はリポジトリの絞り込み条件に委譲します
+// アカウントに紐づくコンテンツを一覧で返すクエリは、このガードを経由せずリポジトリの絞り込み条件で直接絞り込む必要があります
+type PrivacyGuard struct {
+	settings_finder PrivacySettingsFinderInterface
+	follow_checker  FollowExistenceCheckerInterface
+	content_checker ContentVisibilityCheckerInterface
+}
+
+// NewPrivacyGuard は新しいPrivacyGuardを作成します
+func NewPrivacyGuard(
+	settings_finder PrivacySettingsFinderInterface,
+	follow_checker FollowExistenceCheckerInterface,
+	content_checker ContentVisibilityCheckerInterface,
+) *PrivacyGuard {
+	return &PrivacyGuard{
+		settings_finder: settings_finder,
+		follow_checker:  follow_checker,
+		content_checker: content_checker,
+	}
+}
+
+// Resolve はアカウントのプライバシー設定と、閲覧するユーザーとアカウントの所有者の関係を返します
+// viewerがnil（未ログイン）の場合はViewerRelationStrangerを返します
+func (g *PrivacyGuard) Resolve(ctx context.Context, viewer *models.User, owner_id uuid.UUID) (models.PrivacySettings, models.ViewerRelation, error) {
+	var settings models.PrivacySettings
+	var following bool
+	var err error
+
+	settings, err = g.settings_finder.FindPrivacySettings(ctx, owner_id)
+	if err != nil {
+		return models.PrivacySettings{}, models.ViewerRelationStranger, fmt.Errorf("%w", err)
+	}
+	if viewer == nil {
+		return settings, models.ViewerRelationStranger, nil
+	}
+	if viewer.PublicID() == owner_id {
+		return settings, models.ViewerRelationOwner, nil
+	}
+	following, err = g.follow_checker.Exists(ctx, viewer.PublicID(), owner_id)
+	if err != nil {
+		return models.PrivacySettings{}, models.ViewerRelationStranger, fmt.Errorf("%w", err)
+	}
+	if following {
+		return settings, models.ViewerRelationFollower, nil
+	}
+	return settings, models.ViewerRelationStranger, nil
+}
+
+// CanViewContent はviewerがアカウントのコンテンツを閲覧できるかを返します
+func (g *PrivacyGuard) CanViewContent(ctx context.Context, viewer *models.User, owner_id uuid.UUID) (bool, error) {
+	var can_view bool
+	var err error
+
+	can_view, err = g.content_checker.CanViewContent(ctx, owner_id, viewer_public_id(viewer))
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return can_view, nil
+}
+
+// EnsureCanViewContent はviewerがアカウントのコンテンツを閲覧できない場合にErrPrivateAccountを返します
+func (g *PrivacyGuard) EnsureCanViewContent(ctx context.Context, viewer *models.User, owner_id uuid.UUID) error {
+	var can_view bool
+	var err error
+
+	can_view, err = g.CanViewContent(ctx, viewer, owner_id)
+	if err != nil {
+		return err
+	}
+	if !can_view {
+		return fmt.Errorf("%w: %s", domain_errors.ErrPrivateAccount, owner_id)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockPrivacySettingsStore はテスト用のインメモリなプライバシー設定のリポジトリです
+type MockPrivacySettingsStore struct {
+	settings map[uuid.UUID]models.PrivacySettings
+}
+
+// NewMockPrivacySettingsStore は新しいMockPrivacySettingsStoreを作成します
+func NewMockPrivacySettingsStore() *MockPrivacySettingsStore {
+	return &MockPrivacySettingsStore{settings: map[uuid.UUID]models.PrivacySettings{}}
+}
+
+// FindPrivacySettings はプライバシー設定を返します（保存されていない場合は初期値を返します）
+func (m *MockPrivacySettingsStore) FindPrivacySettings(_ context.Context, user_id uuid.UUID) (models.PrivacySettings, error) {
+	var settings models.PrivacySettings
+	var ok bool
+
+	settings, ok = m.settings[user_id]
+	if !ok {
+		return models.DefaultPrivacySettings(), nil
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings はプライバシー設定を保存します
+func (m *MockPrivacySettingsStore) UpdatePrivacySettings(_ context.Context, user_id uuid.UUID, settings models.PrivacySettings) error {
+	m.settings[user_id] = settings
+	return nil
+}
+
+// set_private は非公開アカウントに設定します
+func (m *MockPrivacySettingsStore) set_private(user_id uuid.UUID) {
+	m.settings[user_id], _ = models.NewPrivacySettings(true, false, false, models.CommentPermissionEveryone)
+}
+
+// MockContentVisibilityChecker はテスト用のコンテンツの閲覧可否の判定のモックです
+// リポジトリの絞り込み条件と同じく、公開アカウント・本人・承認されたフォロワーのみ閲覧できるものとします
+type MockContentVisibilityChecker struct {
+	settings_store *MockPrivacySettingsStore
+	follow_repo    *MockFollowRepository
+}
+
+// NewMockContentVisibilityChecker は新しいMockContentVisibilityCheckerを作成します
+func NewMockContentVisibilityChecker(settings_store *MockPrivacySettingsStore, follow_repo *MockFollowRepository) *MockContentVisibilityChecker {
+	return &MockContentVisibilityChecker{
+		settings_store: settings_store,
+		follow_repo:    follow_repo,
+	}
+}
+
+// CanViewContent はプライバシー設定とフォロー関係からコンテンツを閲覧できるかを返します
+func (m *MockContentVisibilityChecker) CanViewContent(ctx context.Context, owner_id uuid.UUID, viewer_id uuid.UUID) (bool, error) {
+	var settings models.PrivacySettings
+
+	settings, _ = m.settings_store.FindPrivacySettings(ctx, owner_id)
+	if !settings.IsPrivate() || owner_id == viewer_id {
+		return true, nil
+	}
+	if viewer_id == uuid.Nil {
+		return false, nil
+	}
+	return m.follow_repo.Exists(ctx, viewer_id, owner_id)
+}
+
+// create_test_privacy_guard はテスト用のPrivacyGuardを作成します
+func create_test_privacy_guard(settings_store *MockPrivacySettingsStore, follow_repo *MockFollowRepository) *PrivacyGuard {
+	return NewPrivacyGuard(settings_store, follow_repo, NewMockContentVisibilityChecker(settings_store, follow_repo))
+}
+
+// TestPrivacyGuard_EnsureCanViewContent は非公開アカウントを本人と承認されたフォロワーのみ閲覧できることをテストします
+func TestPrivacyGuard_EnsureCanViewContent(t *testing.T) {
+	var ctx context.Context
+	var owner *models.User
+	var follower *models.User
+	var stranger *models.User
+	var settings_store *MockPrivacySettingsStore
+	var follow_repo *MockFollowRepository
+	var guard *PrivacyGuard
+	var follow *models.Follow
+	var relation models.ViewerRelation
+	var err error
+
+	ctx = context.Background()
+	owner = create_test_user_with_status(t, nil, nil)
+	follower = create_test_user_with_status(t, nil, nil)
+	stranger = create_test_user_with_status(t, nil, nil)
+	settings_store = NewMockPrivacySettingsStore()
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(follower.PublicID(), owner.PublicID(), time.Now())
+	_, _ = follow_repo.Create(ctx, follow)
+	guard = create_test_privacy_guard(settings_store, follow_repo)
+
+	for _, viewer := range []*models.User{nil, stranger, follower, owner} {
+		err = guard.EnsureCanViewContent(ctx, viewer, owner.PublicID())
+		if err != nil {
+			t.Errorf("expected public account to be visible, got %v", err)
+		}
+	}
+
+	settings_store.set_private(owner.PublicID())
+	for _, viewer := range []*models.User{nil, stranger} {
+		err = guard.EnsureCanViewContent(ctx, viewer, owner.PublicID())
+		if !errors.Is(err, domain_errors.ErrPrivateAccount) {
+			t.Errorf("expected ErrPrivateAccount, got %v", err)
+		}
+	}
+	for _, viewer := range []*models.User{follower, owner} {
+		err = guard.EnsureCanViewContent(ctx, viewer, owner.PublicID())
+		if err != nil {
+			t.Errorf("expected private account to be visible to %s, got %v", viewer.PublicID(), err)
+		}
+	}
+	_, relation, err = guard.Resolve(ctx, owner, owner.PublicID())
+	if err != nil || relation != models.ViewerRelationOwner {
+		t.Errorf("expected owner relation, got %v (err=%v)", relation, err)
+	}
+}
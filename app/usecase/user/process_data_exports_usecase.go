@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// dataExportProcessBatchSize は1回の問い合わせで処理するエクスポートの件数です
+const dataExportProcessBatchSize = 10
+
+// DataExportProcessorRepositoryInterface は作成待ちのエクスポートを取得し、処理結果を保存するインターフェースです
+type DataExportProcessorRepositoryInterface interface {
+	FindPending(ctx context.Context, limit int) ([]*models.DataExport, error)
+	MarkReady(ctx context.Context, data_export *models.DataExport, download_token_hash string) error
+	MarkFailed(ctx context.Context, data_export *models.DataExport) error
+}
+
+// DataExportStorageInterface はエクスポートのZIPファイルを保存するインターフェースです
+type DataExportStorageInterface interface {
+	Save(ctx context.Context, key string, data []byte) error
+}
+
+// DataExportMailSenderInterface はエクスポートの完了とダウンロードリンクを通知するインターフェースです
+type DataExportMailSenderInterface interface {
+	SendDataExportReadyMail(ctx context.Context, email models.Email, download_link string, expires_at time.Time) error
+}
+
+// ProcessDataExportsUseCase は作成待ちのエクスポートのZIPファイルを作成し、ダウンロードリンクをメールで通知するユースケースです
+type ProcessDataExportsUseCase struct {
+	export_repo DataExportProcessorRepositoryInterface
+	user_finder RegisteredUserFinderInterface
+	collector   *DataExportCollector
+	storage     DataExportStorageInterface
+	mail_sender DataExportMailSenderInterface
+	base_url    string
+}
+
+// NewProcessDataExportsUseCase は新しいProcessDataExportsUseCaseを作成します
+// base_urlはダウンロードリンクのURL（<base_url>/data-exports/<エクスポートID>?token=<トークン>）の作成に使用します
+func NewProcessDataExportsUseCase(
+	export_repo DataExportProcessorRepositoryInterface,
+	user_finder RegisteredUserFinderInterface,
+	collector *DataExportCollector,
+	storage DataExportStorageInterface,
+	mail_sender DataExportMailSenderInterface,
+	base_url string,
+) *ProcessDataExportsUseCase {
+	return &ProcessDataExportsUseCase{
+		export_repo: export_repo,
+		user_finder: user_finder,
+		collector:   collector,
+		storage:     storage,
+		mail_sender: mail_sender,
+		base_url:    strings.TrimRight(base_url, "/"),
+	}
+}
+
+// Execute は作成待ちのエクスポートを要求した順に処理し、処理件数を返します
+// ZIPファイルの作成に失敗したエクスポートは失敗として記録し、残りのエクスポートの処理を続けます
+func (uc *ProcessDataExportsUseCase) Execute(ctx context.Context) (int, error) {
+	var data_exports []*models.DataExport
+	var processed_count int
+	var err error
+
+	for {
+		data_exports, err = uc.export_repo.FindPending(ctx, dataExportProcessBatchSize)
+		if err != nil {
+			return processed_count, fmt.Errorf("%w", err)
+		}
+		for _, data_export := range data_exports {
+			err = uc.process(ctx, data_export)
+			if err != nil {
+				return processed_count, err
+			}
+			processed_count++
+		}
+		if len(data_exports) < dataExportProcessBatchSize {
+			return processed_count, nil
+		}
+	}
+}
+
+// process は1件のエクスポートのZIPファイルを作成して保存し、ダウンロードリンクを通知します
+// 処理結果（完了・失敗）の保存に失敗した場合のみエラーを返します
+func (uc *ProcessDataExportsUseCase) process(ctx context.Context, data_export *models.DataExport) error {
+	var user *models.User
+	var download_token string
+	var err error
+
+	user, err = uc.user_finder.FindByPublicID(ctx, data_export.UserID())
+	if err != nil {
+		return uc.mark_failed(ctx, data_export, err)
+	}
+	download_token, err = uc.build_archive(ctx, data_export, user)
+	if err != nil {
+		return uc.mark_failed(ctx, data_export, err)
+	}
+	// 通知メールの送信に失敗しても、ZIPファイルは作成済みのためエクスポートは完了のままにする
+	err = uc.mail_sender.SendDataExportReadyMail(ctx, user.Email(), uc.build_download_link(data_export, download_token), *data_export.ExpiresAt())
+	if err != nil {
+		log.Printf("データのエクスポート完了メールの送信に失敗しました: export_id=%s: %v", data_export.ExportID(), err)
+	}
+	return nil
+}
+
+// build_archive はZIPファイルを作成して保存し、エクスポートを完了として記録します
+// ダウンロードリンクのトークンはハッシュのみを保存し、平文はメールの送信にのみ使用します
+func (uc *ProcessDataExportsUseCase) build_archive(ctx context.Context, data_export *models.DataExport, user *models.User) (string, error) {
+	var now time.Time
+	var storage_key string
+	var archive []byte
+	var download_token string
+	var err error
+
+	if user.IsDeleted() {
+		return "", fmt.Errorf("user is deleted")
+	}
+	now = time.Now()
+	archive, err = uc.collector.Collect(ctx, user, now)
+	if err != nil {
+		return "", err
+	}
+	storage_key = models.DataExportStorageKey(data_export.ExportID())
+	err = uc.storage.Save(ctx, storage_key, archive)
+	if err != nil {
+		return "", err
+	}
+	download_token, err = models.GenerateDataExportDownloadToken()
+	if err != nil {
+		return "", err
+	}
+	err = data_export.MarkReady(storage_key, now)
+	if err != nil {
+		return "", err
+	}
+	err = uc.export_repo.MarkReady(ctx, data_export, models.HashDataExportDownloadToken(download_token))
+	if err != nil {
+		return "", err
+	}
+	return download_token, nil
+}
+
+// mark_failed はエクスポートを失敗として記録します
+func (uc *ProcessDataExportsUseCase) mark_failed(ctx context.Context, data_export *models.DataExport, cause error) error {
+	var err error
+
+	log.Printf("データのエクスポートの作成に失敗しました: export_id=%s: %v", data_export.ExportID(), cause)
+	data_export.MarkFailed(cause.Error(), time.Now())
+	err = uc.export_repo.MarkFailed(ctx, data_export)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// build_download_link はダウンロードリンクのURLを作成します
+func (uc *ProcessDataExportsUseCase) build_download_link(data_export *models.DataExport, download_token string) string {
+	return fmt.Sprintf("%s/data-exports/%s?token=%s", uc.base_url, data_export.ExportID(), url.QueryEscape(download_token))
+}
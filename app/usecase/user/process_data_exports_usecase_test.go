@@ -0,0 +1,197 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// MockDataExportStorage はテスト用のインメモリなストレージです
+type MockDataExportStorage struct {
+	files               map[string][]byte
+	should_return_error bool
+}
+
+// NewMockDataExportStorage は新しいMockDataExportStorageを作成します
+func NewMockDataExportStorage() *MockDataExportStorage {
+	return &MockDataExportStorage{files: map[string][]byte{}}
+}
+
+// NewMockDataExportStorageWithError は保存でエラーを返すMockDataExportStorageを作成します
+func NewMockDataExportStorageWithError() *MockDataExportStorage {
+	return &MockDataExportStorage{files: map[string][]byte{}, should_return_error: true}
+}
+
+// Save はデータを保持します
+func (m *MockDataExportStorage) Save(_ context.Context, key string, data []byte) error {
+	if m.should_return_error {
+		return fmt.Errorf("%w: disk full", domain_errors.ErrStorageError)
+	}
+	m.files[key] = data
+	return nil
+}
+
+// Open は保持しているデータを返します
+func (m *MockDataExportStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	var data []byte
+	var exists bool
+
+	data, exists = m.files[key]
+	if !exists {
+		return nil, fmt.Errorf("%w: file not found", domain_errors.ErrStorageError)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete は保持しているデータを削除します
+func (m *MockDataExportStorage) Delete(_ context.Context, key string) error {
+	delete(m.files, key)
+	return nil
+}
+
+// MockDataExportMailSender は送信したダウンロードリンクを記録するモックです
+type MockDataExportMailSender struct {
+	download_links []string
+}
+
+// NewMockDataExportMailSender は新しいMockDataExportMailSenderを作成します
+func NewMockDataExportMailSender() *MockDataExportMailSender {
+	return &MockDataExportMailSender{download_links: []string{}}
+}
+
+// SendDataExportReadyMail はダウンロードリンクを記録します
+func (m *MockDataExportMailSender) SendDataExportReadyMail(_ context.Context, _ models.Email, download_link string, _ time.Time) error {
+	m.download_links = append(m.download_links, download_link)
+	return nil
+}
+
+// new_test_process_data_exports_usecase はモックを使うProcessDataExportsUseCaseを作成します
+func new_test_process_data_exports_usecase(
+	export_repo *MockDataExportRepository,
+	user *models.User,
+	storage *MockDataExportStorage,
+	mail_sender *MockDataExportMailSender,
+) *ProcessDataExportsUseCase {
+	var user_store *MockRegisteredUserStore
+
+	user_store = NewMockRegisteredUserStore()
+	_ = user_store.Save(context.Background(), user)
+	return NewProcessDataExportsUseCase(
+		export_repo,
+		user_store,
+		new_test_data_export_collector(
+			NewMockProfileRepository(), NewMockUserHandleRepository(), NewMockFollowRepository(),
+			NewMockUserBlockRepository(), NewMockUserMuteRepository(),
+		),
+		storage,
+		mail_sender,
+		"https://example.com/",
+	)
+}
+
+// TestProcessDataExportsUseCase_Execute はZIPファイルが保存され、ダウンロードできるリンクが通知されることをテストします
+func TestProcessDataExportsUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var export_repo *MockDataExportRepository
+	var storage *MockDataExportStorage
+	var mail_sender *MockDataExportMailSender
+	var data_export *models.DataExport
+	var processed_count int
+	var link *url.URL
+	var reader io.ReadCloser
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	export_repo = NewMockDataExportRepository()
+	storage = NewMockDataExportStorage()
+	mail_sender = NewMockDataExportMailSender()
+	data_export, _ = NewRequestDataExportUseCase(export_repo).Execute(ctx, user)
+
+	processed_count, err = new_test_process_data_exports_usecase(export_repo, user, storage, mail_sender).Execute(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if processed_count != 1 || !data_export.IsDownloadable(time.Now()) {
+		t.Fatalf("expected 1 downloadable export, got %d (status=%s)", processed_count, data_export.Status())
+	}
+	if len(storage.files[data_export.StorageKey()]) == 0 {
+		t.Error("expected zip file to be saved")
+	}
+	if len(mail_sender.download_links) != 1 {
+		t.Fatalf("expected 1 mail, got %d", len(mail_sender.download_links))
+	}
+	link, err = url.Parse(mail_sender.download_links[0])
+	if err != nil || !strings.HasPrefix(mail_sender.download_links[0], "https://example.com/data-exports/"+data_export.ExportID().String()+"?") {
+		t.Fatalf("unexpected download link: %s", mail_sender.download_links[0])
+	}
+	// トークンは平文で保存せず、通知したリンクのトークンでダウンロードできる
+	if export_repo.token_hashes[data_export.ExportID()] == link.Query().Get("token") {
+		t.Error("expected download token to be stored as hash")
+	}
+	reader, err = NewDownloadDataExportUseCase(export_repo, storage).Execute(ctx, data_export.ExportID().String(), link.Query().Get("token"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	reader.Close()
+}
+
+// TestProcessDataExportsUseCase_Execute_StorageError は保存に失敗したエクスポートが失敗として記録されることをテストします
+func TestProcessDataExportsUseCase_Execute_StorageError(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var export_repo *MockDataExportRepository
+	var mail_sender *MockDataExportMailSender
+	var data_export *models.DataExport
+	var processed_count int
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	export_repo = NewMockDataExportRepository()
+	mail_sender = NewMockDataExportMailSender()
+	data_export, _ = NewRequestDataExportUseCase(export_repo).Execute(ctx, user)
+
+	processed_count, err = new_test_process_data_exports_usecase(export_repo, user, NewMockDataExportStorageWithError(), mail_sender).Execute(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if processed_count != 1 || data_export.Status() != models.DataExportStatusFailed || data_export.LastError() == "" {
+		t.Errorf("expected failed export with reason, got %d (status=%s)", processed_count, data_export.Status())
+	}
+	if len(mail_sender.download_links) != 0 {
+		t.Errorf("expected no mail, got %d", len(mail_sender.download_links))
+	}
+}
+
+// TestProcessDataExportsUseCase_Execute_UserNotFound はユーザーが見つからないエクスポートが失敗として記録されることをテストします
+func TestProcessDataExportsUseCase_Execute_UserNotFound(t *testing.T) {
+	var ctx context.Context
+	var export_repo *MockDataExportRepository
+	var data_export *models.DataExport
+	var err error
+
+	ctx = context.Background()
+	export_repo = NewMockDataExportRepository()
+	data_export, _ = NewRequestDataExportUseCase(export_repo).Execute(ctx, create_test_account_user(t))
+
+	// 別のユーザーのみを保持するため、エクスポートのユーザーは見つからない
+	_, err = new_test_process_data_exports_usecase(
+		export_repo, create_test_account_user(t), NewMockDataExportStorage(), NewMockDataExportMailSender(),
+	).Execute(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if data_export.Status() != models.DataExportStatusFailed || !strings.Contains(data_export.LastError(), domain_errors.ErrUserNotFound.Error()) {
+		t.Errorf("expected failed export with ErrUserNotFound, got status=%s (%s)", data_export.Status(), data_export.LastError())
+	}
+}
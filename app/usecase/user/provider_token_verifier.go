@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// FirebaseProviderTokenVerifierInterface はGoogle・Apple・XでサインインしたFirebase IDトークンを検証するインターフェースです
+type FirebaseProviderTokenVerifierInterface interface {
+	VerifyProviderIDToken(ctx context.Context, id_token string, provider models.AuthProvider) (*models.ProviderIdentity, error)
+}
+
+// LINETokenVerifierInterface はLINEのIDトークンを検証するインターフェースです
+type LINETokenVerifierInterface interface {
+	VerifyIDToken(ctx context.Context, id_token string) (*models.ProviderIdentity, error)
+}
+
+// ProviderTokenVerifier は外部プロバイダに応じてIDトークンの検証先を振り分けます
+// Firebaseのネイティブプロバイダ（Google・Apple・X）はFirebaseで、LINEはLINEのJWKSで検証します
+type ProviderTokenVerifier struct {
+	firebase_verifier FirebaseProviderTokenVerifierInterface
+	// line_verifier が設定されていない場合、LINEログインはErrUnsupportedProviderになります
+	line_verifier LINETokenVerifierInterface
+}
+
+// NewProviderTokenVerifier は新しいProviderTokenVerifierを作成します
+// LINEログインを無効にする場合はline_verifierにnilを指定します
+func NewProviderTokenVerifier(
+	firebase_verifier FirebaseProviderTokenVerifierInterface,
+	line_verifier LINETokenVerifierInterface,
+) *ProviderTokenVerifier {
+	return &ProviderTokenVerifier{
+		firebase_verifier: firebase_verifier,
+		line_verifier:     line_verifier,
+	}
+}
+
+// Verify は外部プロバイダのIDトークンを検証し、外部プロバイダのアカウント情報を返します
+func (v *ProviderTokenVerifier) Verify(ctx context.Context, provider models.AuthProvider, id_token string) (*models.ProviderIdentity, error) {
+	var identity *models.ProviderIdentity
+	var err error
+
+	switch {
+	case provider.IsFirebaseNative():
+		identity, err = v.firebase_verifier.VerifyProviderIDToken(ctx, id_token, provider)
+	case provider == models.AuthProviderLINE && v.line_verifier != nil:
+		identity, err = v.line_verifier.VerifyIDToken(ctx, id_token)
+	default:
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUnsupportedProvider, provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return identity, nil
+}
+
+// parse_auth_provider は文字列から外部プロバイダを作成します
+func parse_auth_provider(provider_str string) (models.AuthProvider, error) {
+	var provider models.AuthProvider
+	var err error
+
+	provider, err = models.NewAuthProvider(provider_str)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domain_errors.ErrUnsupportedProvider, err)
+	}
+	return provider, nil
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// deletedUserPurgeBatchSize は1回の問い合わせで物理削除するユーザー数です
+const deletedUserPurgeBatchSize = 100
+
+// DeletedUserPurgerInterface は取り消し期間を過ぎた退会ユーザーを検索し、物理削除するインターフェースです
+type DeletedUserPurgerInterface interface {
+	ListDeletedBefore(ctx context.Context, deleted_before time.Time, limit int) ([]*models.User, error)
+	HardDeleteByPublicID(ctx context.Context, public_id uuid.UUID) error
+}
+
+// UserFollowPurgerInterface はユーザーのフォロー関係を全て削除するインターフェースです
+type UserFollowPurgerInterface interface {
+	DeleteAllByUserID(ctx context.Context, user_id uuid.UUID) (int, error)
+}
+
+// ImageBlobDeleterInterface はストレージに保存した画像を削除するインターフェースです
+type ImageBlobDeleterInterface interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// UserDataExportListerInterface はユーザーの全ての個人データのエクスポートを取得するインターフェースです
+type UserDataExportListerInterface interface {
+	ListByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.DataExport, error)
+}
+
+// PurgeDeletedUsersUseCase は削除を取り消せる期間を過ぎた退会ユーザーを物理削除するユースケースです
+// Firebaseのアカウントとストレージ上のアップロードした画像・エクスポートのZIPファイルを削除し、
+// ユーザーに紐づく行はusersの物理削除による外部キーのCASCADEで削除します
+type PurgeDeletedUsersUseCase struct {
+	user_repo        DeletedUserPurgerInterface
+	follow_repo      UserFollowPurgerInterface
+	firebase_deleter FirebaseUserDeleterInterface
+	image_repo       UploadedImageListerInterface
+	image_storage    ImageBlobDeleterInterface
+	export_repo      UserDataExportListerInterface
+	export_storage   DataExportFileDeleterInterface
+}
+
+// NewPurgeDeletedUsersUseCase は新しいPurgeDeletedUsersUseCaseを作成します
+func NewPurgeDeletedUsersUseCase(
+	user_repo DeletedUserPurgerInterface,
+	follow_repo UserFollowPurgerInterface,
+	firebase_deleter FirebaseUserDeleterInterface,
+	image_repo UploadedImageListerInterface,
+	image_storage ImageBlobDeleterInterface,
+	export_repo UserDataExportListerInterface,
+	export_storage DataExportFileDeleterInterface,
+) *PurgeDeletedUsersUseCase {
+	return &PurgeDeletedUsersUseCase{
+		user_repo:        user_repo,
+		follow_repo:      follow_repo,
+		firebase_deleter: firebase_deleter,
+		image_repo:       image_repo,
+		image_storage:    image_storage,
+		export_repo:      export_repo,
+		export_storage:   export_storage,
+	}
+}
+
+// Execute は取り消し期間を過ぎた退会ユーザーを物理削除し、削除件数を返します
+// 途中で失敗した場合も削除済みのユーザーは次回以降に対象から外れるため、再実行で続きから処理されます
+func (uc *PurgeDeletedUsersUseCase) Execute(ctx context.Context) (int, error) {
+	var deleted_before time.Time
+	var users []*models.User
+	var purged_count int
+	var err error
+
+	deleted_before = time.Now().Add(-models.AccountDeletionGracePeriod)
+	for {
+		users, err = uc.user_repo.ListDeletedBefore(ctx, deleted_before, deletedUserPurgeBatchSize)
+		if err != nil {
+			return purged_count, fmt.Errorf("%w", err)
+		}
+		for _, user := range users {
+			err = uc.purge(ctx, user)
+			if err != nil {
+				return purged_count, err
+			}
+			purged_count++
+		}
+		if len(users) < deletedUserPurgeBatchSize {
+			return purged_count, nil
+		}
+	}
+}
+
+// purge は1人の退会ユーザーのFirebaseアカウント・アップロードした画像・エクスポートのZIPファイル・DBの行を削除します
+// DBの行を最後に削除し、途中で失敗した場合も次回の実行で同じユーザーを再度処理できるようにします
+func (uc *PurgeDeletedUsersUseCase) purge(ctx context.Context, user *models.User) error {
+	var images []*models.UploadedImage
+	var data_exports []*models.DataExport
+	var err error
+
+	err = uc.firebase_deleter.DeleteUser(ctx, user.FirebaseUID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	// 画像の記録はusersの物理削除のCASCADEで消えるため、記録が残っているうちにストレージ上のファイルを削除する
+	images, err = uc.image_repo.ListByUserID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	for _, image := range images {
+		for _, variant := range image.Variants() {
+			err = uc.image_storage.Delete(ctx, variant.StorageKey())
+			if err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+	}
+	// エクスポートの記録もCASCADEで消え、有効期限切れの削除の対象から外れるため、同様に先にZIPファイルを削除する
+	data_exports, err = uc.export_repo.ListByUserID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	for _, data_export := range data_exports {
+		if data_export.StorageKey() == "" {
+			continue
+		}
+		err = uc.export_storage.Delete(ctx, data_export.StorageKey())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+	}
+	// フォロー相手の非正規化したフォロワー数・フォロー数はCASCADEでは減算されないため、先に関係を削除する
+	_, err = uc.follow_repo.DeleteAllByUserID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.user_repo.HardDeleteByPublicID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
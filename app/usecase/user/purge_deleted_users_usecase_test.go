@@ -0,0 +1,129 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockUserFollowPurger はテスト用のフォロー関係の削除のモックです
+type MockUserFollowPurger struct {
+	purged_user_ids []uuid.UUID
+}
+
+// DeleteAllByUserID は削除したユーザーIDを記録します
+func (m *MockUserFollowPurger) DeleteAllByUserID(_ context.Context, user_id uuid.UUID) (int, error) {
+	m.purged_user_ids = append(m.purged_user_ids, user_id)
+	return 0, nil
+}
+
+// create_test_ready_data_export はZIPファイルをストレージに保存した作成完了のエクスポートを作成します
+func create_test_ready_data_export(
+	t *testing.T, export_repo *MockDataExportRepository, export_storage *MockDataExportStorage, user_id uuid.UUID,
+) *models.DataExport {
+	var data_export *models.DataExport
+	var err error
+
+	t.Helper()
+	data_export, err = models.NewDataExport(user_id, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create data export: %v", err)
+	}
+	_ = data_export.MarkReady(models.DataExportStorageKey(data_export.ExportID()), time.Now())
+	_ = export_repo.Create(context.Background(), data_export)
+	_ = export_storage.Save(context.Background(), data_export.StorageKey(), []byte("zip"))
+	return data_export
+}
+
+// TestPurgeDeletedUsersUseCase_Execute は取り消し期間を過ぎた退会ユーザーのみが物理削除され、アップロードした画像とエクスポートのZIPファイルが削除されることをテストします
+func TestPurgeDeletedUsersUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var expired_user *models.User
+	var pending_user *models.User
+	var user_repo *MockAccountDeletionRepository
+	var follow_repo *MockUserFollowPurger
+	var firebase_deleter *MockFirebaseUserDisabler
+	var storage *MockBlobStorage
+	var image_repo *MockUploadedImageRepository
+	var expired_image *models.UploadedImage
+	var pending_image *models.UploadedImage
+	var export_repo *MockDataExportRepository
+	var export_storage *MockDataExportStorage
+	var expired_export *models.DataExport
+	var pending_export *models.DataExport
+	var use_case *PurgeDeletedUsersUseCase
+	var purged_count int
+	var is_found bool
+	var err error
+
+	ctx = context.Background()
+	expired_user = create_test_account_user(t)
+	pending_user = create_test_account_user(t)
+	user_repo = NewMockAccountDeletionRepository(expired_user, pending_user)
+	_ = user_repo.MarkDeleted(ctx, expired_user.PublicID(), time.Now().Add(-models.AccountDeletionGracePeriod-time.Hour), "expired")
+	_ = user_repo.MarkDeleted(ctx, pending_user.PublicID(), time.Now().Add(-time.Hour), "pending")
+	follow_repo = &MockUserFollowPurger{}
+	firebase_deleter = NewMockFirebaseUserDisabler()
+	storage = NewMockBlobStorage()
+	image_repo = NewMockUploadedImageRepository()
+	expired_image, err = NewUploadImageUseCase(storage, image_repo).Execute(
+		ctx, expired_user, "avatar", bytes.NewReader(encode_test_image(t, 64, 64, models.ImageFormatPNG)),
+	)
+	if err != nil {
+		t.Fatalf("failed to upload test image: %v", err)
+	}
+	pending_image, err = NewUploadImageUseCase(storage, image_repo).Execute(
+		ctx, pending_user, "avatar", bytes.NewReader(encode_test_image(t, 64, 64, models.ImageFormatPNG)),
+	)
+	if err != nil {
+		t.Fatalf("failed to upload test image: %v", err)
+	}
+	export_repo = NewMockDataExportRepository()
+	export_storage = NewMockDataExportStorage()
+	expired_export = create_test_ready_data_export(t, export_repo, export_storage, expired_user.PublicID())
+	pending_export = create_test_ready_data_export(t, export_repo, export_storage, pending_user.PublicID())
+	use_case = NewPurgeDeletedUsersUseCase(
+		user_repo, follow_repo, firebase_deleter, image_repo, storage, export_repo, export_storage,
+	)
+
+	purged_count, err = use_case.Execute(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if purged_count != 1 || len(firebase_deleter.deleted_uids) != 1 || len(follow_repo.purged_user_ids) != 1 {
+		t.Errorf("expected 1 user to be purged, got %d", purged_count)
+	}
+	_, is_found = user_repo.users[expired_user.PublicID()]
+	if is_found {
+		t.Error("expected expired user to be hard-deleted")
+	}
+	_, is_found = user_repo.users[pending_user.PublicID()]
+	if !is_found {
+		t.Error("expected user within grace period to remain")
+	}
+	for _, variant := range expired_image.Variants() {
+		_, is_found = storage.objects[variant.StorageKey()]
+		if is_found {
+			t.Errorf("expected image %s of the expired user to be deleted", variant.StorageKey())
+		}
+	}
+	for _, variant := range pending_image.Variants() {
+		_, is_found = storage.objects[variant.StorageKey()]
+		if !is_found {
+			t.Errorf("expected image %s of the user within grace period to remain", variant.StorageKey())
+		}
+	}
+	_, is_found = export_storage.files[expired_export.StorageKey()]
+	if is_found {
+		t.Errorf("expected export %s of the expired user to be deleted", expired_export.StorageKey())
+	}
+	_, is_found = export_storage.files[pending_export.StorageKey()]
+	if !is_found {
+		t.Errorf("expected export %s of the user within grace period to remain", pending_export.StorageKey())
+	}
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ExpiredAuthAttemptPurgerInterface は有効期限切れの認証の失敗回数の記録を削除するインターフェースです
+type ExpiredAuthAttemptPurgerInterface interface {
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// PurgeExpiredAuthAttemptsUseCase は有効期限切れの認証の失敗回数の記録を削除するユースケースです
+// 失敗回数は有効期限を過ぎると0として扱われるため、削除しても判定は変わりません
+type PurgeExpiredAuthAttemptsUseCase struct {
+	attempt_repo ExpiredAuthAttemptPurgerInterface
+}
+
+// NewPurgeExpiredAuthAttemptsUseCase は新しいPurgeExpiredAuthAttemptsUseCaseを作成します
+func NewPurgeExpiredAuthAttemptsUseCase(attempt_repo ExpiredAuthAttemptPurgerInterface) *PurgeExpiredAuthAttemptsUseCase {
+	return &PurgeExpiredAuthAttemptsUseCase{
+		attempt_repo: attempt_repo,
+	}
+}
+
+// Execute は有効期限切れの記録を削除し、削除件数を返します
+func (uc *PurgeExpiredAuthAttemptsUseCase) Execute(ctx context.Context) (int, error) {
+	var deleted_count int
+	var err error
+
+	deleted_count, err = uc.attempt_repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return deleted_count, nil
+}
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// dataExportPurgeBatchSize は1回の問い合わせで削除するエクスポートの件数です
+const dataExportPurgeBatchSize = 100
+
+// ExpiredDataExportPurgerInterface はダウンロードの有効期限を過ぎたエクスポートを検索し、削除するインターフェースです
+type ExpiredDataExportPurgerInterface interface {
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error)
+	Delete(ctx context.Context, export_id uuid.UUID) error
+}
+
+// DataExportFileDeleterInterface はエクスポートのZIPファイルを削除するインターフェースです
+type DataExportFileDeleterInterface interface {
+	// Delete はファイルが存在しない場合も成功とします
+	Delete(ctx context.Context, key string) error
+}
+
+// PurgeExpiredDataExportsUseCase はダウンロードの有効期限を過ぎたエクスポートのZIPファイルと記録を削除するユースケースです
+type PurgeExpiredDataExportsUseCase struct {
+	export_repo ExpiredDataExportPurgerInterface
+	storage     DataExportFileDeleterInterface
+}
+
+// NewPurgeExpiredDataExportsUseCase は新しいPurgeExpiredDataExportsUseCaseを作成します
+func NewPurgeExpiredDataExportsUseCase(export_repo ExpiredDataExportPurgerInterface, storage DataExportFileDeleterInterface) *PurgeExpiredDataExportsUseCase {
+	return &PurgeExpiredDataExportsUseCase{
+		export_repo: export_repo,
+		storage:     storage,
+	}
+}
+
+// Execute は有効期限を過ぎたエクスポートを削除し、削除件数を返します
+// ZIPファイルを先に削除し、記録の削除に失敗した場合も次回の実行で同じエクスポートを再度処理できるようにします
+func (uc *PurgeExpiredDataExportsUseCase) Execute(ctx context.Context) (int, error) {
+	var now time.Time
+	var data_exports []*models.DataExport
+	var deleted_count int
+	var err error
+
+	now = time.Now()
+	for {
+		data_exports, err = uc.export_repo.FindExpired(ctx, now, dataExportPurgeBatchSize)
+		if err != nil {
+			return deleted_count, fmt.Errorf("%w", err)
+		}
+		for _, data_export := range data_exports {
+			if data_export.StorageKey() != "" {
+				err = uc.storage.Delete(ctx, data_export.StorageKey())
+				if err != nil {
+					return deleted_count, fmt.Errorf("%w", err)
+				}
+			}
+			err = uc.export_repo.Delete(ctx, data_export.ExportID())
+			if err != nil {
+				return deleted_count, fmt.Errorf("%w", err)
+			}
+			deleted_count++
+		}
+		if len(data_exports) < dataExportPurgeBatchSize {
+			return deleted_count, nil
+		}
+	}
+}
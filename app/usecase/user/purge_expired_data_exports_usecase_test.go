@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// TestPurgeExpiredDataExportsUseCase_Execute は有効期限を過ぎたエクスポートのZIPファイルと記録のみが削除されることをテストします
+func TestPurgeExpiredDataExportsUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var export_repo *MockDataExportRepository
+	var storage *MockDataExportStorage
+	var expired *models.DataExport
+	var active *models.DataExport
+	var pending *models.DataExport
+	var deleted_count int
+	var is_found bool
+	var err error
+
+	ctx = context.Background()
+	export_repo = NewMockDataExportRepository()
+	storage = NewMockDataExportStorage()
+	expired, _ = models.NewDataExport(create_test_account_user(t).PublicID(), time.Now())
+	_ = expired.MarkReady(models.DataExportStorageKey(expired.ExportID()), time.Now().Add(-models.DataExportDownloadPeriod-time.Minute))
+	active, _ = models.NewDataExport(create_test_account_user(t).PublicID(), time.Now())
+	_ = active.MarkReady(models.DataExportStorageKey(active.ExportID()), time.Now())
+	pending, _ = models.NewDataExport(create_test_account_user(t).PublicID(), time.Now())
+	for _, data_export := range []*models.DataExport{expired, active, pending} {
+		_ = export_repo.Create(ctx, data_export)
+		if data_export.StorageKey() != "" {
+			_ = storage.Save(ctx, data_export.StorageKey(), []byte("zip"))
+		}
+	}
+
+	deleted_count, err = NewPurgeExpiredDataExportsUseCase(export_repo, storage).Execute(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted_count != 1 || len(export_repo.exports) != 2 {
+		t.Errorf("expected 1 export to be deleted, got %d (remaining=%d)", deleted_count, len(export_repo.exports))
+	}
+	_, is_found = storage.files[expired.StorageKey()]
+	if is_found {
+		t.Error("expected expired zip file to be deleted")
+	}
+	if len(storage.files) != 1 {
+		t.Errorf("expected active zip file to remain, got %d files", len(storage.files))
+	}
+}
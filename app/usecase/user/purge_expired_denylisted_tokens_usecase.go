@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ExpiredDenylistedTokenPurgerInterface は有効期限切れのdenylistの登録を削除するインターフェースです
+type ExpiredDenylistedTokenPurgerInterface interface {
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// PurgeExpiredDenylistedTokensUseCase は有効期限切れのdenylistの登録を削除するユースケースです
+// 登録の有効期限は対象のトークンが全て期限切れになる日時のため、削除しても判定は変わりません
+type PurgeExpiredDenylistedTokensUseCase struct {
+	denylist_repo ExpiredDenylistedTokenPurgerInterface
+}
+
+// NewPurgeExpiredDenylistedTokensUseCase は新しいPurgeExpiredDenylistedTokensUseCaseを作成します
+func NewPurgeExpiredDenylistedTokensUseCase(denylist_repo ExpiredDenylistedTokenPurgerInterface) *PurgeExpiredDenylistedTokensUseCase {
+	return &PurgeExpiredDenylistedTokensUseCase{
+		denylist_repo: denylist_repo,
+	}
+}
+
+// Execute は有効期限切れの登録を削除し、削除件数を返します
+func (uc *PurgeExpiredDenylistedTokensUseCase) Execute(ctx context.Context) (int, error) {
+	var deleted_count int
+	var err error
+
+	deleted_count, err = uc.denylist_repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return deleted_count, nil
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ExpiredIdempotencyRecordPurgerInterface は有効期限切れの冪等キーの記録を削除するインターフェースです
+type ExpiredIdempotencyRecordPurgerInterface interface {
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// PurgeExpiredIdempotencyRecordsUseCase は有効期限切れの冪等キーの記録を削除するユースケースです
+// 有効期限切れの記録は同じ冪等キーでも新しいリクエストとして扱われるため、削除しても判定は変わりません
+type PurgeExpiredIdempotencyRecordsUseCase struct {
+	idempotency_repo ExpiredIdempotencyRecordPurgerInterface
+}
+
+// NewPurgeExpiredIdempotencyRecordsUseCase は新しいPurgeExpiredIdempotencyRecordsUseCaseを作成します
+func NewPurgeExpiredIdempotencyRecordsUseCase(idempotency_repo ExpiredIdempotencyRecordPurgerInterface) *PurgeExpiredIdempotencyRecordsUseCase {
+	return &PurgeExpiredIdempotencyRecordsUseCase{
+		idempotency_repo: idempotency_repo,
+	}
+}
+
+// Execute は有効期限切れの記録を削除し、削除件数を返します
+func (uc *PurgeExpiredIdempotencyRecordsUseCase) Execute(ctx context.Context) (int, error) {
+	var deleted_count int
+	var err error
+
+	deleted_count, err = uc.idempotency_repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return deleted_count, nil
+}
@@ -0,0 +1,190 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+const (
+	// reconcileGracePeriod は整合性チェックの対象外とする作成直後の期間です
+	// 登録処理の途中（Firebaseユーザーの作成後、DBへの保存前など）のアカウントを孤立したものと誤検出しないためです
+	reconcileGracePeriod = time.Hour
+	// reconcileUserPageSize はusersテーブルを走査する際の1ページの件数です
+	reconcileUserPageSize = 100
+)
+
+// FirebaseAccountListerInterface はFirebaseのアカウントを列挙するインターフェースです
+type FirebaseAccountListerInterface interface {
+	ListAccounts(ctx context.Context, page_token string) ([]*models.FirebaseAccount, string, error)
+	FindMissingUIDs(ctx context.Context, firebase_uids []string) ([]string, error)
+}
+
+// UserReconciliationRepositoryInterface は整合性チェックで使用するユーザーリポジトリのインターフェースです
+type UserReconciliationRepositoryInterface interface {
+	ListActiveAfterFirebaseUID(ctx context.Context, after_firebase_uid string, limit int) ([]*models.User, error)
+	FindExistingFirebaseUIDs(ctx context.Context, firebase_uids []string) (map[string]bool, error)
+	HardDeleteByFirebaseUID(ctx context.Context, firebase_uid string) error
+}
+
+// ReconcileUsersReport はFirebaseとusersテーブルの整合性チェックの結果を表します
+type ReconcileUsersReport struct {
+	// FirebaseOrphans はFirebaseにのみ存在し、usersテーブルに行がないアカウントです（同じメールアドレスで再登録できなくなります）
+	FirebaseOrphans []*models.FirebaseAccount
+	// DatabaseOrphans はusersテーブルにのみ存在し、Firebaseにアカウントがないユーザーです（ログインできなくなります）
+	DatabaseOrphans []*models.User
+	// RepairedFirebaseOrphans は削除した、または補償処理として再試行を登録したFirebaseのアカウントの件数です
+	RepairedFirebaseOrphans int
+	// RepairedDatabaseOrphans は物理削除したusersテーブルの行の件数です
+	RepairedDatabaseOrphans int
+}
+
+// ReconcileUsersUseCase はFirebaseのアカウントとusersテーブルを突き合わせ、孤立したレコードを報告・修復するユースケースです
+type ReconcileUsersUseCase struct {
+	account_lister FirebaseAccountListerInterface
+	user_repo      UserReconciliationRepositoryInterface
+	compensator    *FirebaseUserCompensator
+}
+
+// NewReconcileUsersUseCase は新しいReconcileUsersUseCaseを作成します
+func NewReconcileUsersUseCase(
+	account_lister FirebaseAccountListerInterface,
+	user_repo UserReconciliationRepositoryInterface,
+	compensator *FirebaseUserCompensator,
+) *ReconcileUsersUseCase {
+	return &ReconcileUsersUseCase{
+		account_lister: account_lister,
+		user_repo:      user_repo,
+		compensator:    compensator,
+	}
+}
+
+// Execute は整合性チェックを実行します
+// repairがtrueの場合、孤立したFirebaseのアカウントを削除し、孤立したusersテーブルの行を物理削除します
+func (uc *ReconcileUsersUseCase) Execute(ctx context.Context, repair bool) (*ReconcileUsersReport, error) {
+	var report *ReconcileUsersReport
+	var cutoff time.Time
+	var err error
+
+	report = &ReconcileUsersReport{
+		FirebaseOrphans: []*models.FirebaseAccount{},
+		DatabaseOrphans: []*models.User{},
+	}
+	cutoff = time.Now().Add(-reconcileGracePeriod)
+	err = uc.find_firebase_orphans(ctx, cutoff, report)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.find_database_orphans(ctx, cutoff, report)
+	if err != nil {
+		return nil, err
+	}
+	if !repair {
+		return report, nil
+	}
+	for _, account := range report.FirebaseOrphans {
+		err = uc.compensator.DeleteFirebaseUser(ctx, account.UID())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		report.RepairedFirebaseOrphans++
+	}
+	for _, user := range report.DatabaseOrphans {
+		err = uc.user_repo.HardDeleteByFirebaseUID(ctx, user.FirebaseUID())
+		if err != nil {
+			// 走査後に削除されたユーザーは修復済みとして扱う
+			if errors.Is(err, domain_errors.ErrUserNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("%w", err)
+		}
+		report.RepairedDatabaseOrphans++
+	}
+	return report, nil
+}
+
+// find_firebase_orphans はFirebaseのアカウントを全て走査し、usersテーブルに行がないアカウントを報告に追加します
+// 削除済みのユーザーの行も存在するものとして扱います
+func (uc *ReconcileUsersUseCase) find_firebase_orphans(ctx context.Context, cutoff time.Time, report *ReconcileUsersReport) error {
+	var page_token string
+
+	for {
+		var accounts []*models.FirebaseAccount
+		var candidates []*models.FirebaseAccount
+		var firebase_uids []string
+		var existing map[string]bool
+		var err error
+
+		accounts, page_token, err = uc.account_lister.ListAccounts(ctx, page_token)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		for _, account := range accounts {
+			if account.CreatedAt().After(cutoff) {
+				continue
+			}
+			candidates = append(candidates, account)
+			firebase_uids = append(firebase_uids, account.UID())
+		}
+		existing, err = uc.user_repo.FindExistingFirebaseUIDs(ctx, firebase_uids)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		for _, account := range candidates {
+			if !existing[account.UID()] {
+				report.FirebaseOrphans = append(report.FirebaseOrphans, account)
+			}
+		}
+		if page_token == "" {
+			return nil
+		}
+	}
+}
+
+// find_database_orphans はusersテーブルの削除されていない行を全て走査し、Firebaseにアカウントがないユーザーを報告に追加します
+// カスタムトークンでサインインするユーザー（LINE）はサインインするまでFirebaseに存在しないため対象外とします
+func (uc *ReconcileUsersUseCase) find_database_orphans(ctx context.Context, cutoff time.Time, report *ReconcileUsersReport) error {
+	var after_firebase_uid string
+
+	after_firebase_uid = ""
+	for {
+		var users []*models.User
+		var candidates map[string]*models.User
+		var firebase_uids []string
+		var missing_uids []string
+		var err error
+
+		users, err = uc.user_repo.ListActiveAfterFirebaseUID(ctx, after_firebase_uid, reconcileUserPageSize)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		if len(users) == 0 {
+			return nil
+		}
+		after_firebase_uid = users[len(users)-1].FirebaseUID()
+		candidates = map[string]*models.User{}
+		for _, user := range users {
+			if user.CreatedAt().After(cutoff) || models.IsCustomTokenFirebaseUID(user.FirebaseUID()) {
+				continue
+			}
+			candidates[user.FirebaseUID()] = user
+			firebase_uids = append(firebase_uids, user.FirebaseUID())
+		}
+		if len(firebase_uids) > 0 {
+			missing_uids, err = uc.account_lister.FindMissingUIDs(ctx, firebase_uids)
+			if err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+		for _, firebase_uid := range missing_uids {
+			report.DatabaseOrphans = append(report.DatabaseOrphans, candidates[firebase_uid])
+		}
+		if len(users) < reconcileUserPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// reconcileTestContext は整合性チェックのテストで共有する依存関係です
+type reconcileTestContext struct {
+	mock_firebase  *MockFirebaseUserRepository
+	account_lister *MockFirebaseAccountLister
+	user_repo      *MockUserReconciliationRepository
+	task_repo      *MockCompensationTaskRepository
+	use_case       *ReconcileUsersUseCase
+}
+
+// setupReconcileTest は整合性チェックのテスト用の依存関係を作成します
+// Firebaseにのみuid_firebase_only、usersテーブルにのみuid_db_only、両方にuid_bothが存在します
+func setupReconcileTest(t *testing.T) *reconcileTestContext {
+	var test_context *reconcileTestContext
+	var created_at time.Time
+
+	t.Helper()
+	created_at = time.Now().Add(-2 * reconcileGracePeriod)
+	test_context = &reconcileTestContext{
+		mock_firebase: NewMockFirebaseUserRepository(),
+		account_lister: NewMockFirebaseAccountLister(
+			create_test_firebase_account(t, "uid_both", created_at),
+			create_test_firebase_account(t, "uid_firebase_only", created_at),
+		),
+		user_repo: NewMockUserReconciliationRepository(
+			create_test_user_with_firebase_uid(t, "uid_both", created_at),
+			create_test_user_with_firebase_uid(t, "uid_db_only", created_at),
+		),
+		task_repo: NewMockCompensationTaskRepository(),
+	}
+	test_context.use_case = NewReconcileUsersUseCase(
+		test_context.account_lister,
+		test_context.user_repo,
+		NewFirebaseUserCompensator(test_context.mock_firebase, test_context.task_repo),
+	)
+	return test_context
+}
+
+// create_test_firebase_account はテスト用のFirebaseのアカウントを作成します
+func create_test_firebase_account(t *testing.T, firebase_uid string, created_at time.Time) *models.FirebaseAccount {
+	var account *models.FirebaseAccount
+	var err error
+
+	t.Helper()
+	account, err = models.NewFirebaseAccount(firebase_uid, firebase_uid+"@example.com", created_at)
+	if err != nil {
+		t.Fatalf("failed to create firebase account: %v", err)
+	}
+	return account
+}
+
+// create_test_user_with_firebase_uid はテスト用のユーザーを指定したFirebase UIDと作成日時で作成します
+func create_test_user_with_firebase_uid(t *testing.T, firebase_uid string, created_at time.Time) *models.User {
+	var email models.Email
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(strings.ReplaceAll(firebase_uid, ":", "_") + "@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = models.NewUserWithPublicID(uuid.New(), firebase_uid, email, models.RoleUser, nil, nil, created_at, created_at, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestReconcileUsersUseCase_Execute_ReportOnly は修復せずに両方の孤立したレコードを報告することをテストします
+func TestReconcileUsersUseCase_Execute_ReportOnly(t *testing.T) {
+	var test_context *reconcileTestContext
+	var report *ReconcileUsersReport
+	var err error
+
+	test_context = setupReconcileTest(t)
+	report, err = test_context.use_case.Execute(context.Background(), false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(report.FirebaseOrphans) != 1 || report.FirebaseOrphans[0].UID() != "uid_firebase_only" {
+		t.Errorf("expected uid_firebase_only to be reported, got %v", report.FirebaseOrphans)
+	}
+	if len(report.DatabaseOrphans) != 1 || report.DatabaseOrphans[0].FirebaseUID() != "uid_db_only" {
+		t.Errorf("expected uid_db_only to be reported, got %v", report.DatabaseOrphans)
+	}
+	if report.RepairedFirebaseOrphans != 0 || report.RepairedDatabaseOrphans != 0 {
+		t.Errorf("expected nothing to be repaired, got %+v", report)
+	}
+	if test_context.mock_firebase.DeleteUserCalled || len(test_context.user_repo.users) != 2 {
+		t.Error("expected no records to be deleted without repair")
+	}
+}
+
+// TestReconcileUsersUseCase_Execute_Repair は孤立したFirebaseのアカウントとusersテーブルの行が削除されることをテストします
+func TestReconcileUsersUseCase_Execute_Repair(t *testing.T) {
+	var test_context *reconcileTestContext
+	var report *ReconcileUsersReport
+	var err error
+
+	test_context = setupReconcileTest(t)
+	report, err = test_context.use_case.Execute(context.Background(), true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if report.RepairedFirebaseOrphans != 1 || report.RepairedDatabaseOrphans != 1 {
+		t.Errorf("expected 1 repaired orphan each, got %+v", report)
+	}
+	if !test_context.mock_firebase.DeleteUserCalled {
+		t.Error("expected firebase orphan to be deleted")
+	}
+	if len(test_context.user_repo.users) != 1 || test_context.user_repo.users[0].FirebaseUID() != "uid_both" {
+		t.Errorf("expected only uid_both to remain, got %v", test_context.user_repo.users)
+	}
+}
+
+// TestReconcileUsersUseCase_Execute_RepairFailureRecorded はFirebaseのアカウントの削除に失敗した場合に補償処理が記録されることをテストします
+func TestReconcileUsersUseCase_Execute_RepairFailureRecorded(t *testing.T) {
+	var test_context *reconcileTestContext
+	var report *ReconcileUsersReport
+	var err error
+
+	test_context = setupReconcileTest(t)
+	test_context.mock_firebase.should_return_delete_error = true
+	report, err = test_context.use_case.Execute(context.Background(), true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if report.RepairedFirebaseOrphans != 1 {
+		t.Errorf("expected firebase orphan to be queued, got %+v", report)
+	}
+	if len(test_context.task_repo.tasks) != 1 {
+		t.Errorf("expected 1 compensation task, got %d", len(test_context.task_repo.tasks))
+	}
+}
+
+// TestReconcileUsersUseCase_Execute_SkipsRecentAndCustomTokenUsers は作成直後のレコードとLINEのユーザーを対象外とすることをテストします
+func TestReconcileUsersUseCase_Execute_SkipsRecentAndCustomTokenUsers(t *testing.T) {
+	var now time.Time
+	var created_at time.Time
+	var use_case *ReconcileUsersUseCase
+	var report *ReconcileUsersReport
+	var err error
+
+	now = time.Now()
+	created_at = now.Add(-2 * reconcileGracePeriod)
+	use_case = NewReconcileUsersUseCase(
+		NewMockFirebaseAccountLister(create_test_firebase_account(t, "uid_registering", now)),
+		NewMockUserReconciliationRepository(
+			create_test_user_with_firebase_uid(t, "uid_new", now),
+			create_test_user_with_firebase_uid(t, "line:U1234567890", created_at),
+		),
+		NewFirebaseUserCompensator(NewMockFirebaseUserRepository(), NewMockCompensationTaskRepository()),
+	)
+	report, err = use_case.Execute(context.Background(), true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(report.FirebaseOrphans) != 0 || len(report.DatabaseOrphans) != 0 {
+		t.Errorf("expected no orphans, got %d firebase and %d database orphans", len(report.FirebaseOrphans), len(report.DatabaseOrphans))
+	}
+}
+
+// TestReconcileUsersUseCase_Execute_FirebaseError はFirebaseのエラーをそのまま返すことをテストします
+func TestReconcileUsersUseCase_Execute_FirebaseError(t *testing.T) {
+	var test_context *reconcileTestContext
+	var err error
+
+	test_context = setupReconcileTest(t)
+	test_context.account_lister.should_return_error = true
+	_, err = test_context.use_case.Execute(context.Background(), false)
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Errorf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+}
+
+// MockFirebaseAccountLister はテスト用のFirebaseのアカウント一覧のモックです
+// ページングを確認するため、1ページに1件ずつ返します
+type MockFirebaseAccountLister struct {
+	accounts            []*models.FirebaseAccount
+	should_return_error bool
+}
+
+// NewMockFirebaseAccountLister は指定したアカウントを持つMockFirebaseAccountListerを作成します
+func NewMockFirebaseAccountLister(accounts ...*models.FirebaseAccount) *MockFirebaseAccountLister {
+	return &MockFirebaseAccountLister{
+		accounts:            accounts,
+		should_return_error: false,
+	}
+}
+
+// ListAccounts はモックのアカウントを1ページ分返します
+func (m *MockFirebaseAccountLister) ListAccounts(_ context.Context, page_token string) ([]*models.FirebaseAccount, string, error) {
+	var index int
+
+	if m.should_return_error {
+		return nil, "", domain_errors.ErrFirebaseAuthFailed
+	}
+	if page_token != "" {
+		index, _ = strconv.Atoi(page_token)
+	}
+	if index >= len(m.accounts) {
+		return []*models.FirebaseAccount{}, "", nil
+	}
+	if index+1 == len(m.accounts) {
+		return m.accounts[index : index+1], "", nil
+	}
+	return m.accounts[index : index+1], strconv.Itoa(index + 1), nil
+}
+
+// FindMissingUIDs はモックに存在しないFirebase UIDを返します
+func (m *MockFirebaseAccountLister) FindMissingUIDs(_ context.Context, firebase_uids []string) ([]string, error) {
+	var missing_uids []string
+
+	if m.should_return_error {
+		return nil, domain_errors.ErrFirebaseAuthFailed
+	}
+	for _, firebase_uid := range firebase_uids {
+		if !slices.ContainsFunc(m.accounts, func(account *models.FirebaseAccount) bool { return account.UID() == firebase_uid }) {
+			missing_uids = append(missing_uids, firebase_uid)
+		}
+	}
+	return missing_uids, nil
+}
+
+// MockUserReconciliationRepository はテスト用の整合性チェック用ユーザーリポジトリのモックです
+type MockUserReconciliationRepository struct {
+	users []*models.User
+}
+
+// NewMockUserReconciliationRepository は指定したユーザーを持つMockUserReconciliationRepositoryを作成します
+func NewMockUserReconciliationRepository(users ...*models.User) *MockUserReconciliationRepository {
+	return &MockUserReconciliationRepository{
+		users: users,
+	}
+}
+
+// ListActiveAfterFirebaseUID はモックのユーザーをFirebase UIDの昇順に返します
+func (m *MockUserReconciliationRepository) ListActiveAfterFirebaseUID(_ context.Context, after_firebase_uid string, limit int) ([]*models.User, error) {
+	var users []*models.User
+
+	for _, user := range m.users {
+		if user.FirebaseUID() > after_firebase_uid {
+			users = append(users, user)
+		}
+	}
+	slices.SortFunc(users, func(a *models.User, b *models.User) int {
+		return strings.Compare(a.FirebaseUID(), b.FirebaseUID())
+	})
+	return users[:min(limit, len(users))], nil
+}
+
+// FindExistingFirebaseUIDs はモックに存在するFirebase UIDを返します
+func (m *MockUserReconciliationRepository) FindExistingFirebaseUIDs(_ context.Context, firebase_uids []string) (map[string]bool, error) {
+	var existing map[string]bool
+
+	existing = map[string]bool{}
+	for _, user := range m.users {
+		if slices.Contains(firebase_uids, user.FirebaseUID()) {
+			existing[user.FirebaseUID()] = true
+		}
+	}
+	return existing, nil
+}
+
+// HardDeleteByFirebaseUID はモックのユーザーを削除します
+func (m *MockUserReconciliationRepository) HardDeleteByFirebaseUID(_ context.Context, firebase_uid string) error {
+	var index int
+
+	index = slices.IndexFunc(m.users, func(user *models.User) bool { return user.FirebaseUID() == firebase_uid })
+	if index < 0 {
+		return domain_errors.ErrUserNotFound
+	}
+	m.users = slices.Delete(m.users, index, index+1)
+	return nil
+}
@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenRepositoryInterface はリフレッシュトークンの検索・ローテーションを行うインターフェースです
+// ファミリーの失効はUserSessionRevokerで行います
+type RefreshTokenRepositoryInterface interface {
+	FindByTokenID(ctx context.Context, token_id string) (*models.RefreshToken, error)
+	MarkRotated(ctx context.Context, token_id string, rotated_at time.Time) (bool, error)
+}
+
+// CredentialChangeCheckerInterface はパスワードリセットなどで認証情報が変更された日時を取得するインターフェースです
+type CredentialChangeCheckerInterface interface {
+	TokensValidAfter(ctx context.Context, firebase_uid string) (time.Time, error)
+}
+
+// RefreshTokensResult はトークンリフレッシュの結果を表します
+type RefreshTokensResult struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshTokensUseCase はリフレッシュトークンをローテーションして新しいJWTペアを発行するユースケースです
+type RefreshTokensUseCase struct {
+	jwt_service        *utils.JWTService
+	token_issuer       *TokenIssuer
+	refresh_token_repo RefreshTokenRepositoryInterface
+	user_finder        UserFinderInterface
+	credential_checker CredentialChangeCheckerInterface
+	session_revoker    *UserSessionRevoker
+}
+
+// NewRefreshTokensUseCase は新しいRefreshTokensUseCaseを作成します
+// パスワードリセットの完了後、変更前に発行されたリフレッシュトークンが提示された場合はユーザーの全セッションを失効させます
+func NewRefreshTokensUseCase(
+	jwt_service *utils.JWTService,
+	token_issuer *TokenIssuer,
+	refresh_token_repo RefreshTokenRepositoryInterface,
+	user_finder UserFinderInterface,
+	credential_checker CredentialChangeCheckerInterface,
+	session_revoker *UserSessionRevoker,
+) *RefreshTokensUseCase {
+	return &RefreshTokensUseCase{
+		jwt_service:        jwt_service,
+		token_issuer:       token_issuer,
+		refresh_token_repo: refresh_token_repo,
+		user_finder:        user_finder,
+		credential_checker: credential_checker,
+		session_revoker:    session_revoker,
+	}
+}
+
+// Execute はリフレッシュトークンを検証・ローテーションし、新しいJWTペアを発行します
+// ローテーション済みのトークンが提示された場合は、同じファミリーのトークンを全て失効させます
+func (uc *RefreshTokensUseCase) Execute(ctx context.Context, refresh_token_string string) (*RefreshTokensResult, error) {
+	var claims *utils.JWTClaims
+	var stored_token *models.RefreshToken
+	var user *models.User
+	var now time.Time
+	var is_rotated bool
+	var token_pair *utils.TokenPair
+	var err error
+
+	// 署名・有効期限の検証
+	claims, err = uc.jwt_service.ValidateToken(ctx, refresh_token_string)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidRefreshToken, err)
+	}
+
+	// アクセストークンでのリフレッシュは拒否
+	if claims.TokenType != utils.TokenTypeRefresh {
+		return nil, fmt.Errorf("%w: unexpected token type %s", domain_errors.ErrInvalidRefreshToken, claims.TokenType)
+	}
+
+	// 記録済みのトークンかを確認
+	stored_token, err = uc.refresh_token_repo.FindByTokenID(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if stored_token.UserID().String() != claims.UserID {
+		return nil, fmt.Errorf("%w: token owner mismatch", domain_errors.ErrInvalidRefreshToken)
+	}
+	if stored_token.IsRevoked() {
+		return nil, fmt.Errorf("%w: token family revoked", domain_errors.ErrInvalidRefreshToken)
+	}
+
+	// 使用済みトークンの再利用を検出した場合はファミリーごと失効
+	now = time.Now()
+	if stored_token.IsRotated() {
+		return nil, uc.revoke_family(ctx, stored_token.FamilyID(), now)
+	}
+	is_rotated, err = uc.refresh_token_repo.MarkRotated(ctx, stored_token.TokenID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !is_rotated {
+		// 並行リクエストで先にローテーションされた場合も再利用として扱う
+		return nil, uc.revoke_family(ctx, stored_token.FamilyID(), now)
+	}
+
+	// ユーザーの状態を確認
+	user, err = uc.user_finder.FindByFirebaseUID(ctx, claims.FirebaseUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.PublicID().String() != claims.UserID {
+		return nil, fmt.Errorf("%w: user mismatch", domain_errors.ErrInvalidRefreshToken)
+	}
+	if user.IsDeleted() {
+		return nil, uc.revoke_family_with_error(ctx, stored_token.FamilyID(), now, domain_errors.ErrUserDeleted)
+	}
+
+	// パスワードリセットなどで認証情報が変更された後は、変更前のセッションを全て失効
+	err = uc.revoke_sessions_if_credentials_changed(ctx, claims, user, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じファミリーで新しいJWTペアを発行
+	token_pair, err = uc.token_issuer.IssueTokenPairInFamily(ctx, user, stored_token.FamilyID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &RefreshTokensResult{
+		AccessToken:  token_pair.AccessToken,
+		RefreshToken: token_pair.RefreshToken,
+	}, nil
+}
+
+// revoke_family はトークンの再利用を検出した際にファミリーを失効させ、再利用エラーを返します
+func (uc *RefreshTokensUseCase) revoke_family(ctx context.Context, family_id uuid.UUID, now time.Time) error {
+	return uc.revoke_family_with_error(ctx, family_id, now, domain_errors.ErrRefreshTokenReused)
+}
+
+// revoke_family_with_error はファミリーを失効させ、指定されたエラーを返します
+// 同じセッションのアクセストークンとログイン中の端末の記録も失効させます
+func (uc *RefreshTokensUseCase) revoke_family_with_error(ctx context.Context, family_id uuid.UUID, now time.Time, cause error) error {
+	var err error
+
+	err = uc.session_revoker.RevokeSession(ctx, family_id.String(), now)
+	if err != nil {
+		return fmt.Errorf("%w: %w", cause, err)
+	}
+	return fmt.Errorf("%w: family_id=%s", cause, family_id.String())
+}
+
+// revoke_sessions_if_credentials_changed はトークンの発行後に認証情報が変更されていた場合、ユーザーの全セッションを失効させます
+func (uc *RefreshTokensUseCase) revoke_sessions_if_credentials_changed(
+	ctx context.Context,
+	claims *utils.JWTClaims,
+	user *models.User,
+	now time.Time,
+) error {
+	var valid_after time.Time
+	var err error
+
+	if claims.IssuedAt == nil {
+		return nil
+	}
+	valid_after, err = uc.credential_checker.TokensValidAfter(ctx, user.FirebaseUID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	// JWTのiatは秒単位のため、秒単位で比較する
+	if !claims.IssuedAt.Time.Before(valid_after.Truncate(time.Second)) {
+		return nil
+	}
+	err = uc.session_revoker.RevokeAllSessions(ctx, user.PublicID(), now)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrTokenRevoked, err)
+	}
+	return fmt.Errorf("%w: credentials changed at %s", domain_errors.ErrTokenRevoked, valid_after.Format(time.RFC3339))
+}
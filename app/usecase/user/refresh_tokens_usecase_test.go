@@ -0,0 +1,378 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// setupRefreshTokensTest はリフレッシュテスト用にログイン済みの状態を作成します
+func setupRefreshTokensTest(t *testing.T, user_finder *MockUserFinder) (*RefreshTokensUseCase, *MockRefreshTokenRepository, *LoginUserResult) {
+	var jwt_service *utils.JWTService
+	var refresh_token_repo *MockRefreshTokenRepository
+	var token_issuer *TokenIssuer
+	var login_use_case *LoginUserUseCase
+	var login_result *LoginUserResult
+	var err error
+
+	t.Helper()
+	jwt_service = new_test_jwt_service(NewMockTokenDenylist())
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	token_issuer = NewTokenIssuer(jwt_service, refresh_token_repo, NewMockSessionRepository())
+	login_use_case = new_test_login_user_use_case(NewMockFirebaseTokenVerifier(), user_finder, token_issuer)
+	login_result, err = login_use_case.Execute(context.Background(), testIDToken)
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	return NewRefreshTokensUseCase(
+		jwt_service,
+		token_issuer,
+		refresh_token_repo,
+		user_finder,
+		NewMockCredentialChangeChecker(time.Time{}),
+		NewUserSessionRevoker(NewMockTokenDenylist(), refresh_token_repo, NewMockSessionRepository()),
+	), refresh_token_repo, login_result
+}
+
+// TestRefreshTokensUseCase_Execute_Success は正常なトークンリフレッシュをテストします
+func TestRefreshTokensUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var refresh_token_repo *MockRefreshTokenRepository
+	var login_result *LoginUserResult
+	var result *RefreshTokensResult
+	var err error
+
+	ctx = context.Background()
+	use_case, refresh_token_repo, login_result = setupRefreshTokensTest(t, NewMockUserFinder())
+	result, err = use_case.Execute(ctx, login_result.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.AccessToken == "" {
+		t.Error("expected access_token to be non-empty")
+	}
+	if result.RefreshToken == login_result.RefreshToken {
+		t.Error("expected refresh_token to be rotated")
+	}
+	// 新しいリフレッシュトークンが同じファミリーで記録されていることを確認
+	if len(refresh_token_repo.tokens) != 2 {
+		t.Fatalf("expected 2 stored tokens, got %d", len(refresh_token_repo.tokens))
+	}
+	if refresh_token_repo.tokens[0].FamilyID() != refresh_token_repo.tokens[1].FamilyID() {
+		t.Error("expected rotated token to belong to the same family")
+	}
+	// 続けて新しいトークンでもリフレッシュできることを確認
+	_, err = use_case.Execute(ctx, result.RefreshToken)
+	if err != nil {
+		t.Errorf("expected rotated refresh token to be usable, got %v", err)
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_ReuseRevokesFamily はローテーション済みトークンの再利用でファミリーが失効することをテストします
+func TestRefreshTokensUseCase_Execute_ReuseRevokesFamily(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var refresh_token_repo *MockRefreshTokenRepository
+	var login_result *LoginUserResult
+	var result *RefreshTokensResult
+	var err error
+
+	ctx = context.Background()
+	use_case, refresh_token_repo, login_result = setupRefreshTokensTest(t, NewMockUserFinder())
+	result, err = use_case.Execute(ctx, login_result.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected first refresh to succeed, got %v", err)
+	}
+	// 使用済みのトークンを再提示
+	_, err = use_case.Execute(ctx, login_result.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrRefreshTokenReused) {
+		t.Fatalf("expected ErrRefreshTokenReused, got %v", err)
+	}
+	if !refresh_token_repo.RevokeFamilyCalled {
+		t.Error("expected RevokeFamily to be called")
+	}
+	// 正規のクライアントが持つ最新トークンも失効していることを確認
+	_, err = use_case.Execute(ctx, result.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken for revoked family, got %v", err)
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_AccessTokenRejected はアクセストークンでのリフレッシュが拒否されることをテストします
+func TestRefreshTokensUseCase_Execute_AccessTokenRejected(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var login_result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	use_case, _, login_result = setupRefreshTokensTest(t, NewMockUserFinder())
+	_, err = use_case.Execute(ctx, login_result.AccessToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_InvalidToken は不正なトークンでエラーを返すケースをテストします
+func TestRefreshTokensUseCase_Execute_InvalidToken(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case, _, _ = setupRefreshTokensTest(t, NewMockUserFinder())
+	_, err = use_case.Execute(ctx, "invalid.token.string")
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_UnknownToken はDBに記録されていないトークンでエラーを返すケースをテストします
+func TestRefreshTokensUseCase_Execute_UnknownToken(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var unknown_pair *utils.TokenPair
+	var err error
+
+	ctx = context.Background()
+	use_case, _, _ = setupRefreshTokensTest(t, NewMockUserFinder())
+	unknown_pair, err = new_test_jwt_service(NewMockTokenDenylist()).GenerateTokenPair(uuid.NewString(), testFirebaseUID, uuid.NewString())
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	_, err = use_case.Execute(ctx, unknown_pair.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_DeletedUser は論理削除済みユーザーのリフレッシュが拒否されることをテストします
+func TestRefreshTokensUseCase_Execute_DeletedUser(t *testing.T) {
+	var ctx context.Context
+	var user_finder *MockUserFinder
+	var use_case *RefreshTokensUseCase
+	var refresh_token_repo *MockRefreshTokenRepository
+	var login_result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	user_finder = NewMockUserFinder()
+	use_case, refresh_token_repo, login_result = setupRefreshTokensTest(t, user_finder)
+	user_finder.is_deleted = true
+	_, err = use_case.Execute(ctx, login_result.RefreshToken)
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Errorf("expected ErrUserDeleted, got %v", err)
+	}
+	if !refresh_token_repo.RevokeFamilyCalled {
+		t.Error("expected RevokeFamily to be called for deleted user")
+	}
+}
+
+// setupRefreshTokensWithCredentialCheckTest は認証情報の変更を検知するリフレッシュテスト用に、2つのセッションでログイン済みの状態を作成します
+func setupRefreshTokensWithCredentialCheckTest(
+	t *testing.T,
+	credentials_changed_at time.Time,
+) (*RefreshTokensUseCase, *MockTokenDenylist, []*LoginUserResult) {
+	var jwt_service *utils.JWTService
+	var denylist *MockTokenDenylist
+	var refresh_token_repo *MockRefreshTokenRepository
+	var user_finder *MockUserFinder
+	var token_issuer *TokenIssuer
+	var login_use_case *LoginUserUseCase
+	var login_results []*LoginUserResult
+	var login_result *LoginUserResult
+	var err error
+
+	t.Helper()
+	denylist = NewMockTokenDenylist()
+	jwt_service = new_test_jwt_service(denylist)
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	user_finder = NewMockUserFinder()
+	token_issuer = NewTokenIssuer(jwt_service, refresh_token_repo, NewMockSessionRepository())
+	login_use_case = new_test_login_user_use_case(NewMockFirebaseTokenVerifier(), user_finder, token_issuer)
+	for i := 0; i < 2; i++ {
+		login_result, err = login_use_case.Execute(context.Background(), testIDToken)
+		if err != nil {
+			t.Fatalf("failed to login: %v", err)
+		}
+		login_results = append(login_results, login_result)
+	}
+	return NewRefreshTokensUseCase(
+		jwt_service,
+		token_issuer,
+		refresh_token_repo,
+		user_finder,
+		NewMockCredentialChangeChecker(credentials_changed_at),
+		NewUserSessionRevoker(denylist, refresh_token_repo, NewMockSessionRepository()),
+	), denylist, login_results
+}
+
+// TestRefreshTokensUseCase_Execute_CredentialsChanged はパスワードリセット後に変更前のセッションが全て失効することをテストします
+func TestRefreshTokensUseCase_Execute_CredentialsChanged(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var denylist *MockTokenDenylist
+	var login_results []*LoginUserResult
+	var is_denylisted bool
+	var err error
+
+	ctx = context.Background()
+	// トークン発行後にパスワードがリセットされた状態
+	use_case, denylist, login_results = setupRefreshTokensWithCredentialCheckTest(t, time.Now().Add(2*time.Second))
+	_, err = use_case.Execute(ctx, login_results[0].RefreshToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Fatalf("expected ErrTokenRevoked, got %v", err)
+	}
+	// 他の端末のセッションも失効していることを確認
+	_, err = use_case.Execute(ctx, login_results[1].RefreshToken)
+	if err == nil {
+		t.Error("expected other session to be revoked")
+	}
+	is_denylisted, _ = denylist.IsDenylisted(ctx, "", extract_session_id(t, login_results[1].AccessToken))
+	if !is_denylisted {
+		t.Error("expected access token of other session to be revoked")
+	}
+}
+
+// TestRefreshTokensUseCase_Execute_CredentialsNotChanged はトークン発行前の認証情報変更ではセッションを失効させないことをテストします
+func TestRefreshTokensUseCase_Execute_CredentialsNotChanged(t *testing.T) {
+	var ctx context.Context
+	var use_case *RefreshTokensUseCase
+	var login_results []*LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	use_case, _, login_results = setupRefreshTokensWithCredentialCheckTest(t, time.Now().Add(-time.Hour))
+	_, err = use_case.Execute(ctx, login_results[0].RefreshToken)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+// extract_session_id はトークンのsidを取り出します
+func extract_session_id(t *testing.T, token_string string) string {
+	var claims *utils.JWTClaims
+	var err error
+
+	t.Helper()
+	claims, err = new_test_jwt_service(NewMockTokenDenylist()).ValidateToken(context.Background(), token_string)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+	return claims.SessionID
+}
+
+// MockCredentialChangeChecker はテスト用の認証情報変更日時のモックです
+type MockCredentialChangeChecker struct {
+	tokens_valid_after time.Time
+}
+
+// NewMockCredentialChangeChecker は新しいMockCredentialChangeCheckerを作成します
+func NewMockCredentialChangeChecker(tokens_valid_after time.Time) *MockCredentialChangeChecker {
+	return &MockCredentialChangeChecker{
+		tokens_valid_after: tokens_valid_after,
+	}
+}
+
+// TokensValidAfter はモックの認証情報変更日時を返します
+func (m *MockCredentialChangeChecker) TokensValidAfter(_ context.Context, _ string) (time.Time, error) {
+	return m.tokens_valid_after, nil
+}
+
+// MockRefreshTokenRepository はテスト用のインメモリなリフレッシュトークンリポジトリです
+type MockRefreshTokenRepository struct {
+	tokens              []*models.RefreshToken
+	RevokeFamilyCalled  bool
+	should_return_error bool
+}
+
+// NewMockRefreshTokenRepository は新しいMockRefreshTokenRepositoryを作成します
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		tokens:              []*models.RefreshToken{},
+		RevokeFamilyCalled:  false,
+		should_return_error: false,
+	}
+}
+
+// Save はモックのリフレッシュトークン保存を行います
+func (m *MockRefreshTokenRepository) Save(_ context.Context, token *models.RefreshToken) error {
+	if m.should_return_error {
+		return domain_errors.ErrDatabaseError
+	}
+	m.tokens = append(m.tokens, token)
+	return nil
+}
+
+// FindByTokenID はモックのリフレッシュトークン検索を行います
+func (m *MockRefreshTokenRepository) FindByTokenID(_ context.Context, token_id string) (*models.RefreshToken, error) {
+	for _, token := range m.tokens {
+		if token.TokenID() == token_id {
+			return token, nil
+		}
+	}
+	return nil, domain_errors.ErrInvalidRefreshToken
+}
+
+// MarkRotated はモックのローテーション処理を行います
+func (m *MockRefreshTokenRepository) MarkRotated(_ context.Context, token_id string, rotated_at time.Time) (bool, error) {
+	for i, token := range m.tokens {
+		var err error
+
+		if token.TokenID() != token_id || token.IsRotated() || token.IsRevoked() {
+			continue
+		}
+		m.tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), &rotated_at, nil, token.CreatedAt(),
+		)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// RevokeFamily はモックのファミリー失効処理を行います
+func (m *MockRefreshTokenRepository) RevokeFamily(_ context.Context, family_id uuid.UUID, revoked_at time.Time) error {
+	m.RevokeFamilyCalled = true
+	for i, token := range m.tokens {
+		var err error
+
+		if token.FamilyID() != family_id || token.IsRevoked() {
+			continue
+		}
+		m.tokens[i], err = models.NewRefreshTokenWithStatus(
+			token.TokenID(), token.FamilyID(), token.UserID(), token.ExpiresAt(), token.RotatedAt(), &revoked_at, token.CreatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindActiveFamilyIDsByUserID はモックの未失効ファミリー検索を行います
+func (m *MockRefreshTokenRepository) FindActiveFamilyIDsByUserID(_ context.Context, user_id uuid.UUID) ([]uuid.UUID, error) {
+	var family_ids []uuid.UUID
+	var seen map[uuid.UUID]bool
+
+	family_ids = []uuid.UUID{}
+	seen = map[uuid.UUID]bool{}
+	for _, token := range m.tokens {
+		if token.UserID() != user_id || token.IsRevoked() || seen[token.FamilyID()] {
+			continue
+		}
+		seen[token.FamilyID()] = true
+		family_ids = append(family_ids, token.FamilyID())
+	}
+	return family_ids, nil
+}
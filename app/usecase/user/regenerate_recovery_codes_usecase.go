@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// RegenerateRecoveryCodesUseCase は二要素認証のリカバリーコードを再発行するユースケースです
+type RegenerateRecoveryCodesUseCase struct {
+	code_verifier   *MfaCodeVerifier
+	credential_repo TotpCredentialRepositoryInterface
+}
+
+// NewRegenerateRecoveryCodesUseCase は新しいRegenerateRecoveryCodesUseCaseを作成します
+func NewRegenerateRecoveryCodesUseCase(code_verifier *MfaCodeVerifier, credential_repo TotpCredentialRepositoryInterface) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		code_verifier:   code_verifier,
+		credential_repo: credential_repo,
+	}
+}
+
+// Execute は認証アプリの認証コードを確認してリカバリーコードを再発行します（以前のコードは全て無効になります）
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, user *models.User, code string) ([]string, error) {
+	var credential *models.TotpCredential
+	var recovery_codes []string
+	var err error
+
+	if !user.IsMfaEnabled() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrMfaNotEnabled, user.PublicID())
+	}
+	credential, err = uc.code_verifier.VerifyTotpCode(ctx, user.PublicID(), code)
+	if err != nil {
+		return nil, err
+	}
+	recovery_codes, err = models.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	credential.ReplaceRecoveryCodes(recovery_codes)
+	err = uc.credential_repo.Update(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return recovery_codes, nil
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestRegenerateRecoveryCodesUseCase_Execute_Success は再発行で以前のリカバリーコードが無効になることをテストします
+func TestRegenerateRecoveryCodesUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var test_context *mfaTestContext
+	var use_case *RegenerateRecoveryCodesUseCase
+	var user *models.User
+	var secret string
+	var old_codes []string
+	var new_codes []string
+	var err error
+
+	ctx = context.Background()
+	test_context = setupMfaTest(t)
+	use_case = NewRegenerateRecoveryCodesUseCase(test_context.code_verifier, test_context.credential_repo)
+	user = create_test_user_with_role(t, models.RoleUser)
+	secret, old_codes = test_context.enable_mfa(t, user)
+	new_codes, err = use_case.Execute(ctx, user, generate_test_totp_code(t, secret, time.Now().Add(30*time.Second)))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(new_codes) != models.RecoveryCodeCount {
+		t.Errorf("expected %d recovery codes, got %d", models.RecoveryCodeCount, len(new_codes))
+	}
+	err = test_context.code_verifier.VerifyCode(ctx, user.PublicID(), old_codes[0])
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected old recovery code to be rejected, got %v", err)
+	}
+	err = test_context.code_verifier.VerifyCode(ctx, user.PublicID(), new_codes[0])
+	if err != nil {
+		t.Errorf("expected new recovery code to be accepted, got %v", err)
+	}
+}
+
+// TestRegenerateRecoveryCodesUseCase_Execute_RecoveryCodeRejected はリカバリーコードでは再発行できないことをテストします
+func TestRegenerateRecoveryCodesUseCase_Execute_RecoveryCodeRejected(t *testing.T) {
+	var test_context *mfaTestContext
+	var use_case *RegenerateRecoveryCodesUseCase
+	var user *models.User
+	var recovery_codes []string
+	var err error
+
+	test_context = setupMfaTest(t)
+	use_case = NewRegenerateRecoveryCodesUseCase(test_context.code_verifier, test_context.credential_repo)
+	user = create_test_user_with_role(t, models.RoleUser)
+	_, recovery_codes = test_context.enable_mfa(t, user)
+	_, err = use_case.Execute(context.Background(), user, recovery_codes[0])
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode, got %v", err)
+	}
+}
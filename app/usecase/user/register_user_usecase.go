@@ -2,11 +2,23 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
 	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// 冪等キーによるユーザー登録の重複防止の設定
+const (
+	// registerIdempotencyWindow は同じ冪等キーのリクエストを再送信として扱う期間です
+	registerIdempotencyWindow = 24 * time.Hour
+	// registerIdempotencyProcessingTimeout は処理中の記録を中断されたとみなし、同じ冪等キーで処理し直すまでの時間です
+	registerIdempotencyProcessingTimeout = time.Minute
 )
 
 // FirebaseUserRepositoryInterface はFirebaseユーザーリポジトリのインターフェースです
@@ -18,6 +30,25 @@ type FirebaseUserRepositoryInterface interface {
 // UserDAOInterface はUserDAOのインターフェースです
 type UserDAOInterface interface {
 	Save(ctx context.Context, user *models.User) error
+	// HardDeleteByPublicID はトークンの発行に失敗した登録のユーザーの行を物理削除します（セッション・リフレッシュトークンの行はCASCADEで削除されます）
+	HardDeleteByPublicID(ctx context.Context, public_id uuid.UUID) error
+}
+
+// IdempotencyRecordRepositoryInterface は冪等キーごとのリクエストの処理状況のリポジトリのインターフェースです
+type IdempotencyRecordRepositoryInterface interface {
+	// Reserve は処理中の記録を保存します（同じ冪等キーの有効な記録が既に存在する場合はfalseを返します）
+	Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error)
+	// FindByKey は冪等キーの記録を返します（記録がない場合はnilを返します）
+	FindByKey(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey) (*models.IdempotencyRecord, error)
+	// Complete は処理中の記録に処理結果のユーザーを記録します
+	Complete(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey, user_id uuid.UUID) error
+	// Release は処理中の記録を削除し、同じ冪等キーで再試行できるようにします
+	Release(ctx context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey) error
+}
+
+// RegisteredUserFinderInterface は再送信された登録の結果のユーザーを取得するインターフェースです
+type RegisteredUserFinderInterface interface {
+	FindByPublicID(ctx context.Context, public_id uuid.UUID) (*models.User, error)
 }
 
 // RegisterUserResult はユーザー登録の結果を表します
@@ -31,24 +62,187 @@ type RegisterUserResult struct {
 type RegisterUserUseCase struct {
 	firebase_repo FirebaseUserRepositoryInterface
 	user_dao      UserDAOInterface
-	jwt_service   *utils.JWTService
+	token_issuer  *TokenIssuer
+	// verification_mailer は登録後にメールアドレス確認メールを送信します
+	verification_mailer *EmailVerificationMailer
+	// compensator はロールバックでのFirebaseユーザーの削除に失敗すると補償処理として記録します
+	compensator *FirebaseUserCompensator
+	// attempt_guard はメールアドレス・IPアドレス・端末IDごとの失敗回数に応じて登録を制限します
+	attempt_guard *AuthAttemptGuard
+	// idempotency_repo・user_finder は冪等キー付きで再送信された登録に、最初の登録のユーザーのトークンを再発行して返します
+	idempotency_repo IdempotencyRecordRepositoryInterface
+	user_finder      RegisteredUserFinderInterface
+	// fingerprint_key はリクエストの内容のフィンガープリントを計算するサーバーの秘密鍵です（DBには保存しません）
+	fingerprint_key []byte
 }
 
 // NewRegisterUserUseCase は新しいRegisterUserUseCaseを作成します
 func NewRegisterUserUseCase(
 	firebase_repo FirebaseUserRepositoryInterface,
 	user_dao UserDAOInterface,
-	jwt_service *utils.JWTService,
+	token_issuer *TokenIssuer,
+	verification_mailer *EmailVerificationMailer,
+	compensator *FirebaseUserCompensator,
+	attempt_guard *AuthAttemptGuard,
+	idempotency_repo IdempotencyRecordRepositoryInterface,
+	user_finder RegisteredUserFinderInterface,
+	fingerprint_key []byte,
 ) *RegisterUserUseCase {
 	return &RegisterUserUseCase{
-		firebase_repo: firebase_repo,
-		user_dao:      user_dao,
-		jwt_service:   jwt_service,
+		firebase_repo:       firebase_repo,
+		user_dao:            user_dao,
+		token_issuer:        token_issuer,
+		verification_mailer: verification_mailer,
+		compensator:         compensator,
+		attempt_guard:       attempt_guard,
+		idempotency_repo:    idempotency_repo,
+		user_finder:         user_finder,
+		fingerprint_key:     fingerprint_key,
 	}
 }
 
 // Execute はユーザー登録を実行します
+// 登録済みメールアドレスの探索などで失敗回数が上限に達している場合はErrTooManyAttemptsを返します
 func (uc *RegisterUserUseCase) Execute(ctx context.Context, email_str, password_str string) (*RegisterUserResult, error) {
+	return uc.ExecuteWithIdempotencyKey(ctx, "", email_str, password_str)
+}
+
+// ExecuteWithIdempotencyKey は冪等キーを指定してユーザー登録を実行します（冪等キーが空の場合はExecuteと同じです）
+// 同じ冪等キー・同じ内容で再送信された登録は、ErrDuplicateEmailの代わりに最初の登録のユーザーにトークンを再発行して返します
+func (uc *RegisterUserUseCase) ExecuteWithIdempotencyKey(ctx context.Context, idempotency_key_str, email_str, password_str string) (*RegisterUserResult, error) {
+	var attempt *AuthAttempt
+	var result *RegisterUserResult
+	var err error
+
+	attempt, err = uc.attempt_guard.Begin(ctx, models.AuthActionRegister)
+	if err != nil {
+		return nil, err
+	}
+	err = attempt.IdentifyEmail(ctx, email_str)
+	if err != nil {
+		return nil, err
+	}
+	result, err = uc.execute_idempotent(ctx, idempotency_key_str, email_str, password_str)
+	if err != nil {
+		return nil, attempt.Fail(ctx, err)
+	}
+	attempt.Succeed(ctx)
+	return result, nil
+}
+
+// execute_idempotent は冪等キーの記録で再送信を検出してユーザー登録を実行します
+func (uc *RegisterUserUseCase) execute_idempotent(ctx context.Context, idempotency_key_str, email_str, password_str string) (*RegisterUserResult, error) {
+	var key models.IdempotencyKey
+	var fingerprint string
+	var completed_record *models.IdempotencyRecord
+	var result *RegisterUserResult
+	var err error
+
+	if idempotency_key_str == "" {
+		return uc.execute(ctx, email_str, password_str)
+	}
+	key, err = models.NewIdempotencyKey(idempotency_key_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidIdempotencyKey, err)
+	}
+	// パスワードそのものは保存せず、冪等キーと合わせたサーバーの秘密鍵によるHMACで同じ内容のリクエストかを判定する
+	fingerprint = key.Fingerprint(uc.fingerprint_key, email_str, password_str)
+	completed_record, err = uc.reserve_idempotency_key(ctx, key, fingerprint, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if completed_record != nil {
+		return uc.replay(ctx, completed_record)
+	}
+
+	result, err = uc.execute(ctx, email_str, password_str)
+	if err != nil {
+		// 失敗した登録は同じ冪等キーで再試行できるよう解放する
+		_ = uc.idempotency_repo.Release(ctx, models.IdempotencyOperationRegisterUser, key)
+		return nil, err
+	}
+	// 登録は完了しているため、記録に失敗した場合も成功として扱う
+	// （その場合の再送信は処理時間の上限を過ぎた後に新しい登録として処理され、ErrDuplicateEmailになる）
+	_ = uc.idempotency_repo.Complete(ctx, models.IdempotencyOperationRegisterUser, key, result.User.PublicID())
+	return result, nil
+}
+
+// reserve_idempotency_key は冪等キーの記録を保存して登録を開始します
+// 同じ内容の登録が完了済みの場合はその記録を返し、処理中の場合はErrIdempotentRequestInProgress、
+// 異なる内容のリクエストで使用済みの場合はErrIdempotencyKeyReusedを返します
+func (uc *RegisterUserUseCase) reserve_idempotency_key(
+	ctx context.Context, key models.IdempotencyKey, fingerprint string, now time.Time,
+) (*models.IdempotencyRecord, error) {
+	var reserved bool
+	var existing_record *models.IdempotencyRecord
+	var err error
+
+	for retry := 0; retry < 2; retry++ {
+		reserved, err = uc.idempotency_repo.Reserve(ctx, models.NewIdempotencyRecord(
+			models.IdempotencyOperationRegisterUser, key, fingerprint, now, now.Add(registerIdempotencyWindow),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if reserved {
+			return nil, nil
+		}
+		existing_record, err = uc.idempotency_repo.FindByKey(ctx, models.IdempotencyOperationRegisterUser, key)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		// 保存の後に解放された場合は保存し直す
+		if existing_record == nil {
+			continue
+		}
+		if !existing_record.MatchesFingerprint(fingerprint) {
+			return nil, domain_errors.ErrIdempotencyKeyReused
+		}
+		if existing_record.IsCompleted() {
+			return existing_record, nil
+		}
+		if !existing_record.IsAbandoned(now, registerIdempotencyProcessingTimeout) {
+			return nil, domain_errors.ErrIdempotentRequestInProgress
+		}
+		// プロセスの停止などで中断された登録は解放して処理し直す
+		err = uc.idempotency_repo.Release(ctx, models.IdempotencyOperationRegisterUser, key)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+	return nil, domain_errors.ErrIdempotentRequestInProgress
+}
+
+// replay は完了済みの登録のユーザーにトークンペアを再発行します（確認メールは再送信しません）
+func (uc *RegisterUserUseCase) replay(ctx context.Context, record *models.IdempotencyRecord) (*RegisterUserResult, error) {
+	var user *models.User
+	var token_pair *utils.TokenPair
+	var err error
+
+	user, err = uc.user_finder.FindByPublicID(ctx, *record.UserID())
+	// 登録の完了後に退会したユーザーは論理削除により見つからない
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return nil, domain_errors.ErrUserDeleted
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.IsDeleted() {
+		return nil, domain_errors.ErrUserDeleted
+	}
+	token_pair, err = uc.token_issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &RegisterUserResult{
+		User:         user,
+		AccessToken:  token_pair.AccessToken,
+		RefreshToken: token_pair.RefreshToken,
+	}, nil
+}
+
+// execute はユーザー登録を実行します
+func (uc *RegisterUserUseCase) execute(ctx context.Context, email_str, password_str string) (*RegisterUserResult, error) {
 	var email models.Email
 	var password models.Password
 	var firebase_uid string
@@ -78,7 +272,7 @@ func (uc *RegisterUserUseCase) Execute(ctx context.Context, email_str, password_
 	user, err = models.NewUser(firebase_uid, email)
 	if err != nil {
 		// Firebaseユーザーをロールバック
-		_ = uc.firebase_repo.DeleteUser(ctx, firebase_uid)
+		uc.rollback_firebase_user(ctx, firebase_uid)
 		return nil, fmt.Errorf("%w: %w", domain_errors.ErrDatabaseError, err)
 	}
 
@@ -86,21 +280,44 @@ func (uc *RegisterUserUseCase) Execute(ctx context.Context, email_str, password_
 	err = uc.user_dao.Save(ctx, user)
 	if err != nil {
 		// Firebaseユーザーをロールバック
-		_ = uc.firebase_repo.DeleteUser(ctx, firebase_uid)
+		uc.rollback_firebase_user(ctx, firebase_uid)
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	// JWTを発行
-	token_pair, err = uc.jwt_service.GenerateTokenPair(user.PublicID().String(), firebase_uid)
+	// JWTを発行（リフレッシュトークンは新しいトークンファミリーとして記録）
+	token_pair, err = uc.token_issuer.IssueTokenPair(ctx, user)
 	if err != nil {
-		// Firebaseユーザーをロールバック（JWT発行失敗時もロールバック）
-		_ = uc.firebase_repo.DeleteUser(ctx, firebase_uid)
+		// 保存したユーザーの行とFirebaseユーザーをロールバック
+		uc.rollback_saved_user(ctx, user)
 		return nil, fmt.Errorf("%w", err)
 	}
 
+	// メールアドレス確認メールを送信
+	// 登録は完了しているため、送信に失敗した場合もresendVerificationEmailで再送信できるよう成功として扱う
+	_ = uc.verification_mailer.Send(ctx, email)
+
 	return &RegisterUserResult{
 		User:         user,
 		AccessToken:  token_pair.AccessToken,
 		RefreshToken: token_pair.RefreshToken,
 	}, nil
 }
+
+// rollback_saved_user は保存後に登録に失敗したユーザーの行を物理削除してから、Firebaseユーザーを削除します
+// 行が残ると同じメールアドレスの登録がErrDuplicateEmailで拒否され続けるため、Firebaseユーザーより先に削除します
+// 行の削除に失敗した場合はFirebaseユーザーも残し、ログインできる登録済みのユーザーとして扱います
+func (uc *RegisterUserUseCase) rollback_saved_user(ctx context.Context, user *models.User) {
+	var err error
+
+	err = uc.user_dao.HardDeleteByPublicID(ctx, user.PublicID())
+	if err != nil {
+		return
+	}
+	uc.rollback_firebase_user(ctx, user.FirebaseUID())
+}
+
+// rollback_firebase_user は登録に失敗したFirebaseユーザーを削除します
+// 削除に失敗した補償処理はcompensatorが記録して後から再試行し、記録にも失敗した場合は整合性チェック（reconcile-users）で検出します
+func (uc *RegisterUserUseCase) rollback_firebase_user(ctx context.Context, firebase_uid string) {
+	_ = uc.compensator.DeleteFirebaseUser(ctx, firebase_uid)
+}
@@ -2,11 +2,15 @@ package user
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	domain_errors "sleeve/domain/errors"
 	"sleeve/domain/models"
 	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
 )
 
 // テスト用定数
@@ -15,8 +19,29 @@ const (
 	testPassword    = "Password123!"
 	testFirebaseUID = "firebase_uid_123"
 	testSecretKey   = "test_secret_key_for_testing_1234567890"
+	// testFingerprintKey は冪等キーの記録のフィンガープリントの秘密鍵です
+	testFingerprintKey = "test_idempotency_fingerprint_key_1234567890"
 )
 
+// new_test_register_user_use_case は確認メール・補償処理・失敗回数・冪等キーの記録にモックを使うRegisterUserUseCaseを作成します
+func new_test_register_user_use_case(
+	firebase_repo *MockFirebaseUserRepository,
+	user_dao UserDAOInterface,
+	token_issuer *TokenIssuer,
+) *RegisterUserUseCase {
+	return NewRegisterUserUseCase(
+		firebase_repo,
+		user_dao,
+		token_issuer,
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		NewFirebaseUserCompensator(firebase_repo, NewMockCompensationTaskRepository()),
+		new_test_attempt_guard(),
+		NewMockIdempotencyRecordRepository(),
+		NewMockRegisteredUserStore(),
+		[]byte(testFingerprintKey),
+	)
+}
+
 // TestRegisterUserUseCase_Execute_Success は正常なユーザー登録をテストします
 func TestRegisterUserUseCase_Execute_Success(t *testing.T) {
 	var ctx context.Context
@@ -25,10 +50,10 @@ func TestRegisterUserUseCase_Execute_Success(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepository(),
 		NewMockUserDAO(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	result, err = use_case.Execute(ctx, testEmail, testPassword)
 	if err != nil {
@@ -58,10 +83,10 @@ func TestRegisterUserUseCase_Execute_InvalidEmail(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepository(),
 		NewMockUserDAO(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	_, err = use_case.Execute(ctx, "invalid-email", testPassword)
 	if err == nil {
@@ -79,10 +104,10 @@ func TestRegisterUserUseCase_Execute_WeakPassword(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepository(),
 		NewMockUserDAO(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	_, err = use_case.Execute(ctx, testEmail, "weak")
 	if err == nil {
@@ -100,10 +125,10 @@ func TestRegisterUserUseCase_Execute_DuplicateEmail(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepositoryWithDuplicateEmail(),
 		NewMockUserDAO(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	_, err = use_case.Execute(ctx, testEmail, testPassword)
 	if err == nil {
@@ -121,10 +146,10 @@ func TestRegisterUserUseCase_Execute_FirebaseError(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepositoryWithError(),
 		NewMockUserDAO(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	_, err = use_case.Execute(ctx, testEmail, testPassword)
 	if err == nil {
@@ -144,10 +169,10 @@ func TestRegisterUserUseCase_Execute_DatabaseError(t *testing.T) {
 
 	ctx = context.Background()
 	mock_firebase = NewMockFirebaseUserRepository()
-	use_case = NewRegisterUserUseCase(
+	use_case = new_test_register_user_use_case(
 		mock_firebase,
 		NewMockUserDAOWithError(),
-		utils.NewJWTService(testSecretKey),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	_, err = use_case.Execute(ctx, testEmail, testPassword)
 	if err == nil {
@@ -162,6 +187,75 @@ func TestRegisterUserUseCase_Execute_DatabaseError(t *testing.T) {
 	}
 }
 
+// TestRegisterUserUseCase_Execute_TokenIssueFailed はトークンの発行に失敗した場合に保存したユーザーの行とFirebaseユーザーがロールバックされることをテストします
+func TestRegisterUserUseCase_Execute_TokenIssueFailed(t *testing.T) {
+	var ctx context.Context
+	var use_case *RegisterUserUseCase
+	var mock_firebase *MockFirebaseUserRepository
+	var user_dao *MockUserDAO
+	var err error
+
+	ctx = context.Background()
+	mock_firebase = NewMockFirebaseUserRepository()
+	user_dao = NewMockUserDAO()
+	use_case = new_test_register_user_use_case(
+		mock_firebase,
+		user_dao,
+		NewTokenIssuer(
+			new_test_jwt_service(NewMockTokenDenylist()),
+			&MockRefreshTokenRepository{should_return_error: true},
+			NewMockSessionRepository(),
+		),
+	)
+	_, err = use_case.Execute(ctx, testEmail, testPassword)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	// 同じメールアドレスで再登録できるよう、ユーザーの行がFirebaseユーザーとともに削除されていることを確認
+	if !user_dao.HardDeleteCalled {
+		t.Error("expected HardDeleteByPublicID to be called for rollback")
+	}
+	if !mock_firebase.DeleteUserCalled {
+		t.Error("expected DeleteUser to be called for rollback")
+	}
+}
+
+// TestRegisterUserUseCase_Execute_RollbackFailureRecorded はロールバックでのFirebaseユーザーの削除に失敗した場合に補償処理が記録されることをテストします
+func TestRegisterUserUseCase_Execute_RollbackFailureRecorded(t *testing.T) {
+	var ctx context.Context
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var use_case *RegisterUserUseCase
+	var err error
+
+	ctx = context.Background()
+	mock_firebase = NewMockFirebaseUserRepositoryWithDeleteError()
+	task_repo = NewMockCompensationTaskRepository()
+	use_case = NewRegisterUserUseCase(
+		mock_firebase,
+		NewMockUserDAOWithError(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		NewFirebaseUserCompensator(mock_firebase, task_repo),
+		new_test_attempt_guard(),
+		NewMockIdempotencyRecordRepository(),
+		NewMockRegisteredUserStore(),
+		[]byte(testFingerprintKey),
+	)
+	_, err = use_case.Execute(ctx, testEmail, testPassword)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if len(task_repo.tasks) != 1 {
+		t.Fatalf("expected 1 compensation task, got %d", len(task_repo.tasks))
+	}
+	for _, task := range task_repo.tasks {
+		if task.Target() != testFirebaseUID || task.Kind() != models.CompensationKindDeleteFirebaseUser {
+			t.Errorf("expected delete_firebase_user task for %s, got %s for %s", testFirebaseUID, task.Kind(), task.Target())
+		}
+	}
+}
+
 // TestRegisterUserUseCase_Execute_JWTGenerated は登録成功時にJWTが発行されることをテストします
 func TestRegisterUserUseCase_Execute_JWTGenerated(t *testing.T) {
 	var ctx context.Context
@@ -173,18 +267,18 @@ func TestRegisterUserUseCase_Execute_JWTGenerated(t *testing.T) {
 	var err error
 
 	ctx = context.Background()
-	jwt_service = utils.NewJWTService(testSecretKey)
-	use_case = NewRegisterUserUseCase(
+	jwt_service = new_test_jwt_service(NewMockTokenDenylist())
+	use_case = new_test_register_user_use_case(
 		NewMockFirebaseUserRepository(),
 		NewMockUserDAO(),
-		jwt_service,
+		NewTokenIssuer(jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository()),
 	)
 	result, err = use_case.Execute(ctx, testEmail, testPassword)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 	// アクセストークンの検証
-	access_claims, err = jwt_service.ValidateToken(result.AccessToken)
+	access_claims, err = jwt_service.ValidateToken(ctx, result.AccessToken)
 	if err != nil {
 		t.Errorf("expected valid access token, got error: %v", err)
 	}
@@ -192,7 +286,7 @@ func TestRegisterUserUseCase_Execute_JWTGenerated(t *testing.T) {
 		t.Errorf("expected token type %s, got %s", utils.TokenTypeAccess, access_claims.TokenType)
 	}
 	// リフレッシュトークンの検証
-	refresh_claims, err = jwt_service.ValidateToken(result.RefreshToken)
+	refresh_claims, err = jwt_service.ValidateToken(ctx, result.RefreshToken)
 	if err != nil {
 		t.Errorf("expected valid refresh token, got error: %v", err)
 	}
@@ -201,11 +295,203 @@ func TestRegisterUserUseCase_Execute_JWTGenerated(t *testing.T) {
 	}
 }
 
+// TestRegisterUserUseCase_Execute_SendsVerificationMail はユーザー登録後に確認メールが送信されることをテストします
+func TestRegisterUserUseCase_Execute_SendsVerificationMail(t *testing.T) {
+	var ctx context.Context
+	var mock_firebase *MockFirebaseUserRepository
+	var mail_sender *MockEmailVerificationMailSender
+	var use_case *RegisterUserUseCase
+	var err error
+
+	ctx = context.Background()
+	mock_firebase = NewMockFirebaseUserRepository()
+	mail_sender = NewMockEmailVerificationMailSender()
+	use_case = NewRegisterUserUseCase(
+		mock_firebase,
+		NewMockUserDAO(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), mail_sender),
+		NewFirebaseUserCompensator(mock_firebase, NewMockCompensationTaskRepository()),
+		new_test_attempt_guard(),
+		NewMockIdempotencyRecordRepository(),
+		NewMockRegisteredUserStore(),
+		[]byte(testFingerprintKey),
+	)
+	_, err = use_case.Execute(ctx, testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if mail_sender.SentLinks[testEmail] != testVerificationLink {
+		t.Errorf("expected verification link to be sent to %s, got %v", testEmail, mail_sender.SentLinks)
+	}
+}
+
+// TestRegisterUserUseCase_Execute_VerificationMailFailed は確認メールの送信に失敗しても登録が成功することをテストします
+func TestRegisterUserUseCase_Execute_VerificationMailFailed(t *testing.T) {
+	var ctx context.Context
+	var mock_firebase *MockFirebaseUserRepository
+	var use_case *RegisterUserUseCase
+	var result *RegisterUserResult
+	var err error
+
+	ctx = context.Background()
+	mock_firebase = NewMockFirebaseUserRepository()
+	use_case = NewRegisterUserUseCase(
+		mock_firebase,
+		NewMockUserDAO(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSenderWithError()),
+		NewFirebaseUserCompensator(mock_firebase, NewMockCompensationTaskRepository()),
+		new_test_attempt_guard(),
+		NewMockIdempotencyRecordRepository(),
+		NewMockRegisteredUserStore(),
+		[]byte(testFingerprintKey),
+	)
+	result, err = use_case.Execute(ctx, testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.AccessToken == "" {
+		t.Error("expected access_token to be non-empty")
+	}
+}
+
+// setupIdempotentRegisterTest は冪等キーの記録とユーザーの保存を行うモックで登録のユースケースを作成します
+func setupIdempotentRegisterTest(firebase_repo *MockFirebaseUserRepository) (*RegisterUserUseCase, *MockIdempotencyRecordRepository, *MockRegisteredUserStore) {
+	var idempotency_repo *MockIdempotencyRecordRepository
+	var user_store *MockRegisteredUserStore
+	var use_case *RegisterUserUseCase
+
+	idempotency_repo = NewMockIdempotencyRecordRepository()
+	user_store = NewMockRegisteredUserStore()
+	use_case = NewRegisterUserUseCase(
+		firebase_repo,
+		user_store,
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		NewFirebaseUserCompensator(firebase_repo, NewMockCompensationTaskRepository()),
+		new_test_attempt_guard(),
+		idempotency_repo,
+		user_store,
+		[]byte(testFingerprintKey),
+	)
+	return use_case, idempotency_repo, user_store
+}
+
+// TestRegisterUserUseCase_ExecuteWithIdempotencyKey_Replay は同じ冪等キーの再送信で最初の登録のユーザーにトークンが再発行されることをテストします
+func TestRegisterUserUseCase_ExecuteWithIdempotencyKey_Replay(t *testing.T) {
+	var ctx context.Context
+	var use_case *RegisterUserUseCase
+	var user_store *MockRegisteredUserStore
+	var first_result *RegisterUserResult
+	var replayed_result *RegisterUserResult
+	var err error
+
+	ctx = context.Background()
+	use_case, _, user_store = setupIdempotentRegisterTest(NewMockFirebaseUserRepository())
+	first_result, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	replayed_result, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("expected replay to succeed, got %v", err)
+	}
+	if replayed_result.User.PublicID() != first_result.User.PublicID() {
+		t.Errorf("expected replay to return user %s, got %s", first_result.User.PublicID(), replayed_result.User.PublicID())
+	}
+	if replayed_result.AccessToken == "" || replayed_result.RefreshToken == first_result.RefreshToken {
+		t.Error("expected tokens to be re-issued for the replayed request")
+	}
+	if len(user_store.users) != 1 {
+		t.Errorf("expected user to be saved once, got %d", len(user_store.users))
+	}
+}
+
+// TestRegisterUserUseCase_ExecuteWithIdempotencyKey_KeyReused は同じ冪等キーで異なる内容の登録がエラーになることをテストします
+func TestRegisterUserUseCase_ExecuteWithIdempotencyKey_KeyReused(t *testing.T) {
+	var ctx context.Context
+	var use_case *RegisterUserUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case, _, _ = setupIdempotentRegisterTest(NewMockFirebaseUserRepository())
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", "other@example.com", testPassword)
+	if !errors.Is(err, domain_errors.ErrIdempotencyKeyReused) {
+		t.Errorf("expected ErrIdempotencyKeyReused, got %v", err)
+	}
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key with space", testEmail, testPassword)
+	if !errors.Is(err, domain_errors.ErrInvalidIdempotencyKey) {
+		t.Errorf("expected ErrInvalidIdempotencyKey, got %v", err)
+	}
+}
+
+// TestRegisterUserUseCase_ExecuteWithIdempotencyKey_InProgress は処理中の冪等キーの再送信と中断された処理の引き継ぎをテストします
+func TestRegisterUserUseCase_ExecuteWithIdempotencyKey_InProgress(t *testing.T) {
+	var ctx context.Context
+	var use_case *RegisterUserUseCase
+	var idempotency_repo *MockIdempotencyRecordRepository
+	var key models.IdempotencyKey
+	var err error
+
+	ctx = context.Background()
+	use_case, idempotency_repo, _ = setupIdempotentRegisterTest(NewMockFirebaseUserRepository())
+	key, _ = models.NewIdempotencyKey("key-1")
+	_, _ = idempotency_repo.Reserve(ctx, models.NewIdempotencyRecord(
+		models.IdempotencyOperationRegisterUser, key, key.Fingerprint([]byte(testFingerprintKey), testEmail, testPassword), time.Now(), time.Now().Add(time.Hour),
+	))
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if !errors.Is(err, domain_errors.ErrIdempotentRequestInProgress) {
+		t.Fatalf("expected ErrIdempotentRequestInProgress, got %v", err)
+	}
+
+	// 処理時間の上限を過ぎた処理中の記録は中断されたとみなして処理し直す
+	_ = idempotency_repo.Release(ctx, models.IdempotencyOperationRegisterUser, key)
+	_, _ = idempotency_repo.Reserve(ctx, models.NewIdempotencyRecord(
+		models.IdempotencyOperationRegisterUser, key, key.Fingerprint([]byte(testFingerprintKey), testEmail, testPassword),
+		time.Now().Add(-registerIdempotencyProcessingTimeout), time.Now().Add(time.Hour),
+	))
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if err != nil {
+		t.Errorf("expected abandoned request to be processed again, got %v", err)
+	}
+}
+
+// TestRegisterUserUseCase_ExecuteWithIdempotencyKey_ReleasedOnFailure は失敗した登録の冪等キーが再試行できるよう解放されることをテストします
+func TestRegisterUserUseCase_ExecuteWithIdempotencyKey_ReleasedOnFailure(t *testing.T) {
+	var ctx context.Context
+	var use_case *RegisterUserUseCase
+	var firebase_repo *MockFirebaseUserRepository
+	var err error
+
+	ctx = context.Background()
+	firebase_repo = NewMockFirebaseUserRepositoryWithError()
+	use_case, _, _ = setupIdempotentRegisterTest(firebase_repo)
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if !errors.Is(err, domain_errors.ErrFirebaseAuthFailed) {
+		t.Fatalf("expected ErrFirebaseAuthFailed, got %v", err)
+	}
+	firebase_repo.should_return_error = false
+	_, err = use_case.ExecuteWithIdempotencyKey(ctx, "key-1", testEmail, testPassword)
+	if err != nil {
+		t.Errorf("expected retry with the same key to succeed, got %v", err)
+	}
+}
+
 // MockFirebaseUserRepository はテスト用のFirebaseリポジトリモックです
 type MockFirebaseUserRepository struct {
 	should_return_duplicate_error bool
 	should_return_error           bool
+	should_return_delete_error    bool
+	should_return_enable_error    bool
+	should_return_update_error    bool
 	DeleteUserCalled              bool
+	EnableUserCalled              bool
+	UpdatedEmail                  string
 }
 
 // NewMockFirebaseUserRepository は新しいMockFirebaseUserRepositoryを作成します
@@ -235,6 +521,15 @@ func NewMockFirebaseUserRepositoryWithError() *MockFirebaseUserRepository {
 	}
 }
 
+// NewMockFirebaseUserRepositoryWithDeleteError はユーザー削除でエラーを返すモックを作成します
+func NewMockFirebaseUserRepositoryWithDeleteError() *MockFirebaseUserRepository {
+	var repo *MockFirebaseUserRepository
+
+	repo = NewMockFirebaseUserRepository()
+	repo.should_return_delete_error = true
+	return repo
+}
+
 // CreateUser はモックのユーザー作成を行います
 func (m *MockFirebaseUserRepository) CreateUser(_ context.Context, _ models.Email, _ models.Password) (string, error) {
 	if m.should_return_duplicate_error {
@@ -249,12 +544,34 @@ func (m *MockFirebaseUserRepository) CreateUser(_ context.Context, _ models.Emai
 // DeleteUser はモックのユーザー削除を行います
 func (m *MockFirebaseUserRepository) DeleteUser(_ context.Context, _ string) error {
 	m.DeleteUserCalled = true
+	if m.should_return_delete_error {
+		return domain_errors.ErrFirebaseAuthFailed
+	}
+	return nil
+}
+
+// EnableUser はモックのユーザーの有効化を行います
+func (m *MockFirebaseUserRepository) EnableUser(_ context.Context, _ string) error {
+	m.EnableUserCalled = true
+	if m.should_return_enable_error {
+		return domain_errors.ErrFirebaseAuthFailed
+	}
+	return nil
+}
+
+// UpdateEmail はモックのメールアドレスの更新を行います（更新したメールアドレスを記録します）
+func (m *MockFirebaseUserRepository) UpdateEmail(_ context.Context, _ string, email models.Email, _ bool) error {
+	if m.should_return_update_error {
+		return domain_errors.ErrFirebaseAuthFailed
+	}
+	m.UpdatedEmail = email.Value()
 	return nil
 }
 
 // MockUserDAO はテスト用のUserDAOモックです
 type MockUserDAO struct {
 	should_return_error bool
+	HardDeleteCalled    bool
 }
 
 // NewMockUserDAO は新しいMockUserDAOを作成します
@@ -278,3 +595,101 @@ func (m *MockUserDAO) Save(_ context.Context, _ *models.User) error {
 	}
 	return nil
 }
+
+// HardDeleteByPublicID はモックのユーザーの物理削除を行います
+func (m *MockUserDAO) HardDeleteByPublicID(_ context.Context, _ uuid.UUID) error {
+	m.HardDeleteCalled = true
+	return nil
+}
+
+// MockIdempotencyRecordRepository はテスト用のインメモリな冪等キーの記録のリポジトリモックです
+type MockIdempotencyRecordRepository struct {
+	records map[string]*models.IdempotencyRecord
+}
+
+// NewMockIdempotencyRecordRepository は新しいMockIdempotencyRecordRepositoryを作成します
+func NewMockIdempotencyRecordRepository() *MockIdempotencyRecordRepository {
+	return &MockIdempotencyRecordRepository{records: map[string]*models.IdempotencyRecord{}}
+}
+
+// Reserve は処理中の記録を保存します
+func (m *MockIdempotencyRecordRepository) Reserve(_ context.Context, record *models.IdempotencyRecord) (bool, error) {
+	var existing_record *models.IdempotencyRecord
+
+	existing_record = m.records[record.Key().Value()]
+	if existing_record != nil && !existing_record.IsExpired(record.CreatedAt()) {
+		return false, nil
+	}
+	m.records[record.Key().Value()] = record
+	return true, nil
+}
+
+// FindByKey は冪等キーの記録を返します
+func (m *MockIdempotencyRecordRepository) FindByKey(_ context.Context, _ models.IdempotencyOperation, key models.IdempotencyKey) (*models.IdempotencyRecord, error) {
+	return m.records[key.Value()], nil
+}
+
+// Complete は処理中の記録に処理結果のユーザーを記録します
+func (m *MockIdempotencyRecordRepository) Complete(_ context.Context, operation models.IdempotencyOperation, key models.IdempotencyKey, user_id uuid.UUID) error {
+	var record *models.IdempotencyRecord
+
+	record = m.records[key.Value()]
+	m.records[key.Value()] = models.NewIdempotencyRecordWithResult(
+		operation, key, record.Fingerprint(), &user_id, record.CreatedAt(), record.ExpiresAt(),
+	)
+	return nil
+}
+
+// Release は処理中の記録を削除します
+func (m *MockIdempotencyRecordRepository) Release(_ context.Context, _ models.IdempotencyOperation, key models.IdempotencyKey) error {
+	if m.records[key.Value()] != nil && !m.records[key.Value()].IsCompleted() {
+		delete(m.records, key.Value())
+	}
+	return nil
+}
+
+// MockRegisteredUserStore は登録したユーザーを保持し、公開IDで検索できるモックです
+type MockRegisteredUserStore struct {
+	users  map[uuid.UUID]*models.User
+	blocks [][2]uuid.UUID
+}
+
+// NewMockRegisteredUserStore は新しいMockRegisteredUserStoreを作成します
+func NewMockRegisteredUserStore() *MockRegisteredUserStore {
+	return &MockRegisteredUserStore{users: map[uuid.UUID]*models.User{}}
+}
+
+// Save はユーザーを保持します
+func (m *MockRegisteredUserStore) Save(_ context.Context, user *models.User) error {
+	m.users[user.PublicID()] = user
+	return nil
+}
+
+// HardDeleteByPublicID は保持しているユーザーを削除します
+func (m *MockRegisteredUserStore) HardDeleteByPublicID(_ context.Context, public_id uuid.UUID) error {
+	delete(m.users, public_id)
+	return nil
+}
+
+// FindByPublicID は公開IDでユーザーを検索します
+func (m *MockRegisteredUserStore) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	if m.users[public_id] == nil {
+		return nil, domain_errors.ErrUserNotFound
+	}
+	return m.users[public_id], nil
+}
+
+// add_block はblocker_idがblocked_idをブロックしていることを追加します（FindVisibleByPublicIDの判定に使用します）
+func (m *MockRegisteredUserStore) add_block(blocker_id uuid.UUID, blocked_id uuid.UUID) {
+	m.blocks = append(m.blocks, [2]uuid.UUID{blocker_id, blocked_id})
+}
+
+// FindVisibleByPublicID は閲覧するユーザーとの間にどちらの方向にもブロックがない場合に公開IDでユーザーを検索します
+func (m *MockRegisteredUserStore) FindVisibleByPublicID(ctx context.Context, public_id uuid.UUID, viewer_id uuid.UUID) (*models.User, error) {
+	for _, block := range m.blocks {
+		if block == [2]uuid.UUID{public_id, viewer_id} || block == [2]uuid.UUID{viewer_id, public_id} {
+			return nil, domain_errors.ErrUserNotFound
+		}
+	}
+	return m.FindByPublicID(ctx, public_id)
+}
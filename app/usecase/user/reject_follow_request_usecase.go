@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FollowRequestDeleterInterface はフォローリクエストを削除するインターフェースです
+type FollowRequestDeleterInterface interface {
+	// Delete はリクエストが存在しない場合はfalseを返します
+	Delete(ctx context.Context, requester_id uuid.UUID, target_id uuid.UUID) (bool, error)
+}
+
+// RejectFollowRequestUseCase は非公開アカウントのユーザーが受信したフォローリクエストを拒否するユースケースです
+type RejectFollowRequestUseCase struct {
+	follow_request_repo FollowRequestDeleterInterface
+}
+
+// NewRejectFollowRequestUseCase は新しいRejectFollowRequestUseCaseを作成します
+func NewRejectFollowRequestUseCase(follow_request_repo FollowRequestDeleterInterface) *RejectFollowRequestUseCase {
+	return &RejectFollowRequestUseCase{
+		follow_request_repo: follow_request_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーからのフォローリクエストを削除します（リクエストしたユーザーには通知しません）
+// 不正な公開ID・存在しないユーザー・リクエストが存在しない場合はErrFollowRequestNotFoundを返します
+func (uc *RejectFollowRequestUseCase) Execute(ctx context.Context, user *models.User, requester_id_str string) error {
+	var requester_id uuid.UUID
+	var deleted bool
+	var err error
+
+	requester_id, err = uuid.Parse(requester_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	deleted, err = uc.follow_request_repo.Delete(ctx, requester_id, user.PublicID())
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("%w: %s", domain_errors.ErrFollowRequestNotFound, requester_id_str)
+	}
+	return nil
+}
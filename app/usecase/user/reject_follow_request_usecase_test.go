@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestRejectFollowRequestUseCase_Execute はリクエストの拒否でフォロワーに追加されないことをテストします
+func TestRejectFollowRequestUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var requester_id uuid.UUID
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var use_case *RejectFollowRequestUseCase
+	var request *models.FollowRequest
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	requester_id = uuid.New()
+	follow_repo = NewMockFollowRepository()
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	request, _ = models.NewFollowRequest(requester_id, user.PublicID(), time.Now())
+	_, _ = follow_request_repo.Create(ctx, request)
+	use_case = NewRejectFollowRequestUseCase(follow_request_repo)
+
+	err = use_case.Execute(ctx, user, requester_id.String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	exists, _ = follow_repo.Exists(ctx, requester_id, user.PublicID())
+	if exists || len(follow_request_repo.requests) != 0 {
+		t.Errorf("expected request to be deleted without following, got follows=%v requests=%d", exists, len(follow_request_repo.requests))
+	}
+	err = use_case.Execute(ctx, user, requester_id.String())
+	if !errors.Is(err, domain_errors.ErrFollowRequestNotFound) {
+		t.Errorf("expected ErrFollowRequestNotFound, got %v", err)
+	}
+}
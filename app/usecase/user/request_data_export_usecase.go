@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// DataExportRequesterInterface は個人データのエクスポートを要求として保存するインターフェースです
+type DataExportRequesterInterface interface {
+	// FindPendingByUserID は作成待ちのエクスポートがない場合はErrDataExportNotFoundを返します
+	FindPendingByUserID(ctx context.Context, user_id uuid.UUID) (*models.DataExport, error)
+	Create(ctx context.Context, data_export *models.DataExport) error
+}
+
+// RequestDataExportUseCase はログイン中のユーザーが個人データのエクスポートを要求するユースケースです
+// ZIPファイルの作成は定期実行ジョブ（ProcessDataExportsUseCase）で行い、完了後にダウンロードリンクをメールで通知します
+type RequestDataExportUseCase struct {
+	export_repo DataExportRequesterInterface
+}
+
+// NewRequestDataExportUseCase は新しいRequestDataExportUseCaseを作成します
+func NewRequestDataExportUseCase(export_repo DataExportRequesterInterface) *RequestDataExportUseCase {
+	return &RequestDataExportUseCase{
+		export_repo: export_repo,
+	}
+}
+
+// Execute はエクスポートを要求します
+// 作成待ちのエクスポートがある場合は新たに要求せず、そのエクスポートを返します
+func (uc *RequestDataExportUseCase) Execute(ctx context.Context, user *models.User) (*models.DataExport, error) {
+	var data_export *models.DataExport
+	var err error
+
+	data_export, err = uc.export_repo.FindPendingByUserID(ctx, user.PublicID())
+	if err == nil {
+		return data_export, nil
+	}
+	if !errors.Is(err, domain_errors.ErrDataExportNotFound) {
+		return nil, fmt.Errorf("%w", err)
+	}
+	data_export, err = models.NewDataExport(user.PublicID(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = uc.export_repo.Create(ctx, data_export)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return data_export, nil
+}
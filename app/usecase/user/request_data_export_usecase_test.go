@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockDataExportRepository はテスト用のインメモリなエクスポートのリポジトリです
+type MockDataExportRepository struct {
+	exports      []*models.DataExport
+	token_hashes map[uuid.UUID]string
+}
+
+// NewMockDataExportRepository は新しいMockDataExportRepositoryを作成します
+func NewMockDataExportRepository() *MockDataExportRepository {
+	return &MockDataExportRepository{
+		exports:      []*models.DataExport{},
+		token_hashes: map[uuid.UUID]string{},
+	}
+}
+
+// Create はエクスポートを保存します
+func (m *MockDataExportRepository) Create(_ context.Context, data_export *models.DataExport) error {
+	m.exports = append(m.exports, data_export)
+	return nil
+}
+
+// FindPendingByUserID はユーザーの作成待ちのエクスポートを返します
+func (m *MockDataExportRepository) FindPendingByUserID(_ context.Context, user_id uuid.UUID) (*models.DataExport, error) {
+	for _, data_export := range m.exports {
+		if data_export.UserID() == user_id && data_export.IsPending() {
+			return data_export, nil
+		}
+	}
+	return nil, domain_errors.ErrDataExportNotFound
+}
+
+// ListByUserID はユーザーの全てのエクスポートを返します
+func (m *MockDataExportRepository) ListByUserID(_ context.Context, user_id uuid.UUID) ([]*models.DataExport, error) {
+	var data_exports []*models.DataExport
+
+	for _, data_export := range m.exports {
+		if data_export.UserID() == user_id {
+			data_exports = append(data_exports, data_export)
+		}
+	}
+	return data_exports, nil
+}
+
+// FindPending は作成待ちのエクスポートを最大limit件返します
+func (m *MockDataExportRepository) FindPending(_ context.Context, limit int) ([]*models.DataExport, error) {
+	var pending []*models.DataExport
+
+	for _, data_export := range m.exports {
+		if data_export.IsPending() && len(pending) < limit {
+			pending = append(pending, data_export)
+		}
+	}
+	return pending, nil
+}
+
+// MarkReady はダウンロードリンクのトークンのハッシュを保持します（エクスポートはドメインモデルで更新済みです）
+func (m *MockDataExportRepository) MarkReady(_ context.Context, data_export *models.DataExport, download_token_hash string) error {
+	m.token_hashes[data_export.ExportID()] = download_token_hash
+	return nil
+}
+
+// MarkFailed は何もしません（エクスポートはドメインモデルで更新済みです）
+func (m *MockDataExportRepository) MarkFailed(_ context.Context, _ *models.DataExport) error {
+	return nil
+}
+
+// FindByDownloadToken はエクスポートIDとトークンのハッシュが一致するエクスポートを返します
+func (m *MockDataExportRepository) FindByDownloadToken(_ context.Context, export_id uuid.UUID, download_token_hash string) (*models.DataExport, error) {
+	for _, data_export := range m.exports {
+		if data_export.ExportID() == export_id && m.token_hashes[export_id] == download_token_hash {
+			return data_export, nil
+		}
+	}
+	return nil, domain_errors.ErrDataExportNotFound
+}
+
+// FindExpired は有効期限が指定日時以前のエクスポートを最大limit件返します
+func (m *MockDataExportRepository) FindExpired(_ context.Context, now time.Time, limit int) ([]*models.DataExport, error) {
+	var expired []*models.DataExport
+
+	for _, data_export := range m.exports {
+		if data_export.ExpiresAt() != nil && !data_export.ExpiresAt().After(now) && len(expired) < limit {
+			expired = append(expired, data_export)
+		}
+	}
+	return expired, nil
+}
+
+// Delete はエクスポートを削除します
+func (m *MockDataExportRepository) Delete(_ context.Context, export_id uuid.UUID) error {
+	for i, data_export := range m.exports {
+		if data_export.ExportID() == export_id {
+			m.exports = append(m.exports[:i], m.exports[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// TestRequestDataExportUseCase_Execute は作成待ちのエクスポートがある間は新たに要求されないことをテストします
+func TestRequestDataExportUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var export_repo *MockDataExportRepository
+	var use_case *RequestDataExportUseCase
+	var first *models.DataExport
+	var second *models.DataExport
+	var third *models.DataExport
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	export_repo = NewMockDataExportRepository()
+	use_case = NewRequestDataExportUseCase(export_repo)
+
+	first, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !first.IsPending() || first.UserID() != user.PublicID() {
+		t.Errorf("expected pending export of user %s, got %s of %s", user.PublicID(), first.Status(), first.UserID())
+	}
+	second, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if second.ExportID() != first.ExportID() || len(export_repo.exports) != 1 {
+		t.Errorf("expected pending export to be reused, got %d exports", len(export_repo.exports))
+	}
+
+	_ = first.MarkReady(models.DataExportStorageKey(first.ExportID()), time.Now())
+	third, err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if third.ExportID() == first.ExportID() || len(export_repo.exports) != 2 {
+		t.Errorf("expected new export after completion, got %d exports", len(export_repo.exports))
+	}
+}
+
+// TestRequestDataExportUseCase_Execute_RepositoryError はリポジトリのエラーがそのまま返されることをテストします
+func TestRequestDataExportUseCase_Execute_RepositoryError(t *testing.T) {
+	var err error
+
+	_, err = NewRequestDataExportUseCase(&MockFailingDataExportRequester{}).Execute(context.Background(), create_test_account_user(t))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
+
+// MockFailingDataExportRequester は常にDBエラーを返すモックです
+type MockFailingDataExportRequester struct{}
+
+// FindPendingByUserID はDBエラーを返します
+func (m *MockFailingDataExportRequester) FindPendingByUserID(_ context.Context, _ uuid.UUID) (*models.DataExport, error) {
+	return nil, domain_errors.ErrDatabaseError
+}
+
+// Create はDBエラーを返します
+func (m *MockFailingDataExportRequester) Create(_ context.Context, _ *models.DataExport) error {
+	return domain_errors.ErrDatabaseError
+}
@@ -0,0 +1,124 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// emailChangeRateLimitKeyPrefix はメールアドレスの変更要求のレート制限キーの接頭辞です
+const emailChangeRateLimitKeyPrefix = "email_change:"
+
+// EmailExistenceCheckerInterface はメールアドレスが他のユーザーに登録済みかを確認するインターフェースです
+type EmailExistenceCheckerInterface interface {
+	ExistsByEmail(ctx context.Context, email models.Email) (bool, error)
+}
+
+// EmailChangeRequestRecorderInterface は確認待ちのメールアドレスの変更を保存するインターフェースです
+type EmailChangeRequestRecorderInterface interface {
+	// Replace はユーザーの確認待ちの変更を置き換え、以前の確認リンクを無効にします
+	Replace(ctx context.Context, request *models.EmailChangeRequest, token_hash string) error
+}
+
+// EmailChangeMailSenderInterface はメールアドレスの変更の確認メールと通知メールを送信するインターフェースです
+type EmailChangeMailSenderInterface interface {
+	SendEmailChangeConfirmationMail(ctx context.Context, email models.Email, confirmation_link string, expires_at time.Time) error
+	SendEmailChangeNoticeMail(ctx context.Context, email models.Email, requested_at time.Time) error
+}
+
+// RequestEmailChangeUseCase はログイン中のユーザーがメールアドレスの変更を要求するユースケースです
+// 変更後のメールアドレスに確認リンクを、変更前のメールアドレスに通知を送信し、確認リンクを開くまでメールアドレスは変更しません
+type RequestEmailChangeUseCase struct {
+	user_repo    EmailExistenceCheckerInterface
+	request_repo EmailChangeRequestRecorderInterface
+	mail_sender  EmailChangeMailSenderInterface
+	rate_limiter RateLimiterInterface
+	base_url     string
+}
+
+// NewRequestEmailChangeUseCase は新しいRequestEmailChangeUseCaseを作成します
+// base_urlは確認リンクのURL（<base_url>/email-change/confirm?token=<トークン>）の作成に使用します
+func NewRequestEmailChangeUseCase(
+	user_repo EmailExistenceCheckerInterface,
+	request_repo EmailChangeRequestRecorderInterface,
+	mail_sender EmailChangeMailSenderInterface,
+	rate_limiter RateLimiterInterface,
+	base_url string,
+) *RequestEmailChangeUseCase {
+	return &RequestEmailChangeUseCase{
+		user_repo:    user_repo,
+		request_repo: request_repo,
+		mail_sender:  mail_sender,
+		rate_limiter: rate_limiter,
+		base_url:     strings.TrimRight(base_url, "/"),
+	}
+}
+
+// Execute はメールアドレスの変更を要求し、確認メールと通知メールを送信します
+// 現在と同じ場合はErrSameEmail、他のユーザーが登録済みの場合はErrDuplicateEmail、
+// ユーザーごとの上限を超えた場合はErrTooManyEmailChangeRequestsを返します
+func (uc *RequestEmailChangeUseCase) Execute(ctx context.Context, user *models.User, new_email_value string) error {
+	var new_email models.Email
+	var exists bool
+	var is_allowed bool
+	var token string
+	var request *models.EmailChangeRequest
+	var now time.Time
+	var err error
+
+	new_email, err = models.NewEmail(new_email_value)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidEmail, err)
+	}
+	if strings.EqualFold(new_email.Value(), user.Email().Value()) {
+		return domain_errors.ErrSameEmail
+	}
+	exists, err = uc.user_repo.ExistsByEmail(ctx, new_email)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if exists {
+		return fmt.Errorf("%w: %s", domain_errors.ErrDuplicateEmail, new_email.Value())
+	}
+
+	is_allowed, err = uc.rate_limiter.Allow(ctx, emailChangeRateLimitKeyPrefix+user.PublicID().String())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !is_allowed {
+		return fmt.Errorf("%w", domain_errors.ErrTooManyEmailChangeRequests)
+	}
+
+	token, err = models.GenerateEmailChangeToken()
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	now = time.Now()
+	request, err = models.NewEmailChangeRequest(user.PublicID(), new_email, now)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.request_repo.Replace(ctx, request, models.HashEmailChangeToken(token))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.mail_sender.SendEmailChangeConfirmationMail(ctx, new_email, uc.build_confirmation_link(token), request.ExpiresAt())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.mail_sender.SendEmailChangeNoticeMail(ctx, user.Email(), now)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// build_confirmation_link は確認リンクのURLを作成します
+func (uc *RequestEmailChangeUseCase) build_confirmation_link(token string) string {
+	return fmt.Sprintf("%s/email-change/confirm?token=%s", uc.base_url, url.QueryEscape(token))
+}
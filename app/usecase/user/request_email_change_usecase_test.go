@@ -0,0 +1,170 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockEmailChangeRequestRepository はテスト用の確認待ちのメールアドレスの変更のリポジトリです
+type MockEmailChangeRequestRepository struct {
+	requests     map[uuid.UUID]*models.EmailChangeRequest
+	token_hashes map[uuid.UUID]string
+}
+
+// NewMockEmailChangeRequestRepository は新しいMockEmailChangeRequestRepositoryを作成します
+func NewMockEmailChangeRequestRepository() *MockEmailChangeRequestRepository {
+	return &MockEmailChangeRequestRepository{
+		requests:     map[uuid.UUID]*models.EmailChangeRequest{},
+		token_hashes: map[uuid.UUID]string{},
+	}
+}
+
+// Replace はユーザーの確認待ちの変更を置き換えます
+func (m *MockEmailChangeRequestRepository) Replace(_ context.Context, request *models.EmailChangeRequest, token_hash string) error {
+	m.requests[request.UserID()] = request
+	m.token_hashes[request.UserID()] = token_hash
+	return nil
+}
+
+// FindByTokenHash はトークンのハッシュが一致する変更を返します
+func (m *MockEmailChangeRequestRepository) FindByTokenHash(_ context.Context, token_hash string) (*models.EmailChangeRequest, error) {
+	for user_id, stored_hash := range m.token_hashes {
+		if stored_hash == token_hash {
+			return m.requests[user_id], nil
+		}
+	}
+	return nil, domain_errors.ErrInvalidEmailChangeToken
+}
+
+// DeleteByUserID はユーザーの確認待ちの変更を削除します
+func (m *MockEmailChangeRequestRepository) DeleteByUserID(_ context.Context, user_id uuid.UUID) error {
+	delete(m.requests, user_id)
+	delete(m.token_hashes, user_id)
+	return nil
+}
+
+// MockEmailChangeMailSender は送信したメールを記録するモックです
+type MockEmailChangeMailSender struct {
+	confirmation_links []string
+	confirmation_to    []string
+	notice_to          []string
+}
+
+// NewMockEmailChangeMailSender は新しいMockEmailChangeMailSenderを作成します
+func NewMockEmailChangeMailSender() *MockEmailChangeMailSender {
+	return &MockEmailChangeMailSender{
+		confirmation_links: []string{},
+		confirmation_to:    []string{},
+		notice_to:          []string{},
+	}
+}
+
+// SendEmailChangeConfirmationMail は確認リンクと宛先を記録します
+func (m *MockEmailChangeMailSender) SendEmailChangeConfirmationMail(_ context.Context, email models.Email, confirmation_link string, _ time.Time) error {
+	m.confirmation_to = append(m.confirmation_to, email.Value())
+	m.confirmation_links = append(m.confirmation_links, confirmation_link)
+	return nil
+}
+
+// SendEmailChangeNoticeMail は通知メールの宛先を記録します
+func (m *MockEmailChangeMailSender) SendEmailChangeNoticeMail(_ context.Context, email models.Email, _ time.Time) error {
+	m.notice_to = append(m.notice_to, email.Value())
+	return nil
+}
+
+// TestRequestEmailChangeUseCase_Execute は変更後のアドレスに確認リンク、変更前のアドレスに通知が送信されることをテストします
+func TestRequestEmailChangeUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var user_repo *MockEmailChangeUserRepository
+	var request_repo *MockEmailChangeRequestRepository
+	var mail_sender *MockEmailChangeMailSender
+	var use_case *RequestEmailChangeUseCase
+	var token string
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	user_repo = NewMockEmailChangeUserRepository(user)
+	request_repo = NewMockEmailChangeRequestRepository()
+	mail_sender = NewMockEmailChangeMailSender()
+	use_case = NewRequestEmailChangeUseCase(user_repo, request_repo, mail_sender, NewMockRateLimiter(3), "https://example.com/")
+
+	err = use_case.Execute(ctx, user, "new@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(mail_sender.confirmation_to) != 1 || mail_sender.confirmation_to[0] != "new@example.com" {
+		t.Errorf("expected confirmation mail to new@example.com, got %v", mail_sender.confirmation_to)
+	}
+	if len(mail_sender.notice_to) != 1 || mail_sender.notice_to[0] != testEmail {
+		t.Errorf("expected notice mail to %s, got %v", testEmail, mail_sender.notice_to)
+	}
+	if !strings.HasPrefix(mail_sender.confirmation_links[0], "https://example.com/email-change/confirm?token=") {
+		t.Errorf("unexpected confirmation link: %s", mail_sender.confirmation_links[0])
+	}
+	token = strings.TrimPrefix(mail_sender.confirmation_links[0], "https://example.com/email-change/confirm?token=")
+	if request_repo.token_hashes[user.PublicID()] != models.HashEmailChangeToken(token) {
+		t.Error("expected token hash to be stored")
+	}
+	// 確認が完了するまでメールアドレスは変更しない
+	if user_repo.users[user.PublicID()].Email().Value() != testEmail {
+		t.Errorf("expected email to remain %s, got %s", testEmail, user_repo.users[user.PublicID()].Email().Value())
+	}
+}
+
+// TestRequestEmailChangeUseCase_Execute_Rejected は同じ・登録済み・不正なメールアドレスと上限超過が拒否されることをテストします
+func TestRequestEmailChangeUseCase_Execute_Rejected(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var other_user *models.User
+	var other_email models.Email
+	var mail_sender *MockEmailChangeMailSender
+	var use_case *RequestEmailChangeUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_account_user(t)
+	other_email, _ = models.NewEmail("taken@example.com")
+	other_user, _ = models.NewUser("other_firebase_uid", other_email)
+	mail_sender = NewMockEmailChangeMailSender()
+	use_case = NewRequestEmailChangeUseCase(
+		NewMockEmailChangeUserRepository(user, other_user),
+		NewMockEmailChangeRequestRepository(),
+		mail_sender,
+		NewMockRateLimiter(1),
+		"https://example.com",
+	)
+
+	err = use_case.Execute(ctx, user, "not-an-email")
+	if !errors.Is(err, domain_errors.ErrInvalidEmail) {
+		t.Errorf("expected ErrInvalidEmail, got %v", err)
+	}
+	err = use_case.Execute(ctx, user, strings.ToUpper(testEmail))
+	if !errors.Is(err, domain_errors.ErrSameEmail) {
+		t.Errorf("expected ErrSameEmail, got %v", err)
+	}
+	err = use_case.Execute(ctx, user, "taken@example.com")
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+	err = use_case.Execute(ctx, user, "new@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = use_case.Execute(ctx, user, "another@example.com")
+	if !errors.Is(err, domain_errors.ErrTooManyEmailChangeRequests) {
+		t.Errorf("expected ErrTooManyEmailChangeRequests, got %v", err)
+	}
+	if len(mail_sender.confirmation_to) != 1 {
+		t.Errorf("expected 1 confirmation mail, got %d", len(mail_sender.confirmation_to))
+	}
+}
@@ -0,0 +1,90 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// passwordResetRateLimitKeyPrefix はパスワードリセット要求のレート制限キーの接頭辞です
+const passwordResetRateLimitKeyPrefix = "password_reset:"
+
+// PasswordResetLinkGeneratorInterface はパスワード再設定リンクを生成するインターフェースです
+type PasswordResetLinkGeneratorInterface interface {
+	GeneratePasswordResetLink(ctx context.Context, email models.Email) (string, error)
+}
+
+// PasswordResetMailSenderInterface はパスワード再設定メールを送信するインターフェースです
+type PasswordResetMailSenderInterface interface {
+	SendPasswordResetMail(ctx context.Context, email models.Email, reset_link string) error
+}
+
+// RateLimiterInterface はキーごとに実行回数を制限するインターフェースです
+type RateLimiterInterface interface {
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+// RequestPasswordResetUseCase はメールによるパスワード再設定を要求するユースケースです
+type RequestPasswordResetUseCase struct {
+	link_generator PasswordResetLinkGeneratorInterface
+	mail_sender    PasswordResetMailSenderInterface
+	rate_limiter   RateLimiterInterface
+}
+
+// NewRequestPasswordResetUseCase は新しいRequestPasswordResetUseCaseを作成します
+func NewRequestPasswordResetUseCase(
+	link_generator PasswordResetLinkGeneratorInterface,
+	mail_sender PasswordResetMailSenderInterface,
+	rate_limiter RateLimiterInterface,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		link_generator: link_generator,
+		mail_sender:    mail_sender,
+		rate_limiter:   rate_limiter,
+	}
+}
+
+// Execute はパスワード再設定リンクを生成し、メールで送信します
+// アカウントの有無を推測されないよう、未登録のメールアドレスでも登録済みと同じく成功として扱います
+// レート制限はアカウントの有無に関係なくメールアドレスごとに適用します
+// メール送信の失敗も応答の違いからアカウントの有無が分からないよう、ログに記録して成功として扱います
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, email_string string) error {
+	var email models.Email
+	var is_allowed bool
+	var reset_link string
+	var err error
+
+	email, err = models.NewEmail(email_string)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	// メールアドレスごとのレート制限（大文字・小文字の違いで回避されないよう正規化）
+	is_allowed, err = uc.rate_limiter.Allow(ctx, passwordResetRateLimitKeyPrefix+strings.ToLower(email.Value()))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !is_allowed {
+		return fmt.Errorf("%w", domain_errors.ErrTooManyPasswordResetRequests)
+	}
+
+	// パスワード再設定リンクの生成（未登録の場合は何も送信せずに成功を返す）
+	reset_link, err = uc.link_generator.GeneratePasswordResetLink(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain_errors.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("%w", err)
+	}
+
+	err = uc.mail_sender.SendPasswordResetMail(ctx, email, reset_link)
+	if err != nil {
+		log.Printf("パスワード再設定メールの送信に失敗しました: %v", err)
+	}
+	return nil
+}
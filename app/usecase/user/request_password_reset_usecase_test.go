@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// テスト用定数
+const (
+	testRegisteredEmail   = "registered@example.com"
+	testUnregisteredEmail = "unregistered@example.com"
+)
+
+// TestRequestPasswordResetUseCase_Execute_RegisteredEmail は登録済みのメールアドレスに再設定リンクが送信されることをテストします
+func TestRequestPasswordResetUseCase_Execute_RegisteredEmail(t *testing.T) {
+	var ctx context.Context
+	var mail_sender *MockPasswordResetMailSender
+	var use_case *RequestPasswordResetUseCase
+	var err error
+
+	ctx = context.Background()
+	mail_sender = NewMockPasswordResetMailSender()
+	use_case = NewRequestPasswordResetUseCase(NewMockPasswordResetLinkGenerator(), mail_sender, NewMockRateLimiter(3))
+	err = use_case.Execute(ctx, testRegisteredEmail)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(mail_sender.SentTo) != 1 || mail_sender.SentTo[0] != testRegisteredEmail {
+		t.Errorf("expected mail to be sent to %s, got %v", testRegisteredEmail, mail_sender.SentTo)
+	}
+}
+
+// TestRequestPasswordResetUseCase_Execute_UnregisteredEmail は未登録のメールアドレスでも同じ結果を返し、メールを送信しないことをテストします
+func TestRequestPasswordResetUseCase_Execute_UnregisteredEmail(t *testing.T) {
+	var ctx context.Context
+	var mail_sender *MockPasswordResetMailSender
+	var use_case *RequestPasswordResetUseCase
+	var err error
+
+	ctx = context.Background()
+	mail_sender = NewMockPasswordResetMailSender()
+	use_case = NewRequestPasswordResetUseCase(NewMockPasswordResetLinkGenerator(), mail_sender, NewMockRateLimiter(3))
+	err = use_case.Execute(ctx, testUnregisteredEmail)
+	if err != nil {
+		t.Fatalf("expected no error for unregistered email, got %v", err)
+	}
+	if len(mail_sender.SentTo) != 0 {
+		t.Errorf("expected no mail to be sent, got %v", mail_sender.SentTo)
+	}
+}
+
+// TestRequestPasswordResetUseCase_Execute_RateLimited はメールアドレスごとのレート制限をテストします
+// 通過条件:
+// - 登録の有無に関係なく上限を超えるとErrTooManyPasswordResetRequestsになる
+// - 大文字・小文字の違いで制限を回避できない
+func TestRequestPasswordResetUseCase_Execute_RateLimited(t *testing.T) {
+	var ctx context.Context
+	var use_case *RequestPasswordResetUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case = NewRequestPasswordResetUseCase(NewMockPasswordResetLinkGenerator(), NewMockPasswordResetMailSender(), NewMockRateLimiter(1))
+	for _, email := range []string{testRegisteredEmail, testUnregisteredEmail} {
+		err = use_case.Execute(ctx, email)
+		if err != nil {
+			t.Fatalf("expected first request to succeed, got %v", err)
+		}
+		err = use_case.Execute(ctx, email)
+		if !errors.Is(err, domain_errors.ErrTooManyPasswordResetRequests) {
+			t.Errorf("expected ErrTooManyPasswordResetRequests for %s, got %v", email, err)
+		}
+	}
+	err = use_case.Execute(ctx, "Registered@Example.com")
+	if !errors.Is(err, domain_errors.ErrTooManyPasswordResetRequests) {
+		t.Errorf("expected ErrTooManyPasswordResetRequests for different case, got %v", err)
+	}
+}
+
+// TestRequestPasswordResetUseCase_Execute_InvalidEmail は不正な形式のメールアドレスでエラーを返すことをテストします
+func TestRequestPasswordResetUseCase_Execute_InvalidEmail(t *testing.T) {
+	var ctx context.Context
+	var use_case *RequestPasswordResetUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case = NewRequestPasswordResetUseCase(NewMockPasswordResetLinkGenerator(), NewMockPasswordResetMailSender(), NewMockRateLimiter(3))
+	err = use_case.Execute(ctx, "invalid-email")
+	if err == nil {
+		t.Error("expected error for invalid email, got nil")
+	}
+}
+
+// TestRequestPasswordResetUseCase_Execute_MailSendFailed はメール送信に失敗した場合も未登録のメールアドレスと同じく成功を返すことをテストします
+func TestRequestPasswordResetUseCase_Execute_MailSendFailed(t *testing.T) {
+	var ctx context.Context
+	var use_case *RequestPasswordResetUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case = NewRequestPasswordResetUseCase(NewMockPasswordResetLinkGenerator(), NewMockPasswordResetMailSenderWithError(), NewMockRateLimiter(3))
+	err = use_case.Execute(ctx, testRegisteredEmail)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+// MockPasswordResetLinkGenerator はテスト用のパスワード再設定リンク生成のモックです
+type MockPasswordResetLinkGenerator struct {
+	registered_emails map[string]bool
+}
+
+// NewMockPasswordResetLinkGenerator は新しいMockPasswordResetLinkGeneratorを作成します
+func NewMockPasswordResetLinkGenerator() *MockPasswordResetLinkGenerator {
+	return &MockPasswordResetLinkGenerator{
+		registered_emails: map[string]bool{testRegisteredEmail: true},
+	}
+}
+
+// GeneratePasswordResetLink はモックのリンク生成を行います（未登録の場合はErrUserNotFound）
+func (m *MockPasswordResetLinkGenerator) GeneratePasswordResetLink(_ context.Context, email models.Email) (string, error) {
+	if !m.registered_emails[email.Value()] {
+		return "", fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, email.Value())
+	}
+	return "https://sleeve.example.com/reset-password?oobCode=test", nil
+}
+
+// MockPasswordResetMailSender はテスト用のメール送信のモックです
+type MockPasswordResetMailSender struct {
+	should_return_error bool
+	SentTo              []string
+}
+
+// NewMockPasswordResetMailSender は新しいMockPasswordResetMailSenderを作成します
+func NewMockPasswordResetMailSender() *MockPasswordResetMailSender {
+	return &MockPasswordResetMailSender{
+		should_return_error: false,
+		SentTo:              []string{},
+	}
+}
+
+// NewMockPasswordResetMailSenderWithError は送信エラーを返すMockPasswordResetMailSenderを作成します
+func NewMockPasswordResetMailSenderWithError() *MockPasswordResetMailSender {
+	return &MockPasswordResetMailSender{
+		should_return_error: true,
+		SentTo:              []string{},
+	}
+}
+
+// SendPasswordResetMail はモックのメール送信を行います
+func (m *MockPasswordResetMailSender) SendPasswordResetMail(_ context.Context, email models.Email, _ string) error {
+	if m.should_return_error {
+		return domain_errors.ErrMailSendFailed
+	}
+	m.SentTo = append(m.SentTo, email.Value())
+	return nil
+}
+
+// MockRateLimiter はテスト用のレートリミッターのモックです
+type MockRateLimiter struct {
+	limit  int
+	counts map[string]int
+}
+
+// NewMockRateLimiter は新しいMockRateLimiterを作成します
+func NewMockRateLimiter(limit int) *MockRateLimiter {
+	return &MockRateLimiter{
+		limit:  limit,
+		counts: map[string]int{},
+	}
+}
+
+// Allow はキーごとの回数が上限未満であれば許可します
+func (m *MockRateLimiter) Allow(_ context.Context, key string) (bool, error) {
+	if m.counts[key] >= m.limit {
+		return false, nil
+	}
+	m.counts[key]++
+	return true, nil
+}
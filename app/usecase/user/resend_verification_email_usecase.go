@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// verificationEmailRateLimitKeyPrefix は確認メール再送信のレート制限キーの接頭辞です
+const verificationEmailRateLimitKeyPrefix = "email_verification:"
+
+// EmailVerificationLinkGeneratorInterface はメールアドレス確認リンクを生成するインターフェースです
+type EmailVerificationLinkGeneratorInterface interface {
+	GenerateEmailVerificationLink(ctx context.Context, email models.Email) (string, error)
+}
+
+// EmailVerificationMailSenderInterface はメールアドレス確認メールを送信するインターフェースです
+type EmailVerificationMailSenderInterface interface {
+	SendEmailVerificationMail(ctx context.Context, email models.Email, verification_link string) error
+}
+
+// EmailVerificationMailer はメールアドレス確認リンクを生成し、メールで送信します
+// ユーザー登録時の送信と、resendVerificationEmailによる再送信で共有します
+type EmailVerificationMailer struct {
+	link_generator EmailVerificationLinkGeneratorInterface
+	mail_sender    EmailVerificationMailSenderInterface
+}
+
+// NewEmailVerificationMailer は新しいEmailVerificationMailerを作成します
+func NewEmailVerificationMailer(
+	link_generator EmailVerificationLinkGeneratorInterface,
+	mail_sender EmailVerificationMailSenderInterface,
+) *EmailVerificationMailer {
+	return &EmailVerificationMailer{
+		link_generator: link_generator,
+		mail_sender:    mail_sender,
+	}
+}
+
+// Send はメールアドレス確認リンクを生成し、メールで送信します
+func (m *EmailVerificationMailer) Send(ctx context.Context, email models.Email) error {
+	var verification_link string
+	var err error
+
+	verification_link, err = m.link_generator.GenerateEmailVerificationLink(ctx, email)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = m.mail_sender.SendEmailVerificationMail(ctx, email, verification_link)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// ResendVerificationEmailUseCase はメールアドレス確認メールを再送信するユースケースです
+type ResendVerificationEmailUseCase struct {
+	mailer       *EmailVerificationMailer
+	rate_limiter RateLimiterInterface
+}
+
+// NewResendVerificationEmailUseCase は新しいResendVerificationEmailUseCaseを作成します
+func NewResendVerificationEmailUseCase(
+	mailer *EmailVerificationMailer,
+	rate_limiter RateLimiterInterface,
+) *ResendVerificationEmailUseCase {
+	return &ResendVerificationEmailUseCase{
+		mailer:       mailer,
+		rate_limiter: rate_limiter,
+	}
+}
+
+// Execute はログインユーザーのメールアドレスに確認メールを再送信します
+// 確認済みの場合はErrEmailAlreadyVerified、ユーザーごとの上限を超えた場合はErrTooManyVerificationEmailRequestsを返します
+func (uc *ResendVerificationEmailUseCase) Execute(ctx context.Context, user *models.User) error {
+	var is_allowed bool
+	var err error
+
+	if user.IsEmailVerified() {
+		return fmt.Errorf("%w: %s", domain_errors.ErrEmailAlreadyVerified, user.PublicID().String())
+	}
+
+	is_allowed, err = uc.rate_limiter.Allow(ctx, verificationEmailRateLimitKeyPrefix+user.PublicID().String())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !is_allowed {
+		return fmt.Errorf("%w", domain_errors.ErrTooManyVerificationEmailRequests)
+	}
+
+	err = uc.mailer.Send(ctx, user.Email())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
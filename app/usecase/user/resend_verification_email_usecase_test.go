@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// testVerificationLink はテスト用のメールアドレス確認リンクです
+const testVerificationLink = "https://sleeve.example.com/verify-email?oobCode=test"
+
+// create_unverified_test_user はメールアドレス未確認のテスト用ユーザーを作成します
+func create_unverified_test_user(t *testing.T) *models.User {
+	var email models.Email
+	var now time.Time
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, models.RoleUser, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestResendVerificationEmailUseCase_Execute_Success は未確認のユーザーに確認メールが送信されることをテストします
+func TestResendVerificationEmailUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var mail_sender *MockEmailVerificationMailSender
+	var use_case *ResendVerificationEmailUseCase
+	var err error
+
+	ctx = context.Background()
+	mail_sender = NewMockEmailVerificationMailSender()
+	use_case = NewResendVerificationEmailUseCase(
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), mail_sender),
+		NewMockRateLimiter(3),
+	)
+	err = use_case.Execute(ctx, create_unverified_test_user(t))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if mail_sender.SentLinks[testEmail] != testVerificationLink {
+		t.Errorf("expected verification link to be sent to %s, got %v", testEmail, mail_sender.SentLinks)
+	}
+}
+
+// TestResendVerificationEmailUseCase_Execute_AlreadyVerified は確認済みのユーザーにErrEmailAlreadyVerifiedを返すことをテストします
+func TestResendVerificationEmailUseCase_Execute_AlreadyVerified(t *testing.T) {
+	var ctx context.Context
+	var mail_sender *MockEmailVerificationMailSender
+	var use_case *ResendVerificationEmailUseCase
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	mail_sender = NewMockEmailVerificationMailSender()
+	use_case = NewResendVerificationEmailUseCase(
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), mail_sender),
+		NewMockRateLimiter(3),
+	)
+	user = create_unverified_test_user(t)
+	user.MarkEmailVerified(time.Now())
+	err = use_case.Execute(ctx, user)
+	if !errors.Is(err, domain_errors.ErrEmailAlreadyVerified) {
+		t.Errorf("expected ErrEmailAlreadyVerified, got %v", err)
+	}
+	if len(mail_sender.SentLinks) != 0 {
+		t.Errorf("expected no mail to be sent, got %v", mail_sender.SentLinks)
+	}
+}
+
+// TestResendVerificationEmailUseCase_Execute_RateLimited はユーザーごとの上限を超えた場合にErrTooManyVerificationEmailRequestsを返すことをテストします
+func TestResendVerificationEmailUseCase_Execute_RateLimited(t *testing.T) {
+	var ctx context.Context
+	var use_case *ResendVerificationEmailUseCase
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	use_case = NewResendVerificationEmailUseCase(
+		NewEmailVerificationMailer(NewMockEmailVerificationLinkGenerator(), NewMockEmailVerificationMailSender()),
+		NewMockRateLimiter(1),
+	)
+	user = create_unverified_test_user(t)
+	err = use_case.Execute(ctx, user)
+	if err != nil {
+		t.Fatalf("expected first request to succeed, got %v", err)
+	}
+	err = use_case.Execute(ctx, user)
+	if !errors.Is(err, domain_errors.ErrTooManyVerificationEmailRequests) {
+		t.Errorf("expected ErrTooManyVerificationEmailRequests, got %v", err)
+	}
+}
+
+// MockEmailVerificationLinkGenerator はテスト用のメールアドレス確認リンク生成のモックです
+type MockEmailVerificationLinkGenerator struct{}
+
+// NewMockEmailVerificationLinkGenerator は新しいMockEmailVerificationLinkGeneratorを作成します
+func NewMockEmailVerificationLinkGenerator() *MockEmailVerificationLinkGenerator {
+	return &MockEmailVerificationLinkGenerator{}
+}
+
+// GenerateEmailVerificationLink はモックの確認リンクを返します
+func (m *MockEmailVerificationLinkGenerator) GenerateEmailVerificationLink(_ context.Context, _ models.Email) (string, error) {
+	return testVerificationLink, nil
+}
+
+// MockEmailVerificationMailSender はテスト用の確認メール送信のモックです
+type MockEmailVerificationMailSender struct {
+	should_return_error bool
+	SentLinks           map[string]string
+}
+
+// NewMockEmailVerificationMailSender は新しいMockEmailVerificationMailSenderを作成します
+func NewMockEmailVerificationMailSender() *MockEmailVerificationMailSender {
+	return &MockEmailVerificationMailSender{
+		should_return_error: false,
+		SentLinks:           map[string]string{},
+	}
+}
+
+// NewMockEmailVerificationMailSenderWithError は送信エラーを返すMockEmailVerificationMailSenderを作成します
+func NewMockEmailVerificationMailSenderWithError() *MockEmailVerificationMailSender {
+	return &MockEmailVerificationMailSender{
+		should_return_error: true,
+		SentLinks:           map[string]string{},
+	}
+}
+
+// SendEmailVerificationMail は送信先と確認リンクを記録します
+func (m *MockEmailVerificationMailSender) SendEmailVerificationMail(_ context.Context, email models.Email, verification_link string) error {
+	if m.should_return_error {
+		return domain_errors.ErrMailSendFailed
+	}
+	m.SentLinks[email.Value()] = verification_link
+	return nil
+}
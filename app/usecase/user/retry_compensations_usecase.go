@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// compensationRetryBatchSize は1回の実行で再試行する補償処理の最大件数です
+const compensationRetryBatchSize = 100
+
+// RetryCompensationsResult は補償処理の再試行の結果を表します
+type RetryCompensationsResult struct {
+	// Succeeded は成功して削除した補償処理の件数です
+	Succeeded int
+	// Failed は再び失敗し、次回の再試行を予定した件数です
+	Failed int
+	// Exhausted は再試行回数の上限に達し、手動での対応が必要になった件数です
+	Exhausted int
+}
+
+// CompensationUserFinderInterface は補償処理の対象のユーザーをFirebase UIDで検索するインターフェースです
+type CompensationUserFinderInterface interface {
+	FindByFirebaseUID(ctx context.Context, firebase_uid string) (*models.User, error)
+}
+
+// RetryCompensationsUseCase は再試行時刻を過ぎた補償処理を再試行するユースケースです
+type RetryCompensationsUseCase struct {
+	firebase_repo FirebaseUserCompensationInterface
+	user_finder   CompensationUserFinderInterface
+	task_repo     CompensationTaskRepositoryInterface
+}
+
+// NewRetryCompensationsUseCase は新しいRetryCompensationsUseCaseを作成します
+func NewRetryCompensationsUseCase(
+	firebase_repo FirebaseUserCompensationInterface,
+	user_finder CompensationUserFinderInterface,
+	task_repo CompensationTaskRepositoryInterface,
+) *RetryCompensationsUseCase {
+	return &RetryCompensationsUseCase{
+		firebase_repo: firebase_repo,
+		user_finder:   user_finder,
+		task_repo:     task_repo,
+	}
+}
+
+// Execute は補償処理を再試行します
+// 成功した補償処理は削除し、失敗した補償処理は試行回数を加算して次回の試行時刻を延ばします
+func (uc *RetryCompensationsUseCase) Execute(ctx context.Context) (*RetryCompensationsResult, error) {
+	var now time.Time
+	var tasks []*models.CompensationTask
+	var result *RetryCompensationsResult
+	var err error
+
+	now = time.Now()
+	tasks, err = uc.task_repo.FindDue(ctx, now, compensationRetryBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	result = &RetryCompensationsResult{}
+	for _, task := range tasks {
+		var run_err error
+
+		run_err = uc.run_task(ctx, task)
+		if run_err == nil {
+			err = uc.task_repo.Delete(ctx, task.TaskID())
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+			result.Succeeded++
+			continue
+		}
+		task.RecordFailure(run_err.Error(), now)
+		err = uc.task_repo.Update(ctx, task)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if task.IsExhausted() {
+			result.Exhausted++
+			continue
+		}
+		result.Failed++
+	}
+	return result, nil
+}
+
+// run_task は補償処理の種類に応じた処理を実行します
+func (uc *RetryCompensationsUseCase) run_task(ctx context.Context, task *models.CompensationTask) error {
+	switch task.Kind() {
+	case models.CompensationKindDeleteFirebaseUser:
+		return uc.firebase_repo.DeleteUser(ctx, task.Target())
+	case models.CompensationKindEnableFirebaseUser:
+		return uc.firebase_repo.EnableUser(ctx, task.Target())
+	case models.CompensationKindSyncFirebaseEmail:
+		return uc.sync_firebase_email(ctx, task.Target())
+	default:
+		return fmt.Errorf("unsupported compensation kind: %s", task.Kind())
+	}
+}
+
+// sync_firebase_email はFirebaseのメールアドレスを再試行の時点のusersテーブルのメールアドレスに合わせます
+// usersテーブルから削除されたユーザーは合わせる対象がないため、成功として扱います
+func (uc *RetryCompensationsUseCase) sync_firebase_email(ctx context.Context, firebase_uid string) error {
+	var user *models.User
+	var err error
+
+	user, err = uc.user_finder.FindByFirebaseUID(ctx, firebase_uid)
+	if errors.Is(err, domain_errors.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return uc.firebase_repo.UpdateEmail(ctx, firebase_uid, user.Email(), user.IsEmailVerified())
+}
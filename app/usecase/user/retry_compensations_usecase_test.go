@@ -0,0 +1,162 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sleeve/domain/models"
+)
+
+// create_due_compensation_task はテスト用に再試行時刻を過ぎた補償処理を作成して保存します
+func create_due_compensation_task(t *testing.T, task_repo *MockCompensationTaskRepository) *models.CompensationTask {
+	var task *models.CompensationTask
+	var err error
+
+	t.Helper()
+	task, err = models.NewCompensationTask(models.CompensationKindDeleteFirebaseUser, testFirebaseUID, "delete failed", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create compensation task: %v", err)
+	}
+	err = task_repo.Save(context.Background(), task)
+	if err != nil {
+		t.Fatalf("failed to save compensation task: %v", err)
+	}
+	return task
+}
+
+// TestRetryCompensationsUseCase_Execute_Success は再試行に成功した補償処理が削除されることをテストします
+func TestRetryCompensationsUseCase_Execute_Success(t *testing.T) {
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var result *RetryCompensationsResult
+	var err error
+
+	mock_firebase = NewMockFirebaseUserRepository()
+	task_repo = NewMockCompensationTaskRepository()
+	create_due_compensation_task(t, task_repo)
+	result, err = NewRetryCompensationsUseCase(mock_firebase, NewMockUserFinder(), task_repo).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Succeeded != 1 || result.Failed != 0 {
+		t.Errorf("expected 1 succeeded task, got %+v", result)
+	}
+	if !mock_firebase.DeleteUserCalled {
+		t.Error("expected DeleteUser to be called")
+	}
+	if len(task_repo.tasks) != 0 {
+		t.Errorf("expected task to be deleted, got %d", len(task_repo.tasks))
+	}
+}
+
+// TestRetryCompensationsUseCase_Execute_EnableFirebaseUser はFirebaseユーザーの有効化の補償処理を再試行することをテストします
+func TestRetryCompensationsUseCase_Execute_EnableFirebaseUser(t *testing.T) {
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var task *models.CompensationTask
+	var result *RetryCompensationsResult
+	var err error
+
+	mock_firebase = NewMockFirebaseUserRepository()
+	task_repo = NewMockCompensationTaskRepository()
+	task, err = models.NewCompensationTask(models.CompensationKindEnableFirebaseUser, testFirebaseUID, "enable failed", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create compensation task: %v", err)
+	}
+	_ = task_repo.Save(context.Background(), task)
+	result, err = NewRetryCompensationsUseCase(mock_firebase, NewMockUserFinder(), task_repo).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Succeeded != 1 {
+		t.Errorf("expected 1 succeeded task, got %+v", result)
+	}
+	if !mock_firebase.EnableUserCalled || mock_firebase.DeleteUserCalled {
+		t.Error("expected only EnableUser to be called")
+	}
+}
+
+// TestRetryCompensationsUseCase_Execute_SyncFirebaseEmail はFirebaseのメールアドレスを再試行の時点のusersテーブルのメールアドレスに合わせることをテストします
+func TestRetryCompensationsUseCase_Execute_SyncFirebaseEmail(t *testing.T) {
+	var mock_firebase *MockFirebaseUserRepository
+	var task_repo *MockCompensationTaskRepository
+	var task *models.CompensationTask
+	var result *RetryCompensationsResult
+	var err error
+
+	mock_firebase = NewMockFirebaseUserRepository()
+	task_repo = NewMockCompensationTaskRepository()
+	for _, user_finder := range []*MockUserFinder{NewMockUserFinder(), NewMockUserFinderWithNotFound()} {
+		task, err = models.NewCompensationTask(models.CompensationKindSyncFirebaseEmail, testFirebaseUID, "update failed", time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("failed to create compensation task: %v", err)
+		}
+		_ = task_repo.Save(context.Background(), task)
+		result, err = NewRetryCompensationsUseCase(mock_firebase, user_finder, task_repo).Execute(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		// usersテーブルから削除されたユーザーは合わせる対象がないため成功として扱う
+		if result.Succeeded != 1 || len(task_repo.tasks) != 0 {
+			t.Errorf("expected 1 succeeded task, got %+v", result)
+		}
+	}
+	if mock_firebase.UpdatedEmail != testEmail {
+		t.Errorf("expected Firebase email to be synced to %s, got %s", testEmail, mock_firebase.UpdatedEmail)
+	}
+}
+
+// TestRetryCompensationsUseCase_Execute_Failure は再試行に失敗した補償処理の試行回数と次回の試行時刻が更新されることをテストします
+func TestRetryCompensationsUseCase_Execute_Failure(t *testing.T) {
+	var task_repo *MockCompensationTaskRepository
+	var task *models.CompensationTask
+	var result *RetryCompensationsResult
+	var err error
+
+	task_repo = NewMockCompensationTaskRepository()
+	task = create_due_compensation_task(t, task_repo)
+	result, err = NewRetryCompensationsUseCase(NewMockFirebaseUserRepositoryWithDeleteError(), NewMockUserFinder(), task_repo).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Failed != 1 {
+		t.Errorf("expected 1 failed task, got %+v", result)
+	}
+	if task_repo.tasks[task.TaskID()].Attempts() != 2 {
+		t.Errorf("expected attempts 2, got %d", task_repo.tasks[task.TaskID()].Attempts())
+	}
+	if !task_repo.tasks[task.TaskID()].NextAttemptAt().After(time.Now()) {
+		t.Error("expected next_attempt_at to be in the future")
+	}
+}
+
+// TestRetryCompensationsUseCase_Execute_Exhausted は再試行回数の上限に達した補償処理が再試行されなくなることをテストします
+func TestRetryCompensationsUseCase_Execute_Exhausted(t *testing.T) {
+	var task_repo *MockCompensationTaskRepository
+	var use_case *RetryCompensationsUseCase
+	var task *models.CompensationTask
+	var result *RetryCompensationsResult
+	var err error
+
+	task_repo = NewMockCompensationTaskRepository()
+	task = create_due_compensation_task(t, task_repo)
+	for task.Attempts() < models.MaxCompensationAttempts-1 {
+		task.RecordFailure("delete failed", time.Now().Add(-24*time.Hour))
+	}
+	use_case = NewRetryCompensationsUseCase(NewMockFirebaseUserRepositoryWithDeleteError(), NewMockUserFinder(), task_repo)
+	result, err = use_case.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Exhausted != 1 || !task.IsExhausted() {
+		t.Errorf("expected task to be exhausted, got %+v", result)
+	}
+	result, err = use_case.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Succeeded+result.Failed+result.Exhausted != 0 {
+		t.Errorf("expected exhausted task to not be retried, got %+v", result)
+	}
+}
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// RevokeSessionUseCase はログイン中のユーザーの指定したセッションを失効させるユースケースです（紛失した端末のログアウトなど）
+type RevokeSessionUseCase struct {
+	session_repo    SessionFinderInterface
+	session_revoker *UserSessionRevoker
+}
+
+// NewRevokeSessionUseCase は新しいRevokeSessionUseCaseを作成します
+func NewRevokeSessionUseCase(session_repo SessionFinderInterface, session_revoker *UserSessionRevoker) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		session_repo:    session_repo,
+		session_revoker: session_revoker,
+	}
+}
+
+// Execute はセッションを失効させ、そのセッションのアクセストークン・リフレッシュトークンを使えなくします
+// 他のユーザーのセッション・失効済みのセッションの場合は、存在を明かさないためErrSessionNotFoundを返します
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, user *models.User, session_id_str string) error {
+	var session_id uuid.UUID
+	var session *models.Session
+	var now time.Time
+	var err error
+
+	session_id, err = uuid.Parse(session_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrSessionNotFound, session_id_str)
+	}
+	session, err = uc.session_repo.FindBySessionID(ctx, session_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	now = time.Now()
+	if !session.IsOwnedBy(user.PublicID()) || !session.IsActive(now) {
+		return fmt.Errorf("%w: %s", domain_errors.ErrSessionNotFound, session_id_str)
+	}
+	err = uc.session_revoker.RevokeSession(ctx, session_id.String(), now)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// sessionTestContext はセッション管理のテストで共有する依存関係です
+type sessionTestContext struct {
+	jwt_service        *utils.JWTService
+	session_repo       *MockSessionRepository
+	user               *models.User
+	login_use_case     *LoginUserUseCase
+	refresh_use_case   *RefreshTokensUseCase
+	list_use_case      *ListSessionsUseCase
+	revoke_use_case    *RevokeSessionUseCase
+	refresh_token_repo *MockRefreshTokenRepository
+}
+
+// setupSessionTest はセッション管理のテスト用の依存関係を作成します
+func setupSessionTest(t *testing.T) *sessionTestContext {
+	var test_context *sessionTestContext
+	var denylist *MockTokenDenylist
+	var user_finder *MockUserFinder
+	var token_issuer *TokenIssuer
+	var session_revoker *UserSessionRevoker
+	var err error
+
+	t.Helper()
+	denylist = NewMockTokenDenylist()
+	test_context = &sessionTestContext{
+		jwt_service:        new_test_jwt_service(denylist),
+		session_repo:       NewMockSessionRepository(),
+		refresh_token_repo: NewMockRefreshTokenRepository(),
+	}
+	user_finder = NewMockUserFinder()
+	test_context.user, err = user_finder.FindByFirebaseUID(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	token_issuer = NewTokenIssuer(test_context.jwt_service, test_context.refresh_token_repo, test_context.session_repo)
+	session_revoker = NewUserSessionRevoker(denylist, test_context.refresh_token_repo, test_context.session_repo)
+	test_context.login_use_case = new_test_login_user_use_case(NewMockFirebaseTokenVerifier(), user_finder, token_issuer)
+	test_context.refresh_use_case = NewRefreshTokensUseCase(
+		test_context.jwt_service, token_issuer, test_context.refresh_token_repo, user_finder,
+		NewMockCredentialChangeChecker(time.Time{}), session_revoker,
+	)
+	test_context.list_use_case = NewListSessionsUseCase(test_context.session_repo)
+	test_context.revoke_use_case = NewRevokeSessionUseCase(test_context.session_repo, session_revoker)
+	return test_context
+}
+
+// login は端末情報を付けてログインし、トークンとセッションIDを返します
+func (c *sessionTestContext) login(t *testing.T, device_name string) (*LoginUserResult, string) {
+	var ctx context.Context
+	var result *LoginUserResult
+	var claims *utils.JWTClaims
+	var err error
+
+	t.Helper()
+	ctx = utils.WithClientInfo(context.Background(), &utils.ClientInfo{
+		DeviceName: device_name,
+		Platform:   "ios",
+		IPAddress:  "192.0.2.1",
+		UserAgent:  "SleeveApp/1.0",
+	})
+	result, err = c.login_use_case.Execute(ctx, testIDToken)
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	claims, err = c.jwt_service.ValidateToken(context.Background(), result.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate access token: %v", err)
+	}
+	return result, claims.SessionID
+}
+
+// TestListSessionsUseCase_Execute はログインごとに端末情報付きのセッションが記録されることをテストします
+func TestListSessionsUseCase_Execute(t *testing.T) {
+	var test_context *sessionTestContext
+	var session_id string
+	var sessions []*models.Session
+	var err error
+
+	test_context = setupSessionTest(t)
+	_, session_id = test_context.login(t, "My iPhone")
+	test_context.login(t, "My iPad")
+	sessions, err = test_context.list_use_case.Execute(context.Background(), test_context.user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0].SessionID().String() != session_id {
+		t.Errorf("expected session id %s to match sid claim, got %s", session_id, sessions[0].SessionID())
+	}
+	if sessions[0].Device().Name() != "My iPhone" || sessions[0].Device().Platform() != models.SessionPlatformIOS {
+		t.Errorf("unexpected device: %+v", sessions[0].Device())
+	}
+	if sessions[0].Device().IPAddress() != "192.0.2.1" || sessions[0].Device().UserAgent() != "SleeveApp/1.0" {
+		t.Errorf("unexpected device: %+v", sessions[0].Device())
+	}
+}
+
+// TestTokenIssuer_IssueTokenPairInFamily_TouchesSession はリフレッシュ時にセッションの最終利用日時が更新されることをテストします
+func TestTokenIssuer_IssueTokenPairInFamily_TouchesSession(t *testing.T) {
+	var test_context *sessionTestContext
+	var result *LoginUserResult
+	var session_id string
+	var last_seen_at time.Time
+	var err error
+
+	test_context = setupSessionTest(t)
+	result, session_id = test_context.login(t, "My iPhone")
+	last_seen_at = test_context.session_repo.sessions[session_id].LastSeenAt()
+	time.Sleep(time.Millisecond)
+	_, err = test_context.refresh_use_case.Execute(context.Background(), result.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !test_context.session_repo.sessions[session_id].LastSeenAt().After(last_seen_at) {
+		t.Error("expected last_seen_at to be updated on refresh")
+	}
+}
+
+// TestRevokeSessionUseCase_Execute_Success は失効させたセッションのトークンだけが使えなくなることをテストします
+func TestRevokeSessionUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var test_context *sessionTestContext
+	var lost_device *LoginUserResult
+	var current_device *LoginUserResult
+	var lost_session_id string
+	var sessions []*models.Session
+	var err error
+
+	ctx = context.Background()
+	test_context = setupSessionTest(t)
+	lost_device, lost_session_id = test_context.login(t, "Lost iPhone")
+	current_device, _ = test_context.login(t, "My iPad")
+	err = test_context.revoke_use_case.Execute(ctx, test_context.user, lost_session_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = test_context.jwt_service.ValidateToken(ctx, lost_device.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked for revoked session, got %v", err)
+	}
+	_, err = test_context.refresh_use_case.Execute(ctx, lost_device.RefreshToken)
+	if err == nil {
+		t.Error("expected refresh token of revoked session to be rejected")
+	}
+	_, err = test_context.jwt_service.ValidateToken(ctx, current_device.AccessToken)
+	if err != nil {
+		t.Errorf("expected other session to remain valid, got %v", err)
+	}
+	sessions, err = test_context.list_use_case.Execute(ctx, test_context.user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].SessionID().String() == lost_session_id {
+		t.Error("expected revoked session to be removed from the list")
+	}
+}
+
+// TestRevokeSessionUseCase_Execute_NotFound は存在しない・他のユーザー・失効済みのセッションでErrSessionNotFoundを返すことをテストします
+func TestRevokeSessionUseCase_Execute_NotFound(t *testing.T) {
+	var ctx context.Context
+	var test_context *sessionTestContext
+	var session_id string
+	var other_user *models.User
+	var err error
+
+	ctx = context.Background()
+	test_context = setupSessionTest(t)
+	_, session_id = test_context.login(t, "My iPhone")
+
+	err = test_context.revoke_use_case.Execute(ctx, test_context.user, "invalid_session_id")
+	if !errors.Is(err, domain_errors.ErrSessionNotFound) {
+		t.Errorf("expected ErrSessionNotFound for invalid id, got %v", err)
+	}
+	other_user = create_test_user(t)
+	err = test_context.revoke_use_case.Execute(ctx, other_user, session_id)
+	if !errors.Is(err, domain_errors.ErrSessionNotFound) {
+		t.Errorf("expected ErrSessionNotFound for other user's session, got %v", err)
+	}
+	err = test_context.revoke_use_case.Execute(ctx, test_context.user, session_id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = test_context.revoke_use_case.Execute(ctx, test_context.user, session_id)
+	if !errors.Is(err, domain_errors.ErrSessionNotFound) {
+		t.Errorf("expected ErrSessionNotFound for revoked session, got %v", err)
+	}
+}
+
+// MockSessionRepository はテスト用のインメモリなセッションリポジトリです
+type MockSessionRepository struct {
+	sessions map[string]*models.Session
+	order    []string
+}
+
+// NewMockSessionRepository は新しいMockSessionRepositoryを作成します
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		sessions: map[string]*models.Session{},
+		order:    []string{},
+	}
+}
+
+// Save はモックのセッション保存を行います
+func (m *MockSessionRepository) Save(_ context.Context, session *models.Session) error {
+	m.sessions[session.SessionID().String()] = session
+	m.order = append(m.order, session.SessionID().String())
+	return nil
+}
+
+// Touch はモックのセッションの最終利用日時と有効期限の更新を行います
+func (m *MockSessionRepository) Touch(_ context.Context, session_id uuid.UUID, last_seen_at time.Time, expires_at time.Time) error {
+	var session *models.Session
+	var is_found bool
+	var err error
+
+	session, is_found = m.sessions[session_id.String()]
+	if !is_found || session.RevokedAt() != nil {
+		return nil
+	}
+	m.sessions[session_id.String()], err = models.NewSessionWithStatus(
+		session.SessionID(), session.UserID(), session.Device(), last_seen_at, expires_at, nil, session.CreatedAt(),
+	)
+	return err
+}
+
+// Revoke はモックのセッション失効を行います
+func (m *MockSessionRepository) Revoke(_ context.Context, session_id uuid.UUID, revoked_at time.Time) error {
+	var session *models.Session
+	var is_found bool
+	var err error
+
+	session, is_found = m.sessions[session_id.String()]
+	if !is_found || session.RevokedAt() != nil {
+		return nil
+	}
+	m.sessions[session_id.String()], err = models.NewSessionWithStatus(
+		session.SessionID(), session.UserID(), session.Device(), session.LastSeenAt(), session.ExpiresAt(), &revoked_at, session.CreatedAt(),
+	)
+	return err
+}
+
+// FindBySessionID はモックのセッション検索を行います
+func (m *MockSessionRepository) FindBySessionID(_ context.Context, session_id uuid.UUID) (*models.Session, error) {
+	var session *models.Session
+	var is_found bool
+
+	session, is_found = m.sessions[session_id.String()]
+	if !is_found {
+		return nil, domain_errors.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+// FindActiveByUserID はモックの有効なセッション検索を行います（保存順に返します）
+func (m *MockSessionRepository) FindActiveByUserID(_ context.Context, user_id uuid.UUID, now time.Time) ([]*models.Session, error) {
+	var sessions []*models.Session
+
+	sessions = []*models.Session{}
+	for _, session_id := range m.order {
+		if m.sessions[session_id].IsOwnedBy(user_id) && m.sessions[session_id].IsActive(now) {
+			sessions = append(sessions, m.sessions[session_id])
+		}
+	}
+	return sessions, nil
+}
@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// SessionStatusWriterInterface はログイン中の端末（セッション）の記録を失効済みにするインターフェースです
+type SessionStatusWriterInterface interface {
+	Revoke(ctx context.Context, session_id uuid.UUID, revoked_at time.Time) error
+}
+
+// UserSessionRevoker はセッション（トークンファミリー）をdenylistに登録し、リフレッシュトークンとログイン中の端末の記録を失効させます
+// ログアウト・パスワード変更後のセッション失効・端末のセッション失効で共通して使用します
+type UserSessionRevoker struct {
+	denylist      TokenDenylistWriterInterface
+	session_repo  SessionRevokerInterface
+	status_writer SessionStatusWriterInterface
+}
+
+// NewUserSessionRevoker は新しいUserSessionRevokerを作成します
+func NewUserSessionRevoker(
+	denylist TokenDenylistWriterInterface,
+	session_repo SessionRevokerInterface,
+	status_writer SessionStatusWriterInterface,
+) *UserSessionRevoker {
+	return &UserSessionRevoker{
+		denylist:      denylist,
+		session_repo:  session_repo,
+		status_writer: status_writer,
+	}
+}
+
+// RevokeSession は1つのセッションを失効させます
+func (r *UserSessionRevoker) RevokeSession(ctx context.Context, session_id string, now time.Time) error {
+	var family_id uuid.UUID
+	var entry *models.DenylistedToken
+	var err error
+
+	family_id, err = uuid.Parse(session_id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain_errors.ErrInvalidAccessToken, err)
+	}
+	// セッション内で最も長く有効なリフレッシュトークンが期限切れになるまで登録を保持する
+	entry, err = models.NewDenylistedToken(models.DenylistKindSession, session_id, now.Add(utils.RefreshTokenExpiry))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = r.denylist.Add(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = r.session_repo.RevokeFamily(ctx, family_id, now)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = r.status_writer.Revoke(ctx, family_id, now)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// RevokeAllSessions はユーザーの有効な全セッションを失効させます
+func (r *UserSessionRevoker) RevokeAllSessions(ctx context.Context, user_id uuid.UUID, now time.Time) error {
+	var family_ids []uuid.UUID
+	var err error
+
+	family_ids, err = r.session_repo.FindActiveFamilyIDsByUserID(ctx, user_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	for _, family_id := range family_ids {
+		err = r.RevokeSession(ctx, family_id.String(), now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentityRepositoryInterface は外部プロバイダ連携のリポジトリのインターフェースです
+type UserIdentityRepositoryInterface interface {
+	Save(ctx context.Context, identity *models.UserIdentity) error
+	FindByProviderSubject(ctx context.Context, provider models.AuthProvider, subject string) (*models.UserIdentity, error)
+	FindByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.UserIdentity, error)
+	Delete(ctx context.Context, user_id uuid.UUID, provider models.AuthProvider) error
+}
+
+// ProviderUserRepositoryInterface はソーシャルログインでユーザーを検索・作成するインターフェースです
+type ProviderUserRepositoryInterface interface {
+	Save(ctx context.Context, user *models.User) error
+	FindByPublicID(ctx context.Context, public_id uuid.UUID) (*models.User, error)
+	FindByFirebaseUID(ctx context.Context, firebase_uid string) (*models.User, error)
+	ExistsByEmail(ctx context.Context, email models.Email) (bool, error)
+}
+
+// CustomTokenIssuerInterface はFirebaseのカスタムトークンを発行するインターフェースです
+type CustomTokenIssuerInterface interface {
+	CreateCustomToken(ctx context.Context, firebase_uid string) (string, error)
+}
+
+// SignInWithProviderResult はソーシャルログインの結果を表します
+type SignInWithProviderResult struct {
+	User         *models.User
+	AccessToken  string
+	RefreshToken string
+	IsNewUser    bool
+	// FirebaseCustomToken はLINEなどFirebaseのネイティブプロバイダではない場合に、クライアントがFirebaseへサインインするためのトークンです
+	FirebaseCustomToken string
+	// MfaChallenge は二要素認証が有効なユーザーの場合に設定され、トークンペアは発行されません（verifyMfaで完了）
+	MfaChallenge string
+}
+
+// SignInWithProviderUseCase は外部プロバイダのIDトークンによるログイン（未登録の場合は新規登録）のユースケースです
+type SignInWithProviderUseCase struct {
+	token_verifier      *ProviderTokenVerifier
+	identity_repo       UserIdentityRepositoryInterface
+	user_repo           ProviderUserRepositoryInterface
+	custom_token_issuer CustomTokenIssuerInterface
+	token_issuer        *TokenIssuer
+	// attempt_guard はIPアドレス・端末IDごとの失敗回数に応じてログインを制限します
+	attempt_guard *AuthAttemptGuard
+}
+
+// NewSignInWithProviderUseCase は新しいSignInWithProviderUseCaseを作成します
+func NewSignInWithProviderUseCase(
+	token_verifier *ProviderTokenVerifier,
+	identity_repo UserIdentityRepositoryInterface,
+	user_repo ProviderUserRepositoryInterface,
+	custom_token_issuer CustomTokenIssuerInterface,
+	token_issuer *TokenIssuer,
+	attempt_guard *AuthAttemptGuard,
+) *SignInWithProviderUseCase {
+	return &SignInWithProviderUseCase{
+		token_verifier:      token_verifier,
+		identity_repo:       identity_repo,
+		user_repo:           user_repo,
+		custom_token_issuer: custom_token_issuer,
+		token_issuer:        token_issuer,
+		attempt_guard:       attempt_guard,
+	}
+}
+
+// Execute は外部プロバイダのIDトークンを検証し、SLEEVEのJWTを発行します
+// 失敗回数が上限に達している場合はErrTooManyAttemptsを返します
+func (uc *SignInWithProviderUseCase) Execute(ctx context.Context, provider_str string, id_token string) (*SignInWithProviderResult, error) {
+	var attempt *AuthAttempt
+	var result *SignInWithProviderResult
+	var err error
+
+	attempt, err = uc.attempt_guard.Begin(ctx, models.AuthActionSignInWithProvider)
+	if err != nil {
+		return nil, err
+	}
+	result, err = uc.execute(ctx, provider_str, id_token)
+	if err != nil {
+		return nil, attempt.Fail(ctx, err)
+	}
+	attempt.Succeed(ctx)
+	return result, nil
+}
+
+// execute は外部プロバイダのIDトークンを検証し、SLEEVEのJWTを発行します
+func (uc *SignInWithProviderUseCase) execute(ctx context.Context, provider_str string, id_token string) (*SignInWithProviderResult, error) {
+	var provider models.AuthProvider
+	var provider_identity *models.ProviderIdentity
+	var user *models.User
+	var is_new_user bool
+	var custom_token string
+	var mfa_challenge string
+	var token_pair *utils.TokenPair
+	var err error
+
+	provider, err = parse_auth_provider(provider_str)
+	if err != nil {
+		return nil, err
+	}
+
+	// 外部プロバイダのIDトークンの検証
+	provider_identity, err = uc.token_verifier.Verify(ctx, provider, id_token)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// 連携済みのユーザー、または未連携なら連携（必要に応じて新規登録）したユーザーを取得
+	user, is_new_user, err = uc.find_or_link_user(ctx, provider_identity)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// 論理削除済みユーザーはログイン不可
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserDeleted, user.PublicID().String())
+	}
+
+	// Firebaseのネイティブプロバイダではない場合はカスタムトークンでFirebaseにサインインさせる
+	if !provider.IsFirebaseNative() {
+		custom_token, err = uc.custom_token_issuer.CreateCustomToken(ctx, user.FirebaseUID())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	// 二要素認証が有効な場合はトークンペアの代わりにチャレンジトークンを返す
+	// FirebaseのセッションだけではSLEEVEのAPIを利用できないため、カスタムトークンはそのまま返す
+	if user.IsMfaEnabled() {
+		mfa_challenge, err = uc.token_issuer.IssueMfaChallenge(user)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return &SignInWithProviderResult{
+			User:                user,
+			IsNewUser:           is_new_user,
+			FirebaseCustomToken: custom_token,
+			MfaChallenge:        mfa_challenge,
+		}, nil
+	}
+
+	// JWTを発行（リフレッシュトークンは新しいトークンファミリーとして記録）
+	token_pair, err = uc.token_issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &SignInWithProviderResult{
+		User:                user,
+		AccessToken:         token_pair.AccessToken,
+		RefreshToken:        token_pair.RefreshToken,
+		IsNewUser:           is_new_user,
+		FirebaseCustomToken: custom_token,
+	}, nil
+}
+
+// find_or_link_user は外部アカウントに連携されたユーザーを返します
+// 未連携の場合はFirebase UIDが一致するユーザーに連携し、存在しなければ新規登録します
+func (uc *SignInWithProviderUseCase) find_or_link_user(ctx context.Context, provider_identity *models.ProviderIdentity) (*models.User, bool, error) {
+	var linked_identity *models.UserIdentity
+	var identity *models.UserIdentity
+	var user *models.User
+	var is_new_user bool
+	var err error
+
+	linked_identity, err = uc.identity_repo.FindByProviderSubject(ctx, provider_identity.Provider(), provider_identity.Subject())
+	if err == nil {
+		user, err = uc.user_repo.FindByPublicID(ctx, linked_identity.UserID())
+		// 連携情報が残っているユーザーが見つからない場合は論理削除されている
+		if errors.Is(err, domain_errors.ErrUserNotFound) {
+			return nil, false, fmt.Errorf("%w: %s", domain_errors.ErrUserDeleted, linked_identity.UserID().String())
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("%w", err)
+		}
+		return user, false, nil
+	}
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		return nil, false, fmt.Errorf("%w", err)
+	}
+
+	// Firebase上で同じアカウントに連携済み（メールアドレス登録後にGoogleを追加した場合など）のユーザーを優先する
+	user, err = uc.user_repo.FindByFirebaseUID(ctx, provider_identity.FirebaseUID())
+	switch {
+	case errors.Is(err, domain_errors.ErrUserNotFound):
+		user, err = uc.register_user(ctx, provider_identity)
+		if err != nil {
+			return nil, false, err
+		}
+		is_new_user = true
+	case err != nil:
+		return nil, false, fmt.Errorf("%w", err)
+	}
+
+	identity, err = models.NewUserIdentity(user.PublicID(), provider_identity.Provider(), provider_identity.Subject(), time.Now())
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	err = uc.identity_repo.Save(ctx, identity)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w", err)
+	}
+	return user, is_new_user, nil
+}
+
+// register_user は外部アカウントの情報からユーザーを新規登録します
+// 既存のユーザーと同じメールアドレスの場合は、なりすましによる乗っ取りを防ぐため自動では連携せずErrDuplicateEmailを返します
+func (uc *SignInWithProviderUseCase) register_user(ctx context.Context, provider_identity *models.ProviderIdentity) (*models.User, error) {
+	var email *models.Email
+	var exists bool
+	var user *models.User
+	var err error
+
+	email = provider_identity.Email()
+	if email == nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrProviderEmailRequired, provider_identity.Provider())
+	}
+	exists, err = uc.user_repo.ExistsByEmail(ctx, *email)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrDuplicateEmail, email.Value())
+	}
+	user, err = models.NewUser(provider_identity.FirebaseUID(), *email)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidProviderToken, err)
+	}
+	if provider_identity.IsEmailVerified() {
+		user.MarkEmailVerified(time.Now())
+	}
+	err = uc.user_repo.Save(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return user, nil
+}
@@ -0,0 +1,461 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// テスト用定数
+const (
+	testProviderSubject = "provider_sub_123"
+	testProviderEmail   = "provider@example.com"
+)
+
+// setup_sign_in_with_provider_test はソーシャルログインのテスト用ユースケースを作成します
+func setup_sign_in_with_provider_test(
+	verifier *MockProviderTokenVerifier,
+	identity_repo *MockUserIdentityRepository,
+	user_repo *MockProviderUserRepository,
+) *SignInWithProviderUseCase {
+	return NewSignInWithProviderUseCase(
+		NewProviderTokenVerifier(verifier, verifier),
+		identity_repo,
+		user_repo,
+		NewMockCustomTokenIssuer(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		new_test_attempt_guard(),
+	)
+}
+
+// TestSignInWithProviderUseCase_Execute_NewUser は未登録の外部アカウントで新規登録されるケースをテストします
+func TestSignInWithProviderUseCase_Execute_NewUser(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var user_repo *MockProviderUserRepository
+	var result *SignInWithProviderResult
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user_repo = NewMockProviderUserRepository()
+	result, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier(testFirebaseUID, testProviderSubject, testProviderEmail),
+		identity_repo,
+		user_repo,
+	).Execute(ctx, "google", testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.IsNewUser {
+		t.Error("expected is_new_user to be true")
+	}
+	if !result.User.IsEmailVerified() {
+		t.Error("expected email verified by provider to be recorded")
+	}
+	if result.AccessToken == "" || result.RefreshToken == "" {
+		t.Error("expected tokens to be non-empty")
+	}
+	if result.FirebaseCustomToken != "" {
+		t.Error("expected no custom token for firebase native provider")
+	}
+	if len(user_repo.Users) != 1 || len(identity_repo.Identities) != 1 {
+		t.Fatalf("expected 1 user and 1 identity, got %d and %d", len(user_repo.Users), len(identity_repo.Identities))
+	}
+	if identity_repo.Identities[0].UserID() != result.User.PublicID() {
+		t.Error("expected identity to be linked to the new user")
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_LinkedUser は連携済みの外部アカウントで既存ユーザーとしてログインするケースをテストします
+func TestSignInWithProviderUseCase_Execute_LinkedUser(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var user_repo *MockProviderUserRepository
+	var user *models.User
+	var result *SignInWithProviderResult
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user_repo = NewMockProviderUserRepository()
+	user = user_repo.AddUser(t, "line:"+testProviderSubject, testProviderEmail)
+	identity_repo.AddIdentity(t, user.PublicID(), models.AuthProviderLINE, testProviderSubject)
+	result, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier("", testProviderSubject, testProviderEmail),
+		identity_repo,
+		user_repo,
+	).Execute(ctx, "line", testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.IsNewUser {
+		t.Error("expected is_new_user to be false")
+	}
+	if result.User.PublicID() != user.PublicID() {
+		t.Errorf("expected user %s, got %s", user.PublicID(), result.User.PublicID())
+	}
+	if result.FirebaseCustomToken != "mock_custom_token_line:"+testProviderSubject {
+		t.Errorf("expected custom token for line user, got %s", result.FirebaseCustomToken)
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_LinkedUserDeleted は連携先のユーザーが論理削除されている場合に新規登録せずErrUserDeletedを返すケースをテストします
+func TestSignInWithProviderUseCase_Execute_LinkedUserDeleted(t *testing.T) {
+	var identity_repo *MockUserIdentityRepository
+	var user_repo *MockProviderUserRepository
+	var err error
+
+	identity_repo = NewMockUserIdentityRepository()
+	user_repo = NewMockProviderUserRepository()
+	// 論理削除されたユーザーは検索されないため、連携のみが残っている状態を再現する
+	identity_repo.AddIdentity(t, uuid.New(), models.AuthProviderLINE, testProviderSubject)
+	_, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier("", testProviderSubject, testProviderEmail),
+		identity_repo,
+		user_repo,
+	).Execute(context.Background(), "line", testIDToken)
+	if !errors.Is(err, domain_errors.ErrUserDeleted) {
+		t.Errorf("expected ErrUserDeleted, got %v", err)
+	}
+	if len(user_repo.Users) != 0 {
+		t.Errorf("expected no user to be registered, got %d", len(user_repo.Users))
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_ExistingFirebaseUser はFirebase UIDが一致する既存ユーザーに連携するケースをテストします
+func TestSignInWithProviderUseCase_Execute_ExistingFirebaseUser(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var user_repo *MockProviderUserRepository
+	var user *models.User
+	var result *SignInWithProviderResult
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user_repo = NewMockProviderUserRepository()
+	user = user_repo.AddUser(t, testFirebaseUID, testEmail)
+	result, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier(testFirebaseUID, testProviderSubject, testEmail),
+		identity_repo,
+		user_repo,
+	).Execute(ctx, "apple", testIDToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.IsNewUser || result.User.PublicID() != user.PublicID() {
+		t.Error("expected existing user to sign in")
+	}
+	if len(identity_repo.Identities) != 1 || identity_repo.Identities[0].Provider() != models.AuthProviderApple {
+		t.Error("expected apple identity to be linked")
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_DuplicateEmail は別ユーザーが登録済みのメールアドレスを自動で連携しないケースをテストします
+func TestSignInWithProviderUseCase_Execute_DuplicateEmail(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var user_repo *MockProviderUserRepository
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user_repo = NewMockProviderUserRepository()
+	user_repo.AddUser(t, testFirebaseUID, testProviderEmail)
+	_, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier("", testProviderSubject, testProviderEmail),
+		identity_repo,
+		user_repo,
+	).Execute(ctx, "line", testIDToken)
+	if !errors.Is(err, domain_errors.ErrDuplicateEmail) {
+		t.Errorf("expected ErrDuplicateEmail, got %v", err)
+	}
+	if len(identity_repo.Identities) != 0 {
+		t.Error("expected identity NOT to be linked")
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_EmailRequired はメールアドレスが提供されない外部アカウントで新規登録できないケースをテストします
+func TestSignInWithProviderUseCase_Execute_EmailRequired(t *testing.T) {
+	var ctx context.Context
+	var err error
+
+	ctx = context.Background()
+	_, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier("firebase_uid_x", testProviderSubject, ""),
+		NewMockUserIdentityRepository(),
+		NewMockProviderUserRepository(),
+	).Execute(ctx, "x", testIDToken)
+	if !errors.Is(err, domain_errors.ErrProviderEmailRequired) {
+		t.Errorf("expected ErrProviderEmailRequired, got %v", err)
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_UnsupportedProvider は対応していないプロバイダでエラーを返すケースをテストします
+func TestSignInWithProviderUseCase_Execute_UnsupportedProvider(t *testing.T) {
+	var ctx context.Context
+	var use_case *SignInWithProviderUseCase
+	var err error
+
+	ctx = context.Background()
+	use_case = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifier(testFirebaseUID, testProviderSubject, testProviderEmail),
+		NewMockUserIdentityRepository(),
+		NewMockProviderUserRepository(),
+	)
+	_, err = use_case.Execute(ctx, "facebook", testIDToken)
+	if !errors.Is(err, domain_errors.ErrUnsupportedProvider) {
+		t.Errorf("expected ErrUnsupportedProvider, got %v", err)
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_LINEDisabled はLINEの検証設定が無い場合にLINEログインを拒否するケースをテストします
+func TestSignInWithProviderUseCase_Execute_LINEDisabled(t *testing.T) {
+	var ctx context.Context
+	var verifier *MockProviderTokenVerifier
+	var use_case *SignInWithProviderUseCase
+	var err error
+
+	ctx = context.Background()
+	verifier = NewMockProviderTokenVerifier("", testProviderSubject, testProviderEmail)
+	use_case = NewSignInWithProviderUseCase(
+		NewProviderTokenVerifier(verifier, nil),
+		NewMockUserIdentityRepository(),
+		NewMockProviderUserRepository(),
+		NewMockCustomTokenIssuer(),
+		NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), NewMockRefreshTokenRepository(), NewMockSessionRepository()),
+		new_test_attempt_guard(),
+	)
+	_, err = use_case.Execute(ctx, "line", testIDToken)
+	if !errors.Is(err, domain_errors.ErrUnsupportedProvider) {
+		t.Errorf("expected ErrUnsupportedProvider, got %v", err)
+	}
+}
+
+// TestSignInWithProviderUseCase_Execute_InvalidToken は不正なIDトークンでエラーを返すケースをテストします
+func TestSignInWithProviderUseCase_Execute_InvalidToken(t *testing.T) {
+	var ctx context.Context
+	var err error
+
+	ctx = context.Background()
+	_, err = setup_sign_in_with_provider_test(
+		NewMockProviderTokenVerifierWithError(),
+		NewMockUserIdentityRepository(),
+		NewMockProviderUserRepository(),
+	).Execute(ctx, "google", "invalid_id_token")
+	if !errors.Is(err, domain_errors.ErrInvalidProviderToken) {
+		t.Errorf("expected ErrInvalidProviderToken, got %v", err)
+	}
+}
+
+// MockProviderTokenVerifier はテスト用の外部プロバイダIDトークン検証モックです（FirebaseとLINEの両方の検証を兼ねます）
+type MockProviderTokenVerifier struct {
+	firebase_uid        string
+	subject             string
+	email               string
+	should_return_error bool
+}
+
+// NewMockProviderTokenVerifier は指定された外部アカウント情報を返すモックを作成します
+func NewMockProviderTokenVerifier(firebase_uid string, subject string, email string) *MockProviderTokenVerifier {
+	return &MockProviderTokenVerifier{
+		firebase_uid:        firebase_uid,
+		subject:             subject,
+		email:               email,
+		should_return_error: false,
+	}
+}
+
+// NewMockProviderTokenVerifierWithError は検証エラーを返すモックを作成します
+func NewMockProviderTokenVerifierWithError() *MockProviderTokenVerifier {
+	return &MockProviderTokenVerifier{
+		should_return_error: true,
+	}
+}
+
+// VerifyProviderIDToken はモックのFirebase IDトークン検証を行います
+func (m *MockProviderTokenVerifier) VerifyProviderIDToken(_ context.Context, _ string, provider models.AuthProvider) (*models.ProviderIdentity, error) {
+	return m.build_identity(provider)
+}
+
+// VerifyIDToken はモックのLINE IDトークン検証を行います
+func (m *MockProviderTokenVerifier) VerifyIDToken(_ context.Context, _ string) (*models.ProviderIdentity, error) {
+	return m.build_identity(models.AuthProviderLINE)
+}
+
+// build_identity はモックの外部アカウント情報を作成します
+func (m *MockProviderTokenVerifier) build_identity(provider models.AuthProvider) (*models.ProviderIdentity, error) {
+	var email *models.Email
+	var parsed_email models.Email
+	var err error
+
+	if m.should_return_error {
+		return nil, domain_errors.ErrInvalidProviderToken
+	}
+	if m.email != "" {
+		parsed_email, err = models.NewEmail(m.email)
+		if err != nil {
+			return nil, err
+		}
+		email = &parsed_email
+	}
+	return models.NewProviderIdentity(provider, m.subject, m.firebase_uid, email, email != nil)
+}
+
+// MockUserIdentityRepository はテスト用のインメモリな外部プロバイダ連携リポジトリです
+type MockUserIdentityRepository struct {
+	Identities []*models.UserIdentity
+}
+
+// NewMockUserIdentityRepository は新しいMockUserIdentityRepositoryを作成します
+func NewMockUserIdentityRepository() *MockUserIdentityRepository {
+	return &MockUserIdentityRepository{
+		Identities: []*models.UserIdentity{},
+	}
+}
+
+// AddIdentity はテスト用の連携を追加します
+func (m *MockUserIdentityRepository) AddIdentity(t *testing.T, user_id uuid.UUID, provider models.AuthProvider, subject string) {
+	var identity *models.UserIdentity
+	var err error
+
+	t.Helper()
+	identity, err = models.NewUserIdentity(user_id, provider, subject, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create identity: %v", err)
+	}
+	m.Identities = append(m.Identities, identity)
+}
+
+// Save はモックの連携保存を行います
+func (m *MockUserIdentityRepository) Save(_ context.Context, identity *models.UserIdentity) error {
+	for _, existing := range m.Identities {
+		if existing.Provider() == identity.Provider() &&
+			(existing.Subject() == identity.Subject() || existing.UserID() == identity.UserID()) {
+			return domain_errors.ErrProviderAlreadyLinked
+		}
+	}
+	m.Identities = append(m.Identities, identity)
+	return nil
+}
+
+// FindByProviderSubject はモックの連携検索を行います
+func (m *MockUserIdentityRepository) FindByProviderSubject(_ context.Context, provider models.AuthProvider, subject string) (*models.UserIdentity, error) {
+	for _, identity := range m.Identities {
+		if identity.Provider() == provider && identity.Subject() == subject {
+			return identity, nil
+		}
+	}
+	return nil, domain_errors.ErrProviderNotLinked
+}
+
+// FindByUserID はモックのユーザーの連携一覧取得を行います
+func (m *MockUserIdentityRepository) FindByUserID(_ context.Context, user_id uuid.UUID) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+
+	identities = []*models.UserIdentity{}
+	for _, identity := range m.Identities {
+		if identity.UserID() == user_id {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+// Delete はモックの連携削除を行います
+func (m *MockUserIdentityRepository) Delete(_ context.Context, user_id uuid.UUID, provider models.AuthProvider) error {
+	for i, identity := range m.Identities {
+		if identity.UserID() == user_id && identity.Provider() == provider {
+			m.Identities = append(m.Identities[:i], m.Identities[i+1:]...)
+			return nil
+		}
+	}
+	return domain_errors.ErrProviderNotLinked
+}
+
+// MockProviderUserRepository はテスト用のインメモリなユーザーリポジトリです
+type MockProviderUserRepository struct {
+	Users []*models.User
+}
+
+// NewMockProviderUserRepository は新しいMockProviderUserRepositoryを作成します
+func NewMockProviderUserRepository() *MockProviderUserRepository {
+	return &MockProviderUserRepository{
+		Users: []*models.User{},
+	}
+}
+
+// AddUser はテスト用のユーザーを追加します
+func (m *MockProviderUserRepository) AddUser(t *testing.T, firebase_uid string, email_str string) *models.User {
+	var email models.Email
+	var user *models.User
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(email_str)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	user, err = models.NewUser(firebase_uid, email)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	m.Users = append(m.Users, user)
+	return user
+}
+
+// Save はモックのユーザー保存を行います
+func (m *MockProviderUserRepository) Save(_ context.Context, user *models.User) error {
+	m.Users = append(m.Users, user)
+	return nil
+}
+
+// FindByPublicID はモックの公開IDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByPublicID(_ context.Context, public_id uuid.UUID) (*models.User, error) {
+	for _, user := range m.Users {
+		if user.PublicID() == public_id {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// FindByFirebaseUID はモックのFirebase UIDでのユーザー検索を行います
+func (m *MockProviderUserRepository) FindByFirebaseUID(_ context.Context, firebase_uid string) (*models.User, error) {
+	for _, user := range m.Users {
+		if user.FirebaseUID() == firebase_uid {
+			return user, nil
+		}
+	}
+	return nil, domain_errors.ErrUserNotFound
+}
+
+// ExistsByEmail はモックのメールアドレスの存在確認を行います
+func (m *MockProviderUserRepository) ExistsByEmail(_ context.Context, email models.Email) (bool, error) {
+	for _, user := range m.Users {
+		if user.Email().Value() == email.Value() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockCustomTokenIssuer はテスト用のFirebaseカスタムトークン発行モックです
+type MockCustomTokenIssuer struct{}
+
+// NewMockCustomTokenIssuer は新しいMockCustomTokenIssuerを作成します
+func NewMockCustomTokenIssuer() *MockCustomTokenIssuer {
+	return &MockCustomTokenIssuer{}
+}
+
+// CreateCustomToken はFirebase UIDを含むモックのカスタムトークンを返します
+func (m *MockCustomTokenIssuer) CreateCustomToken(_ context.Context, firebase_uid string) (string, error) {
+	return "mock_custom_token_" + firebase_uid, nil
+}
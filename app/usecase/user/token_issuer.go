@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenSaverInterface はリフレッシュトークンを保存するインターフェースです
+type RefreshTokenSaverInterface interface {
+	Save(ctx context.Context, token *models.RefreshToken) error
+}
+
+// SessionRecorderInterface はログイン中の端末（セッション）を記録するインターフェースです
+type SessionRecorderInterface interface {
+	Save(ctx context.Context, session *models.Session) error
+	Touch(ctx context.Context, session_id uuid.UUID, last_seen_at time.Time, expires_at time.Time) error
+}
+
+// TokenIssuer はJWTペアを発行し、リフレッシュトークンをトークンファミリーとして記録します
+// 登録・ログイン時にcontextの端末情報でセッション（ログイン中の端末）を作成し、リフレッシュ時に最終利用日時を更新します
+type TokenIssuer struct {
+	jwt_service        *utils.JWTService
+	refresh_token_repo RefreshTokenSaverInterface
+	session_recorder   SessionRecorderInterface
+}
+
+// NewTokenIssuer は新しいTokenIssuerを作成します
+func NewTokenIssuer(
+	jwt_service *utils.JWTService,
+	refresh_token_repo RefreshTokenSaverInterface,
+	session_recorder SessionRecorderInterface,
+) *TokenIssuer {
+	return &TokenIssuer{
+		jwt_service:        jwt_service,
+		refresh_token_repo: refresh_token_repo,
+		session_recorder:   session_recorder,
+	}
+}
+
+// IssueTokenPair は新しいトークンファミリー（セッション）を開始してJWTペアを発行します（登録・ログイン時）
+func (i *TokenIssuer) IssueTokenPair(ctx context.Context, user *models.User) (*utils.TokenPair, error) {
+	var family_id uuid.UUID
+	var token_pair *utils.TokenPair
+	var client_info *utils.ClientInfo
+	var session *models.Session
+	var err error
+
+	family_id = uuid.New()
+	token_pair, err = i.issue_token_pair(ctx, user, family_id)
+	if err != nil {
+		return nil, err
+	}
+	client_info = utils.GetClientInfo(ctx)
+	session, err = models.NewSession(
+		family_id,
+		user.PublicID(),
+		models.NewSessionDevice(client_info.DeviceName, client_info.Platform, client_info.IPAddress, client_info.UserAgent),
+		time.Now(),
+		token_pair.RefreshTokenExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = i.session_recorder.Save(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return token_pair, nil
+}
+
+// IssueTokenPairInFamily は既存のトークンファミリーに属するJWTペアを発行します（リフレッシュ時）
+// セッションの最終利用日時と有効期限も更新します
+func (i *TokenIssuer) IssueTokenPairInFamily(ctx context.Context, user *models.User, family_id uuid.UUID) (*utils.TokenPair, error) {
+	var token_pair *utils.TokenPair
+	var err error
+
+	token_pair, err = i.issue_token_pair(ctx, user, family_id)
+	if err != nil {
+		return nil, err
+	}
+	err = i.session_recorder.Touch(ctx, family_id, time.Now(), token_pair.RefreshTokenExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return token_pair, nil
+}
+
+// issue_token_pair はトークンファミリーに属するJWTペアを発行し、リフレッシュトークンを記録します
+func (i *TokenIssuer) issue_token_pair(ctx context.Context, user *models.User, family_id uuid.UUID) (*utils.TokenPair, error) {
+	var token_pair *utils.TokenPair
+	var refresh_token *models.RefreshToken
+	var err error
+
+	token_pair, err = i.jwt_service.GenerateTokenPair(user.PublicID().String(), user.FirebaseUID(), family_id.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	refresh_token, err = models.NewRefreshToken(
+		token_pair.RefreshTokenID,
+		family_id,
+		user.PublicID(),
+		token_pair.RefreshTokenExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = i.refresh_token_repo.Save(ctx, refresh_token)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return token_pair, nil
+}
+
+// IssueMfaChallenge は二要素認証が有効なユーザーのログイン時に、トークンペアの代わりにチャレンジトークンを発行します
+func (i *TokenIssuer) IssueMfaChallenge(user *models.User) (string, error) {
+	var challenge string
+	var err error
+
+	challenge, err = i.jwt_service.GenerateMfaChallengeToken(user.PublicID().String(), user.FirebaseUID())
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+	return challenge, nil
+}
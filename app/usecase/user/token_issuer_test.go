@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// new_test_jwt_service はHS256の共通鍵のみのKeyRingで署名・検証するテスト用のJWTServiceを作成します
+func new_test_jwt_service(denylist utils.TokenDenylistInterface) *utils.JWTService {
+	var signing_key *utils.SigningKey
+	var key_ring *utils.KeyRing
+
+	signing_key, _ = utils.NewHMACSigningKey("", []byte(testSecretKey))
+	key_ring, _ = utils.NewKeyRing(signing_key)
+	return utils.NewJWTService(key_ring, denylist)
+}
+
+// create_test_user はテスト用のユーザーを作成します
+func create_test_user(t *testing.T) *models.User {
+	var email models.Email
+	var user *models.User
+	var now time.Time
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail(testEmail)
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, models.RoleUser, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestTokenIssuer_IssueTokenPair_StartsNewFamily は発行ごとに新しいファミリーが開始されることをテストします
+func TestTokenIssuer_IssueTokenPair_StartsNewFamily(t *testing.T) {
+	var ctx context.Context
+	var refresh_token_repo *MockRefreshTokenRepository
+	var issuer *TokenIssuer
+	var user *models.User
+	var token_pair *utils.TokenPair
+	var err error
+
+	ctx = context.Background()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	issuer = NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), refresh_token_repo, NewMockSessionRepository())
+	user = create_test_user(t)
+	token_pair, err = issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(refresh_token_repo.tokens) != 2 {
+		t.Fatalf("expected 2 stored tokens, got %d", len(refresh_token_repo.tokens))
+	}
+	if refresh_token_repo.tokens[0].TokenID() != token_pair.RefreshTokenID {
+		t.Errorf("expected stored token_id %s, got %s", token_pair.RefreshTokenID, refresh_token_repo.tokens[0].TokenID())
+	}
+	if refresh_token_repo.tokens[0].UserID() != user.PublicID() {
+		t.Errorf("expected stored user_id %s, got %s", user.PublicID(), refresh_token_repo.tokens[0].UserID())
+	}
+	if refresh_token_repo.tokens[0].FamilyID() == refresh_token_repo.tokens[1].FamilyID() {
+		t.Error("expected each login to start a new token family")
+	}
+}
+
+// TestTokenIssuer_IssueTokenPair_SaveError は保存失敗時にエラーを返すケースをテストします
+func TestTokenIssuer_IssueTokenPair_SaveError(t *testing.T) {
+	var ctx context.Context
+	var refresh_token_repo *MockRefreshTokenRepository
+	var issuer *TokenIssuer
+	var err error
+
+	ctx = context.Background()
+	refresh_token_repo = NewMockRefreshTokenRepository()
+	refresh_token_repo.should_return_error = true
+	issuer = NewTokenIssuer(new_test_jwt_service(NewMockTokenDenylist()), refresh_token_repo, NewMockSessionRepository())
+	_, err = issuer.IssueTokenPair(ctx, create_test_user(t))
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
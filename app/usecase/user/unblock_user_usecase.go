@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UnblockUserUseCase はログイン中のユーザーが他のユーザーのブロックを解除するユースケースです
+type UnblockUserUseCase struct {
+	user_finder RegisteredUserFinderInterface
+	block_repo  UserBlockRepositoryInterface
+}
+
+// NewUnblockUserUseCase は新しいUnblockUserUseCaseを作成します
+func NewUnblockUserUseCase(user_finder RegisteredUserFinderInterface, block_repo UserBlockRepositoryInterface) *UnblockUserUseCase {
+	return &UnblockUserUseCase{
+		user_finder: user_finder,
+		block_repo:  block_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーのブロックを解除します
+// ブロックしていない場合は何もしません（ブロックの解除で解除したフォローは元に戻しません）
+// 自分自身の場合はErrCannotBlockSelf、不正な公開ID・存在しないユーザーの場合はErrUserNotFoundを返します
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, user *models.User, blocked_id_str string) error {
+	var blocked_id uuid.UUID
+	var err error
+
+	blocked_id, err = uuid.Parse(blocked_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, blocked_id_str)
+	}
+	if blocked_id == user.PublicID() {
+		return domain_errors.ErrCannotBlockSelf
+	}
+	_, err = uc.user_finder.FindByPublicID(ctx, blocked_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	_, err = uc.block_repo.Delete(ctx, user.PublicID(), blocked_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
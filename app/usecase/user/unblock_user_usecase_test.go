@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestUnblockUserUseCase_Execute はブロック解除の冪等性をテストします
+func TestUnblockUserUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var target *models.User
+	var user_store *MockRegisteredUserStore
+	var block_repo *MockUserBlockRepository
+	var use_case *UnblockUserUseCase
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	target = create_test_user_with_status(t, nil, nil)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[target.PublicID()] = target
+	block_repo = NewMockUserBlockRepository()
+	block_repo.add(user.PublicID(), target.PublicID())
+	use_case = NewUnblockUserUseCase(user_store, block_repo)
+
+	for range 2 {
+		err = use_case.Execute(ctx, user, target.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(block_repo.blocks) != 0 {
+		t.Errorf("expected block to be removed, got %d", len(block_repo.blocks))
+	}
+
+	err = use_case.Execute(ctx, user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotBlockSelf) {
+		t.Errorf("expected ErrCannotBlockSelf, got %v", err)
+	}
+}
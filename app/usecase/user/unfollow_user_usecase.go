@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UnfollowUserUseCase はログイン中のユーザーが他のユーザーのフォローを解除するユースケースです
+type UnfollowUserUseCase struct {
+	user_finder RegisteredUserFinderInterface
+	follow_repo FollowRepositoryInterface
+}
+
+// NewUnfollowUserUseCase は新しいUnfollowUserUseCaseを作成します
+func NewUnfollowUserUseCase(user_finder RegisteredUserFinderInterface, follow_repo FollowRepositoryInterface) *UnfollowUserUseCase {
+	return &UnfollowUserUseCase{
+		user_finder: user_finder,
+		follow_repo: follow_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーのフォローを解除します
+// フォローしていない場合は何も変更せず現在の状態を返します（削除済み・利用停止中のユーザーのフォローも解除できます）
+// 非公開アカウントへの承認待ちのフォローリクエストも取り消します
+// 自分自身の場合はErrCannotFollowSelf、不正な公開ID・存在しないユーザーの場合はErrUserNotFoundを返します
+func (uc *UnfollowUserUseCase) Execute(ctx context.Context, user *models.User, followee_id_str string) (*FollowStatus, error) {
+	var followee_id uuid.UUID
+	var err error
+
+	followee_id, err = uuid.Parse(followee_id_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, followee_id_str)
+	}
+	if followee_id == user.PublicID() {
+		return nil, domain_errors.ErrCannotFollowSelf
+	}
+	_, err = uc.user_finder.FindByPublicID(ctx, followee_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	_, err = uc.follow_repo.Delete(ctx, user.PublicID(), followee_id)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return build_follow_status(ctx, uc.follow_repo, followee_id, false)
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestUnfollowUserUseCase_Execute はフォロー解除の冪等性と利用停止中のユーザーのフォロー解除をテストします
+func TestUnfollowUserUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var now time.Time
+	var followee *models.User
+	var follow *models.Follow
+	var user_store *MockRegisteredUserStore
+	var follow_repo *MockFollowRepository
+	var use_case *UnfollowUserUseCase
+	var status *FollowStatus
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	now = time.Now()
+	followee = create_test_user_with_status(t, nil, &now)
+	user_store = NewMockRegisteredUserStore()
+	user_store.users[followee.PublicID()] = followee
+	follow_repo = NewMockFollowRepository()
+	follow, _ = models.NewFollow(user.PublicID(), followee.PublicID(), now)
+	_, _ = follow_repo.Create(ctx, follow)
+	use_case = NewUnfollowUserUseCase(user_store, follow_repo)
+
+	for range 2 {
+		status, err = use_case.Execute(ctx, user, followee.PublicID().String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if status.Following || status.Counts.Followers() != 0 {
+			t.Errorf("expected not following with 0 followers, got following=%v followers=%d", status.Following, status.Counts.Followers())
+		}
+	}
+
+	_, err = use_case.Execute(ctx, user, user.PublicID().String())
+	if !errors.Is(err, domain_errors.ErrCannotFollowSelf) {
+		t.Errorf("expected ErrCannotFollowSelf, got %v", err)
+	}
+}
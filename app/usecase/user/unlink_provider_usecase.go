@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// FirebaseProviderUnlinkerInterface はFirebaseユーザーの外部プロバイダ連携を管理するインターフェースです
+type FirebaseProviderUnlinkerInterface interface {
+	HasPasswordProvider(ctx context.Context, firebase_uid string) (bool, error)
+	UnlinkProvider(ctx context.Context, firebase_uid string, provider models.AuthProvider) error
+}
+
+// UnlinkProviderUseCase はログイン中のユーザーから外部プロバイダの連携を解除するユースケースです
+type UnlinkProviderUseCase struct {
+	identity_repo     UserIdentityRepositoryInterface
+	firebase_unlinker FirebaseProviderUnlinkerInterface
+}
+
+// NewUnlinkProviderUseCase は新しいUnlinkProviderUseCaseを作成します
+func NewUnlinkProviderUseCase(identity_repo UserIdentityRepositoryInterface, firebase_unlinker FirebaseProviderUnlinkerInterface) *UnlinkProviderUseCase {
+	return &UnlinkProviderUseCase{
+		identity_repo:     identity_repo,
+		firebase_unlinker: firebase_unlinker,
+	}
+}
+
+// Execute は外部プロバイダの連携を解除します
+// 解除するとログイン手段が無くなる（他の連携もパスワードも無い）場合はErrCannotUnlinkLastProviderを返します
+func (uc *UnlinkProviderUseCase) Execute(ctx context.Context, user *models.User, provider_str string) error {
+	var provider models.AuthProvider
+	var identities []*models.UserIdentity
+	var is_linked bool
+	var has_password bool
+	var err error
+
+	provider, err = parse_auth_provider(provider_str)
+	if err != nil {
+		return err
+	}
+	identities, err = uc.identity_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	for _, identity := range identities {
+		if identity.Provider() == provider {
+			is_linked = true
+		}
+	}
+	if !is_linked {
+		return fmt.Errorf("%w: %s", domain_errors.ErrProviderNotLinked, provider)
+	}
+	if len(identities) == 1 {
+		has_password, err = uc.firebase_unlinker.HasPasswordProvider(ctx, user.FirebaseUID())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		if !has_password {
+			return fmt.Errorf("%w: %s", domain_errors.ErrCannotUnlinkLastProvider, provider)
+		}
+	}
+
+	// Firebase側を先に解除し、失敗した場合はDBの連携を残してリトライできるようにする
+	err = uc.firebase_unlinker.UnlinkProvider(ctx, user.FirebaseUID(), provider)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	err = uc.identity_repo.Delete(ctx, user.PublicID(), provider)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestUnlinkProviderUseCase_Execute_Success は他のログイン手段が残る場合に連携を解除するケースをテストします
+func TestUnlinkProviderUseCase_Execute_Success(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var unlinker *MockFirebaseProviderUnlinker
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	unlinker = NewMockFirebaseProviderUnlinker(false)
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	identity_repo.AddIdentity(t, user.PublicID(), models.AuthProviderGoogle, "google_sub")
+	identity_repo.AddIdentity(t, user.PublicID(), models.AuthProviderApple, "apple_sub")
+	err = NewUnlinkProviderUseCase(identity_repo, unlinker).Execute(ctx, user, "google")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(identity_repo.Identities) != 1 || identity_repo.Identities[0].Provider() != models.AuthProviderApple {
+		t.Error("expected only apple identity to remain")
+	}
+	if len(unlinker.UnlinkedProviders) != 1 || unlinker.UnlinkedProviders[0] != models.AuthProviderGoogle {
+		t.Errorf("expected google to be unlinked on firebase, got %v", unlinker.UnlinkedProviders)
+	}
+}
+
+// TestUnlinkProviderUseCase_Execute_LastProvider はパスワードが無いユーザーの最後の連携を解除できないケースをテストします
+func TestUnlinkProviderUseCase_Execute_LastProvider(t *testing.T) {
+	var ctx context.Context
+	var identity_repo *MockUserIdentityRepository
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	identity_repo = NewMockUserIdentityRepository()
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	identity_repo.AddIdentity(t, user.PublicID(), models.AuthProviderGoogle, "google_sub")
+	err = NewUnlinkProviderUseCase(identity_repo, NewMockFirebaseProviderUnlinker(false)).Execute(ctx, user, "google")
+	if !errors.Is(err, domain_errors.ErrCannotUnlinkLastProvider) {
+		t.Errorf("expected ErrCannotUnlinkLastProvider, got %v", err)
+	}
+	// パスワードでログインできる場合は最後の連携も解除できる
+	err = NewUnlinkProviderUseCase(identity_repo, NewMockFirebaseProviderUnlinker(true)).Execute(ctx, user, "google")
+	if err != nil {
+		t.Errorf("expected no error with password provider, got %v", err)
+	}
+}
+
+// TestUnlinkProviderUseCase_Execute_NotLinked は連携していないプロバイダの解除でエラーを返すケースをテストします
+func TestUnlinkProviderUseCase_Execute_NotLinked(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var err error
+
+	ctx = context.Background()
+	user = NewMockProviderUserRepository().AddUser(t, testFirebaseUID, testEmail)
+	err = NewUnlinkProviderUseCase(NewMockUserIdentityRepository(), NewMockFirebaseProviderUnlinker(true)).Execute(ctx, user, "x")
+	if !errors.Is(err, domain_errors.ErrProviderNotLinked) {
+		t.Errorf("expected ErrProviderNotLinked, got %v", err)
+	}
+}
+
+// MockFirebaseProviderUnlinker はテスト用のFirebaseプロバイダ連携管理モックです
+type MockFirebaseProviderUnlinker struct {
+	has_password      bool
+	UnlinkedProviders []models.AuthProvider
+}
+
+// NewMockFirebaseProviderUnlinker は新しいMockFirebaseProviderUnlinkerを作成します
+func NewMockFirebaseProviderUnlinker(has_password bool) *MockFirebaseProviderUnlinker {
+	return &MockFirebaseProviderUnlinker{
+		has_password:      has_password,
+		UnlinkedProviders: []models.AuthProvider{},
+	}
+}
+
+// HasPasswordProvider はモックのパスワード認証の有無を返します
+func (m *MockFirebaseProviderUnlinker) HasPasswordProvider(_ context.Context, _ string) (bool, error) {
+	return m.has_password, nil
+}
+
+// UnlinkProvider は連携解除したプロバイダを記録します
+func (m *MockFirebaseProviderUnlinker) UnlinkProvider(_ context.Context, _ string, provider models.AuthProvider) error {
+	m.UnlinkedProviders = append(m.UnlinkedProviders, provider)
+	return nil
+}
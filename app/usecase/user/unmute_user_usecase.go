@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// UnmuteUserUseCase はログイン中のユーザーが他のユーザーのミュートを解除するユースケースです
+type UnmuteUserUseCase struct {
+	user_finder RegisteredUserFinderInterface
+	mute_repo   UserMuteRepositoryInterface
+}
+
+// NewUnmuteUserUseCase は新しいUnmuteUserUseCaseを作成します
+func NewUnmuteUserUseCase(user_finder RegisteredUserFinderInterface, mute_repo UserMuteRepositoryInterface) *UnmuteUserUseCase {
+	return &UnmuteUserUseCase{
+		user_finder: user_finder,
+		mute_repo:   mute_repo,
+	}
+}
+
+// Execute は公開IDで指定したユーザーのミュートを解除します
+// ミュートしていない場合は何もしません
+// 自分自身の場合はErrCannotMuteSelf、不正な公開ID・存在しないユーザーの場合はErrUserNotFoundを返します
+func (uc *UnmuteUserUseCase) Execute(ctx context.Context, user *models.User, muted_id_str string) error {
+	var muted_id uuid.UUID
+	var err error
+
+	muted_id, err = uuid.Parse(muted_id_str)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain_errors.ErrUserNotFound, muted_id_str)
+	}
+	if muted_id == user.PublicID() {
+		return domain_errors.ErrCannotMuteSelf
+	}
+	_, err = uc.user_finder.FindByPublicID(ctx, muted_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	_, err = uc.mute_repo.Delete(ctx, user.PublicID(), muted_id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
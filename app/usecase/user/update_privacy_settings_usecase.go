@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// PrivacySettingsRepositoryInterface はユーザーのプライバシー設定の取得・保存のインターフェースです
+type PrivacySettingsRepositoryInterface interface {
+	FindPrivacySettings(ctx context.Context, user_id uuid.UUID) (models.PrivacySettings, error)
+	UpdatePrivacySettings(ctx context.Context, user_id uuid.UUID, settings models.PrivacySettings) error
+}
+
+// FollowRequestBulkApproverInterface は受信したフォローリクエストを一括で承認するインターフェースです
+type FollowRequestBulkApproverInterface interface {
+	ApproveAll(ctx context.Context, target_id uuid.UUID, approved_at time.Time) (int, error)
+}
+
+// PrivacySettingsInput はプライバシー設定の更新の入力値です（nilの項目は変更しません）
+type PrivacySettingsInput struct {
+	IsPrivate         *bool
+	HideCloset        *bool
+	HideLikes         *bool
+	CommentPermission *string
+}
+
+// UpdatePrivacySettingsUseCase はログイン中のユーザーのプライバシー設定を更新するユースケースです
+type UpdatePrivacySettingsUseCase struct {
+	settings_repo       PrivacySettingsRepositoryInterface
+	follow_request_repo FollowRequestBulkApproverInterface
+}
+
+// NewUpdatePrivacySettingsUseCase は新しいUpdatePrivacySettingsUseCaseを作成します
+func NewUpdatePrivacySettingsUseCase(
+	settings_repo PrivacySettingsRepositoryInterface,
+	follow_request_repo FollowRequestBulkApproverInterface,
+) *UpdatePrivacySettingsUseCase {
+	return &UpdatePrivacySettingsUseCase{
+		settings_repo:       settings_repo,
+		follow_request_repo: follow_request_repo,
+	}
+}
+
+// Execute は入力された項目のみプライバシー設定を更新し、更新後の設定を返します
+// 公開アカウントの場合は承認待ちのフォローリクエストを全て承認します
+// （更新後に承認が失敗した場合も、再度更新すると残ったリクエストを承認します）
+// コメントできる範囲が不正な場合はErrInvalidPrivacySettingsを返します
+func (uc *UpdatePrivacySettingsUseCase) Execute(ctx context.Context, user *models.User, input PrivacySettingsInput) (models.PrivacySettings, error) {
+	var current models.PrivacySettings
+	var is_private bool
+	var hide_closet bool
+	var hide_likes bool
+	var comment_permission models.CommentPermission
+	var settings models.PrivacySettings
+	var err error
+
+	current, err = uc.settings_repo.FindPrivacySettings(ctx, user.PublicID())
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w", err)
+	}
+	is_private = current.IsPrivate()
+	if input.IsPrivate != nil {
+		is_private = *input.IsPrivate
+	}
+	hide_closet = current.HideCloset()
+	if input.HideCloset != nil {
+		hide_closet = *input.HideCloset
+	}
+	hide_likes = current.HideLikes()
+	if input.HideLikes != nil {
+		hide_likes = *input.HideLikes
+	}
+	comment_permission = current.CommentPermission()
+	if input.CommentPermission != nil {
+		comment_permission, err = models.NewCommentPermission(*input.CommentPermission)
+		if err != nil {
+			return models.PrivacySettings{}, fmt.Errorf("%w: %w", domain_errors.ErrInvalidPrivacySettings, err)
+		}
+	}
+	settings, err = models.NewPrivacySettings(is_private, hide_closet, hide_likes, comment_permission)
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w: %w", domain_errors.ErrInvalidPrivacySettings, err)
+	}
+	err = uc.settings_repo.UpdatePrivacySettings(ctx, user.PublicID(), settings)
+	if err != nil {
+		return models.PrivacySettings{}, fmt.Errorf("%w", err)
+	}
+	if !settings.IsPrivate() {
+		_, err = uc.follow_request_repo.ApproveAll(ctx, user.PublicID(), time.Now())
+		if err != nil {
+			return models.PrivacySettings{}, fmt.Errorf("%w", err)
+		}
+	}
+	return settings, nil
+}
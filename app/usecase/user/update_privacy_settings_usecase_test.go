@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// TestUpdatePrivacySettingsUseCase_Execute は入力された項目のみの更新と、公開アカウントへの変更時の一括承認をテストします
+func TestUpdatePrivacySettingsUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var requester_id uuid.UUID
+	var settings_store *MockPrivacySettingsStore
+	var follow_repo *MockFollowRepository
+	var follow_request_repo *MockFollowRequestRepository
+	var use_case *UpdatePrivacySettingsUseCase
+	var is_private bool
+	var comment_permission string
+	var request *models.FollowRequest
+	var settings models.PrivacySettings
+	var exists bool
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	requester_id = uuid.New()
+	settings_store = NewMockPrivacySettingsStore()
+	follow_repo = NewMockFollowRepository()
+	follow_request_repo = NewMockFollowRequestRepository(follow_repo)
+	use_case = NewUpdatePrivacySettingsUseCase(settings_store, follow_request_repo)
+
+	is_private = true
+	comment_permission = "followers"
+	settings, err = use_case.Execute(ctx, user, PrivacySettingsInput{IsPrivate: &is_private, CommentPermission: &comment_permission})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !settings.IsPrivate() || settings.HideCloset() || settings.CommentPermission() != models.CommentPermissionFollowers {
+		t.Errorf("expected private account with followers-only comments, got %+v", settings)
+	}
+
+	request, _ = models.NewFollowRequest(requester_id, user.PublicID(), time.Now())
+	_, _ = follow_request_repo.Create(ctx, request)
+	is_private = false
+	settings, err = use_case.Execute(ctx, user, PrivacySettingsInput{IsPrivate: &is_private})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if settings.IsPrivate() || settings.CommentPermission() != models.CommentPermissionFollowers {
+		t.Errorf("expected public account keeping comment permission, got %+v", settings)
+	}
+	exists, _ = follow_repo.Exists(ctx, requester_id, user.PublicID())
+	if !exists || len(follow_request_repo.requests) != 0 {
+		t.Errorf("expected pending request to be approved, got follows=%v requests=%d", exists, len(follow_request_repo.requests))
+	}
+
+	comment_permission = "friends"
+	_, err = use_case.Execute(ctx, user, PrivacySettingsInput{CommentPermission: &comment_permission})
+	if !errors.Is(err, domain_errors.ErrInvalidPrivacySettings) {
+		t.Errorf("expected ErrInvalidPrivacySettings, got %v", err)
+	}
+}
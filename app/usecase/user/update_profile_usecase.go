@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"sleeve/domain/models"
+)
+
+// UpdateProfileUseCase はログイン中のユーザーのプロフィールを更新するユースケースです
+type UpdateProfileUseCase struct {
+	profile_repo ProfileRepositoryInterface
+}
+
+// NewUpdateProfileUseCase は新しいUpdateProfileUseCaseを作成します
+func NewUpdateProfileUseCase(profile_repo ProfileRepositoryInterface) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		profile_repo: profile_repo,
+	}
+}
+
+// Execute は入力された項目のみプロフィールを更新します
+// プロフィールが未作成の場合はErrProfileNotFound、入力値が不正な場合はErrInvalidProfileを返します
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, user *models.User, input ProfileInput) (*models.Profile, error) {
+	var profile *models.Profile
+	var err error
+
+	profile, err = uc.profile_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	err = apply_profile_input(profile, input)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.profile_repo.Update(ctx, profile)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return profile, nil
+}
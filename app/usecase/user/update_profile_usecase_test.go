@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// TestUpdateProfileUseCase_Execute は入力された項目のみ更新され、任意項目を未設定に戻せることをテストします
+func TestUpdateProfileUseCase_Execute(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var profile_repo *MockProfileRepository
+	var display_name string
+	var bio string
+	var avatar_url string
+	var cleared_avatar_url string
+	var profile *models.Profile
+	var err error
+
+	ctx = context.Background()
+	user = create_test_user(t)
+	profile_repo = NewMockProfileRepository()
+	display_name = "taro"
+	avatar_url = "https://example.com/avatar.png"
+	_, err = NewCreateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{DisplayName: &display_name, AvatarURL: &avatar_url})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	bio = "古着が好きです"
+	profile, err = NewUpdateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{Bio: &bio})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.Bio().Value() != bio || profile.DisplayName().Value() != display_name {
+		t.Errorf("expected only bio to be updated, got display_name=%s bio=%s", profile.DisplayName().Value(), profile.Bio().Value())
+	}
+	if profile.AvatarURL() == nil {
+		t.Error("expected avatar_url to be unchanged")
+	}
+
+	cleared_avatar_url = ""
+	profile, err = NewUpdateProfileUseCase(profile_repo).Execute(ctx, user, ProfileInput{AvatarURL: &cleared_avatar_url})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.AvatarURL() != nil {
+		t.Errorf("expected avatar_url to be cleared, got %s", profile.AvatarURL().Value())
+	}
+}
+
+// TestUpdateProfileUseCase_Execute_NotFound はプロフィールが未作成の場合をテストします
+func TestUpdateProfileUseCase_Execute_NotFound(t *testing.T) {
+	var bio string
+	var err error
+
+	bio = "bio"
+	_, err = NewUpdateProfileUseCase(NewMockProfileRepository()).Execute(context.Background(), create_test_user(t), ProfileInput{Bio: &bio})
+	if !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+
+	"github.com/google/uuid"
+)
+
+// BlobStorage は画像などのファイルを保存し、公開URLを返すストレージのインターフェースです
+// ローカルのディレクトリ（LocalBlobStorage）とS3互換のオブジェクトストレージ（S3BlobStorage）を切り替えて使用します
+type BlobStorage interface {
+	Put(ctx context.Context, key string, data []byte, content_type string) error
+	// Delete は存在しないキーの場合も成功とします
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+	// Open はキーのファイルを読み出し用に開きます（データエクスポートで使用し、呼び出し側でCloseします）
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// UploadedImageRepositoryInterface はアップロードした画像の所有者とストレージキーを記録するリポジトリのインターフェースです
+// 記録した画像は退会ユーザーの物理削除とデータエクスポートで使用します
+type UploadedImageRepositoryInterface interface {
+	Create(ctx context.Context, image *models.UploadedImage) error
+}
+
+// UploadImageUseCase はログイン中のユーザーが画像をアップロードするユースケースです
+// プロフィールのアイコン・コーデの投稿・アイテムの出品で共通して使用し、用途に応じたサイズのリサイズ画像を保存します
+type UploadImageUseCase struct {
+	storage    BlobStorage
+	image_repo UploadedImageRepositoryInterface
+}
+
+// NewUploadImageUseCase は新しいUploadImageUseCaseを作成します
+func NewUploadImageUseCase(storage BlobStorage, image_repo UploadedImageRepositoryInterface) *UploadImageUseCase {
+	return &UploadImageUseCase{
+		storage:    storage,
+		image_repo: image_repo,
+	}
+}
+
+// Execute は画像を検証し、用途に応じたサイズにリサイズして保存します
+// 形式はファイルの先頭のバイト列から判定し、JPEG・PNG以外はErrUnsupportedImageType、
+// ファイルサイズ・画素数が上限を超える場合はErrImageTooLarge、読み込めない場合はErrInvalidImageを返します
+func (uc *UploadImageUseCase) Execute(ctx context.Context, user *models.User, purpose_str string, content io.Reader) (*models.UploadedImage, error) {
+	var purpose models.ImagePurpose
+	var data []byte
+	var format models.ImageFormat
+	var img image.Image
+	var image_id uuid.UUID
+	var variants []models.ImageVariant
+	var uploaded *models.UploadedImage
+	var err error
+
+	purpose, err = models.NewImagePurpose(purpose_str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidImagePurpose, err)
+	}
+	// 申告されたサイズは信用せず、上限を1バイト超えるまで読み込んで判定する
+	data, err = io.ReadAll(io.LimitReader(content, models.MaxImageUploadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidImage, err)
+	}
+	if len(data) > models.MaxImageUploadSize {
+		return nil, fmt.Errorf("%w: file size exceeds %d bytes", domain_errors.ErrImageTooLarge, models.MaxImageUploadSize)
+	}
+	format, err = models.DetectImageFormat(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrUnsupportedImageType, err)
+	}
+	img, err = decode_image(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	image_id = uuid.New()
+	variants, err = uc.save_variants(ctx, user.PublicID(), image_id, purpose, format, img)
+	if err != nil {
+		return nil, err
+	}
+	uploaded, err = models.NewUploadedImage(image_id, user.PublicID(), purpose, format, variants, time.Now())
+	if err == nil {
+		// 所有者を記録できなかった画像は退会時に削除できないため、保存したファイルも削除する
+		err = uc.image_repo.Create(ctx, uploaded)
+	}
+	if err != nil {
+		uc.delete_variants(ctx, variants)
+		return nil, fmt.Errorf("%w", err)
+	}
+	return uploaded, nil
+}
+
+// save_variants は用途に応じたサイズのリサイズ画像を作成して保存します
+// 途中で保存に失敗した場合は、保存済みのリサイズ画像を削除してからエラーを返します
+func (uc *UploadImageUseCase) save_variants(
+	ctx context.Context,
+	owner_id uuid.UUID,
+	image_id uuid.UUID,
+	purpose models.ImagePurpose,
+	format models.ImageFormat,
+	img image.Image,
+) ([]models.ImageVariant, error) {
+	var variants []models.ImageVariant
+	var resized *image.RGBA
+	var encoded []byte
+	var storage_key string
+	var err error
+
+	variants = []models.ImageVariant{}
+	for _, spec := range purpose.VariantSpecs() {
+		resized = utils.ResizeImage(img, spec.MaxEdge())
+		encoded, err = utils.EncodeImage(resized, format)
+		if err == nil {
+			storage_key = models.ImageStorageKey(owner_id, image_id, spec.Name(), format)
+			err = uc.storage.Put(ctx, storage_key, encoded, format.ContentType())
+		}
+		if err != nil {
+			uc.delete_variants(ctx, variants)
+			return nil, fmt.Errorf("%w", err)
+		}
+		variants = append(variants, models.NewImageVariant(
+			spec.Name(), storage_key, uc.storage.URL(storage_key), resized.Bounds().Dx(), resized.Bounds().Dy(),
+		))
+	}
+	return variants, nil
+}
+
+// delete_variants は保存済みのリサイズ画像を削除します（削除の失敗はログに出力するのみです）
+func (uc *UploadImageUseCase) delete_variants(ctx context.Context, variants []models.ImageVariant) {
+	var err error
+
+	for _, variant := range variants {
+		err = uc.storage.Delete(ctx, variant.StorageKey())
+		if err != nil {
+			log.Printf("アップロードに失敗した画像の削除に失敗しました: key=%s: %v", variant.StorageKey(), err)
+		}
+	}
+}
+
+// decode_image は判定した形式のデコーダーで画像を読み込みます
+// 画素数はヘッダーのみを読み込んで先に確認し、上限を超える場合は画像全体をデコードしません
+func decode_image(data []byte, format models.ImageFormat) (image.Image, error) {
+	var decode_config func(io.Reader) (image.Config, error)
+	var decode func(io.Reader) (image.Image, error)
+	var config image.Config
+	var img image.Image
+	var err error
+
+	switch format {
+	case models.ImageFormatJPEG:
+		decode_config, decode = jpeg.DecodeConfig, jpeg.Decode
+	case models.ImageFormatPNG:
+		decode_config, decode = png.DecodeConfig, png.Decode
+	default:
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUnsupportedImageType, format)
+	}
+	config, err = decode_config(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("%w: invalid image size %dx%d", domain_errors.ErrInvalidImage, config.Width, config.Height)
+	}
+	if config.Width*config.Height > models.MaxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d exceeds %d pixels", domain_errors.ErrImageTooLarge, config.Width, config.Height, models.MaxImagePixels)
+	}
+	img, err = decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidImage, err)
+	}
+	return img, nil
+}
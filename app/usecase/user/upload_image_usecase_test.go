@@ -0,0 +1,296 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// MockBlobStorage はテスト用にファイルをメモリに保存するストレージです
+type MockBlobStorage struct {
+	objects       map[string][]byte
+	content_types map[string]string
+	// fail_after は指定した回数のPutが成功した後のPutを失敗させます（0以下の場合は失敗させません）
+	fail_after int
+	put_count  int
+}
+
+// NewMockBlobStorage は新しいMockBlobStorageを作成します
+func NewMockBlobStorage() *MockBlobStorage {
+	return &MockBlobStorage{
+		objects:       map[string][]byte{},
+		content_types: map[string]string{},
+	}
+}
+
+// Put はファイルをメモリに保存します
+func (m *MockBlobStorage) Put(_ context.Context, key string, data []byte, content_type string) error {
+	if m.fail_after > 0 && m.put_count >= m.fail_after {
+		return domain_errors.ErrStorageError
+	}
+	m.put_count++
+	m.objects[key] = data
+	m.content_types[key] = content_type
+	return nil
+}
+
+// Delete はファイルを削除します
+func (m *MockBlobStorage) Delete(_ context.Context, key string) error {
+	delete(m.objects, key)
+	delete(m.content_types, key)
+	return nil
+}
+
+// URL はテスト用の公開URLを返します
+func (m *MockBlobStorage) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+// Open はメモリに保存したファイルを読み出し用に開きます
+func (m *MockBlobStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	var data []byte
+	var exists bool
+
+	data, exists = m.objects[key]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s not found", domain_errors.ErrStorageError, key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// MockUploadedImageRepository はテスト用にアップロードした画像の記録をメモリに保存するリポジトリです
+type MockUploadedImageRepository struct {
+	images []*models.UploadedImage
+	// create_err はCreateで返すエラーです（nilの場合は記録します）
+	create_err error
+}
+
+// NewMockUploadedImageRepository は新しいMockUploadedImageRepositoryを作成します
+func NewMockUploadedImageRepository() *MockUploadedImageRepository {
+	return &MockUploadedImageRepository{
+		images: []*models.UploadedImage{},
+	}
+}
+
+// Create はアップロードした画像を記録します
+func (m *MockUploadedImageRepository) Create(_ context.Context, image *models.UploadedImage) error {
+	if m.create_err != nil {
+		return m.create_err
+	}
+	m.images = append(m.images, image)
+	return nil
+}
+
+// ListByUserID はユーザーがアップロードした画像をアップロード順に返します
+func (m *MockUploadedImageRepository) ListByUserID(_ context.Context, user_id uuid.UUID) ([]*models.UploadedImage, error) {
+	var images []*models.UploadedImage
+
+	images = []*models.UploadedImage{}
+	for _, image := range m.images {
+		if image.OwnerID() == user_id {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// encode_test_image は指定した大きさの画像を指定した形式でエンコードします
+func encode_test_image(t *testing.T, width int, height int, format models.ImageFormat) []byte {
+	var img *image.NRGBA
+	var buffer bytes.Buffer
+	var err error
+
+	t.Helper()
+	img = image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	if format == models.ImageFormatPNG {
+		err = png.Encode(&buffer, img)
+	} else {
+		err = jpeg.Encode(&buffer, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return buffer.Bytes()
+}
+
+// TestUploadImageUseCase_Execute は用途に応じたサイズのリサイズ画像が保存されることをテストします
+func TestUploadImageUseCase_Execute(t *testing.T) {
+	var user *models.User
+	var storage *MockBlobStorage
+	var image_repo *MockUploadedImageRepository
+	var uploaded *models.UploadedImage
+	var specs []models.ImageVariantSpec
+	var format models.ImageFormat
+	var err error
+
+	user = create_test_account_user(t)
+	storage = NewMockBlobStorage()
+	image_repo = NewMockUploadedImageRepository()
+
+	uploaded, err = NewUploadImageUseCase(storage, image_repo).Execute(
+		context.Background(), user, "avatar", bytes.NewReader(encode_test_image(t, 800, 400, models.ImageFormatJPEG)),
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(image_repo.images) != 1 || image_repo.images[0] != uploaded {
+		t.Errorf("expected the uploaded image to be recorded with its owner, got %d records", len(image_repo.images))
+	}
+	if uploaded.OwnerID() != user.PublicID() || uploaded.Purpose() != models.ImagePurposeAvatar || uploaded.Format() != models.ImageFormatJPEG {
+		t.Errorf("unexpected uploaded image: owner=%s purpose=%s format=%s", uploaded.OwnerID(), uploaded.Purpose(), uploaded.Format())
+	}
+	specs = models.ImagePurposeAvatar.VariantSpecs()
+	if len(uploaded.Variants()) != len(specs) || len(storage.objects) != len(specs) {
+		t.Fatalf("expected %d variants, got %d (stored %d)", len(specs), len(uploaded.Variants()), len(storage.objects))
+	}
+	for i, variant := range uploaded.Variants() {
+		if variant.Name() != specs[i].Name() || variant.Width() != specs[i].MaxEdge() || variant.Height() != specs[i].MaxEdge()/2 {
+			t.Errorf("unexpected variant %s: %dx%d", variant.Name(), variant.Width(), variant.Height())
+		}
+		if !strings.HasPrefix(variant.StorageKey(), "images/"+user.PublicID().String()+"/") {
+			t.Errorf("expected storage key under the owner's directory, got %s", variant.StorageKey())
+		}
+		if variant.URL() != "https://cdn.example.com/"+variant.StorageKey() {
+			t.Errorf("expected url from storage, got %s", variant.URL())
+		}
+		if storage.content_types[variant.StorageKey()] != "image/jpeg" {
+			t.Errorf("expected image/jpeg, got %s", storage.content_types[variant.StorageKey()])
+		}
+		format, err = models.DetectImageFormat(storage.objects[variant.StorageKey()])
+		if err != nil || format != models.ImageFormatJPEG {
+			t.Errorf("expected stored variant to be jpeg, got %s (%v)", format, err)
+		}
+	}
+}
+
+// TestUploadImageUseCase_Execute_PNG はPNG画像をPNGのまま保存し、元の画像より大きいリサイズ画像を作成しないことをテストします
+func TestUploadImageUseCase_Execute_PNG(t *testing.T) {
+	var storage *MockBlobStorage
+	var uploaded *models.UploadedImage
+	var err error
+
+	storage = NewMockBlobStorage()
+
+	uploaded, err = NewUploadImageUseCase(storage, NewMockUploadedImageRepository()).Execute(
+		context.Background(), create_test_account_user(t), "listing", bytes.NewReader(encode_test_image(t, 200, 300, models.ImageFormatPNG)),
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if uploaded.Format() != models.ImageFormatPNG {
+		t.Errorf("expected png, got %s", uploaded.Format())
+	}
+	for _, variant := range uploaded.Variants() {
+		if !strings.HasSuffix(variant.StorageKey(), ".png") || storage.content_types[variant.StorageKey()] != "image/png" {
+			t.Errorf("expected png variant, got %s (%s)", variant.StorageKey(), storage.content_types[variant.StorageKey()])
+		}
+		if variant.Width() > 200 || variant.Height() > 300 {
+			t.Errorf("expected variant %s not to be enlarged, got %dx%d", variant.Name(), variant.Width(), variant.Height())
+		}
+	}
+}
+
+// TestUploadImageUseCase_Execute_InvalidFiles は不正なファイルを保存せずにエラーを返すことをテストします
+func TestUploadImageUseCase_Execute_InvalidFiles(t *testing.T) {
+	var huge_png []byte
+	var test_cases []struct {
+		name     string
+		purpose  string
+		data     []byte
+		expected error
+	}
+	var storage *MockBlobStorage
+	var err error
+
+	// IHDRの幅・高さを書き換え、ファイルサイズは小さいが画素数が上限を超えるPNGを作成する
+	huge_png = encode_test_image(t, 1, 1, models.ImageFormatPNG)
+	binary.BigEndian.PutUint32(huge_png[16:20], 20000)
+	binary.BigEndian.PutUint32(huge_png[20:24], 20000)
+	binary.BigEndian.PutUint32(huge_png[29:33], crc32.ChecksumIEEE(huge_png[12:29]))
+
+	test_cases = []struct {
+		name     string
+		purpose  string
+		data     []byte
+		expected error
+	}{
+		{name: "invalid purpose", purpose: "banner", data: encode_test_image(t, 10, 10, models.ImageFormatJPEG), expected: domain_errors.ErrInvalidImagePurpose},
+		{name: "gif", purpose: "avatar", data: []byte("GIF89a\x01\x00\x01\x00"), expected: domain_errors.ErrUnsupportedImageType},
+		{name: "svg with image content type", purpose: "avatar", data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), expected: domain_errors.ErrUnsupportedImageType},
+		{name: "too large file", purpose: "avatar", data: append([]byte{0xFF, 0xD8, 0xFF}, make([]byte, models.MaxImageUploadSize)...), expected: domain_errors.ErrImageTooLarge},
+		{name: "too many pixels", purpose: "avatar", data: huge_png, expected: domain_errors.ErrImageTooLarge},
+		{name: "corrupted jpeg", purpose: "avatar", data: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 'J', 'F'}, expected: domain_errors.ErrInvalidImage},
+	}
+	for _, test_case := range test_cases {
+		storage = NewMockBlobStorage()
+		_, err = NewUploadImageUseCase(storage, NewMockUploadedImageRepository()).Execute(
+			context.Background(), create_test_account_user(t), test_case.purpose, bytes.NewReader(test_case.data),
+		)
+		if !errors.Is(err, test_case.expected) {
+			t.Errorf("%s: expected %v, got %v", test_case.name, test_case.expected, err)
+		}
+		if len(storage.objects) != 0 {
+			t.Errorf("%s: expected nothing to be stored, got %d objects", test_case.name, len(storage.objects))
+		}
+	}
+}
+
+// TestUploadImageUseCase_Execute_StorageError は保存に失敗した場合に保存済みのリサイズ画像を削除することをテストします
+func TestUploadImageUseCase_Execute_StorageError(t *testing.T) {
+	var storage *MockBlobStorage
+	var err error
+
+	storage = NewMockBlobStorage()
+	storage.fail_after = 2
+
+	_, err = NewUploadImageUseCase(storage, NewMockUploadedImageRepository()).Execute(
+		context.Background(), create_test_account_user(t), "coordinate", bytes.NewReader(encode_test_image(t, 64, 64, models.ImageFormatJPEG)),
+	)
+	if !errors.Is(err, domain_errors.ErrStorageError) {
+		t.Errorf("expected ErrStorageError, got %v", err)
+	}
+	if len(storage.objects) != 0 {
+		t.Errorf("expected saved variants to be deleted, got %d objects", len(storage.objects))
+	}
+}
+
+// TestUploadImageUseCase_Execute_RepositoryError は所有者の記録に失敗した場合に保存したリサイズ画像を削除することをテストします
+func TestUploadImageUseCase_Execute_RepositoryError(t *testing.T) {
+	var storage *MockBlobStorage
+	var image_repo *MockUploadedImageRepository
+	var err error
+
+	storage = NewMockBlobStorage()
+	image_repo = NewMockUploadedImageRepository()
+	image_repo.create_err = domain_errors.ErrDatabaseError
+
+	_, err = NewUploadImageUseCase(storage, image_repo).Execute(
+		context.Background(), create_test_account_user(t), "listing", bytes.NewReader(encode_test_image(t, 64, 64, models.ImageFormatPNG)),
+	)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+	if len(storage.objects) != 0 {
+		t.Errorf("expected saved variants to be deleted, got %d objects", len(storage.objects))
+	}
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// FollowCounterInterface はユーザーのフォロワー数・フォロー数を取得するインターフェースです
+type FollowCounterInterface interface {
+	CountsByUserID(ctx context.Context, user_id uuid.UUID) (models.FollowCounts, error)
+}
+
+// UserSummaryBuilder はユーザーのハンドル・公開プロフィール・各種の件数を集めてUserSummaryを作成します
+// meとuserSummaryのクエリで共通して使用します
+type UserSummaryBuilder struct {
+	handle_finder  UserHandleFinderInterface
+	profile_repo   ProfileFinderInterface
+	follow_counter FollowCounterInterface
+}
+
+// NewUserSummaryBuilder は新しいUserSummaryBuilderを作成します
+func NewUserSummaryBuilder(
+	handle_finder UserHandleFinderInterface,
+	profile_repo ProfileFinderInterface,
+	follow_counter FollowCounterInterface,
+) *UserSummaryBuilder {
+	return &UserSummaryBuilder{
+		handle_finder:  handle_finder,
+		profile_repo:   profile_repo,
+		follow_counter: follow_counter,
+	}
+}
+
+// Build はユーザーの概要を作成します
+// ハンドルが未設定・プロフィールが未作成の場合はnilのまま返します
+// コーデ投稿・アイテム出品・取引の評価は未実装のため、投稿数・出品数・評価数は常に0です
+func (b *UserSummaryBuilder) Build(ctx context.Context, user *models.User) (*models.UserSummary, error) {
+	var handle *models.UserHandle
+	var profile *models.Profile
+	var follow_counts models.FollowCounts
+	var err error
+
+	handle, err = b.handle_finder.FindCurrentByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	profile, err = b.profile_repo.FindByUserID(ctx, user.PublicID())
+	if err != nil && !errors.Is(err, domain_errors.ErrProfileNotFound) {
+		return nil, fmt.Errorf("%w", err)
+	}
+	follow_counts, err = b.follow_counter.CountsByUserID(ctx, user.PublicID())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return models.NewUserSummary(
+		user.PublicID(),
+		handle,
+		profile,
+		models.NewUserCounts(0, 0, follow_counts.Followers(), 0),
+		user.CreatedAt(),
+	)
+}
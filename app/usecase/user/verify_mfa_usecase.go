@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// VerifyMfaUseCase はログイン時に発行した二要素認証のチャレンジを認証コードで完了し、SLEEVEのJWTを発行するユースケースです
+type VerifyMfaUseCase struct {
+	jwt_service   *utils.JWTService
+	user_finder   UserFinderInterface
+	code_verifier *MfaCodeVerifier
+	token_issuer  *TokenIssuer
+	// attempt_guard はメールアドレス・IPアドレス・端末IDごとの失敗回数に応じて認証コードの入力を制限します
+	attempt_guard *AuthAttemptGuard
+}
+
+// NewVerifyMfaUseCase は新しいVerifyMfaUseCaseを作成します
+func NewVerifyMfaUseCase(
+	jwt_service *utils.JWTService,
+	user_finder UserFinderInterface,
+	code_verifier *MfaCodeVerifier,
+	token_issuer *TokenIssuer,
+	attempt_guard *AuthAttemptGuard,
+) *VerifyMfaUseCase {
+	return &VerifyMfaUseCase{
+		jwt_service:   jwt_service,
+		user_finder:   user_finder,
+		code_verifier: code_verifier,
+		token_issuer:  token_issuer,
+		attempt_guard: attempt_guard,
+	}
+}
+
+// Execute はチャレンジトークンと認証コード（またはリカバリーコード）を検証し、トークンペアを発行します
+// 認証コードの総当たりなどで失敗回数が上限に達している場合はErrTooManyAttemptsを返します
+func (uc *VerifyMfaUseCase) Execute(ctx context.Context, challenge string, code string) (*LoginUserResult, error) {
+	var attempt *AuthAttempt
+	var result *LoginUserResult
+	var err error
+
+	attempt, err = uc.attempt_guard.Begin(ctx, models.AuthActionVerifyMfa)
+	if err != nil {
+		return nil, err
+	}
+	result, err = uc.execute(ctx, attempt, challenge, code)
+	if err != nil {
+		return nil, attempt.Fail(ctx, err)
+	}
+	attempt.Succeed(ctx)
+	return result, nil
+}
+
+// execute はチャレンジトークンと認証コード（またはリカバリーコード）を検証し、トークンペアを発行します
+func (uc *VerifyMfaUseCase) execute(ctx context.Context, attempt *AuthAttempt, challenge string, code string) (*LoginUserResult, error) {
+	var claims *utils.JWTClaims
+	var user *models.User
+	var token_pair *utils.TokenPair
+	var err error
+
+	// チャレンジトークンの署名・有効期限の検証
+	claims, err = uc.jwt_service.ValidateToken(ctx, challenge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain_errors.ErrInvalidMfaChallenge, err)
+	}
+	if claims.TokenType != utils.TokenTypeMfaChallenge {
+		return nil, fmt.Errorf("%w: unexpected token type %s", domain_errors.ErrInvalidMfaChallenge, claims.TokenType)
+	}
+
+	// ユーザーの状態を確認
+	user, err = uc.user_finder.FindByFirebaseUID(ctx, claims.FirebaseUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if user.PublicID().String() != claims.UserID {
+		return nil, fmt.Errorf("%w: user mismatch", domain_errors.ErrInvalidMfaChallenge)
+	}
+	if user.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrUserDeleted, user.PublicID().String())
+	}
+	if !user.IsMfaEnabled() {
+		// チャレンジの発行後に二要素認証が無効にされた場合は、ログインからやり直す
+		return nil, fmt.Errorf("%w: mfa is not enabled", domain_errors.ErrInvalidMfaChallenge)
+	}
+
+	// 認証コードの検証（チャレンジを再発行しても失敗回数が引き継がれるよう、ユーザーのメールアドレスごとにも数える）
+	err = attempt.IdentifyEmail(ctx, user.Email().Value())
+	if err != nil {
+		return nil, err
+	}
+	err = uc.code_verifier.VerifyCode(ctx, user.PublicID(), code)
+	if err != nil {
+		return nil, err
+	}
+
+	// JWTを発行（リフレッシュトークンは新しいトークンファミリーとして記録）
+	token_pair, err = uc.token_issuer.IssueTokenPair(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &LoginUserResult{
+		User:         user,
+		AccessToken:  token_pair.AccessToken,
+		RefreshToken: token_pair.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+	"sleeve/usecase/utils"
+)
+
+// verifyMfaTestContext は二要素認証のチャレンジ完了テストで共有する依存関係です
+type verifyMfaTestContext struct {
+	*mfaTestContext
+	jwt_service    *utils.JWTService
+	login_use_case *LoginUserUseCase
+	use_case       *VerifyMfaUseCase
+	secret         string
+	recovery_codes []string
+}
+
+// setupVerifyMfaTest は二要素認証を有効にしたユーザーでチャレンジ完了テスト用の依存関係を作成します
+func setupVerifyMfaTest(t *testing.T) *verifyMfaTestContext {
+	var test_context *verifyMfaTestContext
+	var user_finder *MockUserFinder
+	var token_issuer *TokenIssuer
+	var user *models.User
+	var enabled_at time.Time
+	var err error
+
+	t.Helper()
+	test_context = &verifyMfaTestContext{
+		mfaTestContext: setupMfaTest(t),
+		jwt_service:    new_test_jwt_service(NewMockTokenDenylist()),
+	}
+	user_finder = NewMockUserFinder()
+	user, err = user_finder.FindByFirebaseUID(context.Background(), testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	test_context.secret, test_context.recovery_codes = test_context.enable_mfa(t, user)
+	enabled_at = time.Now()
+	user_finder.mfa_enabled_at = &enabled_at
+
+	token_issuer = NewTokenIssuer(test_context.jwt_service, NewMockRefreshTokenRepository(), NewMockSessionRepository())
+	test_context.login_use_case = new_test_login_user_use_case(NewMockFirebaseTokenVerifier(), user_finder, token_issuer)
+	test_context.use_case = NewVerifyMfaUseCase(
+		test_context.jwt_service, user_finder, test_context.code_verifier, token_issuer, new_test_attempt_guard(),
+	)
+	return test_context
+}
+
+// login_with_challenge はテスト用にログインして二要素認証のチャレンジを取得します
+func (c *verifyMfaTestContext) login_with_challenge(t *testing.T) string {
+	var result *LoginUserResult
+	var err error
+
+	t.Helper()
+	result, err = c.login_use_case.Execute(context.Background(), testIDToken)
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	if result.MfaChallenge == "" {
+		t.Fatal("expected mfa challenge to be issued")
+	}
+	return result.MfaChallenge
+}
+
+// TestVerifyMfaUseCase_Execute_WithTotpCode は認証コードでチャレンジを完了し、トークンペアが発行されることをテストします
+func TestVerifyMfaUseCase_Execute_WithTotpCode(t *testing.T) {
+	var ctx context.Context
+	var test_context *verifyMfaTestContext
+	var result *LoginUserResult
+	var access_claims *utils.JWTClaims
+	var err error
+
+	ctx = context.Background()
+	test_context = setupVerifyMfaTest(t)
+	result, err = test_context.use_case.Execute(
+		ctx,
+		test_context.login_with_challenge(t),
+		generate_test_totp_code(t, test_context.secret, time.Now().Add(30*time.Second)),
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	access_claims, err = test_context.jwt_service.ValidateToken(ctx, result.AccessToken)
+	if err != nil {
+		t.Fatalf("expected valid access token, got error: %v", err)
+	}
+	if access_claims.TokenType != utils.TokenTypeAccess {
+		t.Errorf("expected token type %s, got %s", utils.TokenTypeAccess, access_claims.TokenType)
+	}
+	if result.RefreshToken == "" {
+		t.Error("expected refresh_token to be non-empty")
+	}
+}
+
+// TestVerifyMfaUseCase_Execute_RecoveryCodeIsSingleUse はリカバリーコードが1回しか使えないことをテストします
+func TestVerifyMfaUseCase_Execute_RecoveryCodeIsSingleUse(t *testing.T) {
+	var ctx context.Context
+	var test_context *verifyMfaTestContext
+	var err error
+
+	ctx = context.Background()
+	test_context = setupVerifyMfaTest(t)
+	_, err = test_context.use_case.Execute(ctx, test_context.login_with_challenge(t), test_context.recovery_codes[0])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = test_context.use_case.Execute(ctx, test_context.login_with_challenge(t), test_context.recovery_codes[0])
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode for reused recovery code, got %v", err)
+	}
+}
+
+// TestVerifyMfaUseCase_Execute_ReusedTotpCode は同じ認証コードを再利用できないことをテストします
+func TestVerifyMfaUseCase_Execute_ReusedTotpCode(t *testing.T) {
+	var ctx context.Context
+	var test_context *verifyMfaTestContext
+	var code string
+	var err error
+
+	ctx = context.Background()
+	test_context = setupVerifyMfaTest(t)
+	code = generate_test_totp_code(t, test_context.secret, time.Now().Add(30*time.Second))
+	_, err = test_context.use_case.Execute(ctx, test_context.login_with_challenge(t), code)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = test_context.use_case.Execute(ctx, test_context.login_with_challenge(t), code)
+	if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+		t.Errorf("expected ErrInvalidMfaCode for reused code, got %v", err)
+	}
+}
+
+// TestVerifyMfaUseCase_Execute_AccessTokenAsChallenge はアクセストークンをチャレンジとして使えないことをテストします
+func TestVerifyMfaUseCase_Execute_AccessTokenAsChallenge(t *testing.T) {
+	var ctx context.Context
+	var test_context *verifyMfaTestContext
+	var result *LoginUserResult
+	var err error
+
+	ctx = context.Background()
+	test_context = setupVerifyMfaTest(t)
+	result, err = test_context.use_case.Execute(ctx, test_context.login_with_challenge(t), test_context.recovery_codes[0])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = test_context.use_case.Execute(ctx, result.AccessToken, test_context.recovery_codes[1])
+	if !errors.Is(err, domain_errors.ErrInvalidMfaChallenge) {
+		t.Errorf("expected ErrInvalidMfaChallenge, got %v", err)
+	}
+}
+
+// TestVerifyMfaUseCase_Execute_InvalidChallenge は不正なチャレンジでErrInvalidMfaChallengeを返すことをテストします
+func TestVerifyMfaUseCase_Execute_InvalidChallenge(t *testing.T) {
+	var test_context *verifyMfaTestContext
+	var err error
+
+	test_context = setupVerifyMfaTest(t)
+	_, err = test_context.use_case.Execute(context.Background(), "invalid_challenge", test_context.recovery_codes[0])
+	if !errors.Is(err, domain_errors.ErrInvalidMfaChallenge) {
+		t.Errorf("expected ErrInvalidMfaChallenge, got %v", err)
+	}
+}
+
+// TestVerifyMfaUseCase_Execute_TooManyAttempts は認証コードの失敗が続くと正しいコードでもErrTooManyAttemptsを返すことをテストします
+func TestVerifyMfaUseCase_Execute_TooManyAttempts(t *testing.T) {
+	var ctx context.Context
+	var test_context *verifyMfaTestContext
+	var guard *AuthAttemptGuard
+	var use_case *VerifyMfaUseCase
+	var policy models.AttemptPolicy
+	var err error
+
+	ctx = context.Background()
+	test_context = setupVerifyMfaTest(t)
+	guard, _, _, _ = setupAuthAttemptGuardTest(time.Now())
+	use_case = NewVerifyMfaUseCase(
+		test_context.jwt_service, test_context.use_case.user_finder, test_context.code_verifier,
+		test_context.use_case.token_issuer, guard,
+	)
+	policy = models.DefaultAttemptPolicy(models.AttemptKeyKindEmail)
+	for i := 0; i < policy.FreeFailures+1; i++ {
+		_, err = use_case.Execute(ctx, test_context.login_with_challenge(t), "000000")
+		if !errors.Is(err, domain_errors.ErrInvalidMfaCode) {
+			t.Fatalf("expected ErrInvalidMfaCode, got %v", err)
+		}
+	}
+	_, err = use_case.Execute(ctx, test_context.login_with_challenge(t), test_context.recovery_codes[0])
+	if !errors.Is(err, domain_errors.ErrTooManyAttempts) {
+		t.Errorf("expected ErrTooManyAttempts, got %v", err)
+	}
+}
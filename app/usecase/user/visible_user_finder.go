@@ -0,0 +1,24 @@
+package user
+
+import (
+	"context"
+
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// VisibleUserFinderInterface は閲覧するユーザーから見えるユーザーを検索するインターフェースです
+// ブロックの判定はリポジトリの共通のフィルターで行い、どちらかの方向にブロックがある場合はErrUserNotFoundを返します
+// （プロフィールの閲覧・フォローなど、他のユーザーを公開IDで指定する操作で共通して使用します）
+type VisibleUserFinderInterface interface {
+	FindVisibleByPublicID(ctx context.Context, public_id uuid.UUID, viewer_id uuid.UUID) (*models.User, error)
+}
+
+// viewer_public_id はviewerの公開IDを返します（未ログインの場合はuuid.Nil）
+func viewer_public_id(viewer *models.User) uuid.UUID {
+	if viewer == nil {
+		return uuid.Nil
+	}
+	return viewer.PublicID()
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+)
+
+// auth_context_key は認証情報をcontextに格納するためのキーです
+type auth_context_key struct{}
+
+// AuthInfo は認証済みリクエストの認証情報です
+type AuthInfo struct {
+	Claims *JWTClaims
+	User   *models.User
+}
+
+// WithAuthInfo は認証情報を格納したcontextを返します
+func WithAuthInfo(ctx context.Context, claims *JWTClaims, user *models.User) context.Context {
+	return context.WithValue(ctx, auth_context_key{}, &AuthInfo{
+		Claims: claims,
+		User:   user,
+	})
+}
+
+// GetAuthInfo はcontextから認証情報を取得します（未認証の場合はfalse）
+func GetAuthInfo(ctx context.Context) (*AuthInfo, bool) {
+	var auth_info *AuthInfo
+	var is_auth_info bool
+
+	auth_info, is_auth_info = ctx.Value(auth_context_key{}).(*AuthInfo)
+	if !is_auth_info || auth_info == nil {
+		return nil, false
+	}
+	return auth_info, true
+}
+
+// GetAuthClaims はcontextからアクセストークンのクレームを取得します
+func GetAuthClaims(ctx context.Context) (*JWTClaims, bool) {
+	var auth_info *AuthInfo
+	var is_authenticated bool
+
+	auth_info, is_authenticated = GetAuthInfo(ctx)
+	if !is_authenticated {
+		return nil, false
+	}
+	return auth_info.Claims, true
+}
+
+// GetCurrentUser はcontextからログインユーザーを取得します
+func GetCurrentUser(ctx context.Context) (*models.User, bool) {
+	var auth_info *AuthInfo
+	var is_authenticated bool
+
+	auth_info, is_authenticated = GetAuthInfo(ctx)
+	if !is_authenticated {
+		return nil, false
+	}
+	return auth_info.User, true
+}
+
+// IsAuthenticated はリクエストが認証済みかを判定します
+func IsAuthenticated(ctx context.Context) bool {
+	var is_authenticated bool
+
+	_, is_authenticated = GetAuthInfo(ctx)
+	return is_authenticated
+}
+
+// RequireCurrentUser はログインユーザーを取得し、未認証の場合はErrAuthenticationRequiredを返します
+func RequireCurrentUser(ctx context.Context) (*models.User, error) {
+	var user *models.User
+	var is_authenticated bool
+
+	user, is_authenticated = GetCurrentUser(ctx)
+	if !is_authenticated {
+		return nil, fmt.Errorf("%w", domain_errors.ErrAuthenticationRequired)
+	}
+	return user, nil
+}
+
+// RequireVerifiedEmail はメールアドレス確認済みのログインユーザーを取得します
+// 出品・購入など確認済みのメールアドレスが必要なユースケースで使用し、未確認の場合はErrEmailNotVerifiedを返します
+func RequireVerifiedEmail(ctx context.Context) (*models.User, error) {
+	var user *models.User
+	var err error
+
+	user, err = RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsEmailVerified() {
+		return nil, fmt.Errorf("%w: %s", domain_errors.ErrEmailNotVerified, user.PublicID().String())
+	}
+	return user, nil
+}
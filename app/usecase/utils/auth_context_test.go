@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_errors "sleeve/domain/errors"
+	"sleeve/domain/models"
+
+	"github.com/google/uuid"
+)
+
+// create_test_user はテスト用のユーザーを作成します
+func create_test_user(t *testing.T) *models.User {
+	var email models.Email
+	var user *models.User
+	var now time.Time
+	var err error
+
+	t.Helper()
+	email, err = models.NewEmail("test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create email: %v", err)
+	}
+	now = time.Now()
+	user, err = models.NewUserWithPublicID(uuid.New(), testFirebaseUID, email, models.RoleUser, nil, nil, now, now, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// TestAuthContext_Authenticated は認証情報を格納したcontextから取得できることをテストします
+func TestAuthContext_Authenticated(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var claims *JWTClaims
+	var current_user *models.User
+	var current_claims *JWTClaims
+	var is_authenticated bool
+	var err error
+
+	user = create_test_user(t)
+	claims = &JWTClaims{UserID: user.PublicID().String(), TokenType: TokenTypeAccess}
+	ctx = WithAuthInfo(context.Background(), claims, user)
+	if !IsAuthenticated(ctx) {
+		t.Error("expected context to be authenticated")
+	}
+	current_user, is_authenticated = GetCurrentUser(ctx)
+	if !is_authenticated || current_user != user {
+		t.Error("expected current user to be stored in context")
+	}
+	current_claims, is_authenticated = GetAuthClaims(ctx)
+	if !is_authenticated || current_claims != claims {
+		t.Error("expected claims to be stored in context")
+	}
+	current_user, err = RequireCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if current_user != user {
+		t.Error("expected RequireCurrentUser to return stored user")
+	}
+}
+
+// TestAuthContext_Anonymous は未認証のcontextの振る舞いをテストします
+func TestAuthContext_Anonymous(t *testing.T) {
+	var ctx context.Context
+	var is_authenticated bool
+	var err error
+
+	ctx = context.Background()
+	if IsAuthenticated(ctx) {
+		t.Error("expected context to be anonymous")
+	}
+	_, is_authenticated = GetCurrentUser(ctx)
+	if is_authenticated {
+		t.Error("expected no current user")
+	}
+	_, is_authenticated = GetAuthClaims(ctx)
+	if is_authenticated {
+		t.Error("expected no claims")
+	}
+	_, err = RequireCurrentUser(ctx)
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
+
+// TestRequireVerifiedEmail はメールアドレス未確認のユーザーにErrEmailNotVerifiedを返すことをテストします
+func TestRequireVerifiedEmail(t *testing.T) {
+	var ctx context.Context
+	var user *models.User
+	var current_user *models.User
+	var err error
+
+	user = create_test_user(t)
+	ctx = WithAuthInfo(context.Background(), &JWTClaims{UserID: user.PublicID().String()}, user)
+	_, err = RequireVerifiedEmail(ctx)
+	if !errors.Is(err, domain_errors.ErrEmailNotVerified) {
+		t.Errorf("expected ErrEmailNotVerified, got %v", err)
+	}
+	user.MarkEmailVerified(time.Now())
+	current_user, err = RequireVerifiedEmail(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if current_user != user {
+		t.Error("expected RequireVerifiedEmail to return stored user")
+	}
+	_, err = RequireVerifiedEmail(context.Background())
+	if !errors.Is(err, domain_errors.ErrAuthenticationRequired) {
+		t.Errorf("expected ErrAuthenticationRequired, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import "context"
+
+// client_info_context_key はリクエスト元の端末情報をcontextに格納するためのキーです
+type client_info_context_key struct{}
+
+// ClientInfo はリクエスト元の端末情報です（ログイン時のセッションの記録・認証の試行回数の制限に使用します）
+type ClientInfo struct {
+	// DeviceID はクライアントがインストールごとに生成する端末の識別子です（未送信の場合は空）
+	DeviceID   string
+	DeviceName string
+	Platform   string
+	IPAddress  string
+	UserAgent  string
+}
+
+// WithClientInfo はリクエスト元の端末情報を格納したcontextを返します
+func WithClientInfo(ctx context.Context, client_info *ClientInfo) context.Context {
+	return context.WithValue(ctx, client_info_context_key{}, client_info)
+}
+
+// GetClientInfo はcontextからリクエスト元の端末情報を取得します（未設定の場合は空の端末情報を返します）
+func GetClientInfo(ctx context.Context) *ClientInfo {
+	var client_info *ClientInfo
+	var is_client_info bool
+
+	client_info, is_client_info = ctx.Value(client_info_context_key{}).(*ClientInfo)
+	if !is_client_info || client_info == nil {
+		return &ClientInfo{}
+	}
+	return client_info
+}
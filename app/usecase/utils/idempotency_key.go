@@ -0,0 +1,19 @@
+package utils
+
+import "context"
+
+// idempotency_key_context_key はクライアントが送信した冪等キーをcontextに格納するためのキーです
+type idempotency_key_context_key struct{}
+
+// WithIdempotencyKey はクライアントが送信した冪等キー（Idempotency-Keyヘッダー）を格納したcontextを返します
+func WithIdempotencyKey(ctx context.Context, idempotency_key string) context.Context {
+	return context.WithValue(ctx, idempotency_key_context_key{}, idempotency_key)
+}
+
+// GetIdempotencyKey はcontextから冪等キーを取得します（未送信の場合は空文字を返します）
+func GetIdempotencyKey(ctx context.Context) string {
+	var idempotency_key string
+
+	idempotency_key, _ = ctx.Value(idempotency_key_context_key{}).(string)
+	return idempotency_key
+}
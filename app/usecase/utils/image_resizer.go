@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	"sleeve/domain/models"
+)
+
+// jpegQuality はリサイズ画像をJPEGで保存する場合の品質です
+const jpegQuality = 85
+
+// ResizeImage は画像を長辺がmax_edge以下になるように縦横比を保って縮小します（拡大はしません）
+// 縮小先の1ピクセルに対応する元の画像の範囲の平均を取る（エリア平均法）ため、縮小してもジャギーが出にくくなります
+func ResizeImage(src image.Image, max_edge int) *image.RGBA {
+	var rgba *image.RGBA
+	var src_width int
+	var src_height int
+	var dst_width int
+	var dst_height int
+
+	rgba = to_rgba(src)
+	src_width = rgba.Bounds().Dx()
+	src_height = rgba.Bounds().Dy()
+	if src_width <= max_edge && src_height <= max_edge {
+		return rgba
+	}
+	if src_width >= src_height {
+		dst_width = max_edge
+		dst_height = max(1, src_height*max_edge/src_width)
+	} else {
+		dst_height = max_edge
+		dst_width = max(1, src_width*max_edge/src_height)
+	}
+	return resize_area_average(rgba, dst_width, dst_height)
+}
+
+// EncodeImage は画像を指定した形式でエンコードします
+// 元のファイルのExifなどのメタデータは含まれません
+func EncodeImage(img image.Image, format models.ImageFormat) ([]byte, error) {
+	var buffer bytes.Buffer
+	var err error
+
+	switch format {
+	case models.ImageFormatJPEG:
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality})
+	case models.ImageFormatPNG:
+		err = png.Encode(&buffer, img)
+	default:
+		return nil, fmt.Errorf("unsupported image format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// to_rgba は画像を原点が(0, 0)のRGBA（乗算済みアルファ）に変換します
+// 乗算済みアルファで平均を取ることで、透明なピクセルの色が縮小後の縁に滲まないようにします
+func to_rgba(src image.Image) *image.RGBA {
+	var rgba *image.RGBA
+	var is_rgba bool
+
+	rgba, is_rgba = src.(*image.RGBA)
+	if is_rgba && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	rgba = image.NewRGBA(image.Rect(0, 0, src.Bounds().Dx(), src.Bounds().Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, src.Bounds().Min, draw.Src)
+	return rgba
+}
+
+// resize_area_average は縮小先の各ピクセルに対応する元の画像の範囲の平均で縮小します
+func resize_area_average(src *image.RGBA, dst_width int, dst_height int) *image.RGBA {
+	var dst *image.RGBA
+	var src_width int
+	var src_height int
+	var x0, x1, y0, y1 int
+	var sum [4]int
+	var count int
+	var offset int
+
+	dst = image.NewRGBA(image.Rect(0, 0, dst_width, dst_height))
+	src_width = src.Bounds().Dx()
+	src_height = src.Bounds().Dy()
+	for dy := 0; dy < dst_height; dy++ {
+		y0 = dy * src_height / dst_height
+		y1 = max(y0+1, (dy+1)*src_height/dst_height)
+		for dx := 0; dx < dst_width; dx++ {
+			x0 = dx * src_width / dst_width
+			x1 = max(x0+1, (dx+1)*src_width/dst_width)
+			sum = [4]int{}
+			for sy := y0; sy < y1; sy++ {
+				offset = sy*src.Stride + x0*4
+				for sx := x0; sx < x1; sx++ {
+					sum[0] += int(src.Pix[offset])
+					sum[1] += int(src.Pix[offset+1])
+					sum[2] += int(src.Pix[offset+2])
+					sum[3] += int(src.Pix[offset+3])
+					offset += 4
+				}
+			}
+			count = (x1 - x0) * (y1 - y0)
+			offset = dy*dst.Stride + dx*4
+			for channel := 0; channel < 4; channel++ {
+				dst.Pix[offset+channel] = uint8((sum[channel] + count/2) / count)
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"sleeve/domain/models"
+)
+
+// create_test_image は指定した大きさの単色の画像を作成します
+func create_test_image(width int, height int, fill color.Color) *image.NRGBA {
+	var img *image.NRGBA
+
+	img = image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+	return img
+}
+
+func TestResizeImage_KeepsAspectRatio(t *testing.T) {
+	var test_cases []struct {
+		width           int
+		height          int
+		expected_width  int
+		expected_height int
+	}
+	var resized *image.RGBA
+
+	test_cases = []struct {
+		width           int
+		height          int
+		expected_width  int
+		expected_height int
+	}{
+		{width: 800, height: 600, expected_width: 200, expected_height: 150},
+		{width: 600, height: 800, expected_width: 150, expected_height: 200},
+		{width: 1000, height: 2, expected_width: 200, expected_height: 1},
+		// 長辺がmax_edge以下の場合は拡大しない
+		{width: 120, height: 80, expected_width: 120, expected_height: 80},
+	}
+	for _, test_case := range test_cases {
+		resized = ResizeImage(create_test_image(test_case.width, test_case.height, color.White), 200)
+		if resized.Bounds().Dx() != test_case.expected_width || resized.Bounds().Dy() != test_case.expected_height {
+			t.Errorf("%dx%d: expected %dx%d, got %dx%d",
+				test_case.width, test_case.height, test_case.expected_width, test_case.expected_height,
+				resized.Bounds().Dx(), resized.Bounds().Dy())
+		}
+	}
+}
+
+func TestResizeImage_AveragesPixels(t *testing.T) {
+	var src *image.NRGBA
+	var resized *image.RGBA
+	var pixel color.RGBA
+
+	// 左半分が黒・右半分が白の画像を1ピクセルに縮小すると灰色になる
+	src = create_test_image(4, 2, color.Black)
+	for y := 0; y < 2; y++ {
+		for x := 2; x < 4; x++ {
+			src.Set(x, y, color.White)
+		}
+	}
+	resized = ResizeImage(src, 1)
+	pixel = resized.RGBAAt(0, 0)
+	if pixel.R != 128 || pixel.G != 128 || pixel.B != 128 || pixel.A != 255 {
+		t.Errorf("expected gray pixel, got %+v", pixel)
+	}
+}
+
+func TestResizeImage_TransparentPixelsDoNotBleed(t *testing.T) {
+	var src *image.NRGBA
+	var resized *image.RGBA
+	var pixel color.RGBA
+
+	// 透明なピクセルの色（赤）が縮小後の色に混ざらない
+	src = create_test_image(2, 1, color.NRGBA{R: 255, A: 0})
+	src.Set(1, 0, color.NRGBA{B: 255, A: 255})
+	resized = ResizeImage(src, 1)
+	pixel = resized.RGBAAt(0, 0)
+	if pixel.R != 0 || pixel.B != 128 || pixel.A != 128 {
+		t.Errorf("expected half transparent blue, got %+v", pixel)
+	}
+}
+
+func TestEncodeImage(t *testing.T) {
+	var data []byte
+	var format models.ImageFormat
+	var decoded image.Image
+	var err error
+
+	for _, expected := range []models.ImageFormat{models.ImageFormatJPEG, models.ImageFormatPNG} {
+		data, err = EncodeImage(create_test_image(10, 5, color.White), expected)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", expected, err)
+		}
+		format, err = models.DetectImageFormat(data)
+		if err != nil || format != expected {
+			t.Errorf("%s: expected encoded data to be detected as %s, got %s (%v)", expected, expected, format, err)
+		}
+	}
+	data, _ = EncodeImage(create_test_image(10, 5, color.White), models.ImageFormatPNG)
+	decoded, err = png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("expected encoded png to be decodable, got %v", err)
+	}
+	if decoded.Bounds().Dx() != 10 || decoded.Bounds().Dy() != 5 {
+		t.Errorf("expected 10x5, got %v", decoded.Bounds())
+	}
+	_, err = EncodeImage(create_test_image(1, 1, color.White), models.ImageFormat("gif"))
+	if err == nil {
+		t.Error("expected error for unsupported format, got nil")
+	}
+}
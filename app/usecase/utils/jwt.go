@@ -1,24 +1,28 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	domain_errors "sleeve/domain/errors"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // トークンタイプの定義
 const (
-	TokenTypeAccess  = "access"
-	TokenTypeRefresh = "refresh"
+	TokenTypeAccess       = "access"
+	TokenTypeRefresh      = "refresh"
+	TokenTypeMfaChallenge = "mfa_challenge"
 )
 
 // トークンの有効期限
 const (
-	AccessTokenExpiry  = 15 * time.Minute
-	RefreshTokenExpiry = 7 * 24 * time.Hour
+	AccessTokenExpiry       = 15 * time.Minute
+	RefreshTokenExpiry      = 7 * 24 * time.Hour
+	MfaChallengeTokenExpiry = 5 * time.Minute
 )
 
 // JWTClaims はJWTのカスタムクレームです
@@ -26,73 +30,97 @@ type JWTClaims struct {
 	UserID      string `json:"user_id"`
 	FirebaseUID string `json:"firebase_uid"`
 	TokenType   string `json:"token_type"`
+	SessionID   string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // TokenPair はアクセストークンとリフレッシュトークンのペアです
 type TokenPair struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken           string
+	RefreshToken          string
+	RefreshTokenID        string
+	RefreshTokenExpiresAt time.Time
+}
+
+// TokenDenylistInterface は失効済みのトークン（jti）・セッション（sid）を判定するインターフェースです
+type TokenDenylistInterface interface {
+	IsDenylisted(ctx context.Context, token_id, session_id string) (bool, error)
 }
 
 // JWTService はJWTの生成・検証を行うサービスです
 type JWTService struct {
-	secret_key []byte
+	key_ring *KeyRing
+	denylist TokenDenylistInterface
 }
 
-// NewJWTService は新しいJWTServiceを作成します
-func NewJWTService(secret_key string) *JWTService {
+// NewJWTService はKeyRingの鍵で署名・検証し、検証時にdenylistを参照する新しいJWTServiceを作成します
+// 署名鍵のkidはトークンのヘッダーに設定され、検証時の鍵の選択に使われます
+func NewJWTService(key_ring *KeyRing, denylist TokenDenylistInterface) *JWTService {
 	return &JWTService{
-		secret_key: []byte(secret_key),
+		key_ring: key_ring,
+		denylist: denylist,
 	}
 }
 
+// KeyRing は署名・検証に使うKeyRingを返します（JWKSの公開用）
+func (s *JWTService) KeyRing() *KeyRing {
+	return s.key_ring
+}
+
 // GenerateAccessToken はアクセストークンを生成します
 func (s *JWTService) GenerateAccessToken(user_id, firebase_uid string) (string, error) {
-	return s.generate_token(user_id, firebase_uid, TokenTypeAccess, AccessTokenExpiry)
+	return s.generate_token(user_id, firebase_uid, TokenTypeAccess, uuid.NewString(), "", time.Now().Add(AccessTokenExpiry))
 }
 
 // GenerateRefreshToken はリフレッシュトークンを生成します
 func (s *JWTService) GenerateRefreshToken(user_id, firebase_uid string) (string, error) {
-	return s.generate_token(user_id, firebase_uid, TokenTypeRefresh, RefreshTokenExpiry)
+	return s.generate_token(user_id, firebase_uid, TokenTypeRefresh, uuid.NewString(), "", time.Now().Add(RefreshTokenExpiry))
+}
+
+// GenerateMfaChallengeToken は二要素認証のチャレンジトークンを生成します
+// ログイン時にアクセストークンの代わりに返し、verifyMfaで認証コードと交換します（API呼び出しには使えません）
+func (s *JWTService) GenerateMfaChallengeToken(user_id, firebase_uid string) (string, error) {
+	return s.generate_token(user_id, firebase_uid, TokenTypeMfaChallenge, uuid.NewString(), "", time.Now().Add(MfaChallengeTokenExpiry))
 }
 
 // GenerateTokenPair はアクセストークンとリフレッシュトークンのペアを生成します
-func (s *JWTService) GenerateTokenPair(user_id, firebase_uid string) (*TokenPair, error) {
+// session_idは両方のトークンにsidクレームとして設定され、ログアウト時のセッション単位の失効に使用されます
+func (s *JWTService) GenerateTokenPair(user_id, firebase_uid, session_id string) (*TokenPair, error) {
 	var access_token string
 	var refresh_token string
+	var refresh_token_id string
+	var refresh_expires_at time.Time
 	var err error
 
-	access_token, err = s.GenerateAccessToken(user_id, firebase_uid)
+	access_token, err = s.generate_token(user_id, firebase_uid, TokenTypeAccess, uuid.NewString(), session_id, time.Now().Add(AccessTokenExpiry))
 	if err != nil {
 		return nil, err
 	}
-	refresh_token, err = s.GenerateRefreshToken(user_id, firebase_uid)
+	// リフレッシュトークンはjtiでDBに記録するため、IDと有効期限を呼び出し元に返す
+	refresh_token_id = uuid.NewString()
+	refresh_expires_at = time.Now().Add(RefreshTokenExpiry)
+	refresh_token, err = s.generate_token(user_id, firebase_uid, TokenTypeRefresh, refresh_token_id, session_id, refresh_expires_at)
 	if err != nil {
 		return nil, err
 	}
 	return &TokenPair{
-		AccessToken:  access_token,
-		RefreshToken: refresh_token,
+		AccessToken:           access_token,
+		RefreshToken:          refresh_token,
+		RefreshTokenID:        refresh_token_id,
+		RefreshTokenExpiresAt: refresh_expires_at,
 	}, nil
 }
 
 // ValidateToken はトークンを検証し、クレームを返します
-func (s *JWTService) ValidateToken(token_string string) (*JWTClaims, error) {
+// 失効済みのjti・sidを持つトークンはErrTokenRevokedとして拒否します
+func (s *JWTService) ValidateToken(ctx context.Context, token_string string) (*JWTClaims, error) {
 	var token *jwt.Token
 	var claims *JWTClaims
 	var claims_ok bool
+	var is_denylisted bool
 	var err error
 
-	token, err = jwt.ParseWithClaims(token_string, &JWTClaims{}, func(token *jwt.Token) (any, error) {
-		var is_hmac bool
-
-		_, is_hmac = token.Method.(*jwt.SigningMethodHMAC)
-		if !is_hmac {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return s.secret_key, nil
-	})
+	token, err = jwt.ParseWithClaims(token_string, &JWTClaims{}, s.key_ring.VerificationKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain_errors.ErrJWTGenerationFailed, err)
 	}
@@ -100,32 +128,46 @@ func (s *JWTService) ValidateToken(token_string string) (*JWTClaims, error) {
 	if !claims_ok || !token.Valid {
 		return nil, fmt.Errorf("%w: invalid token claims", domain_errors.ErrJWTGenerationFailed)
 	}
+	is_denylisted, err = s.denylist.IsDenylisted(ctx, claims.ID, claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if is_denylisted {
+		return nil, fmt.Errorf("%w: jti=%s", domain_errors.ErrTokenRevoked, claims.ID)
+	}
 	return claims, nil
 }
 
 // generate_token はトークンを生成する内部関数です
-func (s *JWTService) generate_token(user_id, firebase_uid, token_type string, expiry time.Duration) (string, error) {
+func (s *JWTService) generate_token(user_id, firebase_uid, token_type, token_id, session_id string, expires_at time.Time) (string, error) {
 	var now time.Time
 	var claims *JWTClaims
+	var signing_key *SigningKey
 	var token *jwt.Token
 	var token_string string
 	var err error
 
 	now = time.Now()
+	signing_key = s.key_ring.SigningKey()
 	claims = &JWTClaims{
 		UserID:      user_id,
 		FirebaseUID: firebase_uid,
 		TokenType:   token_type,
+		SessionID:   session_id,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			ID:        token_id,
+			ExpiresAt: jwt.NewNumericDate(expires_at),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "sleeve",
 			Subject:   user_id,
 		},
 	}
-	token = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	token_string, err = token.SignedString(s.secret_key)
+	token = jwt.NewWithClaims(signing_key.method, claims)
+	if signing_key.kid != "" {
+		token.Header["kid"] = signing_key.kid
+	}
+	token_string, err = token.SignedString(signing_key.sign_key)
 	if err != nil {
 		return "", fmt.Errorf("%w: %w", domain_errors.ErrJWTGenerationFailed, err)
 	}
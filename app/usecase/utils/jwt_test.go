@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
+
+	domain_errors "sleeve/domain/errors"
 )
 
 // テスト用定数
@@ -10,6 +14,7 @@ const (
 	testSecretKey   = "test_secret_key_for_testing_1234567890"
 	testUserID      = "user_123"
 	testFirebaseUID = "firebase_uid_456"
+	testSessionID   = "session_789"
 )
 
 // TestJWTService_GenerateAccessToken_Success はアクセストークン生成が成功するケースをテストします
@@ -20,7 +25,7 @@ func TestJWTService_GenerateAccessToken_Success(t *testing.T) {
 	var firebase_uid string
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	user_id = testUserID
 	firebase_uid = testFirebaseUID
 	token, err = service.GenerateAccessToken(user_id, firebase_uid)
@@ -40,7 +45,7 @@ func TestJWTService_GenerateRefreshToken_Success(t *testing.T) {
 	var firebase_uid string
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	user_id = testUserID
 	firebase_uid = testFirebaseUID
 	token, err = service.GenerateRefreshToken(user_id, firebase_uid)
@@ -61,14 +66,14 @@ func TestJWTService_ValidateToken_ValidAccessToken(t *testing.T) {
 	var firebase_uid string
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	user_id = testUserID
 	firebase_uid = testFirebaseUID
 	token, err = service.GenerateAccessToken(user_id, firebase_uid)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
-	claims, err = service.ValidateToken(token)
+	claims, err = service.ValidateToken(context.Background(), token)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
@@ -95,14 +100,14 @@ func TestJWTService_ValidateToken_ValidRefreshToken(t *testing.T) {
 	var firebase_uid string
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	user_id = testUserID
 	firebase_uid = testFirebaseUID
 	token, err = service.GenerateRefreshToken(user_id, firebase_uid)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
-	claims, err = service.ValidateToken(token)
+	claims, err = service.ValidateToken(context.Background(), token)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
@@ -125,8 +130,8 @@ func TestJWTService_ValidateToken_InvalidToken(t *testing.T) {
 	var service *JWTService
 	var err error
 
-	service = NewJWTService(testSecretKey)
-	_, err = service.ValidateToken("invalid_token")
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
+	_, err = service.ValidateToken(context.Background(), "invalid_token")
 	if err == nil {
 		t.Error("expected error for invalid token, got nil")
 	}
@@ -139,13 +144,13 @@ func TestJWTService_ValidateToken_WrongSecret(t *testing.T) {
 	var token string
 	var err error
 
-	service1 = NewJWTService("secret_key_1_for_testing_1234567890")
-	service2 = NewJWTService("secret_key_2_for_testing_1234567890")
+	service1 = new_hmac_jwt_service(t, "secret_key_1_for_testing_1234567890", NewMockTokenDenylist())
+	service2 = new_hmac_jwt_service(t, "secret_key_2_for_testing_1234567890", NewMockTokenDenylist())
 	token, err = service1.GenerateAccessToken(testUserID, testFirebaseUID)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
-	_, err = service2.ValidateToken(token)
+	_, err = service2.ValidateToken(context.Background(), token)
 	if err == nil {
 		t.Error("expected error for wrong secret, got nil")
 	}
@@ -160,12 +165,12 @@ func TestJWTService_AccessTokenExpiry(t *testing.T) {
 	var actual_expiry time.Duration
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	token, err = service.GenerateAccessToken(testUserID, testFirebaseUID)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
-	claims, err = service.ValidateToken(token)
+	claims, err = service.ValidateToken(context.Background(), token)
 	if err != nil {
 		t.Fatalf("failed to validate token: %v", err)
 	}
@@ -186,12 +191,12 @@ func TestJWTService_RefreshTokenExpiry(t *testing.T) {
 	var actual_expiry time.Duration
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	token, err = service.GenerateRefreshToken(testUserID, testFirebaseUID)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
-	claims, err = service.ValidateToken(token)
+	claims, err = service.ValidateToken(context.Background(), token)
 	if err != nil {
 		t.Fatalf("failed to validate token: %v", err)
 	}
@@ -211,10 +216,10 @@ func TestJWTService_GenerateTokenPair_Success(t *testing.T) {
 	var firebase_uid string
 	var err error
 
-	service = NewJWTService(testSecretKey)
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
 	user_id = testUserID
 	firebase_uid = testFirebaseUID
-	token_pair, err = service.GenerateTokenPair(user_id, firebase_uid)
+	token_pair, err = service.GenerateTokenPair(user_id, firebase_uid, testSessionID)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
@@ -231,3 +236,207 @@ func TestJWTService_GenerateTokenPair_Success(t *testing.T) {
 		t.Error("access_token and refresh_token should be different")
 	}
 }
+
+// TestJWTService_GenerateTokenPair_RefreshTokenID はリフレッシュトークンのjtiがペアに含まれることをテストします
+func TestJWTService_GenerateTokenPair_RefreshTokenID(t *testing.T) {
+	var service *JWTService
+	var token_pair *TokenPair
+	var other_pair *TokenPair
+	var refresh_claims *JWTClaims
+	var err error
+
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
+	token_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	refresh_claims, err = service.ValidateToken(context.Background(), token_pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("failed to validate refresh token: %v", err)
+	}
+	if token_pair.RefreshTokenID == "" {
+		t.Error("expected refresh_token_id to be non-empty")
+	}
+	if refresh_claims.ID != token_pair.RefreshTokenID {
+		t.Errorf("expected jti %s, got %s", token_pair.RefreshTokenID, refresh_claims.ID)
+	}
+	if !refresh_claims.ExpiresAt.Time.Equal(token_pair.RefreshTokenExpiresAt.Truncate(time.Second)) {
+		t.Errorf("expected expires_at %v, got %v", token_pair.RefreshTokenExpiresAt, refresh_claims.ExpiresAt.Time)
+	}
+	other_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	if other_pair.RefreshTokenID == token_pair.RefreshTokenID {
+		t.Error("expected refresh_token_id to be unique per pair")
+	}
+}
+
+// TestJWTService_GenerateTokenPair_SessionID は両方のトークンにsidが設定されることをテストします
+func TestJWTService_GenerateTokenPair_SessionID(t *testing.T) {
+	var service *JWTService
+	var token_pair *TokenPair
+	var claims *JWTClaims
+	var err error
+
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
+	token_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	for _, token := range []string{token_pair.AccessToken, token_pair.RefreshToken} {
+		claims, err = service.ValidateToken(context.Background(), token)
+		if err != nil {
+			t.Fatalf("failed to validate token: %v", err)
+		}
+		if claims.SessionID != testSessionID {
+			t.Errorf("expected sid %s, got %s", testSessionID, claims.SessionID)
+		}
+		if claims.ID == "" {
+			t.Error("expected jti to be non-empty")
+		}
+	}
+}
+
+// TestJWTService_ValidateToken_DenylistedToken はdenylistに登録されたjtiのトークンが拒否されることをテストします
+func TestJWTService_ValidateToken_DenylistedToken(t *testing.T) {
+	var ctx context.Context
+	var denylist *MockTokenDenylist
+	var service *JWTService
+	var token_pair *TokenPair
+	var claims *JWTClaims
+	var err error
+
+	ctx = context.Background()
+	denylist = NewMockTokenDenylist()
+	service = new_hmac_jwt_service(t, testSecretKey, denylist)
+	token_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	claims, err = service.ValidateToken(ctx, token_pair.AccessToken)
+	if err != nil {
+		t.Fatalf("expected no error before revocation, got %v", err)
+	}
+	denylist.denylisted_values[claims.ID] = true
+	_, err = service.ValidateToken(ctx, token_pair.AccessToken)
+	if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked, got %v", err)
+	}
+	// 同じセッションの別トークンはjti単位の失効では影響を受けない
+	_, err = service.ValidateToken(ctx, token_pair.RefreshToken)
+	if err != nil {
+		t.Errorf("expected refresh token to remain valid, got %v", err)
+	}
+}
+
+// TestJWTService_ValidateToken_DenylistedSession はdenylistに登録されたsidのトークンが全て拒否されることをテストします
+func TestJWTService_ValidateToken_DenylistedSession(t *testing.T) {
+	var ctx context.Context
+	var denylist *MockTokenDenylist
+	var service *JWTService
+	var token_pair *TokenPair
+	var err error
+
+	ctx = context.Background()
+	denylist = NewMockTokenDenylist()
+	denylist.denylisted_values[testSessionID] = true
+	service = new_hmac_jwt_service(t, testSecretKey, denylist)
+	token_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	for _, token := range []string{token_pair.AccessToken, token_pair.RefreshToken} {
+		_, err = service.ValidateToken(ctx, token)
+		if !errors.Is(err, domain_errors.ErrTokenRevoked) {
+			t.Errorf("expected ErrTokenRevoked, got %v", err)
+		}
+	}
+}
+
+// TestJWTService_ValidateToken_DenylistError はdenylistの参照エラーを返すケースをテストします
+func TestJWTService_ValidateToken_DenylistError(t *testing.T) {
+	var denylist *MockTokenDenylist
+	var service *JWTService
+	var token_pair *TokenPair
+	var err error
+
+	denylist = NewMockTokenDenylist()
+	denylist.should_return_error = true
+	service = new_hmac_jwt_service(t, testSecretKey, denylist)
+	token_pair, err = service.GenerateTokenPair(testUserID, testFirebaseUID, testSessionID)
+	if err != nil {
+		t.Fatalf("failed to generate token pair: %v", err)
+	}
+	_, err = service.ValidateToken(context.Background(), token_pair.AccessToken)
+	if !errors.Is(err, domain_errors.ErrDatabaseError) {
+		t.Errorf("expected ErrDatabaseError, got %v", err)
+	}
+}
+
+// new_hmac_jwt_service はHS256の共通鍵のみのKeyRingで署名・検証するテスト用のJWTServiceを作成します
+func new_hmac_jwt_service(t *testing.T, secret_key string, denylist TokenDenylistInterface) *JWTService {
+	var signing_key *SigningKey
+	var key_ring *KeyRing
+	var err error
+
+	t.Helper()
+	signing_key, err = NewHMACSigningKey("", []byte(secret_key))
+	if err != nil {
+		t.Fatalf("failed to create hmac signing key: %v", err)
+	}
+	key_ring, err = NewKeyRing(signing_key)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	return NewJWTService(key_ring, denylist)
+}
+
+// MockTokenDenylist はテスト用のdenylistモックです
+type MockTokenDenylist struct {
+	denylisted_values   map[string]bool
+	should_return_error bool
+}
+
+// NewMockTokenDenylist は新しいMockTokenDenylistを作成します
+func NewMockTokenDenylist() *MockTokenDenylist {
+	return &MockTokenDenylist{
+		denylisted_values:   map[string]bool{},
+		should_return_error: false,
+	}
+}
+
+// IsDenylisted はjtiまたはsidが登録済みかを返します
+func (m *MockTokenDenylist) IsDenylisted(_ context.Context, token_id, session_id string) (bool, error) {
+	if m.should_return_error {
+		return false, domain_errors.ErrDatabaseError
+	}
+	return m.denylisted_values[token_id] || (session_id != "" && m.denylisted_values[session_id]), nil
+}
+
+// TestJWTService_GenerateMfaChallengeToken は二要素認証のチャレンジトークンの生成と検証をテストします
+func TestJWTService_GenerateMfaChallengeToken(t *testing.T) {
+	var service *JWTService
+	var token string
+	var claims *JWTClaims
+	var err error
+
+	service = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist())
+	token, err = service.GenerateMfaChallengeToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	claims, err = service.ValidateToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if claims.TokenType != TokenTypeMfaChallenge {
+		t.Errorf("expected token type %s, got %s", TokenTypeMfaChallenge, claims.TokenType)
+	}
+	if claims.UserID != testUserID {
+		t.Errorf("expected user_id %s, got %s", testUserID, claims.UserID)
+	}
+	if claims.ExpiresAt.Sub(claims.IssuedAt.Time) != MfaChallengeTokenExpiry {
+		t.Errorf("expected expiry %v, got %v", MfaChallengeTokenExpiry, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+	}
+}
@@ -0,0 +1,318 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits はRS256の鍵として受け付ける最小の鍵長です
+const minRSAKeyBits = 2048
+
+// pemKeyFileExtension は鍵ディレクトリから読み込むPEMファイルの拡張子です（ファイル名がkidになります）
+const pemKeyFileExtension = ".pem"
+
+// SigningKey はkidで識別されるJWTの署名鍵・検証鍵です
+// 公開鍵のみを持つ鍵は検証専用（ローテーションで署名に使わなくなった鍵）として扱います
+type SigningKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	sign_key   any
+	verify_key any
+}
+
+// NewRSASigningKey はRS256の署名鍵を作成します
+func NewRSASigningKey(kid string, private_key *rsa.PrivateKey) (*SigningKey, error) {
+	if private_key == nil {
+		return nil, fmt.Errorf("rsa private key cannot be nil")
+	}
+	if private_key.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("rsa key must be at least %d bits: kid=%s", minRSAKeyBits, kid)
+	}
+	return &SigningKey{
+		kid:        kid,
+		method:     jwt.SigningMethodRS256,
+		sign_key:   private_key,
+		verify_key: &private_key.PublicKey,
+	}, nil
+}
+
+// NewRSAVerificationKey はRS256の検証専用鍵を作成します
+func NewRSAVerificationKey(kid string, public_key *rsa.PublicKey) (*SigningKey, error) {
+	if public_key == nil {
+		return nil, fmt.Errorf("rsa public key cannot be nil")
+	}
+	return &SigningKey{
+		kid:        kid,
+		method:     jwt.SigningMethodRS256,
+		sign_key:   nil,
+		verify_key: public_key,
+	}, nil
+}
+
+// NewEd25519SigningKey はEdDSA（Ed25519）の署名鍵を作成します
+func NewEd25519SigningKey(kid string, private_key ed25519.PrivateKey) (*SigningKey, error) {
+	if len(private_key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid ed25519 private key size: kid=%s", kid)
+	}
+	return &SigningKey{
+		kid:        kid,
+		method:     jwt.SigningMethodEdDSA,
+		sign_key:   private_key,
+		verify_key: private_key.Public(),
+	}, nil
+}
+
+// NewEd25519VerificationKey はEdDSA（Ed25519）の検証専用鍵を作成します
+func NewEd25519VerificationKey(kid string, public_key ed25519.PublicKey) (*SigningKey, error) {
+	if len(public_key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key size: kid=%s", kid)
+	}
+	return &SigningKey{
+		kid:        kid,
+		method:     jwt.SigningMethodEdDSA,
+		sign_key:   nil,
+		verify_key: public_key,
+	}, nil
+}
+
+// NewHMACSigningKey はHS256の共通鍵を作成します
+// 共通鍵は検証できるサービスがトークンを発行できてしまうため、JWKSには公開しません
+func NewHMACSigningKey(kid string, secret_key []byte) (*SigningKey, error) {
+	if len(secret_key) == 0 {
+		return nil, fmt.Errorf("hmac secret key cannot be empty")
+	}
+	return &SigningKey{
+		kid:        kid,
+		method:     jwt.SigningMethodHS256,
+		sign_key:   secret_key,
+		verify_key: secret_key,
+	}, nil
+}
+
+// ParsePEMKey はPEM形式の鍵を読み込みます
+// 秘密鍵（PKCS#8 / PKCS#1）は署名鍵、公開鍵（PKIX）は検証専用鍵になります
+func ParsePEMKey(kid string, pem_bytes []byte) (*SigningKey, error) {
+	var block *pem.Block
+	var parsed_key any
+	var err error
+
+	block, _ = pem.Decode(pem_bytes)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode pem: kid=%s", kid)
+	}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed_key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed_key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed_key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem block type %s: kid=%s", block.Type, kid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pem key: kid=%s: %w", kid, err)
+	}
+	switch key := parsed_key.(type) {
+	case *rsa.PrivateKey:
+		return NewRSASigningKey(kid, key)
+	case *rsa.PublicKey:
+		return NewRSAVerificationKey(kid, key)
+	case ed25519.PrivateKey:
+		return NewEd25519SigningKey(kid, key)
+	case ed25519.PublicKey:
+		return NewEd25519VerificationKey(kid, key)
+	default:
+		return nil, fmt.Errorf("unsupported key type %T: kid=%s", parsed_key, kid)
+	}
+}
+
+// KeyID は鍵のkidを返します
+func (k *SigningKey) KeyID() string {
+	return k.kid
+}
+
+// Algorithm は鍵の署名アルゴリズム（alg）を返します
+func (k *SigningKey) Algorithm() string {
+	return k.method.Alg()
+}
+
+// CanSign は鍵が署名に使えるかどうかを返します
+func (k *SigningKey) CanSign() bool {
+	return k.sign_key != nil
+}
+
+// verification_only は署名に使わない検証専用の鍵を返します
+func (k *SigningKey) verification_only() *SigningKey {
+	return &SigningKey{
+		kid:        k.kid,
+		method:     k.method,
+		sign_key:   nil,
+		verify_key: k.verify_key,
+	}
+}
+
+// KeyRing は署名に使う鍵と、検証に使える複数の鍵をkidで管理します
+// 鍵のローテーション中は旧鍵を検証専用として残すことで、発行済みのトークンを無効にせずに署名鍵を切り替えられます
+type KeyRing struct {
+	signing_key       *SigningKey
+	verification_keys map[string]*SigningKey
+	key_ids           []string
+}
+
+// NewKeyRing は新しいKeyRingを作成します
+// signing_keyは署名と検証の両方に使われ、verification_keysは検証のみに使われます
+func NewKeyRing(signing_key *SigningKey, verification_keys ...*SigningKey) (*KeyRing, error) {
+	var key_ring *KeyRing
+	var is_duplicate bool
+
+	if signing_key == nil || !signing_key.CanSign() {
+		return nil, fmt.Errorf("signing key must have a private key")
+	}
+	key_ring = &KeyRing{
+		signing_key:       signing_key,
+		verification_keys: map[string]*SigningKey{},
+		key_ids:           []string{},
+	}
+	for _, key := range append([]*SigningKey{signing_key}, verification_keys...) {
+		if key == nil {
+			return nil, fmt.Errorf("verification key cannot be nil")
+		}
+		_, is_duplicate = key_ring.verification_keys[key.kid]
+		if is_duplicate {
+			return nil, fmt.Errorf("duplicate key id: %s", key.kid)
+		}
+		key_ring.verification_keys[key.kid] = key
+		key_ring.key_ids = append(key_ring.key_ids, key.kid)
+	}
+	return key_ring, nil
+}
+
+// LoadKeyRingFromDir は鍵ディレクトリの「<kid>.pem」ファイルを全て読み込み、signing_kidの鍵で署名するKeyRingを作成します
+// legacy_secret_keyを指定した場合、kidを持たない旧HS256トークンを検証できるように共通鍵を検証用として追加します
+func LoadKeyRingFromDir(keys_dir string, signing_kid string, legacy_secret_key string) (*KeyRing, error) {
+	var file_paths []string
+	var kid string
+	var pem_bytes []byte
+	var key *SigningKey
+	var signing_key *SigningKey
+	var verification_keys []*SigningKey
+	var err error
+
+	file_paths, err = filepath.Glob(filepath.Join(keys_dir, "*"+pemKeyFileExtension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key files: %w", err)
+	}
+	sort.Strings(file_paths)
+	for _, file_path := range file_paths {
+		kid = strings.TrimSuffix(filepath.Base(file_path), pemKeyFileExtension)
+		pem_bytes, err = os.ReadFile(file_path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: kid=%s: %w", kid, err)
+		}
+		key, err = ParsePEMKey(kid, pem_bytes)
+		if err != nil {
+			return nil, err
+		}
+		if kid == signing_kid {
+			signing_key = key
+			continue
+		}
+		verification_keys = append(verification_keys, key)
+	}
+	if signing_key == nil {
+		return nil, fmt.Errorf("signing key not found in %s: kid=%s", keys_dir, signing_kid)
+	}
+	if legacy_secret_key != "" {
+		key, err = NewHMACSigningKey("", []byte(legacy_secret_key))
+		if err != nil {
+			return nil, err
+		}
+		verification_keys = append(verification_keys, key.verification_only())
+	}
+	return NewKeyRing(signing_key, verification_keys...)
+}
+
+// SigningKey は署名に使う鍵を返します
+func (r *KeyRing) SigningKey() *SigningKey {
+	return r.signing_key
+}
+
+// VerificationKey はトークンヘッダーのkidとalgに対応する検証鍵を返します
+// kidを持たないトークンはkidが空の鍵（旧HS256の共通鍵）で検証します
+func (r *KeyRing) VerificationKey(token *jwt.Token) (any, error) {
+	var kid string
+	var key *SigningKey
+	var is_found bool
+
+	kid, _ = token.Header["kid"].(string)
+	key, is_found = r.verification_keys[kid]
+	if !is_found {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	// algの差し替えによる攻撃を防ぐため、鍵に紐づくアルゴリズム以外は受け付けない
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %v for key id %s", token.Header["alg"], kid)
+	}
+	return key.verify_key, nil
+}
+
+// JSONWebKey はJWKSで公開する公開鍵です
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet は /.well-known/jwks.json で公開する公開鍵の一覧です
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS は検証に使える公開鍵の一覧をJWKS形式で返します（共通鍵は含みません）
+func (r *KeyRing) JWKS() JSONWebKeySet {
+	var key_set JSONWebKeySet
+	var key *SigningKey
+
+	key_set = JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, kid := range r.key_ids {
+		key = r.verification_keys[kid]
+		switch public_key := key.verify_key.(type) {
+		case *rsa.PublicKey:
+			key_set.Keys = append(key_set.Keys, JSONWebKey{
+				KeyType:   "RSA",
+				KeyID:     key.kid,
+				Use:       "sig",
+				Algorithm: key.method.Alg(),
+				N:         base64.RawURLEncoding.EncodeToString(public_key.N.Bytes()),
+				E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public_key.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			key_set.Keys = append(key_set.Keys, JSONWebKey{
+				KeyType:   "OKP",
+				KeyID:     key.kid,
+				Use:       "sig",
+				Algorithm: key.method.Alg(),
+				Curve:     "Ed25519",
+				X:         base64.RawURLEncoding.EncodeToString(public_key),
+			})
+		}
+	}
+	return key_set
+}
@@ -0,0 +1,332 @@
+package utils
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// テスト用のkid
+const (
+	testRSAKeyID     = "rsa-2026-10"
+	testEd25519KeyID = "ed25519-2026-10"
+)
+
+// TestKeyRing_RS256_SignAndValidate はRS256の鍵で署名したトークンにkidが設定され、検証できることをテストします
+func TestKeyRing_RS256_SignAndValidate(t *testing.T) {
+	var key_ring *KeyRing
+	var service *JWTService
+	var token_string string
+	var claims *JWTClaims
+	var err error
+
+	key_ring, err = NewKeyRing(generate_rsa_signing_key(t, testRSAKeyID))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	service = NewJWTService(key_ring, NewMockTokenDenylist())
+	token_string, err = service.GenerateAccessToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	assert_token_header(t, token_string, "RS256", testRSAKeyID)
+	claims, err = service.ValidateToken(context.Background(), token_string)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if claims.UserID != testUserID {
+		t.Errorf("expected user_id %s, got %s", testUserID, claims.UserID)
+	}
+}
+
+// TestKeyRing_EdDSA_SignAndValidate はEdDSAの鍵で署名したトークンを検証できることをテストします
+func TestKeyRing_EdDSA_SignAndValidate(t *testing.T) {
+	var key_ring *KeyRing
+	var service *JWTService
+	var token_string string
+	var err error
+
+	key_ring, err = NewKeyRing(generate_ed25519_signing_key(t, testEd25519KeyID))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	service = NewJWTService(key_ring, NewMockTokenDenylist())
+	token_string, err = service.GenerateAccessToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	assert_token_header(t, token_string, "EdDSA", testEd25519KeyID)
+	_, err = service.ValidateToken(context.Background(), token_string)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+// TestKeyRing_Rotation は署名鍵をローテーションしても、旧鍵で署名済みのトークンを検証できることをテストします
+func TestKeyRing_Rotation(t *testing.T) {
+	var old_key *SigningKey
+	var new_key *SigningKey
+	var old_public_key *SigningKey
+	var old_ring *KeyRing
+	var new_ring *KeyRing
+	var old_token string
+	var new_token string
+	var err error
+
+	old_key = generate_rsa_signing_key(t, testRSAKeyID)
+	new_key = generate_ed25519_signing_key(t, testEd25519KeyID)
+	old_public_key, err = NewRSAVerificationKey(testRSAKeyID, old_key.verify_key.(*rsa.PublicKey))
+	if err != nil {
+		t.Fatalf("failed to create verification key: %v", err)
+	}
+	old_ring, err = NewKeyRing(old_key)
+	if err != nil {
+		t.Fatalf("failed to create old key ring: %v", err)
+	}
+	new_ring, err = NewKeyRing(new_key, old_public_key)
+	if err != nil {
+		t.Fatalf("failed to create new key ring: %v", err)
+	}
+	old_token, err = NewJWTService(old_ring, NewMockTokenDenylist()).GenerateAccessToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate old token: %v", err)
+	}
+	new_token, err = NewJWTService(new_ring, NewMockTokenDenylist()).GenerateAccessToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate new token: %v", err)
+	}
+	// 新しいKeyRingは旧鍵・新鍵の両方のトークンを検証できる
+	_, err = NewJWTService(new_ring, NewMockTokenDenylist()).ValidateToken(context.Background(), old_token)
+	if err != nil {
+		t.Errorf("expected old token to be valid after rotation, got %v", err)
+	}
+	_, err = NewJWTService(new_ring, NewMockTokenDenylist()).ValidateToken(context.Background(), new_token)
+	if err != nil {
+		t.Errorf("expected new token to be valid, got %v", err)
+	}
+	// 旧KeyRingは新鍵を知らないため検証できない
+	_, err = NewJWTService(old_ring, NewMockTokenDenylist()).ValidateToken(context.Background(), new_token)
+	if err == nil {
+		t.Error("expected error for unknown key id, got nil")
+	}
+}
+
+// TestKeyRing_RejectsAlgorithmConfusion は鍵に紐づくアルゴリズム以外で署名されたトークンを拒否することをテストします
+func TestKeyRing_RejectsAlgorithmConfusion(t *testing.T) {
+	var rsa_key *SigningKey
+	var key_ring *KeyRing
+	var public_key_der []byte
+	var token *jwt.Token
+	var token_string string
+	var err error
+
+	rsa_key = generate_rsa_signing_key(t, testRSAKeyID)
+	key_ring, err = NewKeyRing(rsa_key)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	// 公開鍵を共通鍵としてHS256で署名したトークン（公開鍵を知る誰でも作成できる）
+	public_key_der, err = x509.MarshalPKIXPublicKey(rsa_key.verify_key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	token = jwt.NewWithClaims(jwt.SigningMethodHS256, &JWTClaims{UserID: testUserID, TokenType: TokenTypeAccess})
+	token.Header["kid"] = testRSAKeyID
+	token_string, err = token.SignedString(public_key_der)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	_, err = NewJWTService(key_ring, NewMockTokenDenylist()).ValidateToken(context.Background(), token_string)
+	if err == nil {
+		t.Error("expected error for algorithm confusion, got nil")
+	}
+}
+
+// TestNewKeyRing_InvalidKeys は署名できない鍵やkidの重複を拒否することをテストします
+func TestNewKeyRing_InvalidKeys(t *testing.T) {
+	var signing_key *SigningKey
+	var verification_key *SigningKey
+	var err error
+
+	signing_key = generate_ed25519_signing_key(t, testEd25519KeyID)
+	verification_key = signing_key.verification_only()
+	_, err = NewKeyRing(verification_key)
+	if err == nil {
+		t.Error("expected error for verification-only signing key, got nil")
+	}
+	_, err = NewKeyRing(signing_key, verification_key)
+	if err == nil {
+		t.Error("expected error for duplicate key id, got nil")
+	}
+}
+
+// TestKeyRing_JWKS は非対称鍵の公開鍵のみがJWKSに含まれることをテストします
+func TestKeyRing_JWKS(t *testing.T) {
+	var hmac_key *SigningKey
+	var key_ring *KeyRing
+	var key_set JSONWebKeySet
+	var err error
+
+	hmac_key, err = NewHMACSigningKey("", []byte(testSecretKey))
+	if err != nil {
+		t.Fatalf("failed to create hmac key: %v", err)
+	}
+	key_ring, err = NewKeyRing(
+		generate_rsa_signing_key(t, testRSAKeyID),
+		generate_ed25519_signing_key(t, testEd25519KeyID).verification_only(),
+		hmac_key.verification_only(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	key_set = key_ring.JWKS()
+	if len(key_set.Keys) != 2 {
+		t.Fatalf("expected 2 public keys, got %d", len(key_set.Keys))
+	}
+	if key_set.Keys[0].KeyID != testRSAKeyID || key_set.Keys[0].KeyType != "RSA" || key_set.Keys[0].Algorithm != "RS256" {
+		t.Errorf("unexpected rsa jwk: %+v", key_set.Keys[0])
+	}
+	if key_set.Keys[0].N == "" || key_set.Keys[0].E != "AQAB" {
+		t.Errorf("expected rsa modulus and exponent, got %+v", key_set.Keys[0])
+	}
+	if key_set.Keys[1].KeyID != testEd25519KeyID || key_set.Keys[1].KeyType != "OKP" || key_set.Keys[1].Curve != "Ed25519" {
+		t.Errorf("unexpected ed25519 jwk: %+v", key_set.Keys[1])
+	}
+	if key_set.Keys[1].X == "" {
+		t.Error("expected ed25519 public key")
+	}
+}
+
+// TestLoadKeyRingFromDir は鍵ディレクトリからKeyRingを読み込み、旧HS256トークンも検証できることをテストします
+func TestLoadKeyRingFromDir(t *testing.T) {
+	var keys_dir string
+	var key_ring *KeyRing
+	var legacy_token string
+	var err error
+
+	keys_dir = t.TempDir()
+	write_pem_file(t, keys_dir, testEd25519KeyID, generate_ed25519_signing_key(t, testEd25519KeyID).sign_key, true)
+	write_pem_file(t, keys_dir, testRSAKeyID, generate_rsa_signing_key(t, testRSAKeyID).verify_key, false)
+	key_ring, err = LoadKeyRingFromDir(keys_dir, testEd25519KeyID, testSecretKey)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if key_ring.SigningKey().KeyID() != testEd25519KeyID {
+		t.Errorf("expected signing kid %s, got %s", testEd25519KeyID, key_ring.SigningKey().KeyID())
+	}
+	if len(key_ring.JWKS().Keys) != 2 {
+		t.Errorf("expected 2 public keys, got %d", len(key_ring.JWKS().Keys))
+	}
+	legacy_token, err = new_hmac_jwt_service(t, testSecretKey, NewMockTokenDenylist()).GenerateAccessToken(testUserID, testFirebaseUID)
+	if err != nil {
+		t.Fatalf("failed to generate legacy token: %v", err)
+	}
+	_, err = NewJWTService(key_ring, NewMockTokenDenylist()).ValidateToken(context.Background(), legacy_token)
+	if err != nil {
+		t.Errorf("expected legacy hs256 token to be valid, got %v", err)
+	}
+	// 公開鍵のみの鍵は署名鍵にできない
+	_, err = LoadKeyRingFromDir(keys_dir, testRSAKeyID, "")
+	if err == nil {
+		t.Error("expected error for verification-only signing key, got nil")
+	}
+	_, err = LoadKeyRingFromDir(keys_dir, "unknown", "")
+	if err == nil {
+		t.Error("expected error for unknown signing kid, got nil")
+	}
+}
+
+// TestParsePEMKey_Invalid は不正なPEMを拒否することをテストします
+func TestParsePEMKey_Invalid(t *testing.T) {
+	var err error
+
+	_, err = ParsePEMKey(testRSAKeyID, []byte("not a pem"))
+	if err == nil {
+		t.Error("expected error for invalid pem, got nil")
+	}
+}
+
+// generate_rsa_signing_key はテスト用のRS256署名鍵を生成します
+func generate_rsa_signing_key(t *testing.T, kid string) *SigningKey {
+	var private_key *rsa.PrivateKey
+	var key *SigningKey
+	var err error
+
+	t.Helper()
+	private_key, err = rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	key, err = NewRSASigningKey(kid, private_key)
+	if err != nil {
+		t.Fatalf("failed to create rsa signing key: %v", err)
+	}
+	return key
+}
+
+// generate_ed25519_signing_key はテスト用のEdDSA署名鍵を生成します
+func generate_ed25519_signing_key(t *testing.T, kid string) *SigningKey {
+	var private_key ed25519.PrivateKey
+	var key *SigningKey
+	var err error
+
+	t.Helper()
+	_, private_key, err = ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	key, err = NewEd25519SigningKey(kid, private_key)
+	if err != nil {
+		t.Fatalf("failed to create ed25519 signing key: %v", err)
+	}
+	return key
+}
+
+// write_pem_file は鍵をPEM形式で「<kid>.pem」に書き込みます
+func write_pem_file(t *testing.T, keys_dir string, kid string, key any, is_private bool) {
+	var der []byte
+	var block_type string
+	var err error
+
+	t.Helper()
+	if is_private {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+		block_type = "PRIVATE KEY"
+	} else {
+		der, err = x509.MarshalPKIXPublicKey(key)
+		block_type = "PUBLIC KEY"
+	}
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	err = os.WriteFile(filepath.Join(keys_dir, kid+pemKeyFileExtension), pem.EncodeToMemory(&pem.Block{Type: block_type, Bytes: der}), 0o600)
+	if err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+}
+
+// assert_token_header はトークンヘッダーのalgとkidを検証します
+func assert_token_header(t *testing.T, token_string string, expected_alg string, expected_kid string) {
+	var token *jwt.Token
+	var err error
+
+	t.Helper()
+	token, _, err = jwt.NewParser().ParseUnverified(token_string, &JWTClaims{})
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if token.Header["alg"] != expected_alg {
+		t.Errorf("expected alg %s, got %v", expected_alg, token.Header["alg"])
+	}
+	if token.Header["kid"] != expected_kid {
+		t.Errorf("expected kid %s, got %v", expected_kid, token.Header["kid"])
+	}
+}
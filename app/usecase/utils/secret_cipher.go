@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// secretCipherKeySize はAES-256の鍵のバイト数です
+const secretCipherKeySize = 32
+
+// SecretCipher はDBに保存する秘密情報（TOTPの共有シークレットなど）をAES-256-GCMで暗号化・復号します
+// 暗号文は「nonce || ciphertext」をBase64でエンコードした文字列です
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher は32バイトの鍵で新しいSecretCipherを作成します
+func NewSecretCipher(key []byte) (*SecretCipher, error) {
+	var block cipher.Block
+	var aead cipher.AEAD
+	var err error
+
+	if len(key) != secretCipherKeySize {
+		return nil, fmt.Errorf("secret cipher key must be %d bytes, got %d", secretCipherKeySize, len(key))
+	}
+	block, err = aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aes cipher: %w", err)
+	}
+	aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// NewSecretCipherFromBase64 はBase64でエンコードされた鍵（環境変数など）から新しいSecretCipherを作成します
+func NewSecretCipherFromBase64(encoded_key string) (*SecretCipher, error) {
+	var key []byte
+	var err error
+
+	key, err = base64.StdEncoding.DecodeString(encoded_key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode secret cipher key: %w", err)
+	}
+	return NewSecretCipher(key)
+}
+
+// Encrypt は平文を暗号化します（同じ平文でも毎回異なる暗号文になります）
+func (c *SecretCipher) Encrypt(plaintext string) (string, error) {
+	var nonce []byte
+	var sealed []byte
+	var err error
+
+	nonce = make([]byte, c.aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed = c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt は暗号文を復号します（改ざんされている場合や鍵が異なる場合はエラーを返します）
+func (c *SecretCipher) Decrypt(ciphertext string) (string, error) {
+	var sealed []byte
+	var plaintext []byte
+	var nonce_size int
+	var err error
+
+	sealed, err = base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+	nonce_size = c.aead.NonceSize()
+	if len(sealed) < nonce_size {
+		return "", fmt.Errorf("ciphertext is too short")
+	}
+	plaintext, err = c.aead.Open(nil, sealed[:nonce_size], sealed[nonce_size:], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+	return string(plaintext), nil
+}
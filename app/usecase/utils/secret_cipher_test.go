@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+// testSecretCipherKey はテスト用のAES-256の鍵です
+var testSecretCipherKey = bytes.Repeat([]byte{0x42}, secretCipherKeySize)
+
+// TestSecretCipher_EncryptDecrypt は暗号化した値を復号できることをテストします
+func TestSecretCipher_EncryptDecrypt(t *testing.T) {
+	var secret_cipher *SecretCipher
+	var first_ciphertext string
+	var second_ciphertext string
+	var plaintext string
+	var err error
+
+	secret_cipher, err = NewSecretCipher(testSecretCipherKey)
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+	first_ciphertext, err = secret_cipher.Encrypt(rfc6238TestSecret)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second_ciphertext, err = secret_cipher.Encrypt(rfc6238TestSecret)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if first_ciphertext == rfc6238TestSecret || first_ciphertext == second_ciphertext {
+		t.Error("expected ciphertext to differ from plaintext and previous ciphertext")
+	}
+	plaintext, err = secret_cipher.Decrypt(first_ciphertext)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if plaintext != rfc6238TestSecret {
+		t.Errorf("expected %s, got %s", rfc6238TestSecret, plaintext)
+	}
+}
+
+// TestSecretCipher_DecryptWithWrongKey は異なる鍵・改ざんされた暗号文の復号が失敗することをテストします
+func TestSecretCipher_DecryptWithWrongKey(t *testing.T) {
+	var secret_cipher *SecretCipher
+	var other_cipher *SecretCipher
+	var ciphertext string
+	var tampered []byte
+	var err error
+
+	secret_cipher, err = NewSecretCipherFromBase64(base64.StdEncoding.EncodeToString(testSecretCipherKey))
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+	other_cipher, err = NewSecretCipher(bytes.Repeat([]byte{0x24}, secretCipherKeySize))
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+	ciphertext, err = secret_cipher.Encrypt(rfc6238TestSecret)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	_, err = other_cipher.Decrypt(ciphertext)
+	if err == nil {
+		t.Error("expected error for wrong key")
+	}
+	tampered, _ = base64.StdEncoding.DecodeString(ciphertext)
+	tampered[len(tampered)-1] ^= 0x01
+	_, err = secret_cipher.Decrypt(base64.StdEncoding.EncodeToString(tampered))
+	if err == nil {
+		t.Error("expected error for tampered ciphertext")
+	}
+}
+
+// TestNewSecretCipher_InvalidKeySize は鍵長が不正な場合をテストします
+func TestNewSecretCipher_InvalidKeySize(t *testing.T) {
+	var err error
+
+	_, err = NewSecretCipher([]byte("short"))
+	if err == nil {
+		t.Error("expected error for short key")
+	}
+}
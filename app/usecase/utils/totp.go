@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTPの設定（RFC 6238。Google Authenticatorなどの認証アプリの既定値に合わせています）
+const (
+	// TotpIssuer は認証アプリに表示される発行者名です
+	TotpIssuer = "SLEEVE"
+	// totpDigits は認証コードの桁数です
+	totpDigits = 6
+	// totpPeriodSeconds は認証コードが切り替わる間隔（タイムステップ）です
+	totpPeriodSeconds = 30
+	// totpSkewSteps は端末の時刻ずれを許容するタイムステップ数です（前後1ステップ）
+	totpSkewSteps = 1
+	// totpSecretSize は共有シークレットのバイト数です（HMAC-SHA1の出力長）
+	totpSecretSize = 20
+)
+
+// totp_secret_encoding は共有シークレットの表現形式です（パディングなしのBase32）
+var totp_secret_encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret はTOTPの共有シークレットをBase32形式で生成します
+func GenerateTotpSecret() (string, error) {
+	var secret []byte
+	var err error
+
+	secret = make([]byte, totpSecretSize)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	return totp_secret_encoding.EncodeToString(secret), nil
+}
+
+// TotpProvisioningURI は認証アプリに登録するためのotpauth URIを返します（QRコードの内容）
+func TotpProvisioningURI(account_name, secret string) string {
+	var query url.Values
+
+	query = url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", TotpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", totpDigits))
+	query.Set("period", fmt.Sprintf("%d", totpPeriodSeconds))
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + TotpIssuer + ":" + account_name,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// GenerateTotpCode は指定した時刻の認証コードを生成します
+func GenerateTotpCode(secret string, at time.Time) (string, error) {
+	var key []byte
+	var err error
+
+	key, err = decode_totp_secret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate_totp_code_at_step(key, totp_step(at)), nil
+}
+
+// VerifyTotpCode は認証コードを検証し、一致したタイムステップを返します
+// 端末の時刻ずれを考慮して前後のタイムステップも受け付けます
+// 同じコードの再利用を防ぐため、呼び出し元は返されたタイムステップを記録してください
+func VerifyTotpCode(secret, code string, now time.Time) (int64, bool) {
+	var key []byte
+	var current_step int64
+	var err error
+
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	key, err = decode_totp_secret(secret)
+	if err != nil {
+		return 0, false
+	}
+	current_step = totp_step(now)
+	for step := current_step - totpSkewSteps; step <= current_step+totpSkewSteps; step++ {
+		if subtle.ConstantTimeCompare([]byte(generate_totp_code_at_step(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// decode_totp_secret はBase32形式の共有シークレットをデコードします
+func decode_totp_secret(secret string) ([]byte, error) {
+	var key []byte
+	var err error
+
+	key, err = totp_secret_encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+	return key, nil
+}
+
+// totp_step は時刻をタイムステップに変換します
+func totp_step(at time.Time) int64 {
+	return at.Unix() / totpPeriodSeconds
+}
+
+// generate_totp_code_at_step はタイムステップの認証コードを生成します（RFC 4226の動的切り捨て）
+func generate_totp_code_at_step(key []byte, step int64) string {
+	var counter [8]byte
+	var hasher hash.Hash
+	var mac []byte
+	var offset int
+	var binary_code uint32
+	var modulo uint32
+
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+	hasher = hmac.New(sha1.New, key)
+	hasher.Write(counter[:])
+	mac = hasher.Sum(nil)
+	offset = int(mac[len(mac)-1] & 0x0f)
+	binary_code = binary.BigEndian.Uint32(mac[offset:offset+4]) & 0x7fffffff
+	modulo = 1
+	for range totpDigits {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, binary_code%modulo)
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238TestSecret はRFC 6238のテストベクター（SHA1）の共有シークレット「12345678901234567890」をBase32にしたものです
+const rfc6238TestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// TestGenerateTotpCode_RFC6238 はRFC 6238のテストベクター（下位6桁）と一致することをテストします
+func TestGenerateTotpCode_RFC6238(t *testing.T) {
+	var test_cases []struct {
+		unix_time int64
+		expected  string
+	}
+	var code string
+	var err error
+
+	test_cases = []struct {
+		unix_time int64
+		expected  string
+	}{
+		{unix_time: 59, expected: "287082"},
+		{unix_time: 1111111109, expected: "081804"},
+		{unix_time: 1234567890, expected: "005924"},
+		{unix_time: 2000000000, expected: "279037"},
+	}
+	for _, test_case := range test_cases {
+		code, err = GenerateTotpCode(rfc6238TestSecret, time.Unix(test_case.unix_time, 0))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if code != test_case.expected {
+			t.Errorf("expected code %s at %d, got %s", test_case.expected, test_case.unix_time, code)
+		}
+	}
+}
+
+// TestVerifyTotpCode_Success は前後1ステップの時刻ずれを許容して検証できることをテストします
+func TestVerifyTotpCode_Success(t *testing.T) {
+	var secret string
+	var now time.Time
+	var code string
+	var step int64
+	var is_valid bool
+	var err error
+
+	secret, err = GenerateTotpSecret()
+	if err != nil {
+		t.Fatalf("failed to generate secret: %v", err)
+	}
+	now = time.Unix(1700000000, 0)
+	code, err = GenerateTotpCode(secret, now.Add(-totpPeriodSeconds*time.Second))
+	if err != nil {
+		t.Fatalf("failed to generate code: %v", err)
+	}
+	step, is_valid = VerifyTotpCode(secret, code, now)
+	if !is_valid {
+		t.Fatal("expected code from previous step to be valid")
+	}
+	if step != totp_step(now)-1 {
+		t.Errorf("expected step %d, got %d", totp_step(now)-1, step)
+	}
+}
+
+// TestVerifyTotpCode_Invalid は期限切れ・形式不正の認証コードを拒否することをテストします
+func TestVerifyTotpCode_Invalid(t *testing.T) {
+	var now time.Time
+	var expired_code string
+	var is_valid bool
+	var err error
+
+	now = time.Unix(1700000000, 0)
+	expired_code, err = GenerateTotpCode(rfc6238TestSecret, now.Add(-2*totpPeriodSeconds*time.Second))
+	if err != nil {
+		t.Fatalf("failed to generate code: %v", err)
+	}
+	_, is_valid = VerifyTotpCode(rfc6238TestSecret, expired_code, now)
+	if is_valid {
+		t.Error("expected code from two steps ago to be rejected")
+	}
+	_, is_valid = VerifyTotpCode(rfc6238TestSecret, "12345", now)
+	if is_valid {
+		t.Error("expected short code to be rejected")
+	}
+	_, is_valid = VerifyTotpCode("not base32!", "123456", now)
+	if is_valid {
+		t.Error("expected invalid secret to be rejected")
+	}
+}
+
+// TestTotpProvisioningURI は認証アプリ用のotpauth URIをテストします
+func TestTotpProvisioningURI(t *testing.T) {
+	var uri string
+	var parsed *url.URL
+	var err error
+
+	uri = TotpProvisioningURI("user@example.com", rfc6238TestSecret)
+	parsed, err = url.Parse(uri)
+	if err != nil {
+		t.Fatalf("failed to parse uri: %v", err)
+	}
+	if parsed.Scheme != "otpauth" || parsed.Host != "totp" {
+		t.Errorf("unexpected uri: %s", uri)
+	}
+	if !strings.HasSuffix(parsed.Path, "SLEEVE:user@example.com") {
+		t.Errorf("expected account label in path, got %s", parsed.Path)
+	}
+	if parsed.Query().Get("secret") != rfc6238TestSecret || parsed.Query().Get("issuer") != TotpIssuer {
+		t.Errorf("unexpected query: %s", parsed.RawQuery)
+	}
+}